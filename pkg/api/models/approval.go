@@ -1,7 +1,5 @@
 package models
 
-import "encoding/json"
-
 // Approval approval
 type Approval struct {
 
@@ -23,13 +21,13 @@ type Approval struct {
 
 // ToJSON converts object to JSON string
 func (a *Approval) ToJSON() ([]byte, error) {
-	return json.Marshal(a)
+	return jsonCodec.Marshal(a)
 }
 
 // FromJSON converts JSON string to object
 func (a *Approval) FromJSON(b []byte) error {
 	var res Approval
-	if err := json.Unmarshal(b, &res); err != nil {
+	if err := jsonCodec.Unmarshal(b, &res); err != nil {
 		return err
 	}
 	*a = res
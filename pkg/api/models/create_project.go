@@ -1,7 +1,5 @@
 package models
 
-import "encoding/json"
-
 // CreateProject create project
 type CreateProject struct {
 	// name
@@ -18,13 +16,13 @@ type CreateProject struct {
 
 // ToJSON converts object to JSON string
 func (c *CreateProject) ToJSON() ([]byte, error) {
-	return json.Marshal(c)
+	return jsonCodec.Marshal(c)
 }
 
 // FromJSON converts JSON string to object
 func (c *CreateProject) FromJSON(b []byte) error {
 	var res CreateProject
-	if err := json.Unmarshal(b, &res); err != nil {
+	if err := jsonCodec.Unmarshal(b, &res); err != nil {
 		return err
 	}
 	*c = res
@@ -1,7 +1,5 @@
 package models
 
-import "encoding/json"
-
 // CreateService create service
 type CreateService struct {
 
@@ -12,13 +10,13 @@ type CreateService struct {
 
 // ToJSON converts object to JSON string
 func (c *CreateService) ToJSON() ([]byte, error) {
-	return json.Marshal(c)
+	return jsonCodec.Marshal(c)
 }
 
 // FromJSON converts JSON string to object
 func (c *CreateService) FromJSON(b []byte) error {
 	var res CreateService
-	if err := json.Unmarshal(b, &res); err != nil {
+	if err := jsonCodec.Unmarshal(b, &res); err != nil {
 		return err
 	}
 	*c = res
@@ -0,0 +1,136 @@
+package models
+
+import (
+	"fmt"
+	"strconv"
+)
+
+// endOfListKey is the nextPageKey some Keptn services return for the last page instead of an empty key
+const endOfListKey = "0"
+
+// Cursor points to the next page of a list returned by the Keptn API.
+// The zero value points to the first page. Cursors obtained from a response
+// can be persisted, e.g. in checkpoints, using Encode and restored using DecodeCursor
+type Cursor struct {
+	key string
+}
+
+// DecodeCursor creates a Cursor from a nextPageKey as returned by the Keptn API or a previously encoded Cursor
+func DecodeCursor(nextPageKey string) (Cursor, error) {
+	if nextPageKey == "" || nextPageKey == endOfListKey {
+		return Cursor{}, nil
+	}
+	if offset, err := strconv.ParseInt(nextPageKey, 10, 64); err == nil && offset < 0 {
+		return Cursor{}, fmt.Errorf("invalid cursor %q: must not be negative", nextPageKey)
+	}
+	for _, c := range nextPageKey {
+		if !isCursorChar(c) {
+			return Cursor{}, fmt.Errorf("invalid cursor %q: unexpected character %q", nextPageKey, c)
+		}
+	}
+	return Cursor{key: nextPageKey}, nil
+}
+
+// CursorFromOffset creates a Cursor for APIs returning a numeric nextPageKey
+func CursorFromOffset(offset int64) Cursor {
+	if offset <= 0 {
+		return Cursor{}
+	}
+	return Cursor{key: strconv.FormatInt(offset, 10)}
+}
+
+// isCursorChar returns whether c is part of the alphabet of standard or URL safe base64, in which the
+// Keptn API encodes its page keys. Numeric page keys consist of characters of this alphabet as well
+func isCursorChar(c rune) bool {
+	return (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z') || (c >= '0' && c <= '9') ||
+		c == '-' || c == '_' || c == '+' || c == '/' || c == '='
+}
+
+// Encode returns the nextPageKey to send to the Keptn API. It is empty for a Cursor pointing to the first page
+func (c Cursor) Encode() string {
+	return c.key
+}
+
+// IsEnd returns whether a Cursor received from the Keptn API indicates that there are no further pages
+func (c Cursor) IsEnd() bool {
+	return c.key == ""
+}
+
+// Offset returns the number of items before the page the Cursor points to, if the nextPageKey is numeric
+func (c Cursor) Offset() (int, bool) {
+	offset, err := strconv.Atoi(c.key)
+	if err != nil {
+		return 0, false
+	}
+	return offset, true
+}
+
+// String returns the encoded Cursor
+func (c Cursor) String() string {
+	return c.Encode()
+}
+
+// MarshalText implements encoding.TextMarshaler
+func (c Cursor) MarshalText() ([]byte, error) {
+	return []byte(c.Encode()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler
+func (c *Cursor) UnmarshalText(text []byte) error {
+	cursor, err := DecodeCursor(string(text))
+	if err != nil {
+		return err
+	}
+	*c = cursor
+	return nil
+}
+
+// Cursor returns the Cursor pointing to the next page
+func (e *Events) Cursor() (Cursor, error) {
+	return DecodeCursor(e.NextPageKey)
+}
+
+// Cursor returns the Cursor pointing to the next page
+func (p *ExpandedProjects) Cursor() (Cursor, error) {
+	return DecodeCursor(p.NextPageKey)
+}
+
+// Cursor returns the Cursor pointing to the next page
+func (s *ExpandedServices) Cursor() (Cursor, error) {
+	return DecodeCursor(s.NextPageKey)
+}
+
+// Cursor returns the Cursor pointing to the next page
+func (s *ExpandedStages) Cursor() (Cursor, error) {
+	return DecodeCursor(s.NextPageKey)
+}
+
+// Cursor returns the Cursor pointing to the next page
+func (p *Projects) Cursor() (Cursor, error) {
+	return DecodeCursor(p.NextPageKey)
+}
+
+// Cursor returns the Cursor pointing to the next page
+func (r *Resources) Cursor() (Cursor, error) {
+	return DecodeCursor(r.NextPageKey)
+}
+
+// Cursor returns the Cursor pointing to the next page
+func (s *Services) Cursor() (Cursor, error) {
+	return DecodeCursor(s.NextPageKey)
+}
+
+// Cursor returns the Cursor pointing to the next page
+func (s *Stages) Cursor() (Cursor, error) {
+	return DecodeCursor(s.NextPageKey)
+}
+
+// Cursor returns the Cursor pointing to the next page
+func (l *GetLogsResponse) Cursor() Cursor {
+	return CursorFromOffset(l.NextPageKey)
+}
+
+// Cursor returns the Cursor pointing to the next page
+func (s *SequenceStates) Cursor() Cursor {
+	return CursorFromOffset(s.NextPageKey)
+}
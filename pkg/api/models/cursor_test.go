@@ -0,0 +1,87 @@
+package models
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDecodeCursor(t *testing.T) {
+	tests := []struct {
+		nextPageKey string
+		wantEnd     bool
+		wantOffset  int
+		wantNumeric bool
+		wantErr     bool
+	}{
+		{nextPageKey: "", wantEnd: true},
+		{nextPageKey: "0", wantEnd: true},
+		{nextPageKey: "20", wantOffset: 20, wantNumeric: true},
+		{nextPageKey: "eyJvZmZzZXQiOjIwfQ=="},
+		{nextPageKey: "-_8Q-w"},
+		{nextPageKey: "a+b/c="},
+		{nextPageKey: "-1", wantErr: true},
+		{nextPageKey: "20&pageSize=100", wantErr: true},
+		{nextPageKey: " 20", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.nextPageKey, func(t *testing.T) {
+			cursor, err := DecodeCursor(tt.nextPageKey)
+			if tt.wantErr {
+				require.NotNil(t, err)
+				return
+			}
+			require.Nil(t, err)
+			require.Equal(t, tt.wantEnd, cursor.IsEnd())
+			offset, numeric := cursor.Offset()
+			require.Equal(t, tt.wantNumeric, numeric)
+			require.Equal(t, tt.wantOffset, offset)
+			if !tt.wantEnd {
+				require.Equal(t, tt.nextPageKey, cursor.Encode())
+			} else {
+				require.Equal(t, "", cursor.Encode())
+			}
+		})
+	}
+}
+
+func TestCursorFromOffset(t *testing.T) {
+	require.True(t, CursorFromOffset(0).IsEnd())
+	require.True(t, CursorFromOffset(-5).IsEnd())
+	require.Equal(t, "10", CursorFromOffset(10).Encode())
+}
+
+func TestCursor_PersistAsJSON(t *testing.T) {
+	type checkpoint struct {
+		Cursor Cursor `json:"cursor"`
+	}
+	cursor, err := DecodeCursor("40")
+	require.Nil(t, err)
+
+	b, err := json.Marshal(checkpoint{Cursor: cursor})
+	require.Nil(t, err)
+	require.Equal(t, `{"cursor":"40"}`, string(b))
+
+	restored := checkpoint{}
+	require.Nil(t, json.Unmarshal(b, &restored))
+	require.Equal(t, cursor, restored.Cursor)
+
+	require.NotNil(t, json.Unmarshal([]byte(`{"cursor":"-1"}`), &restored))
+}
+
+func TestListCursors(t *testing.T) {
+	cursor, err := (&Events{NextPageKey: "5"}).Cursor()
+	require.Nil(t, err)
+	require.Equal(t, "5", cursor.Encode())
+
+	cursor, err = (&Projects{NextPageKey: "0"}).Cursor()
+	require.Nil(t, err)
+	require.True(t, cursor.IsEnd())
+
+	_, err = (&Resources{NextPageKey: "a b"}).Cursor()
+	require.NotNil(t, err)
+
+	require.Equal(t, "3", (&SequenceStates{NextPageKey: 3}).Cursor().Encode())
+	require.True(t, (&GetLogsResponse{}).Cursor().IsEnd())
+}
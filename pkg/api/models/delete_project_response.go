@@ -1,23 +1,24 @@
 package models
 
-import "encoding/json"
-
 // DeleteProjectResponse delete project response
 type DeleteProjectResponse struct {
 
 	// message
 	Message string `json:"message,omitempty"`
+
+	// AlreadyDeleted is set if the project did not exist and the resulting 404 Not Found was treated as success
+	AlreadyDeleted bool `json:"-"`
 }
 
 // ToJSON converts object to JSON string
 func (d *DeleteProjectResponse) ToJSON() ([]byte, error) {
-	return json.Marshal(d)
+	return jsonCodec.Marshal(d)
 }
 
 // FromJSON converts JSON string to object
 func (d *DeleteProjectResponse) FromJSON(b []byte) error {
 	var res DeleteProjectResponse
-	if err := json.Unmarshal(b, &res); err != nil {
+	if err := jsonCodec.Unmarshal(b, &res); err != nil {
 		return err
 	}
 	*d = res
@@ -1,7 +1,6 @@
 package models
 
 import (
-	"encoding/json"
 	"fmt"
 
 	"github.com/keptn/go-utils/pkg/common/strutils"
@@ -16,6 +15,18 @@ type Error struct {
 	// Error message
 	// Required: true
 	Message *string `json:"message"`
+
+	// RequestID is the ID of the request that failed, as sent in the X-Request-ID header
+	RequestID string `json:"-"`
+
+	// ContentLanguage is the language of the error message, as sent by the server in the Content-Language header
+	ContentLanguage string `json:"-"`
+
+	// ResponseBody is the full body of the response that caused the error. It is only set if debug error verbosity is enabled
+	ResponseBody []byte `json:"-"`
+
+	// Err is the error which made the request fail before a response has been received, e.g. utils.ErrReadOnly
+	Err error `json:"-"`
 }
 
 func (e Error) GetMessage() string {
@@ -25,15 +36,20 @@ func (e Error) GetMessage() string {
 	return *e.Message
 }
 
+// GetResponseBody returns the full body of the response that caused the error, if it has been kept
+func (e Error) GetResponseBody() []byte {
+	return e.ResponseBody
+}
+
 // ToJSON converts object to JSON string
 func (e *Error) ToJSON() ([]byte, error) {
-	return json.Marshal(e)
+	return jsonCodec.Marshal(e)
 }
 
 // FromJSON converts JSON string to object
 func (e *Error) FromJSON(b []byte) error {
 	var res Error
-	if err := json.Unmarshal(b, &res); err != nil {
+	if err := jsonCodec.Unmarshal(b, &res); err != nil {
 		return err
 	}
 	*e = res
@@ -43,7 +59,25 @@ func (e *Error) FromJSON(b []byte) error {
 	return nil
 }
 
-// ToError converts model to fmt.Error
+// ToError converts model to fmt.Error. If Err is set, the returned error wraps it,
+// so that it can be inspected with errors.Is and errors.As
 func (e *Error) ToError() error {
+	if e.Err != nil {
+		return &causedError{message: e.GetMessage(), cause: e.Err}
+	}
 	return fmt.Errorf(*e.Message)
 }
+
+// causedError is an error with the message of an Error wrapping the error which caused it
+type causedError struct {
+	message string
+	cause   error
+}
+
+func (e *causedError) Error() string {
+	return e.message
+}
+
+func (e *causedError) Unwrap() error {
+	return e.cause
+}
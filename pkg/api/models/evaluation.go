@@ -1,7 +1,5 @@
 package models
 
-import "encoding/json"
-
 type Evaluation struct {
 
 	// Evaluation start timestamp
@@ -22,13 +20,13 @@ type Evaluation struct {
 
 // ToJSON converts object to JSON string
 func (e *Evaluation) ToJSON() ([]byte, error) {
-	return json.Marshal(e)
+	return jsonCodec.Marshal(e)
 }
 
 // FromJSON converts JSON string to object
 func (e *Evaluation) FromJSON(b []byte) error {
 	var res Evaluation
-	if err := json.Unmarshal(b, &res); err != nil {
+	if err := jsonCodec.Unmarshal(b, &res); err != nil {
 		return err
 	}
 	*e = res
@@ -1,24 +1,30 @@
 package models
 
-import "encoding/json"
-
 // EventContext event context
 type EventContext struct {
 
 	// keptn context
 	// Required: true
 	KeptnContext *string `json:"keptnContext"`
+
+	// AlreadyExists is set if the create request was answered with 409 Conflict,
+	// which was treated as success because the client accepts already existing entities
+	AlreadyExists bool `json:"-"`
+
+	// AlreadyDeleted is set if the delete request was answered with 404 Not Found,
+	// which was treated as success because the client ignores missing entities on delete
+	AlreadyDeleted bool `json:"-"`
 }
 
 // ToJSON converts object to JSON string
 func (ec *EventContext) ToJSON() ([]byte, error) {
-	return json.Marshal(ec)
+	return jsonCodec.Marshal(ec)
 }
 
 // FromJSON converts JSON string to object
 func (ec *EventContext) FromJSON(b []byte) error {
 	var res EventContext
-	if err := json.Unmarshal(b, &res); err != nil {
+	if err := jsonCodec.Unmarshal(b, &res); err != nil {
 		return err
 	}
 	*ec = res
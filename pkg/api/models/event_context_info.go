@@ -1,7 +1,5 @@
 package models
 
-import "encoding/json"
-
 // EventContextInfo event context info
 type EventContextInfo struct {
 
@@ -17,13 +15,13 @@ type EventContextInfo struct {
 
 // ToJSON converts object to JSON string
 func (ec *EventContextInfo) ToJSON() ([]byte, error) {
-	return json.Marshal(ec)
+	return jsonCodec.Marshal(ec)
 }
 
 // FromJSON converts JSON string to object
 func (ec *EventContextInfo) FromJSON(b []byte) error {
 	var res EventContextInfo
-	if err := json.Unmarshal(b, &res); err != nil {
+	if err := jsonCodec.Unmarshal(b, &res); err != nil {
 		return err
 	}
 	*ec = res
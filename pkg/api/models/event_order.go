@@ -0,0 +1,80 @@
+package models
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"time"
+)
+
+// minUnixNanoTime and maxUnixNanoTime are the earliest and latest times whose nanoseconds since the epoch fit into an int64
+var (
+	minUnixNanoTime = time.Unix(0, math.MinInt64)
+	maxUnixNanoTime = time.Unix(0, math.MaxInt64)
+)
+
+// EventOrderKey is a stable sort key of an event, consisting of its time and its ID.
+// The ID breaks ties between events whose time collides, e.g. because the time is only stored with millisecond resolution,
+// so that ordering events by their keys is deterministic
+type EventOrderKey struct {
+	// UnixNano is the time of the event in nanoseconds since the epoch. Times outside of the range of an int64,
+	// i.e. before 1678 or after 2262, are clamped, e.g. the zero time.Time is math.MinInt64
+	UnixNano int64
+	ID       string
+}
+
+// NewEventOrderKey returns the EventOrderKey of an event with the given time and ID
+func NewEventOrderKey(t time.Time, id string) EventOrderKey {
+	return EventOrderKey{UnixNano: UnixNanoSaturated(t), ID: id}
+}
+
+// OrderKey returns the EventOrderKey of the event. The key of a nil event is the zero EventOrderKey
+func (ce *KeptnContextExtendedCE) OrderKey() EventOrderKey {
+	if ce == nil {
+		return EventOrderKey{}
+	}
+	return NewEventOrderKey(ce.Time, ce.ID)
+}
+
+// Compare returns -1 if k is ordered before other, 1 if it is ordered after other, and 0 if the keys are equal
+func (k EventOrderKey) Compare(other EventOrderKey) int {
+	switch {
+	case k.UnixNano < other.UnixNano:
+		return -1
+	case k.UnixNano > other.UnixNano:
+		return 1
+	}
+	return strings.Compare(k.ID, other.ID)
+}
+
+// Less returns whether k is ordered before other
+func (k EventOrderKey) Less(other EventOrderKey) bool {
+	return k.Compare(other) < 0
+}
+
+// String returns a representation of the key whose lexicographical order is the order of the keys,
+// e.g. to be used as key in a sorted key-value store
+func (k EventOrderKey) String() string {
+	// flipping the sign bit maps the int64 range onto the uint64 range keeping the order
+	return fmt.Sprintf("%020d/%s", uint64(k.UnixNano)^(1<<63), k.ID)
+}
+
+// UnixNanoSaturated returns t in nanoseconds since the epoch like time.Time.UnixNano, but returns math.MinInt64
+// or math.MaxInt64 for times outside of the range of an int64 instead of an undefined result
+func UnixNanoSaturated(t time.Time) int64 {
+	switch {
+	case t.Before(minUnixNanoTime):
+		return math.MinInt64
+	case t.After(maxUnixNanoTime):
+		return math.MaxInt64
+	}
+	return t.UnixNano()
+}
+
+// SortEvents sorts the events by their EventOrderKey, i.e. by time and, for events with the same time, by ID
+func SortEvents(events []*KeptnContextExtendedCE) {
+	sort.SliceStable(events, func(i, j int) bool {
+		return events[i].OrderKey().Less(events[j].OrderKey())
+	})
+}
@@ -0,0 +1,51 @@
+package models
+
+import (
+	"math"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestEventOrderKey(t *testing.T) {
+	now := time.Date(2022, 1, 1, 10, 0, 0, 0, time.UTC)
+	keys := []EventOrderKey{
+		NewEventOrderKey(now.Add(time.Millisecond), "a"),
+		NewEventOrderKey(now, "b"),
+		NewEventOrderKey(now, "a"),
+		NewEventOrderKey(time.Date(1960, 1, 1, 0, 0, 0, 0, time.UTC), "c"),
+		NewEventOrderKey(time.Time{}, "d"),
+		NewEventOrderKey(time.Date(3000, 1, 1, 0, 0, 0, 0, time.UTC), "e"),
+	}
+	sort.Slice(keys, func(i, j int) bool { return keys[i].Less(keys[j]) })
+	ids := []string{}
+	for _, key := range keys {
+		ids = append(ids, key.ID)
+	}
+	require.Equal(t, []string{"d", "c", "a", "b", "a", "e"}, ids)
+	require.Equal(t, int64(math.MinInt64), keys[0].UnixNano)
+	require.Equal(t, int64(math.MaxInt64), keys[5].UnixNano)
+
+	// the string representations are ordered like the keys
+	for i := 1; i < len(keys); i++ {
+		require.Less(t, keys[i-1].String(), keys[i].String())
+	}
+	require.Equal(t, 0, keys[2].Compare(NewEventOrderKey(now, "a")))
+	require.Equal(t, 1, keys[3].Compare(keys[2]))
+}
+
+func TestSortEvents(t *testing.T) {
+	now := time.Date(2022, 1, 1, 10, 0, 0, 0, time.UTC)
+	events := []*KeptnContextExtendedCE{
+		{ID: "c", Time: now},
+		{ID: "b", Time: now.Add(time.Millisecond)},
+		{ID: "a", Time: now},
+	}
+	SortEvents(events)
+	require.Equal(t, "a", events[0].ID)
+	require.Equal(t, "c", events[1].ID)
+	require.Equal(t, "b", events[2].ID)
+	require.Equal(t, EventOrderKey{}, (*KeptnContextExtendedCE)(nil).OrderKey())
+}
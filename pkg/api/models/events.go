@@ -1,7 +1,5 @@
 package models
 
-import "encoding/json"
-
 // Events events
 type Events struct {
 
@@ -20,13 +18,13 @@ type Events struct {
 
 // ToJSON converts object to JSON string
 func (e *Events) ToJSON() ([]byte, error) {
-	return json.Marshal(e)
+	return jsonCodec.Marshal(e)
 }
 
 // FromJSON converts JSON string to object
 func (e *Events) FromJSON(b []byte) error {
 	var res Events
-	if err := json.Unmarshal(b, &res); err != nil {
+	if err := jsonCodec.Unmarshal(b, &res); err != nil {
 		return err
 	}
 	*e = res
@@ -1,7 +1,5 @@
 package models
 
-import "encoding/json"
-
 // ExpandedProject expanded project
 //
 // swagger:model ExpandedProject
@@ -31,13 +29,13 @@ type ExpandedProject struct {
 
 // ToJSON converts object to JSON string
 func (a *ExpandedProject) ToJSON() ([]byte, error) {
-	return json.Marshal(a)
+	return jsonCodec.Marshal(a)
 }
 
 // FromJSON converts JSON string to object
 func (a *ExpandedProject) FromJSON(b []byte) error {
 	var res ExpandedProject
-	if err := json.Unmarshal(b, &res); err != nil {
+	if err := jsonCodec.Unmarshal(b, &res); err != nil {
 		return err
 	}
 	*a = res
@@ -1,7 +1,5 @@
 package models
 
-import "encoding/json"
-
 // GitAuthCredentials stores git credentials
 type GitAuthCredentials struct {
 
@@ -20,13 +18,13 @@ type GitAuthCredentials struct {
 
 // ToJSON converts object to JSON string
 func (p *GitAuthCredentials) ToJSON() ([]byte, error) {
-	return json.Marshal(p)
+	return jsonCodec.Marshal(p)
 }
 
 // FromJSON converts JSON string to object
 func (p *GitAuthCredentials) FromJSON(b []byte) error {
 	var res GitAuthCredentials
-	if err := json.Unmarshal(b, &res); err != nil {
+	if err := jsonCodec.Unmarshal(b, &res); err != nil {
 		return err
 	}
 	*p = res
@@ -50,13 +48,13 @@ type HttpsGitAuth struct {
 
 // ToJSON converts object to JSON string
 func (p *HttpsGitAuth) ToJSON() ([]byte, error) {
-	return json.Marshal(p)
+	return jsonCodec.Marshal(p)
 }
 
 // FromJSON converts JSON string to object
 func (p *HttpsGitAuth) FromJSON(b []byte) error {
 	var res HttpsGitAuth
-	if err := json.Unmarshal(b, &res); err != nil {
+	if err := jsonCodec.Unmarshal(b, &res); err != nil {
 		return err
 	}
 	*p = res
@@ -74,13 +72,13 @@ type SshGitAuth struct {
 
 // ToJSON converts object to JSON string
 func (p *SshGitAuth) ToJSON() ([]byte, error) {
-	return json.Marshal(p)
+	return jsonCodec.Marshal(p)
 }
 
 // FromJSON converts JSON string to object
 func (p *SshGitAuth) FromJSON(b []byte) error {
 	var res SshGitAuth
-	if err := json.Unmarshal(b, &res); err != nil {
+	if err := jsonCodec.Unmarshal(b, &res); err != nil {
 		return err
 	}
 	*p = res
@@ -104,13 +102,13 @@ type ProxyGitAuth struct {
 
 // ToJSON converts object to JSON string
 func (p *ProxyGitAuth) ToJSON() ([]byte, error) {
-	return json.Marshal(p)
+	return jsonCodec.Marshal(p)
 }
 
 // FromJSON converts JSON string to object
 func (p *ProxyGitAuth) FromJSON(b []byte) error {
 	var res ProxyGitAuth
-	if err := json.Unmarshal(b, &res); err != nil {
+	if err := jsonCodec.Unmarshal(b, &res); err != nil {
 		return err
 	}
 	*p = res
@@ -132,13 +130,13 @@ type GitAuthCredentialsSecure struct {
 
 // ToJSON converts object to JSON string
 func (p *GitAuthCredentialsSecure) ToJSON() ([]byte, error) {
-	return json.Marshal(p)
+	return jsonCodec.Marshal(p)
 }
 
 // FromJSON converts JSON string to object
 func (p *GitAuthCredentialsSecure) FromJSON(b []byte) error {
 	var res GitAuthCredentialsSecure
-	if err := json.Unmarshal(b, &res); err != nil {
+	if err := jsonCodec.Unmarshal(b, &res); err != nil {
 		return err
 	}
 	*p = res
@@ -157,13 +155,13 @@ type HttpsGitAuthSecure struct {
 
 // ToJSON converts object to JSON string
 func (p *HttpsGitAuthSecure) ToJSON() ([]byte, error) {
-	return json.Marshal(p)
+	return jsonCodec.Marshal(p)
 }
 
 // FromJSON converts JSON string to object
 func (p *HttpsGitAuthSecure) FromJSON(b []byte) error {
 	var res HttpsGitAuthSecure
-	if err := json.Unmarshal(b, &res); err != nil {
+	if err := jsonCodec.Unmarshal(b, &res); err != nil {
 		return err
 	}
 	*p = res
@@ -185,13 +183,13 @@ type ProxyGitAuthSecure struct {
 
 // ToJSON converts object to JSON string
 func (p *ProxyGitAuthSecure) ToJSON() ([]byte, error) {
-	return json.Marshal(p)
+	return jsonCodec.Marshal(p)
 }
 
 // FromJSON converts JSON string to object
 func (p *ProxyGitAuthSecure) FromJSON(b []byte) error {
 	var res ProxyGitAuthSecure
-	if err := json.Unmarshal(b, &res); err != nil {
+	if err := jsonCodec.Unmarshal(b, &res); err != nil {
 		return err
 	}
 	*p = res
@@ -0,0 +1,39 @@
+package models
+
+import "encoding/json"
+
+// JSONCodec is used by the models to convert themselves from and to JSON.
+// Performance-sensitive users can replace the default codec, which is based on encoding/json,
+// with an implementation using a faster JSON library by calling SetJSONCodec.
+// An implementation must produce the same output as encoding/json for all models
+type JSONCodec interface {
+	// Marshal returns the JSON encoding of v
+	Marshal(v interface{}) ([]byte, error)
+	// Unmarshal parses the JSON-encoded data and stores the result in the value pointed to by v
+	Unmarshal(data []byte, v interface{}) error
+}
+
+// StdJSONCodec is the default JSONCodec based on encoding/json
+type StdJSONCodec struct{}
+
+// Marshal returns the JSON encoding of v
+func (StdJSONCodec) Marshal(v interface{}) ([]byte, error) {
+	return json.Marshal(v)
+}
+
+// Unmarshal parses the JSON-encoded data and stores the result in the value pointed to by v
+func (StdJSONCodec) Unmarshal(data []byte, v interface{}) error {
+	return json.Unmarshal(data, v)
+}
+
+var jsonCodec JSONCodec = StdJSONCodec{}
+
+// SetJSONCodec sets the JSONCodec used by all models.
+// Passing nil restores the default StdJSONCodec.
+// Note, that SetJSONCodec is not safe for concurrent use and should only be called during the initialization of a program
+func SetJSONCodec(codec JSONCodec) {
+	if codec == nil {
+		codec = StdJSONCodec{}
+	}
+	jsonCodec = codec
+}
@@ -0,0 +1,120 @@
+package models
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+type jsonModel interface {
+	ToJSON() ([]byte, error)
+	FromJSON(b []byte) error
+}
+
+// countingJSONCodec delegates to encoding/json and records how often it has been used
+type countingJSONCodec struct {
+	calls int
+}
+
+func (c *countingJSONCodec) Marshal(v interface{}) ([]byte, error) {
+	c.calls++
+	return json.Marshal(v)
+}
+
+func (c *countingJSONCodec) Unmarshal(data []byte, v interface{}) error {
+	c.calls++
+	return json.Unmarshal(data, v)
+}
+
+func conformanceModels() []jsonModel {
+	source := "source"
+	message := "message"
+	resourceURI := "resource.yaml"
+	keptnContext := "context"
+	return []jsonModel{
+		&Approval{},
+		&CreateLogsRequest{Logs: []LogEntry{{IntegrationID: "id", Message: message}}},
+		&CreateProject{},
+		&CreateService{},
+		&CreateSubscriptionResponse{ID: "id"},
+		&DeleteProjectResponse{Message: message},
+		&DeleteServiceResponse{Message: message},
+		&Error{Code: 404, Message: &message},
+		&Evaluation{Start: "start", Labels: map[string]string{"b": "2", "a": "1"}},
+		&EventContext{KeptnContext: &keptnContext},
+		&EventContextInfo{},
+		&Events{Events: []*KeptnContextExtendedCE{{ID: "id", Source: &source}}, NextPageKey: "1"},
+		&ExpandedProject{ProjectName: "project"},
+		&GetLogsResponse{},
+		&GetSecretsResponse{},
+		&GitAuthCredentials{User: "user", HttpsAuth: &HttpsGitAuth{Token: "token"}},
+		&GitAuthCredentialsSecure{},
+		&HttpsGitAuth{},
+		&HttpsGitAuthSecure{},
+		&Integration{Name: "integration"},
+		&KeptnContextExtendedCE{
+			ID:     "id",
+			Source: &source,
+			Time:   time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC),
+			Data:   map[string]interface{}{"project": "<project>", "nested": map[string]interface{}{"a": 1.5}},
+		},
+		&LogEntry{IntegrationID: "id", Message: "<&>"},
+		&Metadata{},
+		&Project{ProjectName: "project", Stages: []*Stage{{StageName: "dev"}}},
+		&Projects{},
+		&ProxyGitAuth{},
+		&ProxyGitAuthSecure{},
+		&RegisterIntegrationResponse{ID: "id"},
+		&Resource{ResourceURI: &resourceURI, ResourceContent: "content"},
+		&Resources{},
+		&Secret{},
+		&Service{ServiceName: "service"},
+		&Services{},
+		&SshGitAuth{},
+		&Stage{StageName: "dev", Services: []*Service{{ServiceName: "service"}}},
+		&Stages{},
+		&Timeframe{},
+		&Version{Version: "v1"},
+	}
+}
+
+// testJSONCodecConformance verifies that all models produce the same output using
+// the currently configured JSONCodec as using encoding/json and that they can be decoded again
+func testJSONCodecConformance(t *testing.T) {
+	for _, model := range conformanceModels() {
+		t.Run(reflect.TypeOf(model).Elem().Name(), func(t *testing.T) {
+			expected, err := json.Marshal(model)
+			require.Nil(t, err)
+
+			actual, err := model.ToJSON()
+			require.Nil(t, err)
+			require.Equal(t, string(expected), string(actual))
+
+			decoded := reflect.New(reflect.TypeOf(model).Elem()).Interface().(jsonModel)
+			require.Nil(t, decoded.FromJSON(actual))
+
+			reencoded, err := decoded.ToJSON()
+			require.Nil(t, err)
+			require.Equal(t, string(expected), string(reencoded))
+		})
+	}
+}
+
+func TestJSONCodecConformance(t *testing.T) {
+	testJSONCodecConformance(t)
+}
+
+func TestSetJSONCodec(t *testing.T) {
+	codec := &countingJSONCodec{}
+	SetJSONCodec(codec)
+	defer SetJSONCodec(nil)
+
+	testJSONCodecConformance(t)
+	require.Equal(t, 3*len(conformanceModels()), codec.calls)
+
+	SetJSONCodec(nil)
+	require.Equal(t, StdJSONCodec{}, jsonCodec)
+}
@@ -1,9 +1,10 @@
 package models
 
 import (
-	"encoding/json"
 	"errors"
 	"fmt"
+	"net/url"
+	"strings"
 	"time"
 )
 
@@ -54,11 +55,11 @@ type KeptnContextExtendedCE struct {
 // DataAs attempts to populate the provided data object with the event payload.
 // data should be a pointer type.
 func (ce *KeptnContextExtendedCE) DataAs(out interface{}) error {
-	bytes, err := json.Marshal(ce.Data)
+	bytes, err := jsonCodec.Marshal(ce.Data)
 	if err != nil {
 		return err
 	}
-	return json.Unmarshal(bytes, out)
+	return jsonCodec.Unmarshal(bytes, out)
 }
 
 // Validate checks whether the required properties 'time', 'type', 'id' and 'source' are defined and non-empty
@@ -75,6 +76,21 @@ func (ce *KeptnContextExtendedCE) Validate() error {
 	if ce.Source == nil || *ce.Source == "" {
 		return errors.New("source must be specified")
 	}
+	return ValidateEventSource(*ce.Source)
+}
+
+// ValidateEventSource checks whether the given source is a non-empty URI-reference,
+// as required for the source of a CloudEvent, e.g. "helm-service" or "https://github.com/keptn/keptn"
+func ValidateEventSource(source string) error {
+	if source == "" {
+		return errors.New("source must be specified")
+	}
+	if strings.ContainsAny(source, " \t\r\n") {
+		return fmt.Errorf("source %q is not a valid URI-reference: must not contain whitespace", source)
+	}
+	if _, err := url.Parse(source); err != nil {
+		return fmt.Errorf("source %q is not a valid URI-reference: %w", source, err)
+	}
 	return nil
 }
 
@@ -93,20 +109,28 @@ type AddTemporaryDataOptions struct {
 const temporaryDataRootKey = "temporaryData"
 
 // AddTemporaryData adds further (temporary) properties to the data section of the keptn event
+// If the data of the event is already decoded into a map, the map is copied instead of being mutated,
+// so that other copies of the event sharing the same data are not affected (copy-on-write)
 func (ce *KeptnContextExtendedCE) AddTemporaryData(key string, tmpData TemporaryData, opts AddTemporaryDataOptions) error {
-	eventData := map[string]interface{}{}
-	if ce.Data != nil {
-		err := ce.DataAs(&eventData)
-		if err != nil {
-			return err
-		}
+	eventData, err := ce.copyData()
+	if err != nil {
+		return err
 	}
 	if temporaryData, found := eventData[temporaryDataRootKey]; found {
-		if _, kfound := temporaryData.(map[string]interface{})[key]; kfound {
+		temporaryDataMap, ok := temporaryData.(map[string]interface{})
+		if !ok {
+			return fmt.Errorf("unexpected format of temporary data")
+		}
+		if _, kfound := temporaryDataMap[key]; kfound {
 			if !opts.OverwriteIfExisting {
 				return fmt.Errorf("Key %s already exists", key)
 			}
-			temporaryData.(map[string]interface{})[key] = tmpData
+			temporaryDataCopy := make(map[string]interface{}, len(temporaryDataMap))
+			for k, v := range temporaryDataMap {
+				temporaryDataCopy[k] = v
+			}
+			temporaryDataCopy[key] = tmpData
+			eventData[temporaryDataRootKey] = temporaryDataCopy
 		}
 	} else {
 		eventData[temporaryDataRootKey] = map[string]interface{}{key: tmpData}
@@ -115,6 +139,26 @@ func (ce *KeptnContextExtendedCE) AddTemporaryData(key string, tmpData Temporary
 	return nil
 }
 
+// copyData returns a shallow copy of the event data as a map.
+// If the data is not yet available as a map it is decoded
+func (ce *KeptnContextExtendedCE) copyData() (map[string]interface{}, error) {
+	if ce.Data == nil {
+		return map[string]interface{}{}, nil
+	}
+	if data, ok := ce.Data.(map[string]interface{}); ok {
+		dataCopy := make(map[string]interface{}, len(data)+1)
+		for k, v := range data {
+			dataCopy[k] = v
+		}
+		return dataCopy, nil
+	}
+	eventData := map[string]interface{}{}
+	if err := ce.DataAs(&eventData); err != nil {
+		return nil, err
+	}
+	return eventData, nil
+}
+
 // GetTemporaryData returns the (temporary) data eventually stored in the event
 func (ce *KeptnContextExtendedCE) GetTemporaryData(key string, tmpdata interface{}) error {
 	eventData := map[string]interface{}{}
@@ -123,8 +167,8 @@ func (ce *KeptnContextExtendedCE) GetTemporaryData(key string, tmpdata interface
 	}
 	if temporaryData, found := eventData[temporaryDataRootKey]; found {
 		if keyData, kfound := temporaryData.(map[string]interface{})[key]; kfound {
-			if marshalledKeyData, err := json.Marshal(keyData); err == nil {
-				return json.Unmarshal(marshalledKeyData, tmpdata)
+			if marshalledKeyData, err := jsonCodec.Marshal(keyData); err == nil {
+				return jsonCodec.Unmarshal(marshalledKeyData, tmpdata)
 			}
 		}
 		return fmt.Errorf("temporary data with key %s not found", key)
@@ -134,13 +178,13 @@ func (ce *KeptnContextExtendedCE) GetTemporaryData(key string, tmpdata interface
 
 // ToJSON converts object to JSON string
 func (ce *KeptnContextExtendedCE) ToJSON() ([]byte, error) {
-	return json.Marshal(ce)
+	return jsonCodec.Marshal(ce)
 }
 
 // FromJSON converts JSON string to object
 func (ce *KeptnContextExtendedCE) FromJSON(b []byte) error {
 	var res KeptnContextExtendedCE
-	if err := json.Unmarshal(b, &res); err != nil {
+	if err := jsonCodec.Unmarshal(b, &res); err != nil {
 		return err
 	}
 	*ce = res
@@ -13,6 +13,7 @@ import (
 
 func TestKeptnContextExtendedCE_Validate(t *testing.T) {
 	source := "my-source"
+	invalidSource := "my source"
 	eventType := "my-type"
 	type fields struct {
 		Contenttype        string
@@ -79,6 +80,16 @@ func TestKeptnContextExtendedCE_Validate(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name: "invalid source",
+			fields: fields{
+				ID:     "my-id",
+				Source: &invalidSource,
+				Time:   time.Now(),
+				Type:   &eventType,
+			},
+			wantErr: true,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -103,6 +114,17 @@ func TestKeptnContextExtendedCE_Validate(t *testing.T) {
 	}
 }
 
+func TestValidateEventSource(t *testing.T) {
+	validSources := []string{"helm-service", "https://github.com/keptn/keptn/helm-service", "/keptn/helm-service", "urn:keptn:helm-service"}
+	for _, source := range validSources {
+		require.Nil(t, models.ValidateEventSource(source), source)
+	}
+	invalidSources := []string{"", "helm service", "helm-service\n", "%zz", "http://[::1"}
+	for _, source := range invalidSources {
+		require.NotNil(t, models.ValidateEventSource(source), source)
+	}
+}
+
 func TestAddTemporaryData(t *testing.T) {
 	type TestData struct {
 		v0_2_0.EventData
@@ -243,3 +265,35 @@ func TestKeptnContextExtendedCE_TemporaryData(t *testing.T) {
 		assert.NotNil(t, err)
 	})
 }
+
+func TestAddTemporaryData_DoesNotModifyOriginalData(t *testing.T) {
+	originalData := map[string]interface{}{
+		"project":       "my-project",
+		"temporaryData": map[string]interface{}{"the-key": "the-value"},
+	}
+	event := models.KeptnContextExtendedCE{Data: originalData}
+	eventCopy := event
+
+	err := eventCopy.AddTemporaryData("the-key", "new-value", models.AddTemporaryDataOptions{OverwriteIfExisting: true})
+	require.Nil(t, err)
+
+	require.Equal(t, map[string]interface{}{
+		"project":       "my-project",
+		"temporaryData": map[string]interface{}{"the-key": "the-value"},
+	}, event.Data)
+
+	var value string
+	require.Nil(t, eventCopy.GetTemporaryData("the-key", &value))
+	require.Equal(t, "new-value", value)
+}
+
+func BenchmarkAddTemporaryData(b *testing.B) {
+	data := map[string]interface{}{"project": "my-project", "stage": "my-stage", "service": "my-service"}
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		event := models.KeptnContextExtendedCE{Data: data}
+		if err := event.AddTemporaryData("distributor", "data", models.AddTemporaryDataOptions{}); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
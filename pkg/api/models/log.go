@@ -1,7 +1,6 @@
 package models
 
 import (
-	"encoding/json"
 	"time"
 )
 
@@ -41,13 +40,13 @@ type CreateLogsRequest struct {
 
 // ToJSON converts object to JSON string
 func (l *LogEntry) ToJSON() ([]byte, error) {
-	return json.Marshal(l)
+	return jsonCodec.Marshal(l)
 }
 
 // FromJSON converts JSON string to object
 func (l *LogEntry) FromJSON(b []byte) error {
 	var res LogEntry
-	if err := json.Unmarshal(b, &res); err != nil {
+	if err := jsonCodec.Unmarshal(b, &res); err != nil {
 		return err
 	}
 	*l = res
@@ -56,13 +55,13 @@ func (l *LogEntry) FromJSON(b []byte) error {
 
 // ToJSON converts object to JSON string
 func (l *GetLogsResponse) ToJSON() ([]byte, error) {
-	return json.Marshal(l)
+	return jsonCodec.Marshal(l)
 }
 
 // FromJSON converts JSON string to object
 func (l *GetLogsResponse) FromJSON(b []byte) error {
 	var res GetLogsResponse
-	if err := json.Unmarshal(b, &res); err != nil {
+	if err := jsonCodec.Unmarshal(b, &res); err != nil {
 		return err
 	}
 	*l = res
@@ -71,13 +70,13 @@ func (l *GetLogsResponse) FromJSON(b []byte) error {
 
 // ToJSON converts object to JSON string
 func (l *CreateLogsRequest) ToJSON() ([]byte, error) {
-	return json.Marshal(l)
+	return jsonCodec.Marshal(l)
 }
 
 // FromJSON converts JSON string to object
 func (l *CreateLogsRequest) FromJSON(b []byte) error {
 	var res CreateLogsRequest
-	if err := json.Unmarshal(b, &res); err != nil {
+	if err := jsonCodec.Unmarshal(b, &res); err != nil {
 		return err
 	}
 	*l = res
@@ -1,7 +1,5 @@
 package models
 
-import "encoding/json"
-
 // Metadata metadata
 type Metadata struct {
 
@@ -30,13 +28,13 @@ type Metadata struct {
 
 // ToJSON converts object to JSON string
 func (m *Metadata) ToJSON() ([]byte, error) {
-	return json.Marshal(m)
+	return jsonCodec.Marshal(m)
 }
 
 // FromJSON converts JSON string to object
 func (m *Metadata) FromJSON(b []byte) error {
 	var res Metadata
-	if err := json.Unmarshal(b, &res); err != nil {
+	if err := jsonCodec.Unmarshal(b, &res); err != nil {
 		return err
 	}
 	*m = res
@@ -0,0 +1,8 @@
+// Package modelspb provides protobuf representations of core keptn API models.
+//
+// The message types are generated from models.proto and can be converted from
+// and to their counterparts in the models package. Use proto.Marshal and
+// proto.Unmarshal to encode and decode them.
+package modelspb
+
+//go:generate protoc --go_out=. --go_opt=paths=source_relative models.proto
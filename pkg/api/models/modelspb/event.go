@@ -0,0 +1,80 @@
+package modelspb
+
+import (
+	"encoding/json"
+
+	"github.com/keptn/go-utils/pkg/api/models"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// FromKeptnContextExtendedCE converts a models.KeptnContextExtendedCE to its protobuf representation.
+// Data and Extensions are carried as JSON encoded bytes
+func FromKeptnContextExtendedCE(ce *models.KeptnContextExtendedCE) (*KeptnContextExtendedCE, error) {
+	data, err := marshalJSON(ce.Data)
+	if err != nil {
+		return nil, err
+	}
+	extensions, err := marshalJSON(ce.Extensions)
+	if err != nil {
+		return nil, err
+	}
+	res := &KeptnContextExtendedCE{
+		Contenttype:        ce.Contenttype,
+		Data:               data,
+		Extensions:         extensions,
+		Id:                 ce.ID,
+		Shkeptncontext:     ce.Shkeptncontext,
+		Shkeptnspecversion: ce.Shkeptnspecversion,
+		Source:             ce.Source,
+		Specversion:        ce.Specversion,
+		Triggeredid:        ce.Triggeredid,
+		Gitcommitid:        ce.GitCommitID,
+		Type:               ce.Type,
+	}
+	if !ce.Time.IsZero() {
+		res.Time = timestamppb.New(ce.Time)
+	}
+	return res, nil
+}
+
+// ToModel converts the message to a models.KeptnContextExtendedCE
+func (x *KeptnContextExtendedCE) ToModel() (*models.KeptnContextExtendedCE, error) {
+	ce := &models.KeptnContextExtendedCE{
+		Contenttype:        x.GetContenttype(),
+		ID:                 x.GetId(),
+		Shkeptncontext:     x.GetShkeptncontext(),
+		Shkeptnspecversion: x.GetShkeptnspecversion(),
+		Source:             x.Source,
+		Specversion:        x.GetSpecversion(),
+		Triggeredid:        x.GetTriggeredid(),
+		GitCommitID:        x.GetGitcommitid(),
+		Type:               x.Type,
+	}
+	if x.GetTime() != nil {
+		if err := x.Time.CheckValid(); err != nil {
+			return nil, err
+		}
+		ce.Time = x.Time.AsTime()
+	}
+	if err := unmarshalJSON(x.GetData(), &ce.Data); err != nil {
+		return nil, err
+	}
+	if err := unmarshalJSON(x.GetExtensions(), &ce.Extensions); err != nil {
+		return nil, err
+	}
+	return ce, nil
+}
+
+func marshalJSON(v interface{}) ([]byte, error) {
+	if v == nil {
+		return nil, nil
+	}
+	return json.Marshal(v)
+}
+
+func unmarshalJSON(b []byte, v *interface{}) error {
+	if len(b) == 0 {
+		return nil
+	}
+	return json.Unmarshal(b, v)
+}
@@ -0,0 +1,151 @@
+package modelspb
+
+import (
+	"testing"
+	"time"
+
+	"github.com/keptn/go-utils/pkg/api/models"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/proto"
+)
+
+func strPtr(s string) *string {
+	return &s
+}
+
+func TestKeptnContextExtendedCE_RoundTrip(t *testing.T) {
+	ce := &models.KeptnContextExtendedCE{
+		Contenttype:        "application/json",
+		Data:               map[string]interface{}{"project": "pr", "stage": "dev", "labels": map[string]interface{}{"a": "b"}},
+		ID:                 "my-id",
+		Shkeptncontext:     "my-context",
+		Shkeptnspecversion: "0.2.4",
+		Source:             strPtr("my-source"),
+		Specversion:        "1.0",
+		Time:               time.Date(2022, 6, 1, 12, 30, 15, 123456789, time.UTC),
+		Triggeredid:        "my-triggered-id",
+		GitCommitID:        "my-commit",
+		Type:               strPtr("sh.keptn.event.deployment.triggered"),
+	}
+
+	msg, err := FromKeptnContextExtendedCE(ce)
+	require.NoError(t, err)
+	b, err := proto.Marshal(msg)
+	require.NoError(t, err)
+
+	decoded := &KeptnContextExtendedCE{}
+	require.NoError(t, proto.Unmarshal(b, decoded))
+	got, err := decoded.ToModel()
+	require.NoError(t, err)
+	require.Equal(t, ce, got)
+}
+
+func TestKeptnContextExtendedCE_EmptySourceIsPreserved(t *testing.T) {
+	msg, err := FromKeptnContextExtendedCE(&models.KeptnContextExtendedCE{Source: strPtr("")})
+	require.NoError(t, err)
+	b, err := proto.Marshal(msg)
+	require.NoError(t, err)
+
+	decoded := &KeptnContextExtendedCE{}
+	require.NoError(t, proto.Unmarshal(b, decoded))
+	require.NotNil(t, decoded.Source)
+	require.Equal(t, "", *decoded.Source)
+	require.Nil(t, decoded.Type)
+	require.Nil(t, decoded.Time)
+	require.Nil(t, decoded.Data)
+}
+
+func TestProject_RoundTrip(t *testing.T) {
+	project := &models.Project{
+		CreationDate:    "1654000000",
+		ProjectName:     "sockshop",
+		ShipyardVersion: "spec.keptn.sh/0.2.3",
+		Stages: []*models.Stage{
+			{
+				StageName: "dev",
+				Services: []*models.Service{
+					{
+						CreationDate:  "1654000001",
+						DeployedImage: "carts:0.1.0",
+						ServiceName:   "carts",
+						LastEventTypes: map[string]models.EventContextInfo{
+							"sh.keptn.event.deployment.finished": {EventID: "id-1", KeptnContext: "ctx-1", Time: "1654000002"},
+							"sh.keptn.event.test.finished":       {EventID: "id-2", KeptnContext: "ctx-1", Time: "1654000003"},
+						},
+						OpenApprovals: []*models.Approval{
+							{EventID: "id-3", Image: "carts", KeptnContext: "ctx-1", Tag: "0.1.0", Time: "1654000004"},
+						},
+					},
+				},
+			},
+			{StageName: "prod"},
+		},
+		GitCredentials: &models.GitAuthCredentials{User: "user"},
+	}
+
+	b, err := proto.Marshal(FromProject(project))
+	require.NoError(t, err)
+
+	decoded := &Project{}
+	require.NoError(t, proto.Unmarshal(b, decoded))
+
+	expected := *project
+	expected.GitCredentials = nil
+	require.Equal(t, &expected, decoded.ToModel())
+}
+
+func TestSequenceState_RoundTrip(t *testing.T) {
+	state := &models.SequenceState{
+		Name:           "delivery",
+		Service:        "carts",
+		Project:        "sockshop",
+		Time:           "2022-06-01T12:00:00.000Z",
+		Shkeptncontext: "ctx-1",
+		State:          "finished",
+		ProblemTitle:   "problem",
+		Stages: []models.SequenceStateStage{
+			{
+				Name:              "dev",
+				Image:             "carts:0.1.0",
+				State:             "finished",
+				LatestEvaluation:  &models.SequenceStateEvaluation{Result: "pass", Score: 95.5},
+				LatestEvent:       &models.SequenceStateEvent{Type: "sh.keptn.event.release.finished", ID: "id-1", Time: "t1"},
+				LatestFailedEvent: &models.SequenceStateEvent{Type: "sh.keptn.event.test.finished", ID: "id-2", Time: "t2"},
+			},
+			{
+				Name:             "prod",
+				State:            "triggered",
+				LatestEvaluation: &models.SequenceStateEvaluation{Result: "fail"},
+			},
+		},
+	}
+
+	b, err := proto.Marshal(FromSequenceState(state))
+	require.NoError(t, err)
+
+	decoded := &SequenceState{}
+	require.NoError(t, proto.Unmarshal(b, decoded))
+	require.Equal(t, state, decoded.ToModel())
+}
+
+func TestUnmarshal_SkipsUnknownFields(t *testing.T) {
+	var b []byte
+	b = protowire.AppendTag(b, 2, protowire.BytesType)
+	b = protowire.AppendString(b, "carts")
+	b = protowire.AppendTag(b, 99, protowire.VarintType)
+	b = protowire.AppendVarint(b, 42)
+	b = protowire.AppendTag(b, 100, protowire.BytesType)
+	b = protowire.AppendString(b, "unknown")
+
+	decoded := &SequenceState{}
+	require.NoError(t, proto.Unmarshal(b, decoded))
+	require.Equal(t, "carts", decoded.Service)
+}
+
+func TestUnmarshal_InvalidInput(t *testing.T) {
+	b := protowire.AppendTag(nil, 1, protowire.BytesType)
+
+	require.Error(t, proto.Unmarshal(b, &SequenceState{}))
+	require.Error(t, proto.Unmarshal([]byte{0x0a, 0x05, 'a'}, &Project{}))
+}
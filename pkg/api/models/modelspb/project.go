@@ -0,0 +1,102 @@
+package modelspb
+
+import "github.com/keptn/go-utils/pkg/api/models"
+
+// FromProject converts a models.Project to its protobuf representation
+func FromProject(p *models.Project) *Project {
+	res := &Project{
+		CreationDate:    p.CreationDate,
+		ProjectName:     p.ProjectName,
+		ShipyardVersion: p.ShipyardVersion,
+	}
+	for _, stage := range p.Stages {
+		if stage == nil {
+			continue
+		}
+		s := &Stage{StageName: stage.StageName}
+		for _, service := range stage.Services {
+			if service == nil {
+				continue
+			}
+			s.Services = append(s.Services, fromService(service))
+		}
+		res.Stages = append(res.Stages, s)
+	}
+	return res
+}
+
+func fromService(service *models.Service) *Service {
+	res := &Service{
+		CreationDate:  service.CreationDate,
+		DeployedImage: service.DeployedImage,
+		ServiceName:   service.ServiceName,
+	}
+	if len(service.LastEventTypes) > 0 {
+		res.LastEventTypes = make(map[string]*EventContextInfo, len(service.LastEventTypes))
+		for eventType, info := range service.LastEventTypes {
+			res.LastEventTypes[eventType] = &EventContextInfo{
+				EventId:      info.EventID,
+				KeptnContext: info.KeptnContext,
+				Time:         info.Time,
+			}
+		}
+	}
+	for _, approval := range service.OpenApprovals {
+		if approval == nil {
+			continue
+		}
+		res.OpenApprovals = append(res.OpenApprovals, &Approval{
+			EventId:      approval.EventID,
+			Image:        approval.Image,
+			KeptnContext: approval.KeptnContext,
+			Tag:          approval.Tag,
+			Time:         approval.Time,
+		})
+	}
+	return res
+}
+
+// ToModel converts the message to a models.Project
+func (x *Project) ToModel() *models.Project {
+	res := &models.Project{
+		CreationDate:    x.CreationDate,
+		ProjectName:     x.ProjectName,
+		ShipyardVersion: x.ShipyardVersion,
+	}
+	for _, stage := range x.Stages {
+		s := &models.Stage{StageName: stage.StageName}
+		for _, service := range stage.Services {
+			s.Services = append(s.Services, service.toModel())
+		}
+		res.Stages = append(res.Stages, s)
+	}
+	return res
+}
+
+func (x *Service) toModel() *models.Service {
+	res := &models.Service{
+		CreationDate:  x.CreationDate,
+		DeployedImage: x.DeployedImage,
+		ServiceName:   x.ServiceName,
+	}
+	if len(x.LastEventTypes) > 0 {
+		res.LastEventTypes = make(map[string]models.EventContextInfo, len(x.LastEventTypes))
+		for eventType, info := range x.LastEventTypes {
+			res.LastEventTypes[eventType] = models.EventContextInfo{
+				EventID:      info.GetEventId(),
+				KeptnContext: info.GetKeptnContext(),
+				Time:         info.GetTime(),
+			}
+		}
+	}
+	for _, approval := range x.OpenApprovals {
+		res.OpenApprovals = append(res.OpenApprovals, &models.Approval{
+			EventID:      approval.EventId,
+			Image:        approval.Image,
+			KeptnContext: approval.KeptnContext,
+			Tag:          approval.Tag,
+			Time:         approval.Time,
+		})
+	}
+	return res
+}
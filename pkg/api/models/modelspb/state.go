@@ -0,0 +1,78 @@
+package modelspb
+
+import "github.com/keptn/go-utils/pkg/api/models"
+
+// FromSequenceState converts a models.SequenceState to its protobuf representation
+func FromSequenceState(s *models.SequenceState) *SequenceState {
+	res := &SequenceState{
+		Name:           s.Name,
+		Service:        s.Service,
+		Project:        s.Project,
+		Time:           s.Time,
+		Shkeptncontext: s.Shkeptncontext,
+		State:          s.State,
+		ProblemTitle:   s.ProblemTitle,
+	}
+	for _, stage := range s.Stages {
+		st := &SequenceStateStage{
+			Name:              stage.Name,
+			Image:             stage.Image,
+			State:             stage.State,
+			LatestEvent:       fromSequenceStateEvent(stage.LatestEvent),
+			LatestFailedEvent: fromSequenceStateEvent(stage.LatestFailedEvent),
+		}
+		if stage.LatestEvaluation != nil {
+			st.LatestEvaluation = &SequenceStateEvaluation{
+				Result: stage.LatestEvaluation.Result,
+				Score:  stage.LatestEvaluation.Score,
+			}
+		}
+		res.Stages = append(res.Stages, st)
+	}
+	return res
+}
+
+func fromSequenceStateEvent(e *models.SequenceStateEvent) *SequenceStateEvent {
+	if e == nil {
+		return nil
+	}
+	return &SequenceStateEvent{Type: e.Type, Id: e.ID, Time: e.Time}
+}
+
+// ToModel converts the message to a models.SequenceState
+func (x *SequenceState) ToModel() *models.SequenceState {
+	res := &models.SequenceState{
+		Name:           x.Name,
+		Service:        x.Service,
+		Project:        x.Project,
+		Time:           x.Time,
+		Shkeptncontext: x.Shkeptncontext,
+		State:          x.State,
+		ProblemTitle:   x.ProblemTitle,
+		Stages:         []models.SequenceStateStage{},
+	}
+	for _, stage := range x.Stages {
+		st := models.SequenceStateStage{
+			Name:              stage.Name,
+			Image:             stage.Image,
+			State:             stage.State,
+			LatestEvent:       stage.LatestEvent.toModel(),
+			LatestFailedEvent: stage.LatestFailedEvent.toModel(),
+		}
+		if stage.LatestEvaluation != nil {
+			st.LatestEvaluation = &models.SequenceStateEvaluation{
+				Result: stage.LatestEvaluation.Result,
+				Score:  stage.LatestEvaluation.Score,
+			}
+		}
+		res.Stages = append(res.Stages, st)
+	}
+	return res
+}
+
+func (x *SequenceStateEvent) toModel() *models.SequenceStateEvent {
+	if x == nil {
+		return nil
+	}
+	return &models.SequenceStateEvent{Type: x.Type, ID: x.Id, Time: x.Time}
+}
@@ -1,7 +1,5 @@
 package models
 
-import "encoding/json"
-
 // Project project
 type Project struct {
 
@@ -23,13 +21,13 @@ type Project struct {
 
 // ToJSON converts object to JSON string
 func (p *Project) ToJSON() ([]byte, error) {
-	return json.Marshal(p)
+	return jsonCodec.Marshal(p)
 }
 
 // FromJSON converts JSON string to object
 func (p *Project) FromJSON(b []byte) error {
 	var res Project
-	if err := json.Unmarshal(b, &res); err != nil {
+	if err := jsonCodec.Unmarshal(b, &res); err != nil {
 		return err
 	}
 	*p = res
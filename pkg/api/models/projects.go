@@ -1,7 +1,5 @@
 package models
 
-import "encoding/json"
-
 // Projects projects
 type Projects struct {
 
@@ -20,13 +18,13 @@ type Projects struct {
 
 // ToJSON converts object to JSON string
 func (p *Projects) ToJSON() ([]byte, error) {
-	return json.Marshal(p)
+	return jsonCodec.Marshal(p)
 }
 
 // FromJSON converts JSON string to object
 func (p *Projects) FromJSON(b []byte) error {
 	var res Projects
-	if err := json.Unmarshal(b, &res); err != nil {
+	if err := jsonCodec.Unmarshal(b, &res); err != nil {
 		return err
 	}
 	*p = res
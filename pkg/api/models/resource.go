@@ -1,7 +1,5 @@
 package models
 
-import "encoding/json"
-
 // Resource resource
 type Resource struct {
 
@@ -14,17 +12,20 @@ type Resource struct {
 	// Resource URI
 	// Required: true
 	ResourceURI *string `json:"resourceURI"`
+
+	// SHA-256 checksum of the resource content, e.g. sha256:9f86d08...
+	Checksum string `json:"checksum,omitempty"`
 }
 
 // ToJSON converts object to JSON string
 func (r *Resource) ToJSON() ([]byte, error) {
-	return json.Marshal(r)
+	return jsonCodec.Marshal(r)
 }
 
 // FromJSON converts JSON string to object
 func (r *Resource) FromJSON(b []byte) error {
 	var res Resource
-	if err := json.Unmarshal(b, &res); err != nil {
+	if err := jsonCodec.Unmarshal(b, &res); err != nil {
 		return err
 	}
 	*r = res
@@ -1,7 +1,5 @@
 package models
 
-import "encoding/json"
-
 // Resources resources
 type Resources struct {
 
@@ -20,13 +18,13 @@ type Resources struct {
 
 // ToJSON converts object to JSON string
 func (r *Resources) ToJSON() ([]byte, error) {
-	return json.Marshal(r)
+	return jsonCodec.Marshal(r)
 }
 
 // FromJSON converts JSON string to object
 func (r *Resources) FromJSON(b []byte) error {
 	var res Resources
-	if err := json.Unmarshal(b, &res); err != nil {
+	if err := jsonCodec.Unmarshal(b, &res); err != nil {
 		return err
 	}
 	*r = res
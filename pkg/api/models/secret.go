@@ -1,7 +1,5 @@
 package models
 
-import "encoding/json"
-
 // Secret secret
 type Secret struct {
 
@@ -33,13 +31,13 @@ type GetSecretsResponse struct {
 
 // ToJSON converts object to JSON string
 func (s *Secret) ToJSON() ([]byte, error) {
-	return json.Marshal(s)
+	return jsonCodec.Marshal(s)
 }
 
 // FromJSON converts JSON string to object
 func (s *Secret) FromJSON(b []byte) error {
 	var res Secret
-	if err := json.Unmarshal(b, &res); err != nil {
+	if err := jsonCodec.Unmarshal(b, &res); err != nil {
 		return err
 	}
 	*s = res
@@ -48,13 +46,13 @@ func (s *Secret) FromJSON(b []byte) error {
 
 // ToJSON converts object to JSON string
 func (s *GetSecretsResponse) ToJSON() ([]byte, error) {
-	return json.Marshal(s)
+	return jsonCodec.Marshal(s)
 }
 
 // FromJSON converts JSON string to object
 func (s *GetSecretsResponse) FromJSON(b []byte) error {
 	var res GetSecretsResponse
-	if err := json.Unmarshal(b, &res); err != nil {
+	if err := jsonCodec.Unmarshal(b, &res); err != nil {
 		return err
 	}
 	*s = res
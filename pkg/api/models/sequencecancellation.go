@@ -1,5 +1,10 @@
 package models
 
+import (
+	"fmt"
+	"strings"
+)
+
 // SequenceTimeout is used to signal via channel that a sequence needs to be timed out
 type SequenceTimeout struct {
 	KeptnContext string
@@ -20,6 +25,45 @@ const (
 	AbortSequence SequenceControlState = "abort"
 )
 
+// Validate returns an error if the SequenceControlState is not one of PauseSequence, ResumeSequence or AbortSequence
+func (s SequenceControlState) Validate() error {
+	switch s {
+	case PauseSequence, ResumeSequence, AbortSequence:
+		return nil
+	}
+	return fmt.Errorf("invalid sequence control state %q: must be one of %q, %q, %q", string(s), PauseSequence, ResumeSequence, AbortSequence)
+}
+
+// MarshalText implements encoding.TextMarshaler and rejects unknown states.
+// An empty state is accepted so that a missing state can be reported by the receiver
+func (s SequenceControlState) MarshalText() ([]byte, error) {
+	if s != "" {
+		if err := s.Validate(); err != nil {
+			return nil, err
+		}
+	}
+	return []byte(s), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Known states are matched regardless of case and surrounding
+// whitespace, while unknown states are decoded as they are, so that they can be rejected via Validate
+func (s *SequenceControlState) UnmarshalText(text []byte) error {
+	*s = SequenceControlState(canonicalState(string(text), string(PauseSequence), string(ResumeSequence), string(AbortSequence)))
+	return nil
+}
+
+// canonicalState returns the known state matching the given one regardless of case and surrounding whitespace,
+// or the given state if it is unknown
+func canonicalState(state string, known ...string) string {
+	trimmed := strings.TrimSpace(state)
+	for _, k := range known {
+		if strings.EqualFold(trimmed, k) {
+			return k
+		}
+	}
+	return state
+}
+
 // SequenceControl represents the wanted SequenceControlState for a certain Project Stage and Context
 type SequenceControl struct {
 	State        SequenceControlState
@@ -1,7 +1,5 @@
 package models
 
-import "encoding/json"
-
 // Service service
 type Service struct {
 
@@ -23,13 +21,13 @@ type Service struct {
 
 // ToJSON converts object to JSON string
 func (s *Service) ToJSON() ([]byte, error) {
-	return json.Marshal(s)
+	return jsonCodec.Marshal(s)
 }
 
 // FromJSON converts JSON string to object
 func (s *Service) FromJSON(b []byte) error {
 	var res Service
-	if err := json.Unmarshal(b, &res); err != nil {
+	if err := jsonCodec.Unmarshal(b, &res); err != nil {
 		return err
 	}
 	*s = res
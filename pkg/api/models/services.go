@@ -1,7 +1,5 @@
 package models
 
-import "encoding/json"
-
 // Services services
 type Services struct {
 
@@ -20,13 +18,13 @@ type Services struct {
 
 // ToJSON converts object to JSON string
 func (s *Services) ToJSON() ([]byte, error) {
-	return json.Marshal(s)
+	return jsonCodec.Marshal(s)
 }
 
 // FromJSON converts JSON string to object
 func (s *Services) FromJSON(b []byte) error {
 	var res Services
-	if err := json.Unmarshal(b, &res); err != nil {
+	if err := jsonCodec.Unmarshal(b, &res); err != nil {
 		return err
 	}
 	*s = res
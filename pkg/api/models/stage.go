@@ -1,7 +1,5 @@
 package models
 
-import "encoding/json"
-
 // Stage stage
 type Stage struct {
 
@@ -14,13 +12,13 @@ type Stage struct {
 
 // ToJSON converts object to JSON string
 func (s *Stage) ToJSON() ([]byte, error) {
-	return json.Marshal(s)
+	return jsonCodec.Marshal(s)
 }
 
 // FromJSON converts JSON string to object
 func (s *Stage) FromJSON(b []byte) error {
 	var res Stage
-	if err := json.Unmarshal(b, &res); err != nil {
+	if err := jsonCodec.Unmarshal(b, &res); err != nil {
 		return err
 	}
 	*s = res
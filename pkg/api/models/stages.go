@@ -1,7 +1,5 @@
 package models
 
-import "encoding/json"
-
 // Stages stages
 type Stages struct {
 
@@ -20,13 +18,13 @@ type Stages struct {
 
 // ToJSON converts object to JSON string
 func (s *Stages) ToJSON() ([]byte, error) {
-	return json.Marshal(s)
+	return jsonCodec.Marshal(s)
 }
 
 // FromJSON converts JSON string to object
 func (s *Stages) FromJSON(b []byte) error {
 	var res Stages
-	if err := json.Unmarshal(b, &res); err != nil {
+	if err := jsonCodec.Unmarshal(b, &res); err != nil {
 		return err
 	}
 	*s = res
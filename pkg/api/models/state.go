@@ -1,5 +1,9 @@
 package models
 
+import "fmt"
+
+// The states a sequence can be in. They are untyped so they can still be assigned to the string
+// fields of SequenceState and SequenceStateStage; use SequenceStatus to validate or inspect a state
 const (
 	SequenceTriggeredState          = "triggered"
 	SequenceStartedState            = "started"
@@ -11,6 +15,63 @@ const (
 	SequenceAborted                 = "aborted"
 )
 
+// SequenceStatus is the state of a sequence, as contained in SequenceState.State and SequenceStateStage.State
+type SequenceStatus string
+
+// Validate returns an error if the SequenceStatus is not one of the known sequence states
+func (s SequenceStatus) Validate() error {
+	switch s {
+	case SequenceTriggeredState, SequenceStartedState, SequenceWaitingState, SequenceWaitingForApprovalState,
+		SequenceFinished, TimedOut, SequencePaused, SequenceAborted:
+		return nil
+	}
+	return fmt.Errorf("invalid sequence state %q", string(s))
+}
+
+// IsStarted returns whether the sequence has been started
+func (s SequenceStatus) IsStarted() bool {
+	return s == SequenceStartedState
+}
+
+// IsPaused returns whether the sequence is paused
+func (s SequenceStatus) IsPaused() bool {
+	return s == SequencePaused
+}
+
+// IsFinished returns whether the sequence has finished
+func (s SequenceStatus) IsFinished() bool {
+	return s == SequenceFinished
+}
+
+// IsAborted returns whether the sequence has been aborted
+func (s SequenceStatus) IsAborted() bool {
+	return s == SequenceAborted
+}
+
+// IsDone returns whether the sequence has come to an end, i.e. it has finished, has been aborted or has timed out
+func (s SequenceStatus) IsDone() bool {
+	return s == SequenceFinished || s == SequenceAborted || s == TimedOut
+}
+
+// MarshalText implements encoding.TextMarshaler and rejects unknown sequence states.
+// An empty state is accepted, e.g. for filters that do not restrict the state
+func (s SequenceStatus) MarshalText() ([]byte, error) {
+	if s != "" {
+		if err := s.Validate(); err != nil {
+			return nil, err
+		}
+	}
+	return []byte(s), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Known states are matched regardless of case and surrounding
+// whitespace, while unknown states are decoded as they are, so that they can be rejected via Validate
+func (s *SequenceStatus) UnmarshalText(text []byte) error {
+	*s = SequenceStatus(canonicalState(string(text), SequenceTriggeredState, SequenceStartedState, SequenceWaitingState,
+		SequenceWaitingForApprovalState, SequenceFinished, TimedOut, SequencePaused, SequenceAborted))
+	return nil
+}
+
 type GetSequenceStateParams struct {
 	/*Pointer to the next set of items
 	  In: query
@@ -93,6 +154,11 @@ type SequenceState struct {
 	ProblemTitle   string               `json:"problemTitle,omitempty" bson:"problemTitle"`
 }
 
+// Status returns the state of the sequence as SequenceStatus
+func (s SequenceState) Status() SequenceStatus {
+	return SequenceStatus(s.State)
+}
+
 // SequenceStates collects all states of a sequence
 type SequenceStates struct {
 	States []SequenceState `json:"states"`
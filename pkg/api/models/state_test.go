@@ -0,0 +1,68 @@
+package models
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSequenceStatus(t *testing.T) {
+	state := SequenceState{}
+	require.Nil(t, json.Unmarshal([]byte(`{"state":"aborted"}`), &state))
+
+	status := state.Status()
+	require.Nil(t, status.Validate())
+	require.True(t, status.IsAborted())
+	require.True(t, status.IsDone())
+	require.False(t, status.IsFinished())
+
+	require.True(t, SequenceStatus(SequencePaused).IsPaused())
+	require.False(t, SequenceStatus(SequencePaused).IsDone())
+	require.True(t, SequenceStatus(TimedOut).IsDone())
+	require.NotNil(t, SequenceStatus("done").Validate())
+}
+
+func TestSequenceStatus_UnmarshalJSON(t *testing.T) {
+	type stateFilter struct {
+		State SequenceStatus `json:"state"`
+	}
+	filter := stateFilter{}
+	require.Nil(t, json.Unmarshal([]byte(`{"state":"started"}`), &filter))
+	require.True(t, filter.State.IsStarted())
+
+	// known states are matched regardless of case, unknown states are decoded and rejected by Validate
+	require.Nil(t, json.Unmarshal([]byte(`{"state":" WaitingForApproval"}`), &filter))
+	require.Equal(t, SequenceStatus(SequenceWaitingForApprovalState), filter.State)
+	require.Nil(t, json.Unmarshal([]byte(`{"state":"done"}`), &filter))
+	require.NotNil(t, filter.State.Validate())
+
+	b, err := json.Marshal(stateFilter{State: SequencePaused})
+	require.Nil(t, err)
+	require.JSONEq(t, `{"state":"paused"}`, string(b))
+	_, err = json.Marshal(stateFilter{State: "done"})
+	require.NotNil(t, err)
+}
+
+func TestSequenceControlState(t *testing.T) {
+	require.Nil(t, PauseSequence.Validate())
+	require.Nil(t, ResumeSequence.Validate())
+	require.Nil(t, AbortSequence.Validate())
+	require.NotNil(t, SequenceControlState("paused").Validate())
+
+	command := SequenceControlCommand{}
+	require.Nil(t, json.Unmarshal([]byte(`{"state":"abort","stage":"dev"}`), &command))
+	require.Equal(t, AbortSequence, command.State)
+
+	require.Nil(t, json.Unmarshal([]byte(`{"state":"Pause"}`), &command))
+	require.Equal(t, PauseSequence, command.State)
+
+	require.Nil(t, json.Unmarshal([]byte(`{"state":"stop"}`), &command))
+	require.NotNil(t, command.State.Validate())
+
+	b, err := json.Marshal(SequenceControlCommand{State: ResumeSequence})
+	require.Nil(t, err)
+	require.JSONEq(t, `{"state":"resume","stage":""}`, string(b))
+	_, err = json.Marshal(SequenceControlCommand{State: "stop"})
+	require.NotNil(t, err)
+}
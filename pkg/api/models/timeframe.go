@@ -1,7 +1,5 @@
 package models
 
-import "encoding/json"
-
 // Timeframe timeframe
 type Timeframe struct {
 
@@ -17,13 +15,13 @@ type Timeframe struct {
 
 // ToJSON converts object to JSON string
 func (t *Timeframe) ToJSON() ([]byte, error) {
-	return json.Marshal(t)
+	return jsonCodec.Marshal(t)
 }
 
 // FromJSON converts JSON string to object
 func (t *Timeframe) FromJSON(b []byte) error {
 	var res Timeframe
-	if err := json.Unmarshal(b, &res); err != nil {
+	if err := jsonCodec.Unmarshal(b, &res); err != nil {
 		return err
 	}
 	*t = res
@@ -3,7 +3,6 @@ package models
 import (
 	"crypto/sha1"
 	"encoding/hex"
-	"encoding/json"
 	"fmt"
 	"time"
 )
@@ -27,6 +26,8 @@ type MetaData struct {
 	Location           string             `json:"location" bson:"location"`
 	KubernetesMetaData KubernetesMetaData `json:"kubernetesmetadata" bson:"kubernetesmetadata"`
 	LastSeen           time.Time          `json:"lastseen" bson:"lastseen"`
+	// Capabilities describe the features enabled for the client of the integration, see Capabilities.RegistrationMetaData of the v2 APISet
+	Capabilities map[string]string `json:"capabilities,omitempty" bson:"capabilities,omitempty"`
 }
 
 // EventSubscription describes to what events the Keptn service is subscribed to
@@ -76,13 +77,13 @@ func (i IntegrationID) validate() bool {
 
 // ToJSON converts object to JSON string
 func (i *Integration) ToJSON() ([]byte, error) {
-	return json.Marshal(i)
+	return jsonCodec.Marshal(i)
 }
 
 // FromJSON converts JSON string to object
 func (i *Integration) FromJSON(b []byte) error {
 	var res Integration
-	if err := json.Unmarshal(b, &res); err != nil {
+	if err := jsonCodec.Unmarshal(b, &res); err != nil {
 		return err
 	}
 	*i = res
@@ -91,5 +92,5 @@ func (i *Integration) FromJSON(b []byte) error {
 
 // ToJSON converts object to JSON string
 func (s *EventSubscription) ToJSON() ([]byte, error) {
-	return json.Marshal(s)
+	return jsonCodec.Marshal(s)
 }
@@ -1,20 +1,18 @@
 package models
 
-import "encoding/json"
-
 type RegisterIntegrationResponse struct {
 	ID string `json:"id"`
 }
 
 // ToJSON converts object to JSON string
 func (i *RegisterIntegrationResponse) ToJSON() ([]byte, error) {
-	return json.Marshal(i)
+	return jsonCodec.Marshal(i)
 }
 
 // FromJSON converts JSON string to object
 func (i *RegisterIntegrationResponse) FromJSON(b []byte) error {
 	var res RegisterIntegrationResponse
-	if err := json.Unmarshal(b, &res); err != nil {
+	if err := jsonCodec.Unmarshal(b, &res); err != nil {
 		return err
 	}
 	*i = res
@@ -27,13 +25,13 @@ type CreateSubscriptionResponse struct {
 
 // ToJSON converts object to JSON string
 func (s *CreateSubscriptionResponse) ToJSON() ([]byte, error) {
-	return json.Marshal(s)
+	return jsonCodec.Marshal(s)
 }
 
 // FromJSON converts JSON string to object
 func (s *CreateSubscriptionResponse) FromJSON(b []byte) error {
 	var res CreateSubscriptionResponse
-	if err := json.Unmarshal(b, &res); err != nil {
+	if err := jsonCodec.Unmarshal(b, &res); err != nil {
 		return err
 	}
 	*s = res
@@ -1,7 +1,5 @@
 package models
 
-import "encoding/json"
-
 // Version version
 type Version struct {
 
@@ -17,13 +15,13 @@ type Version struct {
 
 // ToJSON converts object to JSON string
 func (v *Version) ToJSON() ([]byte, error) {
-	return json.Marshal(v)
+	return jsonCodec.Marshal(v)
 }
 
 // FromJSON converts JSON string to object
 func (v *Version) FromJSON(b []byte) error {
 	var res Version
-	if err := json.Unmarshal(b, &res); err != nil {
+	if err := jsonCodec.Unmarshal(b, &res); err != nil {
 		return err
 	}
 	*v = res
@@ -0,0 +1,1067 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/keptn/go-utils/pkg/api/models"
+	v2 "github.com/keptn/go-utils/pkg/api/utils/v2"
+)
+
+// ErrNotSupportedByAdapter is returned by the adapters between v1 and v2 handlers for operations
+// which have no equivalent in the adapted handler
+var ErrNotSupportedByAdapter = errors.New("operation is not supported by the adapted handler")
+
+// The adapters in this file allow code using the v1 API utils and code using the v2 API utils to share handlers,
+// e.g. during a gradual migration from v1 to v2:
+//
+//	apiSet, _ := v2.New(endpoint)
+//	legacyCode(api.KeptnV1FromV2(apiSet))
+//
+// Adapters wrapping a v2 handler as a v1 interface call the v2 handler with context.TODO() and default options.
+// As the methods of the handlers of an APISet, they can be given a StrictContextMode, see WithStrictContext.
+// Adapters wrapping a v1 handler as a v2 interface ignore the context and the options, unless stated otherwise.
+
+// KeptnV1FromV2 wraps a v2 API set as a v1 KeptnInterface
+func KeptnV1FromV2(apiSet v2.KeptnInterface, strictContext ...StrictContextMode) KeptnInterface {
+	return keptnV1Adapter{apiSet: apiSet, strictContext: strictContextOf(strictContext)}
+}
+
+// KeptnV2FromV1 wraps a v1 API set as a v2 KeptnInterface
+func KeptnV2FromV1(apiSet KeptnInterface) v2.KeptnInterface {
+	return keptnV2Adapter{apiSet: apiSet}
+}
+
+type keptnV1Adapter struct {
+	apiSet        v2.KeptnInterface
+	strictContext StrictContextMode
+}
+
+func (a keptnV1Adapter) APIV1() APIV1Interface {
+	return APIV1FromV2(a.apiSet.API(), a.strictContext)
+}
+func (a keptnV1Adapter) AuthV1() AuthV1Interface {
+	return AuthV1FromV2(a.apiSet.Auth(), a.strictContext)
+}
+func (a keptnV1Adapter) EventsV1() EventsV1Interface {
+	return EventsV1FromV2(a.apiSet.Events(), a.strictContext)
+}
+func (a keptnV1Adapter) LogsV1() LogsV1Interface {
+	return LogsV1FromV2(a.apiSet.Logs(), a.strictContext)
+}
+func (a keptnV1Adapter) ProjectsV1() ProjectsV1Interface {
+	return ProjectsV1FromV2(a.apiSet.Projects(), a.strictContext)
+}
+func (a keptnV1Adapter) ResourcesV1() ResourcesV1Interface {
+	return ResourcesV1FromV2(a.apiSet.Resources(), a.strictContext)
+}
+func (a keptnV1Adapter) SecretsV1() SecretsV1Interface {
+	return SecretsV1FromV2(a.apiSet.Secrets(), a.strictContext)
+}
+func (a keptnV1Adapter) SequencesV1() SequencesV1Interface {
+	return SequencesV1FromV2(a.apiSet.Sequences(), a.strictContext)
+}
+func (a keptnV1Adapter) ServicesV1() ServicesV1Interface {
+	return ServicesV1FromV2(a.apiSet.Services(), a.strictContext)
+}
+func (a keptnV1Adapter) StagesV1() StagesV1Interface {
+	return StagesV1FromV2(a.apiSet.Stages(), a.strictContext)
+}
+func (a keptnV1Adapter) UniformV1() UniformV1Interface {
+	return UniformV1FromV2(a.apiSet.Uniform(), a.strictContext)
+}
+func (a keptnV1Adapter) ShipyardControlV1() ShipyardControlV1Interface {
+	return ShipyardControlV1FromV2(a.apiSet.ShipyardControl(), a.strictContext)
+}
+
+type keptnV2Adapter struct {
+	apiSet KeptnInterface
+}
+
+func (a keptnV2Adapter) API() v2.APIInterface       { return APIV2FromV1(a.apiSet.APIV1()) }
+func (a keptnV2Adapter) Auth() v2.AuthInterface     { return AuthV2FromV1(a.apiSet.AuthV1()) }
+func (a keptnV2Adapter) Events() v2.EventsInterface { return EventsV2FromV1(a.apiSet.EventsV1()) }
+func (a keptnV2Adapter) Logs() v2.LogsInterface     { return LogsV2FromV1(a.apiSet.LogsV1()) }
+func (a keptnV2Adapter) Projects() v2.ProjectsInterface {
+	return ProjectsV2FromV1(a.apiSet.ProjectsV1())
+}
+func (a keptnV2Adapter) Resources() v2.ResourcesInterface {
+	return ResourcesV2FromV1(a.apiSet.ResourcesV1())
+}
+func (a keptnV2Adapter) Secrets() v2.SecretsInterface { return SecretsV2FromV1(a.apiSet.SecretsV1()) }
+func (a keptnV2Adapter) Sequences() v2.SequencesInterface {
+	return SequencesV2FromV1(a.apiSet.SequencesV1())
+}
+func (a keptnV2Adapter) Services() v2.ServicesInterface {
+	return ServicesV2FromV1(a.apiSet.ServicesV1())
+}
+func (a keptnV2Adapter) Stages() v2.StagesInterface   { return StagesV2FromV1(a.apiSet.StagesV1()) }
+func (a keptnV2Adapter) Uniform() v2.UniformInterface { return UniformV2FromV1(a.apiSet.UniformV1()) }
+func (a keptnV2Adapter) ShipyardControl() v2.ShipyardControlInterface {
+	return ShipyardControlV2FromV1(a.apiSet.ShipyardControlV1())
+}
+
+// APIV1FromV2 wraps a v2 APIInterface as a v1 APIV1Interface
+func APIV1FromV2(handler v2.APIInterface, strictContext ...StrictContextMode) APIV1Interface {
+	return apiV1Adapter{handler: handler, strictContext: strictContextOf(strictContext)}
+}
+
+// APIV2FromV1 wraps a v1 APIV1Interface as a v2 APIInterface
+func APIV2FromV1(handler APIV1Interface) v2.APIInterface {
+	return apiV2Adapter{handler: handler}
+}
+
+type apiV1Adapter struct {
+	handler       v2.APIInterface
+	strictContext StrictContextMode
+}
+
+func (a apiV1Adapter) SendEvent(event models.KeptnContextExtendedCE) (*models.EventContext, *models.Error) {
+	ctx, err := a.strictContext.implicitContext("APIV1Interface.SendEvent")
+	if err != nil {
+		return nil, implicitContextResponse(err)
+	}
+	return a.handler.SendEvent(ctx, event, v2.APISendEventOptions{})
+}
+
+func (a apiV1Adapter) TriggerEvaluation(project string, stage string, service string, evaluation models.Evaluation) (*models.EventContext, *models.Error) {
+	ctx, err := a.strictContext.implicitContext("APIV1Interface.TriggerEvaluation")
+	if err != nil {
+		return nil, implicitContextResponse(err)
+	}
+	return a.handler.TriggerEvaluation(ctx, project, stage, service, evaluation, v2.APITriggerEvaluationOptions{})
+}
+
+func (a apiV1Adapter) CreateProject(project models.CreateProject) (string, *models.Error) {
+	ctx, err := a.strictContext.implicitContext("APIV1Interface.CreateProject")
+	if err != nil {
+		return "", implicitContextResponse(err)
+	}
+	return a.handler.CreateProject(ctx, project, v2.APICreateProjectOptions{})
+}
+
+func (a apiV1Adapter) UpdateProject(project models.CreateProject) (string, *models.Error) {
+	ctx, err := a.strictContext.implicitContext("APIV1Interface.UpdateProject")
+	if err != nil {
+		return "", implicitContextResponse(err)
+	}
+	return a.handler.UpdateProject(ctx, project, v2.APIUpdateProjectOptions{})
+}
+
+func (a apiV1Adapter) DeleteProject(project models.Project) (*models.DeleteProjectResponse, *models.Error) {
+	ctx, err := a.strictContext.implicitContext("APIV1Interface.DeleteProject")
+	if err != nil {
+		return nil, implicitContextResponse(err)
+	}
+	return a.handler.DeleteProject(ctx, project, v2.APIDeleteProjectOptions{})
+}
+
+func (a apiV1Adapter) CreateService(project string, service models.CreateService) (string, *models.Error) {
+	ctx, err := a.strictContext.implicitContext("APIV1Interface.CreateService")
+	if err != nil {
+		return "", implicitContextResponse(err)
+	}
+	return a.handler.CreateService(ctx, project, service, v2.APICreateServiceOptions{})
+}
+
+func (a apiV1Adapter) DeleteService(project string, service string) (*models.DeleteServiceResponse, *models.Error) {
+	ctx, err := a.strictContext.implicitContext("APIV1Interface.DeleteService")
+	if err != nil {
+		return nil, implicitContextResponse(err)
+	}
+	return a.handler.DeleteService(ctx, project, service, v2.APIDeleteServiceOptions{})
+}
+
+func (a apiV1Adapter) GetMetadata() (*models.Metadata, *models.Error) {
+	ctx, err := a.strictContext.implicitContext("APIV1Interface.GetMetadata")
+	if err != nil {
+		return nil, implicitContextResponse(err)
+	}
+	return a.handler.GetMetadata(ctx, v2.APIGetMetadataOptions{})
+}
+
+type apiV2Adapter struct {
+	handler APIV1Interface
+}
+
+func (a apiV2Adapter) SendEvent(_ context.Context, event models.KeptnContextExtendedCE, _ v2.APISendEventOptions) (*models.EventContext, *models.Error) {
+	return a.handler.SendEvent(event)
+}
+
+func (a apiV2Adapter) TriggerEvaluation(_ context.Context, project string, stage string, service string, evaluation models.Evaluation, _ v2.APITriggerEvaluationOptions) (*models.EventContext, *models.Error) {
+	return a.handler.TriggerEvaluation(project, stage, service, evaluation)
+}
+
+func (a apiV2Adapter) CreateProject(_ context.Context, project models.CreateProject, _ v2.APICreateProjectOptions) (string, *models.Error) {
+	return a.handler.CreateProject(project)
+}
+
+func (a apiV2Adapter) UpdateProject(_ context.Context, project models.CreateProject, _ v2.APIUpdateProjectOptions) (string, *models.Error) {
+	return a.handler.UpdateProject(project)
+}
+
+func (a apiV2Adapter) DeleteProject(_ context.Context, project models.Project, _ v2.APIDeleteProjectOptions) (*models.DeleteProjectResponse, *models.Error) {
+	return a.handler.DeleteProject(project)
+}
+
+func (a apiV2Adapter) CreateService(_ context.Context, project string, service models.CreateService, _ v2.APICreateServiceOptions) (string, *models.Error) {
+	return a.handler.CreateService(project, service)
+}
+
+func (a apiV2Adapter) DeleteService(_ context.Context, project string, service string, _ v2.APIDeleteServiceOptions) (*models.DeleteServiceResponse, *models.Error) {
+	return a.handler.DeleteService(project, service)
+}
+
+func (a apiV2Adapter) GetMetadata(_ context.Context, _ v2.APIGetMetadataOptions) (*models.Metadata, *models.Error) {
+	return a.handler.GetMetadata()
+}
+
+// AuthV1FromV2 wraps a v2 AuthInterface as a v1 AuthV1Interface
+func AuthV1FromV2(handler v2.AuthInterface, strictContext ...StrictContextMode) AuthV1Interface {
+	return authV1Adapter{handler: handler, strictContext: strictContextOf(strictContext)}
+}
+
+// AuthV2FromV1 wraps a v1 AuthV1Interface as a v2 AuthInterface
+func AuthV2FromV1(handler AuthV1Interface) v2.AuthInterface {
+	return authV2Adapter{handler: handler}
+}
+
+type authV1Adapter struct {
+	handler       v2.AuthInterface
+	strictContext StrictContextMode
+}
+
+func (a authV1Adapter) Authenticate() (*models.EventContext, *models.Error) {
+	ctx, err := a.strictContext.implicitContext("AuthV1Interface.Authenticate")
+	if err != nil {
+		return nil, implicitContextResponse(err)
+	}
+	return a.handler.Authenticate(ctx, v2.AuthAuthenticateOptions{})
+}
+
+type authV2Adapter struct {
+	handler AuthV1Interface
+}
+
+func (a authV2Adapter) Authenticate(_ context.Context, _ v2.AuthAuthenticateOptions) (*models.EventContext, *models.Error) {
+	return a.handler.Authenticate()
+}
+
+// EventsV1FromV2 wraps a v2 EventsInterface as a v1 EventsV1Interface
+func EventsV1FromV2(handler v2.EventsInterface, strictContext ...StrictContextMode) EventsV1Interface {
+	return eventsV1Adapter{handler: handler, strictContext: strictContextOf(strictContext)}
+}
+
+// EventsV2FromV1 wraps a v1 EventsV1Interface as a v2 EventsInterface.
+// Delta polling options are applied by the adapter
+func EventsV2FromV1(handler EventsV1Interface) v2.EventsInterface {
+	return eventsV2Adapter{handler: handler}
+}
+
+type eventsV1Adapter struct {
+	handler       v2.EventsInterface
+	strictContext StrictContextMode
+}
+
+func (a eventsV1Adapter) GetEvents(filter *EventFilter) ([]*models.KeptnContextExtendedCE, *models.Error) {
+	ctx, err := a.strictContext.implicitContext("EventsV1Interface.GetEvents")
+	if err != nil {
+		return nil, implicitContextResponse(err)
+	}
+	return a.handler.GetEvents(ctx, toV2EventFilter(filter), v2.EventsGetEventsOptions{})
+}
+
+func (a eventsV1Adapter) GetEventsWithRetry(filter *EventFilter, maxRetries int, retrySleepTime time.Duration) ([]*models.KeptnContextExtendedCE, error) {
+	ctx, err := a.strictContext.implicitContext("EventsV1Interface.GetEventsWithRetry")
+	if err != nil {
+		return nil, err
+	}
+	return a.handler.GetEventsWithRetry(ctx, toV2EventFilter(filter), maxRetries, retrySleepTime, v2.EventsGetEventsWithRetryOptions{})
+}
+
+type eventsV2Adapter struct {
+	handler EventsV1Interface
+}
+
+func (a eventsV2Adapter) GetEvents(_ context.Context, filter *v2.EventFilter, opts v2.EventsGetEventsOptions) ([]*models.KeptnContextExtendedCE, *models.Error) {
+	v1Filter := toV1EventFilter(filter)
+	if opts.Delta != nil {
+		if fromTime := opts.Delta.FromTime(); fromTime != "" {
+			v1Filter.FromTime = fromTime
+		}
+	}
+	events, errObj := a.handler.GetEvents(v1Filter)
+	if errObj != nil || opts.Delta == nil {
+		return events, errObj
+	}
+	return opts.Delta.Filter(events), nil
+}
+
+func (a eventsV2Adapter) GetEventsWithRetry(_ context.Context, filter *v2.EventFilter, maxRetries int, retrySleepTime time.Duration, _ v2.EventsGetEventsWithRetryOptions) ([]*models.KeptnContextExtendedCE, error) {
+	return a.handler.GetEventsWithRetry(toV1EventFilter(filter), maxRetries, retrySleepTime)
+}
+
+func toV1EventFilter(filter *v2.EventFilter) *EventFilter {
+	return &EventFilter{
+		Project:       filter.Project,
+		Stage:         filter.Stage,
+		Service:       filter.Service,
+		EventType:     filter.EventType,
+		KeptnContext:  filter.KeptnContext,
+		EventID:       filter.EventID,
+		PageSize:      filter.PageSize,
+		NumberOfPages: filter.NumberOfPages,
+		FromTime:      filter.FromTime,
+	}
+}
+
+// LogsV1FromV2 wraps a v2 LogsInterface as a v1 LogsV1Interface
+func LogsV1FromV2(handler v2.LogsInterface, strictContext ...StrictContextMode) LogsV1Interface {
+	return logsV1Adapter{handler: handler, strictContext: strictContextOf(strictContext)}
+}
+
+// LogsV2FromV1 wraps a v1 LogsV1Interface as a v2 LogsInterface
+func LogsV2FromV1(handler LogsV1Interface) v2.LogsInterface {
+	return logsV2Adapter{handler: handler}
+}
+
+type logsV1Adapter struct {
+	handler       v2.LogsInterface
+	strictContext StrictContextMode
+}
+
+func (a logsV1Adapter) Log(logs []models.LogEntry) {
+	a.handler.Log(logs, v2.LogsLogOptions{})
+}
+
+func (a logsV1Adapter) Flush() error {
+	ctx, err := a.strictContext.implicitContext("LogsV1Interface.Flush")
+	if err != nil {
+		return err
+	}
+	return a.handler.Flush(ctx, v2.LogsFlushOptions{})
+}
+
+func (a logsV1Adapter) GetLogs(params models.GetLogsParams) (*models.GetLogsResponse, error) {
+	ctx, err := a.strictContext.implicitContext("LogsV1Interface.GetLogs")
+	if err != nil {
+		return nil, err
+	}
+	return a.handler.GetLogs(ctx, params, v2.LogsGetLogsOptions{})
+}
+
+func (a logsV1Adapter) DeleteLogs(filter models.LogFilter) error {
+	ctx, err := a.strictContext.implicitContext("LogsV1Interface.DeleteLogs")
+	if err != nil {
+		return err
+	}
+	return a.handler.DeleteLogs(ctx, filter, v2.LogsDeleteLogsOptions{})
+}
+
+func (a logsV1Adapter) Start(ctx context.Context) {
+	a.handler.Start(ctx, v2.LogsStartOptions{})
+}
+
+type logsV2Adapter struct {
+	handler LogsV1Interface
+}
+
+func (a logsV2Adapter) Log(logs []models.LogEntry, _ v2.LogsLogOptions) {
+	a.handler.Log(logs)
+}
+
+func (a logsV2Adapter) Flush(_ context.Context, _ v2.LogsFlushOptions) error {
+	return a.handler.Flush()
+}
+
+func (a logsV2Adapter) GetLogs(_ context.Context, params models.GetLogsParams, _ v2.LogsGetLogsOptions) (*models.GetLogsResponse, error) {
+	return a.handler.GetLogs(params)
+}
+
+func (a logsV2Adapter) DeleteLogs(_ context.Context, filter models.LogFilter, _ v2.LogsDeleteLogsOptions) error {
+	return a.handler.DeleteLogs(filter)
+}
+
+func (a logsV2Adapter) Start(ctx context.Context, _ v2.LogsStartOptions) {
+	a.handler.Start(ctx)
+}
+
+// ProjectsV1FromV2 wraps a v2 ProjectsInterface as a v1 ProjectsV1Interface
+func ProjectsV1FromV2(handler v2.ProjectsInterface, strictContext ...StrictContextMode) ProjectsV1Interface {
+	return projectsV1Adapter{handler: handler, strictContext: strictContextOf(strictContext)}
+}
+
+// ProjectsV2FromV1 wraps a v1 ProjectsV1Interface as a v2 ProjectsInterface
+func ProjectsV2FromV1(handler ProjectsV1Interface) v2.ProjectsInterface {
+	return projectsV2Adapter{handler: handler}
+}
+
+type projectsV1Adapter struct {
+	handler       v2.ProjectsInterface
+	strictContext StrictContextMode
+}
+
+func (a projectsV1Adapter) CreateProject(project models.Project) (*models.EventContext, *models.Error) {
+	ctx, err := a.strictContext.implicitContext("ProjectsV1Interface.CreateProject")
+	if err != nil {
+		return nil, implicitContextResponse(err)
+	}
+	return a.handler.CreateProject(ctx, project, v2.ProjectsCreateProjectOptions{})
+}
+
+func (a projectsV1Adapter) DeleteProject(project models.Project) (*models.EventContext, *models.Error) {
+	ctx, err := a.strictContext.implicitContext("ProjectsV1Interface.DeleteProject")
+	if err != nil {
+		return nil, implicitContextResponse(err)
+	}
+	return a.handler.DeleteProject(ctx, project, v2.ProjectsDeleteProjectOptions{})
+}
+
+func (a projectsV1Adapter) GetProject(project models.Project) (*models.Project, *models.Error) {
+	ctx, err := a.strictContext.implicitContext("ProjectsV1Interface.GetProject")
+	if err != nil {
+		return nil, implicitContextResponse(err)
+	}
+	return a.handler.GetProject(ctx, project, v2.ProjectsGetProjectOptions{})
+}
+
+func (a projectsV1Adapter) GetAllProjects() ([]*models.Project, error) {
+	ctx, err := a.strictContext.implicitContext("ProjectsV1Interface.GetAllProjects")
+	if err != nil {
+		return nil, err
+	}
+	return a.handler.GetAllProjects(ctx, v2.ProjectsGetAllProjectsOptions{})
+}
+
+func (a projectsV1Adapter) UpdateConfigurationServiceProject(project models.Project) (*models.EventContext, *models.Error) {
+	ctx, err := a.strictContext.implicitContext("ProjectsV1Interface.UpdateConfigurationServiceProject")
+	if err != nil {
+		return nil, implicitContextResponse(err)
+	}
+	return a.handler.UpdateConfigurationServiceProject(ctx, project, v2.ProjectsUpdateConfigurationServiceProjectOptions{})
+}
+
+type projectsV2Adapter struct {
+	handler ProjectsV1Interface
+}
+
+func (a projectsV2Adapter) CreateProject(_ context.Context, project models.Project, _ v2.ProjectsCreateProjectOptions) (*models.EventContext, *models.Error) {
+	return a.handler.CreateProject(project)
+}
+
+func (a projectsV2Adapter) DeleteProject(_ context.Context, project models.Project, _ v2.ProjectsDeleteProjectOptions) (*models.EventContext, *models.Error) {
+	return a.handler.DeleteProject(project)
+}
+
+func (a projectsV2Adapter) GetProject(_ context.Context, project models.Project, _ v2.ProjectsGetProjectOptions) (*models.Project, *models.Error) {
+	return a.handler.GetProject(project)
+}
+
+func (a projectsV2Adapter) GetAllProjects(_ context.Context, _ v2.ProjectsGetAllProjectsOptions) ([]*models.Project, error) {
+	return a.handler.GetAllProjects()
+}
+
+func (a projectsV2Adapter) UpdateConfigurationServiceProject(_ context.Context, project models.Project, _ v2.ProjectsUpdateConfigurationServiceProjectOptions) (*models.EventContext, *models.Error) {
+	return a.handler.UpdateConfigurationServiceProject(project)
+}
+
+// ResourcesV1FromV2 wraps a v2 ResourcesInterface as a v1 ResourcesV1Interface.
+// UpdateStageResources has no equivalent in the v2 interface and returns ErrNotSupportedByAdapter
+func ResourcesV1FromV2(handler v2.ResourcesInterface, strictContext ...StrictContextMode) ResourcesV1Interface {
+	return resourcesV1Adapter{handler: handler, strictContext: strictContextOf(strictContext)}
+}
+
+// ResourcesV2FromV1 wraps a v1 ResourcesV1Interface as a v2 ResourcesInterface.
+// The methods taking a ResourceScope are mapped to the methods for the project, stage or service of the scope.
+// URI options are only supported if the v1 handler is a *ResourceHandler, otherwise ErrNotSupportedByAdapter is returned
+func ResourcesV2FromV1(handler ResourcesV1Interface) v2.ResourcesInterface {
+	return resourcesV2Adapter{handler: handler}
+}
+
+type resourcesV1Adapter struct {
+	handler       v2.ResourcesInterface
+	strictContext StrictContextMode
+}
+
+func (a resourcesV1Adapter) CreateResources(project string, stage string, service string, resources []*models.Resource) (*models.EventContext, *models.Error) {
+	ctx, err := a.strictContext.implicitContext("ResourcesV1Interface.CreateResources")
+	if err != nil {
+		return nil, implicitContextResponse(err)
+	}
+	return a.handler.CreateResources(ctx, project, stage, service, resources, v2.ResourcesCreateResourcesOptions{})
+}
+
+func (a resourcesV1Adapter) CreateProjectResources(project string, resources []*models.Resource) (string, error) {
+	ctx, err := a.strictContext.implicitContext("ResourcesV1Interface.CreateProjectResources")
+	if err != nil {
+		return "", err
+	}
+	return a.handler.CreateProjectResources(ctx, project, resources, v2.ResourcesCreateProjectResourcesOptions{})
+}
+
+func (a resourcesV1Adapter) GetProjectResource(project string, resourceURI string) (*models.Resource, error) {
+	ctx, err := a.strictContext.implicitContext("ResourcesV1Interface.GetProjectResource")
+	if err != nil {
+		return nil, err
+	}
+	return a.handler.GetResource(ctx, *v2.NewResourceScope().Project(project).Resource(resourceURI), v2.ResourcesGetResourceOptions{})
+}
+
+func (a resourcesV1Adapter) UpdateProjectResource(project string, resource *models.Resource) (string, error) {
+	ctx, err := a.strictContext.implicitContext("ResourcesV1Interface.UpdateProjectResource")
+	if err != nil {
+		return "", err
+	}
+	return a.handler.UpdateResource(ctx, resource, *v2.NewResourceScope().Project(project).Resource(*resource.ResourceURI), v2.ResourcesUpdateResourceOptions{})
+}
+
+func (a resourcesV1Adapter) DeleteProjectResource(project string, resourceURI string) error {
+	ctx, err := a.strictContext.implicitContext("ResourcesV1Interface.DeleteProjectResource")
+	if err != nil {
+		return err
+	}
+	return a.handler.DeleteResource(ctx, *v2.NewResourceScope().Project(project).Resource(resourceURI), v2.ResourcesDeleteResourceOptions{})
+}
+
+func (a resourcesV1Adapter) UpdateProjectResources(project string, resources []*models.Resource) (string, error) {
+	ctx, err := a.strictContext.implicitContext("ResourcesV1Interface.UpdateProjectResources")
+	if err != nil {
+		return "", err
+	}
+	return a.handler.UpdateProjectResources(ctx, project, resources, v2.ResourcesUpdateProjectResourcesOptions{})
+}
+
+func (a resourcesV1Adapter) CreateStageResources(project string, stage string, resources []*models.Resource) (string, error) {
+	ctx, err := a.strictContext.implicitContext("ResourcesV1Interface.CreateStageResources")
+	if err != nil {
+		return "", err
+	}
+	return a.handler.CreateResource(ctx, resources, *v2.NewResourceScope().Project(project).Stage(stage), v2.ResourcesCreateResourceOptions{})
+}
+
+func (a resourcesV1Adapter) GetStageResource(project string, stage string, resourceURI string) (*models.Resource, error) {
+	ctx, err := a.strictContext.implicitContext("ResourcesV1Interface.GetStageResource")
+	if err != nil {
+		return nil, err
+	}
+	return a.handler.GetResource(ctx, *v2.NewResourceScope().Project(project).Stage(stage).Resource(resourceURI), v2.ResourcesGetResourceOptions{})
+}
+
+func (a resourcesV1Adapter) UpdateStageResource(project string, stage string, resource *models.Resource) (string, error) {
+	ctx, err := a.strictContext.implicitContext("ResourcesV1Interface.UpdateStageResource")
+	if err != nil {
+		return "", err
+	}
+	return a.handler.UpdateResource(ctx, resource, *v2.NewResourceScope().Project(project).Stage(stage).Resource(*resource.ResourceURI), v2.ResourcesUpdateResourceOptions{})
+}
+
+func (a resourcesV1Adapter) UpdateStageResources(project string, stage string, resources []*models.Resource) (string, error) {
+	return "", ErrNotSupportedByAdapter
+}
+
+func (a resourcesV1Adapter) DeleteStageResource(project string, stage string, resourceURI string) error {
+	ctx, err := a.strictContext.implicitContext("ResourcesV1Interface.DeleteStageResource")
+	if err != nil {
+		return err
+	}
+	return a.handler.DeleteResource(ctx, *v2.NewResourceScope().Project(project).Stage(stage).Resource(resourceURI), v2.ResourcesDeleteResourceOptions{})
+}
+
+func (a resourcesV1Adapter) CreateServiceResources(project string, stage string, service string, resources []*models.Resource) (string, error) {
+	ctx, err := a.strictContext.implicitContext("ResourcesV1Interface.CreateServiceResources")
+	if err != nil {
+		return "", err
+	}
+	return a.handler.CreateResource(ctx, resources, *v2.NewResourceScope().Project(project).Stage(stage).Service(service), v2.ResourcesCreateResourceOptions{})
+}
+
+func (a resourcesV1Adapter) GetServiceResource(project string, stage string, service string, resourceURI string) (*models.Resource, error) {
+	ctx, err := a.strictContext.implicitContext("ResourcesV1Interface.GetServiceResource")
+	if err != nil {
+		return nil, err
+	}
+	return a.handler.GetResource(ctx, *v2.NewResourceScope().Project(project).Stage(stage).Service(service).Resource(resourceURI), v2.ResourcesGetResourceOptions{})
+}
+
+func (a resourcesV1Adapter) UpdateServiceResource(project string, stage string, service string, resource *models.Resource) (string, error) {
+	ctx, err := a.strictContext.implicitContext("ResourcesV1Interface.UpdateServiceResource")
+	if err != nil {
+		return "", err
+	}
+	return a.handler.UpdateResource(ctx, resource, *v2.NewResourceScope().Project(project).Stage(stage).Service(service).Resource(*resource.ResourceURI), v2.ResourcesUpdateResourceOptions{})
+}
+
+func (a resourcesV1Adapter) UpdateServiceResources(project string, stage string, service string, resources []*models.Resource) (string, error) {
+	ctx, err := a.strictContext.implicitContext("ResourcesV1Interface.UpdateServiceResources")
+	if err != nil {
+		return "", err
+	}
+	return a.handler.UpdateServiceResources(ctx, project, stage, service, resources, v2.ResourcesUpdateServiceResourcesOptions{})
+}
+
+func (a resourcesV1Adapter) DeleteServiceResource(project string, stage string, service string, resourceURI string) error {
+	ctx, err := a.strictContext.implicitContext("ResourcesV1Interface.DeleteServiceResource")
+	if err != nil {
+		return err
+	}
+	return a.handler.DeleteResource(ctx, *v2.NewResourceScope().Project(project).Stage(stage).Service(service).Resource(resourceURI), v2.ResourcesDeleteResourceOptions{})
+}
+
+func (a resourcesV1Adapter) GetAllStageResources(project string, stage string) ([]*models.Resource, error) {
+	ctx, err := a.strictContext.implicitContext("ResourcesV1Interface.GetAllStageResources")
+	if err != nil {
+		return nil, err
+	}
+	return a.handler.GetAllStageResources(ctx, project, stage, v2.ResourcesGetAllStageResourcesOptions{})
+}
+
+func (a resourcesV1Adapter) GetAllServiceResources(project string, stage string, service string) ([]*models.Resource, error) {
+	ctx, err := a.strictContext.implicitContext("ResourcesV1Interface.GetAllServiceResources")
+	if err != nil {
+		return nil, err
+	}
+	return a.handler.GetAllServiceResources(ctx, project, stage, service, v2.ResourcesGetAllServiceResourcesOptions{})
+}
+
+type resourcesV2Adapter struct {
+	handler ResourcesV1Interface
+}
+
+func (a resourcesV2Adapter) CreateResources(_ context.Context, project string, stage string, service string, resources []*models.Resource, _ v2.ResourcesCreateResourcesOptions) (*models.EventContext, *models.Error) {
+	return a.handler.CreateResources(project, stage, service, resources)
+}
+
+func (a resourcesV2Adapter) CreateProjectResources(_ context.Context, project string, resources []*models.Resource, _ v2.ResourcesCreateProjectResourcesOptions) (string, error) {
+	return a.handler.CreateProjectResources(project, resources)
+}
+
+func (a resourcesV2Adapter) UpdateProjectResources(_ context.Context, project string, resources []*models.Resource, _ v2.ResourcesUpdateProjectResourcesOptions) (string, error) {
+	return a.handler.UpdateProjectResources(project, resources)
+}
+
+func (a resourcesV2Adapter) UpdateServiceResources(_ context.Context, project string, stage string, service string, resources []*models.Resource, _ v2.ResourcesUpdateServiceResourcesOptions) (string, error) {
+	return a.handler.UpdateServiceResources(project, stage, service, resources)
+}
+
+func (a resourcesV2Adapter) GetAllStageResources(_ context.Context, project string, stage string, _ v2.ResourcesGetAllStageResourcesOptions) ([]*models.Resource, error) {
+	return a.handler.GetAllStageResources(project, stage)
+}
+
+func (a resourcesV2Adapter) GetAllServiceResources(_ context.Context, project string, stage string, service string, _ v2.ResourcesGetAllServiceResourcesOptions) ([]*models.Resource, error) {
+	return a.handler.GetAllServiceResources(project, stage, service)
+}
+
+func (a resourcesV2Adapter) GetResource(_ context.Context, scope v2.ResourceScope, opts v2.ResourcesGetResourceOptions) (*models.Resource, error) {
+	if handler, ok := a.handler.(*ResourceHandler); ok {
+		return handler.GetResource(toV1ResourceScope(scope), toV1URIOptions(opts.URIOptions)...)
+	}
+	if len(opts.URIOptions) > 0 {
+		return nil, ErrNotSupportedByAdapter
+	}
+	switch {
+	case scope.GetService() != "":
+		return a.handler.GetServiceResource(scope.GetProject(), scope.GetStage(), scope.GetService(), scope.GetResource())
+	case scope.GetStage() != "":
+		return a.handler.GetStageResource(scope.GetProject(), scope.GetStage(), scope.GetResource())
+	}
+	return a.handler.GetProjectResource(scope.GetProject(), scope.GetResource())
+}
+
+func (a resourcesV2Adapter) DeleteResource(_ context.Context, scope v2.ResourceScope, opts v2.ResourcesDeleteResourceOptions) error {
+	if handler, ok := a.handler.(*ResourceHandler); ok {
+		return handler.DeleteResource(toV1ResourceScope(scope), toV1URIOptions(opts.URIOptions)...)
+	}
+	if len(opts.URIOptions) > 0 {
+		return ErrNotSupportedByAdapter
+	}
+	switch {
+	case scope.GetService() != "":
+		return a.handler.DeleteServiceResource(scope.GetProject(), scope.GetStage(), scope.GetService(), scope.GetResource())
+	case scope.GetStage() != "":
+		return a.handler.DeleteStageResource(scope.GetProject(), scope.GetStage(), scope.GetResource())
+	}
+	return a.handler.DeleteProjectResource(scope.GetProject(), scope.GetResource())
+}
+
+func (a resourcesV2Adapter) UpdateResource(_ context.Context, resource *models.Resource, scope v2.ResourceScope, opts v2.ResourcesUpdateResourceOptions) (string, error) {
+	if handler, ok := a.handler.(*ResourceHandler); ok {
+		return handler.UpdateResource(resource, toV1ResourceScope(scope), toV1URIOptions(opts.URIOptions)...)
+	}
+	if len(opts.URIOptions) > 0 {
+		return "", ErrNotSupportedByAdapter
+	}
+	switch {
+	case scope.GetService() != "":
+		return a.handler.UpdateServiceResource(scope.GetProject(), scope.GetStage(), scope.GetService(), resource)
+	case scope.GetStage() != "":
+		return a.handler.UpdateStageResource(scope.GetProject(), scope.GetStage(), resource)
+	}
+	return a.handler.UpdateProjectResource(scope.GetProject(), resource)
+}
+
+func (a resourcesV2Adapter) CreateResource(_ context.Context, resources []*models.Resource, scope v2.ResourceScope, opts v2.ResourcesCreateResourceOptions) (string, error) {
+	if handler, ok := a.handler.(*ResourceHandler); ok {
+		return handler.CreateResource(resources, toV1ResourceScope(scope), toV1URIOptions(opts.URIOptions)...)
+	}
+	if len(opts.URIOptions) > 0 {
+		return "", ErrNotSupportedByAdapter
+	}
+	switch {
+	case scope.GetService() != "":
+		return a.handler.CreateServiceResources(scope.GetProject(), scope.GetStage(), scope.GetService(), resources)
+	case scope.GetStage() != "":
+		return a.handler.CreateStageResources(scope.GetProject(), scope.GetStage(), resources)
+	}
+	return a.handler.CreateProjectResources(scope.GetProject(), resources)
+}
+
+func toV1ResourceScope(scope v2.ResourceScope) ResourceScope {
+	return *(NewResourceScope().Project(scope.GetProject()).Stage(scope.GetStage()).Service(scope.GetService()).Resource(scope.GetResource()))
+}
+
+func toV1URIOptions(uriOptions []v2.URIOption) []URIOption {
+	var v1URIOptions []URIOption
+	for _, v := range uriOptions {
+		v1URIOptions = append(v1URIOptions, URIOption(v))
+	}
+	return v1URIOptions
+}
+
+// SecretsV1FromV2 wraps a v2 SecretsInterface as a v1 SecretsV1Interface
+func SecretsV1FromV2(handler v2.SecretsInterface, strictContext ...StrictContextMode) SecretsV1Interface {
+	return secretsV1Adapter{handler: handler, strictContext: strictContextOf(strictContext)}
+}
+
+// SecretsV2FromV1 wraps a v1 SecretsV1Interface as a v2 SecretsInterface
+func SecretsV2FromV1(handler SecretsV1Interface) v2.SecretsInterface {
+	return secretsV2Adapter{handler: handler}
+}
+
+type secretsV1Adapter struct {
+	handler       v2.SecretsInterface
+	strictContext StrictContextMode
+}
+
+func (a secretsV1Adapter) CreateSecret(secret models.Secret) error {
+	ctx, err := a.strictContext.implicitContext("SecretsV1Interface.CreateSecret")
+	if err != nil {
+		return err
+	}
+	return a.handler.CreateSecret(ctx, secret, v2.SecretsCreateSecretOptions{})
+}
+
+func (a secretsV1Adapter) UpdateSecret(secret models.Secret) error {
+	ctx, err := a.strictContext.implicitContext("SecretsV1Interface.UpdateSecret")
+	if err != nil {
+		return err
+	}
+	return a.handler.UpdateSecret(ctx, secret, v2.SecretsUpdateSecretOptions{})
+}
+
+func (a secretsV1Adapter) DeleteSecret(secretName, secretScope string) error {
+	ctx, err := a.strictContext.implicitContext("SecretsV1Interface.DeleteSecret")
+	if err != nil {
+		return err
+	}
+	return a.handler.DeleteSecret(ctx, secretName, secretScope, v2.SecretsDeleteSecretOptions{})
+}
+
+func (a secretsV1Adapter) GetSecrets() (*models.GetSecretsResponse, error) {
+	ctx, err := a.strictContext.implicitContext("SecretsV1Interface.GetSecrets")
+	if err != nil {
+		return nil, err
+	}
+	return a.handler.GetSecrets(ctx, v2.SecretsGetSecretsOptions{})
+}
+
+type secretsV2Adapter struct {
+	handler SecretsV1Interface
+}
+
+func (a secretsV2Adapter) CreateSecret(_ context.Context, secret models.Secret, _ v2.SecretsCreateSecretOptions) error {
+	return a.handler.CreateSecret(secret)
+}
+
+func (a secretsV2Adapter) UpdateSecret(_ context.Context, secret models.Secret, _ v2.SecretsUpdateSecretOptions) error {
+	return a.handler.UpdateSecret(secret)
+}
+
+func (a secretsV2Adapter) DeleteSecret(_ context.Context, secretName, secretScope string, _ v2.SecretsDeleteSecretOptions) error {
+	return a.handler.DeleteSecret(secretName, secretScope)
+}
+
+func (a secretsV2Adapter) GetSecrets(_ context.Context, _ v2.SecretsGetSecretsOptions) (*models.GetSecretsResponse, error) {
+	return a.handler.GetSecrets()
+}
+
+// SequencesV1FromV2 wraps a v2 SequencesInterface as a v1 SequencesV1Interface
+func SequencesV1FromV2(handler v2.SequencesInterface, strictContext ...StrictContextMode) SequencesV1Interface {
+	return sequencesV1Adapter{handler: handler, strictContext: strictContextOf(strictContext)}
+}
+
+// SequencesV2FromV1 wraps a v1 SequencesV1Interface as a v2 SequencesInterface.
+// Listing sequence states is not supported by the v1 interface, so GetSequenceStates and BulkControlSequences
+// return ErrNotSupportedByAdapter
+func SequencesV2FromV1(handler SequencesV1Interface) v2.SequencesInterface {
+	return sequencesV2Adapter{handler: handler}
+}
+
+type sequencesV1Adapter struct {
+	handler       v2.SequencesInterface
+	strictContext StrictContextMode
+}
+
+func (a sequencesV1Adapter) ControlSequence(params SequenceControlParams) error {
+	ctx, err := a.strictContext.implicitContext("SequencesV1Interface.ControlSequence")
+	if err != nil {
+		return err
+	}
+	return a.handler.ControlSequence(ctx, v2.SequenceControlParams{
+		Project:      params.Project,
+		KeptnContext: params.KeptnContext,
+		Stage:        params.Stage,
+		State:        params.State,
+	}, v2.SequencesControlSequenceOptions{})
+}
+
+type sequencesV2Adapter struct {
+	handler SequencesV1Interface
+}
+
+func (a sequencesV2Adapter) ControlSequence(_ context.Context, params v2.SequenceControlParams, _ v2.SequencesControlSequenceOptions) error {
+	return a.handler.ControlSequence(SequenceControlParams{
+		Project:      params.Project,
+		KeptnContext: params.KeptnContext,
+		Stage:        params.Stage,
+		State:        params.State,
+	})
+}
+
+func (a sequencesV2Adapter) GetSequenceStates(_ context.Context, _ v2.SequenceStateFilter, _ v2.SequencesGetSequenceStatesOptions) ([]models.SequenceState, error) {
+	return nil, ErrNotSupportedByAdapter
+}
+
+func (a sequencesV2Adapter) BulkControlSequences(_ context.Context, _ v2.SequenceStateFilter, _ models.SequenceControlState, _ v2.SequencesBulkControlSequencesOptions) ([]v2.SequenceControlResult, error) {
+	return nil, ErrNotSupportedByAdapter
+}
+
+// ServicesV1FromV2 wraps a v2 ServicesInterface as a v1 ServicesV1Interface
+func ServicesV1FromV2(handler v2.ServicesInterface, strictContext ...StrictContextMode) ServicesV1Interface {
+	return servicesV1Adapter{handler: handler, strictContext: strictContextOf(strictContext)}
+}
+
+// ServicesV2FromV1 wraps a v1 ServicesV1Interface as a v2 ServicesInterface
+func ServicesV2FromV1(handler ServicesV1Interface) v2.ServicesInterface {
+	return servicesV2Adapter{handler: handler}
+}
+
+type servicesV1Adapter struct {
+	handler       v2.ServicesInterface
+	strictContext StrictContextMode
+}
+
+func (a servicesV1Adapter) CreateServiceInStage(project string, stage string, serviceName string) (*models.EventContext, *models.Error) {
+	ctx, err := a.strictContext.implicitContext("ServicesV1Interface.CreateServiceInStage")
+	if err != nil {
+		return nil, implicitContextResponse(err)
+	}
+	return a.handler.CreateServiceInStage(ctx, project, stage, serviceName, v2.ServicesCreateServiceInStageOptions{})
+}
+
+func (a servicesV1Adapter) DeleteServiceFromStage(project string, stage string, serviceName string) (*models.EventContext, *models.Error) {
+	ctx, err := a.strictContext.implicitContext("ServicesV1Interface.DeleteServiceFromStage")
+	if err != nil {
+		return nil, implicitContextResponse(err)
+	}
+	return a.handler.DeleteServiceFromStage(ctx, project, stage, serviceName, v2.ServicesDeleteServiceFromStageOptions{})
+}
+
+func (a servicesV1Adapter) GetService(project, stage, service string) (*models.Service, error) {
+	ctx, err := a.strictContext.implicitContext("ServicesV1Interface.GetService")
+	if err != nil {
+		return nil, err
+	}
+	return a.handler.GetService(ctx, project, stage, service, v2.ServicesGetServiceOptions{})
+}
+
+func (a servicesV1Adapter) GetAllServices(project string, stage string) ([]*models.Service, error) {
+	ctx, err := a.strictContext.implicitContext("ServicesV1Interface.GetAllServices")
+	if err != nil {
+		return nil, err
+	}
+	return a.handler.GetAllServices(ctx, project, stage, v2.ServicesGetAllServicesOptions{})
+}
+
+type servicesV2Adapter struct {
+	handler ServicesV1Interface
+}
+
+func (a servicesV2Adapter) CreateServiceInStage(_ context.Context, project string, stage string, serviceName string, _ v2.ServicesCreateServiceInStageOptions) (*models.EventContext, *models.Error) {
+	return a.handler.CreateServiceInStage(project, stage, serviceName)
+}
+
+func (a servicesV2Adapter) DeleteServiceFromStage(_ context.Context, project string, stage string, serviceName string, _ v2.ServicesDeleteServiceFromStageOptions) (*models.EventContext, *models.Error) {
+	return a.handler.DeleteServiceFromStage(project, stage, serviceName)
+}
+
+func (a servicesV2Adapter) GetService(_ context.Context, project, stage, service string, _ v2.ServicesGetServiceOptions) (*models.Service, error) {
+	return a.handler.GetService(project, stage, service)
+}
+
+func (a servicesV2Adapter) GetAllServices(_ context.Context, project string, stage string, _ v2.ServicesGetAllServicesOptions) ([]*models.Service, error) {
+	return a.handler.GetAllServices(project, stage)
+}
+
+// StagesV1FromV2 wraps a v2 StagesInterface as a v1 StagesV1Interface
+func StagesV1FromV2(handler v2.StagesInterface, strictContext ...StrictContextMode) StagesV1Interface {
+	return stagesV1Adapter{handler: handler, strictContext: strictContextOf(strictContext)}
+}
+
+// StagesV2FromV1 wraps a v1 StagesV1Interface as a v2 StagesInterface
+func StagesV2FromV1(handler StagesV1Interface) v2.StagesInterface {
+	return stagesV2Adapter{handler: handler}
+}
+
+type stagesV1Adapter struct {
+	handler       v2.StagesInterface
+	strictContext StrictContextMode
+}
+
+func (a stagesV1Adapter) CreateStage(project string, stageName string) (*models.EventContext, *models.Error) {
+	ctx, err := a.strictContext.implicitContext("StagesV1Interface.CreateStage")
+	if err != nil {
+		return nil, implicitContextResponse(err)
+	}
+	return a.handler.CreateStage(ctx, project, stageName, v2.StagesCreateStageOptions{})
+}
+
+func (a stagesV1Adapter) GetAllStages(project string) ([]*models.Stage, error) {
+	ctx, err := a.strictContext.implicitContext("StagesV1Interface.GetAllStages")
+	if err != nil {
+		return nil, err
+	}
+	return a.handler.GetAllStages(ctx, project, v2.StagesGetAllStagesOptions{})
+}
+
+type stagesV2Adapter struct {
+	handler StagesV1Interface
+}
+
+func (a stagesV2Adapter) CreateStage(_ context.Context, project string, stageName string, _ v2.StagesCreateStageOptions) (*models.EventContext, *models.Error) {
+	return a.handler.CreateStage(project, stageName)
+}
+
+func (a stagesV2Adapter) GetAllStages(_ context.Context, project string, _ v2.StagesGetAllStagesOptions) ([]*models.Stage, error) {
+	return a.handler.GetAllStages(project)
+}
+
+// UniformV1FromV2 wraps a v2 UniformInterface as a v1 UniformV1Interface
+func UniformV1FromV2(handler v2.UniformInterface, strictContext ...StrictContextMode) UniformV1Interface {
+	return uniformV1Adapter{handler: handler, strictContext: strictContextOf(strictContext)}
+}
+
+// UniformV2FromV1 wraps a v1 UniformV1Interface as a v2 UniformInterface
+func UniformV2FromV1(handler UniformV1Interface) v2.UniformInterface {
+	return uniformV2Adapter{handler: handler}
+}
+
+type uniformV1Adapter struct {
+	handler       v2.UniformInterface
+	strictContext StrictContextMode
+}
+
+func (a uniformV1Adapter) Ping(integrationID string) (*models.Integration, error) {
+	ctx, err := a.strictContext.implicitContext("UniformV1Interface.Ping")
+	if err != nil {
+		return nil, err
+	}
+	return a.handler.Ping(ctx, integrationID, v2.UniformPingOptions{})
+}
+
+func (a uniformV1Adapter) RegisterIntegration(integration models.Integration) (string, error) {
+	ctx, err := a.strictContext.implicitContext("UniformV1Interface.RegisterIntegration")
+	if err != nil {
+		return "", err
+	}
+	return a.handler.RegisterIntegration(ctx, integration, v2.UniformRegisterIntegrationOptions{})
+}
+
+func (a uniformV1Adapter) CreateSubscription(integrationID string, subscription models.EventSubscription) (string, error) {
+	ctx, err := a.strictContext.implicitContext("UniformV1Interface.CreateSubscription")
+	if err != nil {
+		return "", err
+	}
+	return a.handler.CreateSubscription(ctx, integrationID, subscription, v2.UniformCreateSubscriptionOptions{})
+}
+
+func (a uniformV1Adapter) UnregisterIntegration(integrationID string) error {
+	ctx, err := a.strictContext.implicitContext("UniformV1Interface.UnregisterIntegration")
+	if err != nil {
+		return err
+	}
+	return a.handler.UnregisterIntegration(ctx, integrationID, v2.UniformUnregisterIntegrationOptions{})
+}
+
+func (a uniformV1Adapter) GetRegistrations() ([]*models.Integration, error) {
+	ctx, err := a.strictContext.implicitContext("UniformV1Interface.GetRegistrations")
+	if err != nil {
+		return nil, err
+	}
+	return a.handler.GetRegistrations(ctx, v2.UniformGetRegistrationsOptions{})
+}
+
+type uniformV2Adapter struct {
+	handler UniformV1Interface
+}
+
+func (a uniformV2Adapter) Ping(_ context.Context, integrationID string, _ v2.UniformPingOptions) (*models.Integration, error) {
+	return a.handler.Ping(integrationID)
+}
+
+func (a uniformV2Adapter) RegisterIntegration(_ context.Context, integration models.Integration, _ v2.UniformRegisterIntegrationOptions) (string, error) {
+	return a.handler.RegisterIntegration(integration)
+}
+
+func (a uniformV2Adapter) CreateSubscription(_ context.Context, integrationID string, subscription models.EventSubscription, _ v2.UniformCreateSubscriptionOptions) (string, error) {
+	return a.handler.CreateSubscription(integrationID, subscription)
+}
+
+func (a uniformV2Adapter) UnregisterIntegration(_ context.Context, integrationID string, _ v2.UniformUnregisterIntegrationOptions) error {
+	return a.handler.UnregisterIntegration(integrationID)
+}
+
+func (a uniformV2Adapter) GetRegistrations(_ context.Context, _ v2.UniformGetRegistrationsOptions) ([]*models.Integration, error) {
+	return a.handler.GetRegistrations()
+}
+
+// ShipyardControlV1FromV2 wraps a v2 ShipyardControlInterface as a v1 ShipyardControlV1Interface
+func ShipyardControlV1FromV2(handler v2.ShipyardControlInterface, strictContext ...StrictContextMode) ShipyardControlV1Interface {
+	return shipyardControlV1Adapter{handler: handler, strictContext: strictContextOf(strictContext)}
+}
+
+// ShipyardControlV2FromV1 wraps a v1 ShipyardControlV1Interface as a v2 ShipyardControlInterface
+func ShipyardControlV2FromV1(handler ShipyardControlV1Interface) v2.ShipyardControlInterface {
+	return shipyardControlV2Adapter{handler: handler}
+}
+
+type shipyardControlV1Adapter struct {
+	handler       v2.ShipyardControlInterface
+	strictContext StrictContextMode
+}
+
+func (a shipyardControlV1Adapter) GetOpenTriggeredEvents(filter EventFilter) ([]*models.KeptnContextExtendedCE, error) {
+	ctx, err := a.strictContext.implicitContext("ShipyardControlV1Interface.GetOpenTriggeredEvents")
+	if err != nil {
+		return nil, err
+	}
+	return a.handler.GetOpenTriggeredEvents(ctx, *toV2EventFilter(&filter), v2.ShipyardControlGetOpenTriggeredEventsOptions{})
+}
+
+type shipyardControlV2Adapter struct {
+	handler ShipyardControlV1Interface
+}
+
+func (a shipyardControlV2Adapter) GetOpenTriggeredEvents(_ context.Context, filter v2.EventFilter, _ v2.ShipyardControlGetOpenTriggeredEventsOptions) ([]*models.KeptnContextExtendedCE, error) {
+	return a.handler.GetOpenTriggeredEvents(*toV1EventFilter(&filter))
+}
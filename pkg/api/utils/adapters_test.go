@@ -0,0 +1,151 @@
+package api
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/keptn/go-utils/pkg/api/models"
+	v2 "github.com/keptn/go-utils/pkg/api/utils/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type requestRecorder struct {
+	mtx   sync.Mutex
+	paths []string
+}
+
+func (r *requestRecorder) server(t *testing.T, response string) *httptest.Server {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		r.mtx.Lock()
+		r.paths = append(r.paths, req.Method+" "+req.URL.EscapedPath())
+		r.mtx.Unlock()
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(response))
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func (r *requestRecorder) recorded() []string {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+	return append([]string{}, r.paths...)
+}
+
+// resourcesV1Only hides the scoped methods of a *ResourceHandler, so that the fallback of the adapter is used
+type resourcesV1Only struct {
+	ResourcesV1Interface
+}
+
+func TestResourcesV1FromV2(t *testing.T) {
+	recorder := &requestRecorder{}
+	server := recorder.server(t, `{"resourceURI":"sli.yaml","resourceContent":"Y29udGVudA=="}`)
+
+	handler := ResourcesV1FromV2(v2.NewResourceHandler(server.URL))
+
+	resource, err := handler.GetStageResource("my-project", "dev", "sli.yaml")
+	require.NoError(t, err)
+	assert.Equal(t, "sli.yaml", *resource.ResourceURI)
+
+	err = handler.DeleteServiceResource("my-project", "dev", "my-service", "sli.yaml")
+	require.NoError(t, err)
+
+	_, err = handler.UpdateStageResources("my-project", "dev", nil)
+	assert.ErrorIs(t, err, ErrNotSupportedByAdapter)
+
+	assert.Equal(t, []string{
+		"GET /v1/project/my-project/stage/dev/resource/sli.yaml",
+		"DELETE /v1/project/my-project/stage/dev/service/my-service/resource/sli.yaml",
+	}, recorder.recorded())
+}
+
+func TestResourcesV2FromV1(t *testing.T) {
+	recorder := &requestRecorder{}
+	server := recorder.server(t, `{"resourceURI":"sli.yaml","resourceContent":"Y29udGVudA=="}`)
+
+	scope := *v2.NewResourceScope().Project("my-project").Stage("dev").Resource("sli.yaml")
+	opts := v2.ResourcesGetResourceOptions{URIOptions: []v2.URIOption{v2.AppendQuery(map[string][]string{"commitID": {"abc"}})}}
+
+	t.Run("scoped methods of the v1 handler are used", func(t *testing.T) {
+		handler := ResourcesV2FromV1(NewResourceHandler(server.URL))
+		resource, err := handler.GetResource(context.TODO(), scope, opts)
+		require.NoError(t, err)
+		assert.Equal(t, "sli.yaml", *resource.ResourceURI)
+	})
+
+	t.Run("methods for the scope level are used as fallback", func(t *testing.T) {
+		handler := ResourcesV2FromV1(resourcesV1Only{NewResourceHandler(server.URL)})
+		resource, err := handler.GetResource(context.TODO(), scope, v2.ResourcesGetResourceOptions{})
+		require.NoError(t, err)
+		assert.Equal(t, "sli.yaml", *resource.ResourceURI)
+
+		_, err = handler.GetResource(context.TODO(), scope, opts)
+		assert.ErrorIs(t, err, ErrNotSupportedByAdapter)
+	})
+
+	assert.Equal(t, []string{
+		"GET /v1/project/my-project/stage/dev/resource/sli.yaml",
+		"GET /v1/project/my-project/stage/dev/resource/sli.yaml",
+	}, recorder.recorded())
+}
+
+func TestEventsV2FromV1_GetEventsByContexts(t *testing.T) {
+	recorder := &requestRecorder{}
+	server := recorder.server(t, `{"events":[{"id":"1","shkeptncontext":"ctx"}],"totalCount":1}`)
+
+	handler := EventsV2FromV1(NewEventHandler(server.URL))
+	result, err := v2.GetEventsByContexts(context.TODO(), handler, []string{"ctx-1", "ctx-2", "ctx-1"}, v2.EventsGetEventsByContextsOptions{
+		Filter: &v2.EventFilter{Project: "my-project"},
+	})
+	require.NoError(t, err)
+	require.Len(t, result, 2)
+	assert.Len(t, result["ctx-1"], 1)
+	assert.Len(t, result["ctx-2"], 1)
+	assert.Len(t, recorder.recorded(), 2)
+}
+
+func TestKeptnAdapters(t *testing.T) {
+	recorder := &requestRecorder{}
+	server := recorder.server(t, `{"stages":[{"stageName":"dev"}]}`)
+
+	apiSet, err := v2.New(server.URL)
+	require.NoError(t, err)
+
+	v1APISet := KeptnV1FromV2(apiSet)
+	stages, err := v1APISet.StagesV1().GetAllStages("my-project")
+	require.NoError(t, err)
+	require.Len(t, stages, 1)
+	assert.Equal(t, "dev", stages[0].StageName)
+
+	roundTrip := KeptnV2FromV1(v1APISet)
+	_, err = roundTrip.Sequences().GetSequenceStates(context.TODO(), v2.SequenceStateFilter{Project: "my-project"}, v2.SequencesGetSequenceStatesOptions{})
+	assert.ErrorIs(t, err, ErrNotSupportedByAdapter)
+}
+
+func TestKeptnV1FromV2_StrictContext(t *testing.T) {
+	recorder := &requestRecorder{}
+	server := recorder.server(t, `{"projects":[]}`)
+
+	apiSet, err := v2.New(server.URL)
+	require.NoError(t, err)
+
+	v1APISet := KeptnV1FromV2(apiSet, ImplicitContextError)
+	_, err = v1APISet.ProjectsV1().GetAllProjects()
+	require.ErrorIs(t, err, ErrImplicitContext)
+	require.Contains(t, err.Error(), "ProjectsV1Interface.GetAllProjects")
+
+	_, mErr := v1APISet.ProjectsV1().GetProject(models.Project{ProjectName: "my-project"})
+	require.NotNil(t, mErr)
+	require.ErrorIs(t, mErr.ToError(), ErrImplicitContext)
+
+	require.ErrorIs(t, SequencesV1FromV2(apiSet.Sequences(), ImplicitContextError).ControlSequence(SequenceControlParams{}), ErrImplicitContext)
+	require.Empty(t, recorder.recorded())
+
+	_, err = KeptnV1FromV2(apiSet).ProjectsV1().GetAllProjects()
+	require.NoError(t, err)
+	require.Len(t, recorder.recorded(), 1)
+}
@@ -1,9 +1,8 @@
 package api
 
 import (
-	"context"
 	"net/http"
-	"strings"
+	"sync"
 
 	"github.com/keptn/go-utils/pkg/api/models"
 	v2 "github.com/keptn/go-utils/pkg/api/utils/v2"
@@ -42,11 +41,26 @@ type APIV1Interface interface {
 // APIHandler handles projects
 type APIHandler struct {
 	apiHandler *v2.APIHandler
-	BaseURL    string
-	AuthToken  string
+
+	// Deprecated: changes to the field are not applied to the handler; create a new handler to use another base URL
+	BaseURL string
+
+	// Deprecated: use WithAuthToken to derive a handler with another token
+	AuthToken string
+
+	// Deprecated: use WithAuthToken to derive a handler with another auth header
 	AuthHeader string
+
+	// Deprecated: use WithHTTPClient to derive a handler with another http.Client
 	HTTPClient *http.Client
-	Scheme     string
+
+	// Deprecated: changes to the field are not applied to the handler; create a new handler to use another scheme
+	Scheme string
+
+	once          sync.Once
+	strictContext StrictContextMode
+	basePathMode  v2.BasePathMode
+	endpoint      string
 }
 
 // NewAPIHandler returns a new APIHandler
@@ -57,37 +71,38 @@ func NewAPIHandler(baseURL string) *APIHandler {
 // NewAPIHandlerWithHTTPClient returns a new APIHandler that uses the specified http.Client
 func NewAPIHandlerWithHTTPClient(baseURL string, httpClient *http.Client) *APIHandler {
 	return &APIHandler{
-		BaseURL:    httputils.TrimHTTPScheme(baseURL),
-		HTTPClient: httpClient,
-		Scheme:     "http",
-		apiHandler: v2.NewAPIHandlerWithHTTPClient(baseURL, httpClient),
+		BaseURL:      httputils.TrimHTTPScheme(baseURL),
+		HTTPClient:   httpClient,
+		Scheme:       "http",
+		apiHandler:   v2.NewAPIHandlerWithHTTPClient(baseURL, httpClient),
+		endpoint:     baseURL,
+		basePathMode: v2.ExactBaseURL,
 	}
 }
 
-// NewAuthenticatedAPIHandler returns a new APIHandler that authenticates at the api-service endpoint via the provided token
+// NewAuthenticatedAPIHandler returns a new APIHandler that authenticates at the api-service endpoint via the provided token.
+// The base path of the service is added to the base URL according to basePathMode (default v2.AppendMissingBasePath)
 // Deprecated: use APISet instead
-func NewAuthenticatedAPIHandler(baseURL string, authToken string, authHeader string, httpClient *http.Client, scheme string) *APIHandler {
+func NewAuthenticatedAPIHandler(baseURL string, authToken string, authHeader string, httpClient *http.Client, scheme string, basePathMode ...v2.BasePathMode) *APIHandler {
 	if httpClient == nil {
 		httpClient = &http.Client{}
 	}
 	httpClient.Transport = wrapOtelTransport(getClientTransport(httpClient.Transport))
-	return createAuthenticatedAPIHandler(baseURL, authToken, authHeader, httpClient, scheme)
+	return createAuthenticatedAPIHandler(baseURL, authToken, authHeader, httpClient, scheme, basePathModeOf(basePathMode))
 }
 
-func createAuthenticatedAPIHandler(baseURL string, authToken string, authHeader string, httpClient *http.Client, scheme string) *APIHandler {
-	v2APIHandler := v2.NewAuthenticatedAPIHandler(baseURL, authToken, authHeader, httpClient, scheme)
-
-	if !strings.HasSuffix(baseURL, shipyardControllerBaseURL) {
-		baseURL += "/" + shipyardControllerBaseURL
-	}
+func createAuthenticatedAPIHandler(baseURL string, authToken string, authHeader string, httpClient *http.Client, scheme string, basePathMode v2.BasePathMode) *APIHandler {
+	v2APIHandler := v2.NewAuthenticatedAPIHandler(baseURL, authToken, authHeader, httpClient, scheme, basePathMode)
 
 	return &APIHandler{
-		BaseURL:    httputils.TrimHTTPScheme(baseURL),
-		AuthHeader: authHeader,
-		AuthToken:  authToken,
-		HTTPClient: httpClient,
-		Scheme:     scheme,
-		apiHandler: v2APIHandler,
+		BaseURL:      httputils.TrimHTTPScheme(basePathMode.HandlerBaseURL(baseURL, v2.HandlerAPI)),
+		AuthHeader:   authHeader,
+		AuthToken:    authToken,
+		HTTPClient:   httpClient,
+		Scheme:       scheme,
+		apiHandler:   v2APIHandler,
+		basePathMode: basePathMode,
+		endpoint:     baseURL,
 	}
 }
 
@@ -110,59 +125,109 @@ func (a *APIHandler) getHTTPClient() *http.Client {
 // SendEvent sends an event to Keptn.
 func (a *APIHandler) SendEvent(event models.KeptnContextExtendedCE) (*models.EventContext, *models.Error) {
 	a.ensureHandlerIsSet()
-	return a.apiHandler.SendEvent(context.TODO(), event, v2.APISendEventOptions{})
+	ctx, err := a.strictContext.implicitContext("APIHandler.SendEvent")
+	if err != nil {
+		return nil, implicitContextResponse(err)
+	}
+	return a.apiHandler.SendEvent(ctx, event, v2.APISendEventOptions{})
 }
 
 // TriggerEvaluation triggers a new evaluation.
 func (a *APIHandler) TriggerEvaluation(project, stage, service string, evaluation models.Evaluation) (*models.EventContext, *models.Error) {
 	a.ensureHandlerIsSet()
-	return a.apiHandler.TriggerEvaluation(context.TODO(), project, stage, service, evaluation, v2.APITriggerEvaluationOptions{})
+	ctx, err := a.strictContext.implicitContext("APIHandler.TriggerEvaluation")
+	if err != nil {
+		return nil, implicitContextResponse(err)
+	}
+	return a.apiHandler.TriggerEvaluation(ctx, project, stage, service, evaluation, v2.APITriggerEvaluationOptions{})
 }
 
 // CreateProject creates a new project.
 func (a *APIHandler) CreateProject(project models.CreateProject) (string, *models.Error) {
 	a.ensureHandlerIsSet()
-	return a.apiHandler.CreateProject(context.TODO(), project, v2.APICreateProjectOptions{})
+	ctx, err := a.strictContext.implicitContext("APIHandler.CreateProject")
+	if err != nil {
+		return "", implicitContextResponse(err)
+	}
+	return a.apiHandler.CreateProject(ctx, project, v2.APICreateProjectOptions{})
 }
 
 // UpdateProject updates a project.
 func (a *APIHandler) UpdateProject(project models.CreateProject) (string, *models.Error) {
 	a.ensureHandlerIsSet()
-	return a.apiHandler.UpdateProject(context.TODO(), project, v2.APIUpdateProjectOptions{})
+	ctx, err := a.strictContext.implicitContext("APIHandler.UpdateProject")
+	if err != nil {
+		return "", implicitContextResponse(err)
+	}
+	return a.apiHandler.UpdateProject(ctx, project, v2.APIUpdateProjectOptions{})
 }
 
 // DeleteProject deletes a project.
 func (a *APIHandler) DeleteProject(project models.Project) (*models.DeleteProjectResponse, *models.Error) {
 	a.ensureHandlerIsSet()
-	return a.apiHandler.DeleteProject(context.TODO(), project, v2.APIDeleteProjectOptions{})
+	ctx, err := a.strictContext.implicitContext("APIHandler.DeleteProject")
+	if err != nil {
+		return nil, implicitContextResponse(err)
+	}
+	return a.apiHandler.DeleteProject(ctx, project, v2.APIDeleteProjectOptions{})
 }
 
 // CreateService creates a new service.
 func (a *APIHandler) CreateService(project string, service models.CreateService) (string, *models.Error) {
 	a.ensureHandlerIsSet()
-	return a.apiHandler.CreateService(context.TODO(), project, service, v2.APICreateServiceOptions{})
+	ctx, err := a.strictContext.implicitContext("APIHandler.CreateService")
+	if err != nil {
+		return "", implicitContextResponse(err)
+	}
+	return a.apiHandler.CreateService(ctx, project, service, v2.APICreateServiceOptions{})
 }
 
 // DeleteService deletes a service.
 func (a *APIHandler) DeleteService(project, service string) (*models.DeleteServiceResponse, *models.Error) {
 	a.ensureHandlerIsSet()
-	return a.apiHandler.DeleteService(context.TODO(), project, service, v2.APIDeleteServiceOptions{})
+	ctx, err := a.strictContext.implicitContext("APIHandler.DeleteService")
+	if err != nil {
+		return nil, implicitContextResponse(err)
+	}
+	return a.apiHandler.DeleteService(ctx, project, service, v2.APIDeleteServiceOptions{})
 }
 
 // GetMetadata retrieves Keptn metadata information.
 func (a *APIHandler) GetMetadata() (*models.Metadata, *models.Error) {
 	a.ensureHandlerIsSet()
-	return a.apiHandler.GetMetadata(context.TODO(), v2.APIGetMetadataOptions{})
+	ctx, err := a.strictContext.implicitContext("APIHandler.GetMetadata")
+	if err != nil {
+		return nil, implicitContextResponse(err)
+	}
+	return a.apiHandler.GetMetadata(ctx, v2.APIGetMetadataOptions{})
 }
 
 func (a *APIHandler) ensureHandlerIsSet() {
-	if a.apiHandler != nil {
-		return
-	}
-
-	if a.AuthToken != "" {
-		a.apiHandler = v2.NewAuthenticatedAPIHandler(a.BaseURL, a.AuthToken, a.AuthHeader, a.HTTPClient, a.Scheme)
-	} else {
-		a.apiHandler = v2.NewAPIHandlerWithHTTPClient(a.BaseURL, a.HTTPClient)
-	}
+	a.once.Do(func() {
+		if a.apiHandler != nil {
+			return
+		}
+
+		if a.AuthToken != "" {
+			a.apiHandler = v2.NewAuthenticatedAPIHandler(a.BaseURL, a.AuthToken, a.AuthHeader, a.HTTPClient, a.Scheme)
+		} else {
+			a.apiHandler = v2.NewAPIHandlerWithHTTPClient(a.BaseURL, a.HTTPClient)
+		}
+	})
+}
+
+// WithAuthToken returns a new APIHandler that uses the given token and auth header but otherwise the same
+// settings. The APIHandler itself is not modified, so it can still be used concurrently
+func (a *APIHandler) WithAuthToken(authToken string, authHeader string) *APIHandler {
+	derived := createAuthenticatedAPIHandler(derivedBaseURL(a.endpoint, a.BaseURL), authToken, authHeader, a.HTTPClient, a.Scheme, a.basePathMode)
+	derived.strictContext = a.strictContext
+	return derived
+}
+
+// WithHTTPClient returns a new APIHandler that uses the given http.Client but otherwise the same settings.
+// The APIHandler itself is not modified, so it can still be used concurrently
+func (a *APIHandler) WithHTTPClient(httpClient *http.Client) *APIHandler {
+	derived := createAuthenticatedAPIHandler(derivedBaseURL(a.endpoint, a.BaseURL), a.AuthToken, a.AuthHeader, httpClient, a.Scheme, a.basePathMode)
+	derived.strictContext = a.strictContext
+	return derived
 }
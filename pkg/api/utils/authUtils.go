@@ -1,8 +1,8 @@
 package api
 
 import (
-	"context"
 	"net/http"
+	"sync"
 
 	"github.com/keptn/go-utils/pkg/api/models"
 	v2 "github.com/keptn/go-utils/pkg/api/utils/v2"
@@ -17,11 +17,24 @@ type AuthV1Interface interface {
 // AuthHandler handles projects
 type AuthHandler struct {
 	authHandler *v2.AuthHandler
-	BaseURL     string
-	AuthToken   string
-	AuthHeader  string
-	HTTPClient  *http.Client
-	Scheme      string
+
+	// Deprecated: changes to the field are not applied to the handler; create a new handler to use another base URL
+	BaseURL string
+
+	// Deprecated: use WithAuthToken to derive a handler with another token
+	AuthToken string
+
+	// Deprecated: use WithAuthToken to derive a handler with another auth header
+	AuthHeader string
+
+	// Deprecated: use WithHTTPClient to derive a handler with another http.Client
+	HTTPClient *http.Client
+
+	// Deprecated: changes to the field are not applied to the handler; create a new handler to use another scheme
+	Scheme string
+
+	once          sync.Once
+	strictContext StrictContextMode
 }
 
 // NewAuthHandler returns a new AuthHandler
@@ -80,17 +93,39 @@ func (a *AuthHandler) getHTTPClient() *http.Client {
 // Authenticate authenticates the client request against the server.
 func (a *AuthHandler) Authenticate() (*models.EventContext, *models.Error) {
 	a.ensureHandlerIsSet()
-	return a.authHandler.Authenticate(context.TODO(), v2.AuthAuthenticateOptions{})
+	ctx, err := a.strictContext.implicitContext("AuthHandler.Authenticate")
+	if err != nil {
+		return nil, implicitContextResponse(err)
+	}
+	return a.authHandler.Authenticate(ctx, v2.AuthAuthenticateOptions{})
 }
 
 func (a *AuthHandler) ensureHandlerIsSet() {
-	if a.authHandler != nil {
-		return
-	}
+	a.once.Do(func() {
+		if a.authHandler != nil {
+			return
+		}
+
+		if a.AuthToken != "" {
+			a.authHandler = v2.NewAuthenticatedAuthHandler(a.BaseURL, a.AuthToken, a.AuthHeader, a.HTTPClient, a.Scheme)
+		} else {
+			a.authHandler = v2.NewAuthHandlerWithHTTPClient(a.BaseURL, a.HTTPClient)
+		}
+	})
+}
 
-	if a.AuthToken != "" {
-		a.authHandler = v2.NewAuthenticatedAuthHandler(a.BaseURL, a.AuthToken, a.AuthHeader, a.HTTPClient, a.Scheme)
-	} else {
-		a.authHandler = v2.NewAuthHandlerWithHTTPClient(a.BaseURL, a.HTTPClient)
-	}
+// WithAuthToken returns a new AuthHandler that uses the given token and auth header but otherwise the same
+// settings. The AuthHandler itself is not modified, so it can still be used concurrently
+func (a *AuthHandler) WithAuthToken(authToken string, authHeader string) *AuthHandler {
+	derived := createAuthenticatedAuthHandler(a.BaseURL, authToken, authHeader, a.HTTPClient, a.Scheme)
+	derived.strictContext = a.strictContext
+	return derived
+}
+
+// WithHTTPClient returns a new AuthHandler that uses the given http.Client but otherwise the same settings.
+// The AuthHandler itself is not modified, so it can still be used concurrently
+func (a *AuthHandler) WithHTTPClient(httpClient *http.Client) *AuthHandler {
+	derived := createAuthenticatedAuthHandler(a.BaseURL, a.AuthToken, a.AuthHeader, httpClient, a.Scheme)
+	derived.strictContext = a.strictContext
+	return derived
 }
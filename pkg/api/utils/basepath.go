@@ -0,0 +1,37 @@
+package api
+
+import v2 "github.com/keptn/go-utils/pkg/api/utils/v2"
+
+// WithBasePathMode sets how the base paths of the Keptn services, e.g. controlPlane for the shipyard controller
+// or mongodb-datastore for the datastore, are added to the base URL of the handlers of the APISet
+// (default v2.AppendMissingBasePath)
+func WithBasePathMode(mode v2.BasePathMode) func(*APISet) {
+	return func(a *APISet) {
+		a.basePathMode = mode
+	}
+}
+
+// WithExactBaseURL uses the base URL of the APISet as is for all handlers, without appending the base paths
+// of the Keptn services. It is a shorthand for WithBasePathMode(v2.ExactBaseURL)
+func WithExactBaseURL() func(*APISet) {
+	return WithBasePathMode(v2.ExactBaseURL)
+}
+
+// basePathModeOf returns the first of the given modes, or v2.AppendMissingBasePath if none is given.
+// It is used by the NewAuthenticated*Handler functions, which take the mode as optional argument
+func basePathModeOf(modes []v2.BasePathMode) v2.BasePathMode {
+	if len(modes) > 0 {
+		return modes[0]
+	}
+	return v2.AppendMissingBasePath
+}
+
+// derivedBaseURL returns the base URL a handler derived via WithAuthToken or WithHTTPClient is created with,
+// i.e. the base URL the handler has been created with, so that the base path of the service is added in the same way.
+// Handlers which have not been created by one of the constructors fall back to their BaseURL
+func derivedBaseURL(endpoint string, baseURL string) string {
+	if endpoint != "" {
+		return endpoint
+	}
+	return baseURL
+}
@@ -0,0 +1,105 @@
+package api
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	v2 "github.com/keptn/go-utils/pkg/api/utils/v2"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWithBasePathMode(t *testing.T) {
+	tests := []struct {
+		name          string
+		baseURL       string
+		options       []func(*APISet)
+		wantEvents    string
+		wantProjects  string
+		wantResources string
+		wantSecrets   string
+	}{
+		{
+			name:          "AppendMissingBasePath appends base paths",
+			baseURL:       "http://keptn/api/",
+			wantEvents:    "keptn/api/mongodb-datastore",
+			wantProjects:  "keptn/api/controlPlane",
+			wantResources: "keptn/api/configuration-service",
+			wantSecrets:   "keptn/api/secrets",
+		},
+		{
+			name:          "AppendMissingBasePath keeps existing base path",
+			baseURL:       "http://keptn/api/controlPlane",
+			wantEvents:    "keptn/api/controlPlane/mongodb-datastore",
+			wantProjects:  "keptn/api/controlPlane",
+			wantResources: "keptn/api/controlPlane/configuration-service",
+			wantSecrets:   "keptn/api/controlPlane/secrets",
+		},
+		{
+			name:          "AlwaysAppendBasePath appends existing base path",
+			baseURL:       "http://keptn/api/controlPlane",
+			options:       []func(*APISet){WithBasePathMode(v2.AlwaysAppendBasePath)},
+			wantEvents:    "keptn/api/controlPlane/mongodb-datastore",
+			wantProjects:  "keptn/api/controlPlane/controlPlane",
+			wantResources: "keptn/api/controlPlane/configuration-service",
+			wantSecrets:   "keptn/api/controlPlane/secrets",
+		},
+		{
+			name:          "ExactBaseURL uses base URL as is",
+			baseURL:       "http://keptn/api/controlPlane",
+			options:       []func(*APISet){WithExactBaseURL()},
+			wantEvents:    "keptn/api/controlPlane",
+			wantProjects:  "keptn/api/controlPlane",
+			wantResources: "keptn/api/controlPlane",
+			wantSecrets:   "keptn/api/controlPlane",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			apiSet, err := New(tt.baseURL, tt.options...)
+			require.NoError(t, err)
+			require.Equal(t, tt.wantEvents, apiSet.eventHandler.BaseURL)
+			require.Equal(t, tt.wantProjects, apiSet.projectHandler.BaseURL)
+			require.Equal(t, tt.wantResources, apiSet.resourceHandler.BaseURL)
+			require.Equal(t, tt.wantSecrets, apiSet.secretHandler.BaseURL)
+		})
+	}
+}
+
+func TestWithExactBaseURL_SendsRequestsToBaseURL(t *testing.T) {
+	var paths []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		paths = append(paths, r.URL.Path)
+		w.Write([]byte(`{"events":[]}`))
+	}))
+	defer server.Close()
+
+	apiSet, err := New(server.URL+"/datastore", WithExactBaseURL())
+	require.NoError(t, err)
+	_, mErr := apiSet.EventsV1().GetEvents(&EventFilter{Project: "my-project"})
+	require.Nil(t, mErr)
+	require.Equal(t, []string{"/datastore/event"}, paths)
+}
+
+func TestNewAuthenticatedHandler_BasePathMode(t *testing.T) {
+	var paths []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		paths = append(paths, r.URL.Path)
+		w.Write([]byte(`{"events":[]}`))
+	}))
+	defer server.Close()
+	host := strings.TrimPrefix(server.URL, "http://")
+
+	eventHandler := NewAuthenticatedEventHandler(server.URL+"/datastore", "a-token", "x-token", nil, "http", v2.ExactBaseURL)
+	require.Equal(t, host+"/datastore", eventHandler.BaseURL)
+	_, mErr := eventHandler.GetEvents(&EventFilter{Project: "my-project"})
+	require.Nil(t, mErr)
+
+	eventHandler = NewAuthenticatedEventHandler(server.URL+"/datastore", "a-token", "x-token", nil, "http")
+	require.Equal(t, host+"/datastore/mongodb-datastore", eventHandler.BaseURL)
+	_, mErr = eventHandler.GetEvents(&EventFilter{Project: "my-project"})
+	require.Nil(t, mErr)
+
+	require.Equal(t, []string{"/datastore/event", "/datastore/mongodb-datastore/event"}, paths)
+}
@@ -4,6 +4,8 @@ import (
 	"fmt"
 	"net/http"
 	"net/url"
+
+	v2 "github.com/keptn/go-utils/pkg/api/utils/v2"
 )
 
 var _ KeptnInterface = (*APISet)(nil)
@@ -42,6 +44,8 @@ type APISet struct {
 	stageHandler           *StageHandler
 	uniformHandler         *UniformHandler
 	shipyardControlHandler *ShipyardControllerHandler
+	strictContext          StrictContextMode
+	basePathMode           v2.BasePathMode
 }
 
 // APIV1 retrieves the APIHandler
@@ -142,7 +146,8 @@ func WithScheme(scheme string) func(*APISet) {
 	}
 }
 
-// New creates a new APISet instance
+// New creates a new APISet instance.
+// The base paths of the Keptn services are appended to the base URL as configured via WithBasePathMode
 func New(baseURL string, options ...func(*APISet)) (*APISet, error) {
 	u, err := url.Parse(baseURL)
 	if err != nil {
@@ -165,17 +170,34 @@ func New(baseURL string, options ...func(*APISet)) (*APISet, error) {
 		}
 	}
 
-	as.apiHandler = createAuthenticatedAPIHandler(baseURL, as.apiToken, as.authHeader, as.httpClient, as.scheme)
+	as.apiHandler = createAuthenticatedAPIHandler(baseURL, as.apiToken, as.authHeader, as.httpClient, as.scheme, as.basePathMode)
 	as.authHandler = createAuthenticatedAuthHandler(baseURL, as.apiToken, as.authHeader, as.httpClient, as.scheme)
-	as.logHandler = createAuthenticatedLogHandler(baseURL, as.apiToken, as.authHeader, as.httpClient, as.scheme)
-	as.eventHandler = createAuthenticatedEventHandler(baseURL, as.apiToken, as.authHeader, as.httpClient, as.scheme)
-	as.projectHandler = createAuthenticatedProjectHandler(baseURL, as.apiToken, as.authHeader, as.httpClient, as.scheme)
-	as.resourceHandler = createAuthenticatedResourceHandler(baseURL, as.apiToken, as.authHeader, as.httpClient, as.scheme)
-	as.secretHandler = createAuthenticatedSecretHandler(baseURL, as.apiToken, as.authHeader, as.httpClient, as.scheme)
-	as.sequenceControlHandler = createAuthenticatedSequenceControlHandler(baseURL, as.apiToken, as.authHeader, as.httpClient, as.scheme)
-	as.serviceHandler = createAuthenticatedServiceHandler(baseURL, as.apiToken, as.authHeader, as.httpClient, as.scheme)
-	as.shipyardControlHandler = createAuthenticatedShipyardControllerHandler(baseURL, as.apiToken, as.authHeader, as.httpClient, as.scheme)
-	as.stageHandler = createAuthenticatedStageHandler(baseURL, as.apiToken, as.authHeader, as.httpClient, as.scheme)
-	as.uniformHandler = createAuthenticatedUniformHandler(baseURL, as.apiToken, as.authHeader, as.httpClient, as.scheme)
+	as.logHandler = createAuthenticatedLogHandler(baseURL, as.apiToken, as.authHeader, as.httpClient, as.scheme, as.basePathMode)
+	as.eventHandler = createAuthenticatedEventHandler(baseURL, as.apiToken, as.authHeader, as.httpClient, as.scheme, as.basePathMode)
+	as.projectHandler = createAuthenticatedProjectHandler(baseURL, as.apiToken, as.authHeader, as.httpClient, as.scheme, as.basePathMode)
+	as.resourceHandler = createAuthenticatedResourceHandler(baseURL, as.apiToken, as.authHeader, as.httpClient, as.scheme, as.basePathMode)
+	as.secretHandler = createAuthenticatedSecretHandler(baseURL, as.apiToken, as.authHeader, as.httpClient, as.scheme, as.basePathMode)
+	as.sequenceControlHandler = createAuthenticatedSequenceControlHandler(baseURL, as.apiToken, as.authHeader, as.httpClient, as.scheme, as.basePathMode)
+	as.serviceHandler = createAuthenticatedServiceHandler(baseURL, as.apiToken, as.authHeader, as.httpClient, as.scheme, as.basePathMode)
+	as.shipyardControlHandler = createAuthenticatedShipyardControllerHandler(baseURL, as.apiToken, as.authHeader, as.httpClient, as.scheme, as.basePathMode)
+	as.stageHandler = createAuthenticatedStageHandler(baseURL, as.apiToken, as.authHeader, as.httpClient, as.scheme, as.basePathMode)
+	as.uniformHandler = createAuthenticatedUniformHandler(baseURL, as.apiToken, as.authHeader, as.httpClient, as.scheme, as.basePathMode)
+	as.setStrictContext()
 	return as, nil
 }
+
+// setStrictContext sets the StrictContextMode of the APISet on all its handlers, see WithStrictContext
+func (c *APISet) setStrictContext() {
+	c.apiHandler.strictContext = c.strictContext
+	c.authHandler.strictContext = c.strictContext
+	c.logHandler.strictContext = c.strictContext
+	c.eventHandler.strictContext = c.strictContext
+	c.projectHandler.strictContext = c.strictContext
+	c.resourceHandler.strictContext = c.strictContext
+	c.secretHandler.strictContext = c.strictContext
+	c.sequenceControlHandler.strictContext = c.strictContext
+	c.serviceHandler.strictContext = c.strictContext
+	c.shipyardControlHandler.strictContext = c.strictContext
+	c.stageHandler.strictContext = c.strictContext
+	c.uniformHandler.strictContext = c.strictContext
+}
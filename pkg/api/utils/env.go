@@ -0,0 +1,23 @@
+package api
+
+import (
+	"fmt"
+	"os"
+
+	v2 "github.com/keptn/go-utils/pkg/api/utils/v2"
+)
+
+// NewAPISetFromEnv creates a new APISet for the Keptn API set via KEPTN_API_ENDPOINT, authenticating
+// with the token set via KEPTN_API_TOKEN. The given options are applied after the ones derived
+// from the environment, so they can be used to override them
+func NewAPISetFromEnv(options ...func(*APISet)) (*APISet, error) {
+	endpoint, scheme, err := v2.EndpointFromEnv()
+	if err != nil {
+		return nil, fmt.Errorf("unable to create apiset: %w", err)
+	}
+	envOptions := []func(*APISet){WithScheme(scheme)}
+	if token := os.Getenv(v2.EnvVarKeptnAPIToken); token != "" {
+		envOptions = append(envOptions, WithAuthToken(token))
+	}
+	return New(endpoint, append(envOptions, options...)...)
+}
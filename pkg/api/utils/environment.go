@@ -0,0 +1,100 @@
+package api
+
+import (
+	"fmt"
+	"net/http"
+	"os"
+
+	v2 "github.com/keptn/go-utils/pkg/api/utils/v2"
+)
+
+// ExecutionEnvironment is the environment an integration is running in, which determines how it reaches the Keptn API
+type ExecutionEnvironment string
+
+const (
+	// ControlPlaneEnvironment is a Kubernetes cluster running the Keptn control plane.
+	// The Keptn services are called directly via their in-cluster addresses, without authentication
+	ControlPlaneEnvironment ExecutionEnvironment = "control-plane"
+	// RemoteEnvironment is a remote execution plane, which calls the Keptn API set via KEPTN_API_ENDPOINT,
+	// authenticating with the token set via KEPTN_API_TOKEN
+	RemoteEnvironment ExecutionEnvironment = "remote"
+	// LocalEnvironment is a machine outside of any Kubernetes cluster, e.g. the one of a developer.
+	// The Keptn API is expected at DefaultLocalAPIEndpoint, e.g. forwarded via kubectl port-forward
+	LocalEnvironment ExecutionEnvironment = "local"
+)
+
+// Environment variables DetectExecutionEnvironment considers
+const (
+	// EnvVarExecutionEnvironment sets the ExecutionEnvironment explicitly, skipping the detection
+	EnvVarExecutionEnvironment = "KEPTN_EXECUTION_ENVIRONMENT"
+	// EnvVarKubernetesServiceHost is set by Kubernetes in every container
+	EnvVarKubernetesServiceHost = "KUBERNETES_SERVICE_HOST"
+)
+
+// DefaultLocalAPIEndpoint is the endpoint of the Keptn API used in the LocalEnvironment,
+// matching kubectl -n keptn port-forward service/api-gateway-nginx 8080:80
+const DefaultLocalAPIEndpoint = "http://localhost:8080/api"
+
+// serviceAccountTokenFile contains the token of the service account if the process runs in a Kubernetes pod
+var serviceAccountTokenFile = "/var/run/secrets/kubernetes.io/serviceaccount/token"
+
+// DetectExecutionEnvironment returns the ExecutionEnvironment set via KEPTN_EXECUTION_ENVIRONMENT or, if it is not set,
+// detects it: if KEPTN_API_ENDPOINT is set, the integration runs on a RemoteEnvironment; otherwise, if it runs in a
+// Kubernetes pod, i.e. KUBERNETES_SERVICE_HOST is set or a service account token is mounted, it runs in the
+// ControlPlaneEnvironment, and in the LocalEnvironment else
+func DetectExecutionEnvironment() (ExecutionEnvironment, error) {
+	if value := os.Getenv(EnvVarExecutionEnvironment); value != "" {
+		switch env := ExecutionEnvironment(value); env {
+		case ControlPlaneEnvironment, RemoteEnvironment, LocalEnvironment:
+			return env, nil
+		}
+		return "", fmt.Errorf("environment variable %s must be one of %s, %s or %s, but is %s",
+			EnvVarExecutionEnvironment, ControlPlaneEnvironment, RemoteEnvironment, LocalEnvironment, value)
+	}
+	if os.Getenv(v2.EnvVarKeptnAPIEndpoint) != "" {
+		return RemoteEnvironment, nil
+	}
+	if os.Getenv(EnvVarKubernetesServiceHost) != "" {
+		return ControlPlaneEnvironment, nil
+	}
+	if _, err := os.Stat(serviceAccountTokenFile); err == nil {
+		return ControlPlaneEnvironment, nil
+	}
+	return LocalEnvironment, nil
+}
+
+// NewKeptnAPIFromEnv creates the KeptnInterface matching the environment detected by DetectExecutionEnvironment,
+// so that the same binary can run in the control plane, on a remote execution plane and locally.
+// The given options are applied to the APISet used in the RemoteEnvironment and LocalEnvironment. If client is nil,
+// a default http client is used
+func NewKeptnAPIFromEnv(client *http.Client, options ...func(*APISet)) (KeptnInterface, error) {
+	env, err := DetectExecutionEnvironment()
+	if err != nil {
+		return nil, fmt.Errorf("unable to create apiset: %w", err)
+	}
+	return NewKeptnAPIForEnvironment(env, client, options...)
+}
+
+// NewKeptnAPIForEnvironment creates the KeptnInterface for the given ExecutionEnvironment, see NewKeptnAPIFromEnv.
+// In the LocalEnvironment, KEPTN_API_ENDPOINT overrides DefaultLocalAPIEndpoint
+func NewKeptnAPIForEnvironment(env ExecutionEnvironment, client *http.Client, options ...func(*APISet)) (KeptnInterface, error) {
+	if client == nil {
+		client = &http.Client{}
+	}
+	switch env {
+	case ControlPlaneEnvironment:
+		return NewInternal(client)
+	case RemoteEnvironment:
+		return NewAPISetFromEnv(append([]func(*APISet){WithHTTPClient(client)}, options...)...)
+	case LocalEnvironment:
+		if os.Getenv(v2.EnvVarKeptnAPIEndpoint) != "" {
+			return NewAPISetFromEnv(append([]func(*APISet){WithHTTPClient(client)}, options...)...)
+		}
+		envOptions := []func(*APISet){WithScheme("http"), WithHTTPClient(client)}
+		if token := os.Getenv(v2.EnvVarKeptnAPIToken); token != "" {
+			envOptions = append(envOptions, WithAuthToken(token))
+		}
+		return New(DefaultLocalAPIEndpoint, append(envOptions, options...)...)
+	}
+	return nil, fmt.Errorf("unable to create apiset: unknown execution environment %q", env)
+}
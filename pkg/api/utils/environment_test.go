@@ -0,0 +1,96 @@
+package api
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// unsetEnvironment clears the environment variables considered by DetectExecutionEnvironment
+// and points the service account token to a file which does not exist
+func unsetEnvironment(t *testing.T) {
+	for _, name := range []string{EnvVarExecutionEnvironment, EnvVarKubernetesServiceHost, "KEPTN_API_ENDPOINT", "KEPTN_API_TOKEN"} {
+		t.Setenv(name, "")
+	}
+	previous := serviceAccountTokenFile
+	serviceAccountTokenFile = filepath.Join(t.TempDir(), "token")
+	t.Cleanup(func() {
+		serviceAccountTokenFile = previous
+	})
+}
+
+func TestDetectExecutionEnvironment(t *testing.T) {
+	tests := []struct {
+		name                string
+		env                 map[string]string
+		serviceAccountToken bool
+		want                ExecutionEnvironment
+		wantErr             bool
+	}{
+		{name: "local", want: LocalEnvironment},
+		{name: "remote", env: map[string]string{"KEPTN_API_ENDPOINT": "https://keptn.example.com/api"}, want: RemoteEnvironment},
+		{name: "kubernetes service host", env: map[string]string{EnvVarKubernetesServiceHost: "10.0.0.1"}, want: ControlPlaneEnvironment},
+		{name: "service account token", serviceAccountToken: true, want: ControlPlaneEnvironment},
+		{
+			name: "remote execution plane in another cluster",
+			env:  map[string]string{"KEPTN_API_ENDPOINT": "https://keptn.example.com/api", EnvVarKubernetesServiceHost: "10.0.0.1"},
+			want: RemoteEnvironment,
+		},
+		{
+			name: "explicit override",
+			env:  map[string]string{EnvVarExecutionEnvironment: "local", EnvVarKubernetesServiceHost: "10.0.0.1"},
+			want: LocalEnvironment,
+		},
+		{name: "invalid override", env: map[string]string{EnvVarExecutionEnvironment: "cloud"}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			unsetEnvironment(t)
+			for name, value := range tt.env {
+				t.Setenv(name, value)
+			}
+			if tt.serviceAccountToken {
+				require.NoError(t, os.WriteFile(serviceAccountTokenFile, []byte("token"), 0600))
+			}
+			got, err := DetectExecutionEnvironment()
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestNewKeptnAPIFromEnv(t *testing.T) {
+	t.Run("control plane", func(t *testing.T) {
+		unsetEnvironment(t)
+		t.Setenv(EnvVarKubernetesServiceHost, "10.0.0.1")
+		api, err := NewKeptnAPIFromEnv(nil)
+		require.NoError(t, err)
+		assert.IsType(t, &InternalAPISet{}, api)
+	})
+	t.Run("remote", func(t *testing.T) {
+		unsetEnvironment(t)
+		t.Setenv("KEPTN_API_ENDPOINT", "https://keptn.example.com/api")
+		t.Setenv("KEPTN_API_TOKEN", "my-token")
+		api, err := NewKeptnAPIFromEnv(nil)
+		require.NoError(t, err)
+		apiSet := api.(*APISet)
+		assert.Equal(t, "https://keptn.example.com/api", apiSet.Endpoint().String())
+		assert.Equal(t, "https", apiSet.scheme)
+		assert.Equal(t, "my-token", apiSet.Token())
+	})
+	t.Run("local", func(t *testing.T) {
+		unsetEnvironment(t)
+		api, err := NewKeptnAPIFromEnv(nil, WithAuthToken("my-token"))
+		require.NoError(t, err)
+		apiSet := api.(*APISet)
+		assert.Equal(t, DefaultLocalAPIEndpoint, apiSet.Endpoint().String())
+		assert.Equal(t, "my-token", apiSet.Token())
+	})
+}
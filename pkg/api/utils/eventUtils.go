@@ -1,9 +1,8 @@
 package api
 
 import (
-	"context"
 	"net/http"
-	"strings"
+	"sync"
 	"time"
 
 	"github.com/keptn/go-utils/pkg/api/models"
@@ -22,11 +21,26 @@ type EventsV1Interface interface {
 // EventHandler handles services
 type EventHandler struct {
 	eventHandler *v2.EventHandler
-	BaseURL      string
-	AuthToken    string
-	AuthHeader   string
-	HTTPClient   *http.Client
-	Scheme       string
+
+	// Deprecated: changes to the field are not applied to the handler; create a new handler to use another base URL
+	BaseURL string
+
+	// Deprecated: use WithAuthToken to derive a handler with another token
+	AuthToken string
+
+	// Deprecated: use WithAuthToken to derive a handler with another auth header
+	AuthHeader string
+
+	// Deprecated: use WithHTTPClient to derive a handler with another http.Client
+	HTTPClient *http.Client
+
+	// Deprecated: changes to the field are not applied to the handler; create a new handler to use another scheme
+	Scheme string
+
+	once          sync.Once
+	strictContext StrictContextMode
+	basePathMode  v2.BasePathMode
+	endpoint      string
 }
 
 // EventFilter allows to filter events based on the provided properties
@@ -54,36 +68,34 @@ func NewEventHandlerWithHTTPClient(baseURL string, httpClient *http.Client) *Eve
 		HTTPClient:   httpClient,
 		Scheme:       "http",
 		eventHandler: v2.NewEventHandlerWithHTTPClient(baseURL, httpClient),
+		endpoint:     baseURL,
+		basePathMode: v2.ExactBaseURL,
 	}
 }
 
-const mongodbDatastoreServiceBaseUrl = "mongodb-datastore"
-
-// NewAuthenticatedEventHandler returns a new EventHandler that authenticates at the endpoint via the provided token
+// NewAuthenticatedEventHandler returns a new EventHandler that authenticates at the endpoint via the provided token.
+// The base path of the service is added to the base URL according to basePathMode (default v2.AppendMissingBasePath)
 // Deprecated: use APISet instead
-func NewAuthenticatedEventHandler(baseURL string, authToken string, authHeader string, httpClient *http.Client, scheme string) *EventHandler {
+func NewAuthenticatedEventHandler(baseURL string, authToken string, authHeader string, httpClient *http.Client, scheme string, basePathMode ...v2.BasePathMode) *EventHandler {
 	if httpClient == nil {
 		httpClient = &http.Client{}
 	}
 	httpClient.Transport = wrapOtelTransport(getClientTransport(httpClient.Transport))
-	return createAuthenticatedEventHandler(baseURL, authToken, authHeader, httpClient, scheme)
+	return createAuthenticatedEventHandler(baseURL, authToken, authHeader, httpClient, scheme, basePathModeOf(basePathMode))
 }
 
-func createAuthenticatedEventHandler(baseURL string, authToken string, authHeader string, httpClient *http.Client, scheme string) *EventHandler {
-	v2EventHandler := v2.NewAuthenticatedEventHandler(baseURL, authToken, authHeader, httpClient, scheme)
-
-	baseURL = strings.TrimRight(baseURL, "/")
-	if !strings.HasSuffix(baseURL, mongodbDatastoreServiceBaseUrl) {
-		baseURL += "/" + mongodbDatastoreServiceBaseUrl
-	}
+func createAuthenticatedEventHandler(baseURL string, authToken string, authHeader string, httpClient *http.Client, scheme string, basePathMode v2.BasePathMode) *EventHandler {
+	v2EventHandler := v2.NewAuthenticatedEventHandler(baseURL, authToken, authHeader, httpClient, scheme, basePathMode)
 
 	return &EventHandler{
-		BaseURL:      httputils.TrimHTTPScheme(baseURL),
+		BaseURL:      httputils.TrimHTTPScheme(basePathMode.HandlerBaseURL(baseURL, v2.HandlerEvents)),
 		AuthHeader:   authHeader,
 		AuthToken:    authToken,
 		HTTPClient:   httpClient,
 		Scheme:       scheme,
 		eventHandler: v2EventHandler,
+		basePathMode: basePathMode,
+		endpoint:     baseURL,
 	}
 }
 
@@ -106,13 +118,21 @@ func (e *EventHandler) getHTTPClient() *http.Client {
 // GetEvents returns all events matching the properties in the passed filter object.
 func (e *EventHandler) GetEvents(filter *EventFilter) ([]*models.KeptnContextExtendedCE, *models.Error) {
 	e.ensureHandlerIsSet()
-	return e.eventHandler.GetEvents(context.TODO(), toV2EventFilter(filter), v2.EventsGetEventsOptions{})
+	ctx, err := e.strictContext.implicitContext("EventHandler.GetEvents")
+	if err != nil {
+		return nil, implicitContextResponse(err)
+	}
+	return e.eventHandler.GetEvents(ctx, toV2EventFilter(filter), v2.EventsGetEventsOptions{})
 }
 
 // GetEventsWithRetry tries to retrieve events matching the passed filter.
 func (e *EventHandler) GetEventsWithRetry(filter *EventFilter, maxRetries int, retrySleepTime time.Duration) ([]*models.KeptnContextExtendedCE, error) {
 	e.ensureHandlerIsSet()
-	return e.eventHandler.GetEventsWithRetry(context.TODO(), toV2EventFilter(filter), maxRetries, retrySleepTime, v2.EventsGetEventsWithRetryOptions{})
+	ctx, err := e.strictContext.implicitContext("EventHandler.GetEventsWithRetry")
+	if err != nil {
+		return nil, err
+	}
+	return e.eventHandler.GetEventsWithRetry(ctx, toV2EventFilter(filter), maxRetries, retrySleepTime, v2.EventsGetEventsWithRetryOptions{})
 }
 
 func toV2EventFilter(filter *EventFilter) *v2.EventFilter {
@@ -130,13 +150,31 @@ func toV2EventFilter(filter *EventFilter) *v2.EventFilter {
 }
 
 func (e *EventHandler) ensureHandlerIsSet() {
-	if e.eventHandler != nil {
-		return
-	}
+	e.once.Do(func() {
+		if e.eventHandler != nil {
+			return
+		}
+
+		if e.AuthToken != "" {
+			e.eventHandler = v2.NewAuthenticatedEventHandler(e.BaseURL, e.AuthToken, e.AuthHeader, e.HTTPClient, e.Scheme)
+		} else {
+			e.eventHandler = v2.NewEventHandlerWithHTTPClient(e.BaseURL, e.HTTPClient)
+		}
+	})
+}
 
-	if e.AuthToken != "" {
-		e.eventHandler = v2.NewAuthenticatedEventHandler(e.BaseURL, e.AuthToken, e.AuthHeader, e.HTTPClient, e.Scheme)
-	} else {
-		e.eventHandler = v2.NewEventHandlerWithHTTPClient(e.BaseURL, e.HTTPClient)
-	}
+// WithAuthToken returns a new EventHandler that uses the given token and auth header but otherwise the same
+// settings. The EventHandler itself is not modified, so it can still be used concurrently
+func (e *EventHandler) WithAuthToken(authToken string, authHeader string) *EventHandler {
+	derived := createAuthenticatedEventHandler(derivedBaseURL(e.endpoint, e.BaseURL), authToken, authHeader, e.HTTPClient, e.Scheme, e.basePathMode)
+	derived.strictContext = e.strictContext
+	return derived
+}
+
+// WithHTTPClient returns a new EventHandler that uses the given http.Client but otherwise the same settings.
+// The EventHandler itself is not modified, so it can still be used concurrently
+func (e *EventHandler) WithHTTPClient(httpClient *http.Client) *EventHandler {
+	derived := createAuthenticatedEventHandler(derivedBaseURL(e.endpoint, e.BaseURL), e.AuthToken, e.AuthHeader, httpClient, e.Scheme, e.basePathMode)
+	derived.strictContext = e.strictContext
+	return derived
 }
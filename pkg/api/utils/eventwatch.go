@@ -4,7 +4,6 @@ import (
 	"context"
 	"github.com/keptn/go-utils/pkg/api/models"
 	"log"
-	"sort"
 	"time"
 )
 
@@ -128,9 +127,8 @@ type EventHandlerInterface interface {
 // EventManipulatorFunc can be used to manipulate a slice of events
 type EventManipulatorFunc func([]*models.KeptnContextExtendedCE)
 
-// SortByTime sorts the event slice by time (oldest to newest)
+// SortByTime sorts the event slice by time (oldest to newest).
+// Events with the same time are sorted by ID, see models.EventOrderKey
 func SortByTime(events []*models.KeptnContextExtendedCE) {
-	sort.Slice(events, func(i, j int) bool {
-		return events[i].Time.Before(events[j].Time)
-	})
+	models.SortEvents(events)
 }
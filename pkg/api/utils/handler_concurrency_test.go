@@ -0,0 +1,110 @@
+package api
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/keptn/go-utils/pkg/api/models"
+	v2 "github.com/keptn/go-utils/pkg/api/utils/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTokenRecordingServer(tokens chan<- string) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		tokens <- r.Header.Get("x-token")
+		w.Write([]byte(`{}`))
+	}))
+}
+
+func TestProjectHandler_ConcurrentFirstUse(t *testing.T) {
+	const numRequests = 20
+	tokens := make(chan string, numRequests)
+	server := newTokenRecordingServer(tokens)
+	defer server.Close()
+
+	handler := &ProjectHandler{
+		BaseURL:    strings.TrimPrefix(server.URL, "http://"),
+		HTTPClient: &http.Client{},
+		Scheme:     "http",
+	}
+
+	wg := sync.WaitGroup{}
+	for i := 0; i < numRequests; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := handler.GetProject(models.Project{ProjectName: "my-project"})
+			assert.Nil(t, err)
+		}()
+	}
+	wg.Wait()
+	require.Len(t, tokens, numRequests)
+}
+
+func TestProjectHandler_WithAuthToken(t *testing.T) {
+	tokens := make(chan string, 2)
+	server := newTokenRecordingServer(tokens)
+	defer server.Close()
+
+	handler := NewAuthenticatedProjectHandler(server.URL, "original-token", "x-token", &http.Client{}, "http")
+	derived := handler.WithAuthToken("derived-token", "x-token")
+	require.NotSame(t, handler, derived)
+
+	wg := sync.WaitGroup{}
+	for _, h := range []*ProjectHandler{handler, derived} {
+		wg.Add(1)
+		go func(h *ProjectHandler) {
+			defer wg.Done()
+			_, err := h.GetProject(models.Project{ProjectName: "my-project"})
+			assert.Nil(t, err)
+		}(h)
+	}
+	wg.Wait()
+	close(tokens)
+
+	received := []string{}
+	for token := range tokens {
+		received = append(received, token)
+	}
+	require.ElementsMatch(t, []string{"original-token", "derived-token"}, received)
+	require.Equal(t, "original-token", handler.AuthToken)
+	require.Equal(t, "derived-token", derived.AuthToken)
+}
+
+func TestProjectHandler_WithHTTPClient(t *testing.T) {
+	handler := NewAuthenticatedProjectHandler("localhost", "token", "x-token", &http.Client{}, "http")
+	httpClient := &http.Client{}
+	derived := handler.WithHTTPClient(httpClient)
+
+	require.NotSame(t, httpClient, handler.HTTPClient)
+	require.Same(t, httpClient, derived.HTTPClient)
+	require.Equal(t, handler.BaseURL, derived.BaseURL)
+	require.Equal(t, handler.AuthToken, derived.AuthToken)
+}
+
+func TestEventHandler_DerivedHandlerKeepsBaseURL(t *testing.T) {
+	var paths []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		paths = append(paths, r.URL.Path)
+		w.Write([]byte(`{"events":[]}`))
+	}))
+	defer server.Close()
+
+	handler := NewEventHandler(server.URL)
+	derived := handler.WithAuthToken("token", "x-token").WithHTTPClient(&http.Client{})
+	require.Equal(t, handler.BaseURL, derived.BaseURL)
+	_, mErr := derived.GetEvents(&EventFilter{Project: "my-project"})
+	require.Nil(t, mErr)
+
+	authenticated := NewAuthenticatedEventHandler(server.URL+"/api", "token", "x-token", &http.Client{}, "http", v2.AlwaysAppendBasePath)
+	derived = authenticated.WithHTTPClient(&http.Client{})
+	require.Equal(t, authenticated.BaseURL, derived.BaseURL)
+	_, mErr = derived.GetEvents(&EventFilter{Project: "my-project"})
+	require.Nil(t, mErr)
+
+	require.Equal(t, []string{"/event", "/api/mongodb-datastore/event"}, paths)
+}
@@ -3,7 +3,6 @@ package api
 import (
 	"context"
 	"net/http"
-	"strings"
 	"sync"
 	"time"
 
@@ -40,16 +39,33 @@ type ILogHandler interface {
 }
 
 type LogHandler struct {
-	logHandler   *v2.LogHandler
-	BaseURL      string
-	AuthToken    string
-	AuthHeader   string
-	HTTPClient   *http.Client
-	Scheme       string
-	LogCache     []models.LogEntry
-	TheClock     clock.Clock
-	SyncInterval time.Duration
-	lock         sync.Mutex
+	logHandler *v2.LogHandler
+
+	// Deprecated: changes to the field are not applied to the handler; create a new handler to use another base URL
+	BaseURL string
+
+	// Deprecated: use WithAuthToken to derive a handler with another token
+	AuthToken string
+
+	// Deprecated: use WithAuthToken to derive a handler with another auth header
+	AuthHeader string
+
+	// Deprecated: use WithHTTPClient to derive a handler with another http.Client
+	HTTPClient *http.Client
+
+	// Deprecated: changes to the field are not applied to the handler; create a new handler to use another scheme
+	Scheme string
+
+	// Deprecated: the cache is filled by Log and emptied by Flush and must not be accessed directly
+	LogCache []models.LogEntry
+
+	TheClock      clock.Clock
+	SyncInterval  time.Duration
+	lock          sync.Mutex
+	once          sync.Once
+	strictContext StrictContextMode
+	basePathMode  v2.BasePathMode
+	endpoint      string
 }
 
 // NewLogHandler returns a new LogHandler
@@ -67,29 +83,27 @@ func NewLogHandlerWithHTTPClient(baseURL string, httpClient *http.Client) *LogHa
 		TheClock:     clock.New(),
 		SyncInterval: defaultSyncInterval,
 		logHandler:   v2.NewLogHandlerWithHTTPClient(baseURL, httpClient),
+		endpoint:     baseURL,
+		basePathMode: v2.ExactBaseURL,
 	}
 }
 
-// NewAuthenticatedLogHandler returns a new EventHandler that authenticates at the endpoint via the provided token
+// NewAuthenticatedLogHandler returns a new EventHandler that authenticates at the endpoint via the provided token.
+// The base path of the service is added to the base URL according to basePathMode (default v2.AppendMissingBasePath)
 // Deprecated: use APISet instead
-func NewAuthenticatedLogHandler(baseURL string, authToken string, authHeader string, httpClient *http.Client, scheme string) *LogHandler {
+func NewAuthenticatedLogHandler(baseURL string, authToken string, authHeader string, httpClient *http.Client, scheme string, basePathMode ...v2.BasePathMode) *LogHandler {
 	if httpClient == nil {
 		httpClient = &http.Client{}
 	}
 	httpClient.Transport = getClientTransport(httpClient.Transport)
-	return createAuthenticatedLogHandler(baseURL, authToken, authHeader, httpClient, scheme)
+	return createAuthenticatedLogHandler(baseURL, authToken, authHeader, httpClient, scheme, basePathModeOf(basePathMode))
 }
 
-func createAuthenticatedLogHandler(baseURL string, authToken string, authHeader string, httpClient *http.Client, scheme string) *LogHandler {
-	v2LogHandler := v2.NewAuthenticatedLogHandler(baseURL, authToken, authHeader, httpClient, scheme)
-
-	baseURL = strings.TrimRight(baseURL, "/")
-	if !strings.HasSuffix(baseURL, shipyardControllerBaseURL) {
-		baseURL += "/" + shipyardControllerBaseURL
-	}
+func createAuthenticatedLogHandler(baseURL string, authToken string, authHeader string, httpClient *http.Client, scheme string, basePathMode v2.BasePathMode) *LogHandler {
+	v2LogHandler := v2.NewAuthenticatedLogHandler(baseURL, authToken, authHeader, httpClient, scheme, basePathMode)
 
 	return &LogHandler{
-		BaseURL:      httputils.TrimHTTPScheme(baseURL),
+		BaseURL:      httputils.TrimHTTPScheme(basePathMode.HandlerBaseURL(baseURL, v2.HandlerLogs)),
 		AuthHeader:   authHeader,
 		AuthToken:    authToken,
 		HTTPClient:   httpClient,
@@ -98,6 +112,8 @@ func createAuthenticatedLogHandler(baseURL string, authToken string, authHeader
 		TheClock:     clock.New(),
 		SyncInterval: defaultSyncInterval,
 		logHandler:   v2LogHandler,
+		basePathMode: basePathMode,
+		endpoint:     baseURL,
 	}
 }
 
@@ -126,13 +142,21 @@ func (lh *LogHandler) Log(logs []models.LogEntry) {
 // GetLogs gets logs with the specified parameters.
 func (lh *LogHandler) GetLogs(params models.GetLogsParams) (*models.GetLogsResponse, error) {
 	lh.ensureHandlerIsSet()
-	return lh.logHandler.GetLogs(context.TODO(), params, v2.LogsGetLogsOptions{})
+	ctx, err := lh.strictContext.implicitContext("LogHandler.GetLogs")
+	if err != nil {
+		return nil, err
+	}
+	return lh.logHandler.GetLogs(ctx, params, v2.LogsGetLogsOptions{})
 }
 
 // DeleteLogs deletes logs matching the specified log filter.
 func (lh *LogHandler) DeleteLogs(params models.LogFilter) error {
 	lh.ensureHandlerIsSet()
-	return lh.logHandler.DeleteLogs(context.TODO(), params, v2.LogsDeleteLogsOptions{})
+	ctx, err := lh.strictContext.implicitContext("LogHandler.DeleteLogs")
+	if err != nil {
+		return err
+	}
+	return lh.logHandler.DeleteLogs(ctx, params, v2.LogsDeleteLogsOptions{})
 }
 
 func (lh *LogHandler) Start(ctx context.Context) {
@@ -143,17 +167,43 @@ func (lh *LogHandler) Start(ctx context.Context) {
 // Flush flushes the log cache.
 func (lh *LogHandler) Flush() error {
 	lh.ensureHandlerIsSet()
-	return lh.logHandler.Flush(context.TODO(), v2.LogsFlushOptions{})
+	ctx, err := lh.strictContext.implicitContext("LogHandler.Flush")
+	if err != nil {
+		return err
+	}
+	return lh.logHandler.Flush(ctx, v2.LogsFlushOptions{})
 }
 
 func (lh *LogHandler) ensureHandlerIsSet() {
-	if lh.logHandler != nil {
-		return
-	}
-
-	if lh.AuthToken != "" {
-		lh.logHandler = v2.NewAuthenticatedLogHandler(lh.BaseURL, lh.AuthToken, lh.AuthHeader, lh.HTTPClient, lh.Scheme)
-	} else {
-		lh.logHandler = v2.NewLogHandlerWithHTTPClient(lh.BaseURL, lh.HTTPClient)
-	}
+	lh.once.Do(func() {
+		if lh.logHandler != nil {
+			return
+		}
+
+		if lh.AuthToken != "" {
+			lh.logHandler = v2.NewAuthenticatedLogHandler(lh.BaseURL, lh.AuthToken, lh.AuthHeader, lh.HTTPClient, lh.Scheme)
+		} else {
+			lh.logHandler = v2.NewLogHandlerWithHTTPClient(lh.BaseURL, lh.HTTPClient)
+		}
+	})
+}
+
+// WithAuthToken returns a new LogHandler that uses the given token and auth header but otherwise the same
+// settings. The LogHandler itself is not modified, so it can still be used concurrently
+func (lh *LogHandler) WithAuthToken(authToken string, authHeader string) *LogHandler {
+	derived := createAuthenticatedLogHandler(derivedBaseURL(lh.endpoint, lh.BaseURL), authToken, authHeader, lh.HTTPClient, lh.Scheme, lh.basePathMode)
+	derived.strictContext = lh.strictContext
+	derived.TheClock = lh.TheClock
+	derived.SyncInterval = lh.SyncInterval
+	return derived
+}
+
+// WithHTTPClient returns a new LogHandler that uses the given http.Client but otherwise the same settings.
+// The LogHandler itself is not modified, so it can still be used concurrently
+func (lh *LogHandler) WithHTTPClient(httpClient *http.Client) *LogHandler {
+	derived := createAuthenticatedLogHandler(derivedBaseURL(lh.endpoint, lh.BaseURL), lh.AuthToken, lh.AuthHeader, httpClient, lh.Scheme, lh.basePathMode)
+	derived.strictContext = lh.strictContext
+	derived.TheClock = lh.TheClock
+	derived.SyncInterval = lh.SyncInterval
+	return derived
 }
@@ -0,0 +1,48 @@
+// Package mocks contains mocks of the public interfaces of the Keptn API utils in pkg/api/utils/v2 and pkg/api/utils,
+// e.g. a ProjectsInterfaceMock implementing v2.ProjectsInterface and a ProjectsV1InterfaceMock implementing
+// api.ProjectsV1Interface. The mocks of the v1 interfaces are suffixed with V1 where the names of the interfaces
+// are the same in both versions, e.g. KeptnV1InterfaceMock.
+//
+// Two kinds of mocks are provided for every interface. The mocks generated with moq (github.com/matryer/moq)
+// delegate the calls to their methods to the function set for the method, e.g. GetProjectFunc, and record them,
+// so that they can be checked afterwards, e.g. via GetProjectCalls. The mocks generated with mockgen
+// (github.com/golang/mock) are prefixed with Mock, e.g. MockProjectsInterface and MockProjectsV1Interface, and are
+// created with a gomock.Controller, which checks the calls expected via EXPECT.
+//
+// The mocks are kept up to date in this module, so that integrations do not need to generate mocks of their own.
+// After changing an interface, run go generate ./pkg/api/utils/mocks/...
+package mocks
+
+//go:generate moq -pkg mocks -skip-ensure -out ./api_mock.go ../v2 APIInterface
+//go:generate moq -pkg mocks -skip-ensure -out ./auth_mock.go ../v2 AuthInterface
+//go:generate moq -pkg mocks -skip-ensure -out ./event_handler_mock.go ../v2 EventHandlerInterface
+//go:generate moq -pkg mocks -skip-ensure -out ./events_mock.go ../v2 EventsInterface
+//go:generate moq -pkg mocks -skip-ensure -out ./keptn_mock.go ../v2 KeptnInterface
+//go:generate moq -pkg mocks -skip-ensure -out ./logs_mock.go ../v2 LogsInterface
+//go:generate moq -pkg mocks -skip-ensure -out ./projects_mock.go ../v2 ProjectsInterface
+//go:generate moq -pkg mocks -skip-ensure -out ./resources_mock.go ../v2 ResourcesInterface
+//go:generate moq -pkg mocks -skip-ensure -out ./secrets_mock.go ../v2 SecretsInterface
+//go:generate moq -pkg mocks -skip-ensure -out ./sequences_mock.go ../v2 SequencesInterface
+//go:generate moq -pkg mocks -skip-ensure -out ./services_mock.go ../v2 ServicesInterface
+//go:generate moq -pkg mocks -skip-ensure -out ./shipyard_control_mock.go ../v2 ShipyardControlInterface
+//go:generate moq -pkg mocks -skip-ensure -out ./sleeper_mock.go ../v2 Sleeper
+//go:generate moq -pkg mocks -skip-ensure -out ./stages_mock.go ../v2 StagesInterface
+//go:generate moq -pkg mocks -skip-ensure -out ./token_provider_mock.go ../v2 TokenProvider
+//go:generate moq -pkg mocks -skip-ensure -out ./uniform_mock.go ../v2 UniformInterface
+//go:generate moq -pkg mocks -skip-ensure -out ./api_v1_mock.go .. APIV1Interface
+//go:generate moq -pkg mocks -skip-ensure -out ./auth_v1_mock.go .. AuthV1Interface
+//go:generate moq -pkg mocks -skip-ensure -out ./event_handler_v1_mock.go .. EventHandlerInterface:EventHandlerV1InterfaceMock
+//go:generate moq -pkg mocks -skip-ensure -out ./events_v1_mock.go .. EventsV1Interface
+//go:generate moq -pkg mocks -skip-ensure -out ./keptn_v1_mock.go .. KeptnInterface:KeptnV1InterfaceMock
+//go:generate moq -pkg mocks -skip-ensure -out ./logs_v1_mock.go .. LogsV1Interface
+//go:generate moq -pkg mocks -skip-ensure -out ./projects_v1_mock.go .. ProjectsV1Interface
+//go:generate moq -pkg mocks -skip-ensure -out ./resources_v1_mock.go .. ResourcesV1Interface
+//go:generate moq -pkg mocks -skip-ensure -out ./secrets_v1_mock.go .. SecretsV1Interface
+//go:generate moq -pkg mocks -skip-ensure -out ./sequences_v1_mock.go .. SequencesV1Interface
+//go:generate moq -pkg mocks -skip-ensure -out ./services_v1_mock.go .. ServicesV1Interface
+//go:generate moq -pkg mocks -skip-ensure -out ./shipyard_control_v1_mock.go .. ShipyardControlV1Interface
+//go:generate moq -pkg mocks -skip-ensure -out ./sleeper_v1_mock.go .. Sleeper:SleeperV1Mock
+//go:generate moq -pkg mocks -skip-ensure -out ./stages_v1_mock.go .. StagesV1Interface
+//go:generate moq -pkg mocks -skip-ensure -out ./uniform_v1_mock.go .. UniformV1Interface
+//go:generate mockgen -destination=./gomock_mock.go -package=mocks github.com/keptn/go-utils/pkg/api/utils/v2 APIInterface,AuthInterface,EventHandlerInterface,EventsInterface,KeptnInterface,LogsInterface,ProjectsInterface,ResourcesInterface,SecretsInterface,SequencesInterface,ServicesInterface,ShipyardControlInterface,Sleeper,StagesInterface,TokenProvider,UniformInterface
+//go:generate mockgen -destination=./gomock_v1_mock.go -package=mocks -mock_names=EventHandlerInterface=MockEventHandlerV1Interface,KeptnInterface=MockKeptnV1Interface,Sleeper=MockSleeperV1 github.com/keptn/go-utils/pkg/api/utils APIV1Interface,AuthV1Interface,EventHandlerInterface,EventsV1Interface,KeptnInterface,LogsV1Interface,ProjectsV1Interface,ResourcesV1Interface,SecretsV1Interface,SequencesV1Interface,ServicesV1Interface,ShipyardControlV1Interface,Sleeper,StagesV1Interface,UniformV1Interface
@@ -0,0 +1,1344 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/keptn/go-utils/pkg/api/utils/v2 (interfaces: APIInterface,AuthInterface,EventHandlerInterface,EventsInterface,KeptnInterface,LogsInterface,ProjectsInterface,ResourcesInterface,SecretsInterface,SequencesInterface,ServicesInterface,ShipyardControlInterface,Sleeper,StagesInterface,TokenProvider,UniformInterface)
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	gomock "github.com/golang/mock/gomock"
+	models "github.com/keptn/go-utils/pkg/api/models"
+	v2 "github.com/keptn/go-utils/pkg/api/utils/v2"
+)
+
+// MockAPIInterface is a mock of APIInterface interface.
+type MockAPIInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockAPIInterfaceMockRecorder
+}
+
+// MockAPIInterfaceMockRecorder is the mock recorder for MockAPIInterface.
+type MockAPIInterfaceMockRecorder struct {
+	mock *MockAPIInterface
+}
+
+// NewMockAPIInterface creates a new mock instance.
+func NewMockAPIInterface(ctrl *gomock.Controller) *MockAPIInterface {
+	mock := &MockAPIInterface{ctrl: ctrl}
+	mock.recorder = &MockAPIInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAPIInterface) EXPECT() *MockAPIInterfaceMockRecorder {
+	return m.recorder
+}
+
+// CreateProject mocks base method.
+func (m *MockAPIInterface) CreateProject(arg0 context.Context, arg1 models.CreateProject, arg2 v2.APICreateProjectOptions) (string, *models.Error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateProject", arg0, arg1, arg2)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(*models.Error)
+	return ret0, ret1
+}
+
+// CreateProject indicates an expected call of CreateProject.
+func (mr *MockAPIInterfaceMockRecorder) CreateProject(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateProject", reflect.TypeOf((*MockAPIInterface)(nil).CreateProject), arg0, arg1, arg2)
+}
+
+// CreateService mocks base method.
+func (m *MockAPIInterface) CreateService(arg0 context.Context, arg1 string, arg2 models.CreateService, arg3 v2.APICreateServiceOptions) (string, *models.Error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateService", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(*models.Error)
+	return ret0, ret1
+}
+
+// CreateService indicates an expected call of CreateService.
+func (mr *MockAPIInterfaceMockRecorder) CreateService(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateService", reflect.TypeOf((*MockAPIInterface)(nil).CreateService), arg0, arg1, arg2, arg3)
+}
+
+// DeleteProject mocks base method.
+func (m *MockAPIInterface) DeleteProject(arg0 context.Context, arg1 models.Project, arg2 v2.APIDeleteProjectOptions) (*models.DeleteProjectResponse, *models.Error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteProject", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*models.DeleteProjectResponse)
+	ret1, _ := ret[1].(*models.Error)
+	return ret0, ret1
+}
+
+// DeleteProject indicates an expected call of DeleteProject.
+func (mr *MockAPIInterfaceMockRecorder) DeleteProject(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteProject", reflect.TypeOf((*MockAPIInterface)(nil).DeleteProject), arg0, arg1, arg2)
+}
+
+// DeleteService mocks base method.
+func (m *MockAPIInterface) DeleteService(arg0 context.Context, arg1, arg2 string, arg3 v2.APIDeleteServiceOptions) (*models.DeleteServiceResponse, *models.Error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteService", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(*models.DeleteServiceResponse)
+	ret1, _ := ret[1].(*models.Error)
+	return ret0, ret1
+}
+
+// DeleteService indicates an expected call of DeleteService.
+func (mr *MockAPIInterfaceMockRecorder) DeleteService(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteService", reflect.TypeOf((*MockAPIInterface)(nil).DeleteService), arg0, arg1, arg2, arg3)
+}
+
+// GetMetadata mocks base method.
+func (m *MockAPIInterface) GetMetadata(arg0 context.Context, arg1 v2.APIGetMetadataOptions) (*models.Metadata, *models.Error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMetadata", arg0, arg1)
+	ret0, _ := ret[0].(*models.Metadata)
+	ret1, _ := ret[1].(*models.Error)
+	return ret0, ret1
+}
+
+// GetMetadata indicates an expected call of GetMetadata.
+func (mr *MockAPIInterfaceMockRecorder) GetMetadata(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMetadata", reflect.TypeOf((*MockAPIInterface)(nil).GetMetadata), arg0, arg1)
+}
+
+// SendEvent mocks base method.
+func (m *MockAPIInterface) SendEvent(arg0 context.Context, arg1 models.KeptnContextExtendedCE, arg2 v2.APISendEventOptions) (*models.EventContext, *models.Error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendEvent", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*models.EventContext)
+	ret1, _ := ret[1].(*models.Error)
+	return ret0, ret1
+}
+
+// SendEvent indicates an expected call of SendEvent.
+func (mr *MockAPIInterfaceMockRecorder) SendEvent(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendEvent", reflect.TypeOf((*MockAPIInterface)(nil).SendEvent), arg0, arg1, arg2)
+}
+
+// TriggerEvaluation mocks base method.
+func (m *MockAPIInterface) TriggerEvaluation(arg0 context.Context, arg1, arg2, arg3 string, arg4 models.Evaluation, arg5 v2.APITriggerEvaluationOptions) (*models.EventContext, *models.Error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TriggerEvaluation", arg0, arg1, arg2, arg3, arg4, arg5)
+	ret0, _ := ret[0].(*models.EventContext)
+	ret1, _ := ret[1].(*models.Error)
+	return ret0, ret1
+}
+
+// TriggerEvaluation indicates an expected call of TriggerEvaluation.
+func (mr *MockAPIInterfaceMockRecorder) TriggerEvaluation(arg0, arg1, arg2, arg3, arg4, arg5 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TriggerEvaluation", reflect.TypeOf((*MockAPIInterface)(nil).TriggerEvaluation), arg0, arg1, arg2, arg3, arg4, arg5)
+}
+
+// UpdateProject mocks base method.
+func (m *MockAPIInterface) UpdateProject(arg0 context.Context, arg1 models.CreateProject, arg2 v2.APIUpdateProjectOptions) (string, *models.Error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateProject", arg0, arg1, arg2)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(*models.Error)
+	return ret0, ret1
+}
+
+// UpdateProject indicates an expected call of UpdateProject.
+func (mr *MockAPIInterfaceMockRecorder) UpdateProject(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateProject", reflect.TypeOf((*MockAPIInterface)(nil).UpdateProject), arg0, arg1, arg2)
+}
+
+// MockAuthInterface is a mock of AuthInterface interface.
+type MockAuthInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockAuthInterfaceMockRecorder
+}
+
+// MockAuthInterfaceMockRecorder is the mock recorder for MockAuthInterface.
+type MockAuthInterfaceMockRecorder struct {
+	mock *MockAuthInterface
+}
+
+// NewMockAuthInterface creates a new mock instance.
+func NewMockAuthInterface(ctrl *gomock.Controller) *MockAuthInterface {
+	mock := &MockAuthInterface{ctrl: ctrl}
+	mock.recorder = &MockAuthInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAuthInterface) EXPECT() *MockAuthInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Authenticate mocks base method.
+func (m *MockAuthInterface) Authenticate(arg0 context.Context, arg1 v2.AuthAuthenticateOptions) (*models.EventContext, *models.Error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Authenticate", arg0, arg1)
+	ret0, _ := ret[0].(*models.EventContext)
+	ret1, _ := ret[1].(*models.Error)
+	return ret0, ret1
+}
+
+// Authenticate indicates an expected call of Authenticate.
+func (mr *MockAuthInterfaceMockRecorder) Authenticate(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Authenticate", reflect.TypeOf((*MockAuthInterface)(nil).Authenticate), arg0, arg1)
+}
+
+// MockEventHandlerInterface is a mock of EventHandlerInterface interface.
+type MockEventHandlerInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockEventHandlerInterfaceMockRecorder
+}
+
+// MockEventHandlerInterfaceMockRecorder is the mock recorder for MockEventHandlerInterface.
+type MockEventHandlerInterfaceMockRecorder struct {
+	mock *MockEventHandlerInterface
+}
+
+// NewMockEventHandlerInterface creates a new mock instance.
+func NewMockEventHandlerInterface(ctrl *gomock.Controller) *MockEventHandlerInterface {
+	mock := &MockEventHandlerInterface{ctrl: ctrl}
+	mock.recorder = &MockEventHandlerInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockEventHandlerInterface) EXPECT() *MockEventHandlerInterfaceMockRecorder {
+	return m.recorder
+}
+
+// GetEvents mocks base method.
+func (m *MockEventHandlerInterface) GetEvents(arg0 *v2.EventFilter) ([]*models.KeptnContextExtendedCE, *models.Error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEvents", arg0)
+	ret0, _ := ret[0].([]*models.KeptnContextExtendedCE)
+	ret1, _ := ret[1].(*models.Error)
+	return ret0, ret1
+}
+
+// GetEvents indicates an expected call of GetEvents.
+func (mr *MockEventHandlerInterfaceMockRecorder) GetEvents(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEvents", reflect.TypeOf((*MockEventHandlerInterface)(nil).GetEvents), arg0)
+}
+
+// GetEventsWithRetry mocks base method.
+func (m *MockEventHandlerInterface) GetEventsWithRetry(arg0 *v2.EventFilter, arg1 int, arg2 time.Duration) ([]*models.KeptnContextExtendedCE, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEventsWithRetry", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]*models.KeptnContextExtendedCE)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetEventsWithRetry indicates an expected call of GetEventsWithRetry.
+func (mr *MockEventHandlerInterfaceMockRecorder) GetEventsWithRetry(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEventsWithRetry", reflect.TypeOf((*MockEventHandlerInterface)(nil).GetEventsWithRetry), arg0, arg1, arg2)
+}
+
+// MockEventsInterface is a mock of EventsInterface interface.
+type MockEventsInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockEventsInterfaceMockRecorder
+}
+
+// MockEventsInterfaceMockRecorder is the mock recorder for MockEventsInterface.
+type MockEventsInterfaceMockRecorder struct {
+	mock *MockEventsInterface
+}
+
+// NewMockEventsInterface creates a new mock instance.
+func NewMockEventsInterface(ctrl *gomock.Controller) *MockEventsInterface {
+	mock := &MockEventsInterface{ctrl: ctrl}
+	mock.recorder = &MockEventsInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockEventsInterface) EXPECT() *MockEventsInterfaceMockRecorder {
+	return m.recorder
+}
+
+// GetEvents mocks base method.
+func (m *MockEventsInterface) GetEvents(arg0 context.Context, arg1 *v2.EventFilter, arg2 v2.EventsGetEventsOptions) ([]*models.KeptnContextExtendedCE, *models.Error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEvents", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]*models.KeptnContextExtendedCE)
+	ret1, _ := ret[1].(*models.Error)
+	return ret0, ret1
+}
+
+// GetEvents indicates an expected call of GetEvents.
+func (mr *MockEventsInterfaceMockRecorder) GetEvents(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEvents", reflect.TypeOf((*MockEventsInterface)(nil).GetEvents), arg0, arg1, arg2)
+}
+
+// GetEventsWithRetry mocks base method.
+func (m *MockEventsInterface) GetEventsWithRetry(arg0 context.Context, arg1 *v2.EventFilter, arg2 int, arg3 time.Duration, arg4 v2.EventsGetEventsWithRetryOptions) ([]*models.KeptnContextExtendedCE, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEventsWithRetry", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].([]*models.KeptnContextExtendedCE)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetEventsWithRetry indicates an expected call of GetEventsWithRetry.
+func (mr *MockEventsInterfaceMockRecorder) GetEventsWithRetry(arg0, arg1, arg2, arg3, arg4 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEventsWithRetry", reflect.TypeOf((*MockEventsInterface)(nil).GetEventsWithRetry), arg0, arg1, arg2, arg3, arg4)
+}
+
+// MockKeptnInterface is a mock of KeptnInterface interface.
+type MockKeptnInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockKeptnInterfaceMockRecorder
+}
+
+// MockKeptnInterfaceMockRecorder is the mock recorder for MockKeptnInterface.
+type MockKeptnInterfaceMockRecorder struct {
+	mock *MockKeptnInterface
+}
+
+// NewMockKeptnInterface creates a new mock instance.
+func NewMockKeptnInterface(ctrl *gomock.Controller) *MockKeptnInterface {
+	mock := &MockKeptnInterface{ctrl: ctrl}
+	mock.recorder = &MockKeptnInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockKeptnInterface) EXPECT() *MockKeptnInterfaceMockRecorder {
+	return m.recorder
+}
+
+// API mocks base method.
+func (m *MockKeptnInterface) API() v2.APIInterface {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "API")
+	ret0, _ := ret[0].(v2.APIInterface)
+	return ret0
+}
+
+// API indicates an expected call of API.
+func (mr *MockKeptnInterfaceMockRecorder) API() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "API", reflect.TypeOf((*MockKeptnInterface)(nil).API))
+}
+
+// Auth mocks base method.
+func (m *MockKeptnInterface) Auth() v2.AuthInterface {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Auth")
+	ret0, _ := ret[0].(v2.AuthInterface)
+	return ret0
+}
+
+// Auth indicates an expected call of Auth.
+func (mr *MockKeptnInterfaceMockRecorder) Auth() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Auth", reflect.TypeOf((*MockKeptnInterface)(nil).Auth))
+}
+
+// Events mocks base method.
+func (m *MockKeptnInterface) Events() v2.EventsInterface {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Events")
+	ret0, _ := ret[0].(v2.EventsInterface)
+	return ret0
+}
+
+// Events indicates an expected call of Events.
+func (mr *MockKeptnInterfaceMockRecorder) Events() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Events", reflect.TypeOf((*MockKeptnInterface)(nil).Events))
+}
+
+// Logs mocks base method.
+func (m *MockKeptnInterface) Logs() v2.LogsInterface {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Logs")
+	ret0, _ := ret[0].(v2.LogsInterface)
+	return ret0
+}
+
+// Logs indicates an expected call of Logs.
+func (mr *MockKeptnInterfaceMockRecorder) Logs() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Logs", reflect.TypeOf((*MockKeptnInterface)(nil).Logs))
+}
+
+// Projects mocks base method.
+func (m *MockKeptnInterface) Projects() v2.ProjectsInterface {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Projects")
+	ret0, _ := ret[0].(v2.ProjectsInterface)
+	return ret0
+}
+
+// Projects indicates an expected call of Projects.
+func (mr *MockKeptnInterfaceMockRecorder) Projects() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Projects", reflect.TypeOf((*MockKeptnInterface)(nil).Projects))
+}
+
+// Resources mocks base method.
+func (m *MockKeptnInterface) Resources() v2.ResourcesInterface {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Resources")
+	ret0, _ := ret[0].(v2.ResourcesInterface)
+	return ret0
+}
+
+// Resources indicates an expected call of Resources.
+func (mr *MockKeptnInterfaceMockRecorder) Resources() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Resources", reflect.TypeOf((*MockKeptnInterface)(nil).Resources))
+}
+
+// Secrets mocks base method.
+func (m *MockKeptnInterface) Secrets() v2.SecretsInterface {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Secrets")
+	ret0, _ := ret[0].(v2.SecretsInterface)
+	return ret0
+}
+
+// Secrets indicates an expected call of Secrets.
+func (mr *MockKeptnInterfaceMockRecorder) Secrets() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Secrets", reflect.TypeOf((*MockKeptnInterface)(nil).Secrets))
+}
+
+// Sequences mocks base method.
+func (m *MockKeptnInterface) Sequences() v2.SequencesInterface {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Sequences")
+	ret0, _ := ret[0].(v2.SequencesInterface)
+	return ret0
+}
+
+// Sequences indicates an expected call of Sequences.
+func (mr *MockKeptnInterfaceMockRecorder) Sequences() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Sequences", reflect.TypeOf((*MockKeptnInterface)(nil).Sequences))
+}
+
+// Services mocks base method.
+func (m *MockKeptnInterface) Services() v2.ServicesInterface {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Services")
+	ret0, _ := ret[0].(v2.ServicesInterface)
+	return ret0
+}
+
+// Services indicates an expected call of Services.
+func (mr *MockKeptnInterfaceMockRecorder) Services() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Services", reflect.TypeOf((*MockKeptnInterface)(nil).Services))
+}
+
+// ShipyardControl mocks base method.
+func (m *MockKeptnInterface) ShipyardControl() v2.ShipyardControlInterface {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ShipyardControl")
+	ret0, _ := ret[0].(v2.ShipyardControlInterface)
+	return ret0
+}
+
+// ShipyardControl indicates an expected call of ShipyardControl.
+func (mr *MockKeptnInterfaceMockRecorder) ShipyardControl() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ShipyardControl", reflect.TypeOf((*MockKeptnInterface)(nil).ShipyardControl))
+}
+
+// Stages mocks base method.
+func (m *MockKeptnInterface) Stages() v2.StagesInterface {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Stages")
+	ret0, _ := ret[0].(v2.StagesInterface)
+	return ret0
+}
+
+// Stages indicates an expected call of Stages.
+func (mr *MockKeptnInterfaceMockRecorder) Stages() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Stages", reflect.TypeOf((*MockKeptnInterface)(nil).Stages))
+}
+
+// Uniform mocks base method.
+func (m *MockKeptnInterface) Uniform() v2.UniformInterface {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Uniform")
+	ret0, _ := ret[0].(v2.UniformInterface)
+	return ret0
+}
+
+// Uniform indicates an expected call of Uniform.
+func (mr *MockKeptnInterfaceMockRecorder) Uniform() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Uniform", reflect.TypeOf((*MockKeptnInterface)(nil).Uniform))
+}
+
+// MockLogsInterface is a mock of LogsInterface interface.
+type MockLogsInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockLogsInterfaceMockRecorder
+}
+
+// MockLogsInterfaceMockRecorder is the mock recorder for MockLogsInterface.
+type MockLogsInterfaceMockRecorder struct {
+	mock *MockLogsInterface
+}
+
+// NewMockLogsInterface creates a new mock instance.
+func NewMockLogsInterface(ctrl *gomock.Controller) *MockLogsInterface {
+	mock := &MockLogsInterface{ctrl: ctrl}
+	mock.recorder = &MockLogsInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockLogsInterface) EXPECT() *MockLogsInterfaceMockRecorder {
+	return m.recorder
+}
+
+// DeleteLogs mocks base method.
+func (m *MockLogsInterface) DeleteLogs(arg0 context.Context, arg1 models.LogFilter, arg2 v2.LogsDeleteLogsOptions) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteLogs", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteLogs indicates an expected call of DeleteLogs.
+func (mr *MockLogsInterfaceMockRecorder) DeleteLogs(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteLogs", reflect.TypeOf((*MockLogsInterface)(nil).DeleteLogs), arg0, arg1, arg2)
+}
+
+// Flush mocks base method.
+func (m *MockLogsInterface) Flush(arg0 context.Context, arg1 v2.LogsFlushOptions) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Flush", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Flush indicates an expected call of Flush.
+func (mr *MockLogsInterfaceMockRecorder) Flush(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Flush", reflect.TypeOf((*MockLogsInterface)(nil).Flush), arg0, arg1)
+}
+
+// GetLogs mocks base method.
+func (m *MockLogsInterface) GetLogs(arg0 context.Context, arg1 models.GetLogsParams, arg2 v2.LogsGetLogsOptions) (*models.GetLogsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLogs", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*models.GetLogsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLogs indicates an expected call of GetLogs.
+func (mr *MockLogsInterfaceMockRecorder) GetLogs(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLogs", reflect.TypeOf((*MockLogsInterface)(nil).GetLogs), arg0, arg1, arg2)
+}
+
+// Log mocks base method.
+func (m *MockLogsInterface) Log(arg0 []models.LogEntry, arg1 v2.LogsLogOptions) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Log", arg0, arg1)
+}
+
+// Log indicates an expected call of Log.
+func (mr *MockLogsInterfaceMockRecorder) Log(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Log", reflect.TypeOf((*MockLogsInterface)(nil).Log), arg0, arg1)
+}
+
+// Start mocks base method.
+func (m *MockLogsInterface) Start(arg0 context.Context, arg1 v2.LogsStartOptions) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Start", arg0, arg1)
+}
+
+// Start indicates an expected call of Start.
+func (mr *MockLogsInterfaceMockRecorder) Start(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Start", reflect.TypeOf((*MockLogsInterface)(nil).Start), arg0, arg1)
+}
+
+// MockProjectsInterface is a mock of ProjectsInterface interface.
+type MockProjectsInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockProjectsInterfaceMockRecorder
+}
+
+// MockProjectsInterfaceMockRecorder is the mock recorder for MockProjectsInterface.
+type MockProjectsInterfaceMockRecorder struct {
+	mock *MockProjectsInterface
+}
+
+// NewMockProjectsInterface creates a new mock instance.
+func NewMockProjectsInterface(ctrl *gomock.Controller) *MockProjectsInterface {
+	mock := &MockProjectsInterface{ctrl: ctrl}
+	mock.recorder = &MockProjectsInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockProjectsInterface) EXPECT() *MockProjectsInterfaceMockRecorder {
+	return m.recorder
+}
+
+// CreateProject mocks base method.
+func (m *MockProjectsInterface) CreateProject(arg0 context.Context, arg1 models.Project, arg2 v2.ProjectsCreateProjectOptions) (*models.EventContext, *models.Error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateProject", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*models.EventContext)
+	ret1, _ := ret[1].(*models.Error)
+	return ret0, ret1
+}
+
+// CreateProject indicates an expected call of CreateProject.
+func (mr *MockProjectsInterfaceMockRecorder) CreateProject(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateProject", reflect.TypeOf((*MockProjectsInterface)(nil).CreateProject), arg0, arg1, arg2)
+}
+
+// DeleteProject mocks base method.
+func (m *MockProjectsInterface) DeleteProject(arg0 context.Context, arg1 models.Project, arg2 v2.ProjectsDeleteProjectOptions) (*models.EventContext, *models.Error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteProject", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*models.EventContext)
+	ret1, _ := ret[1].(*models.Error)
+	return ret0, ret1
+}
+
+// DeleteProject indicates an expected call of DeleteProject.
+func (mr *MockProjectsInterfaceMockRecorder) DeleteProject(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteProject", reflect.TypeOf((*MockProjectsInterface)(nil).DeleteProject), arg0, arg1, arg2)
+}
+
+// GetAllProjects mocks base method.
+func (m *MockProjectsInterface) GetAllProjects(arg0 context.Context, arg1 v2.ProjectsGetAllProjectsOptions) ([]*models.Project, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllProjects", arg0, arg1)
+	ret0, _ := ret[0].([]*models.Project)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAllProjects indicates an expected call of GetAllProjects.
+func (mr *MockProjectsInterfaceMockRecorder) GetAllProjects(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllProjects", reflect.TypeOf((*MockProjectsInterface)(nil).GetAllProjects), arg0, arg1)
+}
+
+// GetProject mocks base method.
+func (m *MockProjectsInterface) GetProject(arg0 context.Context, arg1 models.Project, arg2 v2.ProjectsGetProjectOptions) (*models.Project, *models.Error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetProject", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*models.Project)
+	ret1, _ := ret[1].(*models.Error)
+	return ret0, ret1
+}
+
+// GetProject indicates an expected call of GetProject.
+func (mr *MockProjectsInterfaceMockRecorder) GetProject(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProject", reflect.TypeOf((*MockProjectsInterface)(nil).GetProject), arg0, arg1, arg2)
+}
+
+// UpdateConfigurationServiceProject mocks base method.
+func (m *MockProjectsInterface) UpdateConfigurationServiceProject(arg0 context.Context, arg1 models.Project, arg2 v2.ProjectsUpdateConfigurationServiceProjectOptions) (*models.EventContext, *models.Error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateConfigurationServiceProject", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*models.EventContext)
+	ret1, _ := ret[1].(*models.Error)
+	return ret0, ret1
+}
+
+// UpdateConfigurationServiceProject indicates an expected call of UpdateConfigurationServiceProject.
+func (mr *MockProjectsInterfaceMockRecorder) UpdateConfigurationServiceProject(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateConfigurationServiceProject", reflect.TypeOf((*MockProjectsInterface)(nil).UpdateConfigurationServiceProject), arg0, arg1, arg2)
+}
+
+// MockResourcesInterface is a mock of ResourcesInterface interface.
+type MockResourcesInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockResourcesInterfaceMockRecorder
+}
+
+// MockResourcesInterfaceMockRecorder is the mock recorder for MockResourcesInterface.
+type MockResourcesInterfaceMockRecorder struct {
+	mock *MockResourcesInterface
+}
+
+// NewMockResourcesInterface creates a new mock instance.
+func NewMockResourcesInterface(ctrl *gomock.Controller) *MockResourcesInterface {
+	mock := &MockResourcesInterface{ctrl: ctrl}
+	mock.recorder = &MockResourcesInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockResourcesInterface) EXPECT() *MockResourcesInterfaceMockRecorder {
+	return m.recorder
+}
+
+// CreateProjectResources mocks base method.
+func (m *MockResourcesInterface) CreateProjectResources(arg0 context.Context, arg1 string, arg2 []*models.Resource, arg3 v2.ResourcesCreateProjectResourcesOptions) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateProjectResources", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateProjectResources indicates an expected call of CreateProjectResources.
+func (mr *MockResourcesInterfaceMockRecorder) CreateProjectResources(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateProjectResources", reflect.TypeOf((*MockResourcesInterface)(nil).CreateProjectResources), arg0, arg1, arg2, arg3)
+}
+
+// CreateResource mocks base method.
+func (m *MockResourcesInterface) CreateResource(arg0 context.Context, arg1 []*models.Resource, arg2 v2.ResourceScope, arg3 v2.ResourcesCreateResourceOptions) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateResource", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateResource indicates an expected call of CreateResource.
+func (mr *MockResourcesInterfaceMockRecorder) CreateResource(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateResource", reflect.TypeOf((*MockResourcesInterface)(nil).CreateResource), arg0, arg1, arg2, arg3)
+}
+
+// CreateResources mocks base method.
+func (m *MockResourcesInterface) CreateResources(arg0 context.Context, arg1, arg2, arg3 string, arg4 []*models.Resource, arg5 v2.ResourcesCreateResourcesOptions) (*models.EventContext, *models.Error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateResources", arg0, arg1, arg2, arg3, arg4, arg5)
+	ret0, _ := ret[0].(*models.EventContext)
+	ret1, _ := ret[1].(*models.Error)
+	return ret0, ret1
+}
+
+// CreateResources indicates an expected call of CreateResources.
+func (mr *MockResourcesInterfaceMockRecorder) CreateResources(arg0, arg1, arg2, arg3, arg4, arg5 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateResources", reflect.TypeOf((*MockResourcesInterface)(nil).CreateResources), arg0, arg1, arg2, arg3, arg4, arg5)
+}
+
+// DeleteResource mocks base method.
+func (m *MockResourcesInterface) DeleteResource(arg0 context.Context, arg1 v2.ResourceScope, arg2 v2.ResourcesDeleteResourceOptions) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteResource", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteResource indicates an expected call of DeleteResource.
+func (mr *MockResourcesInterfaceMockRecorder) DeleteResource(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteResource", reflect.TypeOf((*MockResourcesInterface)(nil).DeleteResource), arg0, arg1, arg2)
+}
+
+// GetAllServiceResources mocks base method.
+func (m *MockResourcesInterface) GetAllServiceResources(arg0 context.Context, arg1, arg2, arg3 string, arg4 v2.ResourcesGetAllServiceResourcesOptions) ([]*models.Resource, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllServiceResources", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].([]*models.Resource)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAllServiceResources indicates an expected call of GetAllServiceResources.
+func (mr *MockResourcesInterfaceMockRecorder) GetAllServiceResources(arg0, arg1, arg2, arg3, arg4 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllServiceResources", reflect.TypeOf((*MockResourcesInterface)(nil).GetAllServiceResources), arg0, arg1, arg2, arg3, arg4)
+}
+
+// GetAllStageResources mocks base method.
+func (m *MockResourcesInterface) GetAllStageResources(arg0 context.Context, arg1, arg2 string, arg3 v2.ResourcesGetAllStageResourcesOptions) ([]*models.Resource, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllStageResources", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]*models.Resource)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAllStageResources indicates an expected call of GetAllStageResources.
+func (mr *MockResourcesInterfaceMockRecorder) GetAllStageResources(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllStageResources", reflect.TypeOf((*MockResourcesInterface)(nil).GetAllStageResources), arg0, arg1, arg2, arg3)
+}
+
+// GetResource mocks base method.
+func (m *MockResourcesInterface) GetResource(arg0 context.Context, arg1 v2.ResourceScope, arg2 v2.ResourcesGetResourceOptions) (*models.Resource, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetResource", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*models.Resource)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetResource indicates an expected call of GetResource.
+func (mr *MockResourcesInterfaceMockRecorder) GetResource(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetResource", reflect.TypeOf((*MockResourcesInterface)(nil).GetResource), arg0, arg1, arg2)
+}
+
+// UpdateProjectResources mocks base method.
+func (m *MockResourcesInterface) UpdateProjectResources(arg0 context.Context, arg1 string, arg2 []*models.Resource, arg3 v2.ResourcesUpdateProjectResourcesOptions) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateProjectResources", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateProjectResources indicates an expected call of UpdateProjectResources.
+func (mr *MockResourcesInterfaceMockRecorder) UpdateProjectResources(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateProjectResources", reflect.TypeOf((*MockResourcesInterface)(nil).UpdateProjectResources), arg0, arg1, arg2, arg3)
+}
+
+// UpdateResource mocks base method.
+func (m *MockResourcesInterface) UpdateResource(arg0 context.Context, arg1 *models.Resource, arg2 v2.ResourceScope, arg3 v2.ResourcesUpdateResourceOptions) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateResource", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateResource indicates an expected call of UpdateResource.
+func (mr *MockResourcesInterfaceMockRecorder) UpdateResource(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateResource", reflect.TypeOf((*MockResourcesInterface)(nil).UpdateResource), arg0, arg1, arg2, arg3)
+}
+
+// UpdateServiceResources mocks base method.
+func (m *MockResourcesInterface) UpdateServiceResources(arg0 context.Context, arg1, arg2, arg3 string, arg4 []*models.Resource, arg5 v2.ResourcesUpdateServiceResourcesOptions) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateServiceResources", arg0, arg1, arg2, arg3, arg4, arg5)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateServiceResources indicates an expected call of UpdateServiceResources.
+func (mr *MockResourcesInterfaceMockRecorder) UpdateServiceResources(arg0, arg1, arg2, arg3, arg4, arg5 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateServiceResources", reflect.TypeOf((*MockResourcesInterface)(nil).UpdateServiceResources), arg0, arg1, arg2, arg3, arg4, arg5)
+}
+
+// MockSecretsInterface is a mock of SecretsInterface interface.
+type MockSecretsInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockSecretsInterfaceMockRecorder
+}
+
+// MockSecretsInterfaceMockRecorder is the mock recorder for MockSecretsInterface.
+type MockSecretsInterfaceMockRecorder struct {
+	mock *MockSecretsInterface
+}
+
+// NewMockSecretsInterface creates a new mock instance.
+func NewMockSecretsInterface(ctrl *gomock.Controller) *MockSecretsInterface {
+	mock := &MockSecretsInterface{ctrl: ctrl}
+	mock.recorder = &MockSecretsInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSecretsInterface) EXPECT() *MockSecretsInterfaceMockRecorder {
+	return m.recorder
+}
+
+// CreateSecret mocks base method.
+func (m *MockSecretsInterface) CreateSecret(arg0 context.Context, arg1 models.Secret, arg2 v2.SecretsCreateSecretOptions) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateSecret", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateSecret indicates an expected call of CreateSecret.
+func (mr *MockSecretsInterfaceMockRecorder) CreateSecret(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSecret", reflect.TypeOf((*MockSecretsInterface)(nil).CreateSecret), arg0, arg1, arg2)
+}
+
+// DeleteSecret mocks base method.
+func (m *MockSecretsInterface) DeleteSecret(arg0 context.Context, arg1, arg2 string, arg3 v2.SecretsDeleteSecretOptions) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteSecret", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteSecret indicates an expected call of DeleteSecret.
+func (mr *MockSecretsInterfaceMockRecorder) DeleteSecret(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSecret", reflect.TypeOf((*MockSecretsInterface)(nil).DeleteSecret), arg0, arg1, arg2, arg3)
+}
+
+// GetSecrets mocks base method.
+func (m *MockSecretsInterface) GetSecrets(arg0 context.Context, arg1 v2.SecretsGetSecretsOptions) (*models.GetSecretsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSecrets", arg0, arg1)
+	ret0, _ := ret[0].(*models.GetSecretsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSecrets indicates an expected call of GetSecrets.
+func (mr *MockSecretsInterfaceMockRecorder) GetSecrets(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSecrets", reflect.TypeOf((*MockSecretsInterface)(nil).GetSecrets), arg0, arg1)
+}
+
+// UpdateSecret mocks base method.
+func (m *MockSecretsInterface) UpdateSecret(arg0 context.Context, arg1 models.Secret, arg2 v2.SecretsUpdateSecretOptions) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateSecret", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateSecret indicates an expected call of UpdateSecret.
+func (mr *MockSecretsInterfaceMockRecorder) UpdateSecret(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateSecret", reflect.TypeOf((*MockSecretsInterface)(nil).UpdateSecret), arg0, arg1, arg2)
+}
+
+// MockSequencesInterface is a mock of SequencesInterface interface.
+type MockSequencesInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockSequencesInterfaceMockRecorder
+}
+
+// MockSequencesInterfaceMockRecorder is the mock recorder for MockSequencesInterface.
+type MockSequencesInterfaceMockRecorder struct {
+	mock *MockSequencesInterface
+}
+
+// NewMockSequencesInterface creates a new mock instance.
+func NewMockSequencesInterface(ctrl *gomock.Controller) *MockSequencesInterface {
+	mock := &MockSequencesInterface{ctrl: ctrl}
+	mock.recorder = &MockSequencesInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSequencesInterface) EXPECT() *MockSequencesInterfaceMockRecorder {
+	return m.recorder
+}
+
+// BulkControlSequences mocks base method.
+func (m *MockSequencesInterface) BulkControlSequences(arg0 context.Context, arg1 v2.SequenceStateFilter, arg2 models.SequenceControlState, arg3 v2.SequencesBulkControlSequencesOptions) ([]v2.SequenceControlResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BulkControlSequences", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]v2.SequenceControlResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BulkControlSequences indicates an expected call of BulkControlSequences.
+func (mr *MockSequencesInterfaceMockRecorder) BulkControlSequences(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BulkControlSequences", reflect.TypeOf((*MockSequencesInterface)(nil).BulkControlSequences), arg0, arg1, arg2, arg3)
+}
+
+// ControlSequence mocks base method.
+func (m *MockSequencesInterface) ControlSequence(arg0 context.Context, arg1 v2.SequenceControlParams, arg2 v2.SequencesControlSequenceOptions) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ControlSequence", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ControlSequence indicates an expected call of ControlSequence.
+func (mr *MockSequencesInterfaceMockRecorder) ControlSequence(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ControlSequence", reflect.TypeOf((*MockSequencesInterface)(nil).ControlSequence), arg0, arg1, arg2)
+}
+
+// GetSequenceStates mocks base method.
+func (m *MockSequencesInterface) GetSequenceStates(arg0 context.Context, arg1 v2.SequenceStateFilter, arg2 v2.SequencesGetSequenceStatesOptions) ([]models.SequenceState, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSequenceStates", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]models.SequenceState)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSequenceStates indicates an expected call of GetSequenceStates.
+func (mr *MockSequencesInterfaceMockRecorder) GetSequenceStates(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSequenceStates", reflect.TypeOf((*MockSequencesInterface)(nil).GetSequenceStates), arg0, arg1, arg2)
+}
+
+// MockServicesInterface is a mock of ServicesInterface interface.
+type MockServicesInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockServicesInterfaceMockRecorder
+}
+
+// MockServicesInterfaceMockRecorder is the mock recorder for MockServicesInterface.
+type MockServicesInterfaceMockRecorder struct {
+	mock *MockServicesInterface
+}
+
+// NewMockServicesInterface creates a new mock instance.
+func NewMockServicesInterface(ctrl *gomock.Controller) *MockServicesInterface {
+	mock := &MockServicesInterface{ctrl: ctrl}
+	mock.recorder = &MockServicesInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockServicesInterface) EXPECT() *MockServicesInterfaceMockRecorder {
+	return m.recorder
+}
+
+// CreateServiceInStage mocks base method.
+func (m *MockServicesInterface) CreateServiceInStage(arg0 context.Context, arg1, arg2, arg3 string, arg4 v2.ServicesCreateServiceInStageOptions) (*models.EventContext, *models.Error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateServiceInStage", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].(*models.EventContext)
+	ret1, _ := ret[1].(*models.Error)
+	return ret0, ret1
+}
+
+// CreateServiceInStage indicates an expected call of CreateServiceInStage.
+func (mr *MockServicesInterfaceMockRecorder) CreateServiceInStage(arg0, arg1, arg2, arg3, arg4 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateServiceInStage", reflect.TypeOf((*MockServicesInterface)(nil).CreateServiceInStage), arg0, arg1, arg2, arg3, arg4)
+}
+
+// DeleteServiceFromStage mocks base method.
+func (m *MockServicesInterface) DeleteServiceFromStage(arg0 context.Context, arg1, arg2, arg3 string, arg4 v2.ServicesDeleteServiceFromStageOptions) (*models.EventContext, *models.Error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteServiceFromStage", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].(*models.EventContext)
+	ret1, _ := ret[1].(*models.Error)
+	return ret0, ret1
+}
+
+// DeleteServiceFromStage indicates an expected call of DeleteServiceFromStage.
+func (mr *MockServicesInterfaceMockRecorder) DeleteServiceFromStage(arg0, arg1, arg2, arg3, arg4 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteServiceFromStage", reflect.TypeOf((*MockServicesInterface)(nil).DeleteServiceFromStage), arg0, arg1, arg2, arg3, arg4)
+}
+
+// GetAllServices mocks base method.
+func (m *MockServicesInterface) GetAllServices(arg0 context.Context, arg1, arg2 string, arg3 v2.ServicesGetAllServicesOptions) ([]*models.Service, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllServices", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]*models.Service)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAllServices indicates an expected call of GetAllServices.
+func (mr *MockServicesInterfaceMockRecorder) GetAllServices(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllServices", reflect.TypeOf((*MockServicesInterface)(nil).GetAllServices), arg0, arg1, arg2, arg3)
+}
+
+// GetService mocks base method.
+func (m *MockServicesInterface) GetService(arg0 context.Context, arg1, arg2, arg3 string, arg4 v2.ServicesGetServiceOptions) (*models.Service, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetService", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].(*models.Service)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetService indicates an expected call of GetService.
+func (mr *MockServicesInterfaceMockRecorder) GetService(arg0, arg1, arg2, arg3, arg4 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetService", reflect.TypeOf((*MockServicesInterface)(nil).GetService), arg0, arg1, arg2, arg3, arg4)
+}
+
+// MockShipyardControlInterface is a mock of ShipyardControlInterface interface.
+type MockShipyardControlInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockShipyardControlInterfaceMockRecorder
+}
+
+// MockShipyardControlInterfaceMockRecorder is the mock recorder for MockShipyardControlInterface.
+type MockShipyardControlInterfaceMockRecorder struct {
+	mock *MockShipyardControlInterface
+}
+
+// NewMockShipyardControlInterface creates a new mock instance.
+func NewMockShipyardControlInterface(ctrl *gomock.Controller) *MockShipyardControlInterface {
+	mock := &MockShipyardControlInterface{ctrl: ctrl}
+	mock.recorder = &MockShipyardControlInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockShipyardControlInterface) EXPECT() *MockShipyardControlInterfaceMockRecorder {
+	return m.recorder
+}
+
+// GetOpenTriggeredEvents mocks base method.
+func (m *MockShipyardControlInterface) GetOpenTriggeredEvents(arg0 context.Context, arg1 v2.EventFilter, arg2 v2.ShipyardControlGetOpenTriggeredEventsOptions) ([]*models.KeptnContextExtendedCE, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOpenTriggeredEvents", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]*models.KeptnContextExtendedCE)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOpenTriggeredEvents indicates an expected call of GetOpenTriggeredEvents.
+func (mr *MockShipyardControlInterfaceMockRecorder) GetOpenTriggeredEvents(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOpenTriggeredEvents", reflect.TypeOf((*MockShipyardControlInterface)(nil).GetOpenTriggeredEvents), arg0, arg1, arg2)
+}
+
+// MockSleeper is a mock of Sleeper interface.
+type MockSleeper struct {
+	ctrl     *gomock.Controller
+	recorder *MockSleeperMockRecorder
+}
+
+// MockSleeperMockRecorder is the mock recorder for MockSleeper.
+type MockSleeperMockRecorder struct {
+	mock *MockSleeper
+}
+
+// NewMockSleeper creates a new mock instance.
+func NewMockSleeper(ctrl *gomock.Controller) *MockSleeper {
+	mock := &MockSleeper{ctrl: ctrl}
+	mock.recorder = &MockSleeperMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSleeper) EXPECT() *MockSleeperMockRecorder {
+	return m.recorder
+}
+
+// Sleep mocks base method.
+func (m *MockSleeper) Sleep() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Sleep")
+}
+
+// Sleep indicates an expected call of Sleep.
+func (mr *MockSleeperMockRecorder) Sleep() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Sleep", reflect.TypeOf((*MockSleeper)(nil).Sleep))
+}
+
+// MockStagesInterface is a mock of StagesInterface interface.
+type MockStagesInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockStagesInterfaceMockRecorder
+}
+
+// MockStagesInterfaceMockRecorder is the mock recorder for MockStagesInterface.
+type MockStagesInterfaceMockRecorder struct {
+	mock *MockStagesInterface
+}
+
+// NewMockStagesInterface creates a new mock instance.
+func NewMockStagesInterface(ctrl *gomock.Controller) *MockStagesInterface {
+	mock := &MockStagesInterface{ctrl: ctrl}
+	mock.recorder = &MockStagesInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockStagesInterface) EXPECT() *MockStagesInterfaceMockRecorder {
+	return m.recorder
+}
+
+// CreateStage mocks base method.
+func (m *MockStagesInterface) CreateStage(arg0 context.Context, arg1, arg2 string, arg3 v2.StagesCreateStageOptions) (*models.EventContext, *models.Error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateStage", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(*models.EventContext)
+	ret1, _ := ret[1].(*models.Error)
+	return ret0, ret1
+}
+
+// CreateStage indicates an expected call of CreateStage.
+func (mr *MockStagesInterfaceMockRecorder) CreateStage(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateStage", reflect.TypeOf((*MockStagesInterface)(nil).CreateStage), arg0, arg1, arg2, arg3)
+}
+
+// GetAllStages mocks base method.
+func (m *MockStagesInterface) GetAllStages(arg0 context.Context, arg1 string, arg2 v2.StagesGetAllStagesOptions) ([]*models.Stage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllStages", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]*models.Stage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAllStages indicates an expected call of GetAllStages.
+func (mr *MockStagesInterfaceMockRecorder) GetAllStages(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllStages", reflect.TypeOf((*MockStagesInterface)(nil).GetAllStages), arg0, arg1, arg2)
+}
+
+// MockTokenProvider is a mock of TokenProvider interface.
+type MockTokenProvider struct {
+	ctrl     *gomock.Controller
+	recorder *MockTokenProviderMockRecorder
+}
+
+// MockTokenProviderMockRecorder is the mock recorder for MockTokenProvider.
+type MockTokenProviderMockRecorder struct {
+	mock *MockTokenProvider
+}
+
+// NewMockTokenProvider creates a new mock instance.
+func NewMockTokenProvider(ctrl *gomock.Controller) *MockTokenProvider {
+	mock := &MockTokenProvider{ctrl: ctrl}
+	mock.recorder = &MockTokenProviderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTokenProvider) EXPECT() *MockTokenProviderMockRecorder {
+	return m.recorder
+}
+
+// Token mocks base method.
+func (m *MockTokenProvider) Token(arg0 context.Context) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Token", arg0)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Token indicates an expected call of Token.
+func (mr *MockTokenProviderMockRecorder) Token(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Token", reflect.TypeOf((*MockTokenProvider)(nil).Token), arg0)
+}
+
+// MockUniformInterface is a mock of UniformInterface interface.
+type MockUniformInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockUniformInterfaceMockRecorder
+}
+
+// MockUniformInterfaceMockRecorder is the mock recorder for MockUniformInterface.
+type MockUniformInterfaceMockRecorder struct {
+	mock *MockUniformInterface
+}
+
+// NewMockUniformInterface creates a new mock instance.
+func NewMockUniformInterface(ctrl *gomock.Controller) *MockUniformInterface {
+	mock := &MockUniformInterface{ctrl: ctrl}
+	mock.recorder = &MockUniformInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUniformInterface) EXPECT() *MockUniformInterfaceMockRecorder {
+	return m.recorder
+}
+
+// CreateSubscription mocks base method.
+func (m *MockUniformInterface) CreateSubscription(arg0 context.Context, arg1 string, arg2 models.EventSubscription, arg3 v2.UniformCreateSubscriptionOptions) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateSubscription", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateSubscription indicates an expected call of CreateSubscription.
+func (mr *MockUniformInterfaceMockRecorder) CreateSubscription(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSubscription", reflect.TypeOf((*MockUniformInterface)(nil).CreateSubscription), arg0, arg1, arg2, arg3)
+}
+
+// GetRegistrations mocks base method.
+func (m *MockUniformInterface) GetRegistrations(arg0 context.Context, arg1 v2.UniformGetRegistrationsOptions) ([]*models.Integration, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRegistrations", arg0, arg1)
+	ret0, _ := ret[0].([]*models.Integration)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRegistrations indicates an expected call of GetRegistrations.
+func (mr *MockUniformInterfaceMockRecorder) GetRegistrations(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRegistrations", reflect.TypeOf((*MockUniformInterface)(nil).GetRegistrations), arg0, arg1)
+}
+
+// Ping mocks base method.
+func (m *MockUniformInterface) Ping(arg0 context.Context, arg1 string, arg2 v2.UniformPingOptions) (*models.Integration, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Ping", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*models.Integration)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Ping indicates an expected call of Ping.
+func (mr *MockUniformInterfaceMockRecorder) Ping(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Ping", reflect.TypeOf((*MockUniformInterface)(nil).Ping), arg0, arg1, arg2)
+}
+
+// RegisterIntegration mocks base method.
+func (m *MockUniformInterface) RegisterIntegration(arg0 context.Context, arg1 models.Integration, arg2 v2.UniformRegisterIntegrationOptions) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RegisterIntegration", arg0, arg1, arg2)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RegisterIntegration indicates an expected call of RegisterIntegration.
+func (mr *MockUniformInterfaceMockRecorder) RegisterIntegration(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterIntegration", reflect.TypeOf((*MockUniformInterface)(nil).RegisterIntegration), arg0, arg1, arg2)
+}
+
+// UnregisterIntegration mocks base method.
+func (m *MockUniformInterface) UnregisterIntegration(arg0 context.Context, arg1 string, arg2 v2.UniformUnregisterIntegrationOptions) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnregisterIntegration", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UnregisterIntegration indicates an expected call of UnregisterIntegration.
+func (mr *MockUniformInterfaceMockRecorder) UnregisterIntegration(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnregisterIntegration", reflect.TypeOf((*MockUniformInterface)(nil).UnregisterIntegration), arg0, arg1, arg2)
+}
@@ -0,0 +1,1394 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/keptn/go-utils/pkg/api/utils (interfaces: APIV1Interface,AuthV1Interface,EventHandlerInterface,EventsV1Interface,KeptnInterface,LogsV1Interface,ProjectsV1Interface,ResourcesV1Interface,SecretsV1Interface,SequencesV1Interface,ServicesV1Interface,ShipyardControlV1Interface,Sleeper,StagesV1Interface,UniformV1Interface)
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	gomock "github.com/golang/mock/gomock"
+	models "github.com/keptn/go-utils/pkg/api/models"
+	api "github.com/keptn/go-utils/pkg/api/utils"
+)
+
+// MockAPIV1Interface is a mock of APIV1Interface interface.
+type MockAPIV1Interface struct {
+	ctrl     *gomock.Controller
+	recorder *MockAPIV1InterfaceMockRecorder
+}
+
+// MockAPIV1InterfaceMockRecorder is the mock recorder for MockAPIV1Interface.
+type MockAPIV1InterfaceMockRecorder struct {
+	mock *MockAPIV1Interface
+}
+
+// NewMockAPIV1Interface creates a new mock instance.
+func NewMockAPIV1Interface(ctrl *gomock.Controller) *MockAPIV1Interface {
+	mock := &MockAPIV1Interface{ctrl: ctrl}
+	mock.recorder = &MockAPIV1InterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAPIV1Interface) EXPECT() *MockAPIV1InterfaceMockRecorder {
+	return m.recorder
+}
+
+// CreateProject mocks base method.
+func (m *MockAPIV1Interface) CreateProject(arg0 models.CreateProject) (string, *models.Error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateProject", arg0)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(*models.Error)
+	return ret0, ret1
+}
+
+// CreateProject indicates an expected call of CreateProject.
+func (mr *MockAPIV1InterfaceMockRecorder) CreateProject(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateProject", reflect.TypeOf((*MockAPIV1Interface)(nil).CreateProject), arg0)
+}
+
+// CreateService mocks base method.
+func (m *MockAPIV1Interface) CreateService(arg0 string, arg1 models.CreateService) (string, *models.Error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateService", arg0, arg1)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(*models.Error)
+	return ret0, ret1
+}
+
+// CreateService indicates an expected call of CreateService.
+func (mr *MockAPIV1InterfaceMockRecorder) CreateService(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateService", reflect.TypeOf((*MockAPIV1Interface)(nil).CreateService), arg0, arg1)
+}
+
+// DeleteProject mocks base method.
+func (m *MockAPIV1Interface) DeleteProject(arg0 models.Project) (*models.DeleteProjectResponse, *models.Error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteProject", arg0)
+	ret0, _ := ret[0].(*models.DeleteProjectResponse)
+	ret1, _ := ret[1].(*models.Error)
+	return ret0, ret1
+}
+
+// DeleteProject indicates an expected call of DeleteProject.
+func (mr *MockAPIV1InterfaceMockRecorder) DeleteProject(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteProject", reflect.TypeOf((*MockAPIV1Interface)(nil).DeleteProject), arg0)
+}
+
+// DeleteService mocks base method.
+func (m *MockAPIV1Interface) DeleteService(arg0, arg1 string) (*models.DeleteServiceResponse, *models.Error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteService", arg0, arg1)
+	ret0, _ := ret[0].(*models.DeleteServiceResponse)
+	ret1, _ := ret[1].(*models.Error)
+	return ret0, ret1
+}
+
+// DeleteService indicates an expected call of DeleteService.
+func (mr *MockAPIV1InterfaceMockRecorder) DeleteService(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteService", reflect.TypeOf((*MockAPIV1Interface)(nil).DeleteService), arg0, arg1)
+}
+
+// GetMetadata mocks base method.
+func (m *MockAPIV1Interface) GetMetadata() (*models.Metadata, *models.Error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMetadata")
+	ret0, _ := ret[0].(*models.Metadata)
+	ret1, _ := ret[1].(*models.Error)
+	return ret0, ret1
+}
+
+// GetMetadata indicates an expected call of GetMetadata.
+func (mr *MockAPIV1InterfaceMockRecorder) GetMetadata() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMetadata", reflect.TypeOf((*MockAPIV1Interface)(nil).GetMetadata))
+}
+
+// SendEvent mocks base method.
+func (m *MockAPIV1Interface) SendEvent(arg0 models.KeptnContextExtendedCE) (*models.EventContext, *models.Error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendEvent", arg0)
+	ret0, _ := ret[0].(*models.EventContext)
+	ret1, _ := ret[1].(*models.Error)
+	return ret0, ret1
+}
+
+// SendEvent indicates an expected call of SendEvent.
+func (mr *MockAPIV1InterfaceMockRecorder) SendEvent(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendEvent", reflect.TypeOf((*MockAPIV1Interface)(nil).SendEvent), arg0)
+}
+
+// TriggerEvaluation mocks base method.
+func (m *MockAPIV1Interface) TriggerEvaluation(arg0, arg1, arg2 string, arg3 models.Evaluation) (*models.EventContext, *models.Error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TriggerEvaluation", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(*models.EventContext)
+	ret1, _ := ret[1].(*models.Error)
+	return ret0, ret1
+}
+
+// TriggerEvaluation indicates an expected call of TriggerEvaluation.
+func (mr *MockAPIV1InterfaceMockRecorder) TriggerEvaluation(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TriggerEvaluation", reflect.TypeOf((*MockAPIV1Interface)(nil).TriggerEvaluation), arg0, arg1, arg2, arg3)
+}
+
+// UpdateProject mocks base method.
+func (m *MockAPIV1Interface) UpdateProject(arg0 models.CreateProject) (string, *models.Error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateProject", arg0)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(*models.Error)
+	return ret0, ret1
+}
+
+// UpdateProject indicates an expected call of UpdateProject.
+func (mr *MockAPIV1InterfaceMockRecorder) UpdateProject(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateProject", reflect.TypeOf((*MockAPIV1Interface)(nil).UpdateProject), arg0)
+}
+
+// MockAuthV1Interface is a mock of AuthV1Interface interface.
+type MockAuthV1Interface struct {
+	ctrl     *gomock.Controller
+	recorder *MockAuthV1InterfaceMockRecorder
+}
+
+// MockAuthV1InterfaceMockRecorder is the mock recorder for MockAuthV1Interface.
+type MockAuthV1InterfaceMockRecorder struct {
+	mock *MockAuthV1Interface
+}
+
+// NewMockAuthV1Interface creates a new mock instance.
+func NewMockAuthV1Interface(ctrl *gomock.Controller) *MockAuthV1Interface {
+	mock := &MockAuthV1Interface{ctrl: ctrl}
+	mock.recorder = &MockAuthV1InterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAuthV1Interface) EXPECT() *MockAuthV1InterfaceMockRecorder {
+	return m.recorder
+}
+
+// Authenticate mocks base method.
+func (m *MockAuthV1Interface) Authenticate() (*models.EventContext, *models.Error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Authenticate")
+	ret0, _ := ret[0].(*models.EventContext)
+	ret1, _ := ret[1].(*models.Error)
+	return ret0, ret1
+}
+
+// Authenticate indicates an expected call of Authenticate.
+func (mr *MockAuthV1InterfaceMockRecorder) Authenticate() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Authenticate", reflect.TypeOf((*MockAuthV1Interface)(nil).Authenticate))
+}
+
+// MockEventHandlerV1Interface is a mock of EventHandlerInterface interface.
+type MockEventHandlerV1Interface struct {
+	ctrl     *gomock.Controller
+	recorder *MockEventHandlerV1InterfaceMockRecorder
+}
+
+// MockEventHandlerV1InterfaceMockRecorder is the mock recorder for MockEventHandlerV1Interface.
+type MockEventHandlerV1InterfaceMockRecorder struct {
+	mock *MockEventHandlerV1Interface
+}
+
+// NewMockEventHandlerV1Interface creates a new mock instance.
+func NewMockEventHandlerV1Interface(ctrl *gomock.Controller) *MockEventHandlerV1Interface {
+	mock := &MockEventHandlerV1Interface{ctrl: ctrl}
+	mock.recorder = &MockEventHandlerV1InterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockEventHandlerV1Interface) EXPECT() *MockEventHandlerV1InterfaceMockRecorder {
+	return m.recorder
+}
+
+// GetEvents mocks base method.
+func (m *MockEventHandlerV1Interface) GetEvents(arg0 *api.EventFilter) ([]*models.KeptnContextExtendedCE, *models.Error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEvents", arg0)
+	ret0, _ := ret[0].([]*models.KeptnContextExtendedCE)
+	ret1, _ := ret[1].(*models.Error)
+	return ret0, ret1
+}
+
+// GetEvents indicates an expected call of GetEvents.
+func (mr *MockEventHandlerV1InterfaceMockRecorder) GetEvents(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEvents", reflect.TypeOf((*MockEventHandlerV1Interface)(nil).GetEvents), arg0)
+}
+
+// GetEventsWithRetry mocks base method.
+func (m *MockEventHandlerV1Interface) GetEventsWithRetry(arg0 *api.EventFilter, arg1 int, arg2 time.Duration) ([]*models.KeptnContextExtendedCE, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEventsWithRetry", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]*models.KeptnContextExtendedCE)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetEventsWithRetry indicates an expected call of GetEventsWithRetry.
+func (mr *MockEventHandlerV1InterfaceMockRecorder) GetEventsWithRetry(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEventsWithRetry", reflect.TypeOf((*MockEventHandlerV1Interface)(nil).GetEventsWithRetry), arg0, arg1, arg2)
+}
+
+// MockEventsV1Interface is a mock of EventsV1Interface interface.
+type MockEventsV1Interface struct {
+	ctrl     *gomock.Controller
+	recorder *MockEventsV1InterfaceMockRecorder
+}
+
+// MockEventsV1InterfaceMockRecorder is the mock recorder for MockEventsV1Interface.
+type MockEventsV1InterfaceMockRecorder struct {
+	mock *MockEventsV1Interface
+}
+
+// NewMockEventsV1Interface creates a new mock instance.
+func NewMockEventsV1Interface(ctrl *gomock.Controller) *MockEventsV1Interface {
+	mock := &MockEventsV1Interface{ctrl: ctrl}
+	mock.recorder = &MockEventsV1InterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockEventsV1Interface) EXPECT() *MockEventsV1InterfaceMockRecorder {
+	return m.recorder
+}
+
+// GetEvents mocks base method.
+func (m *MockEventsV1Interface) GetEvents(arg0 *api.EventFilter) ([]*models.KeptnContextExtendedCE, *models.Error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEvents", arg0)
+	ret0, _ := ret[0].([]*models.KeptnContextExtendedCE)
+	ret1, _ := ret[1].(*models.Error)
+	return ret0, ret1
+}
+
+// GetEvents indicates an expected call of GetEvents.
+func (mr *MockEventsV1InterfaceMockRecorder) GetEvents(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEvents", reflect.TypeOf((*MockEventsV1Interface)(nil).GetEvents), arg0)
+}
+
+// GetEventsWithRetry mocks base method.
+func (m *MockEventsV1Interface) GetEventsWithRetry(arg0 *api.EventFilter, arg1 int, arg2 time.Duration) ([]*models.KeptnContextExtendedCE, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEventsWithRetry", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]*models.KeptnContextExtendedCE)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetEventsWithRetry indicates an expected call of GetEventsWithRetry.
+func (mr *MockEventsV1InterfaceMockRecorder) GetEventsWithRetry(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEventsWithRetry", reflect.TypeOf((*MockEventsV1Interface)(nil).GetEventsWithRetry), arg0, arg1, arg2)
+}
+
+// MockKeptnV1Interface is a mock of KeptnInterface interface.
+type MockKeptnV1Interface struct {
+	ctrl     *gomock.Controller
+	recorder *MockKeptnV1InterfaceMockRecorder
+}
+
+// MockKeptnV1InterfaceMockRecorder is the mock recorder for MockKeptnV1Interface.
+type MockKeptnV1InterfaceMockRecorder struct {
+	mock *MockKeptnV1Interface
+}
+
+// NewMockKeptnV1Interface creates a new mock instance.
+func NewMockKeptnV1Interface(ctrl *gomock.Controller) *MockKeptnV1Interface {
+	mock := &MockKeptnV1Interface{ctrl: ctrl}
+	mock.recorder = &MockKeptnV1InterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockKeptnV1Interface) EXPECT() *MockKeptnV1InterfaceMockRecorder {
+	return m.recorder
+}
+
+// APIV1 mocks base method.
+func (m *MockKeptnV1Interface) APIV1() api.APIV1Interface {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "APIV1")
+	ret0, _ := ret[0].(api.APIV1Interface)
+	return ret0
+}
+
+// APIV1 indicates an expected call of APIV1.
+func (mr *MockKeptnV1InterfaceMockRecorder) APIV1() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "APIV1", reflect.TypeOf((*MockKeptnV1Interface)(nil).APIV1))
+}
+
+// AuthV1 mocks base method.
+func (m *MockKeptnV1Interface) AuthV1() api.AuthV1Interface {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AuthV1")
+	ret0, _ := ret[0].(api.AuthV1Interface)
+	return ret0
+}
+
+// AuthV1 indicates an expected call of AuthV1.
+func (mr *MockKeptnV1InterfaceMockRecorder) AuthV1() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AuthV1", reflect.TypeOf((*MockKeptnV1Interface)(nil).AuthV1))
+}
+
+// EventsV1 mocks base method.
+func (m *MockKeptnV1Interface) EventsV1() api.EventsV1Interface {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EventsV1")
+	ret0, _ := ret[0].(api.EventsV1Interface)
+	return ret0
+}
+
+// EventsV1 indicates an expected call of EventsV1.
+func (mr *MockKeptnV1InterfaceMockRecorder) EventsV1() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EventsV1", reflect.TypeOf((*MockKeptnV1Interface)(nil).EventsV1))
+}
+
+// LogsV1 mocks base method.
+func (m *MockKeptnV1Interface) LogsV1() api.LogsV1Interface {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LogsV1")
+	ret0, _ := ret[0].(api.LogsV1Interface)
+	return ret0
+}
+
+// LogsV1 indicates an expected call of LogsV1.
+func (mr *MockKeptnV1InterfaceMockRecorder) LogsV1() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LogsV1", reflect.TypeOf((*MockKeptnV1Interface)(nil).LogsV1))
+}
+
+// ProjectsV1 mocks base method.
+func (m *MockKeptnV1Interface) ProjectsV1() api.ProjectsV1Interface {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ProjectsV1")
+	ret0, _ := ret[0].(api.ProjectsV1Interface)
+	return ret0
+}
+
+// ProjectsV1 indicates an expected call of ProjectsV1.
+func (mr *MockKeptnV1InterfaceMockRecorder) ProjectsV1() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ProjectsV1", reflect.TypeOf((*MockKeptnV1Interface)(nil).ProjectsV1))
+}
+
+// ResourcesV1 mocks base method.
+func (m *MockKeptnV1Interface) ResourcesV1() api.ResourcesV1Interface {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResourcesV1")
+	ret0, _ := ret[0].(api.ResourcesV1Interface)
+	return ret0
+}
+
+// ResourcesV1 indicates an expected call of ResourcesV1.
+func (mr *MockKeptnV1InterfaceMockRecorder) ResourcesV1() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResourcesV1", reflect.TypeOf((*MockKeptnV1Interface)(nil).ResourcesV1))
+}
+
+// SecretsV1 mocks base method.
+func (m *MockKeptnV1Interface) SecretsV1() api.SecretsV1Interface {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SecretsV1")
+	ret0, _ := ret[0].(api.SecretsV1Interface)
+	return ret0
+}
+
+// SecretsV1 indicates an expected call of SecretsV1.
+func (mr *MockKeptnV1InterfaceMockRecorder) SecretsV1() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SecretsV1", reflect.TypeOf((*MockKeptnV1Interface)(nil).SecretsV1))
+}
+
+// SequencesV1 mocks base method.
+func (m *MockKeptnV1Interface) SequencesV1() api.SequencesV1Interface {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SequencesV1")
+	ret0, _ := ret[0].(api.SequencesV1Interface)
+	return ret0
+}
+
+// SequencesV1 indicates an expected call of SequencesV1.
+func (mr *MockKeptnV1InterfaceMockRecorder) SequencesV1() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SequencesV1", reflect.TypeOf((*MockKeptnV1Interface)(nil).SequencesV1))
+}
+
+// ServicesV1 mocks base method.
+func (m *MockKeptnV1Interface) ServicesV1() api.ServicesV1Interface {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ServicesV1")
+	ret0, _ := ret[0].(api.ServicesV1Interface)
+	return ret0
+}
+
+// ServicesV1 indicates an expected call of ServicesV1.
+func (mr *MockKeptnV1InterfaceMockRecorder) ServicesV1() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ServicesV1", reflect.TypeOf((*MockKeptnV1Interface)(nil).ServicesV1))
+}
+
+// ShipyardControlV1 mocks base method.
+func (m *MockKeptnV1Interface) ShipyardControlV1() api.ShipyardControlV1Interface {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ShipyardControlV1")
+	ret0, _ := ret[0].(api.ShipyardControlV1Interface)
+	return ret0
+}
+
+// ShipyardControlV1 indicates an expected call of ShipyardControlV1.
+func (mr *MockKeptnV1InterfaceMockRecorder) ShipyardControlV1() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ShipyardControlV1", reflect.TypeOf((*MockKeptnV1Interface)(nil).ShipyardControlV1))
+}
+
+// StagesV1 mocks base method.
+func (m *MockKeptnV1Interface) StagesV1() api.StagesV1Interface {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StagesV1")
+	ret0, _ := ret[0].(api.StagesV1Interface)
+	return ret0
+}
+
+// StagesV1 indicates an expected call of StagesV1.
+func (mr *MockKeptnV1InterfaceMockRecorder) StagesV1() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StagesV1", reflect.TypeOf((*MockKeptnV1Interface)(nil).StagesV1))
+}
+
+// UniformV1 mocks base method.
+func (m *MockKeptnV1Interface) UniformV1() api.UniformV1Interface {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UniformV1")
+	ret0, _ := ret[0].(api.UniformV1Interface)
+	return ret0
+}
+
+// UniformV1 indicates an expected call of UniformV1.
+func (mr *MockKeptnV1InterfaceMockRecorder) UniformV1() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UniformV1", reflect.TypeOf((*MockKeptnV1Interface)(nil).UniformV1))
+}
+
+// MockLogsV1Interface is a mock of LogsV1Interface interface.
+type MockLogsV1Interface struct {
+	ctrl     *gomock.Controller
+	recorder *MockLogsV1InterfaceMockRecorder
+}
+
+// MockLogsV1InterfaceMockRecorder is the mock recorder for MockLogsV1Interface.
+type MockLogsV1InterfaceMockRecorder struct {
+	mock *MockLogsV1Interface
+}
+
+// NewMockLogsV1Interface creates a new mock instance.
+func NewMockLogsV1Interface(ctrl *gomock.Controller) *MockLogsV1Interface {
+	mock := &MockLogsV1Interface{ctrl: ctrl}
+	mock.recorder = &MockLogsV1InterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockLogsV1Interface) EXPECT() *MockLogsV1InterfaceMockRecorder {
+	return m.recorder
+}
+
+// DeleteLogs mocks base method.
+func (m *MockLogsV1Interface) DeleteLogs(arg0 models.LogFilter) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteLogs", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteLogs indicates an expected call of DeleteLogs.
+func (mr *MockLogsV1InterfaceMockRecorder) DeleteLogs(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteLogs", reflect.TypeOf((*MockLogsV1Interface)(nil).DeleteLogs), arg0)
+}
+
+// Flush mocks base method.
+func (m *MockLogsV1Interface) Flush() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Flush")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Flush indicates an expected call of Flush.
+func (mr *MockLogsV1InterfaceMockRecorder) Flush() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Flush", reflect.TypeOf((*MockLogsV1Interface)(nil).Flush))
+}
+
+// GetLogs mocks base method.
+func (m *MockLogsV1Interface) GetLogs(arg0 models.GetLogsParams) (*models.GetLogsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLogs", arg0)
+	ret0, _ := ret[0].(*models.GetLogsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLogs indicates an expected call of GetLogs.
+func (mr *MockLogsV1InterfaceMockRecorder) GetLogs(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLogs", reflect.TypeOf((*MockLogsV1Interface)(nil).GetLogs), arg0)
+}
+
+// Log mocks base method.
+func (m *MockLogsV1Interface) Log(arg0 []models.LogEntry) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Log", arg0)
+}
+
+// Log indicates an expected call of Log.
+func (mr *MockLogsV1InterfaceMockRecorder) Log(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Log", reflect.TypeOf((*MockLogsV1Interface)(nil).Log), arg0)
+}
+
+// Start mocks base method.
+func (m *MockLogsV1Interface) Start(arg0 context.Context) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Start", arg0)
+}
+
+// Start indicates an expected call of Start.
+func (mr *MockLogsV1InterfaceMockRecorder) Start(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Start", reflect.TypeOf((*MockLogsV1Interface)(nil).Start), arg0)
+}
+
+// MockProjectsV1Interface is a mock of ProjectsV1Interface interface.
+type MockProjectsV1Interface struct {
+	ctrl     *gomock.Controller
+	recorder *MockProjectsV1InterfaceMockRecorder
+}
+
+// MockProjectsV1InterfaceMockRecorder is the mock recorder for MockProjectsV1Interface.
+type MockProjectsV1InterfaceMockRecorder struct {
+	mock *MockProjectsV1Interface
+}
+
+// NewMockProjectsV1Interface creates a new mock instance.
+func NewMockProjectsV1Interface(ctrl *gomock.Controller) *MockProjectsV1Interface {
+	mock := &MockProjectsV1Interface{ctrl: ctrl}
+	mock.recorder = &MockProjectsV1InterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockProjectsV1Interface) EXPECT() *MockProjectsV1InterfaceMockRecorder {
+	return m.recorder
+}
+
+// CreateProject mocks base method.
+func (m *MockProjectsV1Interface) CreateProject(arg0 models.Project) (*models.EventContext, *models.Error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateProject", arg0)
+	ret0, _ := ret[0].(*models.EventContext)
+	ret1, _ := ret[1].(*models.Error)
+	return ret0, ret1
+}
+
+// CreateProject indicates an expected call of CreateProject.
+func (mr *MockProjectsV1InterfaceMockRecorder) CreateProject(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateProject", reflect.TypeOf((*MockProjectsV1Interface)(nil).CreateProject), arg0)
+}
+
+// DeleteProject mocks base method.
+func (m *MockProjectsV1Interface) DeleteProject(arg0 models.Project) (*models.EventContext, *models.Error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteProject", arg0)
+	ret0, _ := ret[0].(*models.EventContext)
+	ret1, _ := ret[1].(*models.Error)
+	return ret0, ret1
+}
+
+// DeleteProject indicates an expected call of DeleteProject.
+func (mr *MockProjectsV1InterfaceMockRecorder) DeleteProject(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteProject", reflect.TypeOf((*MockProjectsV1Interface)(nil).DeleteProject), arg0)
+}
+
+// GetAllProjects mocks base method.
+func (m *MockProjectsV1Interface) GetAllProjects() ([]*models.Project, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllProjects")
+	ret0, _ := ret[0].([]*models.Project)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAllProjects indicates an expected call of GetAllProjects.
+func (mr *MockProjectsV1InterfaceMockRecorder) GetAllProjects() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllProjects", reflect.TypeOf((*MockProjectsV1Interface)(nil).GetAllProjects))
+}
+
+// GetProject mocks base method.
+func (m *MockProjectsV1Interface) GetProject(arg0 models.Project) (*models.Project, *models.Error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetProject", arg0)
+	ret0, _ := ret[0].(*models.Project)
+	ret1, _ := ret[1].(*models.Error)
+	return ret0, ret1
+}
+
+// GetProject indicates an expected call of GetProject.
+func (mr *MockProjectsV1InterfaceMockRecorder) GetProject(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProject", reflect.TypeOf((*MockProjectsV1Interface)(nil).GetProject), arg0)
+}
+
+// UpdateConfigurationServiceProject mocks base method.
+func (m *MockProjectsV1Interface) UpdateConfigurationServiceProject(arg0 models.Project) (*models.EventContext, *models.Error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateConfigurationServiceProject", arg0)
+	ret0, _ := ret[0].(*models.EventContext)
+	ret1, _ := ret[1].(*models.Error)
+	return ret0, ret1
+}
+
+// UpdateConfigurationServiceProject indicates an expected call of UpdateConfigurationServiceProject.
+func (mr *MockProjectsV1InterfaceMockRecorder) UpdateConfigurationServiceProject(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateConfigurationServiceProject", reflect.TypeOf((*MockProjectsV1Interface)(nil).UpdateConfigurationServiceProject), arg0)
+}
+
+// MockResourcesV1Interface is a mock of ResourcesV1Interface interface.
+type MockResourcesV1Interface struct {
+	ctrl     *gomock.Controller
+	recorder *MockResourcesV1InterfaceMockRecorder
+}
+
+// MockResourcesV1InterfaceMockRecorder is the mock recorder for MockResourcesV1Interface.
+type MockResourcesV1InterfaceMockRecorder struct {
+	mock *MockResourcesV1Interface
+}
+
+// NewMockResourcesV1Interface creates a new mock instance.
+func NewMockResourcesV1Interface(ctrl *gomock.Controller) *MockResourcesV1Interface {
+	mock := &MockResourcesV1Interface{ctrl: ctrl}
+	mock.recorder = &MockResourcesV1InterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockResourcesV1Interface) EXPECT() *MockResourcesV1InterfaceMockRecorder {
+	return m.recorder
+}
+
+// CreateProjectResources mocks base method.
+func (m *MockResourcesV1Interface) CreateProjectResources(arg0 string, arg1 []*models.Resource) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateProjectResources", arg0, arg1)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateProjectResources indicates an expected call of CreateProjectResources.
+func (mr *MockResourcesV1InterfaceMockRecorder) CreateProjectResources(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateProjectResources", reflect.TypeOf((*MockResourcesV1Interface)(nil).CreateProjectResources), arg0, arg1)
+}
+
+// CreateResources mocks base method.
+func (m *MockResourcesV1Interface) CreateResources(arg0, arg1, arg2 string, arg3 []*models.Resource) (*models.EventContext, *models.Error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateResources", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(*models.EventContext)
+	ret1, _ := ret[1].(*models.Error)
+	return ret0, ret1
+}
+
+// CreateResources indicates an expected call of CreateResources.
+func (mr *MockResourcesV1InterfaceMockRecorder) CreateResources(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateResources", reflect.TypeOf((*MockResourcesV1Interface)(nil).CreateResources), arg0, arg1, arg2, arg3)
+}
+
+// CreateServiceResources mocks base method.
+func (m *MockResourcesV1Interface) CreateServiceResources(arg0, arg1, arg2 string, arg3 []*models.Resource) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateServiceResources", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateServiceResources indicates an expected call of CreateServiceResources.
+func (mr *MockResourcesV1InterfaceMockRecorder) CreateServiceResources(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateServiceResources", reflect.TypeOf((*MockResourcesV1Interface)(nil).CreateServiceResources), arg0, arg1, arg2, arg3)
+}
+
+// CreateStageResources mocks base method.
+func (m *MockResourcesV1Interface) CreateStageResources(arg0, arg1 string, arg2 []*models.Resource) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateStageResources", arg0, arg1, arg2)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateStageResources indicates an expected call of CreateStageResources.
+func (mr *MockResourcesV1InterfaceMockRecorder) CreateStageResources(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateStageResources", reflect.TypeOf((*MockResourcesV1Interface)(nil).CreateStageResources), arg0, arg1, arg2)
+}
+
+// DeleteProjectResource mocks base method.
+func (m *MockResourcesV1Interface) DeleteProjectResource(arg0, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteProjectResource", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteProjectResource indicates an expected call of DeleteProjectResource.
+func (mr *MockResourcesV1InterfaceMockRecorder) DeleteProjectResource(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteProjectResource", reflect.TypeOf((*MockResourcesV1Interface)(nil).DeleteProjectResource), arg0, arg1)
+}
+
+// DeleteServiceResource mocks base method.
+func (m *MockResourcesV1Interface) DeleteServiceResource(arg0, arg1, arg2, arg3 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteServiceResource", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteServiceResource indicates an expected call of DeleteServiceResource.
+func (mr *MockResourcesV1InterfaceMockRecorder) DeleteServiceResource(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteServiceResource", reflect.TypeOf((*MockResourcesV1Interface)(nil).DeleteServiceResource), arg0, arg1, arg2, arg3)
+}
+
+// DeleteStageResource mocks base method.
+func (m *MockResourcesV1Interface) DeleteStageResource(arg0, arg1, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteStageResource", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteStageResource indicates an expected call of DeleteStageResource.
+func (mr *MockResourcesV1InterfaceMockRecorder) DeleteStageResource(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteStageResource", reflect.TypeOf((*MockResourcesV1Interface)(nil).DeleteStageResource), arg0, arg1, arg2)
+}
+
+// GetAllServiceResources mocks base method.
+func (m *MockResourcesV1Interface) GetAllServiceResources(arg0, arg1, arg2 string) ([]*models.Resource, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllServiceResources", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]*models.Resource)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAllServiceResources indicates an expected call of GetAllServiceResources.
+func (mr *MockResourcesV1InterfaceMockRecorder) GetAllServiceResources(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllServiceResources", reflect.TypeOf((*MockResourcesV1Interface)(nil).GetAllServiceResources), arg0, arg1, arg2)
+}
+
+// GetAllStageResources mocks base method.
+func (m *MockResourcesV1Interface) GetAllStageResources(arg0, arg1 string) ([]*models.Resource, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllStageResources", arg0, arg1)
+	ret0, _ := ret[0].([]*models.Resource)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAllStageResources indicates an expected call of GetAllStageResources.
+func (mr *MockResourcesV1InterfaceMockRecorder) GetAllStageResources(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllStageResources", reflect.TypeOf((*MockResourcesV1Interface)(nil).GetAllStageResources), arg0, arg1)
+}
+
+// GetProjectResource mocks base method.
+func (m *MockResourcesV1Interface) GetProjectResource(arg0, arg1 string) (*models.Resource, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetProjectResource", arg0, arg1)
+	ret0, _ := ret[0].(*models.Resource)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetProjectResource indicates an expected call of GetProjectResource.
+func (mr *MockResourcesV1InterfaceMockRecorder) GetProjectResource(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProjectResource", reflect.TypeOf((*MockResourcesV1Interface)(nil).GetProjectResource), arg0, arg1)
+}
+
+// GetServiceResource mocks base method.
+func (m *MockResourcesV1Interface) GetServiceResource(arg0, arg1, arg2, arg3 string) (*models.Resource, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetServiceResource", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(*models.Resource)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetServiceResource indicates an expected call of GetServiceResource.
+func (mr *MockResourcesV1InterfaceMockRecorder) GetServiceResource(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetServiceResource", reflect.TypeOf((*MockResourcesV1Interface)(nil).GetServiceResource), arg0, arg1, arg2, arg3)
+}
+
+// GetStageResource mocks base method.
+func (m *MockResourcesV1Interface) GetStageResource(arg0, arg1, arg2 string) (*models.Resource, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetStageResource", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*models.Resource)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetStageResource indicates an expected call of GetStageResource.
+func (mr *MockResourcesV1InterfaceMockRecorder) GetStageResource(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStageResource", reflect.TypeOf((*MockResourcesV1Interface)(nil).GetStageResource), arg0, arg1, arg2)
+}
+
+// UpdateProjectResource mocks base method.
+func (m *MockResourcesV1Interface) UpdateProjectResource(arg0 string, arg1 *models.Resource) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateProjectResource", arg0, arg1)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateProjectResource indicates an expected call of UpdateProjectResource.
+func (mr *MockResourcesV1InterfaceMockRecorder) UpdateProjectResource(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateProjectResource", reflect.TypeOf((*MockResourcesV1Interface)(nil).UpdateProjectResource), arg0, arg1)
+}
+
+// UpdateProjectResources mocks base method.
+func (m *MockResourcesV1Interface) UpdateProjectResources(arg0 string, arg1 []*models.Resource) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateProjectResources", arg0, arg1)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateProjectResources indicates an expected call of UpdateProjectResources.
+func (mr *MockResourcesV1InterfaceMockRecorder) UpdateProjectResources(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateProjectResources", reflect.TypeOf((*MockResourcesV1Interface)(nil).UpdateProjectResources), arg0, arg1)
+}
+
+// UpdateServiceResource mocks base method.
+func (m *MockResourcesV1Interface) UpdateServiceResource(arg0, arg1, arg2 string, arg3 *models.Resource) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateServiceResource", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateServiceResource indicates an expected call of UpdateServiceResource.
+func (mr *MockResourcesV1InterfaceMockRecorder) UpdateServiceResource(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateServiceResource", reflect.TypeOf((*MockResourcesV1Interface)(nil).UpdateServiceResource), arg0, arg1, arg2, arg3)
+}
+
+// UpdateServiceResources mocks base method.
+func (m *MockResourcesV1Interface) UpdateServiceResources(arg0, arg1, arg2 string, arg3 []*models.Resource) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateServiceResources", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateServiceResources indicates an expected call of UpdateServiceResources.
+func (mr *MockResourcesV1InterfaceMockRecorder) UpdateServiceResources(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateServiceResources", reflect.TypeOf((*MockResourcesV1Interface)(nil).UpdateServiceResources), arg0, arg1, arg2, arg3)
+}
+
+// UpdateStageResource mocks base method.
+func (m *MockResourcesV1Interface) UpdateStageResource(arg0, arg1 string, arg2 *models.Resource) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateStageResource", arg0, arg1, arg2)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateStageResource indicates an expected call of UpdateStageResource.
+func (mr *MockResourcesV1InterfaceMockRecorder) UpdateStageResource(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateStageResource", reflect.TypeOf((*MockResourcesV1Interface)(nil).UpdateStageResource), arg0, arg1, arg2)
+}
+
+// UpdateStageResources mocks base method.
+func (m *MockResourcesV1Interface) UpdateStageResources(arg0, arg1 string, arg2 []*models.Resource) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateStageResources", arg0, arg1, arg2)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateStageResources indicates an expected call of UpdateStageResources.
+func (mr *MockResourcesV1InterfaceMockRecorder) UpdateStageResources(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateStageResources", reflect.TypeOf((*MockResourcesV1Interface)(nil).UpdateStageResources), arg0, arg1, arg2)
+}
+
+// MockSecretsV1Interface is a mock of SecretsV1Interface interface.
+type MockSecretsV1Interface struct {
+	ctrl     *gomock.Controller
+	recorder *MockSecretsV1InterfaceMockRecorder
+}
+
+// MockSecretsV1InterfaceMockRecorder is the mock recorder for MockSecretsV1Interface.
+type MockSecretsV1InterfaceMockRecorder struct {
+	mock *MockSecretsV1Interface
+}
+
+// NewMockSecretsV1Interface creates a new mock instance.
+func NewMockSecretsV1Interface(ctrl *gomock.Controller) *MockSecretsV1Interface {
+	mock := &MockSecretsV1Interface{ctrl: ctrl}
+	mock.recorder = &MockSecretsV1InterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSecretsV1Interface) EXPECT() *MockSecretsV1InterfaceMockRecorder {
+	return m.recorder
+}
+
+// CreateSecret mocks base method.
+func (m *MockSecretsV1Interface) CreateSecret(arg0 models.Secret) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateSecret", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateSecret indicates an expected call of CreateSecret.
+func (mr *MockSecretsV1InterfaceMockRecorder) CreateSecret(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSecret", reflect.TypeOf((*MockSecretsV1Interface)(nil).CreateSecret), arg0)
+}
+
+// DeleteSecret mocks base method.
+func (m *MockSecretsV1Interface) DeleteSecret(arg0, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteSecret", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteSecret indicates an expected call of DeleteSecret.
+func (mr *MockSecretsV1InterfaceMockRecorder) DeleteSecret(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSecret", reflect.TypeOf((*MockSecretsV1Interface)(nil).DeleteSecret), arg0, arg1)
+}
+
+// GetSecrets mocks base method.
+func (m *MockSecretsV1Interface) GetSecrets() (*models.GetSecretsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSecrets")
+	ret0, _ := ret[0].(*models.GetSecretsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSecrets indicates an expected call of GetSecrets.
+func (mr *MockSecretsV1InterfaceMockRecorder) GetSecrets() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSecrets", reflect.TypeOf((*MockSecretsV1Interface)(nil).GetSecrets))
+}
+
+// UpdateSecret mocks base method.
+func (m *MockSecretsV1Interface) UpdateSecret(arg0 models.Secret) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateSecret", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateSecret indicates an expected call of UpdateSecret.
+func (mr *MockSecretsV1InterfaceMockRecorder) UpdateSecret(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateSecret", reflect.TypeOf((*MockSecretsV1Interface)(nil).UpdateSecret), arg0)
+}
+
+// MockSequencesV1Interface is a mock of SequencesV1Interface interface.
+type MockSequencesV1Interface struct {
+	ctrl     *gomock.Controller
+	recorder *MockSequencesV1InterfaceMockRecorder
+}
+
+// MockSequencesV1InterfaceMockRecorder is the mock recorder for MockSequencesV1Interface.
+type MockSequencesV1InterfaceMockRecorder struct {
+	mock *MockSequencesV1Interface
+}
+
+// NewMockSequencesV1Interface creates a new mock instance.
+func NewMockSequencesV1Interface(ctrl *gomock.Controller) *MockSequencesV1Interface {
+	mock := &MockSequencesV1Interface{ctrl: ctrl}
+	mock.recorder = &MockSequencesV1InterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSequencesV1Interface) EXPECT() *MockSequencesV1InterfaceMockRecorder {
+	return m.recorder
+}
+
+// ControlSequence mocks base method.
+func (m *MockSequencesV1Interface) ControlSequence(arg0 api.SequenceControlParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ControlSequence", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ControlSequence indicates an expected call of ControlSequence.
+func (mr *MockSequencesV1InterfaceMockRecorder) ControlSequence(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ControlSequence", reflect.TypeOf((*MockSequencesV1Interface)(nil).ControlSequence), arg0)
+}
+
+// MockServicesV1Interface is a mock of ServicesV1Interface interface.
+type MockServicesV1Interface struct {
+	ctrl     *gomock.Controller
+	recorder *MockServicesV1InterfaceMockRecorder
+}
+
+// MockServicesV1InterfaceMockRecorder is the mock recorder for MockServicesV1Interface.
+type MockServicesV1InterfaceMockRecorder struct {
+	mock *MockServicesV1Interface
+}
+
+// NewMockServicesV1Interface creates a new mock instance.
+func NewMockServicesV1Interface(ctrl *gomock.Controller) *MockServicesV1Interface {
+	mock := &MockServicesV1Interface{ctrl: ctrl}
+	mock.recorder = &MockServicesV1InterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockServicesV1Interface) EXPECT() *MockServicesV1InterfaceMockRecorder {
+	return m.recorder
+}
+
+// CreateServiceInStage mocks base method.
+func (m *MockServicesV1Interface) CreateServiceInStage(arg0, arg1, arg2 string) (*models.EventContext, *models.Error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateServiceInStage", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*models.EventContext)
+	ret1, _ := ret[1].(*models.Error)
+	return ret0, ret1
+}
+
+// CreateServiceInStage indicates an expected call of CreateServiceInStage.
+func (mr *MockServicesV1InterfaceMockRecorder) CreateServiceInStage(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateServiceInStage", reflect.TypeOf((*MockServicesV1Interface)(nil).CreateServiceInStage), arg0, arg1, arg2)
+}
+
+// DeleteServiceFromStage mocks base method.
+func (m *MockServicesV1Interface) DeleteServiceFromStage(arg0, arg1, arg2 string) (*models.EventContext, *models.Error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteServiceFromStage", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*models.EventContext)
+	ret1, _ := ret[1].(*models.Error)
+	return ret0, ret1
+}
+
+// DeleteServiceFromStage indicates an expected call of DeleteServiceFromStage.
+func (mr *MockServicesV1InterfaceMockRecorder) DeleteServiceFromStage(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteServiceFromStage", reflect.TypeOf((*MockServicesV1Interface)(nil).DeleteServiceFromStage), arg0, arg1, arg2)
+}
+
+// GetAllServices mocks base method.
+func (m *MockServicesV1Interface) GetAllServices(arg0, arg1 string) ([]*models.Service, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllServices", arg0, arg1)
+	ret0, _ := ret[0].([]*models.Service)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAllServices indicates an expected call of GetAllServices.
+func (mr *MockServicesV1InterfaceMockRecorder) GetAllServices(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllServices", reflect.TypeOf((*MockServicesV1Interface)(nil).GetAllServices), arg0, arg1)
+}
+
+// GetService mocks base method.
+func (m *MockServicesV1Interface) GetService(arg0, arg1, arg2 string) (*models.Service, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetService", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*models.Service)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetService indicates an expected call of GetService.
+func (mr *MockServicesV1InterfaceMockRecorder) GetService(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetService", reflect.TypeOf((*MockServicesV1Interface)(nil).GetService), arg0, arg1, arg2)
+}
+
+// MockShipyardControlV1Interface is a mock of ShipyardControlV1Interface interface.
+type MockShipyardControlV1Interface struct {
+	ctrl     *gomock.Controller
+	recorder *MockShipyardControlV1InterfaceMockRecorder
+}
+
+// MockShipyardControlV1InterfaceMockRecorder is the mock recorder for MockShipyardControlV1Interface.
+type MockShipyardControlV1InterfaceMockRecorder struct {
+	mock *MockShipyardControlV1Interface
+}
+
+// NewMockShipyardControlV1Interface creates a new mock instance.
+func NewMockShipyardControlV1Interface(ctrl *gomock.Controller) *MockShipyardControlV1Interface {
+	mock := &MockShipyardControlV1Interface{ctrl: ctrl}
+	mock.recorder = &MockShipyardControlV1InterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockShipyardControlV1Interface) EXPECT() *MockShipyardControlV1InterfaceMockRecorder {
+	return m.recorder
+}
+
+// GetOpenTriggeredEvents mocks base method.
+func (m *MockShipyardControlV1Interface) GetOpenTriggeredEvents(arg0 api.EventFilter) ([]*models.KeptnContextExtendedCE, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOpenTriggeredEvents", arg0)
+	ret0, _ := ret[0].([]*models.KeptnContextExtendedCE)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOpenTriggeredEvents indicates an expected call of GetOpenTriggeredEvents.
+func (mr *MockShipyardControlV1InterfaceMockRecorder) GetOpenTriggeredEvents(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOpenTriggeredEvents", reflect.TypeOf((*MockShipyardControlV1Interface)(nil).GetOpenTriggeredEvents), arg0)
+}
+
+// MockSleeperV1 is a mock of Sleeper interface.
+type MockSleeperV1 struct {
+	ctrl     *gomock.Controller
+	recorder *MockSleeperV1MockRecorder
+}
+
+// MockSleeperV1MockRecorder is the mock recorder for MockSleeperV1.
+type MockSleeperV1MockRecorder struct {
+	mock *MockSleeperV1
+}
+
+// NewMockSleeperV1 creates a new mock instance.
+func NewMockSleeperV1(ctrl *gomock.Controller) *MockSleeperV1 {
+	mock := &MockSleeperV1{ctrl: ctrl}
+	mock.recorder = &MockSleeperV1MockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSleeperV1) EXPECT() *MockSleeperV1MockRecorder {
+	return m.recorder
+}
+
+// Sleep mocks base method.
+func (m *MockSleeperV1) Sleep() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Sleep")
+}
+
+// Sleep indicates an expected call of Sleep.
+func (mr *MockSleeperV1MockRecorder) Sleep() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Sleep", reflect.TypeOf((*MockSleeperV1)(nil).Sleep))
+}
+
+// MockStagesV1Interface is a mock of StagesV1Interface interface.
+type MockStagesV1Interface struct {
+	ctrl     *gomock.Controller
+	recorder *MockStagesV1InterfaceMockRecorder
+}
+
+// MockStagesV1InterfaceMockRecorder is the mock recorder for MockStagesV1Interface.
+type MockStagesV1InterfaceMockRecorder struct {
+	mock *MockStagesV1Interface
+}
+
+// NewMockStagesV1Interface creates a new mock instance.
+func NewMockStagesV1Interface(ctrl *gomock.Controller) *MockStagesV1Interface {
+	mock := &MockStagesV1Interface{ctrl: ctrl}
+	mock.recorder = &MockStagesV1InterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockStagesV1Interface) EXPECT() *MockStagesV1InterfaceMockRecorder {
+	return m.recorder
+}
+
+// CreateStage mocks base method.
+func (m *MockStagesV1Interface) CreateStage(arg0, arg1 string) (*models.EventContext, *models.Error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateStage", arg0, arg1)
+	ret0, _ := ret[0].(*models.EventContext)
+	ret1, _ := ret[1].(*models.Error)
+	return ret0, ret1
+}
+
+// CreateStage indicates an expected call of CreateStage.
+func (mr *MockStagesV1InterfaceMockRecorder) CreateStage(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateStage", reflect.TypeOf((*MockStagesV1Interface)(nil).CreateStage), arg0, arg1)
+}
+
+// GetAllStages mocks base method.
+func (m *MockStagesV1Interface) GetAllStages(arg0 string) ([]*models.Stage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllStages", arg0)
+	ret0, _ := ret[0].([]*models.Stage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAllStages indicates an expected call of GetAllStages.
+func (mr *MockStagesV1InterfaceMockRecorder) GetAllStages(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllStages", reflect.TypeOf((*MockStagesV1Interface)(nil).GetAllStages), arg0)
+}
+
+// MockUniformV1Interface is a mock of UniformV1Interface interface.
+type MockUniformV1Interface struct {
+	ctrl     *gomock.Controller
+	recorder *MockUniformV1InterfaceMockRecorder
+}
+
+// MockUniformV1InterfaceMockRecorder is the mock recorder for MockUniformV1Interface.
+type MockUniformV1InterfaceMockRecorder struct {
+	mock *MockUniformV1Interface
+}
+
+// NewMockUniformV1Interface creates a new mock instance.
+func NewMockUniformV1Interface(ctrl *gomock.Controller) *MockUniformV1Interface {
+	mock := &MockUniformV1Interface{ctrl: ctrl}
+	mock.recorder = &MockUniformV1InterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUniformV1Interface) EXPECT() *MockUniformV1InterfaceMockRecorder {
+	return m.recorder
+}
+
+// CreateSubscription mocks base method.
+func (m *MockUniformV1Interface) CreateSubscription(arg0 string, arg1 models.EventSubscription) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateSubscription", arg0, arg1)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateSubscription indicates an expected call of CreateSubscription.
+func (mr *MockUniformV1InterfaceMockRecorder) CreateSubscription(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSubscription", reflect.TypeOf((*MockUniformV1Interface)(nil).CreateSubscription), arg0, arg1)
+}
+
+// GetRegistrations mocks base method.
+func (m *MockUniformV1Interface) GetRegistrations() ([]*models.Integration, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRegistrations")
+	ret0, _ := ret[0].([]*models.Integration)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRegistrations indicates an expected call of GetRegistrations.
+func (mr *MockUniformV1InterfaceMockRecorder) GetRegistrations() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRegistrations", reflect.TypeOf((*MockUniformV1Interface)(nil).GetRegistrations))
+}
+
+// Ping mocks base method.
+func (m *MockUniformV1Interface) Ping(arg0 string) (*models.Integration, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Ping", arg0)
+	ret0, _ := ret[0].(*models.Integration)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Ping indicates an expected call of Ping.
+func (mr *MockUniformV1InterfaceMockRecorder) Ping(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Ping", reflect.TypeOf((*MockUniformV1Interface)(nil).Ping), arg0)
+}
+
+// RegisterIntegration mocks base method.
+func (m *MockUniformV1Interface) RegisterIntegration(arg0 models.Integration) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RegisterIntegration", arg0)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RegisterIntegration indicates an expected call of RegisterIntegration.
+func (mr *MockUniformV1InterfaceMockRecorder) RegisterIntegration(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterIntegration", reflect.TypeOf((*MockUniformV1Interface)(nil).RegisterIntegration), arg0)
+}
+
+// UnregisterIntegration mocks base method.
+func (m *MockUniformV1Interface) UnregisterIntegration(arg0 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnregisterIntegration", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UnregisterIntegration indicates an expected call of UnregisterIntegration.
+func (mr *MockUniformV1InterfaceMockRecorder) UnregisterIntegration(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnregisterIntegration", reflect.TypeOf((*MockUniformV1Interface)(nil).UnregisterIntegration), arg0)
+}
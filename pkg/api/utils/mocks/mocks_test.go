@@ -0,0 +1,115 @@
+package mocks
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/keptn/go-utils/pkg/api/models"
+	api "github.com/keptn/go-utils/pkg/api/utils"
+	"github.com/keptn/go-utils/pkg/api/utils/v2"
+	"github.com/stretchr/testify/require"
+)
+
+// the mocks are generated with -skip-ensure, so that this test fails instead of the build if they are outdated
+var (
+	_ v2.APIInterface             = &APIInterfaceMock{}
+	_ v2.AuthInterface            = &AuthInterfaceMock{}
+	_ v2.EventHandlerInterface    = &EventHandlerInterfaceMock{}
+	_ v2.EventsInterface          = &EventsInterfaceMock{}
+	_ v2.KeptnInterface           = &KeptnInterfaceMock{}
+	_ v2.LogsInterface            = &LogsInterfaceMock{}
+	_ v2.ProjectsInterface        = &ProjectsInterfaceMock{}
+	_ v2.ResourcesInterface       = &ResourcesInterfaceMock{}
+	_ v2.SecretsInterface         = &SecretsInterfaceMock{}
+	_ v2.SequencesInterface       = &SequencesInterfaceMock{}
+	_ v2.ServicesInterface        = &ServicesInterfaceMock{}
+	_ v2.ShipyardControlInterface = &ShipyardControlInterfaceMock{}
+	_ v2.Sleeper                  = &SleeperMock{}
+	_ v2.StagesInterface          = &StagesInterfaceMock{}
+	_ v2.TokenProvider            = &TokenProviderMock{}
+	_ v2.UniformInterface         = &UniformInterfaceMock{}
+
+	_ api.APIV1Interface             = &APIV1InterfaceMock{}
+	_ api.AuthV1Interface            = &AuthV1InterfaceMock{}
+	_ api.EventHandlerInterface      = &EventHandlerV1InterfaceMock{}
+	_ api.EventsV1Interface          = &EventsV1InterfaceMock{}
+	_ api.KeptnInterface             = &KeptnV1InterfaceMock{}
+	_ api.LogsV1Interface            = &LogsV1InterfaceMock{}
+	_ api.ILogHandler                = &LogsV1InterfaceMock{}
+	_ api.ProjectsV1Interface        = &ProjectsV1InterfaceMock{}
+	_ api.ResourcesV1Interface       = &ResourcesV1InterfaceMock{}
+	_ api.SecretsV1Interface         = &SecretsV1InterfaceMock{}
+	_ api.SecretHandlerInterface     = &SecretsV1InterfaceMock{}
+	_ api.SequencesV1Interface       = &SequencesV1InterfaceMock{}
+	_ api.ServicesV1Interface        = &ServicesV1InterfaceMock{}
+	_ api.ShipyardControlV1Interface = &ShipyardControlV1InterfaceMock{}
+	_ api.Sleeper                    = &SleeperV1Mock{}
+	_ api.StagesV1Interface          = &StagesV1InterfaceMock{}
+	_ api.UniformV1Interface         = &UniformV1InterfaceMock{}
+)
+
+func TestKeptnInterfaceMock(t *testing.T) {
+	projects := &ProjectsInterfaceMock{
+		GetProjectFunc: func(ctx context.Context, project models.Project, opts v2.ProjectsGetProjectOptions) (*models.Project, *models.Error) {
+			return &models.Project{ProjectName: project.ProjectName}, nil
+		},
+	}
+	keptn := &KeptnInterfaceMock{
+		ProjectsFunc: func() v2.ProjectsInterface { return projects },
+	}
+
+	project, err := keptn.Projects().GetProject(context.TODO(), models.Project{ProjectName: "sockshop"}, v2.ProjectsGetProjectOptions{})
+	require.Nil(t, err)
+	require.Equal(t, "sockshop", project.ProjectName)
+	require.Len(t, keptn.ProjectsCalls(), 1)
+	require.Len(t, projects.GetProjectCalls(), 1)
+	require.Equal(t, "sockshop", projects.GetProjectCalls()[0].Project.ProjectName)
+	require.Panics(t, func() { keptn.Events() })
+}
+
+func TestKeptnV1InterfaceMock(t *testing.T) {
+	projects := &ProjectsV1InterfaceMock{
+		GetProjectFunc: func(project models.Project) (*models.Project, *models.Error) {
+			return &models.Project{ProjectName: project.ProjectName}, nil
+		},
+	}
+	keptn := &KeptnV1InterfaceMock{
+		ProjectsV1Func: func() api.ProjectsV1Interface { return projects },
+	}
+
+	project, err := keptn.ProjectsV1().GetProject(models.Project{ProjectName: "sockshop"})
+	require.Nil(t, err)
+	require.Equal(t, "sockshop", project.ProjectName)
+	require.Len(t, keptn.ProjectsV1Calls(), 1)
+	require.Len(t, projects.GetProjectCalls(), 1)
+	require.Panics(t, func() { keptn.EventsV1() })
+}
+
+func TestMockKeptnInterface(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	projects := NewMockProjectsInterface(ctrl)
+	projects.EXPECT().
+		GetProject(gomock.Any(), models.Project{ProjectName: "sockshop"}, v2.ProjectsGetProjectOptions{}).
+		Return(&models.Project{ProjectName: "sockshop"}, nil)
+	keptn := NewMockKeptnInterface(ctrl)
+	keptn.EXPECT().Projects().Return(projects)
+
+	project, err := keptn.Projects().GetProject(context.TODO(), models.Project{ProjectName: "sockshop"}, v2.ProjectsGetProjectOptions{})
+	require.Nil(t, err)
+	require.Equal(t, "sockshop", project.ProjectName)
+}
+
+func TestMockKeptnV1Interface(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	projects := NewMockProjectsV1Interface(ctrl)
+	projects.EXPECT().
+		GetProject(models.Project{ProjectName: "sockshop"}).
+		Return(&models.Project{ProjectName: "sockshop"}, nil)
+	keptn := NewMockKeptnV1Interface(ctrl)
+	keptn.EXPECT().ProjectsV1().Return(projects)
+
+	project, err := keptn.ProjectsV1().GetProject(models.Project{ProjectName: "sockshop"})
+	require.Nil(t, err)
+	require.Equal(t, "sockshop", project.ProjectName)
+}
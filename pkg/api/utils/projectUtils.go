@@ -1,9 +1,8 @@
 package api
 
 import (
-	"context"
 	"net/http"
-	"strings"
+	"sync"
 
 	"github.com/keptn/go-utils/pkg/api/models"
 	v2 "github.com/keptn/go-utils/pkg/api/utils/v2"
@@ -32,11 +31,26 @@ type ProjectsV1Interface interface {
 // ProjectHandler handles projects
 type ProjectHandler struct {
 	projectHandler *v2.ProjectHandler
-	BaseURL        string
-	AuthToken      string
-	AuthHeader     string
-	HTTPClient     *http.Client
-	Scheme         string
+
+	// Deprecated: changes to the field are not applied to the handler; create a new handler to use another base URL
+	BaseURL string
+
+	// Deprecated: use WithAuthToken to derive a handler with another token
+	AuthToken string
+
+	// Deprecated: use WithAuthToken to derive a handler with another auth header
+	AuthHeader string
+
+	// Deprecated: use WithHTTPClient to derive a handler with another http.Client
+	HTTPClient *http.Client
+
+	// Deprecated: changes to the field are not applied to the handler; create a new handler to use another scheme
+	Scheme string
+
+	once          sync.Once
+	strictContext StrictContextMode
+	basePathMode  v2.BasePathMode
+	endpoint      string
 }
 
 // NewProjectHandler returns a new ProjectHandler which sends all requests directly to the configuration-service
@@ -51,35 +65,35 @@ func NewProjectHandlerWithHTTPClient(baseURL string, httpClient *http.Client) *P
 		HTTPClient:     httpClient,
 		Scheme:         "http",
 		projectHandler: v2.NewProjectHandlerWithHTTPClient(baseURL, httpClient),
+		endpoint:       baseURL,
+		basePathMode:   v2.ExactBaseURL,
 	}
 }
 
-// NewAuthenticatedProjectHandler returns a new ProjectHandler that authenticates at the api via the provided token
+// NewAuthenticatedProjectHandler returns a new ProjectHandler that authenticates at the api via the provided token.
+// The base path of the service is added to the base URL according to basePathMode (default v2.AppendMissingBasePath)
 // and sends all requests directly to the configuration-service
 // Deprecated: use APISet instead
-func NewAuthenticatedProjectHandler(baseURL string, authToken string, authHeader string, httpClient *http.Client, scheme string) *ProjectHandler {
+func NewAuthenticatedProjectHandler(baseURL string, authToken string, authHeader string, httpClient *http.Client, scheme string, basePathMode ...v2.BasePathMode) *ProjectHandler {
 	if httpClient == nil {
 		httpClient = &http.Client{}
 	}
 	httpClient.Transport = wrapOtelTransport(getClientTransport(httpClient.Transport))
-	return createAuthenticatedProjectHandler(baseURL, authToken, authHeader, httpClient, scheme)
+	return createAuthenticatedProjectHandler(baseURL, authToken, authHeader, httpClient, scheme, basePathModeOf(basePathMode))
 }
 
-func createAuthenticatedProjectHandler(baseURL string, authToken string, authHeader string, httpClient *http.Client, scheme string) *ProjectHandler {
-	v2ProjectHandler := v2.NewAuthenticatedProjectHandler(baseURL, authToken, authHeader, httpClient, scheme)
-
-	baseURL = strings.TrimRight(baseURL, "/")
-	if !strings.HasSuffix(baseURL, shipyardControllerBaseURL) {
-		baseURL += "/" + shipyardControllerBaseURL
-	}
+func createAuthenticatedProjectHandler(baseURL string, authToken string, authHeader string, httpClient *http.Client, scheme string, basePathMode v2.BasePathMode) *ProjectHandler {
+	v2ProjectHandler := v2.NewAuthenticatedProjectHandler(baseURL, authToken, authHeader, httpClient, scheme, basePathMode)
 
 	return &ProjectHandler{
-		BaseURL:        httputils.TrimHTTPScheme(baseURL),
+		BaseURL:        httputils.TrimHTTPScheme(basePathMode.HandlerBaseURL(baseURL, v2.HandlerProjects)),
 		AuthHeader:     authHeader,
 		AuthToken:      authToken,
 		HTTPClient:     httpClient,
 		Scheme:         scheme,
 		projectHandler: v2ProjectHandler,
+		basePathMode:   basePathMode,
+		endpoint:       baseURL,
 	}
 }
 
@@ -102,41 +116,79 @@ func (p *ProjectHandler) getHTTPClient() *http.Client {
 // CreateProject creates a new project.
 func (p *ProjectHandler) CreateProject(project models.Project) (*models.EventContext, *models.Error) {
 	p.ensureHandlerIsSet()
-	return p.projectHandler.CreateProject(context.TODO(), project, v2.ProjectsCreateProjectOptions{})
+	ctx, err := p.strictContext.implicitContext("ProjectHandler.CreateProject")
+	if err != nil {
+		return nil, implicitContextResponse(err)
+	}
+	return p.projectHandler.CreateProject(ctx, project, v2.ProjectsCreateProjectOptions{})
 }
 
 // DeleteProject deletes a project.
 func (p *ProjectHandler) DeleteProject(project models.Project) (*models.EventContext, *models.Error) {
 	p.ensureHandlerIsSet()
-	return p.projectHandler.DeleteProject(context.TODO(), project, v2.ProjectsDeleteProjectOptions{})
+	ctx, err := p.strictContext.implicitContext("ProjectHandler.DeleteProject")
+	if err != nil {
+		return nil, implicitContextResponse(err)
+	}
+	return p.projectHandler.DeleteProject(ctx, project, v2.ProjectsDeleteProjectOptions{})
 }
 
 // GetProject returns a project.
 func (p *ProjectHandler) GetProject(project models.Project) (*models.Project, *models.Error) {
 	p.ensureHandlerIsSet()
-	return p.projectHandler.GetProject(context.TODO(), project, v2.ProjectsGetProjectOptions{})
+	ctx, err := p.strictContext.implicitContext("ProjectHandler.GetProject")
+	if err != nil {
+		return nil, implicitContextResponse(err)
+	}
+	return p.projectHandler.GetProject(ctx, project, v2.ProjectsGetProjectOptions{})
 }
 
 // GetAllProjects returns all projects.
 func (p *ProjectHandler) GetAllProjects() ([]*models.Project, error) {
 	p.ensureHandlerIsSet()
-	return p.projectHandler.GetAllProjects(context.TODO(), v2.ProjectsGetAllProjectsOptions{})
+	ctx, err := p.strictContext.implicitContext("ProjectHandler.GetAllProjects")
+	if err != nil {
+		return nil, err
+	}
+	return p.projectHandler.GetAllProjects(ctx, v2.ProjectsGetAllProjectsOptions{})
 }
 
 // UpdateConfigurationServiceProject updates a configuration service project.
 func (p *ProjectHandler) UpdateConfigurationServiceProject(project models.Project) (*models.EventContext, *models.Error) {
 	p.ensureHandlerIsSet()
-	return p.projectHandler.UpdateConfigurationServiceProject(context.TODO(), project, v2.ProjectsUpdateConfigurationServiceProjectOptions{})
+	ctx, err := p.strictContext.implicitContext("ProjectHandler.UpdateConfigurationServiceProject")
+	if err != nil {
+		return nil, implicitContextResponse(err)
+	}
+	return p.projectHandler.UpdateConfigurationServiceProject(ctx, project, v2.ProjectsUpdateConfigurationServiceProjectOptions{})
 }
 
 func (p *ProjectHandler) ensureHandlerIsSet() {
-	if p.projectHandler != nil {
-		return
-	}
-
-	if p.AuthToken != "" {
-		p.projectHandler = v2.NewAuthenticatedProjectHandler(p.BaseURL, p.AuthToken, p.AuthHeader, p.HTTPClient, p.Scheme)
-	} else {
-		p.projectHandler = v2.NewProjectHandlerWithHTTPClient(p.BaseURL, p.HTTPClient)
-	}
+	p.once.Do(func() {
+		if p.projectHandler != nil {
+			return
+		}
+
+		if p.AuthToken != "" {
+			p.projectHandler = v2.NewAuthenticatedProjectHandler(p.BaseURL, p.AuthToken, p.AuthHeader, p.HTTPClient, p.Scheme)
+		} else {
+			p.projectHandler = v2.NewProjectHandlerWithHTTPClient(p.BaseURL, p.HTTPClient)
+		}
+	})
+}
+
+// WithAuthToken returns a new ProjectHandler that uses the given token and auth header but otherwise the same
+// settings. The ProjectHandler itself is not modified, so it can still be used concurrently
+func (p *ProjectHandler) WithAuthToken(authToken string, authHeader string) *ProjectHandler {
+	derived := createAuthenticatedProjectHandler(derivedBaseURL(p.endpoint, p.BaseURL), authToken, authHeader, p.HTTPClient, p.Scheme, p.basePathMode)
+	derived.strictContext = p.strictContext
+	return derived
+}
+
+// WithHTTPClient returns a new ProjectHandler that uses the given http.Client but otherwise the same settings.
+// The ProjectHandler itself is not modified, so it can still be used concurrently
+func (p *ProjectHandler) WithHTTPClient(httpClient *http.Client) *ProjectHandler {
+	derived := createAuthenticatedProjectHandler(derivedBaseURL(p.endpoint, p.BaseURL), p.AuthToken, p.AuthHeader, httpClient, p.Scheme, p.basePathMode)
+	derived.strictContext = p.strictContext
+	return derived
 }
@@ -1,11 +1,10 @@
 package api
 
 import (
-	"context"
 	"encoding/json"
 	"net/http"
 	"net/url"
-	"strings"
+	"sync"
 
 	"github.com/keptn/go-utils/pkg/api/models"
 	v2 "github.com/keptn/go-utils/pkg/api/utils/v2"
@@ -15,7 +14,6 @@ import (
 const pathToResource = "/resource"
 const pathToService = "/service"
 const pathToStage = "/stage"
-const configurationServiceBaseURL = "configuration-service"
 
 var ResourceNotFoundError = v2.ResourceNotFoundError
 
@@ -90,11 +88,26 @@ type ResourcesV1Interface interface {
 // ResourceHandler handles resources
 type ResourceHandler struct {
 	resourceHandler *v2.ResourceHandler
-	BaseURL         string
-	AuthToken       string
-	AuthHeader      string
-	HTTPClient      *http.Client
-	Scheme          string
+
+	// Deprecated: changes to the field are not applied to the handler; create a new handler to use another base URL
+	BaseURL string
+
+	// Deprecated: use WithAuthToken to derive a handler with another token
+	AuthToken string
+
+	// Deprecated: use WithAuthToken to derive a handler with another auth header
+	AuthHeader string
+
+	// Deprecated: use WithHTTPClient to derive a handler with another http.Client
+	HTTPClient *http.Client
+
+	// Deprecated: changes to the field are not applied to the handler; create a new handler to use another scheme
+	Scheme string
+
+	once          sync.Once
+	strictContext StrictContextMode
+	basePathMode  v2.BasePathMode
+	endpoint      string
 }
 
 type resourceRequest struct {
@@ -141,19 +154,19 @@ func (s *ResourceScope) Resource(resource string) *ResourceScope {
 // GetProjectPath returns a string to construct the url to path eg. /<api-version>/project/<project-name>
 //or an empty string if the project is not set
 func (s *ResourceScope) GetProjectPath() string {
-	return buildPath(v1ProjectPath, s.project)
+	return buildPath(v1ProjectPath, v2.EscapeIdentifier(s.project))
 }
 
 // GetStagePath returns a string to construct the url to a stage eg. /stage/<stage-name>
 //or an empty string if the stage is unset
 func (s *ResourceScope) GetStagePath() string {
-	return buildPath(pathToStage, s.stage)
+	return buildPath(pathToStage, v2.EscapeIdentifier(s.stage))
 }
 
 // GetServicePath returns a string to construct the url to a service eg. /service/<service-name>
 //or an empty string if the service is unset
 func (s *ResourceScope) GetServicePath() string {
-	return buildPath(pathToService, url.QueryEscape(s.service))
+	return buildPath(pathToService, v2.EscapeIdentifier(s.service))
 }
 
 // GetResourcePath returns a string to construct the url to a resource eg. /resource/<escaped-resource-name>
@@ -217,35 +230,35 @@ func NewResourceHandlerWithHTTPClient(baseURL string, httpClient *http.Client) *
 		HTTPClient:      httpClient,
 		Scheme:          "http",
 		resourceHandler: v2.NewResourceHandlerWithHTTPClient(baseURL, httpClient),
+		endpoint:        baseURL,
+		basePathMode:    v2.ExactBaseURL,
 	}
 }
 
 // NewAuthenticatedResourceHandler returns a new ResourceHandler that authenticates at the api via the provided token
-// and sends all requests directly to the configuration-service
+// and sends all requests directly to the configuration-service.
+// The base path of the service is added to the base URL according to basePathMode (default v2.AppendMissingBasePath)
 // Deprecated: use APISet instead
-func NewAuthenticatedResourceHandler(baseURL string, authToken string, authHeader string, httpClient *http.Client, scheme string) *ResourceHandler {
+func NewAuthenticatedResourceHandler(baseURL string, authToken string, authHeader string, httpClient *http.Client, scheme string, basePathMode ...v2.BasePathMode) *ResourceHandler {
 	if httpClient == nil {
 		httpClient = &http.Client{}
 	}
 	httpClient.Transport = wrapOtelTransport(getClientTransport(httpClient.Transport))
-	return createAuthenticatedResourceHandler(baseURL, authToken, authHeader, httpClient, scheme)
+	return createAuthenticatedResourceHandler(baseURL, authToken, authHeader, httpClient, scheme, basePathModeOf(basePathMode))
 }
 
-func createAuthenticatedResourceHandler(baseURL string, authToken string, authHeader string, httpClient *http.Client, scheme string) *ResourceHandler {
-	v2ResourceHandler := v2.NewAuthenticatedResourceHandler(baseURL, authToken, authHeader, httpClient, scheme)
-
-	baseURL = strings.TrimRight(baseURL, "/")
-	if !strings.HasSuffix(baseURL, configurationServiceBaseURL) {
-		baseURL += "/" + configurationServiceBaseURL
-	}
+func createAuthenticatedResourceHandler(baseURL string, authToken string, authHeader string, httpClient *http.Client, scheme string, basePathMode v2.BasePathMode) *ResourceHandler {
+	v2ResourceHandler := v2.NewAuthenticatedResourceHandler(baseURL, authToken, authHeader, httpClient, scheme, basePathMode)
 
 	return &ResourceHandler{
-		BaseURL:         httputils.TrimHTTPScheme(baseURL),
+		BaseURL:         httputils.TrimHTTPScheme(basePathMode.HandlerBaseURL(baseURL, v2.HandlerResources)),
 		AuthHeader:      authHeader,
 		AuthToken:       authToken,
 		HTTPClient:      httpClient,
 		Scheme:          scheme,
 		resourceHandler: v2ResourceHandler,
+		basePathMode:    basePathMode,
+		endpoint:        baseURL,
 	}
 }
 
@@ -268,148 +281,236 @@ func (r *ResourceHandler) getHTTPClient() *http.Client {
 // CreateResources creates a resource for the specified entity.
 func (r *ResourceHandler) CreateResources(project string, stage string, service string, resources []*models.Resource) (*models.EventContext, *models.Error) {
 	r.ensureHandlerIsSet()
-	return r.resourceHandler.CreateResources(context.TODO(), project, stage, service, resources, v2.ResourcesCreateResourcesOptions{})
+	ctx, err := r.strictContext.implicitContext("ResourceHandler.CreateResources")
+	if err != nil {
+		return nil, implicitContextResponse(err)
+	}
+	return r.resourceHandler.CreateResources(ctx, project, stage, service, resources, v2.ResourcesCreateResourcesOptions{})
 }
 
 // CreateProjectResources creates multiple project resources.
 func (r *ResourceHandler) CreateProjectResources(project string, resources []*models.Resource) (string, error) {
 	r.ensureHandlerIsSet()
-	return r.resourceHandler.CreateProjectResources(context.TODO(), project, resources, v2.ResourcesCreateProjectResourcesOptions{})
+	ctx, err := r.strictContext.implicitContext("ResourceHandler.CreateProjectResources")
+	if err != nil {
+		return "", err
+	}
+	return r.resourceHandler.CreateProjectResources(ctx, project, resources, v2.ResourcesCreateProjectResourcesOptions{})
 }
 
 // GetProjectResource retrieves a project resource from the configuration service.
 // Deprecated: use GetResource instead.
 func (r *ResourceHandler) GetProjectResource(project string, resourceURI string) (*models.Resource, error) {
 	r.ensureHandlerIsSet()
-	buildURI := r.Scheme + "://" + r.BaseURL + v1ProjectPath + "/" + project + pathToResource + "/" + url.QueryEscape(resourceURI)
-	return r.resourceHandler.GetResourceByURI(context.TODO(), buildURI)
+	buildURI := r.Scheme + "://" + r.BaseURL + v1ProjectPath + "/" + v2.EscapeIdentifier(project) + pathToResource + "/" + url.QueryEscape(resourceURI)
+	ctx, err := r.strictContext.implicitContext("ResourceHandler.GetProjectResource")
+	if err != nil {
+		return nil, err
+	}
+	return r.resourceHandler.GetResourceByURI(ctx, buildURI)
 }
 
 // UpdateProjectResource updates a project resource.
 // Deprecated: use UpdateResource instead.
 func (r *ResourceHandler) UpdateProjectResource(project string, resource *models.Resource) (string, error) {
 	r.ensureHandlerIsSet()
-	return r.resourceHandler.UpdateResourceByURI(context.TODO(), r.Scheme+"://"+r.BaseURL+v1ProjectPath+"/"+project+pathToResource+"/"+url.QueryEscape(*resource.ResourceURI), resource)
+	ctx, err := r.strictContext.implicitContext("ResourceHandler.UpdateProjectResource")
+	if err != nil {
+		return "", err
+	}
+	return r.resourceHandler.UpdateResourceByURI(ctx, r.Scheme+"://"+r.BaseURL+v1ProjectPath+"/"+v2.EscapeIdentifier(project)+pathToResource+"/"+url.QueryEscape(*resource.ResourceURI), resource)
 }
 
 // DeleteProjectResource deletes a project resource.
 // Deprecated: use DeleteResource instead.
 func (r *ResourceHandler) DeleteProjectResource(project string, resourceURI string) error {
 	r.ensureHandlerIsSet()
-	return r.resourceHandler.DeleteResourceByURI(context.TODO(), r.Scheme+"://"+r.BaseURL+v1ProjectPath+"/"+project+pathToResource+"/"+url.QueryEscape(resourceURI))
+	ctx, err := r.strictContext.implicitContext("ResourceHandler.DeleteProjectResource")
+	if err != nil {
+		return err
+	}
+	return r.resourceHandler.DeleteResourceByURI(ctx, r.Scheme+"://"+r.BaseURL+v1ProjectPath+"/"+v2.EscapeIdentifier(project)+pathToResource+"/"+url.QueryEscape(resourceURI))
 }
 
 // UpdateProjectResources updates multiple project resources.
 func (r *ResourceHandler) UpdateProjectResources(project string, resources []*models.Resource) (string, error) {
 	r.ensureHandlerIsSet()
-	return r.resourceHandler.UpdateProjectResources(context.TODO(), project, resources, v2.ResourcesUpdateProjectResourcesOptions{})
+	ctx, err := r.strictContext.implicitContext("ResourceHandler.UpdateProjectResources")
+	if err != nil {
+		return "", err
+	}
+	return r.resourceHandler.UpdateProjectResources(ctx, project, resources, v2.ResourcesUpdateProjectResourcesOptions{})
 }
 
 // CreateStageResources creates a stage resource.
 // Deprecated: use CreateResource instead.
 func (r *ResourceHandler) CreateStageResources(project string, stage string, resources []*models.Resource) (string, error) {
 	r.ensureHandlerIsSet()
-	return r.resourceHandler.CreateResourcesByURI(context.TODO(), r.Scheme+"://"+r.BaseURL+v1ProjectPath+"/"+project+pathToStage+"/"+stage+pathToResource, resources)
+	ctx, err := r.strictContext.implicitContext("ResourceHandler.CreateStageResources")
+	if err != nil {
+		return "", err
+	}
+	return r.resourceHandler.CreateResourcesByURI(ctx, r.Scheme+"://"+r.BaseURL+v1ProjectPath+"/"+v2.EscapeIdentifier(project)+pathToStage+"/"+v2.EscapeIdentifier(stage)+pathToResource, resources)
 }
 
 // GetStageResource retrieves a stage resource from the configuration service.
 // Deprecated: use GetResource instead.
 func (r *ResourceHandler) GetStageResource(project string, stage string, resourceURI string) (*models.Resource, error) {
 	r.ensureHandlerIsSet()
-	buildURI := r.Scheme + "://" + r.BaseURL + v1ProjectPath + "/" + project + pathToStage + "/" + stage + pathToResource + "/" + url.QueryEscape(resourceURI)
-	return r.resourceHandler.GetResourceByURI(context.TODO(), buildURI)
+	buildURI := r.Scheme + "://" + r.BaseURL + v1ProjectPath + "/" + v2.EscapeIdentifier(project) + pathToStage + "/" + v2.EscapeIdentifier(stage) + pathToResource + "/" + url.QueryEscape(resourceURI)
+	ctx, err := r.strictContext.implicitContext("ResourceHandler.GetStageResource")
+	if err != nil {
+		return nil, err
+	}
+	return r.resourceHandler.GetResourceByURI(ctx, buildURI)
 }
 
 // UpdateStageResource updates a stage resource.
 // Deprecated: use UpdateResource instead.
 func (r *ResourceHandler) UpdateStageResource(project string, stage string, resource *models.Resource) (string, error) {
 	r.ensureHandlerIsSet()
-	return r.resourceHandler.UpdateResourceByURI(context.TODO(), r.Scheme+"://"+r.BaseURL+v1ProjectPath+"/"+project+pathToStage+"/"+stage+pathToResource+"/"+url.QueryEscape(*resource.ResourceURI), resource)
+	ctx, err := r.strictContext.implicitContext("ResourceHandler.UpdateStageResource")
+	if err != nil {
+		return "", err
+	}
+	return r.resourceHandler.UpdateResourceByURI(ctx, r.Scheme+"://"+r.BaseURL+v1ProjectPath+"/"+v2.EscapeIdentifier(project)+pathToStage+"/"+v2.EscapeIdentifier(stage)+pathToResource+"/"+url.QueryEscape(*resource.ResourceURI), resource)
 }
 
 // UpdateStageResources updates multiple stage resources.
 // Deprecated: use UpdateResource instead.
 func (r *ResourceHandler) UpdateStageResources(project string, stage string, resources []*models.Resource) (string, error) {
 	r.ensureHandlerIsSet()
-	return r.resourceHandler.UpdateResourcesByURI(context.TODO(), r.Scheme+"://"+r.BaseURL+v1ProjectPath+"/"+project+pathToStage+"/"+stage+pathToResource, resources)
+	ctx, err := r.strictContext.implicitContext("ResourceHandler.UpdateStageResources")
+	if err != nil {
+		return "", err
+	}
+	return r.resourceHandler.UpdateResourcesByURI(ctx, r.Scheme+"://"+r.BaseURL+v1ProjectPath+"/"+v2.EscapeIdentifier(project)+pathToStage+"/"+v2.EscapeIdentifier(stage)+pathToResource, resources)
 }
 
 // DeleteStageResource deletes a stage resource.
 // Deprecated: use DeleteResource instead.
 func (r *ResourceHandler) DeleteStageResource(project string, stage string, resourceURI string) error {
 	r.ensureHandlerIsSet()
-	return r.resourceHandler.DeleteResourceByURI(context.TODO(), r.Scheme+"://"+r.BaseURL+v1ProjectPath+"/"+project+pathToStage+"/"+stage+pathToResource+"/"+url.QueryEscape(resourceURI))
+	ctx, err := r.strictContext.implicitContext("ResourceHandler.DeleteStageResource")
+	if err != nil {
+		return err
+	}
+	return r.resourceHandler.DeleteResourceByURI(ctx, r.Scheme+"://"+r.BaseURL+v1ProjectPath+"/"+v2.EscapeIdentifier(project)+pathToStage+"/"+v2.EscapeIdentifier(stage)+pathToResource+"/"+url.QueryEscape(resourceURI))
 }
 
 // CreateServiceResources creates a service resource.
 // Deprecated: use CreateResource instead.
 func (r *ResourceHandler) CreateServiceResources(project string, stage string, service string, resources []*models.Resource) (string, error) {
 	r.ensureHandlerIsSet()
-	return r.resourceHandler.CreateResourcesByURI(context.TODO(), r.Scheme+"://"+r.BaseURL+v1ProjectPath+"/"+project+pathToStage+"/"+stage+pathToService+"/"+service+pathToResource, resources)
+	ctx, err := r.strictContext.implicitContext("ResourceHandler.CreateServiceResources")
+	if err != nil {
+		return "", err
+	}
+	return r.resourceHandler.CreateResourcesByURI(ctx, r.Scheme+"://"+r.BaseURL+v1ProjectPath+"/"+v2.EscapeIdentifier(project)+pathToStage+"/"+v2.EscapeIdentifier(stage)+pathToService+"/"+v2.EscapeIdentifier(service)+pathToResource, resources)
 }
 
 // GetServiceResource retrieves a service resource from the configuration service.
 // Deprecated: use GetResource instead.
 func (r *ResourceHandler) GetServiceResource(project string, stage string, service string, resourceURI string) (*models.Resource, error) {
 	r.ensureHandlerIsSet()
-	buildURI := r.Scheme + "://" + r.BaseURL + v1ProjectPath + "/" + project + pathToStage + "/" + stage + pathToService + "/" + url.QueryEscape(service) + pathToResource + "/" + url.QueryEscape(resourceURI)
-	return r.resourceHandler.GetResourceByURI(context.TODO(), buildURI)
+	buildURI := r.Scheme + "://" + r.BaseURL + v1ProjectPath + "/" + v2.EscapeIdentifier(project) + pathToStage + "/" + v2.EscapeIdentifier(stage) + pathToService + "/" + v2.EscapeIdentifier(service) + pathToResource + "/" + url.QueryEscape(resourceURI)
+	ctx, err := r.strictContext.implicitContext("ResourceHandler.GetServiceResource")
+	if err != nil {
+		return nil, err
+	}
+	return r.resourceHandler.GetResourceByURI(ctx, buildURI)
 }
 
 // UpdateServiceResource updates a service resource.
 // Deprecated: use UpdateResource instead.
 func (r *ResourceHandler) UpdateServiceResource(project string, stage string, service string, resource *models.Resource) (string, error) {
 	r.ensureHandlerIsSet()
-	return r.resourceHandler.UpdateResourceByURI(context.TODO(), r.Scheme+"://"+r.BaseURL+v1ProjectPath+"/"+project+pathToStage+"/"+stage+pathToService+"/"+url.QueryEscape(service)+pathToResource+"/"+url.QueryEscape(*resource.ResourceURI), resource)
+	ctx, err := r.strictContext.implicitContext("ResourceHandler.UpdateServiceResource")
+	if err != nil {
+		return "", err
+	}
+	return r.resourceHandler.UpdateResourceByURI(ctx, r.Scheme+"://"+r.BaseURL+v1ProjectPath+"/"+v2.EscapeIdentifier(project)+pathToStage+"/"+v2.EscapeIdentifier(stage)+pathToService+"/"+v2.EscapeIdentifier(service)+pathToResource+"/"+url.QueryEscape(*resource.ResourceURI), resource)
 }
 
 // UpdateServiceResources updates multiple service resources.
 func (r *ResourceHandler) UpdateServiceResources(project string, stage string, service string, resources []*models.Resource) (string, error) {
 	r.ensureHandlerIsSet()
-	return r.resourceHandler.UpdateServiceResources(context.TODO(), project, stage, service, resources, v2.ResourcesUpdateServiceResourcesOptions{})
+	ctx, err := r.strictContext.implicitContext("ResourceHandler.UpdateServiceResources")
+	if err != nil {
+		return "", err
+	}
+	return r.resourceHandler.UpdateServiceResources(ctx, project, stage, service, resources, v2.ResourcesUpdateServiceResourcesOptions{})
 }
 
 // DeleteServiceResource deletes a service resource.
 // Deprecated: use DeleteResource instead.
 func (r *ResourceHandler) DeleteServiceResource(project string, stage string, service string, resourceURI string) error {
 	r.ensureHandlerIsSet()
-	return r.resourceHandler.DeleteResourceByURI(context.TODO(), r.Scheme+"://"+r.BaseURL+v1ProjectPath+"/"+project+pathToStage+"/"+stage+pathToService+"/"+url.QueryEscape(service)+pathToResource+"/"+url.QueryEscape(resourceURI))
+	ctx, err := r.strictContext.implicitContext("ResourceHandler.DeleteServiceResource")
+	if err != nil {
+		return err
+	}
+	return r.resourceHandler.DeleteResourceByURI(ctx, r.Scheme+"://"+r.BaseURL+v1ProjectPath+"/"+v2.EscapeIdentifier(project)+pathToStage+"/"+v2.EscapeIdentifier(stage)+pathToService+"/"+v2.EscapeIdentifier(service)+pathToResource+"/"+url.QueryEscape(resourceURI))
 }
 
 //GetResource returns a resource from the defined ResourceScope after applying all URI change configured in the options.
 func (r *ResourceHandler) GetResource(scope ResourceScope, options ...URIOption) (*models.Resource, error) {
 	r.ensureHandlerIsSet()
-	return r.resourceHandler.GetResource(context.TODO(), toV2ResourceScope(scope), v2.ResourcesGetResourceOptions{URIOptions: toV2URIOptions(options)})
+	ctx, err := r.strictContext.implicitContext("ResourceHandler.GetResource")
+	if err != nil {
+		return nil, err
+	}
+	return r.resourceHandler.GetResource(ctx, toV2ResourceScope(scope), v2.ResourcesGetResourceOptions{URIOptions: toV2URIOptions(options)})
 }
 
 //DeleteResource delete a resource from the URI defined by ResourceScope and modified by the URIOption.
 func (r *ResourceHandler) DeleteResource(scope ResourceScope, options ...URIOption) error {
 	r.ensureHandlerIsSet()
-	return r.resourceHandler.DeleteResource(context.TODO(), toV2ResourceScope(scope), v2.ResourcesDeleteResourceOptions{URIOptions: toV2URIOptions(options)})
+	ctx, err := r.strictContext.implicitContext("ResourceHandler.DeleteResource")
+	if err != nil {
+		return err
+	}
+	return r.resourceHandler.DeleteResource(ctx, toV2ResourceScope(scope), v2.ResourcesDeleteResourceOptions{URIOptions: toV2URIOptions(options)})
 }
 
 //UpdateResource updates a resource from the URI defined by ResourceScope and modified by the URIOption.
 func (r *ResourceHandler) UpdateResource(resource *models.Resource, scope ResourceScope, options ...URIOption) (string, error) {
 	r.ensureHandlerIsSet()
-	return r.resourceHandler.UpdateResource(context.TODO(), resource, toV2ResourceScope(scope), v2.ResourcesUpdateResourceOptions{URIOptions: toV2URIOptions(options)})
+	ctx, err := r.strictContext.implicitContext("ResourceHandler.UpdateResource")
+	if err != nil {
+		return "", err
+	}
+	return r.resourceHandler.UpdateResource(ctx, resource, toV2ResourceScope(scope), v2.ResourcesUpdateResourceOptions{URIOptions: toV2URIOptions(options)})
 }
 
 //CreateResource creates one or more resources at the URI defined by ResourceScope and modified by the URIOption.
 func (r *ResourceHandler) CreateResource(resource []*models.Resource, scope ResourceScope, options ...URIOption) (string, error) {
 	r.ensureHandlerIsSet()
-	return r.resourceHandler.CreateResource(context.TODO(), resource, toV2ResourceScope(scope), v2.ResourcesCreateResourceOptions{URIOptions: toV2URIOptions(options)})
+	ctx, err := r.strictContext.implicitContext("ResourceHandler.CreateResource")
+	if err != nil {
+		return "", err
+	}
+	return r.resourceHandler.CreateResource(ctx, resource, toV2ResourceScope(scope), v2.ResourcesCreateResourceOptions{URIOptions: toV2URIOptions(options)})
 }
 
 // GetAllStageResources returns a list of all resources.
 func (r *ResourceHandler) GetAllStageResources(project string, stage string) ([]*models.Resource, error) {
 	r.ensureHandlerIsSet()
-	return r.resourceHandler.GetAllStageResources(context.TODO(), project, stage, v2.ResourcesGetAllStageResourcesOptions{})
+	ctx, err := r.strictContext.implicitContext("ResourceHandler.GetAllStageResources")
+	if err != nil {
+		return nil, err
+	}
+	return r.resourceHandler.GetAllStageResources(ctx, project, stage, v2.ResourcesGetAllStageResourcesOptions{})
 }
 
 // GetAllServiceResources returns a list of all resources.
 func (r *ResourceHandler) GetAllServiceResources(project string, stage string, service string) ([]*models.Resource, error) {
 	r.ensureHandlerIsSet()
-	return r.resourceHandler.GetAllServiceResources(context.TODO(), project, stage, service, v2.ResourcesGetAllServiceResourcesOptions{})
+	ctx, err := r.strictContext.implicitContext("ResourceHandler.GetAllServiceResources")
+	if err != nil {
+		return nil, err
+	}
+	return r.resourceHandler.GetAllServiceResources(ctx, project, stage, service, v2.ResourcesGetAllServiceResourcesOptions{})
 }
 
 func buildPath(base, name string) string {
@@ -433,13 +534,31 @@ func toV2ResourceScope(scope ResourceScope) v2.ResourceScope {
 }
 
 func (r *ResourceHandler) ensureHandlerIsSet() {
-	if r.resourceHandler != nil {
-		return
-	}
+	r.once.Do(func() {
+		if r.resourceHandler != nil {
+			return
+		}
 
-	if r.AuthToken != "" {
-		r.resourceHandler = v2.NewAuthenticatedResourceHandler(r.BaseURL, r.AuthToken, r.AuthHeader, r.HTTPClient, r.Scheme)
-	} else {
-		r.resourceHandler = v2.NewResourceHandlerWithHTTPClient(r.BaseURL, r.HTTPClient)
-	}
+		if r.AuthToken != "" {
+			r.resourceHandler = v2.NewAuthenticatedResourceHandler(r.BaseURL, r.AuthToken, r.AuthHeader, r.HTTPClient, r.Scheme)
+		} else {
+			r.resourceHandler = v2.NewResourceHandlerWithHTTPClient(r.BaseURL, r.HTTPClient)
+		}
+	})
+}
+
+// WithAuthToken returns a new ResourceHandler that uses the given token and auth header but otherwise the same
+// settings. The ResourceHandler itself is not modified, so it can still be used concurrently
+func (r *ResourceHandler) WithAuthToken(authToken string, authHeader string) *ResourceHandler {
+	derived := createAuthenticatedResourceHandler(derivedBaseURL(r.endpoint, r.BaseURL), authToken, authHeader, r.HTTPClient, r.Scheme, r.basePathMode)
+	derived.strictContext = r.strictContext
+	return derived
+}
+
+// WithHTTPClient returns a new ResourceHandler that uses the given http.Client but otherwise the same settings.
+// The ResourceHandler itself is not modified, so it can still be used concurrently
+func (r *ResourceHandler) WithHTTPClient(httpClient *http.Client) *ResourceHandler {
+	derived := createAuthenticatedResourceHandler(derivedBaseURL(r.endpoint, r.BaseURL), r.AuthToken, r.AuthHeader, httpClient, r.Scheme, r.basePathMode)
+	derived.strictContext = r.strictContext
+	return derived
 }
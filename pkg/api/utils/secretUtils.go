@@ -1,16 +1,14 @@
 package api
 
 import (
-	"context"
 	"net/http"
-	"strings"
+	"sync"
 
 	"github.com/keptn/go-utils/pkg/api/models"
 	v2 "github.com/keptn/go-utils/pkg/api/utils/v2"
 	"github.com/keptn/go-utils/pkg/common/httputils"
 )
 
-const secretServiceBaseURL = "secrets"
 const v1SecretPath = "/v1/secret"
 
 type SecretsV1Interface interface {
@@ -35,11 +33,26 @@ type SecretHandlerInterface interface {
 // SecretHandler handles services
 type SecretHandler struct {
 	secretHandler *v2.SecretHandler
-	BaseURL       string
-	AuthToken     string
-	AuthHeader    string
-	HTTPClient    *http.Client
-	Scheme        string
+
+	// Deprecated: changes to the field are not applied to the handler; create a new handler to use another base URL
+	BaseURL string
+
+	// Deprecated: use WithAuthToken to derive a handler with another token
+	AuthToken string
+
+	// Deprecated: use WithAuthToken to derive a handler with another auth header
+	AuthHeader string
+
+	// Deprecated: use WithHTTPClient to derive a handler with another http.Client
+	HTTPClient *http.Client
+
+	// Deprecated: changes to the field are not applied to the handler; create a new handler to use another scheme
+	Scheme string
+
+	once          sync.Once
+	strictContext StrictContextMode
+	basePathMode  v2.BasePathMode
+	endpoint      string
 }
 
 // NewSecretHandler returns a new SecretHandler which sends all requests directly to the secret-service
@@ -54,35 +67,35 @@ func NewSecretHandlerWithHTTPClient(baseURL string, httpClient *http.Client) *Se
 		HTTPClient:    httpClient,
 		Scheme:        "http",
 		secretHandler: v2.NewSecretHandlerWithHTTPClient(baseURL, httpClient),
+		endpoint:      baseURL,
+		basePathMode:  v2.ExactBaseURL,
 	}
 }
 
-// NewAuthenticatedSecretHandler returns a new SecretHandler that authenticates at the api via the provided token
+// NewAuthenticatedSecretHandler returns a new SecretHandler that authenticates at the api via the provided token.
+// The base path of the service is added to the base URL according to basePathMode (default v2.AppendMissingBasePath)
 // and sends all requests directly to the secret-service
 // Deprecated: use APISet instead
-func NewAuthenticatedSecretHandler(baseURL string, authToken string, authHeader string, httpClient *http.Client, scheme string) *SecretHandler {
+func NewAuthenticatedSecretHandler(baseURL string, authToken string, authHeader string, httpClient *http.Client, scheme string, basePathMode ...v2.BasePathMode) *SecretHandler {
 	if httpClient == nil {
 		httpClient = &http.Client{}
 	}
 	httpClient.Transport = wrapOtelTransport(getClientTransport(httpClient.Transport))
-	return createAuthenticatedSecretHandler(baseURL, authToken, authHeader, httpClient, scheme)
+	return createAuthenticatedSecretHandler(baseURL, authToken, authHeader, httpClient, scheme, basePathModeOf(basePathMode))
 }
 
-func createAuthenticatedSecretHandler(baseURL string, authToken string, authHeader string, httpClient *http.Client, scheme string) *SecretHandler {
-	v2SecretHandler := v2.NewAuthenticatedSecretHandler(baseURL, authToken, authHeader, httpClient, scheme)
-
-	baseURL = strings.TrimRight(baseURL, "/")
-	if !strings.HasSuffix(baseURL, secretServiceBaseURL) {
-		baseURL += "/" + secretServiceBaseURL
-	}
+func createAuthenticatedSecretHandler(baseURL string, authToken string, authHeader string, httpClient *http.Client, scheme string, basePathMode v2.BasePathMode) *SecretHandler {
+	v2SecretHandler := v2.NewAuthenticatedSecretHandler(baseURL, authToken, authHeader, httpClient, scheme, basePathMode)
 
 	return &SecretHandler{
-		BaseURL:       httputils.TrimHTTPScheme(baseURL),
+		BaseURL:       httputils.TrimHTTPScheme(basePathMode.HandlerBaseURL(baseURL, v2.HandlerSecrets)),
 		AuthHeader:    authHeader,
 		AuthToken:     authToken,
 		HTTPClient:    httpClient,
 		Scheme:        scheme,
 		secretHandler: v2SecretHandler,
+		basePathMode:  basePathMode,
+		endpoint:      baseURL,
 	}
 }
 
@@ -105,35 +118,69 @@ func (s *SecretHandler) getHTTPClient() *http.Client {
 // CreateSecret creates a new secret.
 func (s *SecretHandler) CreateSecret(secret models.Secret) error {
 	s.ensureHandlerIsSet()
-	return s.secretHandler.CreateSecret(context.TODO(), secret, v2.SecretsCreateSecretOptions{})
+	ctx, err := s.strictContext.implicitContext("SecretHandler.CreateSecret")
+	if err != nil {
+		return err
+	}
+	return s.secretHandler.CreateSecret(ctx, secret, v2.SecretsCreateSecretOptions{})
 }
 
 // UpdateSecret creates a new secret.
 func (s *SecretHandler) UpdateSecret(secret models.Secret) error {
 	s.ensureHandlerIsSet()
-	return s.secretHandler.UpdateSecret(context.TODO(), secret, v2.SecretsUpdateSecretOptions{})
+	ctx, err := s.strictContext.implicitContext("SecretHandler.UpdateSecret")
+	if err != nil {
+		return err
+	}
+	return s.secretHandler.UpdateSecret(ctx, secret, v2.SecretsUpdateSecretOptions{})
 }
 
 // DeleteSecret deletes a secret.
 func (s *SecretHandler) DeleteSecret(secretName, secretScope string) error {
 	s.ensureHandlerIsSet()
-	return s.secretHandler.DeleteSecret(context.TODO(), secretName, secretScope, v2.SecretsDeleteSecretOptions{})
+	ctx, err := s.strictContext.implicitContext("SecretHandler.DeleteSecret")
+	if err != nil {
+		return err
+	}
+	return s.secretHandler.DeleteSecret(ctx, secretName, secretScope, v2.SecretsDeleteSecretOptions{})
 }
 
 // GetSecrets returns a list of created secrets.
 func (s *SecretHandler) GetSecrets() (*models.GetSecretsResponse, error) {
 	s.ensureHandlerIsSet()
-	return s.secretHandler.GetSecrets(context.TODO(), v2.SecretsGetSecretsOptions{})
+	ctx, err := s.strictContext.implicitContext("SecretHandler.GetSecrets")
+	if err != nil {
+		return nil, err
+	}
+	return s.secretHandler.GetSecrets(ctx, v2.SecretsGetSecretsOptions{})
 }
 
 func (s *SecretHandler) ensureHandlerIsSet() {
-	if s.secretHandler != nil {
-		return
-	}
-
-	if s.AuthToken != "" {
-		s.secretHandler = v2.NewAuthenticatedSecretHandler(s.BaseURL, s.AuthToken, s.AuthHeader, s.HTTPClient, s.Scheme)
-	} else {
-		s.secretHandler = v2.NewSecretHandlerWithHTTPClient(s.BaseURL, s.HTTPClient)
-	}
+	s.once.Do(func() {
+		if s.secretHandler != nil {
+			return
+		}
+
+		if s.AuthToken != "" {
+			s.secretHandler = v2.NewAuthenticatedSecretHandler(s.BaseURL, s.AuthToken, s.AuthHeader, s.HTTPClient, s.Scheme)
+		} else {
+			s.secretHandler = v2.NewSecretHandlerWithHTTPClient(s.BaseURL, s.HTTPClient)
+		}
+	})
+}
+
+// WithAuthToken returns a new SecretHandler that uses the given token and auth header but otherwise the same
+// settings. The SecretHandler itself is not modified, so it can still be used concurrently
+func (s *SecretHandler) WithAuthToken(authToken string, authHeader string) *SecretHandler {
+	derived := createAuthenticatedSecretHandler(derivedBaseURL(s.endpoint, s.BaseURL), authToken, authHeader, s.HTTPClient, s.Scheme, s.basePathMode)
+	derived.strictContext = s.strictContext
+	return derived
+}
+
+// WithHTTPClient returns a new SecretHandler that uses the given http.Client but otherwise the same settings.
+// The SecretHandler itself is not modified, so it can still be used concurrently
+func (s *SecretHandler) WithHTTPClient(httpClient *http.Client) *SecretHandler {
+	derived := createAuthenticatedSecretHandler(derivedBaseURL(s.endpoint, s.BaseURL), s.AuthToken, s.AuthHeader, httpClient, s.Scheme, s.basePathMode)
+	derived.strictContext = s.strictContext
+	return derived
 }
@@ -1,12 +1,13 @@
 package api
 
 import (
-	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
 	"strings"
+	"sync"
 
+	"github.com/keptn/go-utils/pkg/api/models"
 	v2 "github.com/keptn/go-utils/pkg/api/utils/v2"
 	"github.com/keptn/go-utils/pkg/common/httputils"
 )
@@ -19,11 +20,26 @@ type SequencesV1Interface interface {
 
 type SequenceControlHandler struct {
 	sequenceControlHandler *v2.SequenceControlHandler
-	BaseURL                string
-	AuthToken              string
-	AuthHeader             string
-	HTTPClient             *http.Client
-	Scheme                 string
+
+	// Deprecated: changes to the field are not applied to the handler; create a new handler to use another base URL
+	BaseURL string
+
+	// Deprecated: use WithAuthToken to derive a handler with another token
+	AuthToken string
+
+	// Deprecated: use WithAuthToken to derive a handler with another auth header
+	AuthHeader string
+
+	// Deprecated: use WithHTTPClient to derive a handler with another http.Client
+	HTTPClient *http.Client
+
+	// Deprecated: changes to the field are not applied to the handler; create a new handler to use another scheme
+	Scheme string
+
+	once          sync.Once
+	strictContext StrictContextMode
+	basePathMode  v2.BasePathMode
+	endpoint      string
 }
 
 type SequenceControlParams struct {
@@ -33,6 +49,11 @@ type SequenceControlParams struct {
 	State        string `json:"state"`
 }
 
+// ControlState returns the State as models.SequenceControlState, e.g. to check it with its Validate method
+func (s *SequenceControlParams) ControlState() models.SequenceControlState {
+	return models.SequenceControlState(s.State)
+}
+
 func (s *SequenceControlParams) Validate() error {
 	var errMsg []string
 	if s.Project == "" {
@@ -43,6 +64,8 @@ func (s *SequenceControlParams) Validate() error {
 	}
 	if s.State == "" {
 		errMsg = append(errMsg, "sequence state parameter not set")
+	} else if err := s.ControlState().Validate(); err != nil {
+		errMsg = append(errMsg, err.Error())
 	}
 	errStr := strings.Join(errMsg, ",")
 
@@ -57,6 +80,11 @@ type SequenceControlBody struct {
 	State string `json:"state"`
 }
 
+// ControlState returns the State as models.SequenceControlState, e.g. to check it with its Validate method
+func (s *SequenceControlBody) ControlState() models.SequenceControlState {
+	return models.SequenceControlState(s.State)
+}
+
 // Converts object to JSON string
 func (s *SequenceControlBody) ToJSON() ([]byte, error) {
 	return json.Marshal(s)
@@ -84,34 +112,34 @@ func NewSequenceControlHandlerWithHTTPClient(baseURL string, httpClient *http.Cl
 		HTTPClient:             httpClient,
 		Scheme:                 "http",
 		sequenceControlHandler: v2.NewSequenceControlHandlerWithHTTPClient(baseURL, httpClient),
+		endpoint:               baseURL,
+		basePathMode:           v2.ExactBaseURL,
 	}
 }
 
-// NewAuthenticatedSequenceControlHandler returns a new SequenceControlHandler that authenticates at the api via the provided token
+// NewAuthenticatedSequenceControlHandler returns a new SequenceControlHandler that authenticates at the api via the provided token.
+// The base path of the service is added to the base URL according to basePathMode (default v2.AppendMissingBasePath)
 // Deprecated: use APISet instead
-func NewAuthenticatedSequenceControlHandler(baseURL string, authToken string, authHeader string, httpClient *http.Client, scheme string) *SequenceControlHandler {
+func NewAuthenticatedSequenceControlHandler(baseURL string, authToken string, authHeader string, httpClient *http.Client, scheme string, basePathMode ...v2.BasePathMode) *SequenceControlHandler {
 	if httpClient == nil {
 		httpClient = &http.Client{}
 	}
 	httpClient.Transport = wrapOtelTransport(getClientTransport(httpClient.Transport))
-	return createAuthenticatedSequenceControlHandler(baseURL, authToken, authHeader, httpClient, scheme)
+	return createAuthenticatedSequenceControlHandler(baseURL, authToken, authHeader, httpClient, scheme, basePathModeOf(basePathMode))
 }
 
-func createAuthenticatedSequenceControlHandler(baseURL string, authToken string, authHeader string, httpClient *http.Client, scheme string) *SequenceControlHandler {
-	v2SequenceControlHandler := v2.NewAuthenticatedSequenceControlHandler(baseURL, authToken, authHeader, httpClient, scheme)
-
-	baseURL = strings.TrimRight(baseURL, "/")
-	if !strings.HasSuffix(baseURL, shipyardControllerBaseURL) {
-		baseURL += "/" + shipyardControllerBaseURL
-	}
+func createAuthenticatedSequenceControlHandler(baseURL string, authToken string, authHeader string, httpClient *http.Client, scheme string, basePathMode v2.BasePathMode) *SequenceControlHandler {
+	v2SequenceControlHandler := v2.NewAuthenticatedSequenceControlHandler(baseURL, authToken, authHeader, httpClient, scheme, basePathMode)
 
 	return &SequenceControlHandler{
-		BaseURL:                httputils.TrimHTTPScheme(baseURL),
+		BaseURL:                httputils.TrimHTTPScheme(basePathMode.HandlerBaseURL(baseURL, v2.HandlerSequences)),
 		AuthHeader:             authHeader,
 		AuthToken:              authToken,
 		HTTPClient:             httpClient,
 		Scheme:                 scheme,
 		sequenceControlHandler: v2SequenceControlHandler,
+		basePathMode:           basePathMode,
+		endpoint:               baseURL,
 	}
 }
 
@@ -133,8 +161,12 @@ func (s *SequenceControlHandler) getHTTPClient() *http.Client {
 
 func (s *SequenceControlHandler) ControlSequence(params SequenceControlParams) error {
 	s.ensureHandlerIsSet()
+	ctx, err := s.strictContext.implicitContext("SequenceControlHandler.ControlSequence")
+	if err != nil {
+		return err
+	}
 	return s.sequenceControlHandler.ControlSequence(
-		context.TODO(),
+		ctx,
 		v2.SequenceControlParams{
 			Project:      params.Project,
 			KeptnContext: params.KeptnContext,
@@ -145,13 +177,31 @@ func (s *SequenceControlHandler) ControlSequence(params SequenceControlParams) e
 }
 
 func (s *SequenceControlHandler) ensureHandlerIsSet() {
-	if s.sequenceControlHandler != nil {
-		return
-	}
-
-	if s.AuthToken != "" {
-		s.sequenceControlHandler = v2.NewAuthenticatedSequenceControlHandler(s.BaseURL, s.AuthToken, s.AuthHeader, s.HTTPClient, s.Scheme)
-	} else {
-		s.sequenceControlHandler = v2.NewSequenceControlHandlerWithHTTPClient(s.BaseURL, s.HTTPClient)
-	}
+	s.once.Do(func() {
+		if s.sequenceControlHandler != nil {
+			return
+		}
+
+		if s.AuthToken != "" {
+			s.sequenceControlHandler = v2.NewAuthenticatedSequenceControlHandler(s.BaseURL, s.AuthToken, s.AuthHeader, s.HTTPClient, s.Scheme)
+		} else {
+			s.sequenceControlHandler = v2.NewSequenceControlHandlerWithHTTPClient(s.BaseURL, s.HTTPClient)
+		}
+	})
+}
+
+// WithAuthToken returns a new SequenceControlHandler that uses the given token and auth header but otherwise the same
+// settings. The SequenceControlHandler itself is not modified, so it can still be used concurrently
+func (s *SequenceControlHandler) WithAuthToken(authToken string, authHeader string) *SequenceControlHandler {
+	derived := createAuthenticatedSequenceControlHandler(derivedBaseURL(s.endpoint, s.BaseURL), authToken, authHeader, s.HTTPClient, s.Scheme, s.basePathMode)
+	derived.strictContext = s.strictContext
+	return derived
+}
+
+// WithHTTPClient returns a new SequenceControlHandler that uses the given http.Client but otherwise the same settings.
+// The SequenceControlHandler itself is not modified, so it can still be used concurrently
+func (s *SequenceControlHandler) WithHTTPClient(httpClient *http.Client) *SequenceControlHandler {
+	derived := createAuthenticatedSequenceControlHandler(derivedBaseURL(s.endpoint, s.BaseURL), s.AuthToken, s.AuthHeader, httpClient, s.Scheme, s.basePathMode)
+	derived.strictContext = s.strictContext
+	return derived
 }
@@ -1,9 +1,8 @@
 package api
 
 import (
-	"context"
 	"net/http"
-	"strings"
+	"sync"
 
 	"github.com/keptn/go-utils/pkg/api/models"
 	v2 "github.com/keptn/go-utils/pkg/api/utils/v2"
@@ -27,11 +26,26 @@ type ServicesV1Interface interface {
 // ServiceHandler handles services
 type ServiceHandler struct {
 	serviceHandler *v2.ServiceHandler
-	BaseURL        string
-	AuthToken      string
-	AuthHeader     string
-	HTTPClient     *http.Client
-	Scheme         string
+
+	// Deprecated: changes to the field are not applied to the handler; create a new handler to use another base URL
+	BaseURL string
+
+	// Deprecated: use WithAuthToken to derive a handler with another token
+	AuthToken string
+
+	// Deprecated: use WithAuthToken to derive a handler with another auth header
+	AuthHeader string
+
+	// Deprecated: use WithHTTPClient to derive a handler with another http.Client
+	HTTPClient *http.Client
+
+	// Deprecated: changes to the field are not applied to the handler; create a new handler to use another scheme
+	Scheme string
+
+	once          sync.Once
+	strictContext StrictContextMode
+	basePathMode  v2.BasePathMode
+	endpoint      string
 }
 
 // NewServiceHandler returns a new ServiceHandler which sends all requests directly to the configuration-service
@@ -46,35 +60,35 @@ func NewServiceHandlerWithHTTPClient(baseURL string, httpClient *http.Client) *S
 		HTTPClient:     httpClient,
 		Scheme:         "http",
 		serviceHandler: v2.NewServiceHandlerWithHTTPClient(baseURL, httpClient),
+		endpoint:       baseURL,
+		basePathMode:   v2.ExactBaseURL,
 	}
 }
 
-// NewAuthenticatedServiceHandler returns a new ServiceHandler that authenticates at the api via the provided token
+// NewAuthenticatedServiceHandler returns a new ServiceHandler that authenticates at the api via the provided token.
+// The base path of the service is added to the base URL according to basePathMode (default v2.AppendMissingBasePath)
 // and sends all requests directly to the configuration-service
 // Deprecated: use APISet instead
-func NewAuthenticatedServiceHandler(baseURL string, authToken string, authHeader string, httpClient *http.Client, scheme string) *ServiceHandler {
+func NewAuthenticatedServiceHandler(baseURL string, authToken string, authHeader string, httpClient *http.Client, scheme string, basePathMode ...v2.BasePathMode) *ServiceHandler {
 	if httpClient == nil {
 		httpClient = &http.Client{}
 	}
 	httpClient.Transport = wrapOtelTransport(getClientTransport(httpClient.Transport))
-	return createAuthenticatedServiceHandler(baseURL, authToken, authHeader, httpClient, scheme)
+	return createAuthenticatedServiceHandler(baseURL, authToken, authHeader, httpClient, scheme, basePathModeOf(basePathMode))
 }
 
-func createAuthenticatedServiceHandler(baseURL string, authToken string, authHeader string, httpClient *http.Client, scheme string) *ServiceHandler {
-	v2ServiceHandler := v2.NewAuthenticatedServiceHandler(baseURL, authToken, authHeader, httpClient, scheme)
-
-	baseURL = strings.TrimRight(baseURL, "/")
-	if !strings.HasSuffix(baseURL, shipyardControllerBaseURL) {
-		baseURL += "/" + shipyardControllerBaseURL
-	}
+func createAuthenticatedServiceHandler(baseURL string, authToken string, authHeader string, httpClient *http.Client, scheme string, basePathMode v2.BasePathMode) *ServiceHandler {
+	v2ServiceHandler := v2.NewAuthenticatedServiceHandler(baseURL, authToken, authHeader, httpClient, scheme, basePathMode)
 
 	return &ServiceHandler{
-		BaseURL:        httputils.TrimHTTPScheme(baseURL),
+		BaseURL:        httputils.TrimHTTPScheme(basePathMode.HandlerBaseURL(baseURL, v2.HandlerServices)),
 		AuthHeader:     authHeader,
 		AuthToken:      authToken,
 		HTTPClient:     httpClient,
 		Scheme:         scheme,
 		serviceHandler: v2ServiceHandler,
+		basePathMode:   basePathMode,
+		endpoint:       baseURL,
 	}
 }
 
@@ -97,35 +111,69 @@ func (s *ServiceHandler) getHTTPClient() *http.Client {
 // CreateServiceInStage creates a new service.
 func (s *ServiceHandler) CreateServiceInStage(project string, stage string, serviceName string) (*models.EventContext, *models.Error) {
 	s.ensureHandlerIsSet()
-	return s.serviceHandler.CreateServiceInStage(context.TODO(), project, stage, serviceName, v2.ServicesCreateServiceInStageOptions{})
+	ctx, err := s.strictContext.implicitContext("ServiceHandler.CreateServiceInStage")
+	if err != nil {
+		return nil, implicitContextResponse(err)
+	}
+	return s.serviceHandler.CreateServiceInStage(ctx, project, stage, serviceName, v2.ServicesCreateServiceInStageOptions{})
 }
 
 // DeleteServiceFromStage deletes a service from a stage.
 func (s *ServiceHandler) DeleteServiceFromStage(project string, stage string, serviceName string) (*models.EventContext, *models.Error) {
 	s.ensureHandlerIsSet()
-	return s.serviceHandler.DeleteServiceFromStage(context.TODO(), project, stage, serviceName, v2.ServicesDeleteServiceFromStageOptions{})
+	ctx, err := s.strictContext.implicitContext("ServiceHandler.DeleteServiceFromStage")
+	if err != nil {
+		return nil, implicitContextResponse(err)
+	}
+	return s.serviceHandler.DeleteServiceFromStage(ctx, project, stage, serviceName, v2.ServicesDeleteServiceFromStageOptions{})
 }
 
 // GetService gets a service.
 func (s *ServiceHandler) GetService(project, stage, service string) (*models.Service, error) {
 	s.ensureHandlerIsSet()
-	return s.serviceHandler.GetService(context.TODO(), project, stage, service, v2.ServicesGetServiceOptions{})
+	ctx, err := s.strictContext.implicitContext("ServiceHandler.GetService")
+	if err != nil {
+		return nil, err
+	}
+	return s.serviceHandler.GetService(ctx, project, stage, service, v2.ServicesGetServiceOptions{})
 }
 
 // GetAllServices returns a list of all services.
 func (s *ServiceHandler) GetAllServices(project string, stage string) ([]*models.Service, error) {
 	s.ensureHandlerIsSet()
-	return s.serviceHandler.GetAllServices(context.TODO(), project, stage, v2.ServicesGetAllServicesOptions{})
+	ctx, err := s.strictContext.implicitContext("ServiceHandler.GetAllServices")
+	if err != nil {
+		return nil, err
+	}
+	return s.serviceHandler.GetAllServices(ctx, project, stage, v2.ServicesGetAllServicesOptions{})
 }
 
 func (s *ServiceHandler) ensureHandlerIsSet() {
-	if s.serviceHandler != nil {
-		return
-	}
+	s.once.Do(func() {
+		if s.serviceHandler != nil {
+			return
+		}
+
+		if s.AuthToken != "" {
+			s.serviceHandler = v2.NewAuthenticatedServiceHandler(s.BaseURL, s.AuthToken, s.AuthHeader, s.HTTPClient, s.Scheme)
+		} else {
+			s.serviceHandler = v2.NewServiceHandlerWithHTTPClient(s.BaseURL, s.HTTPClient)
+		}
+	})
+}
 
-	if s.AuthToken != "" {
-		s.serviceHandler = v2.NewAuthenticatedServiceHandler(s.BaseURL, s.AuthToken, s.AuthHeader, s.HTTPClient, s.Scheme)
-	} else {
-		s.serviceHandler = v2.NewServiceHandlerWithHTTPClient(s.BaseURL, s.HTTPClient)
-	}
+// WithAuthToken returns a new ServiceHandler that uses the given token and auth header but otherwise the same
+// settings. The ServiceHandler itself is not modified, so it can still be used concurrently
+func (s *ServiceHandler) WithAuthToken(authToken string, authHeader string) *ServiceHandler {
+	derived := createAuthenticatedServiceHandler(derivedBaseURL(s.endpoint, s.BaseURL), authToken, authHeader, s.HTTPClient, s.Scheme, s.basePathMode)
+	derived.strictContext = s.strictContext
+	return derived
+}
+
+// WithHTTPClient returns a new ServiceHandler that uses the given http.Client but otherwise the same settings.
+// The ServiceHandler itself is not modified, so it can still be used concurrently
+func (s *ServiceHandler) WithHTTPClient(httpClient *http.Client) *ServiceHandler {
+	derived := createAuthenticatedServiceHandler(derivedBaseURL(s.endpoint, s.BaseURL), s.AuthToken, s.AuthHeader, httpClient, s.Scheme, s.basePathMode)
+	derived.strictContext = s.strictContext
+	return derived
 }
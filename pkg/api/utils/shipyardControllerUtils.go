@@ -1,17 +1,14 @@
 package api
 
 import (
-	"context"
 	"net/http"
-	"strings"
+	"sync"
 
 	"github.com/keptn/go-utils/pkg/api/models"
 	v2 "github.com/keptn/go-utils/pkg/api/utils/v2"
 	"github.com/keptn/go-utils/pkg/common/httputils"
 )
 
-const shipyardControllerBaseURL = "controlPlane"
-
 type ShipyardControlV1Interface interface {
 	// GetOpenTriggeredEvents returns all open triggered events.
 	GetOpenTriggeredEvents(filter EventFilter) ([]*models.KeptnContextExtendedCE, error)
@@ -20,11 +17,26 @@ type ShipyardControlV1Interface interface {
 // ShipyardControllerHandler handles services
 type ShipyardControllerHandler struct {
 	shipyardControllerHandler *v2.ShipyardControllerHandler
-	BaseURL                   string
-	AuthToken                 string
-	AuthHeader                string
-	HTTPClient                *http.Client
-	Scheme                    string
+
+	// Deprecated: changes to the field are not applied to the handler; create a new handler to use another base URL
+	BaseURL string
+
+	// Deprecated: use WithAuthToken to derive a handler with another token
+	AuthToken string
+
+	// Deprecated: use WithAuthToken to derive a handler with another auth header
+	AuthHeader string
+
+	// Deprecated: use WithHTTPClient to derive a handler with another http.Client
+	HTTPClient *http.Client
+
+	// Deprecated: changes to the field are not applied to the handler; create a new handler to use another scheme
+	Scheme string
+
+	once          sync.Once
+	strictContext StrictContextMode
+	basePathMode  v2.BasePathMode
+	endpoint      string
 }
 
 // NewShipyardControllerHandler returns a new ShipyardControllerHandler which sends all requests directly to the configuration-service
@@ -39,35 +51,35 @@ func NewShipyardControllerHandlerWithHTTPClient(baseURL string, httpClient *http
 		HTTPClient:                httpClient,
 		Scheme:                    "http",
 		shipyardControllerHandler: v2.NewShipyardControllerHandlerWithHTTPClient(baseURL, httpClient),
+		endpoint:                  baseURL,
+		basePathMode:              v2.ExactBaseURL,
 	}
 }
 
-// NewAuthenticatedShipyardControllerHandler returns a new ShipyardControllerHandler that authenticates at the api via the provided token
+// NewAuthenticatedShipyardControllerHandler returns a new ShipyardControllerHandler that authenticates at the api via the provided token.
+// The base path of the service is added to the base URL according to basePathMode (default v2.AppendMissingBasePath)
 // and sends all requests directly to the configuration-service
 // Deprecated: use APISet instead
-func NewAuthenticatedShipyardControllerHandler(baseURL string, authToken string, authHeader string, httpClient *http.Client, scheme string) *ShipyardControllerHandler {
+func NewAuthenticatedShipyardControllerHandler(baseURL string, authToken string, authHeader string, httpClient *http.Client, scheme string, basePathMode ...v2.BasePathMode) *ShipyardControllerHandler {
 	if httpClient == nil {
 		httpClient = &http.Client{}
 	}
 	httpClient.Transport = wrapOtelTransport(getClientTransport(httpClient.Transport))
-	return createAuthenticatedShipyardControllerHandler(baseURL, authToken, authHeader, httpClient, scheme)
+	return createAuthenticatedShipyardControllerHandler(baseURL, authToken, authHeader, httpClient, scheme, basePathModeOf(basePathMode))
 }
 
-func createAuthenticatedShipyardControllerHandler(baseURL string, authToken string, authHeader string, httpClient *http.Client, scheme string) *ShipyardControllerHandler {
-	v2ShipyardControllerHandler := v2.NewAuthenticatedShipyardControllerHandler(baseURL, authToken, authHeader, httpClient, scheme)
-
-	baseURL = strings.TrimRight(baseURL, "/")
-	if !strings.HasSuffix(baseURL, shipyardControllerBaseURL) {
-		baseURL += "/" + shipyardControllerBaseURL
-	}
+func createAuthenticatedShipyardControllerHandler(baseURL string, authToken string, authHeader string, httpClient *http.Client, scheme string, basePathMode v2.BasePathMode) *ShipyardControllerHandler {
+	v2ShipyardControllerHandler := v2.NewAuthenticatedShipyardControllerHandler(baseURL, authToken, authHeader, httpClient, scheme, basePathMode)
 
 	return &ShipyardControllerHandler{
-		BaseURL:                   httputils.TrimHTTPScheme(baseURL),
+		BaseURL:                   httputils.TrimHTTPScheme(basePathMode.HandlerBaseURL(baseURL, v2.HandlerShipyardControl)),
 		AuthHeader:                authHeader,
 		AuthToken:                 authToken,
 		HTTPClient:                httpClient,
 		Scheme:                    scheme,
 		shipyardControllerHandler: v2ShipyardControllerHandler,
+		basePathMode:              basePathMode,
+		endpoint:                  baseURL,
 	}
 }
 
@@ -90,17 +102,39 @@ func (s *ShipyardControllerHandler) getHTTPClient() *http.Client {
 // GetOpenTriggeredEvents returns all open triggered events.
 func (s *ShipyardControllerHandler) GetOpenTriggeredEvents(filter EventFilter) ([]*models.KeptnContextExtendedCE, error) {
 	s.ensureHandlerIsSet()
-	return s.shipyardControllerHandler.GetOpenTriggeredEvents(context.TODO(), *toV2EventFilter(&filter), v2.ShipyardControlGetOpenTriggeredEventsOptions{})
+	ctx, err := s.strictContext.implicitContext("ShipyardControllerHandler.GetOpenTriggeredEvents")
+	if err != nil {
+		return nil, err
+	}
+	return s.shipyardControllerHandler.GetOpenTriggeredEvents(ctx, *toV2EventFilter(&filter), v2.ShipyardControlGetOpenTriggeredEventsOptions{})
 }
 
 func (s *ShipyardControllerHandler) ensureHandlerIsSet() {
-	if s.shipyardControllerHandler != nil {
-		return
-	}
+	s.once.Do(func() {
+		if s.shipyardControllerHandler != nil {
+			return
+		}
+
+		if s.AuthToken != "" {
+			s.shipyardControllerHandler = v2.NewAuthenticatedShipyardControllerHandler(s.BaseURL, s.AuthToken, s.AuthHeader, s.HTTPClient, s.Scheme)
+		} else {
+			s.shipyardControllerHandler = v2.NewShipyardControllerHandlerWithHTTPClient(s.BaseURL, s.HTTPClient)
+		}
+	})
+}
 
-	if s.AuthToken != "" {
-		s.shipyardControllerHandler = v2.NewAuthenticatedShipyardControllerHandler(s.BaseURL, s.AuthToken, s.AuthHeader, s.HTTPClient, s.Scheme)
-	} else {
-		s.shipyardControllerHandler = v2.NewShipyardControllerHandlerWithHTTPClient(s.BaseURL, s.HTTPClient)
-	}
+// WithAuthToken returns a new ShipyardControllerHandler that uses the given token and auth header but otherwise the same
+// settings. The ShipyardControllerHandler itself is not modified, so it can still be used concurrently
+func (s *ShipyardControllerHandler) WithAuthToken(authToken string, authHeader string) *ShipyardControllerHandler {
+	derived := createAuthenticatedShipyardControllerHandler(derivedBaseURL(s.endpoint, s.BaseURL), authToken, authHeader, s.HTTPClient, s.Scheme, s.basePathMode)
+	derived.strictContext = s.strictContext
+	return derived
+}
+
+// WithHTTPClient returns a new ShipyardControllerHandler that uses the given http.Client but otherwise the same settings.
+// The ShipyardControllerHandler itself is not modified, so it can still be used concurrently
+func (s *ShipyardControllerHandler) WithHTTPClient(httpClient *http.Client) *ShipyardControllerHandler {
+	derived := createAuthenticatedShipyardControllerHandler(derivedBaseURL(s.endpoint, s.BaseURL), s.AuthToken, s.AuthHeader, httpClient, s.Scheme, s.basePathMode)
+	derived.strictContext = s.strictContext
+	return derived
 }
@@ -1,9 +1,8 @@
 package api
 
 import (
-	"context"
 	"net/http"
-	"strings"
+	"sync"
 
 	"github.com/keptn/go-utils/pkg/api/models"
 	v2 "github.com/keptn/go-utils/pkg/api/utils/v2"
@@ -22,11 +21,26 @@ type StagesV1Interface interface {
 // StageHandler handles stages
 type StageHandler struct {
 	stageHandler *v2.StageHandler
-	BaseURL      string
-	AuthToken    string
-	AuthHeader   string
-	HTTPClient   *http.Client
-	Scheme       string
+
+	// Deprecated: changes to the field are not applied to the handler; create a new handler to use another base URL
+	BaseURL string
+
+	// Deprecated: use WithAuthToken to derive a handler with another token
+	AuthToken string
+
+	// Deprecated: use WithAuthToken to derive a handler with another auth header
+	AuthHeader string
+
+	// Deprecated: use WithHTTPClient to derive a handler with another http.Client
+	HTTPClient *http.Client
+
+	// Deprecated: changes to the field are not applied to the handler; create a new handler to use another scheme
+	Scheme string
+
+	once          sync.Once
+	strictContext StrictContextMode
+	basePathMode  v2.BasePathMode
+	endpoint      string
 }
 
 // NewStageHandler returns a new StageHandler which sends all requests directly to the configuration-service
@@ -41,35 +55,35 @@ func NewStageHandlerWithHTTPClient(baseURL string, httpClient *http.Client) *Sta
 		HTTPClient:   httpClient,
 		Scheme:       "http",
 		stageHandler: v2.NewStageHandlerWithHTTPClient(baseURL, httpClient),
+		endpoint:     baseURL,
+		basePathMode: v2.ExactBaseURL,
 	}
 }
 
-// NewAuthenticatedStageHandler returns a new StageHandler that authenticates at the api via the provided token
+// NewAuthenticatedStageHandler returns a new StageHandler that authenticates at the api via the provided token.
+// The base path of the service is added to the base URL according to basePathMode (default v2.AppendMissingBasePath)
 // and sends all requests directly to the configuration-service
 // Deprecated: use APISet instead
-func NewAuthenticatedStageHandler(baseURL string, authToken string, authHeader string, httpClient *http.Client, scheme string) *StageHandler {
+func NewAuthenticatedStageHandler(baseURL string, authToken string, authHeader string, httpClient *http.Client, scheme string, basePathMode ...v2.BasePathMode) *StageHandler {
 	if httpClient == nil {
 		httpClient = &http.Client{}
 	}
 	httpClient.Transport = wrapOtelTransport(getClientTransport(httpClient.Transport))
-	return createAuthenticatedStageHandler(baseURL, authToken, authHeader, httpClient, scheme)
+	return createAuthenticatedStageHandler(baseURL, authToken, authHeader, httpClient, scheme, basePathModeOf(basePathMode))
 }
 
-func createAuthenticatedStageHandler(baseURL string, authToken string, authHeader string, httpClient *http.Client, scheme string) *StageHandler {
-	v2StageHandler := v2.NewAuthenticatedStageHandler(baseURL, authToken, authHeader, httpClient, scheme)
-
-	baseURL = strings.TrimRight(baseURL, "/")
-	if !strings.HasSuffix(baseURL, shipyardControllerBaseURL) {
-		baseURL += "/" + shipyardControllerBaseURL
-	}
+func createAuthenticatedStageHandler(baseURL string, authToken string, authHeader string, httpClient *http.Client, scheme string, basePathMode v2.BasePathMode) *StageHandler {
+	v2StageHandler := v2.NewAuthenticatedStageHandler(baseURL, authToken, authHeader, httpClient, scheme, basePathMode)
 
 	return &StageHandler{
-		BaseURL:      httputils.TrimHTTPScheme(baseURL),
+		BaseURL:      httputils.TrimHTTPScheme(basePathMode.HandlerBaseURL(baseURL, v2.HandlerStages)),
 		AuthHeader:   authHeader,
 		AuthToken:    authToken,
 		HTTPClient:   httpClient,
 		Scheme:       scheme,
 		stageHandler: v2StageHandler,
+		basePathMode: basePathMode,
+		endpoint:     baseURL,
 	}
 }
 
@@ -92,23 +106,49 @@ func (s *StageHandler) getHTTPClient() *http.Client {
 // CreateStage creates a new stage with the provided name.
 func (s *StageHandler) CreateStage(project string, stageName string) (*models.EventContext, *models.Error) {
 	s.ensureHandlerIsSet()
-	return s.stageHandler.CreateStage(context.TODO(), project, stageName, v2.StagesCreateStageOptions{})
+	ctx, err := s.strictContext.implicitContext("StageHandler.CreateStage")
+	if err != nil {
+		return nil, implicitContextResponse(err)
+	}
+	return s.stageHandler.CreateStage(ctx, project, stageName, v2.StagesCreateStageOptions{})
 }
 
 // GetAllStages returns a list of all stages.
 func (s *StageHandler) GetAllStages(project string) ([]*models.Stage, error) {
 	s.ensureHandlerIsSet()
-	return s.stageHandler.GetAllStages(context.TODO(), project, v2.StagesGetAllStagesOptions{})
+	ctx, err := s.strictContext.implicitContext("StageHandler.GetAllStages")
+	if err != nil {
+		return nil, err
+	}
+	return s.stageHandler.GetAllStages(ctx, project, v2.StagesGetAllStagesOptions{})
 }
 
 func (s *StageHandler) ensureHandlerIsSet() {
-	if s.stageHandler != nil {
-		return
-	}
+	s.once.Do(func() {
+		if s.stageHandler != nil {
+			return
+		}
+
+		if s.AuthToken != "" {
+			s.stageHandler = v2.NewAuthenticatedStageHandler(s.BaseURL, s.AuthToken, s.AuthHeader, s.HTTPClient, s.Scheme)
+		} else {
+			s.stageHandler = v2.NewStageHandlerWithHTTPClient(s.BaseURL, s.HTTPClient)
+		}
+	})
+}
 
-	if s.AuthToken != "" {
-		s.stageHandler = v2.NewAuthenticatedStageHandler(s.BaseURL, s.AuthToken, s.AuthHeader, s.HTTPClient, s.Scheme)
-	} else {
-		s.stageHandler = v2.NewStageHandlerWithHTTPClient(s.BaseURL, s.HTTPClient)
-	}
+// WithAuthToken returns a new StageHandler that uses the given token and auth header but otherwise the same
+// settings. The StageHandler itself is not modified, so it can still be used concurrently
+func (s *StageHandler) WithAuthToken(authToken string, authHeader string) *StageHandler {
+	derived := createAuthenticatedStageHandler(derivedBaseURL(s.endpoint, s.BaseURL), authToken, authHeader, s.HTTPClient, s.Scheme, s.basePathMode)
+	derived.strictContext = s.strictContext
+	return derived
+}
+
+// WithHTTPClient returns a new StageHandler that uses the given http.Client but otherwise the same settings.
+// The StageHandler itself is not modified, so it can still be used concurrently
+func (s *StageHandler) WithHTTPClient(httpClient *http.Client) *StageHandler {
+	derived := createAuthenticatedStageHandler(derivedBaseURL(s.endpoint, s.BaseURL), s.AuthToken, s.AuthHeader, httpClient, s.Scheme, s.basePathMode)
+	derived.strictContext = s.strictContext
+	return derived
 }
@@ -0,0 +1,68 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/keptn/go-utils/pkg/api/models"
+)
+
+// ErrImplicitContext is returned by the methods of the handlers of an APISet in strict context mode,
+// as they have no context parameter and would send their requests with context.TODO(), see WithStrictContext
+var ErrImplicitContext = errors.New("method without context called in strict context mode")
+
+// StrictContextMode determines what the methods of the handlers of an APISet do, as they have no context parameter
+// and send their requests with context.TODO(), so that deadlines and cancellation of the caller are not propagated
+type StrictContextMode int
+
+const (
+	// ImplicitContextAllowed sends the requests with context.TODO(). This is the default
+	ImplicitContextAllowed StrictContextMode = iota
+	// ImplicitContextError makes the methods return an error wrapping ErrImplicitContext without sending a request
+	ImplicitContextError
+	// ImplicitContextPanic makes the methods panic with an error wrapping ErrImplicitContext, e.g. to find
+	// all remaining calls in tests
+	ImplicitContextPanic
+)
+
+// WithStrictContext sets what the methods of the handlers of the APISet do, as they have no context parameter
+// (default ImplicitContextAllowed). Strict modes help to migrate to the methods of the v2 handlers, which
+// take a context, e.g. by making the remaining calls fail in tests. Handlers derived via WithAuthToken or
+// WithHTTPClient keep the mode
+func WithStrictContext(mode StrictContextMode) func(*APISet) {
+	return func(a *APISet) {
+		a.strictContext = mode
+	}
+}
+
+// strictContextOf returns the first of the given modes, or ImplicitContextAllowed if none is given.
+// It is used by the adapters wrapping a v2 handler as a v1 interface, which take the mode as optional argument
+func strictContextOf(modes []StrictContextMode) StrictContextMode {
+	if len(modes) > 0 {
+		return modes[0]
+	}
+	return ImplicitContextAllowed
+}
+
+// implicitContext returns the context for a request of the given method of a handler, e.g. ProjectHandler.GetProject
+func (m StrictContextMode) implicitContext(method string) (context.Context, error) {
+	switch m {
+	case ImplicitContextError:
+		return nil, implicitContextError(method)
+	case ImplicitContextPanic:
+		panic(implicitContextError(method))
+	}
+	return context.TODO(), nil
+}
+
+func implicitContextError(method string) error {
+	return fmt.Errorf("%s: %w, use the method of the v2 handler which takes a context instead", method, ErrImplicitContext)
+}
+
+// implicitContextResponse returns the given error as *models.Error
+func implicitContextResponse(err error) *models.Error {
+	mErr := buildErrorResponse(err.Error())
+	mErr.Err = err
+	return mErr
+}
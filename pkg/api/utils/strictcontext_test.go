@@ -0,0 +1,55 @@
+package api
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/keptn/go-utils/pkg/api/models"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWithStrictContext_Error(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.Write([]byte(`{"projects":[]}`))
+	}))
+	defer server.Close()
+
+	apiSet, err := New(server.URL, WithStrictContext(ImplicitContextError))
+	require.NoError(t, err)
+
+	_, err = apiSet.ProjectsV1().GetAllProjects()
+	require.ErrorIs(t, err, ErrImplicitContext)
+	require.Contains(t, err.Error(), "ProjectHandler.GetAllProjects")
+
+	_, mErr := apiSet.ProjectsV1().GetProject(models.Project{ProjectName: "my-project"})
+	require.NotNil(t, mErr)
+	require.True(t, errors.Is(mErr.ToError(), ErrImplicitContext))
+
+	_, err = apiSet.projectHandler.WithAuthToken("token", "x-token").GetAllProjects()
+	require.ErrorIs(t, err, ErrImplicitContext)
+	require.Zero(t, requests)
+}
+
+func TestWithStrictContext_Panic(t *testing.T) {
+	apiSet, err := New("http://localhost:8080", WithStrictContext(ImplicitContextPanic))
+	require.NoError(t, err)
+	require.PanicsWithError(t, "StageHandler.GetAllStages: method without context called in strict context mode, use the method of the v2 handler which takes a context instead", func() {
+		apiSet.StagesV1().GetAllStages("my-project")
+	})
+}
+
+func TestWithStrictContext_AllowedByDefault(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"projects":[]}`))
+	}))
+	defer server.Close()
+
+	apiSet, err := New(server.URL)
+	require.NoError(t, err)
+	_, err = apiSet.ProjectsV1().GetAllProjects()
+	require.NoError(t, err)
+}
@@ -1,9 +1,8 @@
 package api
 
 import (
-	"context"
 	"net/http"
-	"strings"
+	"sync"
 
 	"github.com/keptn/go-utils/pkg/api/models"
 	v2 "github.com/keptn/go-utils/pkg/api/utils/v2"
@@ -23,11 +22,26 @@ type UniformV1Interface interface {
 
 type UniformHandler struct {
 	uniformHandler *v2.UniformHandler
-	BaseURL        string
-	AuthToken      string
-	AuthHeader     string
-	HTTPClient     *http.Client
-	Scheme         string
+
+	// Deprecated: changes to the field are not applied to the handler; create a new handler to use another base URL
+	BaseURL string
+
+	// Deprecated: use WithAuthToken to derive a handler with another token
+	AuthToken string
+
+	// Deprecated: use WithAuthToken to derive a handler with another auth header
+	AuthHeader string
+
+	// Deprecated: use WithHTTPClient to derive a handler with another http.Client
+	HTTPClient *http.Client
+
+	// Deprecated: changes to the field are not applied to the handler; create a new handler to use another scheme
+	Scheme string
+
+	once          sync.Once
+	strictContext StrictContextMode
+	basePathMode  v2.BasePathMode
+	endpoint      string
 }
 
 // NewUniformHandler returns a new UniformHandler
@@ -42,34 +56,34 @@ func NewUniformHandlerWithHTTPClient(baseURL string, httpClient *http.Client) *U
 		HTTPClient:     httpClient,
 		Scheme:         "http",
 		uniformHandler: v2.NewUniformHandlerWithHTTPClient(baseURL, httpClient),
+		endpoint:       baseURL,
+		basePathMode:   v2.ExactBaseURL,
 	}
 }
 
-// NewAuthenticatedUniformHandler returns a new UniformHandler that authenticates at the api via the provided token
+// NewAuthenticatedUniformHandler returns a new UniformHandler that authenticates at the api via the provided token.
+// The base path of the service is added to the base URL according to basePathMode (default v2.AppendMissingBasePath)
 // Deprecated: use APISet instead
-func NewAuthenticatedUniformHandler(baseURL string, authToken string, authHeader string, httpClient *http.Client, scheme string) *UniformHandler {
+func NewAuthenticatedUniformHandler(baseURL string, authToken string, authHeader string, httpClient *http.Client, scheme string, basePathMode ...v2.BasePathMode) *UniformHandler {
 	if httpClient == nil {
 		httpClient = &http.Client{}
 	}
 	httpClient.Transport = getClientTransport(httpClient.Transport)
-	return createAuthenticatedUniformHandler(baseURL, authToken, authHeader, httpClient, scheme)
+	return createAuthenticatedUniformHandler(baseURL, authToken, authHeader, httpClient, scheme, basePathModeOf(basePathMode))
 }
 
-func createAuthenticatedUniformHandler(baseURL string, authToken string, authHeader string, httpClient *http.Client, scheme string) *UniformHandler {
-	v2UniformHandler := v2.NewAuthenticatedUniformHandler(baseURL, authToken, authHeader, httpClient, scheme)
-
-	baseURL = strings.TrimRight(baseURL, "/")
-	if !strings.HasSuffix(baseURL, shipyardControllerBaseURL) {
-		baseURL += "/" + shipyardControllerBaseURL
-	}
+func createAuthenticatedUniformHandler(baseURL string, authToken string, authHeader string, httpClient *http.Client, scheme string, basePathMode v2.BasePathMode) *UniformHandler {
+	v2UniformHandler := v2.NewAuthenticatedUniformHandler(baseURL, authToken, authHeader, httpClient, scheme, basePathMode)
 
 	return &UniformHandler{
-		BaseURL:        httputils.TrimHTTPScheme(baseURL),
+		BaseURL:        httputils.TrimHTTPScheme(basePathMode.HandlerBaseURL(baseURL, v2.HandlerUniform)),
 		AuthHeader:     authHeader,
 		AuthToken:      authToken,
 		HTTPClient:     httpClient,
 		Scheme:         scheme,
 		uniformHandler: v2UniformHandler,
+		basePathMode:   basePathMode,
+		endpoint:       baseURL,
 	}
 }
 
@@ -91,37 +105,75 @@ func (u *UniformHandler) getHTTPClient() *http.Client {
 
 func (u *UniformHandler) Ping(integrationID string) (*models.Integration, error) {
 	u.ensureHandlerIsSet()
-	return u.uniformHandler.Ping(context.TODO(), integrationID, v2.UniformPingOptions{})
+	ctx, err := u.strictContext.implicitContext("UniformHandler.Ping")
+	if err != nil {
+		return nil, err
+	}
+	return u.uniformHandler.Ping(ctx, integrationID, v2.UniformPingOptions{})
 }
 
 func (u *UniformHandler) RegisterIntegration(integration models.Integration) (string, error) {
 	u.ensureHandlerIsSet()
-	return u.uniformHandler.RegisterIntegration(context.TODO(), integration, v2.UniformRegisterIntegrationOptions{})
+	ctx, err := u.strictContext.implicitContext("UniformHandler.RegisterIntegration")
+	if err != nil {
+		return "", err
+	}
+	return u.uniformHandler.RegisterIntegration(ctx, integration, v2.UniformRegisterIntegrationOptions{})
 }
 
 func (u *UniformHandler) CreateSubscription(integrationID string, subscription models.EventSubscription) (string, error) {
 	u.ensureHandlerIsSet()
-	return u.uniformHandler.CreateSubscription(context.TODO(), integrationID, subscription, v2.UniformCreateSubscriptionOptions{})
+	ctx, err := u.strictContext.implicitContext("UniformHandler.CreateSubscription")
+	if err != nil {
+		return "", err
+	}
+	return u.uniformHandler.CreateSubscription(ctx, integrationID, subscription, v2.UniformCreateSubscriptionOptions{})
 }
 
 func (u *UniformHandler) UnregisterIntegration(integrationID string) error {
 	u.ensureHandlerIsSet()
-	return u.uniformHandler.UnregisterIntegration(context.TODO(), integrationID, v2.UniformUnregisterIntegrationOptions{})
+	ctx, err := u.strictContext.implicitContext("UniformHandler.UnregisterIntegration")
+	if err != nil {
+		return err
+	}
+	return u.uniformHandler.UnregisterIntegration(ctx, integrationID, v2.UniformUnregisterIntegrationOptions{})
 }
 
 func (u *UniformHandler) GetRegistrations() ([]*models.Integration, error) {
 	u.ensureHandlerIsSet()
-	return u.uniformHandler.GetRegistrations(context.TODO(), v2.UniformGetRegistrationsOptions{})
+	ctx, err := u.strictContext.implicitContext("UniformHandler.GetRegistrations")
+	if err != nil {
+		return nil, err
+	}
+	return u.uniformHandler.GetRegistrations(ctx, v2.UniformGetRegistrationsOptions{})
 }
 
 func (u *UniformHandler) ensureHandlerIsSet() {
-	if u.uniformHandler != nil {
-		return
-	}
-
-	if u.AuthToken != "" {
-		u.uniformHandler = v2.NewAuthenticatedUniformHandler(u.BaseURL, u.AuthToken, u.AuthHeader, u.HTTPClient, u.Scheme)
-	} else {
-		u.uniformHandler = v2.NewUniformHandlerWithHTTPClient(u.BaseURL, u.HTTPClient)
-	}
+	u.once.Do(func() {
+		if u.uniformHandler != nil {
+			return
+		}
+
+		if u.AuthToken != "" {
+			u.uniformHandler = v2.NewAuthenticatedUniformHandler(u.BaseURL, u.AuthToken, u.AuthHeader, u.HTTPClient, u.Scheme)
+		} else {
+			u.uniformHandler = v2.NewUniformHandlerWithHTTPClient(u.BaseURL, u.HTTPClient)
+		}
+	})
+}
+
+// WithAuthToken returns a new UniformHandler that uses the given token and auth header but otherwise the same
+// settings. The UniformHandler itself is not modified, so it can still be used concurrently
+func (u *UniformHandler) WithAuthToken(authToken string, authHeader string) *UniformHandler {
+	derived := createAuthenticatedUniformHandler(derivedBaseURL(u.endpoint, u.BaseURL), authToken, authHeader, u.HTTPClient, u.Scheme, u.basePathMode)
+	derived.strictContext = u.strictContext
+	return derived
+}
+
+// WithHTTPClient returns a new UniformHandler that uses the given http.Client but otherwise the same settings.
+// The UniformHandler itself is not modified, so it can still be used concurrently
+func (u *UniformHandler) WithHTTPClient(httpClient *http.Client) *UniformHandler {
+	derived := createAuthenticatedUniformHandler(derivedBaseURL(u.endpoint, u.BaseURL), u.AuthToken, u.AuthHeader, httpClient, u.Scheme, u.basePathMode)
+	derived.strictContext = u.strictContext
+	return derived
 }
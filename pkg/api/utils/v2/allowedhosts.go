@@ -0,0 +1,62 @@
+package v2
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"strings"
+)
+
+// ErrHostNotAllowed is returned when a request is sent to a host which is not part of the hosts configured via WithAllowedHosts
+var ErrHostNotAllowed = errors.New("host is not allowed")
+
+// allowedHostsTransport is a http.RoundTripper which rejects all requests to hosts
+// that are not contained in its list of allowed hosts
+type allowedHostsTransport struct {
+	base  http.RoundTripper
+	hosts []string
+}
+
+func newAllowedHostsTransport(base http.RoundTripper, hosts []string) *allowedHostsTransport {
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	normalized := make([]string, 0, len(hosts))
+	for _, host := range hosts {
+		normalized = append(normalized, strings.ToLower(strings.TrimSuffix(strings.TrimSpace(host), ".")))
+	}
+	return &allowedHostsTransport{base: base, hosts: normalized}
+}
+
+func (t *allowedHostsTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if !t.isAllowed(req.URL.Host) {
+		if req.Body != nil {
+			req.Body.Close()
+		}
+		return nil, fmt.Errorf("%s: %w", req.URL.Host, ErrHostNotAllowed)
+	}
+	return t.base.RoundTrip(req)
+}
+
+// isAllowed checks the given host, optionally including a port, against the allowed hosts.
+// An allowed host matches either the host name only or the host name and port.
+// An allowed host starting with "*." matches all subdomains of the given domain
+func (t *allowedHostsTransport) isAllowed(hostPort string) bool {
+	hostPort = strings.ToLower(hostPort)
+	hostname := hostPort
+	if h, _, err := net.SplitHostPort(hostPort); err == nil {
+		hostname = h
+	}
+	hostname = strings.TrimSuffix(strings.Trim(hostname, "[]"), ".")
+
+	for _, allowed := range t.hosts {
+		switch {
+		case allowed == hostname || allowed == hostPort:
+			return true
+		case strings.HasPrefix(allowed, "*.") && strings.HasSuffix(hostname, allowed[1:]):
+			return true
+		}
+	}
+	return false
+}
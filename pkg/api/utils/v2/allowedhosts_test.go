@@ -0,0 +1,101 @@
+package v2
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/keptn/go-utils/pkg/api/models"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAllowedHostsTransport_isAllowed(t *testing.T) {
+	transport := newAllowedHostsTransport(nil, []string{"keptn.example.com", "LOCALHOST:8080", "*.svc.cluster.local", "[::1]:9090"})
+
+	tests := []struct {
+		host    string
+		allowed bool
+	}{
+		{host: "keptn.example.com", allowed: true},
+		{host: "keptn.example.com:443", allowed: true},
+		{host: "Keptn.Example.Com.", allowed: true},
+		{host: "other.example.com", allowed: false},
+		{host: "keptn.example.com.evil.com", allowed: false},
+		{host: "localhost:8080", allowed: true},
+		{host: "localhost", allowed: false},
+		{host: "localhost:9090", allowed: false},
+		{host: "api-gateway-nginx.keptn.svc.cluster.local", allowed: true},
+		{host: "svc.cluster.local", allowed: false},
+		{host: "evilsvc.cluster.local", allowed: false},
+		{host: "[::1]:9090", allowed: true},
+		{host: "[::1]:8080", allowed: false},
+		{host: "169.254.169.254", allowed: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.host, func(t *testing.T) {
+			require.Equal(t, tt.allowed, transport.isAllowed(tt.host))
+		})
+	}
+}
+
+func TestAPISet_WithAllowedHosts(t *testing.T) {
+	target := newTokenRecordingServer()
+	defer target.Close()
+
+	targetURL, _ := url.Parse(target.URL)
+	apiSet, err := New(target.URL, WithAllowedHosts(targetURL.Host))
+	require.Nil(t, err)
+
+	_, mErr := apiSet.Projects().GetProject(context.TODO(), models.Project{ProjectName: "my-project"}, ProjectsGetProjectOptions{})
+	require.Nil(t, mErr)
+	require.Len(t, target.received(), 1)
+
+	apiSet, err = New(target.URL, WithAllowedHosts("keptn.example.com"))
+	require.Nil(t, err)
+
+	_, mErr = apiSet.Projects().GetProject(context.TODO(), models.Project{ProjectName: "my-project"}, ProjectsGetProjectOptions{})
+	require.NotNil(t, mErr)
+	require.Contains(t, mErr.GetMessage(), ErrHostNotAllowed.Error())
+	require.Len(t, target.received(), 1)
+}
+
+func TestAPISet_WithAllowedHostsRejectsRedirect(t *testing.T) {
+	target := newTokenRecordingServer()
+	defer target.Close()
+
+	server := newRedirectingServer(target.URL)
+	defer server.Close()
+
+	serverURL, _ := url.Parse(server.URL)
+	apiSet, err := New(server.URL, WithAllowedHosts(serverURL.Host))
+	require.Nil(t, err)
+
+	_, mErr := apiSet.Projects().GetProject(context.TODO(), models.Project{ProjectName: "my-project"}, ProjectsGetProjectOptions{})
+	require.NotNil(t, mErr)
+	require.Contains(t, mErr.GetMessage(), ErrHostNotAllowed.Error())
+	require.Len(t, server.received(), 1)
+	require.Empty(t, target.received())
+}
+
+type roundTripperFunc func(req *http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+func TestAllowedHostsTransport_RoundTrip(t *testing.T) {
+	transport := newAllowedHostsTransport(roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		return &http.Response{StatusCode: http.StatusOK}, nil
+	}), []string{"keptn.example.com"})
+
+	req, _ := http.NewRequest(http.MethodGet, "http://keptn.example.com/api", nil)
+	resp, err := transport.RoundTrip(req)
+	require.Nil(t, err)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+
+	req, _ = http.NewRequest(http.MethodGet, "http://169.254.169.254/latest/meta-data", nil)
+	_, err = transport.RoundTrip(req)
+	require.True(t, errors.Is(err, ErrHostNotAllowed))
+}
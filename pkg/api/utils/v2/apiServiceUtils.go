@@ -5,8 +5,9 @@ import (
 	"context"
 	"crypto/tls"
 	"fmt"
-	"io/ioutil"
+	"io"
 	"net/http"
+	"sync"
 
 	"github.com/keptn/go-utils/pkg/api/models"
 	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
@@ -64,8 +65,51 @@ func getClientTransport(rt http.RoundTripper) http.RoundTripper {
 	return rt
 }
 
+// skipDefaultTransportVerificationOnce guards the modification of the http.DefaultTransport,
+// since changing it while it is used by concurrent requests is a data race
+var skipDefaultTransportVerificationOnce sync.Once
+
+// skipDefaultTransportVerification disables server certificate verification for the http.DefaultTransport
+func skipDefaultTransportVerification() {
+	skipDefaultTransportVerificationOnce.Do(func() {
+		http.DefaultTransport.(*http.Transport).TLSClientConfig = &tls.Config{InsecureSkipVerify: true}
+	})
+}
+
+// maxPooledBufferSize is the maximum capacity of a buffer that is handed back to
+// the buffer pool. Larger buffers are left to the garbage collector so that a single
+// big response does not pin memory for the lifetime of the process
+const maxPooledBufferSize = 1 << 20
+
+// bufferPool holds buffers that are reused for reading response bodies
+var bufferPool = sync.Pool{
+	New: func() interface{} {
+		return new(bytes.Buffer)
+	},
+}
+
+// readBody reads the content of the given reader into a pooled buffer
+// and returns a copy of the content that is owned by the caller
+func readBody(r io.Reader) ([]byte, error) {
+	buf := bufferPool.Get().(*bytes.Buffer)
+	buf.Reset()
+	defer func() {
+		if buf.Cap() <= maxPooledBufferSize {
+			bufferPool.Put(buf)
+		}
+	}()
+
+	if _, err := buf.ReadFrom(r); err != nil {
+		return nil, err
+	}
+	body := make([]byte, buf.Len())
+	copy(body, buf.Bytes())
+	return body, nil
+}
+
 func getAndExpectOK(ctx context.Context, uri string, api APIService) ([]byte, *models.Error) {
-	body, statusCode, status, err := get(ctx, uri, api)
+	ctx, requestID := ensureRequestID(ctx)
+	body, statusCode, status, header, err := get(ctx, uri, api)
 	if err != nil {
 		return nil, err
 	}
@@ -75,14 +119,15 @@ func getAndExpectOK(ctx context.Context, uri string, api APIService) ([]byte, *m
 	}
 
 	if len(body) > 0 {
-		return nil, handleErrStatusCode(statusCode, body)
+		return nil, withRequestID(handleErrStatusCode(statusCode, header, body, errorDetailsOf(api)), requestID)
 	}
 
-	return nil, buildErrorResponse(fmt.Sprintf("Received unexpected response: %d %s", statusCode, status))
+	return nil, withRequestID(buildErrorResponse(fmt.Sprintf("Received unexpected response: %d %s", statusCode, status)), requestID)
 }
 
 func getAndExpectSuccess(ctx context.Context, uri string, api APIService) ([]byte, *models.Error) {
-	body, statusCode, status, err := get(ctx, uri, api)
+	ctx, requestID := ensureRequestID(ctx)
+	body, statusCode, status, header, err := get(ctx, uri, api)
 	if err != nil {
 		return nil, err
 	}
@@ -92,51 +137,66 @@ func getAndExpectSuccess(ctx context.Context, uri string, api APIService) ([]byt
 	}
 
 	if len(body) > 0 {
-		return nil, handleErrStatusCode(statusCode, body)
+		return nil, withRequestID(handleErrStatusCode(statusCode, header, body, errorDetailsOf(api)), requestID)
 	}
 
-	return nil, buildErrorResponse(fmt.Sprintf("Received unexpected response: %d %s", statusCode, status))
+	return nil, withRequestID(buildErrorResponse(fmt.Sprintf("Received unexpected response: %d %s", statusCode, status)), requestID)
 }
 
-func get(ctx context.Context, uri string, api APIService) ([]byte, int, string, *models.Error) {
+func get(ctx context.Context, uri string, api APIService) ([]byte, int, string, http.Header, *models.Error) {
+	return getWithHeader(ctx, uri, nil, api)
+}
+
+// getWithHeader sends a GET request with the given additional header, e.g. for conditional requests
+func getWithHeader(ctx context.Context, uri string, header http.Header, api APIService) ([]byte, int, string, http.Header, *models.Error) {
 	req, err := http.NewRequestWithContext(ctx, "GET", uri, nil)
 	if err != nil {
-		return nil, 0, "", buildErrorResponse(err.Error())
+		return nil, 0, "", nil, buildErrorResponse(err.Error())
 	}
 	req.Header.Set("Content-Type", "application/json")
+	for key, values := range header {
+		req.Header[key] = values
+	}
 	addAuthHeader(req, api)
+	requestID := addRequestIDHeader(req)
 
 	resp, err := api.getHTTPClient().Do(req)
 	if err != nil {
-		return nil, 0, "", buildErrorResponse(err.Error())
+		return nil, 0, "", nil, withRequestID(requestErrorResponse(err), requestID)
 	}
 	defer resp.Body.Close()
 
-	body, err := ioutil.ReadAll(resp.Body)
+	body, err := readBody(resp.Body)
 	if err != nil {
-		return nil, 0, "", buildErrorResponse(err.Error())
+		return nil, 0, "", nil, withRequestID(buildErrorResponse(err.Error()), requestID)
 	}
 
-	return body, resp.StatusCode, resp.Status, nil
+	return body, resp.StatusCode, resp.Status, resp.Header, nil
 }
 
 func putWithEventContext(ctx context.Context, uri string, data []byte, api APIService) (*models.EventContext, *models.Error) {
-	req, err := http.NewRequestWithContext(ctx, "PUT", uri, bytes.NewBuffer(data))
+	return writeWithEventContext(ctx, "PUT", uri, "application/json", data, api)
+}
+
+// writeWithEventContext sends a request with the given method and body, e.g. a PATCH request with a JSON merge patch
+func writeWithEventContext(ctx context.Context, method string, uri string, contentType string, data []byte, api APIService) (*models.EventContext, *models.Error) {
+	req, err := http.NewRequestWithContext(ctx, method, uri, bytes.NewBuffer(data))
 	if err != nil {
 		return nil, buildErrorResponse(err.Error())
 	}
-	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Content-Type", contentType)
 	addAuthHeader(req, api)
+	requestID := addRequestIDHeader(req)
 
 	resp, err := api.getHTTPClient().Do(req)
 	if err != nil {
-		return nil, buildErrorResponse(err.Error())
+		return nil, withRequestID(requestErrorResponse(err), requestID)
 	}
 	defer resp.Body.Close()
 
-	body, err := ioutil.ReadAll(resp.Body)
+	body, err := readBody(resp.Body)
 	if err != nil {
-		return nil, buildErrorResponse(err.Error())
+		return nil, withRequestID(buildErrorResponse(err.Error()), requestID)
 	}
 
 	if resp.StatusCode >= 200 && resp.StatusCode <= 204 {
@@ -148,7 +208,7 @@ func putWithEventContext(ctx context.Context, uri string, data []byte, api APISe
 
 		if err = eventContext.FromJSON(body); err != nil {
 			// failed to parse json
-			return nil, buildErrorResponse(err.Error() + "\n" + "-----DETAILS-----" + string(body))
+			return nil, withRequestID(buildParseErrorResponse(err, body, errorDetailsOf(api)), requestID)
 		}
 
 		if eventContext.KeptnContext != nil {
@@ -158,10 +218,10 @@ func putWithEventContext(ctx context.Context, uri string, data []byte, api APISe
 	}
 
 	if len(body) > 0 {
-		return nil, handleErrStatusCode(resp.StatusCode, body)
+		return nil, withRequestID(handleErrStatusCode(resp.StatusCode, resp.Header, body, errorDetailsOf(api)), requestID)
 	}
 
-	return nil, buildErrorResponse(fmt.Sprintf("Received unexpected response: %d %s", resp.StatusCode, resp.Status))
+	return nil, withRequestID(buildErrorResponse(fmt.Sprintf("Received unexpected response: %d %s", resp.StatusCode, resp.Status)), requestID)
 }
 
 func put(ctx context.Context, uri string, data []byte, api APIService) (string, *models.Error) {
@@ -171,16 +231,17 @@ func put(ctx context.Context, uri string, data []byte, api APIService) (string,
 	}
 	req.Header.Set("Content-Type", "application/json")
 	addAuthHeader(req, api)
+	requestID := addRequestIDHeader(req)
 
 	resp, err := api.getHTTPClient().Do(req)
 	if err != nil {
-		return "", buildErrorResponse(err.Error())
+		return "", withRequestID(requestErrorResponse(err), requestID)
 	}
 	defer resp.Body.Close()
 
-	body, err := ioutil.ReadAll(resp.Body)
+	body, err := readBody(resp.Body)
 	if err != nil {
-		return "", buildErrorResponse(err.Error())
+		return "", withRequestID(buildErrorResponse(err.Error()), requestID)
 	}
 
 	if resp.StatusCode >= 200 && resp.StatusCode <= 204 {
@@ -188,10 +249,10 @@ func put(ctx context.Context, uri string, data []byte, api APIService) (string,
 	}
 
 	if len(body) > 0 {
-		return "", handleErrStatusCode(resp.StatusCode, body)
+		return "", withRequestID(handleErrStatusCode(resp.StatusCode, resp.Header, body, errorDetailsOf(api)), requestID)
 	}
 
-	return "", buildErrorResponse(fmt.Sprintf("Received unexpected response: %d %s", resp.StatusCode, resp.Status))
+	return "", withRequestID(buildErrorResponse(fmt.Sprintf("Received unexpected response: %d %s", resp.StatusCode, resp.Status)), requestID)
 }
 
 func postWithEventContext(ctx context.Context, uri string, data []byte, api APIService) (*models.EventContext, *models.Error) {
@@ -201,16 +262,17 @@ func postWithEventContext(ctx context.Context, uri string, data []byte, api APIS
 	}
 	req.Header.Set("Content-Type", "application/json")
 	addAuthHeader(req, api)
+	requestID := addRequestIDHeader(req)
 
 	resp, err := api.getHTTPClient().Do(req)
 	if err != nil {
-		return nil, buildErrorResponse(err.Error())
+		return nil, withRequestID(requestErrorResponse(err), requestID)
 	}
 	defer resp.Body.Close()
 
-	body, err := ioutil.ReadAll(resp.Body)
+	body, err := readBody(resp.Body)
 	if err != nil {
-		return nil, buildErrorResponse(err.Error())
+		return nil, withRequestID(buildErrorResponse(err.Error()), requestID)
 	}
 
 	if resp.StatusCode >= 200 && resp.StatusCode <= 204 {
@@ -221,7 +283,7 @@ func postWithEventContext(ctx context.Context, uri string, data []byte, api APIS
 		eventContext := &models.EventContext{}
 		if err = eventContext.FromJSON(body); err != nil {
 			// failed to parse json
-			return nil, buildErrorResponse(err.Error() + "\n" + "-----DETAILS-----" + string(body))
+			return nil, withRequestID(buildParseErrorResponse(err, body, errorDetailsOf(api)), requestID)
 		}
 
 		if eventContext.KeptnContext != nil {
@@ -231,29 +293,38 @@ func postWithEventContext(ctx context.Context, uri string, data []byte, api APIS
 	}
 
 	if len(body) > 0 {
-		return nil, handleErrStatusCode(resp.StatusCode, body)
+		return nil, withRequestID(handleErrStatusCode(resp.StatusCode, resp.Header, body, errorDetailsOf(api)), requestID)
 	}
 
-	return nil, buildErrorResponse(fmt.Sprintf("Received unexpected response: %d %s", resp.StatusCode, resp.Status))
+	return nil, withRequestID(buildErrorResponse(fmt.Sprintf("Received unexpected response: %d %s", resp.StatusCode, resp.Status)), requestID)
 }
 
 func post(ctx context.Context, uri string, data []byte, api APIService) (string, *models.Error) {
+	return postEncoded(ctx, uri, data, "", api)
+}
+
+// postEncoded sends a POST request whose body has been encoded with the given content encoding, e.g. "gzip"
+func postEncoded(ctx context.Context, uri string, data []byte, contentEncoding string, api APIService) (string, *models.Error) {
 	req, err := http.NewRequestWithContext(ctx, "POST", uri, bytes.NewBuffer(data))
 	if err != nil {
 		return "", buildErrorResponse(err.Error())
 	}
 	req.Header.Set("Content-Type", "application/json")
+	if contentEncoding != "" {
+		req.Header.Set("Content-Encoding", contentEncoding)
+	}
 	addAuthHeader(req, api)
+	requestID := addRequestIDHeader(req)
 
 	resp, err := api.getHTTPClient().Do(req)
 	if err != nil {
-		return "", buildErrorResponse(err.Error())
+		return "", withRequestID(requestErrorResponse(err), requestID)
 	}
 	defer resp.Body.Close()
 
-	body, err := ioutil.ReadAll(resp.Body)
+	body, err := readBody(resp.Body)
 	if err != nil {
-		return "", buildErrorResponse(err.Error())
+		return "", withRequestID(buildErrorResponse(err.Error()), requestID)
 	}
 
 	if resp.StatusCode >= 200 && resp.StatusCode <= 204 {
@@ -261,10 +332,10 @@ func post(ctx context.Context, uri string, data []byte, api APIService) (string,
 	}
 
 	if len(body) > 0 {
-		return "", handleErrStatusCode(resp.StatusCode, body)
+		return "", withRequestID(handleErrStatusCode(resp.StatusCode, resp.Header, body, errorDetailsOf(api)), requestID)
 	}
 
-	return "", buildErrorResponse(fmt.Sprintf("Received unexpected response: %d %s", resp.StatusCode, resp.Status))
+	return "", withRequestID(buildErrorResponse(fmt.Sprintf("Received unexpected response: %d %s", resp.StatusCode, resp.Status)), requestID)
 }
 
 func deleteWithEventContext(ctx context.Context, uri string, api APIService) (*models.EventContext, *models.Error) {
@@ -274,16 +345,17 @@ func deleteWithEventContext(ctx context.Context, uri string, api APIService) (*m
 	}
 	req.Header.Set("Content-Type", "application/json")
 	addAuthHeader(req, api)
+	requestID := addRequestIDHeader(req)
 
 	resp, err := api.getHTTPClient().Do(req)
 	if err != nil {
-		return nil, buildErrorResponse(err.Error())
+		return nil, withRequestID(requestErrorResponse(err), requestID)
 	}
 	defer resp.Body.Close()
 
-	body, err := ioutil.ReadAll(resp.Body)
+	body, err := readBody(resp.Body)
 	if err != nil {
-		return nil, buildErrorResponse(err.Error())
+		return nil, withRequestID(buildErrorResponse(err.Error()), requestID)
 	}
 
 	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
@@ -294,38 +366,39 @@ func deleteWithEventContext(ctx context.Context, uri string, api APIService) (*m
 		eventContext := &models.EventContext{}
 		if err = eventContext.FromJSON(body); err != nil {
 			// failed to parse json
-			return nil, buildErrorResponse(err.Error() + "\n" + "-----DETAILS-----" + string(body))
+			return nil, withRequestID(buildParseErrorResponse(err, body, errorDetailsOf(api)), requestID)
 		}
 		return eventContext, nil
 	}
 
-	return nil, handleErrStatusCode(resp.StatusCode, body)
+	return nil, withRequestID(handleErrStatusCode(resp.StatusCode, resp.Header, body, errorDetailsOf(api)), requestID)
 }
 
-func delete(ctx context.Context, uri string, api APIService) (string, *models.Error) {
+func deleteRequest(ctx context.Context, uri string, api APIService) (string, *models.Error) {
 	req, err := http.NewRequestWithContext(ctx, "DELETE", uri, nil)
 	if err != nil {
 		return "", buildErrorResponse(err.Error())
 	}
 	req.Header.Set("Content-Type", "application/json")
 	addAuthHeader(req, api)
+	requestID := addRequestIDHeader(req)
 
 	resp, err := api.getHTTPClient().Do(req)
 	if err != nil {
-		return "", buildErrorResponse(err.Error())
+		return "", withRequestID(requestErrorResponse(err), requestID)
 	}
 	defer resp.Body.Close()
 
-	body, err := ioutil.ReadAll(resp.Body)
+	body, err := readBody(resp.Body)
 	if err != nil {
-		return "", buildErrorResponse(err.Error())
+		return "", withRequestID(buildErrorResponse(err.Error()), requestID)
 	}
 
 	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
 		return string(body), nil
 	}
 
-	return "", handleErrStatusCode(resp.StatusCode, body)
+	return "", withRequestID(handleErrStatusCode(resp.StatusCode, resp.Header, body, errorDetailsOf(api)), requestID)
 }
 
 func buildErrorResponse(errorStr string) *models.Error {
@@ -333,6 +406,24 @@ func buildErrorResponse(errorStr string) *models.Error {
 	return &err
 }
 
+// requestErrorResponse builds the error of a request which failed before a response has been received.
+// Network-level failures are classified, see ClassifyNetworkError
+func requestErrorResponse(err error) *models.Error {
+	mErr := buildErrorResponse(err.Error())
+	mErr.Err = ClassifyNetworkError(err)
+	return mErr
+}
+
+// reachedNumberOfPages returns whether the given cursor points beyond the number of pages that should be retrieved.
+// If numberOfPages is not positive, all pages are retrieved
+func reachedNumberOfPages(cursor models.Cursor, numberOfPages int) bool {
+	if numberOfPages <= 0 {
+		return false
+	}
+	offset, ok := cursor.Offset()
+	return ok && offset >= numberOfPages
+}
+
 func addAuthHeader(req *http.Request, api APIService) {
 	if api.getAuthHeader() != "" && api.getAuthToken() != "" {
 		req.Header.Set(api.getAuthHeader(), api.getAuthToken())
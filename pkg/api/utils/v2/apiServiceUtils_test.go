@@ -1,10 +1,13 @@
 package v2
 
 import (
+	"bytes"
+	"io/ioutil"
 	"net/http"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
 )
 
@@ -25,3 +28,36 @@ func Test_createInstrumentedClientTransport(t *testing.T) {
 	_, isOtelTransport = client.Transport.(*otelhttp.Transport)
 	assert.True(t, isOtelTransport)
 }
+
+func Test_readBody(t *testing.T) {
+	content := []byte(`{"events":[{"id":"my-id"}]}`)
+
+	body, err := readBody(bytes.NewReader(content))
+	require.Nil(t, err)
+	require.Equal(t, content, body)
+
+	// reading another body must not modify the previously returned one
+	_, err = readBody(bytes.NewReader([]byte("other-content-that-is-longer")))
+	require.Nil(t, err)
+	require.Equal(t, content, body)
+}
+
+func BenchmarkReadBody(b *testing.B) {
+	content := bytes.Repeat([]byte("a"), 64*1024)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		if _, err := readBody(bytes.NewReader(content)); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkReadAll(b *testing.B) {
+	content := bytes.Repeat([]byte("a"), 64*1024)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		if _, err := ioutil.ReadAll(bytes.NewReader(content)); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
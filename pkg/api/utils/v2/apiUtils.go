@@ -38,6 +38,7 @@ type APIGetMetadataOptions struct{}
 
 type APIInterface interface {
 	// SendEvent sends an event to Keptn.
+	// If the event has no source, the source configured via WithEventSource is used.
 	SendEvent(ctx context.Context, event models.KeptnContextExtendedCE, opts APISendEventOptions) (*models.EventContext, *models.Error)
 
 	// TriggerEvaluation triggers a new evaluation.
@@ -63,11 +64,16 @@ type APIInterface interface {
 }
 
 type APIHandler struct {
-	baseURL    string
-	authToken  string
-	authHeader string
-	httpClient *http.Client
-	scheme     string
+	baseURL     string
+	authToken   string
+	authHeader  string
+	httpClient  *http.Client
+	scheme      string
+	eventSource string
+	idempotency idempotencyOptions
+	// exactBaseURL disables removing the base path of the shipyard controller for requests to the API service, see WithExactBaseURL
+	exactBaseURL bool
+	errorDetailsSetting
 }
 
 // NewAPIHandler returns a new APIHandler
@@ -80,13 +86,13 @@ func NewAPIHandlerWithHTTPClient(baseURL string, httpClient *http.Client) *APIHa
 	return createAPIHandler(baseURL, "", "", httpClient, "http")
 }
 
-// NewAuthenticatedAPIHandler returns a new APIHandler that authenticates at the api-service endpoint via the provided token
-func NewAuthenticatedAPIHandler(baseURL string, authToken string, authHeader string, httpClient *http.Client, scheme string) *APIHandler {
-	if !strings.HasSuffix(baseURL, shipyardControllerBaseURL) {
-		baseURL += "/" + shipyardControllerBaseURL
-	}
-
-	return createAPIHandler(baseURL, authToken, authHeader, httpClient, scheme)
+// NewAuthenticatedAPIHandler returns a new APIHandler that authenticates at the api-service endpoint via the provided token.
+// The base path of the service is added to the base URL according to basePathMode (default AppendMissingBasePath)
+func NewAuthenticatedAPIHandler(baseURL string, authToken string, authHeader string, httpClient *http.Client, scheme string, basePathMode ...BasePathMode) *APIHandler {
+	mode := basePathModeOf(basePathMode)
+	apiHandler := createAPIHandler(mode.HandlerBaseURL(baseURL, HandlerAPI), authToken, authHeader, httpClient, scheme)
+	apiHandler.exactBaseURL = mode == ExactBaseURL
+	return apiHandler
 }
 
 func createAPIHandler(baseURL string, authToken string, authHeader string, httpClient *http.Client, scheme string) *APIHandler {
@@ -116,9 +122,21 @@ func (a *APIHandler) getHTTPClient() *http.Client {
 }
 
 // SendEvent sends an event to Keptn.
+// If the event has no source, the source configured via WithEventSource is used.
 func (a *APIHandler) SendEvent(ctx context.Context, event models.KeptnContextExtendedCE, opts APISendEventOptions) (*models.EventContext, *models.Error) {
 	baseURL := a.getAPIServicePath()
 
+	if (event.Source == nil || *event.Source == "") && a.eventSource != "" {
+		source := a.eventSource
+		event.Source = &source
+	}
+	if event.Source == nil {
+		return nil, buildErrorResponse("source must be specified")
+	}
+	if err := models.ValidateEventSource(*event.Source); err != nil {
+		return nil, buildErrorResponse(err.Error())
+	}
+
 	bodyStr, err := event.ToJSON()
 	if err != nil {
 		return nil, buildErrorResponse(err.Error())
@@ -133,7 +151,10 @@ func (a *APIHandler) TriggerEvaluation(ctx context.Context, project, stage, serv
 	if err != nil {
 		return nil, buildErrorResponse(err.Error())
 	}
-	return postWithEventContext(ctx, a.scheme+"://"+a.getBaseURL()+v1ProjectPath+"/"+project+pathToStage+"/"+stage+pathToService+"/"+service+"/evaluation", bodyStr, a)
+	if mErr := checkIdentifiers("project", project, "stage", stage, "service", service); mErr != nil {
+		return nil, mErr
+	}
+	return postWithEventContext(ctx, a.scheme+"://"+a.getBaseURL()+v1ProjectPath+"/"+EscapeIdentifier(project)+pathToStage+"/"+EscapeIdentifier(stage)+pathToService+"/"+EscapeIdentifier(service)+"/evaluation", bodyStr, a)
 }
 
 // CreateProject creates a new project.
@@ -143,7 +164,11 @@ func (a *APIHandler) CreateProject(ctx context.Context, project models.CreatePro
 	if err != nil {
 		return "", buildErrorResponse(err.Error())
 	}
-	return post(ctx, a.scheme+"://"+a.getBaseURL()+v1ProjectPath, bodyStr, a)
+	resp, errObj := post(ctx, a.scheme+"://"+a.getBaseURL()+v1ProjectPath, bodyStr, a)
+	if a.idempotency.alreadyExists(errObj) {
+		return "", nil
+	}
+	return resp, errObj
 }
 
 // UpdateProject updates a project.
@@ -157,7 +182,13 @@ func (a *APIHandler) UpdateProject(ctx context.Context, project models.CreatePro
 
 // DeleteProject deletes a project.
 func (a *APIHandler) DeleteProject(ctx context.Context, project models.Project, opts APIDeleteProjectOptions) (*models.DeleteProjectResponse, *models.Error) {
-	resp, err := delete(ctx, a.scheme+"://"+a.getBaseURL()+v1ProjectPath+"/"+project.ProjectName, a)
+	if mErr := checkIdentifiers("project", project.ProjectName); mErr != nil {
+		return nil, mErr
+	}
+	resp, err := deleteRequest(ctx, a.scheme+"://"+a.getBaseURL()+v1ProjectPath+"/"+EscapeIdentifier(project.ProjectName), a)
+	if a.idempotency.notFoundOnDelete(err) {
+		return &models.DeleteProjectResponse{AlreadyDeleted: true}, nil
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -178,13 +209,25 @@ func (a *APIHandler) CreateService(ctx context.Context, project string, service
 	if err != nil {
 		return "", buildErrorResponse(err.Error())
 	}
-	return post(ctx, a.scheme+"://"+a.getBaseURL()+v1ProjectPath+"/"+project+pathToService, bodyStr, a)
+	if mErr := checkIdentifiers("project", project); mErr != nil {
+		return "", mErr
+	}
+	resp, errObj := post(ctx, a.scheme+"://"+a.getBaseURL()+v1ProjectPath+"/"+EscapeIdentifier(project)+pathToService, bodyStr, a)
+	if a.idempotency.alreadyExists(errObj) {
+		return "", nil
+	}
+	return resp, errObj
 }
 
 // DeleteService deletes a service.
 func (a *APIHandler) DeleteService(ctx context.Context, project, service string, opts APIDeleteServiceOptions) (*models.DeleteServiceResponse, *models.Error) {
-	resp, err := delete(ctx, a.scheme+"://"+a.getBaseURL()+v1ProjectPath+"/"+project+pathToService+"/"+service, a)
-
+	if mErr := checkIdentifiers("project", project, "service", service); mErr != nil {
+		return nil, mErr
+	}
+	resp, err := deleteRequest(ctx, a.scheme+"://"+a.getBaseURL()+v1ProjectPath+"/"+EscapeIdentifier(project)+pathToService+"/"+EscapeIdentifier(service), a)
+	if a.idempotency.notFoundOnDelete(err) {
+		return &models.DeleteServiceResponse{AlreadyDeleted: true}, nil
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -219,6 +262,9 @@ func (a *APIHandler) GetMetadata(ctx context.Context, opts APIGetMetadataOptions
 
 func (a *APIHandler) getAPIServicePath() string {
 	baseURL := a.getBaseURL()
+	if a.exactBaseURL {
+		return baseURL
+	}
 	if strings.HasSuffix(baseURL, "/"+shipyardControllerBaseURL) {
 		baseURL = strings.TrimSuffix(a.getBaseURL(), "/"+shipyardControllerBaseURL)
 	}
@@ -1,9 +1,16 @@
 package v2
 
 import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 
+	"github.com/keptn/go-utils/pkg/api/models"
+	"github.com/keptn/go-utils/pkg/common/strutils"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestAPIHandler_getAPIServicePath(t *testing.T) {
@@ -39,3 +46,42 @@ func TestAPIHandler_getAPIServicePath(t *testing.T) {
 		})
 	}
 }
+
+func TestAPIHandler_SendEventWithEventSource(t *testing.T) {
+	sources := []string{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		event := models.KeptnContextExtendedCE{}
+		require.Nil(t, event.FromJSON(body))
+		sources = append(sources, *event.Source)
+		w.Write([]byte(`{"keptnContext":"my-context"}`))
+	}))
+	defer server.Close()
+
+	apiSet, err := New(server.URL, WithEventSource("my-integration"))
+	require.Nil(t, err)
+
+	event := models.KeptnContextExtendedCE{Type: strutils.Stringp("sh.keptn.event.dev.delivery.triggered")}
+	_, mErr := apiSet.API().SendEvent(context.TODO(), event, APISendEventOptions{})
+	require.Nil(t, mErr)
+	require.Nil(t, event.Source)
+
+	event.Source = strutils.Stringp("my-override")
+	_, mErr = apiSet.API().SendEvent(context.TODO(), event, APISendEventOptions{})
+	require.Nil(t, mErr)
+	require.Equal(t, []string{"my-integration", "my-override"}, sources)
+
+	event.Source = strutils.Stringp("my override")
+	_, mErr = apiSet.API().SendEvent(context.TODO(), event, APISendEventOptions{})
+	require.NotNil(t, mErr)
+
+	apiSet, err = New(server.URL)
+	require.Nil(t, err)
+	event.Source = nil
+	_, mErr = apiSet.API().SendEvent(context.TODO(), event, APISendEventOptions{})
+	require.NotNil(t, mErr)
+	require.Len(t, sources, 2)
+
+	_, err = New(server.URL, WithEventSource("my integration"))
+	require.NotNil(t, err)
+}
@@ -0,0 +1,122 @@
+package v2
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/keptn/go-utils/pkg/api/models"
+)
+
+const (
+	approvalTriggeredEventType = "sh.keptn.event.approval.triggered"
+	approvalFinishedEventType  = "sh.keptn.event.approval.finished"
+)
+
+// ErrApprovalNotFound is returned if an approval to approve or decline is not open
+var ErrApprovalNotFound = errors.New("open approval not found")
+
+// ApprovalsListOpenApprovalsOptions are options for APISet.ListOpenApprovals().
+type ApprovalsListOpenApprovalsOptions struct{}
+
+// ApprovalsApproveEventOptions are options for APISet.ApproveEvent().
+type ApprovalsApproveEventOptions struct {
+	// Project is the project of the approval. It is optional, but narrows down the search for the approval
+	Project string
+	// Source is the source of the approval.finished event. If it is empty, the source configured via WithEventSource is used
+	Source string
+}
+
+// ApprovalsDeclineEventOptions are options for APISet.DeclineEvent().
+type ApprovalsDeclineEventOptions struct {
+	// Project is the project of the approval. It is optional, but narrows down the search for the approval
+	Project string
+	// Source is the source of the approval.finished event. If it is empty, the source configured via WithEventSource is used
+	Source string
+}
+
+// approvalFinishedData is the data of an approval.finished event, see v0_2_0.ApprovalFinishedEventData
+type approvalFinishedData struct {
+	Project string            `json:"project"`
+	Stage   string            `json:"stage"`
+	Service string            `json:"service"`
+	Labels  map[string]string `json:"labels,omitempty"`
+	Status  string            `json:"status"`
+	Result  string            `json:"result"`
+	Message string            `json:"message,omitempty"`
+}
+
+// ListOpenApprovals returns the approval.triggered events of the stage of the project which have not been approved or declined yet.
+// If stage is empty, the open approvals of all stages are returned
+func (c *APISet) ListOpenApprovals(ctx context.Context, project string, stage string, opts ApprovalsListOpenApprovalsOptions) ([]*models.KeptnContextExtendedCE, error) {
+	return c.shipyardControlHandler.GetOpenTriggeredEvents(ctx, EventFilter{
+		Project:   project,
+		Stage:     stage,
+		EventType: approvalTriggeredEventType,
+	}, ShipyardControlGetOpenTriggeredEventsOptions{})
+}
+
+// ApproveEvent approves the open approval with the given approval.triggered event ID by sending
+// an approval.finished event with result pass. The comment is sent as message of the event and may be empty.
+// If the approval is not open, an error wrapping ErrApprovalNotFound is returned
+func (c *APISet) ApproveEvent(ctx context.Context, approvalTriggeredID string, comment string, opts ApprovalsApproveEventOptions) (*models.EventContext, error) {
+	return c.finishApproval(ctx, approvalTriggeredID, opts.Project, opts.Source, "pass", comment)
+}
+
+// DeclineEvent declines the open approval with the given approval.triggered event ID by sending
+// an approval.finished event with result fail. The comment is sent as message of the event and may be empty.
+// If the approval is not open, an error wrapping ErrApprovalNotFound is returned
+func (c *APISet) DeclineEvent(ctx context.Context, approvalTriggeredID string, comment string, opts ApprovalsDeclineEventOptions) (*models.EventContext, error) {
+	return c.finishApproval(ctx, approvalTriggeredID, opts.Project, opts.Source, "fail", comment)
+}
+
+func (c *APISet) finishApproval(ctx context.Context, approvalTriggeredID string, project string, source string, result string, comment string) (*models.EventContext, error) {
+	if approvalTriggeredID == "" {
+		return nil, errors.New("approval.triggered event ID must not be empty")
+	}
+	triggered, err := c.shipyardControlHandler.GetOpenTriggeredEvents(ctx, EventFilter{
+		Project:   project,
+		EventType: approvalTriggeredEventType,
+		EventID:   approvalTriggeredID,
+	}, ShipyardControlGetOpenTriggeredEventsOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("could not retrieve approval %s: %w", approvalTriggeredID, err)
+	}
+	var triggeredEvent *models.KeptnContextExtendedCE
+	for _, event := range triggered {
+		if event.ID == approvalTriggeredID {
+			triggeredEvent = event
+			break
+		}
+	}
+	if triggeredEvent == nil {
+		return nil, fmt.Errorf("%w: %s", ErrApprovalNotFound, approvalTriggeredID)
+	}
+
+	data := approvalFinishedData{}
+	if err := triggeredEvent.DataAs(&data); err != nil {
+		return nil, fmt.Errorf("could not decode data of approval %s: %w", approvalTriggeredID, err)
+	}
+	data.Status = "succeeded"
+	data.Result = result
+	data.Message = comment
+
+	finishedType := approvalFinishedEventType
+	finished := models.KeptnContextExtendedCE{
+		Contenttype:        "application/json",
+		Data:               data,
+		Shkeptncontext:     triggeredEvent.Shkeptncontext,
+		Shkeptnspecversion: triggeredEvent.Shkeptnspecversion,
+		Specversion:        triggeredEvent.Specversion,
+		Triggeredid:        triggeredEvent.ID,
+		Type:               &finishedType,
+	}
+	if source != "" {
+		finished.Source = &source
+	}
+	eventContext, mErr := c.apiHandler.SendEvent(ctx, finished, APISendEventOptions{})
+	if mErr != nil {
+		return nil, mErr.ToError()
+	}
+	return eventContext, nil
+}
@@ -0,0 +1,100 @@
+package v2
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+const openApprovalsResponse = `{"events":[{
+	"id":"approval-1",
+	"shkeptncontext":"my-context",
+	"specversion":"1.0",
+	"shkeptnspecversion":"0.2.4",
+	"type":"sh.keptn.event.approval.triggered",
+	"data":{"project":"my-project","stage":"hardening","service":"carts","labels":{"owner":"team-a"},"approval":{"pass":"manual","warning":"manual"},"result":"pass","status":"succeeded"}
+}]}`
+
+const approvalTriggeredPath = "/v1/event/triggered/sh.keptn.event.approval.triggered"
+
+func newApprovalServer() *recordingServer {
+	return newRecordingServer(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodGet && strings.HasSuffix(r.URL.Path, approvalTriggeredPath):
+			if eventID := r.URL.Query().Get("eventID"); eventID != "" && eventID != "approval-1" {
+				w.Write([]byte(`{"events":[]}`))
+				return
+			}
+			w.Write([]byte(openApprovalsResponse))
+		case r.Method == http.MethodPost && strings.HasSuffix(r.URL.Path, "/v1/event"):
+			w.Write([]byte(`{"keptnContext":"my-context"}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+}
+
+func TestAPISet_ListOpenApprovals(t *testing.T) {
+	server := newApprovalServer()
+	defer server.Close()
+	apiSet, err := New(server.URL)
+	require.Nil(t, err)
+
+	approvals, err := apiSet.ListOpenApprovals(context.TODO(), "my-project", "hardening", ApprovalsListOpenApprovalsOptions{})
+	require.Nil(t, err)
+	require.Len(t, approvals, 1)
+	require.Equal(t, "approval-1", approvals[0].ID)
+	require.Equal(t, []string{"project=my-project&stage=hardening"}, server.queries(http.MethodGet, approvalTriggeredPath))
+}
+
+func TestAPISet_ApproveAndDeclineEvent(t *testing.T) {
+	server := newApprovalServer()
+	defer server.Close()
+	apiSet, err := New(server.URL, WithEventSource("my-cli"))
+	require.Nil(t, err)
+
+	eventContext, err := apiSet.ApproveEvent(context.TODO(), "approval-1", "looks good", ApprovalsApproveEventOptions{Project: "my-project"})
+	require.Nil(t, err)
+	require.Equal(t, "my-context", *eventContext.KeptnContext)
+	_, err = apiSet.DeclineEvent(context.TODO(), "approval-1", "", ApprovalsDeclineEventOptions{Source: "other-cli"})
+	require.Nil(t, err)
+	require.Equal(t, []string{"eventID=approval-1&project=my-project", "eventID=approval-1"}, server.queries(http.MethodGet, approvalTriggeredPath))
+
+	sent := server.sentEvents(t)
+	require.Len(t, sent, 2)
+	approved := sent[0]
+	require.Equal(t, "sh.keptn.event.approval.finished", *approved.Type)
+	require.Equal(t, "approval-1", approved.Triggeredid)
+	require.Equal(t, "my-context", approved.Shkeptncontext)
+	require.Equal(t, "my-cli", *approved.Source)
+	require.Equal(t, map[string]interface{}{
+		"project": "my-project",
+		"stage":   "hardening",
+		"service": "carts",
+		"labels":  map[string]interface{}{"owner": "team-a"},
+		"status":  "succeeded",
+		"result":  "pass",
+		"message": "looks good",
+	}, approved.Data)
+
+	declined := sent[1]
+	require.Equal(t, "other-cli", *declined.Source)
+	data := approvalFinishedData{}
+	require.Nil(t, declined.DataAs(&data))
+	require.Equal(t, "fail", data.Result)
+	require.Empty(t, data.Message)
+}
+
+func TestAPISet_ApproveEventNotOpen(t *testing.T) {
+	server := newApprovalServer()
+	defer server.Close()
+	apiSet, err := New(server.URL, WithEventSource("my-cli"))
+	require.Nil(t, err)
+
+	_, err = apiSet.ApproveEvent(context.TODO(), "approval-2", "", ApprovalsApproveEventOptions{})
+	require.ErrorIs(t, err, ErrApprovalNotFound)
+	require.Empty(t, server.sentEvents(t))
+}
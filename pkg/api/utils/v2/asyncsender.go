@@ -0,0 +1,178 @@
+package v2
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/keptn/go-utils/pkg/api/models"
+	"github.com/keptn/go-utils/pkg/common/retry"
+)
+
+// DefaultAsyncSenderQueueSize is the default number of events an AsyncSender buffers
+const DefaultAsyncSenderQueueSize = 100
+
+// DefaultAsyncSenderWorkers is the default number of events an AsyncSender delivers in parallel
+const DefaultAsyncSenderWorkers = 2
+
+// DefaultAsyncSenderMaxRetries is the default number of times an AsyncSender sends an event again after a retryable error
+const DefaultAsyncSenderMaxRetries = 3
+
+// ErrAsyncSenderQueueFull is returned by AsyncSender.Send if the queue of the sender is full
+var ErrAsyncSenderQueueFull = errors.New("queue of async sender is full")
+
+// ErrAsyncSenderClosed is returned by AsyncSender.Send once the sender has been closed
+var ErrAsyncSenderClosed = errors.New("async sender is closed")
+
+// EventDeliveryCallback is called once the delivery of an event queued by AsyncSender.Send has finished.
+// Either the event context returned by the Keptn API or the error of the last attempt is set
+type EventDeliveryCallback func(event models.KeptnContextExtendedCE, eventContext *models.EventContext, err *models.Error)
+
+// AsyncSenderOptions are options for NewAsyncSender()
+type AsyncSenderOptions struct {
+	// QueueSize is the number of events buffered before Send fails with ErrAsyncSenderQueueFull.
+	// If it is not positive, DefaultAsyncSenderQueueSize is used
+	QueueSize int
+	// Workers is the number of events delivered in parallel. If it is not positive, DefaultAsyncSenderWorkers is used.
+	// With more than one worker, events may be delivered in a different order than they were queued
+	Workers int
+	// MaxRetries is the number of times an event is sent again after a retryable error, e.g. a 503 response.
+	// If it is 0, DefaultAsyncSenderMaxRetries is used. A negative value disables retries
+	MaxRetries int
+	// RetryInterval is the delay before the first retry, which doubles with every retry.
+	// If it is not positive, DefaultPageRetryInterval is used
+	RetryInterval time.Duration
+}
+
+type queuedEvent struct {
+	event    models.KeptnContextExtendedCE
+	callback EventDeliveryCallback
+}
+
+// AsyncSender sends events to the Keptn API in the background, so that e.g. a task handler does not
+// wait for the API when it emits a .started event. Events are queued by Send and delivered by a pool of
+// workers, which retry failed deliveries and report the result of each event to its callback.
+// An AsyncSender must be closed with Close to deliver the queued events and stop the workers
+type AsyncSender struct {
+	api           APIInterface
+	queue         chan queuedEvent
+	maxRetries    int
+	retryInterval time.Duration
+	ctx           context.Context
+	cancel        context.CancelFunc
+	mtx           sync.RWMutex
+	closed        bool
+	wg            sync.WaitGroup
+}
+
+// NewAsyncSender creates a new AsyncSender sending events via the given APIInterface and starts its workers
+func NewAsyncSender(api APIInterface, opts AsyncSenderOptions) *AsyncSender {
+	queueSize := opts.QueueSize
+	if queueSize <= 0 {
+		queueSize = DefaultAsyncSenderQueueSize
+	}
+	workers := opts.Workers
+	if workers <= 0 {
+		workers = DefaultAsyncSenderWorkers
+	}
+	maxRetries := opts.MaxRetries
+	if maxRetries == 0 {
+		maxRetries = DefaultAsyncSenderMaxRetries
+	}
+	retryInterval := opts.RetryInterval
+	if retryInterval <= 0 {
+		retryInterval = DefaultPageRetryInterval
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	s := &AsyncSender{
+		api:           api,
+		queue:         make(chan queuedEvent, queueSize),
+		maxRetries:    maxRetries,
+		retryInterval: retryInterval,
+		ctx:           ctx,
+		cancel:        cancel,
+	}
+	for i := 0; i < workers; i++ {
+		s.wg.Add(1)
+		go s.work()
+	}
+	return s
+}
+
+// Send queues the event for delivery without waiting for the Keptn API. The callback, which may be nil,
+// is called by a worker of the sender once the event has been delivered or its delivery has failed.
+// Send fails with ErrAsyncSenderQueueFull if the queue is full and with ErrAsyncSenderClosed after Close
+func (s *AsyncSender) Send(event models.KeptnContextExtendedCE, callback EventDeliveryCallback) error {
+	s.mtx.RLock()
+	defer s.mtx.RUnlock()
+	if s.closed {
+		return ErrAsyncSenderClosed
+	}
+	select {
+	case s.queue <- queuedEvent{event: event, callback: callback}:
+		return nil
+	default:
+		return ErrAsyncSenderQueueFull
+	}
+}
+
+// Close stops accepting events and waits until all queued events have been delivered.
+// If the context is done before, pending deliveries are aborted, their callbacks are called with the error
+// of the context, and the error of the context is returned
+func (s *AsyncSender) Close(ctx context.Context) error {
+	s.mtx.Lock()
+	if !s.closed {
+		s.closed = true
+		close(s.queue)
+	}
+	s.mtx.Unlock()
+
+	done := make(chan struct{})
+	go func() {
+		s.wg.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+		s.cancel()
+		return nil
+	case <-ctx.Done():
+		s.cancel()
+		<-done
+		return ctx.Err()
+	}
+}
+
+func (s *AsyncSender) work() {
+	defer s.wg.Done()
+	for queued := range s.queue {
+		eventContext, err := s.deliver(queued.event)
+		if queued.callback != nil {
+			queued.callback(queued.event, eventContext, err)
+		}
+	}
+}
+
+// deliver sends the event, retrying it after retryable errors
+func (s *AsyncSender) deliver(event models.KeptnContextExtendedCE) (*models.EventContext, *models.Error) {
+	if err := s.ctx.Err(); err != nil {
+		return nil, buildErrorResponse(err.Error())
+	}
+	var eventContext *models.EventContext
+	var mErr *models.Error
+	attempts := 0
+	err := retry.Poll(s.ctx, s.retryInterval, maxPageRetryInterval, func(ctx context.Context) (bool, error) {
+		eventContext, mErr = s.api.SendEvent(ctx, event, APISendEventOptions{})
+		if mErr == nil || !isRetryableError(mErr) {
+			return true, nil
+		}
+		attempts++
+		return attempts > s.maxRetries, nil
+	})
+	if err != nil && mErr == nil {
+		return nil, buildErrorResponse(err.Error())
+	}
+	return eventContext, mErr
+}
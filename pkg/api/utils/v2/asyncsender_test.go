@@ -0,0 +1,120 @@
+package v2
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/keptn/go-utils/pkg/api/models"
+	"github.com/keptn/go-utils/pkg/common/strutils"
+	"github.com/stretchr/testify/require"
+)
+
+type deliveryRecorder struct {
+	mtx     sync.Mutex
+	results map[string]*models.Error
+	wg      sync.WaitGroup
+}
+
+func newDeliveryRecorder(expected int) *deliveryRecorder {
+	r := &deliveryRecorder{results: map[string]*models.Error{}}
+	r.wg.Add(expected)
+	return r
+}
+
+func (r *deliveryRecorder) callback(event models.KeptnContextExtendedCE, eventContext *models.EventContext, err *models.Error) {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+	r.results[event.ID] = err
+	r.wg.Done()
+}
+
+func asyncTestEvent(id string) models.KeptnContextExtendedCE {
+	return models.KeptnContextExtendedCE{
+		ID:     id,
+		Type:   strutils.Stringp("sh.keptn.event.echo.started"),
+		Source: strutils.Stringp("my-service"),
+	}
+}
+
+func TestAsyncSender_DeliversEventsWithRetries(t *testing.T) {
+	var requests int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		event := models.KeptnContextExtendedCE{}
+		body, err := ioutil.ReadAll(r.Body)
+		require.Nil(t, err)
+		require.Nil(t, event.FromJSON(body))
+		switch {
+		case event.ID == "rejected":
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(`{"code":400,"message":"invalid event"}`))
+		case event.ID == "flaky" && atomic.AddInt32(&requests, 1) == 1:
+			w.WriteHeader(http.StatusServiceUnavailable)
+			w.Write([]byte(`{"code":503,"message":"unavailable"}`))
+		default:
+			w.Write([]byte(`{"keptnContext":"my-context"}`))
+		}
+	}))
+	defer server.Close()
+
+	sender := NewAsyncSender(NewAPIHandler(server.URL), AsyncSenderOptions{RetryInterval: time.Millisecond})
+	recorder := newDeliveryRecorder(3)
+	for _, id := range []string{"ok", "flaky", "rejected"} {
+		require.Nil(t, sender.Send(asyncTestEvent(id), recorder.callback))
+	}
+	recorder.wg.Wait()
+	require.Nil(t, sender.Close(context.TODO()))
+
+	require.Nil(t, recorder.results["ok"])
+	require.Nil(t, recorder.results["flaky"])
+	require.NotNil(t, recorder.results["rejected"])
+	require.Equal(t, int64(http.StatusBadRequest), recorder.results["rejected"].Code)
+
+	require.ErrorIs(t, sender.Send(asyncTestEvent("late"), nil), ErrAsyncSenderClosed)
+}
+
+func TestAsyncSender_QueueFull(t *testing.T) {
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-release
+		w.Write([]byte(`{"keptnContext":"my-context"}`))
+	}))
+	defer server.Close()
+
+	sender := NewAsyncSender(NewAPIHandler(server.URL), AsyncSenderOptions{QueueSize: 1, Workers: 1})
+	recorder := newDeliveryRecorder(2)
+	require.Nil(t, sender.Send(asyncTestEvent("first"), recorder.callback))
+	// the first event is taken by the worker, the second one fills the queue
+	require.Eventually(t, func() bool {
+		return sender.Send(asyncTestEvent("second"), recorder.callback) == nil
+	}, time.Second, time.Millisecond)
+	require.ErrorIs(t, sender.Send(asyncTestEvent("third"), recorder.callback), ErrAsyncSenderQueueFull)
+
+	close(release)
+	require.Nil(t, sender.Close(context.TODO()))
+	recorder.wg.Wait()
+	require.Len(t, recorder.results, 2)
+}
+
+func TestAsyncSender_CloseAbortsPendingDeliveries(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+		w.Write([]byte(`{"code":503,"message":"unavailable"}`))
+	}))
+	defer server.Close()
+
+	sender := NewAsyncSender(NewAPIHandler(server.URL), AsyncSenderOptions{MaxRetries: 1000, RetryInterval: time.Millisecond})
+	recorder := newDeliveryRecorder(1)
+	require.Nil(t, sender.Send(asyncTestEvent("pending"), recorder.callback))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	require.ErrorIs(t, sender.Close(ctx), context.DeadlineExceeded)
+	recorder.wg.Wait()
+	require.NotNil(t, recorder.results["pending"])
+}
@@ -8,6 +8,8 @@ import (
 	"github.com/keptn/go-utils/pkg/common/httputils"
 )
 
+const v1AuthPath = "/v1/auth"
+
 // AuthAuthenticateOptions are options for AuthInterface.Authenticate().
 type AuthAuthenticateOptions struct{}
 
@@ -22,6 +24,7 @@ type AuthHandler struct {
 	authHeader string
 	httpClient *http.Client
 	scheme     string
+	errorDetailsSetting
 }
 
 // NewAuthHandler returns a new AuthHandler
@@ -67,5 +70,5 @@ func (a *AuthHandler) getHTTPClient() *http.Client {
 
 // Authenticate authenticates the client request against the server.
 func (a *AuthHandler) Authenticate(ctx context.Context, opts AuthAuthenticateOptions) (*models.EventContext, *models.Error) {
-	return postWithEventContext(ctx, a.scheme+"://"+a.getBaseURL()+"/v1/auth", nil, a)
+	return postWithEventContext(ctx, a.scheme+"://"+a.getBaseURL()+v1AuthPath, nil, a)
 }
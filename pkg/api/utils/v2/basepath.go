@@ -0,0 +1,87 @@
+package v2
+
+import (
+	"fmt"
+	"strings"
+)
+
+// BasePathMode determines how the base paths of the Keptn services, e.g. controlPlane for the shipyard controller
+// or mongodb-datastore for the datastore, are added to the base URL of the handlers of an APISet
+type BasePathMode int
+
+const (
+	// AppendMissingBasePath appends the base path of the service of a handler unless the base URL already ends with it.
+	// This is the default, so that both the URL of the API gateway, e.g. http://keptn/api, and the URL of a
+	// single service, e.g. http://keptn/api/controlPlane, can be used as base URL
+	AppendMissingBasePath BasePathMode = iota
+	// AlwaysAppendBasePath appends the base path of the service of a handler to the base URL, even if it already ends with it
+	AlwaysAppendBasePath
+	// ExactBaseURL uses the base URL as is for all handlers, e.g. if a gateway routes the requests to the services by itself
+	ExactBaseURL
+)
+
+// String returns the name of the mode
+func (m BasePathMode) String() string {
+	switch m {
+	case AppendMissingBasePath:
+		return "AppendMissingBasePath"
+	case AlwaysAppendBasePath:
+		return "AlwaysAppendBasePath"
+	case ExactBaseURL:
+		return "ExactBaseURL"
+	}
+	return fmt.Sprintf("BasePathMode(%d)", int(m))
+}
+
+// handlerBasePaths contains the base paths of the services the handlers of an APISet send their requests to.
+// The auth handler has no base path, as the auth endpoint is part of the API service
+var handlerBasePaths = map[string]string{
+	HandlerAPI:             shipyardControllerBaseURL,
+	HandlerAuth:            "",
+	HandlerEvents:          mongodbDatastoreServiceBaseUrl,
+	HandlerLogs:            shipyardControllerBaseURL,
+	HandlerProjects:        shipyardControllerBaseURL,
+	HandlerResources:       configurationServiceBaseURL,
+	HandlerSecrets:         secretServiceBaseURL,
+	HandlerSequences:       shipyardControllerBaseURL,
+	HandlerServices:        shipyardControllerBaseURL,
+	HandlerShipyardControl: shipyardControllerBaseURL,
+	HandlerStages:          shipyardControllerBaseURL,
+	HandlerUniform:         shipyardControllerBaseURL,
+}
+
+// WithBasePathMode sets how the base paths of the Keptn services are added to the base URL of the APISet
+// (default AppendMissingBasePath)
+func WithBasePathMode(mode BasePathMode) func(*APISet) {
+	return func(a *APISet) {
+		a.basePathMode = mode
+	}
+}
+
+// WithExactBaseURL uses the base URL of the APISet as is for all handlers, without appending the base paths
+// of the Keptn services. It is a shorthand for WithBasePathMode(ExactBaseURL)
+func WithExactBaseURL() func(*APISet) {
+	return WithBasePathMode(ExactBaseURL)
+}
+
+// HandlerBaseURL returns the base URL of the handler with the given name, e.g. HandlerEvents, according to the BasePathMode
+func (m BasePathMode) HandlerBaseURL(baseURL string, name string) string {
+	if m == ExactBaseURL {
+		return baseURL
+	}
+	baseURL = strings.TrimRight(baseURL, "/")
+	basePath := handlerBasePaths[name]
+	if basePath == "" || (m == AppendMissingBasePath && strings.HasSuffix(baseURL, basePath)) {
+		return baseURL
+	}
+	return baseURL + "/" + basePath
+}
+
+// basePathModeOf returns the first of the given modes, or AppendMissingBasePath if none is given.
+// It is used by the NewAuthenticated*Handler functions, which take the mode as optional argument
+func basePathModeOf(modes []BasePathMode) BasePathMode {
+	if len(modes) > 0 {
+		return modes[0]
+	}
+	return AppendMissingBasePath
+}
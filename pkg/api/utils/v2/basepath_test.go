@@ -0,0 +1,105 @@
+package v2
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestWithBasePathMode(t *testing.T) {
+	tests := []struct {
+		name          string
+		baseURL       string
+		options       []func(*APISet)
+		wantEvents    string
+		wantProjects  string
+		wantResources string
+		wantAuth      string
+		wantAPI       string
+	}{
+		{
+			name:          "AppendMissingBasePath appends base paths",
+			baseURL:       "http://keptn/api/",
+			wantEvents:    "keptn/api/mongodb-datastore",
+			wantProjects:  "keptn/api/controlPlane",
+			wantResources: "keptn/api/configuration-service",
+			wantAuth:      "keptn/api",
+			wantAPI:       "keptn/api",
+		},
+		{
+			name:          "AppendMissingBasePath keeps existing base path",
+			baseURL:       "http://keptn/api/controlPlane",
+			wantEvents:    "keptn/api/controlPlane/mongodb-datastore",
+			wantProjects:  "keptn/api/controlPlane",
+			wantResources: "keptn/api/controlPlane/configuration-service",
+			wantAuth:      "keptn/api/controlPlane",
+			wantAPI:       "keptn/api",
+		},
+		{
+			name:          "AlwaysAppendBasePath appends existing base path",
+			baseURL:       "http://keptn/api/controlPlane",
+			options:       []func(*APISet){WithBasePathMode(AlwaysAppendBasePath)},
+			wantEvents:    "keptn/api/controlPlane/mongodb-datastore",
+			wantProjects:  "keptn/api/controlPlane/controlPlane",
+			wantResources: "keptn/api/controlPlane/configuration-service",
+			wantAuth:      "keptn/api/controlPlane",
+			wantAPI:       "keptn/api/controlPlane",
+		},
+		{
+			name:          "ExactBaseURL uses base URL as is",
+			baseURL:       "http://keptn/api/controlPlane",
+			options:       []func(*APISet){WithExactBaseURL()},
+			wantEvents:    "keptn/api/controlPlane",
+			wantProjects:  "keptn/api/controlPlane",
+			wantResources: "keptn/api/controlPlane",
+			wantAuth:      "keptn/api/controlPlane",
+			wantAPI:       "keptn/api/controlPlane",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			apiSet, err := New(tt.baseURL, tt.options...)
+			require.NoError(t, err)
+			require.Equal(t, tt.wantEvents, apiSet.eventHandler.getBaseURL())
+			require.Equal(t, tt.wantProjects, apiSet.projectHandler.getBaseURL())
+			require.Equal(t, tt.wantResources, apiSet.resourceHandler.getBaseURL())
+			require.Equal(t, tt.wantAuth, apiSet.authHandler.getBaseURL())
+			require.Equal(t, tt.wantAPI, apiSet.apiHandler.getAPIServicePath())
+		})
+	}
+}
+
+func TestWithExactBaseURL_SendsRequestsToBaseURL(t *testing.T) {
+	var paths []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		paths = append(paths, r.URL.Path)
+		w.Write([]byte(`{"events":[]}`))
+	}))
+	defer server.Close()
+
+	apiSet, err := New(server.URL+"/datastore", WithExactBaseURL())
+	require.NoError(t, err)
+	_, mErr := apiSet.Events().GetEvents(context.TODO(), &EventFilter{Project: "my-project"}, EventsGetEventsOptions{})
+	require.Nil(t, mErr)
+	require.Equal(t, []string{"/datastore/event"}, paths)
+}
+
+func TestNewAuthenticatedHandler_BasePathMode(t *testing.T) {
+	require.Equal(t, "keptn/api/mongodb-datastore", NewAuthenticatedEventHandler("http://keptn/api", "", "", nil, "http").getBaseURL())
+	require.Equal(t, "keptn/api/controlPlane/controlPlane",
+		NewAuthenticatedProjectHandler("http://keptn/api/controlPlane", "", "", nil, "http", AlwaysAppendBasePath).getBaseURL())
+
+	apiHandler := NewAuthenticatedAPIHandler("http://keptn/api/controlPlane", "", "", nil, "http", ExactBaseURL)
+	require.Equal(t, "keptn/api/controlPlane", apiHandler.getBaseURL())
+	require.Equal(t, "keptn/api/controlPlane", apiHandler.getAPIServicePath())
+}
+
+func TestBasePathMode_String(t *testing.T) {
+	require.Equal(t, "AppendMissingBasePath", AppendMissingBasePath.String())
+	require.Equal(t, "AlwaysAppendBasePath", AlwaysAppendBasePath.String())
+	require.Equal(t, "ExactBaseURL", ExactBaseURL.String())
+	require.Equal(t, "BasePathMode(7)", BasePathMode(7).String())
+}
@@ -0,0 +1,229 @@
+package v2
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// Auth styles reported in Capabilities
+const (
+	// AuthStyleNone is used if no auth token is configured
+	AuthStyleNone = "none"
+	// AuthStyleToken is used for a static auth token configured via WithAuthToken
+	AuthStyleToken = "token"
+	// AuthStyleRefreshingToken is used for an auth token which is refreshed via WithTokenRefresher or WithTokenProvider
+	AuthStyleRefreshingToken = "refreshing-token"
+)
+
+// Capabilities describes the features enabled for an APISet. It can be logged when an integration starts,
+// or sent along with other metadata, e.g. as JSON, so that support can see how a client is configured.
+// It never contains credentials
+type Capabilities struct {
+	// Endpoint is the base URL of the Keptn API, with a password removed
+	Endpoint     string `json:"endpoint"`
+	Scheme       string `json:"scheme"`
+	SchemePolicy string `json:"schemePolicy"`
+	// Tracing is true if a TracerProvider has been configured via otel.SetTracerProvider, so that the OpenTelemetry spans
+	// of the requests are exported. Requests are instrumented either way
+	Tracing bool              `json:"tracing"`
+	Auth    AuthCapabilities  `json:"auth"`
+	Retry   RetryCapabilities `json:"retry"`
+	// EventSource is the source of events sent without a source of their own, see WithEventSource
+	EventSource string `json:"eventSource,omitempty"`
+	// EventSources are the event sources through which the integration receives events, e.g. nats.
+	// They are not known to the APISet and are added by the integration via WithEventSources
+	EventSources []string `json:"eventSources,omitempty"`
+	ReadOnly     bool     `json:"readOnly"`
+	// MaxConcurrentRequests is the maximum number of requests in flight, or 0 if it is unlimited
+	MaxConcurrentRequests int `json:"maxConcurrentRequests"`
+	// MaxRedirects is the maximum number of followed redirects, or 0 if redirects are disabled
+	MaxRedirects       int      `json:"maxRedirects"`
+	AllowedHosts       []string `json:"allowedHosts,omitempty"`
+	CertificatePinning bool     `json:"certificatePinning"`
+	ProxyAuth          bool     `json:"proxyAuth"`
+	// HandlerTransports are the names of the handlers with their own scheme or transport settings, see WithHandlerTransport
+	HandlerTransports []string `json:"handlerTransports,omitempty"`
+	AcceptLanguage    string   `json:"acceptLanguage,omitempty"`
+	PolicyDecider     bool     `json:"policyDecider"`
+	RequestJournal    bool     `json:"requestJournal"`
+	// LogCompressionThreshold is the number of log entries from which logs are sent compressed, or 0 if logs are not compressed
+	LogCompressionThreshold int  `json:"logCompressionThreshold"`
+	IgnoreNotFoundOnDelete  bool `json:"ignoreNotFoundOnDelete"`
+	AcceptAlreadyExists     bool `json:"acceptAlreadyExists"`
+	// MemoryBudget is the memory budget in bytes of paginated listings, or 0 if it is unlimited, see WithMemoryBudget
+	MemoryBudget int64 `json:"memoryBudget,omitempty"`
+	// SlowCallThreshold is the duration above which calls are reported as slow, or 0 if they are not reported,
+	// see WithSlowCallThreshold
+	SlowCallThreshold time.Duration `json:"slowCallThreshold,omitempty"`
+	// PathTemplate is the path template under which the Keptn API is exposed, see WithPathTemplate
+	PathTemplate string `json:"pathTemplate,omitempty"`
+	// ResponseValidators is the number of validators checking the responses, see WithResponseValidators
+	ResponseValidators int `json:"responseValidators,omitempty"`
+}
+
+// AuthCapabilities describes how an APISet authenticates at the Keptn API
+type AuthCapabilities struct {
+	// Style is one of AuthStyleNone, AuthStyleToken and AuthStyleRefreshingToken
+	Style  string `json:"style"`
+	Header string `json:"header,omitempty"`
+}
+
+// RetryCapabilities describes how an APISet retries failed requests
+type RetryCapabilities struct {
+	// PageRetries is the number of retries of a failed page of a paginated listing, see WithPageRetries
+	PageRetries       int           `json:"pageRetries"`
+	PageRetryInterval time.Duration `json:"pageRetryInterval,omitempty"`
+	// OperationPolicies are the policies per OperationClass, see WithOperationClasses. It is empty if they are not used
+	OperationPolicies map[OperationClass]OperationPolicy `json:"operationPolicies,omitempty"`
+}
+
+// Capabilities returns a description of the features enabled for the APISet
+func (c *APISet) Capabilities() Capabilities {
+	capabilities := Capabilities{
+		Endpoint:               c.endpointURL.Redacted(),
+		Scheme:                 c.scheme,
+		SchemePolicy:           c.schemePolicy.String(),
+		Tracing:                tracingConfigured(),
+		Auth:                   AuthCapabilities{Style: AuthStyleNone},
+		EventSource:            c.eventSource,
+		ReadOnly:               c.readOnly,
+		MaxConcurrentRequests:  c.maxConcurrentRequests,
+		MaxRedirects:           defaultMaxRedirects,
+		AllowedHosts:           append([]string{}, c.allowedHosts...),
+		CertificatePinning:     len(c.pinnedCertificates) > 0 || len(c.spkiPins) > 0,
+		ProxyAuth:              c.proxyAuth != nil,
+		AcceptLanguage:         c.acceptLanguage,
+		PolicyDecider:          c.policyDecider != nil,
+		RequestJournal:         c.requestJournal != nil,
+		IgnoreNotFoundOnDelete: c.idempotency.ignoreNotFoundOnDelete,
+		AcceptAlreadyExists:    c.idempotency.acceptAlreadyExists,
+		Retry: RetryCapabilities{
+			PageRetries:       c.pageRetries.maxRetries,
+			PageRetryInterval: c.pageRetries.interval,
+		},
+	}
+	switch {
+	case c.tokenRefresher != nil:
+		capabilities.Auth = AuthCapabilities{Style: AuthStyleRefreshingToken, Header: c.authHeader}
+	case c.apiToken != "":
+		capabilities.Auth = AuthCapabilities{Style: AuthStyleToken, Header: c.authHeader}
+	}
+	if c.redirectPolicy != nil {
+		capabilities.MaxRedirects = c.redirectPolicy.maxRedirects
+		if c.redirectPolicy.disabled {
+			capabilities.MaxRedirects = 0
+		}
+	}
+	if len(c.operationPolicies) > 0 {
+		capabilities.Retry.OperationPolicies = map[OperationClass]OperationPolicy{}
+		for class, policy := range c.operationPolicies {
+			capabilities.Retry.OperationPolicies[class] = policy
+		}
+	}
+	if len(c.handlerTransports) > 0 {
+		capabilities.HandlerTransports = sortedHandlerTransports(c.handlerTransports)
+	}
+	if c.logCompressionThreshold != nil {
+		capabilities.LogCompressionThreshold = *c.logCompressionThreshold
+	}
+	if c.memoryBudget > 0 {
+		capabilities.MemoryBudget = c.memoryBudget
+	}
+	if c.slowCallThreshold > 0 {
+		capabilities.SlowCallThreshold = c.slowCallThreshold
+	}
+	if c.pathTemplate != nil {
+		capabilities.PathTemplate = c.pathTemplate.template
+	}
+	capabilities.ResponseValidators = len(c.responseValidators)
+	return capabilities
+}
+
+// defaultTracerProvider is the global TracerProvider which is used until one is configured via otel.SetTracerProvider
+var defaultTracerProvider = otel.GetTracerProvider()
+
+// tracingConfigured returns whether a TracerProvider exporting the spans of the requests has been configured.
+// The types are compared, since a configured TracerProvider is not necessarily comparable
+func tracingConfigured() bool {
+	providerType := reflect.TypeOf(otel.GetTracerProvider())
+	return providerType != reflect.TypeOf(defaultTracerProvider) && providerType != reflect.TypeOf(trace.NewNoopTracerProvider())
+}
+
+// WithEventSources returns a copy of the capabilities with the given event sources of the integration, e.g. nats
+func (c Capabilities) WithEventSources(sources ...string) Capabilities {
+	c.EventSources = append([]string{}, sources...)
+	return c
+}
+
+// RegistrationMetaData returns the capabilities as key value pairs to be added to the metadata of a uniform registration,
+// see models.MetaData. The keys are the ones of String, and features without a value are set to "true"
+func (c Capabilities) RegistrationMetaData() map[string]string {
+	metaData := map[string]string{}
+	for _, feature := range c.features() {
+		keyValue := strings.SplitN(feature, "=", 2)
+		if len(keyValue) == 1 {
+			keyValue = append(keyValue, "true")
+		}
+		metaData[keyValue[0]] = keyValue[1]
+	}
+	return metaData
+}
+
+// String returns a single line summary of the capabilities, e.g. to be logged when an integration starts
+func (c Capabilities) String() string {
+	return strings.Join(c.features(), " ")
+}
+
+func (c Capabilities) features() []string {
+	features := []string{
+		"endpoint=" + c.Endpoint,
+		"auth=" + c.Auth.Style,
+		fmt.Sprintf("tracing=%t", c.Tracing),
+		fmt.Sprintf("pageRetries=%d", c.Retry.PageRetries),
+	}
+	if len(c.Retry.OperationPolicies) > 0 {
+		classes := make([]string, 0, len(c.Retry.OperationPolicies))
+		for class, policy := range c.Retry.OperationPolicies {
+			classes = append(classes, fmt.Sprintf("%s:%d", class, policy.MaxRetries))
+		}
+		sort.Strings(classes)
+		features = append(features, "retries="+strings.Join(classes, ","))
+	}
+	if c.EventSource != "" {
+		features = append(features, "eventSource="+c.EventSource)
+	}
+	if len(c.EventSources) > 0 {
+		features = append(features, "eventSources="+strings.Join(c.EventSources, ","))
+	}
+	if c.ReadOnly {
+		features = append(features, "readOnly")
+	}
+	if c.MaxConcurrentRequests > 0 {
+		features = append(features, fmt.Sprintf("maxConcurrentRequests=%d", c.MaxConcurrentRequests))
+	}
+	if c.CertificatePinning {
+		features = append(features, "certificatePinning")
+	}
+	if c.ProxyAuth {
+		features = append(features, "proxyAuth")
+	}
+	if len(c.HandlerTransports) > 0 {
+		features = append(features, "handlerTransports="+strings.Join(c.HandlerTransports, ","))
+	}
+	if len(c.AllowedHosts) > 0 {
+		features = append(features, "allowedHosts="+strings.Join(c.AllowedHosts, ","))
+	}
+	if c.PolicyDecider {
+		features = append(features, "policyDecider")
+	}
+	if c.RequestJournal {
+		features = append(features, "requestJournal")
+	}
+	return features
+}
@@ -0,0 +1,215 @@
+package v2
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/keptn/go-utils/pkg/api/models"
+	"github.com/keptn/go-utils/pkg/common/timeutils"
+)
+
+// ChangelogAction describes what happened to the configuration of a project
+type ChangelogAction string
+
+const (
+	// ChangelogCreate means that a project or service has been created
+	ChangelogCreate ChangelogAction = "create"
+	// ChangelogUpdate means that a project, service or resource has been updated
+	ChangelogUpdate ChangelogAction = "update"
+	// ChangelogDelete means that a project or service has been deleted
+	ChangelogDelete ChangelogAction = "delete"
+	// ChangelogTrigger means that a sequence has been triggered
+	ChangelogTrigger ChangelogAction = "trigger"
+)
+
+// ChangelogRecord is a single configuration operation of a project.
+// Depending on the operation, Stage, Service, Resource and Sequence are empty
+type ChangelogRecord struct {
+	// Time is the time of the operation. It is zero for resources whose version is not referenced by any event
+	Time   time.Time       `json:"time"`
+	Action ChangelogAction `json:"action"`
+	// Actor is the source of the event of the operation, e.g. the integration or user which triggered it
+	Actor    string `json:"actor,omitempty"`
+	Project  string `json:"project"`
+	Stage    string `json:"stage,omitempty"`
+	Service  string `json:"service,omitempty"`
+	Resource string `json:"resource,omitempty"`
+	Sequence string `json:"sequence,omitempty"`
+	// Version is the git commit ID of the configuration after the operation, if known
+	Version string `json:"version,omitempty"`
+	// Result is the result of a finished operation, e.g. "pass" or "fail"
+	Result       string `json:"result,omitempty"`
+	EventID      string `json:"eventId,omitempty"`
+	EventType    string `json:"eventType,omitempty"`
+	KeptnContext string `json:"keptnContext,omitempty"`
+}
+
+// Path returns the path of the entity the operation has been applied to, e.g. my-project/dev/my-service/helm/chart.tgz
+func (r ChangelogRecord) Path() string {
+	return DriftEntry{Project: r.Project, Stage: r.Stage, Service: r.Service, Resource: r.Resource}.Path()
+}
+
+// Changelog lists the configuration operations of a project in chronological order.
+// Records of resources whose time is unknown come last, sorted by path
+type Changelog struct {
+	Project string            `json:"project"`
+	Records []ChangelogRecord `json:"records"`
+}
+
+// ChangelogOptions configures ProjectChangelog
+type ChangelogOptions struct {
+	// FromTime limits the changelog to operations from the given time on. If it is zero, all stored events are used
+	FromTime time.Time
+	// SkipResources only lists the operations recorded as events, but not the versions of the resources of the project
+	SkipResources bool
+}
+
+// entityActions are the entities whose create, update and delete operations are recorded as events,
+// e.g. sh.keptn.event.service.create.finished
+var entityActions = map[string]bool{"project": true, "stage": true, "service": true}
+
+// ProjectChangelog composes the events of a project and the versions of its resources into a changelog
+// of who created, updated or deleted the project and its services, and who triggered which sequence when,
+// e.g. for compliance reports. The finished events of create, update and delete operations and the triggered
+// events of sequences are recorded. The current version of each resource is recorded as an update with the time
+// and actor of the first event referencing this version as git commit ID, since the configuration-service
+// does not provide the history of resources
+func ProjectChangelog(ctx context.Context, api KeptnInterface, project string, opts ChangelogOptions) (*Changelog, error) {
+	filter := &EventFilter{Project: project}
+	if !opts.FromTime.IsZero() {
+		filter.FromTime = opts.FromTime.UTC().Format(timeutils.KeptnTimeFormatISO8601)
+	}
+	events, mErr := api.Events().GetEvents(ctx, filter, EventsGetEventsOptions{})
+	if mErr != nil {
+		return nil, fmt.Errorf("unable to get events of project %s: %w", project, mErr.ToError())
+	}
+	SortByTime(events)
+
+	changelog := &Changelog{Project: project, Records: []ChangelogRecord{}}
+	// the first event referencing each version
+	versions := map[string]*models.KeptnContextExtendedCE{}
+	for _, event := range events {
+		if event == nil || event.Type == nil {
+			continue
+		}
+		if event.GitCommitID != "" && versions[event.GitCommitID] == nil {
+			versions[event.GitCommitID] = event
+		}
+		if record, ok := changelogRecordOf(project, event); ok {
+			changelog.Records = append(changelog.Records, record)
+		}
+	}
+
+	if !opts.SkipResources {
+		records, err := resourceChangelogRecords(ctx, api, project, versions)
+		if err != nil {
+			return nil, err
+		}
+		changelog.Records = append(changelog.Records, records...)
+	}
+
+	sort.SliceStable(changelog.Records, func(i, j int) bool {
+		a, b := changelog.Records[i], changelog.Records[j]
+		if a.Time.IsZero() != b.Time.IsZero() {
+			return !a.Time.IsZero()
+		}
+		if a.Time.IsZero() {
+			return a.Path() < b.Path()
+		}
+		return models.NewEventOrderKey(a.Time, a.EventID).Less(models.NewEventOrderKey(b.Time, b.EventID))
+	})
+	return changelog, nil
+}
+
+// changelogRecordOf returns the record of the given event, if it is the finished event of a create, update or delete
+// operation or the triggered event of a sequence
+func changelogRecordOf(project string, event *models.KeptnContextExtendedCE) (ChangelogRecord, bool) {
+	// sh.keptn.event.<entity>.<action>.finished or sh.keptn.event.<stage>.<sequence>.triggered
+	parts := strings.Split(*event.Type, ".")
+	if len(parts) != 6 || !strings.HasPrefix(*event.Type, "sh.keptn.event.") {
+		return ChangelogRecord{}, false
+	}
+	data := map[string]interface{}{}
+	_ = event.DataAs(&data)
+	stage, _ := data["stage"].(string)
+	service, _ := data["service"].(string)
+	result, _ := data["result"].(string)
+	record := ChangelogRecord{
+		Time:         event.Time,
+		Project:      project,
+		Stage:        stage,
+		Service:      service,
+		Result:       result,
+		Version:      event.GitCommitID,
+		EventID:      event.ID,
+		EventType:    *event.Type,
+		KeptnContext: event.Shkeptncontext,
+	}
+	if event.Source != nil {
+		record.Actor = *event.Source
+	}
+
+	switch {
+	case entityActions[parts[3]] && parts[5] == "finished":
+		switch action := ChangelogAction(parts[4]); action {
+		case ChangelogCreate, ChangelogUpdate, ChangelogDelete:
+			record.Action = action
+			return record, true
+		}
+	case !entityActions[parts[3]] && parts[5] == "triggered":
+		record.Action = ChangelogTrigger
+		record.Stage = parts[3]
+		record.Sequence = parts[4]
+		return record, true
+	}
+	return ChangelogRecord{}, false
+}
+
+// resourceChangelogRecords returns an update record for each resource of the project
+func resourceChangelogRecords(ctx context.Context, api KeptnInterface, project string, versions map[string]*models.KeptnContextExtendedCE) ([]ChangelogRecord, error) {
+	p, mErr := api.Projects().GetProject(ctx, models.Project{ProjectName: project}, ProjectsGetProjectOptions{})
+	if mErr != nil {
+		return nil, fmt.Errorf("unable to get project %s: %w", project, mErr.ToError())
+	}
+
+	records := []ChangelogRecord{}
+	add := func(stage string, service string, resources []*models.Resource) {
+		for _, resource := range resources {
+			if resource == nil || resource.ResourceURI == nil {
+				continue
+			}
+			record := ChangelogRecord{Action: ChangelogUpdate, Project: project, Stage: stage, Service: service, Resource: *resource.ResourceURI}
+			if resource.Metadata != nil {
+				record.Version = resource.Metadata.Version
+			}
+			if event := versions[record.Version]; record.Version != "" && event != nil {
+				record.Time = event.Time
+				record.EventID = event.ID
+				record.EventType = *event.Type
+				record.KeptnContext = event.Shkeptncontext
+				if event.Source != nil {
+					record.Actor = *event.Source
+				}
+			}
+			records = append(records, record)
+		}
+	}
+	for _, stage := range p.Stages {
+		resources, err := api.Resources().GetAllStageResources(ctx, project, stage.StageName, ResourcesGetAllStageResourcesOptions{})
+		if err != nil {
+			return nil, fmt.Errorf("unable to get resources of stage %s of project %s: %w", stage.StageName, project, err)
+		}
+		add(stage.StageName, "", resources)
+		for _, service := range stage.Services {
+			resources, err := api.Resources().GetAllServiceResources(ctx, project, stage.StageName, service.ServiceName, ResourcesGetAllServiceResourcesOptions{})
+			if err != nil {
+				return nil, fmt.Errorf("unable to get resources of service %s in stage %s of project %s: %w", service.ServiceName, stage.StageName, project, err)
+			}
+			add(stage.StageName, service.ServiceName, resources)
+		}
+	}
+	return records, nil
+}
@@ -0,0 +1,81 @@
+package v2
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/keptn/go-utils/pkg/api/models"
+	"github.com/keptn/go-utils/pkg/common/strutils"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProjectChangelog(t *testing.T) {
+	events := `{"events":[
+		{"id":"3","type":"sh.keptn.event.dev.delivery.triggered","source":"cli","time":"2022-01-01T10:00:03.000Z","shkeptncontext":"ctx-2","gitcommitid":"c2","data":{"project":"sockshop","service":"carts"}},
+		{"id":"1","type":"sh.keptn.event.project.create.finished","source":"shipyard-controller","time":"2022-01-01T10:00:01.000Z","shkeptncontext":"ctx-1","gitcommitid":"c1","data":{"project":"sockshop","result":"pass"}},
+		{"id":"2","type":"sh.keptn.event.service.create.finished","source":"shipyard-controller","time":"2022-01-01T10:00:02.000Z","data":{"project":"sockshop","service":"carts","result":"pass"}},
+		{"id":"4","type":"sh.keptn.event.deployment.triggered","source":"shipyard-controller","time":"2022-01-01T10:00:04.000Z","gitcommitid":"c2","data":{"project":"sockshop","stage":"dev","service":"carts"}},
+		{"id":"5","type":"sh.keptn.event.service.create.started","source":"shipyard-controller","time":"2022-01-01T10:00:05.000Z","data":{"project":"sockshop"}}
+	]}`
+	resources := map[string][]*models.Resource{
+		"sockshop/stage/dev/resource": {
+			{ResourceURI: strutils.Stringp("/slo.yaml"), Metadata: &models.Version{Version: "c2"}},
+			{ResourceURI: strutils.Stringp("/sli.yaml"), Metadata: &models.Version{Version: "unknown"}},
+		},
+		"sockshop/stage/dev/service/carts/resource": {{ResourceURI: strutils.Stringp("/helm/carts.tgz")}},
+	}
+	var fromTime string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case strings.HasSuffix(r.URL.Path, "/mongodb-datastore/event"):
+			require.Equal(t, "sockshop", r.URL.Query().Get("project"))
+			fromTime = r.URL.Query().Get("fromTime")
+			w.Write([]byte(events))
+		case strings.HasSuffix(r.URL.Path, "/controlPlane"+v1ProjectPath+"/sockshop"):
+			json.NewEncoder(w).Encode(models.Project{ProjectName: "sockshop", Stages: []*models.Stage{
+				{StageName: "dev", Services: []*models.Service{{ServiceName: "carts"}}},
+			}})
+		default:
+			path := strings.TrimPrefix(r.URL.Path, "/configuration-service"+v1ProjectPath+"/")
+			json.NewEncoder(w).Encode(models.Resources{Resources: resources[path]})
+		}
+	}))
+	defer server.Close()
+	apiSet, err := New(server.URL)
+	require.Nil(t, err)
+
+	changelog, err := ProjectChangelog(context.TODO(), apiSet, "sockshop", ChangelogOptions{FromTime: time.Date(2022, 1, 1, 10, 0, 0, 0, time.UTC)})
+	require.Nil(t, err)
+	require.Equal(t, "2022-01-01T10:00:00.000Z", fromTime)
+	require.Equal(t, "sockshop", changelog.Project)
+
+	summary := []string{}
+	for _, record := range changelog.Records {
+		summary = append(summary, string(record.Action)+" "+record.Path()+" "+record.Actor+" "+record.EventID)
+	}
+	require.Equal(t, []string{
+		"create sockshop shipyard-controller 1",
+		"create sockshop/carts shipyard-controller 2",
+		"trigger sockshop/dev/carts cli 3",
+		"update sockshop/dev/slo.yaml cli 3",
+		"update sockshop/dev/carts/helm/carts.tgz  ",
+		"update sockshop/dev/sli.yaml  ",
+	}, summary)
+
+	trigger := changelog.Records[2]
+	require.Equal(t, "delivery", trigger.Sequence)
+	require.Equal(t, "c2", trigger.Version)
+	require.Equal(t, "ctx-2", trigger.KeptnContext)
+	require.Equal(t, "pass", changelog.Records[0].Result)
+	require.True(t, changelog.Records[5].Time.IsZero())
+
+	changelog, err = ProjectChangelog(context.TODO(), apiSet, "sockshop", ChangelogOptions{SkipResources: true})
+	require.Nil(t, err)
+	require.Empty(t, fromTime)
+	require.Len(t, changelog.Records, 3)
+}
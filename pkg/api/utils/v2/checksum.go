@@ -0,0 +1,68 @@
+package v2
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"github.com/keptn/go-utils/pkg/api/models"
+)
+
+// ResourceChecksumHeader is the header the checksum of a single written resource is sent in.
+// If the response contains it as well, it has to match the checksum that was sent
+const ResourceChecksumHeader = "X-Keptn-Resource-Checksum"
+
+const sha256ChecksumPrefix = "sha256:"
+
+// ResourceIntegrityError is returned if the content of a resource does not match its checksum,
+// e.g. because it has been truncated or corrupted by a proxy on its way
+type ResourceIntegrityError struct {
+	ResourceURI string
+	Expected    string
+	Actual      string
+}
+
+func (e *ResourceIntegrityError) Error() string {
+	return fmt.Sprintf("integrity check of resource %s failed: expected checksum %s but got %s", e.ResourceURI, e.Expected, e.Actual)
+}
+
+// ResourceChecksum returns the SHA-256 checksum of the given resource content in the form sha256:<hex digest>
+func ResourceChecksum(content string) string {
+	digest := sha256.Sum256([]byte(content))
+	return sha256ChecksumPrefix + hex.EncodeToString(digest[:])
+}
+
+// verifyResourceChecksum checks whether the checksum of the given content matches the expected one.
+// If no checksum is expected, e.g. because the configuration-service does not provide one, the content is not checked
+func verifyResourceChecksum(resourceURI *string, content string, expected string) error {
+	if expected == "" {
+		return nil
+	}
+	if actual := ResourceChecksum(content); !strings.EqualFold(actual, expected) {
+		return newResourceIntegrityError(resourceURI, expected, actual)
+	}
+	return nil
+}
+
+// verifyListedResourceChecksum checks the checksum of a resource contained in a listing of resources.
+// Listings contain the base64 encoded content of the resources, if any
+func verifyListedResourceChecksum(resource *models.Resource) error {
+	if resource == nil || resource.Checksum == "" || resource.ResourceContent == "" {
+		return nil
+	}
+	content, err := base64.StdEncoding.DecodeString(resource.ResourceContent)
+	if err != nil {
+		return err
+	}
+	return verifyResourceChecksum(resource.ResourceURI, string(content), resource.Checksum)
+}
+
+func newResourceIntegrityError(resourceURI *string, expected string, actual string) *ResourceIntegrityError {
+	err := &ResourceIntegrityError{Expected: expected, Actual: actual}
+	if resourceURI != nil {
+		err.ResourceURI = *resourceURI
+	}
+	return err
+}
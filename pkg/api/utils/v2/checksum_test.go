@@ -0,0 +1,113 @@
+package v2
+
+import (
+	"context"
+	b64 "encoding/base64"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/keptn/go-utils/pkg/api/models"
+	"github.com/keptn/go-utils/pkg/common/strutils"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResourceChecksum(t *testing.T) {
+	require.Equal(t, "sha256:9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08", ResourceChecksum("test"))
+	require.Nil(t, verifyResourceChecksum(nil, "test", ""))
+	require.Nil(t, verifyResourceChecksum(nil, "test", "SHA256:9F86D081884C7D659A2FEAA0C55AD015A3BF4F1B2B0B822CD15D6C15B0F00A08"))
+
+	err := verifyResourceChecksum(strutils.Stringp("shipyard.yaml"), "tes", ResourceChecksum("test"))
+	integrityErr := &ResourceIntegrityError{}
+	require.True(t, errors.As(err, &integrityErr))
+	require.Equal(t, "shipyard.yaml", integrityErr.ResourceURI)
+	require.Equal(t, ResourceChecksum("test"), integrityErr.Expected)
+	require.Equal(t, ResourceChecksum("tes"), integrityErr.Actual)
+}
+
+func newResourceServer(content string, checksum string) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resource := models.Resource{
+			ResourceURI:     strutils.Stringp("shipyard.yaml"),
+			ResourceContent: b64.StdEncoding.EncodeToString([]byte(content)),
+			Checksum:        checksum,
+		}
+		body, _ := resource.ToJSON()
+		w.Write(body)
+	}))
+}
+
+func TestResourceHandler_GetResourceVerifiesChecksum(t *testing.T) {
+	server := newResourceServer("content", ResourceChecksum("content"))
+	defer server.Close()
+	resource, err := NewResourceHandler(server.URL).GetResourceByURI(context.TODO(), server.URL)
+	require.Nil(t, err)
+	require.Equal(t, "content", resource.ResourceContent)
+
+	server = newResourceServer("conte", ResourceChecksum("content"))
+	defer server.Close()
+	_, err = NewResourceHandler(server.URL).GetResourceByURI(context.TODO(), server.URL)
+	integrityErr := &ResourceIntegrityError{}
+	require.True(t, errors.As(err, &integrityErr))
+
+	server = newResourceServer("content", "")
+	defer server.Close()
+	resource, err = NewResourceHandler(server.URL).GetResourceByURI(context.TODO(), server.URL)
+	require.Nil(t, err)
+	require.Equal(t, "content", resource.ResourceContent)
+}
+
+func TestResourceHandler_GetAllResourcesVerifiesChecksum(t *testing.T) {
+	checksum := ResourceChecksum("content")
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("nextPageKey") == "" {
+			w.Write([]byte(`{"resources":[{"resourceURI":"/slo.yaml","resourceContent":"` + b64.StdEncoding.EncodeToString([]byte("content")) + `","checksum":"` + checksum + `"}],"nextPageKey":"2"}`))
+			return
+		}
+		w.Write([]byte(`{"resources":[{"resourceURI":"/sli.yaml","resourceContent":"` + b64.StdEncoding.EncodeToString([]byte("conte")) + `","checksum":"` + checksum + `"}]}`))
+	}))
+	defer server.Close()
+
+	apiSet, err := New(server.URL)
+	require.Nil(t, err)
+	_, err = apiSet.Resources().GetAllServiceResources(context.TODO(), "sockshop", "dev", "carts", ResourcesGetAllServiceResourcesOptions{})
+	integrityErr := &ResourceIntegrityError{}
+	require.True(t, errors.As(err, &integrityErr))
+	require.Equal(t, "/sli.yaml", integrityErr.ResourceURI)
+
+	err = apiSet.GetAllResourcesAsArchive(context.TODO(), *NewResourceScope().Project("sockshop"), io.Discard, ResourcesGetAllResourcesAsArchiveOptions{})
+	require.True(t, errors.As(err, &integrityErr))
+}
+
+func TestResourceHandler_WriteResourceSendsChecksum(t *testing.T) {
+	receivedHeaders := []string{}
+	receivedResources := []models.Resource{}
+	responseChecksum := ""
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		receivedHeaders = append(receivedHeaders, r.Header.Get(ResourceChecksumHeader))
+		body, _ := io.ReadAll(r.Body)
+		resource := models.Resource{}
+		require.Nil(t, resource.FromJSON(body))
+		receivedResources = append(receivedResources, resource)
+		if responseChecksum != "" {
+			w.Header().Set(ResourceChecksumHeader, responseChecksum)
+		}
+		w.Write([]byte(`{"version":"my-version"}`))
+	}))
+	defer server.Close()
+
+	resource := &models.Resource{ResourceURI: strutils.Stringp("shipyard.yaml"), ResourceContent: "content"}
+	version, err := NewResourceHandler(server.URL).UpdateResourceByURI(context.TODO(), server.URL, resource)
+	require.Nil(t, err)
+	require.Equal(t, "my-version", version)
+	require.Equal(t, []string{ResourceChecksum("content")}, receivedHeaders)
+	require.Equal(t, ResourceChecksum("content"), receivedResources[0].Checksum)
+
+	responseChecksum = ResourceChecksum("conte")
+	_, err = NewResourceHandler(server.URL).UpdateResourceByURI(context.TODO(), server.URL, resource)
+	integrityErr := &ResourceIntegrityError{}
+	require.True(t, errors.As(err, &integrityErr))
+	require.Equal(t, "shipyard.yaml", integrityErr.ResourceURI)
+}
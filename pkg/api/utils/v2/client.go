@@ -1,9 +1,14 @@
 package v2
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 	"net/url"
+	"time"
+
+	"github.com/keptn/go-utils/pkg/api/models"
+	"github.com/keptn/go-utils/pkg/common/policy"
 )
 
 var _ KeptnInterface = (*APISet)(nil)
@@ -25,23 +30,53 @@ type KeptnInterface interface {
 
 // APISet contains the API utils for all Keptn APIs
 type APISet struct {
-	endpointURL            *url.URL
-	apiToken               string
-	authHeader             string
-	scheme                 string
-	httpClient             *http.Client
-	apiHandler             *APIHandler
-	authHandler            *AuthHandler
-	eventHandler           *EventHandler
-	logHandler             *LogHandler
-	projectHandler         *ProjectHandler
-	resourceHandler        *ResourceHandler
-	secretHandler          *SecretHandler
-	sequenceControlHandler *SequenceControlHandler
-	serviceHandler         *ServiceHandler
-	stageHandler           *StageHandler
-	uniformHandler         *UniformHandler
-	shipyardControlHandler *ShipyardControllerHandler
+	endpointURL             *url.URL
+	apiToken                string
+	authHeader              string
+	scheme                  string
+	httpClient              *http.Client
+	apiHandler              *APIHandler
+	authHandler             *AuthHandler
+	eventHandler            *EventHandler
+	logHandler              *LogHandler
+	logCompressionThreshold *int
+	projectHandler          *ProjectHandler
+	resourceHandler         *ResourceHandler
+	secretHandler           *SecretHandler
+	sequenceControlHandler  *SequenceControlHandler
+	serviceHandler          *ServiceHandler
+	stageHandler            *StageHandler
+	uniformHandler          *UniformHandler
+	shipyardControlHandler  *ShipyardControllerHandler
+	transportStats          map[string]*transportStatsCollector
+	tokenRefresher          TokenRefresher
+	tokenProvider           TokenProvider
+	refreshingTransport     *refreshingTransport
+	warningHandler          ResponseWarningHandler
+	operationResultHandler  OperationResultHandler
+	policyDecider           policy.Decider
+	acceptLanguage          string
+	redirectPolicy          *redirectPolicy
+	allowedHosts            []string
+	pinnedCertificates      []string
+	spkiPins                []string
+	eventSource             string
+	idempotency             idempotencyOptions
+	pageRetries             pageRetryPolicy
+	schemePolicy            SchemePolicy
+	operationPolicies       map[OperationClass]OperationPolicy
+	readOnly                bool
+	requestJournal          *RequestJournal
+	maxConcurrentRequests   int
+	proxyAuth               *ProxyAuth
+	handlerTransports       map[string]HandlerTransport
+	basePathMode            BasePathMode
+	memoryBudget            int64
+	slowCallThreshold       time.Duration
+	slowCallHandler         SlowCallHandler
+	pathTemplate            *pathTemplate
+	responseValidators      []ResponseValidator
+	errorDetails            *ErrorDetails
 }
 
 // API retrieves the APIHandler
@@ -104,8 +139,12 @@ func (c *APISet) ShipyardControl() ShipyardControlInterface {
 	return c.shipyardControlHandler
 }
 
-// Token retrieves the API token
+// Token retrieves the API token.
+// If a TokenRefresher or TokenProvider is configured, the most recently obtained token is returned
 func (c *APISet) Token() string {
+	if c.refreshingTransport != nil {
+		return c.refreshingTransport.currentToken()
+	}
 	return c.apiToken
 }
 
@@ -114,6 +153,30 @@ func (c *APISet) Endpoint() *url.URL {
 	return c.endpointURL
 }
 
+// Stats returns the transport-level statistics of the requests sent by each handler of the APISet,
+// e.g. the number of new and reused connections. They can be used to diagnose slow connections to the Keptn API
+func (c *APISet) Stats() APISetStats {
+	stats := APISetStats{}
+	for name, collector := range c.transportStats {
+		stats[name] = collector.get()
+	}
+	return stats
+}
+
+// handlerClient returns the http.Client for the handler with the given name.
+// The client shares its transport with all other handlers, but records its own statistics.
+// Requests of handlers with a HandlerTransport are sent via their own transport, see WithHandlerTransport.
+// Slow requests are reported with the name of the handler, see WithSlowCallThreshold
+func (c *APISet) handlerClient(name string) *http.Client {
+	collector := &transportStatsCollector{}
+	c.transportStats[name] = collector
+	client := withTransportStats(c.httpClient, collector)
+	if _, ok := c.handlerTransports[name]; ok || c.slowCallThreshold > 0 {
+		client.Transport = &handlerNameTransport{base: client.Transport, name: name}
+	}
+	return client
+}
+
 // WithAuthToken sets the given auth token.
 // Optionally a custom auth header can be set (default x-token)
 func WithAuthToken(authToken string, authHeader ...string) func(*APISet) {
@@ -134,15 +197,99 @@ func WithHTTPClient(client *http.Client) func(*APISet) {
 	}
 }
 
+// WithTokenRefresher configures a TokenRefresher which is used to obtain a new token
+// when a request is rejected with 401 Unauthorized. The rejected request is retried once using the new token
+// and the new token is used for all subsequent requests. This allows to rotate the token
+// without restarting long-running integrations
+func WithTokenRefresher(refresher TokenRefresher) func(*APISet) {
+	return func(a *APISet) {
+		a.tokenRefresher = refresher
+	}
+}
+
+// WithResponseWarningHandler configures a ResponseWarningHandler which is called whenever the
+// Keptn API responds with Warning, Deprecation or Sunset headers, e.g. to inform about deprecated endpoints.
+// Independent of this option, such warnings are always written to the log
+func WithResponseWarningHandler(handler ResponseWarningHandler) func(*APISet) {
+	return func(a *APISet) {
+		a.warningHandler = handler
+	}
+}
+
+// WithAcceptLanguage sets the Accept-Language header of all requests, e.g. "de-AT, de;q=0.9, en;q=0.5",
+// so that Keptn installations which localize their error messages respond in the preferred language.
+// The language of an error message is available in the ContentLanguage field of the returned models.Error
+func WithAcceptLanguage(acceptLanguage string) func(*APISet) {
+	return func(a *APISet) {
+		a.acceptLanguage = acceptLanguage
+	}
+}
+
+// WithMaxRedirects sets the maximum number of redirects that are followed (default 10).
+// If a redirect points to a host different from the one of the original request, the auth header is removed
+func WithMaxRedirects(maxRedirects int) func(*APISet) {
+	return func(a *APISet) {
+		a.redirectPolicy = &redirectPolicy{maxRedirects: maxRedirects}
+	}
+}
+
+// WithoutRedirects disables following redirects. Requests that are answered with a redirect fail with ErrRedirectsDisabled
+func WithoutRedirects() func(*APISet) {
+	return func(a *APISet) {
+		a.redirectPolicy = &redirectPolicy{disabled: true}
+	}
+}
+
+// WithAllowedHosts restricts the hosts the APISet sends requests to, including requests following a redirect.
+// Requests to other hosts fail with ErrHostNotAllowed. A host may contain a port, in which case only requests
+// to this port are allowed, and may start with "*." to allow all subdomains of a domain.
+// Note that the host of the base URL of the APISet has to be contained in the allowed hosts as well
+func WithAllowedHosts(hosts ...string) func(*APISet) {
+	return func(a *APISet) {
+		a.allowedHosts = append(a.allowedHosts, hosts...)
+	}
+}
+
+// WithPinnedCertificates validates the certificate presented by the Keptn API against the given
+// hex encoded SHA-256 fingerprints of the DER encoded certificates. The connection is refused
+// if the certificate matches none of the fingerprints (or SPKI pins configured via WithSPKIPins).
+// The option requires the http client of the APISet to use a *http.Transport
+func WithPinnedCertificates(fingerprints ...string) func(*APISet) {
+	return func(a *APISet) {
+		a.pinnedCertificates = append(a.pinnedCertificates, fingerprints...)
+	}
+}
+
+// WithSPKIPins validates the public key of the certificate presented by the Keptn API against the given
+// base64 encoded SHA-256 hashes of the Subject Public Key Info, as known from HTTP public key pinning.
+// Unlike certificate fingerprints, SPKI pins stay valid as long as a renewed certificate uses the same key.
+// The option requires the http client of the APISet to use a *http.Transport
+func WithSPKIPins(pins ...string) func(*APISet) {
+	return func(a *APISet) {
+		a.spkiPins = append(a.spkiPins, pins...)
+	}
+}
+
+// WithEventSource sets the source that is set for events sent via the APIHandler that do not specify a source themselves.
+// The source must be a valid URI-reference, e.g. the name of the integration
+func WithEventSource(source string) func(*APISet) {
+	return func(a *APISet) {
+		a.eventSource = source
+	}
+}
+
 // WithScheme sets the scheme
-// If this option is not used, then default scheme "http" is used by the APISet
+// If this option is not used, then the scheme of the base URL is used, or the default scheme of the SchemePolicy of the APISet
+// if the base URL has no scheme, i.e. "http" unless configured otherwise via WithSchemePolicy
 func WithScheme(scheme string) func(*APISet) {
 	return func(a *APISet) {
 		a.scheme = scheme
 	}
 }
 
-// New creates a new APISet instance
+// New creates a new APISet instance.
+// The base paths of the Keptn services are appended to the base URL, extended by the path set via WithPathTemplate,
+// as configured via WithBasePathMode
 func New(baseURL string, options ...func(*APISet)) (*APISet, error) {
 	u, err := url.Parse(baseURL)
 	if err != nil {
@@ -155,27 +302,189 @@ func New(baseURL string, options ...func(*APISet)) (*APISet, error) {
 		}
 	}
 	as.endpointURL = u
+	if as.eventSource != "" {
+		if err := models.ValidateEventSource(as.eventSource); err != nil {
+			return nil, fmt.Errorf("unable to create apiset: %w", err)
+		}
+	}
+	for name := range as.handlerTransports {
+		if !handlerNames[name] {
+			return nil, fmt.Errorf("unable to create apiset: %w: unknown handler %q", ErrInvalidConfiguration, name)
+		}
+	}
+	var pins *certificatePins
+	var pinnedTransport *http.Transport
+	if len(as.pinnedCertificates) > 0 || len(as.spkiPins) > 0 {
+		if pins, err = newCertificatePins(as.pinnedCertificates, as.spkiPins); err != nil {
+			return nil, fmt.Errorf("unable to create apiset: %w", err)
+		}
+		if as.httpClient == nil {
+			as.httpClient = &http.Client{}
+		}
+		if as.httpClient.Transport == nil {
+			as.httpClient.Transport = &http.Transport{}
+		}
+		tr, ok := as.httpClient.Transport.(*http.Transport)
+		if !ok {
+			return nil, fmt.Errorf("unable to create apiset: certificate pinning requires a *http.Transport")
+		}
+		pinnedTransport = tr
+	}
+	var proxy *proxyConfig
+	var proxyTransport *http.Transport
+	if as.proxyAuth != nil {
+		if proxy, err = newProxyConfig(*as.proxyAuth); err != nil {
+			return nil, fmt.Errorf("unable to create apiset: %w", err)
+		}
+		if as.httpClient == nil {
+			as.httpClient = &http.Client{}
+		}
+		if as.httpClient.Transport == nil {
+			as.httpClient.Transport = &http.Transport{}
+		}
+		tr, ok := as.httpClient.Transport.(*http.Transport)
+		if !ok {
+			return nil, fmt.Errorf("unable to create apiset: proxy auth requires a *http.Transport")
+		}
+		proxyTransport = tr
+	}
 	as.httpClient = createInstrumentedClientTransport(as.httpClient)
+	if pinnedTransport != nil {
+		// getClientTransport has replaced the TLS config of the transport
+		pinnedTransport.TLSClientConfig.VerifyPeerCertificate = pins.verifyPeerCertificate
+	}
+	if proxyTransport != nil {
+		// getClientTransport has replaced the proxy of the transport
+		proxy.apply(proxyTransport)
+		if proxy.header != nil {
+			as.httpClient.Transport = newProxyHeaderTransport(as.httpClient.Transport, proxy)
+		}
+	}
+	if len(as.handlerTransports) > 0 {
+		dispatch, err := newHandlerDispatchTransport(as.httpClient.Transport, as.handlerTransports, pins, proxy)
+		if err != nil {
+			return nil, fmt.Errorf("unable to create apiset: %w", err)
+		}
+		as.httpClient.Transport = dispatch
+	}
+	if as.requestJournal != nil {
+		as.httpClient.Transport = newJournalTransport(as.httpClient.Transport, as.requestJournal)
+	}
+	if as.maxConcurrentRequests > 0 {
+		as.httpClient.Transport = newConcurrencyLimitTransport(as.httpClient.Transport, as.maxConcurrentRequests)
+	}
+	if len(as.allowedHosts) > 0 {
+		as.httpClient.Transport = newAllowedHostsTransport(as.httpClient.Transport, as.allowedHosts)
+	}
+	as.httpClient.Transport = newWarningTransport(as.httpClient.Transport, as.warningHandler)
+	if as.acceptLanguage != "" {
+		as.httpClient.Transport = newAcceptLanguageTransport(as.httpClient.Transport, as.acceptLanguage)
+	}
+	if as.slowCallThreshold > 0 {
+		// slow calls are reported below the retries of the operation classes, so that each attempt is reported on its own
+		as.httpClient.Transport = &slowCallTransport{base: as.httpClient.Transport, threshold: as.slowCallThreshold, report: as.slowCallHandler}
+	}
+	if as.operationPolicies != nil {
+		as.httpClient.Transport = newOperationClassTransport(as.httpClient.Transport, as.operationPolicies)
+	}
+	if as.tokenProvider != nil {
+		ctx, cancel := context.WithTimeout(context.Background(), tokenProviderTimeout)
+		token, err := as.tokenProvider.Token(ctx)
+		cancel()
+		if err != nil {
+			return nil, fmt.Errorf("unable to create apiset: could not resolve token: %w", err)
+		}
+		as.apiToken = token
+		as.tokenRefresher = providerRefresher(as.tokenProvider, token)
+	}
+	if as.tokenRefresher != nil {
+		if as.authHeader == "" {
+			as.authHeader = "x-token"
+		}
+		as.refreshingTransport = newRefreshingTransport(as.httpClient.Transport, as.authHeader, as.apiToken, as.tokenRefresher)
+		as.httpClient.Transport = as.refreshingTransport
+	}
+	if len(as.responseValidators) > 0 {
+		// responses are validated after all retries, so that invalid responses are not retried
+		as.httpClient.Transport = newResponseValidationTransport(as.httpClient.Transport, as.responseValidators)
+	}
+	if as.policyDecider != nil {
+		as.httpClient.Transport = newPolicyTransport(as.httpClient.Transport, as.policyDecider)
+	}
+	if as.operationResultHandler != nil {
+		as.httpClient.Transport = newOperationResultTransport(as.httpClient.Transport, as.operationResultHandler)
+	}
+	if as.readOnly {
+		// the read-only check comes first, so that rejected requests are neither retried nor reported
+		as.httpClient.Transport = newReadOnlyTransport(as.httpClient.Transport)
+	}
+	if as.redirectPolicy != nil || as.httpClient.CheckRedirect == nil {
+		// a CheckRedirect function of a custom http client is only replaced if a redirect option is given
+		policy := redirectPolicy{maxRedirects: defaultMaxRedirects}
+		if as.redirectPolicy != nil {
+			policy = *as.redirectPolicy
+		}
+		policy.authHeader = as.authHeader
+		as.httpClient.CheckRedirect = policy.checkRedirect
+	}
+	as.transportStats = map[string]*transportStatsCollector{}
 
-	if as.scheme == "" {
-		if as.endpointURL.Scheme != "" {
-			as.scheme = u.Scheme
-		} else {
-			as.scheme = "http"
-		}
-	}
-
-	as.apiHandler = NewAuthenticatedAPIHandler(baseURL, as.apiToken, as.authHeader, as.httpClient, as.scheme)
-	as.authHandler = NewAuthenticatedAuthHandler(baseURL, as.apiToken, as.authHeader, as.httpClient, as.scheme)
-	as.logHandler = NewAuthenticatedLogHandler(baseURL, as.apiToken, as.authHeader, as.httpClient, as.scheme)
-	as.eventHandler = NewAuthenticatedEventHandler(baseURL, as.apiToken, as.authHeader, as.httpClient, as.scheme)
-	as.projectHandler = NewAuthenticatedProjectHandler(baseURL, as.apiToken, as.authHeader, as.httpClient, as.scheme)
-	as.resourceHandler = NewAuthenticatedResourceHandler(baseURL, as.apiToken, as.authHeader, as.httpClient, as.scheme)
-	as.secretHandler = NewAuthenticatedSecretHandler(baseURL, as.apiToken, as.authHeader, as.httpClient, as.scheme)
-	as.sequenceControlHandler = NewAuthenticatedSequenceControlHandler(baseURL, as.apiToken, as.authHeader, as.httpClient, as.scheme)
-	as.serviceHandler = NewAuthenticatedServiceHandler(baseURL, as.apiToken, as.authHeader, as.httpClient, as.scheme)
-	as.shipyardControlHandler = NewAuthenticatedShipyardControllerHandler(baseURL, as.apiToken, as.authHeader, as.httpClient, as.scheme)
-	as.stageHandler = NewAuthenticatedStageHandler(baseURL, as.apiToken, as.authHeader, as.httpClient, as.scheme)
-	as.uniformHandler = NewAuthenticatedUniformHandler(baseURL, as.apiToken, as.authHeader, as.httpClient, as.scheme)
+	if as.scheme, err = as.schemePolicy.resolveScheme(baseURL, as.scheme); err != nil {
+		return nil, fmt.Errorf("unable to create apiset: %w", err)
+	}
+	if err := validateBaseURL(baseURL, as.scheme); err != nil {
+		return nil, fmt.Errorf("unable to create apiset: %w", err)
+	}
+	if err := as.resolveHandlerSchemes(baseURL); err != nil {
+		return nil, fmt.Errorf("unable to create apiset: %w", err)
+	}
+	if err := validateAuth(as.apiToken, as.authHeader); err != nil {
+		return nil, fmt.Errorf("unable to create apiset: %w", err)
+	}
+	if baseURL, err = as.pathTemplate.apply(baseURL); err != nil {
+		return nil, fmt.Errorf("unable to create apiset: %w", err)
+	}
+
+	as.apiHandler = createAPIHandler(as.basePathMode.HandlerBaseURL(baseURL, HandlerAPI), as.apiToken, as.authHeader, as.handlerClient(HandlerAPI), as.handlerScheme(HandlerAPI))
+	as.apiHandler.eventSource = as.eventSource
+	as.apiHandler.exactBaseURL = as.basePathMode == ExactBaseURL
+	as.apiHandler.idempotency = as.idempotency
+	as.authHandler = createAuthHandler(as.basePathMode.HandlerBaseURL(baseURL, HandlerAuth), as.apiToken, as.authHeader, as.handlerClient(HandlerAuth), as.handlerScheme(HandlerAuth))
+	as.logHandler = createLogHandler(as.basePathMode.HandlerBaseURL(baseURL, HandlerLogs), as.apiToken, as.authHeader, as.handlerClient(HandlerLogs), as.handlerScheme(HandlerLogs))
+	if as.logCompressionThreshold != nil {
+		as.logHandler.compression.threshold = *as.logCompressionThreshold
+	}
+	as.eventHandler = createEventHandler(as.basePathMode.HandlerBaseURL(baseURL, HandlerEvents), as.apiToken, as.authHeader, as.handlerClient(HandlerEvents), as.handlerScheme(HandlerEvents))
+	as.eventHandler.pageRetries = as.pageRetries
+	as.eventHandler.memoryBudget = as.memoryBudget
+	as.projectHandler = createProjectHandler(as.basePathMode.HandlerBaseURL(baseURL, HandlerProjects), as.apiToken, as.authHeader, as.handlerClient(HandlerProjects), as.handlerScheme(HandlerProjects))
+	as.projectHandler.idempotency = as.idempotency
+	as.projectHandler.pageRetries = as.pageRetries
+	as.projectHandler.memoryBudget = as.memoryBudget
+	as.resourceHandler = createResourceHandler(as.basePathMode.HandlerBaseURL(baseURL, HandlerResources), as.apiToken, as.authHeader, as.handlerClient(HandlerResources), as.handlerScheme(HandlerResources))
+	as.resourceHandler.pageRetries = as.pageRetries
+	as.resourceHandler.memoryBudget = as.memoryBudget
+	as.secretHandler = createSecretHandler(as.basePathMode.HandlerBaseURL(baseURL, HandlerSecrets), as.apiToken, as.authHeader, as.handlerClient(HandlerSecrets), as.handlerScheme(HandlerSecrets))
+	as.secretHandler.idempotency = as.idempotency
+	as.sequenceControlHandler = createSequenceControlHandler(as.basePathMode.HandlerBaseURL(baseURL, HandlerSequences), as.apiToken, as.authHeader, as.handlerClient(HandlerSequences), as.handlerScheme(HandlerSequences))
+	as.sequenceControlHandler.pageRetries = as.pageRetries
+	as.serviceHandler = createServiceHandler(as.basePathMode.HandlerBaseURL(baseURL, HandlerServices), as.apiToken, as.authHeader, as.handlerClient(HandlerServices), as.handlerScheme(HandlerServices))
+	as.serviceHandler.idempotency = as.idempotency
+	as.serviceHandler.pageRetries = as.pageRetries
+	as.shipyardControlHandler = createShipyardControllerHandler(as.basePathMode.HandlerBaseURL(baseURL, HandlerShipyardControl), as.apiToken, as.authHeader, as.handlerClient(HandlerShipyardControl), as.handlerScheme(HandlerShipyardControl))
+	as.shipyardControlHandler.pageRetries = as.pageRetries
+	as.stageHandler = createStageHandler(as.basePathMode.HandlerBaseURL(baseURL, HandlerStages), as.apiToken, as.authHeader, as.handlerClient(HandlerStages), as.handlerScheme(HandlerStages))
+	as.stageHandler.idempotency = as.idempotency
+	as.stageHandler.pageRetries = as.pageRetries
+	as.uniformHandler = createUniformHandler(as.basePathMode.HandlerBaseURL(baseURL, HandlerUniform), as.apiToken, as.authHeader, as.handlerClient(HandlerUniform), as.handlerScheme(HandlerUniform))
+	as.uniformHandler.idempotency = as.idempotency
+	for _, setting := range []*errorDetailsSetting{
+		&as.apiHandler.errorDetailsSetting, &as.authHandler.errorDetailsSetting, &as.eventHandler.errorDetailsSetting,
+		&as.logHandler.errorDetailsSetting, &as.projectHandler.errorDetailsSetting, &as.resourceHandler.errorDetailsSetting,
+		&as.secretHandler.errorDetailsSetting, &as.sequenceControlHandler.errorDetailsSetting, &as.serviceHandler.errorDetailsSetting,
+		&as.shipyardControlHandler.errorDetailsSetting, &as.stageHandler.errorDetailsSetting, &as.uniformHandler.errorDetailsSetting,
+	} {
+		setting.errorDetails = as.errorDetails
+	}
 	return as, nil
 }
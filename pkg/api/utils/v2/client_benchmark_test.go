@@ -0,0 +1,94 @@
+package v2
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/keptn/go-utils/pkg/api/models"
+	"github.com/keptn/go-utils/pkg/common/strutils"
+	"github.com/keptn/go-utils/pkg/common/testutils"
+	"github.com/stretchr/testify/require"
+)
+
+func newBenchmarkAPISet(b testing.TB) (*APISet, *testutils.APISimulator) {
+	simulator := testutils.NewAPISimulator()
+	for i := 0; i < 100; i++ {
+		simulator.AddEvents(&models.KeptnContextExtendedCE{
+			ID:             fmt.Sprintf("event-%d", i),
+			Type:           strutils.Stringp("sh.keptn.event.evaluation.finished"),
+			Source:         strutils.Stringp("benchmark"),
+			Shkeptncontext: "my-context",
+			Data:           map[string]interface{}{"project": "my-project", "stage": "my-stage", "service": "my-service"},
+		})
+	}
+	for i := 0; i < 50; i++ {
+		simulator.AddProjects(&models.Project{ProjectName: fmt.Sprintf("project-%d", i), Stages: []*models.Stage{{StageName: "dev"}}})
+	}
+	apiSet, err := New(simulator.URL)
+	require.Nil(b, err)
+	return apiSet, simulator
+}
+
+func BenchmarkGetEvents(b *testing.B) {
+	apiSet, simulator := newBenchmarkAPISet(b)
+	defer simulator.Close()
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := apiSet.Events().GetEvents(context.TODO(), &EventFilter{KeptnContext: "my-context"}, EventsGetEventsOptions{}); err != nil {
+			b.Fatal(err.GetMessage())
+		}
+	}
+}
+
+func BenchmarkSendEvent(b *testing.B) {
+	apiSet, simulator := newBenchmarkAPISet(b)
+	defer simulator.Close()
+
+	event := models.KeptnContextExtendedCE{
+		Type:   strutils.Stringp("sh.keptn.event.evaluation.triggered"),
+		Source: strutils.Stringp("benchmark"),
+		Data:   map[string]interface{}{"project": "my-project", "stage": "my-stage", "service": "my-service"},
+	}
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := apiSet.API().SendEvent(context.TODO(), event, APISendEventOptions{}); err != nil {
+			b.Fatal(err.GetMessage())
+		}
+	}
+}
+
+func BenchmarkGetAllProjects(b *testing.B) {
+	apiSet, simulator := newBenchmarkAPISet(b)
+	defer simulator.Close()
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := apiSet.Projects().GetAllProjects(context.TODO(), ProjectsGetAllProjectsOptions{}); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func TestAPISetUnderLoad(t *testing.T) {
+	apiSet, simulator := newBenchmarkAPISet(t)
+	defer simulator.Close()
+
+	result := testutils.RunLoadTest(context.TODO(), testutils.LoadTestConfig{Requests: 200, Concurrency: 8}, func(ctx context.Context) error {
+		events, err := apiSet.Events().GetEvents(ctx, &EventFilter{KeptnContext: "my-context"}, EventsGetEventsOptions{})
+		if err != nil {
+			return err.ToError()
+		}
+		if len(events) != 100 {
+			return fmt.Errorf("expected 100 events but got %d", len(events))
+		}
+		return nil
+	})
+	t.Log(result)
+	require.Equal(t, 200, result.Requests)
+	require.Equal(t, 0, result.Errors)
+}
@@ -0,0 +1,72 @@
+package v2
+
+import (
+	"io"
+	"net/http"
+	"sync"
+)
+
+// WithMaxConcurrentRequests limits the number of requests which all handlers of the APISet have in flight at the same time,
+// so that bulk operations run by many goroutines can neither exhaust the local ephemeral ports nor overwhelm the API gateway.
+// A request is in flight until its response body has been closed. Further requests wait until a request has finished
+// or their context is done. The number of requests in flight is reported by APISet.Stats
+func WithMaxConcurrentRequests(maxRequests int) func(*APISet) {
+	return func(a *APISet) {
+		a.maxConcurrentRequests = maxRequests
+	}
+}
+
+// concurrencyLimitTransport is a http.RoundTripper which limits the number of requests in flight
+type concurrencyLimitTransport struct {
+	base  http.RoundTripper
+	slots chan struct{}
+}
+
+func newConcurrencyLimitTransport(base http.RoundTripper, maxRequests int) *concurrencyLimitTransport {
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	return &concurrencyLimitTransport{base: base, slots: make(chan struct{}, maxRequests)}
+}
+
+func (t *concurrencyLimitTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	select {
+	case t.slots <- struct{}{}:
+	case <-req.Context().Done():
+		if req.Body != nil {
+			req.Body.Close()
+		}
+		return nil, req.Context().Err()
+	}
+	release := func() { <-t.slots }
+	resp, err := t.base.RoundTrip(req)
+	if err != nil {
+		release()
+		return nil, err
+	}
+	return withReleaseOnClose(resp, release), nil
+}
+
+// withReleaseOnClose makes the response call release once its body is closed.
+// If the response has no body, release is called immediately
+func withReleaseOnClose(resp *http.Response, release func()) *http.Response {
+	if resp.Body == nil {
+		release()
+		return resp
+	}
+	resp.Body = &releaseOnClose{ReadCloser: resp.Body, release: release}
+	return resp
+}
+
+// releaseOnClose calls its release function once the response body is closed for the first time
+type releaseOnClose struct {
+	io.ReadCloser
+	release func()
+	once    sync.Once
+}
+
+func (r *releaseOnClose) Close() error {
+	err := r.ReadCloser.Close()
+	r.once.Do(r.release)
+	return err
+}
@@ -0,0 +1,79 @@
+package v2
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/keptn/go-utils/pkg/api/models"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAPISet_WithMaxConcurrentRequests(t *testing.T) {
+	var inFlight, maxInFlight int32
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		current := atomic.AddInt32(&inFlight, 1)
+		defer atomic.AddInt32(&inFlight, -1)
+		for {
+			max := atomic.LoadInt32(&maxInFlight)
+			if current <= max || atomic.CompareAndSwapInt32(&maxInFlight, max, current) {
+				break
+			}
+		}
+		<-release
+		w.Write([]byte(`{"projectName":"sockshop"}`))
+	}))
+	defer server.Close()
+
+	apiSet, err := New(server.URL, WithMaxConcurrentRequests(2))
+	require.Nil(t, err)
+
+	wg := sync.WaitGroup{}
+	for i := 0; i < 6; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			var mErr *models.Error
+			if i%2 == 0 {
+				_, mErr = apiSet.Projects().GetProject(context.TODO(), models.Project{ProjectName: "sockshop"}, ProjectsGetProjectOptions{})
+			} else {
+				_, mErr = apiSet.API().GetMetadata(context.TODO(), APIGetMetadataOptions{})
+			}
+			require.Nil(t, mErr)
+		}(i)
+	}
+
+	require.Eventually(t, func() bool { return atomic.LoadInt32(&inFlight) == 2 }, time.Second, time.Millisecond)
+	require.Eventually(t, func() bool { return apiSet.Stats().InFlight() == 2 }, time.Second, time.Millisecond)
+	close(release)
+	wg.Wait()
+
+	require.Equal(t, int32(2), atomic.LoadInt32(&maxInFlight))
+	require.Zero(t, apiSet.Stats().InFlight())
+}
+
+func TestAPISet_WithMaxConcurrentRequestsHonorsContext(t *testing.T) {
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-release
+	}))
+	defer server.Close()
+	defer close(release)
+
+	apiSet, err := New(server.URL, WithMaxConcurrentRequests(1))
+	require.Nil(t, err)
+
+	go apiSet.API().GetMetadata(context.TODO(), APIGetMetadataOptions{})
+	require.Eventually(t, func() bool { return apiSet.Stats().InFlight() == 1 }, time.Second, time.Millisecond)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	_, mErr := apiSet.API().GetMetadata(ctx, APIGetMetadataOptions{})
+	require.NotNil(t, mErr)
+	require.Contains(t, mErr.GetMessage(), context.DeadlineExceeded.Error())
+}
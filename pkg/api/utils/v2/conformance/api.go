@@ -0,0 +1,194 @@
+package conformance
+
+import (
+	"context"
+	"encoding/base64"
+	"net/http"
+	"testing"
+
+	"github.com/keptn/go-utils/pkg/api/models"
+	v2 "github.com/keptn/go-utils/pkg/api/utils/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// APIFactory creates the APIInterface under test, which needs to use the Keptn API at the given base URL
+type APIFactory func(t *testing.T, baseURL string) v2.APIInterface
+
+// RunAPIInterfaceTests runs the conformance tests for v2.APIInterface against the implementations
+// created by newAPI. Every test creates a new implementation
+func RunAPIInterfaceTests(t *testing.T, newAPI APIFactory) {
+	setup := func(t *testing.T) (*backend, v2.APIInterface) {
+		b := startBackend(t)
+		return b, newAPI(t, b.URL)
+	}
+
+	t.Run("SendEvent sends the event", func(t *testing.T) {
+		b, api := setup(t)
+		eventContext, mErr := api.SendEvent(context.Background(), newEvent(""), v2.APISendEventOptions{})
+		require.Nil(t, mErr)
+		requireKeptnContext(t, eventContext)
+
+		received := b.receivedEvent(*eventContext.KeptnContext)
+		require.NotNil(t, received)
+		assert.Equal(t, TriggeredEventType, *received.Type)
+		assert.Equal(t, "conformance", *received.Source)
+	})
+
+	t.Run("SendEvent keeps the keptnContext of the event", func(t *testing.T) {
+		b, api := setup(t)
+		eventContext, mErr := api.SendEvent(context.Background(), newEvent("my-context"), v2.APISendEventOptions{})
+		require.Nil(t, mErr)
+		require.NotNil(t, eventContext)
+		assert.Equal(t, "my-context", *eventContext.KeptnContext)
+		assert.NotNil(t, b.receivedEvent("my-context"))
+	})
+
+	t.Run("TriggerEvaluation triggers the evaluation", func(t *testing.T) {
+		_, api := setup(t)
+		evaluation := models.Evaluation{Timeframe: "5m"}
+		eventContext, mErr := api.TriggerEvaluation(context.Background(), projectName(1), SeededStages[0], serviceName(1), evaluation, v2.APITriggerEvaluationOptions{})
+		require.Nil(t, mErr)
+		requireKeptnContext(t, eventContext)
+	})
+
+	t.Run("TriggerEvaluation returns 404 for an unknown service", func(t *testing.T) {
+		_, api := setup(t)
+		eventContext, mErr := api.TriggerEvaluation(context.Background(), projectName(1), SeededStages[0], "unknown", models.Evaluation{}, v2.APITriggerEvaluationOptions{})
+		assert.Nil(t, eventContext)
+		requireAPIError(t, mErr, http.StatusNotFound, "service unknown not found")
+	})
+
+	t.Run("CreateProject creates the project", func(t *testing.T) {
+		b, api := setup(t)
+		_, mErr := api.CreateProject(context.Background(), newCreateProject("new-project"), v2.APICreateProjectOptions{})
+		require.Nil(t, mErr)
+		assert.True(t, b.hasProject("new-project"))
+	})
+
+	t.Run("CreateProject returns 409 for an existing project", func(t *testing.T) {
+		_, api := setup(t)
+		_, mErr := api.CreateProject(context.Background(), newCreateProject(projectName(1)), v2.APICreateProjectOptions{})
+		requireAPIError(t, mErr, http.StatusConflict, "project project-1 already exists")
+	})
+
+	t.Run("UpdateProject updates the project", func(t *testing.T) {
+		_, api := setup(t)
+		_, mErr := api.UpdateProject(context.Background(), newCreateProject(projectName(1)), v2.APIUpdateProjectOptions{})
+		require.Nil(t, mErr)
+	})
+
+	t.Run("UpdateProject returns 404 for an unknown project", func(t *testing.T) {
+		_, api := setup(t)
+		_, mErr := api.UpdateProject(context.Background(), newCreateProject("unknown"), v2.APIUpdateProjectOptions{})
+		requireAPIError(t, mErr, http.StatusNotFound, "project unknown not found")
+	})
+
+	t.Run("DeleteProject deletes the project", func(t *testing.T) {
+		b, api := setup(t)
+		response, mErr := api.DeleteProject(context.Background(), models.Project{ProjectName: projectName(1)}, v2.APIDeleteProjectOptions{})
+		require.Nil(t, mErr)
+		require.NotNil(t, response)
+		assert.False(t, response.AlreadyDeleted)
+		assert.False(t, b.hasProject(projectName(1)))
+	})
+
+	t.Run("DeleteProject returns 404 for an unknown project", func(t *testing.T) {
+		_, api := setup(t)
+		response, mErr := api.DeleteProject(context.Background(), models.Project{ProjectName: "unknown"}, v2.APIDeleteProjectOptions{})
+		assert.Nil(t, response)
+		requireAPIError(t, mErr, http.StatusNotFound, "project unknown not found")
+	})
+
+	t.Run("CreateService creates the service in all stages", func(t *testing.T) {
+		b, api := setup(t)
+		serviceName := "new-service"
+		_, mErr := api.CreateService(context.Background(), projectName(1), models.CreateService{ServiceName: &serviceName}, v2.APICreateServiceOptions{})
+		require.Nil(t, mErr)
+		for _, stage := range SeededStages {
+			assert.True(t, b.hasService(projectName(1), stage, serviceName), "service is missing in stage %s", stage)
+		}
+	})
+
+	t.Run("CreateService returns 409 for an existing service", func(t *testing.T) {
+		_, api := setup(t)
+		existing := serviceName(1)
+		_, mErr := api.CreateService(context.Background(), projectName(1), models.CreateService{ServiceName: &existing}, v2.APICreateServiceOptions{})
+		requireAPIError(t, mErr, http.StatusConflict, "service service-1 already exists")
+	})
+
+	t.Run("DeleteService deletes the service from all stages", func(t *testing.T) {
+		b, api := setup(t)
+		response, mErr := api.DeleteService(context.Background(), projectName(1), serviceName(1), v2.APIDeleteServiceOptions{})
+		require.Nil(t, mErr)
+		require.NotNil(t, response)
+		for _, stage := range SeededStages {
+			assert.False(t, b.hasService(projectName(1), stage, serviceName(1)), "service is still contained in stage %s", stage)
+		}
+	})
+
+	t.Run("DeleteService returns 404 for an unknown service", func(t *testing.T) {
+		_, api := setup(t)
+		response, mErr := api.DeleteService(context.Background(), projectName(1), "unknown", v2.APIDeleteServiceOptions{})
+		assert.Nil(t, response)
+		requireAPIError(t, mErr, http.StatusNotFound, "service unknown not found")
+	})
+
+	t.Run("GetMetadata returns the metadata", func(t *testing.T) {
+		_, api := setup(t)
+		metadata, mErr := api.GetMetadata(context.Background(), v2.APIGetMetadataOptions{})
+		require.Nil(t, mErr)
+		require.NotNil(t, metadata)
+		assert.Equal(t, KeptnVersion, metadata.Keptnversion)
+	})
+
+	t.Run("errors of the API are returned", func(t *testing.T) {
+		b, api := setup(t)
+		b.setFailing(true)
+
+		_, mErr := api.SendEvent(context.Background(), newEvent(""), v2.APISendEventOptions{})
+		requireAPIError(t, mErr, http.StatusInternalServerError, "internal server error")
+
+		_, mErr = api.CreateProject(context.Background(), newCreateProject("new-project"), v2.APICreateProjectOptions{})
+		requireAPIError(t, mErr, http.StatusInternalServerError, "internal server error")
+
+		_, mErr = api.DeleteService(context.Background(), projectName(1), serviceName(1), v2.APIDeleteServiceOptions{})
+		requireAPIError(t, mErr, http.StatusInternalServerError, "internal server error")
+
+		_, mErr = api.GetMetadata(context.Background(), v2.APIGetMetadataOptions{})
+		requireAPIError(t, mErr, http.StatusInternalServerError, "internal server error")
+	})
+
+	t.Run("SendEvent respects the context", func(t *testing.T) {
+		b, api := setup(t)
+		testContextCancellation(t, b, func(ctx context.Context) error {
+			_, mErr := api.SendEvent(ctx, newEvent(""), v2.APISendEventOptions{})
+			return toError(mErr)
+		})
+	})
+
+	t.Run("GetMetadata respects the context", func(t *testing.T) {
+		b, api := setup(t)
+		testContextCancellation(t, b, func(ctx context.Context) error {
+			_, mErr := api.GetMetadata(ctx, v2.APIGetMetadataOptions{})
+			return toError(mErr)
+		})
+	})
+}
+
+// newEvent returns an event of type TriggeredEventType for the seeded service service-1 of the project project-1
+func newEvent(keptnContext string) models.KeptnContextExtendedCE {
+	eventType := TriggeredEventType
+	source := "conformance"
+	return models.KeptnContextExtendedCE{
+		Type:           &eventType,
+		Source:         &source,
+		Shkeptncontext: keptnContext,
+		Data:           map[string]interface{}{"project": projectName(1), "stage": SeededStages[0], "service": serviceName(1)},
+	}
+}
+
+func newCreateProject(name string) models.CreateProject {
+	shipyard := base64.StdEncoding.EncodeToString([]byte("apiVersion: spec.keptn.sh/0.2.3\nkind: Shipyard"))
+	return models.CreateProject{Name: &name, Shipyard: &shipyard}
+}
@@ -0,0 +1,46 @@
+package conformance
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	v2 "github.com/keptn/go-utils/pkg/api/utils/v2"
+	"github.com/stretchr/testify/require"
+)
+
+// AuthFactory creates the AuthInterface under test, which needs to use the Keptn API at the given base URL
+type AuthFactory func(t *testing.T, baseURL string) v2.AuthInterface
+
+// RunAuthInterfaceTests runs the conformance tests for v2.AuthInterface against the implementations
+// created by newAuth. Every test creates a new implementation
+func RunAuthInterfaceTests(t *testing.T, newAuth AuthFactory) {
+	setup := func(t *testing.T) (*backend, v2.AuthInterface) {
+		b := startBackend(t)
+		return b, newAuth(t, b.URL)
+	}
+
+	t.Run("Authenticate authenticates at the API", func(t *testing.T) {
+		_, auth := setup(t)
+		eventContext, mErr := auth.Authenticate(context.Background(), v2.AuthAuthenticateOptions{})
+		require.Nil(t, mErr)
+		requireKeptnContext(t, eventContext)
+	})
+
+	t.Run("errors of the API are returned", func(t *testing.T) {
+		b, auth := setup(t)
+		b.setFailing(true)
+
+		eventContext, mErr := auth.Authenticate(context.Background(), v2.AuthAuthenticateOptions{})
+		require.Nil(t, eventContext)
+		requireAPIError(t, mErr, http.StatusInternalServerError, "internal server error")
+	})
+
+	t.Run("Authenticate respects the context", func(t *testing.T) {
+		b, auth := setup(t)
+		testContextCancellation(t, b, func(ctx context.Context) error {
+			_, mErr := auth.Authenticate(ctx, v2.AuthAuthenticateOptions{})
+			return toError(mErr)
+		})
+	})
+}
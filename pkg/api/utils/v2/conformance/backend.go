@@ -0,0 +1,1080 @@
+package conformance
+
+import (
+	"compress/gzip"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/keptn/go-utils/pkg/api/models"
+	v2 "github.com/keptn/go-utils/pkg/api/utils/v2"
+)
+
+// PageSize is the number of items per page returned by the backend of the conformance tests,
+// unless a different page size is requested. It is small, so that all lists span several pages
+const PageSize = 2
+
+const (
+	// SeededProjects is the number of projects the backend of the conformance tests is seeded with,
+	// named project-1 to project-<SeededProjects>
+	SeededProjects = 5
+	// SeededServices is the number of services in each stage of the seeded projects, named service-1 to service-<SeededServices>
+	SeededServices = 3
+	// SeededEvents is the number of events of type TriggeredEventType in the project project-1 the backend is seeded with.
+	// Every second event belongs to the keptnContext context-1, the others to context-2
+	SeededEvents = 5
+	// TriggeredEventType is the type of the seeded events
+	TriggeredEventType = "sh.keptn.event.evaluation.triggered"
+	// SeededSequences is the number of sequences in the stage dev of the project project-1 the backend is seeded with,
+	// named delivery and with the keptnContexts context-1 to context-<SeededSequences>.
+	// The sequences with an even number have finished, the others have been started
+	SeededSequences = 3
+	// KeptnVersion is the version of Keptn contained in the metadata returned by the backend
+	KeptnVersion = "0.19.0"
+)
+
+// SeededStages are the stages of each seeded project
+var SeededStages = []string{"dev", "staging", "production"}
+
+// backend simulates the endpoints of the Keptn API used by the handlers, with the documented pagination and error responses
+type backend struct {
+	*httptest.Server
+	mtx      sync.Mutex
+	projects []*models.Project
+	events   []*models.KeptnContextExtendedCE
+	// resources maps the names of a project, stage or service, joined by slashes, to the resources of the entity
+	resources    map[string][]*models.Resource
+	commits      int
+	sequences    []models.SequenceState
+	secrets      []models.Secret
+	integrations []*models.Integration
+	logs         []models.LogEntry
+	// failing makes the backend answer all requests with 500 Internal Server Error
+	failing bool
+	// blocking makes the backend answer requests only after the request has been cancelled
+	blocking bool
+	// closed releases blocked requests when the backend is closed
+	closed chan struct{}
+}
+
+func newBackend() *backend {
+	b := &backend{closed: make(chan struct{}), resources: map[string][]*models.Resource{}}
+	for i := 1; i <= SeededProjects; i++ {
+		project := &models.Project{ProjectName: projectName(i), ShipyardVersion: "spec.keptn.sh/0.2.3"}
+		for _, stageName := range SeededStages {
+			stage := &models.Stage{StageName: stageName}
+			for j := 1; j <= SeededServices; j++ {
+				stage.Services = append(stage.Services, &models.Service{ServiceName: serviceName(j)})
+			}
+			project.Stages = append(project.Stages, stage)
+		}
+		b.projects = append(b.projects, project)
+	}
+	for i := 1; i <= SeededEvents; i++ {
+		eventType := TriggeredEventType
+		b.events = append(b.events, &models.KeptnContextExtendedCE{
+			ID:             fmt.Sprintf("event-%d", i),
+			Type:           &eventType,
+			Shkeptncontext: fmt.Sprintf("context-%d", 2-i%2),
+			Data:           map[string]interface{}{"project": "project-1", "stage": "dev", "service": "service-1"},
+		})
+	}
+	for i := 1; i <= SeededSequences; i++ {
+		state := models.SequenceStartedState
+		if i%2 == 0 {
+			state = models.SequenceFinished
+		}
+		b.sequences = append(b.sequences, models.SequenceState{
+			Name:           "delivery",
+			Service:        serviceName(1),
+			Project:        projectName(1),
+			Shkeptncontext: fmt.Sprintf("context-%d", i),
+			State:          state,
+			Stages:         []models.SequenceStateStage{{Name: "dev", State: state}},
+		})
+	}
+	b.Server = httptest.NewServer(http.HandlerFunc(b.handle))
+	return b
+}
+
+// Close releases blocked requests and shuts down the backend
+func (b *backend) Close() {
+	close(b.closed)
+	b.Server.Close()
+}
+
+func (b *backend) setFailing(failing bool) {
+	b.mtx.Lock()
+	defer b.mtx.Unlock()
+	b.failing = failing
+}
+
+func (b *backend) setBlocking(blocking bool) {
+	b.mtx.Lock()
+	defer b.mtx.Unlock()
+	b.blocking = blocking
+}
+
+// hasProject returns whether the backend contains the project, for the tests of the interfaces which cannot read projects
+func (b *backend) hasProject(projectName string) bool {
+	b.mtx.Lock()
+	defer b.mtx.Unlock()
+	return b.project(projectName) != nil
+}
+
+// hasService returns whether the backend contains the service in the stage of the project
+func (b *backend) hasService(projectName string, stageName string, serviceName string) bool {
+	b.mtx.Lock()
+	defer b.mtx.Unlock()
+	return b.service(projectName, stageName, serviceName) != nil
+}
+
+// receivedEvent returns the last event of the keptnContext the backend has received, or nil
+func (b *backend) receivedEvent(keptnContext string) *models.KeptnContextExtendedCE {
+	b.mtx.Lock()
+	defer b.mtx.Unlock()
+	for i := len(b.events) - 1; i >= 0; i-- {
+		if b.events[i].Shkeptncontext == keptnContext {
+			return b.events[i]
+		}
+	}
+	return nil
+}
+
+func (b *backend) handle(w http.ResponseWriter, r *http.Request) {
+	b.mtx.Lock()
+	failing, blocking := b.failing, b.blocking
+	b.mtx.Unlock()
+
+	if blocking {
+		// the cancellation of a request is only noticed by the server after the body has been read
+		_, _ = io.Copy(ioutil.Discard, r.Body)
+		select {
+		case <-r.Context().Done():
+		case <-b.closed:
+		}
+		return
+	}
+	if failing {
+		writeError(w, http.StatusInternalServerError, "internal server error")
+		return
+	}
+
+	if strings.HasSuffix(r.URL.Path, "/event") && r.Method == http.MethodGet {
+		b.handleGetEvents(w, r)
+		return
+	}
+	// the escaped path is split, so that escaped slashes, e.g. of resource URIs, stay within their segment
+	path := r.URL.EscapedPath()
+	index := strings.Index(path, "/v1/")
+	if index < 0 {
+		writeError(w, http.StatusNotFound, "unknown path "+r.URL.Path)
+		return
+	}
+	// the segments after /v1, e.g. [project my-project stage dev service]
+	segments := strings.Split(strings.Trim(path[index+len("/v1/"):], "/"), "/")
+	for i, segment := range segments {
+		unescaped, err := url.PathUnescape(segment)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, "invalid path "+path)
+			return
+		}
+		segments[i] = unescaped
+	}
+
+	b.mtx.Lock()
+	defer b.mtx.Unlock()
+	switch {
+	case segments[0] == "project":
+		b.routeProject(w, r, segments[1:])
+	case segments[0] == "event" && len(segments) == 1:
+		b.handleSendEvent(w, r)
+	case segments[0] == "event" && len(segments) == 3 && segments[1] == "triggered":
+		b.handleOpenTriggeredEvents(w, r, segments[2])
+	case segments[0] == "sequence" && len(segments) == 2:
+		b.handleSequenceStates(w, r, segments[1])
+	case segments[0] == "sequence" && len(segments) == 4 && segments[3] == "control":
+		b.handleSequenceControl(w, r, segments[1], segments[2])
+	case segments[0] == "secret" && len(segments) == 1:
+		b.handleSecrets(w, r)
+	case segments[0] == "uniform" && len(segments) >= 2 && segments[1] == "registration":
+		b.routeUniform(w, r, segments[2:])
+	case segments[0] == "log" && len(segments) == 1:
+		b.handleLogs(w, r)
+	case segments[0] == "metadata" && len(segments) == 1 && r.Method == http.MethodGet:
+		automaticProvisioning := false
+		writeResponse(w, http.StatusOK, &models.Metadata{Keptnversion: KeptnVersion, Namespace: "keptn", Automaticprovisioning: &automaticProvisioning})
+	case segments[0] == "auth" && len(segments) == 1 && r.Method == http.MethodPost:
+		writeEventContext(w)
+	default:
+		writeError(w, http.StatusNotFound, "unknown path "+r.URL.Path)
+	}
+}
+
+// routeProject routes the requests to the given segments after /v1/project
+func (b *backend) routeProject(w http.ResponseWriter, r *http.Request, segments []string) {
+	switch {
+	case len(segments) == 0:
+		b.handleProjects(w, r)
+	case len(segments) >= 2 && segments[1] == "resource":
+		b.handleResources(w, r, segments[:1], segments[2:])
+	case len(segments) >= 4 && segments[1] == "stage" && segments[3] == "resource":
+		b.handleResources(w, r, []string{segments[0], segments[2]}, segments[4:])
+	case len(segments) >= 6 && segments[1] == "stage" && segments[3] == "service" && segments[5] == "resource":
+		b.handleResources(w, r, []string{segments[0], segments[2], segments[4]}, segments[6:])
+	case len(segments) == 1:
+		b.handleProject(w, r, segments[0])
+	case len(segments) == 2 && segments[1] == "stage":
+		b.handleStages(w, r, segments[0])
+	case len(segments) == 2 && segments[1] == "service":
+		b.handleProjectServices(w, r, segments[0])
+	case len(segments) == 3 && segments[1] == "service":
+		b.handleProjectService(w, r, segments[0], segments[2])
+	case len(segments) == 4 && segments[1] == "stage" && segments[3] == "service":
+		b.handleServices(w, r, segments[0], segments[2])
+	case len(segments) == 5 && segments[1] == "stage" && segments[3] == "service":
+		b.handleService(w, r, segments[0], segments[2], segments[4])
+	case len(segments) == 6 && segments[1] == "stage" && segments[3] == "service" && segments[5] == "evaluation":
+		b.handleEvaluation(w, r, segments[0], segments[2], segments[4])
+	default:
+		writeError(w, http.StatusNotFound, "unknown path "+r.URL.Path)
+	}
+}
+
+func (b *backend) handleProjects(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case http.MethodGet:
+		page, nextPageKey := paginate(len(b.projects), r)
+		writeResponse(w, http.StatusOK, &models.Projects{
+			Projects:    b.projects[page.start:page.end],
+			NextPageKey: nextPageKey,
+			PageSize:    float64(page.end - page.start),
+			TotalCount:  float64(len(b.projects)),
+		})
+	case http.MethodPost:
+		project := &projectRequest{}
+		if !decodeJSON(w, r, project) {
+			return
+		}
+		if b.project(project.name()) != nil {
+			writeError(w, http.StatusConflict, fmt.Sprintf("project %s already exists", project.name()))
+			return
+		}
+		b.projects = append(b.projects, &models.Project{ProjectName: project.name(), ShipyardVersion: project.ShipyardVersion})
+		writeEventContext(w)
+	case http.MethodPut:
+		project := &projectRequest{}
+		if !decodeJSON(w, r, project) {
+			return
+		}
+		if b.project(project.name()) == nil {
+			writeError(w, http.StatusNotFound, fmt.Sprintf("project %s not found", project.name()))
+			return
+		}
+		writeEventContext(w)
+	default:
+		writeError(w, http.StatusMethodNotAllowed, "method not allowed")
+	}
+}
+
+func (b *backend) handleProject(w http.ResponseWriter, r *http.Request, projectName string) {
+	project := b.project(projectName)
+	if project == nil {
+		writeError(w, http.StatusNotFound, fmt.Sprintf("project %s not found", projectName))
+		return
+	}
+	switch r.Method {
+	case http.MethodGet:
+		writeResponse(w, http.StatusOK, project)
+	case http.MethodPut:
+		writeEventContext(w)
+	case http.MethodDelete:
+		projects := []*models.Project{}
+		for _, p := range b.projects {
+			if p.ProjectName != projectName {
+				projects = append(projects, p)
+			}
+		}
+		b.projects = projects
+		writeEventContext(w)
+	default:
+		writeError(w, http.StatusMethodNotAllowed, "method not allowed")
+	}
+}
+
+func (b *backend) handleStages(w http.ResponseWriter, r *http.Request, projectName string) {
+	project := b.project(projectName)
+	if project == nil {
+		writeError(w, http.StatusNotFound, fmt.Sprintf("project %s not found", projectName))
+		return
+	}
+	switch r.Method {
+	case http.MethodGet:
+		page, nextPageKey := paginate(len(project.Stages), r)
+		writeResponse(w, http.StatusOK, &models.Stages{
+			Stages:      project.Stages[page.start:page.end],
+			NextPageKey: nextPageKey,
+			PageSize:    float64(page.end - page.start),
+			TotalCount:  float64(len(project.Stages)),
+		})
+	case http.MethodPost:
+		stage := &models.Stage{}
+		if !decodeBody(w, r, stage) {
+			return
+		}
+		if b.stage(projectName, stage.StageName) != nil {
+			writeError(w, http.StatusConflict, fmt.Sprintf("stage %s already exists", stage.StageName))
+			return
+		}
+		project.Stages = append(project.Stages, &models.Stage{StageName: stage.StageName})
+		writeEventContext(w)
+	default:
+		writeError(w, http.StatusMethodNotAllowed, "method not allowed")
+	}
+}
+
+func (b *backend) handleServices(w http.ResponseWriter, r *http.Request, projectName string, stageName string) {
+	stage := b.stage(projectName, stageName)
+	if stage == nil {
+		writeError(w, http.StatusNotFound, fmt.Sprintf("stage %s not found", stageName))
+		return
+	}
+	switch r.Method {
+	case http.MethodGet:
+		page, nextPageKey := paginate(len(stage.Services), r)
+		writeResponse(w, http.StatusOK, &models.Services{
+			Services:    stage.Services[page.start:page.end],
+			NextPageKey: nextPageKey,
+			PageSize:    float64(page.end - page.start),
+			TotalCount:  float64(len(stage.Services)),
+		})
+	case http.MethodPost:
+		service := &models.Service{}
+		if !decodeBody(w, r, service) {
+			return
+		}
+		if b.service(projectName, stageName, service.ServiceName) != nil {
+			writeError(w, http.StatusConflict, fmt.Sprintf("service %s already exists", service.ServiceName))
+			return
+		}
+		stage.Services = append(stage.Services, &models.Service{ServiceName: service.ServiceName})
+		writeEventContext(w)
+	default:
+		writeError(w, http.StatusMethodNotAllowed, "method not allowed")
+	}
+}
+
+func (b *backend) handleService(w http.ResponseWriter, r *http.Request, projectName string, stageName string, serviceName string) {
+	service := b.service(projectName, stageName, serviceName)
+	if service == nil {
+		writeError(w, http.StatusNotFound, fmt.Sprintf("service %s not found", serviceName))
+		return
+	}
+	switch r.Method {
+	case http.MethodGet:
+		writeResponse(w, http.StatusOK, service)
+	case http.MethodDelete:
+		stage := b.stage(projectName, stageName)
+		services := []*models.Service{}
+		for _, s := range stage.Services {
+			if s.ServiceName != serviceName {
+				services = append(services, s)
+			}
+		}
+		stage.Services = services
+		writeEventContext(w)
+	default:
+		writeError(w, http.StatusMethodNotAllowed, "method not allowed")
+	}
+}
+
+func (b *backend) handleGetEvents(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	b.mtx.Lock()
+	defer b.mtx.Unlock()
+	matching := []*models.KeptnContextExtendedCE{}
+	for _, e := range b.events {
+		if project := query.Get("project"); project != "" && eventDataField(e, "project") != project {
+			continue
+		}
+		if t := query.Get("type"); t != "" && (e.Type == nil || *e.Type != t) {
+			continue
+		}
+		if c := query.Get("keptnContext"); c != "" && e.Shkeptncontext != c {
+			continue
+		}
+		if id := query.Get("eventID"); id != "" && e.ID != id {
+			continue
+		}
+		matching = append(matching, e)
+	}
+
+	page, nextPageKey := paginate(len(matching), r)
+	writeResponse(w, http.StatusOK, &models.Events{
+		Events:      matching[page.start:page.end],
+		NextPageKey: nextPageKey,
+		PageSize:    float64(page.end - page.start),
+		TotalCount:  float64(len(matching)),
+	})
+}
+
+func (b *backend) handleProjectServices(w http.ResponseWriter, r *http.Request, projectName string) {
+	project := b.project(projectName)
+	if project == nil {
+		writeError(w, http.StatusNotFound, fmt.Sprintf("project %s not found", projectName))
+		return
+	}
+	if r.Method != http.MethodPost {
+		writeError(w, http.StatusMethodNotAllowed, "method not allowed")
+		return
+	}
+	service := &models.CreateService{}
+	if !decodeBody(w, r, service) {
+		return
+	}
+	if service.ServiceName == nil || *service.ServiceName == "" {
+		writeError(w, http.StatusBadRequest, "service name must be specified")
+		return
+	}
+	for _, stage := range project.Stages {
+		if b.service(projectName, stage.StageName, *service.ServiceName) != nil {
+			writeError(w, http.StatusConflict, fmt.Sprintf("service %s already exists", *service.ServiceName))
+			return
+		}
+	}
+	for _, stage := range project.Stages {
+		stage.Services = append(stage.Services, &models.Service{ServiceName: *service.ServiceName})
+	}
+	writeEventContext(w)
+}
+
+func (b *backend) handleProjectService(w http.ResponseWriter, r *http.Request, projectName string, serviceName string) {
+	project := b.project(projectName)
+	if project == nil {
+		writeError(w, http.StatusNotFound, fmt.Sprintf("project %s not found", projectName))
+		return
+	}
+	if r.Method != http.MethodDelete {
+		writeError(w, http.StatusMethodNotAllowed, "method not allowed")
+		return
+	}
+	found := false
+	for _, stage := range project.Stages {
+		services := []*models.Service{}
+		for _, s := range stage.Services {
+			if s.ServiceName == serviceName {
+				found = true
+			} else {
+				services = append(services, s)
+			}
+		}
+		stage.Services = services
+	}
+	if !found {
+		writeError(w, http.StatusNotFound, fmt.Sprintf("service %s not found", serviceName))
+		return
+	}
+	writeResponse(w, http.StatusOK, &models.DeleteServiceResponse{Message: fmt.Sprintf("service %s deleted", serviceName)})
+}
+
+func (b *backend) handleEvaluation(w http.ResponseWriter, r *http.Request, projectName string, stageName string, serviceName string) {
+	if b.service(projectName, stageName, serviceName) == nil {
+		writeError(w, http.StatusNotFound, fmt.Sprintf("service %s not found", serviceName))
+		return
+	}
+	if r.Method != http.MethodPost {
+		writeError(w, http.StatusMethodNotAllowed, "method not allowed")
+		return
+	}
+	if !decodeBody(w, r, &models.Evaluation{}) {
+		return
+	}
+	writeEventContext(w)
+}
+
+// handleResources handles the requests to the resources of the entity with the given names, i.e. of a project,
+// a stage or a service. The optional resourceURI addresses a single resource
+func (b *backend) handleResources(w http.ResponseWriter, r *http.Request, entity []string, resourceURI []string) {
+	switch {
+	case len(entity) == 1 && b.project(entity[0]) == nil:
+		writeError(w, http.StatusNotFound, fmt.Sprintf("project %s not found", entity[0]))
+		return
+	case len(entity) == 2 && b.stage(entity[0], entity[1]) == nil:
+		writeError(w, http.StatusNotFound, fmt.Sprintf("stage %s not found", entity[1]))
+		return
+	case len(entity) == 3 && b.service(entity[0], entity[1], entity[2]) == nil:
+		writeError(w, http.StatusNotFound, fmt.Sprintf("service %s not found", entity[2]))
+		return
+	}
+	key := strings.Join(entity, "/")
+
+	if len(resourceURI) == 1 {
+		b.handleResource(w, r, key, resourceURI[0])
+		return
+	}
+	if len(resourceURI) > 1 {
+		writeError(w, http.StatusNotFound, "unknown path "+r.URL.Path)
+		return
+	}
+	switch r.Method {
+	case http.MethodGet:
+		resources := b.resources[key]
+		page, nextPageKey := paginate(len(resources), r)
+		writeResponse(w, http.StatusOK, &models.Resources{
+			Resources:   resources[page.start:page.end],
+			NextPageKey: nextPageKey,
+			PageSize:    float64(page.end - page.start),
+			TotalCount:  float64(len(resources)),
+		})
+	case http.MethodPost, http.MethodPut:
+		received := &models.Resources{}
+		if !decodeBody(w, r, received) {
+			return
+		}
+		for _, resource := range received.Resources {
+			if resource.ResourceURI == nil {
+				writeError(w, http.StatusBadRequest, "resource URI must be specified")
+				return
+			}
+			if r.Method == http.MethodPost && b.resourceIndex(key, *resource.ResourceURI) >= 0 {
+				writeError(w, http.StatusConflict, fmt.Sprintf("resource %s already exists", *resource.ResourceURI))
+				return
+			}
+		}
+		for _, resource := range received.Resources {
+			b.putResource(key, resource)
+		}
+		b.writeVersion(w)
+	default:
+		writeError(w, http.StatusMethodNotAllowed, "method not allowed")
+	}
+}
+
+func (b *backend) handleResource(w http.ResponseWriter, r *http.Request, key string, resourceURI string) {
+	index := b.resourceIndex(key, resourceURI)
+	if index < 0 && r.Method != http.MethodPut {
+		writeError(w, http.StatusNotFound, fmt.Sprintf("resource %s not found", resourceURI))
+		return
+	}
+	switch r.Method {
+	case http.MethodGet:
+		writeResponse(w, http.StatusOK, b.resources[key][index])
+	case http.MethodPut:
+		resource := &models.Resource{}
+		if !decodeBody(w, r, resource) {
+			return
+		}
+		resource.ResourceURI = &resourceURI
+		b.putResource(key, resource)
+		b.writeVersion(w)
+	case http.MethodDelete:
+		resources := b.resources[key]
+		b.resources[key] = append(resources[:index:index], resources[index+1:]...)
+		b.writeVersion(w)
+	default:
+		writeError(w, http.StatusMethodNotAllowed, "method not allowed")
+	}
+}
+
+func (b *backend) handleSendEvent(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		writeError(w, http.StatusMethodNotAllowed, "method not allowed")
+		return
+	}
+	event := &models.KeptnContextExtendedCE{}
+	if !decodeBody(w, r, event) {
+		return
+	}
+	if event.Type == nil || *event.Type == "" {
+		writeError(w, http.StatusBadRequest, "type must be specified")
+		return
+	}
+	if event.Shkeptncontext == "" {
+		event.Shkeptncontext = uuid.New().String()
+	}
+	if event.ID == "" {
+		event.ID = uuid.New().String()
+	}
+	b.events = append(b.events, event)
+	writeKeptnContext(w, event.Shkeptncontext)
+}
+
+// handleOpenTriggeredEvents returns the events of the given type, since the backend does not track whether
+// the triggered events have been answered
+func (b *backend) handleOpenTriggeredEvents(w http.ResponseWriter, r *http.Request, eventType string) {
+	if r.Method != http.MethodGet {
+		writeError(w, http.StatusMethodNotAllowed, "method not allowed")
+		return
+	}
+	query := r.URL.Query()
+	matching := []*models.KeptnContextExtendedCE{}
+	for _, e := range b.events {
+		if e.Type == nil || *e.Type != eventType {
+			continue
+		}
+		if id := query.Get("eventID"); id != "" && e.ID != id {
+			continue
+		}
+		matches := true
+		for _, field := range []string{"project", "stage", "service"} {
+			if value := query.Get(field); value != "" && eventDataField(e, field) != value {
+				matches = false
+			}
+		}
+		if matches {
+			matching = append(matching, e)
+		}
+	}
+
+	page, nextPageKey := paginate(len(matching), r)
+	writeResponse(w, http.StatusOK, &models.Events{
+		Events:      matching[page.start:page.end],
+		NextPageKey: nextPageKey,
+		PageSize:    float64(page.end - page.start),
+		TotalCount:  float64(len(matching)),
+	})
+}
+
+func (b *backend) handleSequenceStates(w http.ResponseWriter, r *http.Request, projectName string) {
+	if b.project(projectName) == nil {
+		writeError(w, http.StatusNotFound, fmt.Sprintf("project %s not found", projectName))
+		return
+	}
+	if r.Method != http.MethodGet {
+		writeError(w, http.StatusMethodNotAllowed, "method not allowed")
+		return
+	}
+	query := r.URL.Query()
+	matching := []models.SequenceState{}
+	for _, s := range b.sequences {
+		if s.Project != projectName {
+			continue
+		}
+		if name := query.Get("name"); name != "" && s.Name != name {
+			continue
+		}
+		if state := query.Get("state"); state != "" && s.State != state {
+			continue
+		}
+		if keptnContext := query.Get("keptnContext"); keptnContext != "" && s.Shkeptncontext != keptnContext {
+			continue
+		}
+		matching = append(matching, s)
+	}
+
+	page, nextPageKey := paginate(len(matching), r)
+	writeJSON(w, http.StatusOK, &models.SequenceStates{
+		States:      matching[page.start:page.end],
+		NextPageKey: pageOffset(nextPageKey),
+		PageSize:    int64(page.end - page.start),
+		TotalCount:  int64(len(matching)),
+	})
+}
+
+func (b *backend) handleSequenceControl(w http.ResponseWriter, r *http.Request, projectName string, keptnContext string) {
+	if r.Method != http.MethodPost {
+		writeError(w, http.StatusMethodNotAllowed, "method not allowed")
+		return
+	}
+	index := -1
+	for i, s := range b.sequences {
+		if s.Project == projectName && s.Shkeptncontext == keptnContext {
+			index = i
+		}
+	}
+	if index < 0 {
+		writeError(w, http.StatusNotFound, fmt.Sprintf("sequence %s not found", keptnContext))
+		return
+	}
+	control := &v2.SequenceControlBody{}
+	if !decodeBody(w, r, control) {
+		return
+	}
+	var state string
+	switch control.ControlState() {
+	case models.PauseSequence:
+		state = models.SequencePaused
+	case models.ResumeSequence:
+		state = models.SequenceStartedState
+	case models.AbortSequence:
+		state = models.SequenceAborted
+	default:
+		writeError(w, http.StatusBadRequest, fmt.Sprintf("invalid state %s", control.State))
+		return
+	}
+	b.sequences[index].State = state
+	writeJSON(w, http.StatusOK, map[string]interface{}{})
+}
+
+func (b *backend) handleSecrets(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case http.MethodGet:
+		response := &models.GetSecretsResponse{Secrets: []models.GetSecretResponseItem{}}
+		for _, secret := range b.secrets {
+			keys := []string{}
+			for key := range secret.Data {
+				keys = append(keys, key)
+			}
+			sort.Strings(keys)
+			response.Secrets = append(response.Secrets, models.GetSecretResponseItem{SecretMetadata: secret.SecretMetadata, Keys: keys})
+		}
+		writeResponse(w, http.StatusOK, response)
+	case http.MethodPost, http.MethodPut:
+		secret := &models.Secret{}
+		if !decodeBody(w, r, secret) {
+			return
+		}
+		if secret.Name == nil || *secret.Name == "" {
+			writeError(w, http.StatusBadRequest, "secret name must be specified")
+			return
+		}
+		index := b.secretIndex(*secret.Name, secretScope(secret.SecretMetadata))
+		switch {
+		case r.Method == http.MethodPost && index >= 0:
+			writeError(w, http.StatusConflict, fmt.Sprintf("secret %s already exists", *secret.Name))
+		case r.Method == http.MethodPost:
+			b.secrets = append(b.secrets, *secret)
+			writeJSON(w, http.StatusOK, secret)
+		case index < 0:
+			writeError(w, http.StatusNotFound, fmt.Sprintf("secret %s not found", *secret.Name))
+		default:
+			b.secrets[index] = *secret
+			writeJSON(w, http.StatusOK, secret)
+		}
+	case http.MethodDelete:
+		name := r.URL.Query().Get("name")
+		index := b.secretIndex(name, r.URL.Query().Get("scope"))
+		if index < 0 {
+			writeError(w, http.StatusNotFound, fmt.Sprintf("secret %s not found", name))
+			return
+		}
+		b.secrets = append(b.secrets[:index:index], b.secrets[index+1:]...)
+		writeJSON(w, http.StatusOK, map[string]interface{}{})
+	default:
+		writeError(w, http.StatusMethodNotAllowed, "method not allowed")
+	}
+}
+
+// routeUniform routes the requests to the given segments after /v1/uniform/registration
+func (b *backend) routeUniform(w http.ResponseWriter, r *http.Request, segments []string) {
+	if len(segments) == 0 {
+		b.handleIntegrations(w, r)
+		return
+	}
+	integration := b.integration(segments[0])
+	if integration == nil {
+		writeError(w, http.StatusNotFound, fmt.Sprintf("integration %s not found", segments[0]))
+		return
+	}
+	switch {
+	case len(segments) == 1 && r.Method == http.MethodDelete:
+		integrations := []*models.Integration{}
+		for _, i := range b.integrations {
+			if i.ID != integration.ID {
+				integrations = append(integrations, i)
+			}
+		}
+		b.integrations = integrations
+		writeJSON(w, http.StatusOK, map[string]interface{}{})
+	case len(segments) == 2 && segments[1] == "ping" && r.Method == http.MethodPut:
+		integration.MetaData.LastSeen = time.Now().UTC()
+		writeResponse(w, http.StatusOK, integration)
+	case len(segments) == 2 && segments[1] == "subscription" && r.Method == http.MethodPost:
+		subscription := &models.EventSubscription{}
+		if !decodeJSON(w, r, subscription) {
+			return
+		}
+		subscription.ID = uuid.New().String()
+		integration.Subscriptions = append(integration.Subscriptions, *subscription)
+		writeResponse(w, http.StatusOK, &models.CreateSubscriptionResponse{ID: subscription.ID})
+	default:
+		writeError(w, http.StatusNotFound, "unknown path "+r.URL.Path)
+	}
+}
+
+func (b *backend) handleIntegrations(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case http.MethodGet:
+		writeJSON(w, http.StatusOK, b.integrations)
+	case http.MethodPost:
+		integration := &models.Integration{}
+		if !decodeBody(w, r, integration) {
+			return
+		}
+		// like the shipyard controller, the ID of an integration is derived from its name and location,
+		// so that registering an integration again updates the existing registration
+		id, err := models.IntegrationID{
+			Name:      integration.Name,
+			Namespace: integration.MetaData.KubernetesMetaData.Namespace,
+			NodeName:  integration.MetaData.Hostname,
+		}.Hash()
+		if err != nil {
+			writeError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		integration.ID = id
+		integration.MetaData.LastSeen = time.Now().UTC()
+		if existing := b.integration(id); existing != nil {
+			*existing = *integration
+		} else {
+			b.integrations = append(b.integrations, integration)
+		}
+		writeResponse(w, http.StatusOK, &models.RegisterIntegrationResponse{ID: id})
+	default:
+		writeError(w, http.StatusMethodNotAllowed, "method not allowed")
+	}
+}
+
+func (b *backend) handleLogs(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	switch r.Method {
+	case http.MethodGet:
+		matching := []models.LogEntry{}
+		for _, entry := range b.logs {
+			if id := query.Get("integrationId"); id == "" || entry.IntegrationID == id {
+				matching = append(matching, entry)
+			}
+		}
+		page, nextPageKey := paginate(len(matching), r)
+		writeResponse(w, http.StatusOK, &models.GetLogsResponse{
+			Logs:        matching[page.start:page.end],
+			NextPageKey: pageOffset(nextPageKey),
+			PageSize:    int64(page.end - page.start),
+			TotalCount:  int64(len(matching)),
+		})
+	case http.MethodPost:
+		if r.Header.Get("Content-Encoding") == "gzip" {
+			reader, err := gzip.NewReader(r.Body)
+			if err != nil {
+				writeError(w, http.StatusBadRequest, "could not decode request body")
+				return
+			}
+			r.Body = reader
+		}
+		request := &models.CreateLogsRequest{}
+		if !decodeBody(w, r, request) {
+			return
+		}
+		b.logs = append(b.logs, request.Logs...)
+		writeJSON(w, http.StatusOK, map[string]interface{}{})
+	case http.MethodDelete:
+		logs := []models.LogEntry{}
+		for _, entry := range b.logs {
+			if id := query.Get("integrationId"); id != "" && entry.IntegrationID != id {
+				logs = append(logs, entry)
+			}
+		}
+		b.logs = logs
+		writeJSON(w, http.StatusOK, map[string]interface{}{})
+	default:
+		writeError(w, http.StatusMethodNotAllowed, "method not allowed")
+	}
+}
+
+func (b *backend) project(projectName string) *models.Project {
+	for _, p := range b.projects {
+		if p.ProjectName == projectName {
+			return p
+		}
+	}
+	return nil
+}
+
+func (b *backend) stage(projectName string, stageName string) *models.Stage {
+	project := b.project(projectName)
+	if project == nil {
+		return nil
+	}
+	for _, s := range project.Stages {
+		if s.StageName == stageName {
+			return s
+		}
+	}
+	return nil
+}
+
+func (b *backend) service(projectName string, stageName string, serviceName string) *models.Service {
+	stage := b.stage(projectName, stageName)
+	if stage == nil {
+		return nil
+	}
+	for _, s := range stage.Services {
+		if s.ServiceName == serviceName {
+			return s
+		}
+	}
+	return nil
+}
+
+func (b *backend) resourceIndex(key string, resourceURI string) int {
+	for i, resource := range b.resources[key] {
+		if resource.ResourceURI != nil && *resource.ResourceURI == resourceURI {
+			return i
+		}
+	}
+	return -1
+}
+
+// putResource creates or replaces the resource with the URI of the given resource
+func (b *backend) putResource(key string, resource *models.Resource) {
+	if index := b.resourceIndex(key, *resource.ResourceURI); index >= 0 {
+		b.resources[key][index] = resource
+		return
+	}
+	b.resources[key] = append(b.resources[key], resource)
+}
+
+// writeVersion writes the version of a new commit to the repository containing the resources
+func (b *backend) writeVersion(w http.ResponseWriter) {
+	b.commits++
+	writeResponse(w, http.StatusOK, &models.Version{Version: fmt.Sprintf("commit-%d", b.commits)})
+}
+
+func (b *backend) secretIndex(name string, scope string) int {
+	for i, secret := range b.secrets {
+		if secret.Name != nil && *secret.Name == name && secretScope(secret.SecretMetadata) == scope {
+			return i
+		}
+	}
+	return -1
+}
+
+func secretScope(metadata models.SecretMetadata) string {
+	if metadata.Scope == nil {
+		return ""
+	}
+	return *metadata.Scope
+}
+
+func (b *backend) integration(id string) *models.Integration {
+	for _, i := range b.integrations {
+		if i.ID == id {
+			return i
+		}
+	}
+	return nil
+}
+
+// eventDataField returns the given string field of the data of an event, e.g. its project
+func eventDataField(event *models.KeptnContextExtendedCE, field string) string {
+	data, _ := event.Data.(map[string]interface{})
+	value, _ := data[field].(string)
+	return value
+}
+
+type pageBounds struct {
+	start int
+	end   int
+}
+
+// paginate determines the bounds of the requested page as well as the key of the next page.
+// The key of a page is the index of its first element
+func paginate(total int, r *http.Request) (pageBounds, string) {
+	query := r.URL.Query()
+	pageSize, err := strconv.Atoi(query.Get("pageSize"))
+	if err != nil || pageSize <= 0 {
+		pageSize = PageSize
+	}
+	start, err := strconv.Atoi(query.Get("nextPageKey"))
+	if err != nil || start < 0 || start > total {
+		start = 0
+	}
+	end := start + pageSize
+	if end >= total {
+		return pageBounds{start: start, end: total}, ""
+	}
+	return pageBounds{start: start, end: end}, strconv.Itoa(end)
+}
+
+// pageOffset converts the nextPageKey determined by paginate for the APIs returning a numeric nextPageKey
+func pageOffset(nextPageKey string) int64 {
+	offset, _ := strconv.ParseInt(nextPageKey, 10, 64)
+	return offset
+}
+
+// projectRequest is the body of the requests creating or updating a project, which is a models.Project
+// when sent to the shipyard controller and a models.CreateProject when sent to the API service
+type projectRequest struct {
+	ProjectName     string `json:"projectName"`
+	Name            string `json:"name"`
+	ShipyardVersion string `json:"shipyardVersion"`
+}
+
+func (p *projectRequest) name() string {
+	if p.ProjectName != "" {
+		return p.ProjectName
+	}
+	return p.Name
+}
+
+type jsonModel interface {
+	ToJSON() ([]byte, error)
+	FromJSON(b []byte) error
+}
+
+func decodeBody(w http.ResponseWriter, r *http.Request, v jsonModel) bool {
+	body, err := ioutil.ReadAll(r.Body)
+	if err != nil || v.FromJSON(body) != nil {
+		writeError(w, http.StatusBadRequest, "could not decode request body")
+		return false
+	}
+	return true
+}
+
+// decodeJSON decodes a request body which is not a model of the API
+func decodeJSON(w http.ResponseWriter, r *http.Request, v interface{}) bool {
+	body, err := ioutil.ReadAll(r.Body)
+	if err != nil || json.Unmarshal(body, v) != nil {
+		writeError(w, http.StatusBadRequest, "could not decode request body")
+		return false
+	}
+	return true
+}
+
+func writeEventContext(w http.ResponseWriter) {
+	writeKeptnContext(w, uuid.New().String())
+}
+
+func writeKeptnContext(w http.ResponseWriter, keptnContext string) {
+	writeResponse(w, http.StatusOK, &models.EventContext{KeptnContext: &keptnContext})
+}
+
+func writeResponse(w http.ResponseWriter, code int, response jsonModel) {
+	body, err := response.ToJSON()
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	writeBody(w, code, body)
+}
+
+// writeJSON writes a response which is not a model of the API, e.g. a list of models
+func writeJSON(w http.ResponseWriter, code int, response interface{}) {
+	body, err := json.Marshal(response)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	writeBody(w, code, body)
+}
+
+func writeError(w http.ResponseWriter, code int, message string) {
+	body, _ := (&models.Error{Code: int64(code), Message: &message}).ToJSON()
+	writeBody(w, code, body)
+}
+
+func writeBody(w http.ResponseWriter, code int, body []byte) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	_, _ = w.Write(body)
+}
@@ -0,0 +1,101 @@
+// Package conformance contains test suites which exercise the documented semantics of the interfaces of the v2 API utils,
+// i.e. pagination, the mapping of API errors and the handling of cancelled contexts.
+// Implementations of the interfaces which are written on top of the API, e.g. fakes, caches, decorators or adapters,
+// can be run against the suites to ensure that they stay behaviorally compatible with the handlers of this module:
+//
+//	func TestCachingProjects(t *testing.T) {
+//		conformance.RunProjectsInterfaceTests(t, func(t *testing.T, baseURL string) v2.ProjectsInterface {
+//			return NewCachingProjects(v2.NewProjectHandler(baseURL))
+//		})
+//	}
+//
+// There is a suite for each interface of the APISet. Every test starts a new in-memory backend which simulates
+// the Keptn API and is seeded with the projects, stages, services, events and sequences described by the
+// constants of this package. Resources, secrets, integrations and logs are only created by the tests themselves
+package conformance
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/keptn/go-utils/pkg/api/models"
+	"github.com/stretchr/testify/require"
+)
+
+// cancellationTimeout is the time after which a request is cancelled by the tests for context cancellation
+const cancellationTimeout = 100 * time.Millisecond
+
+// maxCancellationDelay is the time within which a call needs to return after its context has been cancelled
+const maxCancellationDelay = 5 * time.Second
+
+func startBackend(t *testing.T) *backend {
+	b := newBackend()
+	t.Cleanup(b.Close)
+	return b
+}
+
+// testContextCancellation checks that the given call fails if its context has been cancelled before the call,
+// and that it returns in time if its context is cancelled while the API has not yet answered
+func testContextCancellation(t *testing.T, b *backend, call func(ctx context.Context) error) {
+	t.Run("cancelled before the call", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		require.Error(t, call(ctx))
+	})
+
+	t.Run("cancelled during the call", func(t *testing.T) {
+		b.setBlocking(true)
+		defer b.setBlocking(false)
+
+		ctx, cancel := context.WithTimeout(context.Background(), cancellationTimeout)
+		defer cancel()
+		done := make(chan error, 1)
+		go func() {
+			done <- call(ctx)
+		}()
+		select {
+		case err := <-done:
+			require.Error(t, err)
+		case <-time.After(maxCancellationDelay):
+			t.Fatalf("call did not return within %s after its context has been cancelled", maxCancellationDelay)
+		}
+	})
+}
+
+func projectName(i int) string {
+	return fmt.Sprintf("project-%d", i)
+}
+
+func serviceName(i int) string {
+	return fmt.Sprintf("service-%d", i)
+}
+
+// toError converts an API error to an error, without turning a nil *models.Error into a non-nil error
+func toError(mErr *models.Error) error {
+	if mErr == nil {
+		return nil
+	}
+	return mErr.ToError()
+}
+
+func requireAPIError(t *testing.T, mErr *models.Error, code int, message string) {
+	t.Helper()
+	require.NotNil(t, mErr, "expected an error with code %d", code)
+	require.Equal(t, int64(code), mErr.Code)
+	require.Contains(t, mErr.GetMessage(), message)
+}
+
+func requireErrorMessage(t *testing.T, err error, message string) {
+	t.Helper()
+	require.Error(t, err)
+	require.Contains(t, err.Error(), message)
+}
+
+func requireKeptnContext(t *testing.T, eventContext *models.EventContext) {
+	t.Helper()
+	require.NotNil(t, eventContext)
+	require.NotNil(t, eventContext.KeptnContext)
+	require.NotEmpty(t, *eventContext.KeptnContext)
+}
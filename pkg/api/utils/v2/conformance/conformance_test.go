@@ -0,0 +1,159 @@
+package conformance_test
+
+import (
+	"testing"
+
+	v2 "github.com/keptn/go-utils/pkg/api/utils/v2"
+	"github.com/keptn/go-utils/pkg/api/utils/v2/conformance"
+	"github.com/stretchr/testify/require"
+)
+
+func newAPISet(t *testing.T, baseURL string) *v2.APISet {
+	apiSet, err := v2.New(baseURL)
+	require.NoError(t, err)
+	return apiSet
+}
+
+func TestProjectHandler(t *testing.T) {
+	conformance.RunProjectsInterfaceTests(t, func(t *testing.T, baseURL string) v2.ProjectsInterface {
+		return v2.NewProjectHandler(baseURL)
+	})
+}
+
+func TestAPISetProjects(t *testing.T) {
+	conformance.RunProjectsInterfaceTests(t, func(t *testing.T, baseURL string) v2.ProjectsInterface {
+		return newAPISet(t, baseURL).Projects()
+	})
+}
+
+func TestStageHandler(t *testing.T) {
+	conformance.RunStagesInterfaceTests(t, func(t *testing.T, baseURL string) v2.StagesInterface {
+		return v2.NewStageHandler(baseURL)
+	})
+}
+
+func TestServiceHandler(t *testing.T) {
+	conformance.RunServicesInterfaceTests(t, func(t *testing.T, baseURL string) v2.ServicesInterface {
+		return v2.NewServiceHandler(baseURL)
+	})
+}
+
+func TestEventHandler(t *testing.T) {
+	conformance.RunEventsInterfaceTests(t, func(t *testing.T, baseURL string) v2.EventsInterface {
+		return v2.NewEventHandler(baseURL)
+	})
+}
+
+func TestAPISetStages(t *testing.T) {
+	conformance.RunStagesInterfaceTests(t, func(t *testing.T, baseURL string) v2.StagesInterface {
+		return newAPISet(t, baseURL).Stages()
+	})
+}
+
+func TestAPISetServices(t *testing.T) {
+	conformance.RunServicesInterfaceTests(t, func(t *testing.T, baseURL string) v2.ServicesInterface {
+		return newAPISet(t, baseURL).Services()
+	})
+}
+
+func TestAPISetEvents(t *testing.T) {
+	conformance.RunEventsInterfaceTests(t, func(t *testing.T, baseURL string) v2.EventsInterface {
+		return newAPISet(t, baseURL).Events()
+	})
+}
+
+func TestResourceHandler(t *testing.T) {
+	conformance.RunResourcesInterfaceTests(t, func(t *testing.T, baseURL string) v2.ResourcesInterface {
+		return v2.NewResourceHandler(baseURL)
+	})
+}
+
+func TestAPISetResources(t *testing.T) {
+	conformance.RunResourcesInterfaceTests(t, func(t *testing.T, baseURL string) v2.ResourcesInterface {
+		return newAPISet(t, baseURL).Resources()
+	})
+}
+
+func TestSequenceControlHandler(t *testing.T) {
+	conformance.RunSequencesInterfaceTests(t, func(t *testing.T, baseURL string) v2.SequencesInterface {
+		return v2.NewSequenceControlHandler(baseURL)
+	})
+}
+
+func TestAPISetSequences(t *testing.T) {
+	conformance.RunSequencesInterfaceTests(t, func(t *testing.T, baseURL string) v2.SequencesInterface {
+		return newAPISet(t, baseURL).Sequences()
+	})
+}
+
+func TestSecretHandler(t *testing.T) {
+	conformance.RunSecretsInterfaceTests(t, func(t *testing.T, baseURL string) v2.SecretsInterface {
+		return v2.NewSecretHandler(baseURL)
+	})
+}
+
+func TestAPISetSecrets(t *testing.T) {
+	conformance.RunSecretsInterfaceTests(t, func(t *testing.T, baseURL string) v2.SecretsInterface {
+		return newAPISet(t, baseURL).Secrets()
+	})
+}
+
+func TestUniformHandler(t *testing.T) {
+	conformance.RunUniformInterfaceTests(t, func(t *testing.T, baseURL string) v2.UniformInterface {
+		return v2.NewUniformHandler(baseURL)
+	})
+}
+
+func TestAPISetUniform(t *testing.T) {
+	conformance.RunUniformInterfaceTests(t, func(t *testing.T, baseURL string) v2.UniformInterface {
+		return newAPISet(t, baseURL).Uniform()
+	})
+}
+
+func TestLogHandler(t *testing.T) {
+	conformance.RunLogsInterfaceTests(t, func(t *testing.T, baseURL string) v2.LogsInterface {
+		return v2.NewLogHandler(baseURL)
+	})
+}
+
+func TestAPISetLogs(t *testing.T) {
+	conformance.RunLogsInterfaceTests(t, func(t *testing.T, baseURL string) v2.LogsInterface {
+		return newAPISet(t, baseURL).Logs()
+	})
+}
+
+func TestShipyardControllerHandler(t *testing.T) {
+	conformance.RunShipyardControlInterfaceTests(t, func(t *testing.T, baseURL string) v2.ShipyardControlInterface {
+		return v2.NewShipyardControllerHandler(baseURL)
+	})
+}
+
+func TestAPISetShipyardControl(t *testing.T) {
+	conformance.RunShipyardControlInterfaceTests(t, func(t *testing.T, baseURL string) v2.ShipyardControlInterface {
+		return newAPISet(t, baseURL).ShipyardControl()
+	})
+}
+
+func TestAPIHandler(t *testing.T) {
+	conformance.RunAPIInterfaceTests(t, func(t *testing.T, baseURL string) v2.APIInterface {
+		return v2.NewAPIHandler(baseURL)
+	})
+}
+
+func TestAPISetAPI(t *testing.T) {
+	conformance.RunAPIInterfaceTests(t, func(t *testing.T, baseURL string) v2.APIInterface {
+		return newAPISet(t, baseURL).API()
+	})
+}
+
+func TestAuthHandler(t *testing.T) {
+	conformance.RunAuthInterfaceTests(t, func(t *testing.T, baseURL string) v2.AuthInterface {
+		return v2.NewAuthHandler(baseURL)
+	})
+}
+
+func TestAPISetAuth(t *testing.T) {
+	conformance.RunAuthInterfaceTests(t, func(t *testing.T, baseURL string) v2.AuthInterface {
+		return newAPISet(t, baseURL).Auth()
+	})
+}
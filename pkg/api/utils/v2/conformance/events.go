@@ -0,0 +1,127 @@
+package conformance
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/keptn/go-utils/pkg/api/models"
+	v2 "github.com/keptn/go-utils/pkg/api/utils/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// EventsFactory creates the EventsInterface under test, which needs to use the Keptn API at the given base URL
+type EventsFactory func(t *testing.T, baseURL string) v2.EventsInterface
+
+// RunEventsInterfaceTests runs the conformance tests for v2.EventsInterface against the implementations
+// created by newEvents. Every test creates a new implementation
+func RunEventsInterfaceTests(t *testing.T, newEvents EventsFactory) {
+	setup := func(t *testing.T) (*backend, v2.EventsInterface) {
+		b := startBackend(t)
+		return b, newEvents(t, b.URL)
+	}
+
+	t.Run("GetEvents returns the events of all pages", func(t *testing.T) {
+		_, events := setup(t)
+		result, mErr := events.GetEvents(context.Background(), &v2.EventFilter{Project: projectName(1)}, v2.EventsGetEventsOptions{})
+		require.Nil(t, mErr)
+		assert.Equal(t, []string{"event-1", "event-2", "event-3", "event-4", "event-5"}, eventIDs(result))
+	})
+
+	t.Run("GetEvents returns the requested number of pages", func(t *testing.T) {
+		_, events := setup(t)
+		result, mErr := events.GetEvents(context.Background(), &v2.EventFilter{Project: projectName(1), NumberOfPages: 1}, v2.EventsGetEventsOptions{})
+		require.Nil(t, mErr)
+		assert.Equal(t, []string{"event-1", "event-2"}, eventIDs(result))
+
+		result, mErr = events.GetEvents(context.Background(), &v2.EventFilter{Project: projectName(1), NumberOfPages: 1, PageSize: "4"}, v2.EventsGetEventsOptions{})
+		require.Nil(t, mErr)
+		assert.Equal(t, []string{"event-1", "event-2", "event-3", "event-4"}, eventIDs(result))
+	})
+
+	t.Run("GetEvents applies the filter", func(t *testing.T) {
+		_, events := setup(t)
+		result, mErr := events.GetEvents(context.Background(), &v2.EventFilter{Project: projectName(1), KeptnContext: "context-1"}, v2.EventsGetEventsOptions{})
+		require.Nil(t, mErr)
+		assert.Equal(t, []string{"event-1", "event-3", "event-5"}, eventIDs(result))
+
+		result, mErr = events.GetEvents(context.Background(), &v2.EventFilter{Project: projectName(2)}, v2.EventsGetEventsOptions{})
+		require.Nil(t, mErr)
+		assert.Empty(t, result)
+	})
+
+	t.Run("GetEvents does not return events twice when polling with a delta tracker", func(t *testing.T) {
+		_, events := setup(t)
+		opts := v2.EventsGetEventsOptions{Delta: v2.NewEventDeltaTracker(0)}
+		result, mErr := events.GetEvents(context.Background(), &v2.EventFilter{Project: projectName(1)}, opts)
+		require.Nil(t, mErr)
+		assert.Len(t, result, SeededEvents)
+
+		result, mErr = events.GetEvents(context.Background(), &v2.EventFilter{Project: projectName(1)}, opts)
+		require.Nil(t, mErr)
+		assert.Empty(t, result)
+	})
+
+	t.Run("GetEventsWithRetry returns the matching events", func(t *testing.T) {
+		_, events := setup(t)
+		result, err := events.GetEventsWithRetry(context.Background(), &v2.EventFilter{KeptnContext: "context-2"}, 3, time.Millisecond, v2.EventsGetEventsWithRetryOptions{})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"event-2", "event-4"}, eventIDs(result))
+	})
+
+	t.Run("GetEventsWithRetry returns an error if no event matches", func(t *testing.T) {
+		_, events := setup(t)
+		result, err := events.GetEventsWithRetry(context.Background(), &v2.EventFilter{KeptnContext: "unknown"}, 3, time.Millisecond, v2.EventsGetEventsWithRetryOptions{})
+		assert.Empty(t, result)
+		require.Error(t, err)
+	})
+
+	t.Run("GetEventsByContexts returns the events of each context", func(t *testing.T) {
+		_, events := setup(t)
+		result, err := v2.GetEventsByContexts(context.Background(), events, []string{"context-1", "context-2"}, v2.EventsGetEventsByContextsOptions{})
+		require.NoError(t, err)
+		require.Len(t, result, 2)
+		assert.Equal(t, []string{"event-1", "event-3", "event-5"}, eventIDs(result["context-1"]))
+		assert.Equal(t, []string{"event-2", "event-4"}, eventIDs(result["context-2"]))
+	})
+
+	t.Run("errors of the API are returned", func(t *testing.T) {
+		b, events := setup(t)
+		b.setFailing(true)
+
+		_, mErr := events.GetEvents(context.Background(), &v2.EventFilter{Project: projectName(1)}, v2.EventsGetEventsOptions{})
+		requireAPIError(t, mErr, http.StatusInternalServerError, "internal server error")
+
+		_, err := v2.GetEventsByContexts(context.Background(), events, []string{"context-1"}, v2.EventsGetEventsByContextsOptions{})
+		byContextsErr := &v2.EventsByContextsError{}
+		require.True(t, errors.As(err, &byContextsErr), "expected an EventsByContextsError, got %v", err)
+		requireAPIError(t, byContextsErr.Errors["context-1"], http.StatusInternalServerError, "internal server error")
+	})
+
+	t.Run("GetEvents respects the context", func(t *testing.T) {
+		b, events := setup(t)
+		testContextCancellation(t, b, func(ctx context.Context) error {
+			_, mErr := events.GetEvents(ctx, &v2.EventFilter{Project: projectName(1)}, v2.EventsGetEventsOptions{})
+			return toError(mErr)
+		})
+	})
+
+	t.Run("GetEventsWithRetry respects the context", func(t *testing.T) {
+		b, events := setup(t)
+		testContextCancellation(t, b, func(ctx context.Context) error {
+			_, err := events.GetEventsWithRetry(ctx, &v2.EventFilter{KeptnContext: "unknown"}, 1000, 10*time.Millisecond, v2.EventsGetEventsWithRetryOptions{})
+			return err
+		})
+	})
+}
+
+func eventIDs(events []*models.KeptnContextExtendedCE) []string {
+	ids := []string{}
+	for _, event := range events {
+		ids = append(ids, event.ID)
+	}
+	return ids
+}
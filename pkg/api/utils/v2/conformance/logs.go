@@ -0,0 +1,140 @@
+package conformance
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/keptn/go-utils/pkg/api/models"
+	v2 "github.com/keptn/go-utils/pkg/api/utils/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// LogsFactory creates the LogsInterface under test, which needs to use the Keptn API at the given base URL
+type LogsFactory func(t *testing.T, baseURL string) v2.LogsInterface
+
+// RunLogsInterfaceTests runs the conformance tests for v2.LogsInterface against the implementations
+// created by newLogs. Every test creates a new implementation
+func RunLogsInterfaceTests(t *testing.T, newLogs LogsFactory) {
+	setup := func(t *testing.T) (*backend, v2.LogsInterface) {
+		b := startBackend(t)
+		return b, newLogs(t, b.URL)
+	}
+	getLogs := func(t *testing.T, logs v2.LogsInterface, params models.GetLogsParams) *models.GetLogsResponse {
+		t.Helper()
+		response, err := logs.GetLogs(context.Background(), params, v2.LogsGetLogsOptions{})
+		require.NoError(t, err)
+		require.NotNil(t, response)
+		return response
+	}
+
+	t.Run("Flush sends the logged entries", func(t *testing.T) {
+		_, logs := setup(t)
+		logs.Log(logEntries("integration-1", 2), v2.LogsLogOptions{})
+		logs.Log(logEntries("integration-2", 1), v2.LogsLogOptions{})
+		require.NoError(t, logs.Flush(context.Background(), v2.LogsFlushOptions{}))
+
+		response := getLogs(t, logs, models.GetLogsParams{LogFilter: models.LogFilter{IntegrationID: "integration-1"}})
+		assert.Equal(t, []string{"integration-1 message 1", "integration-1 message 2"}, logMessages(response.Logs))
+		response = getLogs(t, logs, models.GetLogsParams{LogFilter: models.LogFilter{IntegrationID: "integration-2"}})
+		assert.Equal(t, []string{"integration-2 message 1"}, logMessages(response.Logs))
+	})
+
+	t.Run("Flush does not send the entries twice", func(t *testing.T) {
+		_, logs := setup(t)
+		logs.Log(logEntries("integration-1", 1), v2.LogsLogOptions{})
+		require.NoError(t, logs.Flush(context.Background(), v2.LogsFlushOptions{}))
+		require.NoError(t, logs.Flush(context.Background(), v2.LogsFlushOptions{}))
+
+		response := getLogs(t, logs, models.GetLogsParams{})
+		assert.Len(t, response.Logs, 1)
+	})
+
+	t.Run("Flush keeps the entries which could not be sent", func(t *testing.T) {
+		b, logs := setup(t)
+		logs.Log(logEntries("integration-1", 1), v2.LogsLogOptions{})
+		b.setFailing(true)
+		require.Error(t, logs.Flush(context.Background(), v2.LogsFlushOptions{}))
+		b.setFailing(false)
+		require.NoError(t, logs.Flush(context.Background(), v2.LogsFlushOptions{}))
+
+		response := getLogs(t, logs, models.GetLogsParams{})
+		assert.Equal(t, []string{"integration-1 message 1"}, logMessages(response.Logs))
+	})
+
+	t.Run("GetLogs returns the requested page", func(t *testing.T) {
+		_, logs := setup(t)
+		logs.Log(logEntries("integration-1", 3), v2.LogsLogOptions{})
+		require.NoError(t, logs.Flush(context.Background(), v2.LogsFlushOptions{}))
+
+		response := getLogs(t, logs, models.GetLogsParams{PageSize: 2})
+		assert.Equal(t, []string{"integration-1 message 1", "integration-1 message 2"}, logMessages(response.Logs))
+		assert.Equal(t, int64(3), response.TotalCount)
+		require.Equal(t, int64(2), response.NextPageKey)
+
+		response = getLogs(t, logs, models.GetLogsParams{PageSize: 2, NextPageKey: int(response.NextPageKey)})
+		assert.Equal(t, []string{"integration-1 message 3"}, logMessages(response.Logs))
+		assert.True(t, response.Cursor().IsEnd())
+	})
+
+	t.Run("DeleteLogs deletes the logs matching the filter", func(t *testing.T) {
+		_, logs := setup(t)
+		logs.Log(logEntries("integration-1", 2), v2.LogsLogOptions{})
+		logs.Log(logEntries("integration-2", 1), v2.LogsLogOptions{})
+		require.NoError(t, logs.Flush(context.Background(), v2.LogsFlushOptions{}))
+
+		require.NoError(t, logs.DeleteLogs(context.Background(), models.LogFilter{IntegrationID: "integration-1"}, v2.LogsDeleteLogsOptions{}))
+
+		response := getLogs(t, logs, models.GetLogsParams{})
+		assert.Equal(t, []string{"integration-2 message 1"}, logMessages(response.Logs))
+	})
+
+	t.Run("errors of the API are returned", func(t *testing.T) {
+		b, logs := setup(t)
+		b.setFailing(true)
+
+		logs.Log(logEntries("integration-1", 1), v2.LogsLogOptions{})
+		err := logs.Flush(context.Background(), v2.LogsFlushOptions{})
+		requireErrorMessage(t, err, "internal server error")
+
+		_, err = logs.GetLogs(context.Background(), models.GetLogsParams{}, v2.LogsGetLogsOptions{})
+		requireErrorMessage(t, err, "internal server error")
+
+		err = logs.DeleteLogs(context.Background(), models.LogFilter{IntegrationID: "integration-1"}, v2.LogsDeleteLogsOptions{})
+		requireErrorMessage(t, err, "internal server error")
+	})
+
+	t.Run("Flush respects the context", func(t *testing.T) {
+		b, logs := setup(t)
+		testContextCancellation(t, b, func(ctx context.Context) error {
+			logs.Log(logEntries("integration-1", 1), v2.LogsLogOptions{})
+			return logs.Flush(ctx, v2.LogsFlushOptions{})
+		})
+	})
+
+	t.Run("GetLogs respects the context", func(t *testing.T) {
+		b, logs := setup(t)
+		testContextCancellation(t, b, func(ctx context.Context) error {
+			_, err := logs.GetLogs(ctx, models.GetLogsParams{}, v2.LogsGetLogsOptions{})
+			return err
+		})
+	})
+}
+
+// logEntries returns the given number of log entries of the integration
+func logEntries(integrationID string, count int) []models.LogEntry {
+	entries := []models.LogEntry{}
+	for i := 1; i <= count; i++ {
+		entries = append(entries, models.LogEntry{IntegrationID: integrationID, Message: fmt.Sprintf("%s message %d", integrationID, i)})
+	}
+	return entries
+}
+
+func logMessages(entries []models.LogEntry) []string {
+	messages := []string{}
+	for _, entry := range entries {
+		messages = append(messages, entry.Message)
+	}
+	return messages
+}
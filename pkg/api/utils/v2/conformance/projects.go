@@ -0,0 +1,127 @@
+package conformance
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/keptn/go-utils/pkg/api/models"
+	v2 "github.com/keptn/go-utils/pkg/api/utils/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// ProjectsFactory creates the ProjectsInterface under test, which needs to use the Keptn API at the given base URL
+type ProjectsFactory func(t *testing.T, baseURL string) v2.ProjectsInterface
+
+// RunProjectsInterfaceTests runs the conformance tests for v2.ProjectsInterface against the implementations
+// created by newProjects. Every test creates a new implementation
+func RunProjectsInterfaceTests(t *testing.T, newProjects ProjectsFactory) {
+	setup := func(t *testing.T) (*backend, v2.ProjectsInterface) {
+		b := startBackend(t)
+		return b, newProjects(t, b.URL)
+	}
+
+	t.Run("GetAllProjects returns the projects of all pages", func(t *testing.T) {
+		_, projects := setup(t)
+		result, err := projects.GetAllProjects(context.Background(), v2.ProjectsGetAllProjectsOptions{})
+		require.NoError(t, err)
+		require.Len(t, result, SeededProjects)
+		for i, project := range result {
+			assert.Equal(t, projectName(i+1), project.ProjectName)
+		}
+	})
+
+	t.Run("GetProject returns the project", func(t *testing.T) {
+		_, projects := setup(t)
+		project, mErr := projects.GetProject(context.Background(), models.Project{ProjectName: projectName(2)}, v2.ProjectsGetProjectOptions{})
+		require.Nil(t, mErr)
+		require.NotNil(t, project)
+		assert.Equal(t, projectName(2), project.ProjectName)
+		assert.Len(t, project.Stages, len(SeededStages))
+	})
+
+	t.Run("GetProject returns 404 for an unknown project", func(t *testing.T) {
+		_, projects := setup(t)
+		project, mErr := projects.GetProject(context.Background(), models.Project{ProjectName: "unknown"}, v2.ProjectsGetProjectOptions{})
+		assert.Nil(t, project)
+		requireAPIError(t, mErr, http.StatusNotFound, "project unknown not found")
+	})
+
+	t.Run("CreateProject creates the project", func(t *testing.T) {
+		_, projects := setup(t)
+		eventContext, mErr := projects.CreateProject(context.Background(), models.Project{ProjectName: "new-project"}, v2.ProjectsCreateProjectOptions{})
+		require.Nil(t, mErr)
+		requireKeptnContext(t, eventContext)
+
+		project, mErr := projects.GetProject(context.Background(), models.Project{ProjectName: "new-project"}, v2.ProjectsGetProjectOptions{})
+		require.Nil(t, mErr)
+		assert.Equal(t, "new-project", project.ProjectName)
+	})
+
+	t.Run("CreateProject returns 409 for an existing project", func(t *testing.T) {
+		_, projects := setup(t)
+		eventContext, mErr := projects.CreateProject(context.Background(), models.Project{ProjectName: projectName(1)}, v2.ProjectsCreateProjectOptions{})
+		assert.Nil(t, eventContext)
+		requireAPIError(t, mErr, http.StatusConflict, "project project-1 already exists")
+	})
+
+	t.Run("DeleteProject deletes the project", func(t *testing.T) {
+		_, projects := setup(t)
+		eventContext, mErr := projects.DeleteProject(context.Background(), models.Project{ProjectName: projectName(1)}, v2.ProjectsDeleteProjectOptions{})
+		require.Nil(t, mErr)
+		requireKeptnContext(t, eventContext)
+
+		_, mErr = projects.GetProject(context.Background(), models.Project{ProjectName: projectName(1)}, v2.ProjectsGetProjectOptions{})
+		requireAPIError(t, mErr, http.StatusNotFound, "project project-1 not found")
+	})
+
+	t.Run("DeleteProject returns 404 for an unknown project", func(t *testing.T) {
+		_, projects := setup(t)
+		eventContext, mErr := projects.DeleteProject(context.Background(), models.Project{ProjectName: "unknown"}, v2.ProjectsDeleteProjectOptions{})
+		assert.Nil(t, eventContext)
+		requireAPIError(t, mErr, http.StatusNotFound, "project unknown not found")
+	})
+
+	t.Run("UpdateConfigurationServiceProject updates the project", func(t *testing.T) {
+		_, projects := setup(t)
+		eventContext, mErr := projects.UpdateConfigurationServiceProject(context.Background(), models.Project{ProjectName: projectName(1)}, v2.ProjectsUpdateConfigurationServiceProjectOptions{})
+		require.Nil(t, mErr)
+		requireKeptnContext(t, eventContext)
+	})
+
+	t.Run("errors of the API are returned", func(t *testing.T) {
+		b, projects := setup(t)
+		b.setFailing(true)
+
+		_, err := projects.GetAllProjects(context.Background(), v2.ProjectsGetAllProjectsOptions{})
+		requireErrorMessage(t, err, "internal server error")
+
+		_, mErr := projects.GetProject(context.Background(), models.Project{ProjectName: projectName(1)}, v2.ProjectsGetProjectOptions{})
+		requireAPIError(t, mErr, http.StatusInternalServerError, "internal server error")
+	})
+
+	t.Run("GetAllProjects respects the context", func(t *testing.T) {
+		b, projects := setup(t)
+		testContextCancellation(t, b, func(ctx context.Context) error {
+			_, err := projects.GetAllProjects(ctx, v2.ProjectsGetAllProjectsOptions{})
+			return err
+		})
+	})
+
+	t.Run("GetProject respects the context", func(t *testing.T) {
+		b, projects := setup(t)
+		testContextCancellation(t, b, func(ctx context.Context) error {
+			_, mErr := projects.GetProject(ctx, models.Project{ProjectName: projectName(1)}, v2.ProjectsGetProjectOptions{})
+			return toError(mErr)
+		})
+	})
+
+	t.Run("CreateProject respects the context", func(t *testing.T) {
+		b, projects := setup(t)
+		testContextCancellation(t, b, func(ctx context.Context) error {
+			_, mErr := projects.CreateProject(ctx, models.Project{ProjectName: "new-project"}, v2.ProjectsCreateProjectOptions{})
+			return toError(mErr)
+		})
+	})
+}
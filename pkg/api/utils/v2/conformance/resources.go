@@ -0,0 +1,241 @@
+package conformance
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/keptn/go-utils/pkg/api/models"
+	v2 "github.com/keptn/go-utils/pkg/api/utils/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// ResourcesFactory creates the ResourcesInterface under test, which needs to use the Keptn API at the given base URL
+type ResourcesFactory func(t *testing.T, baseURL string) v2.ResourcesInterface
+
+// RunResourcesInterfaceTests runs the conformance tests for v2.ResourcesInterface against the implementations
+// created by newResources. Every test creates a new implementation
+func RunResourcesInterfaceTests(t *testing.T, newResources ResourcesFactory) {
+	setup := func(t *testing.T) (*backend, v2.ResourcesInterface) {
+		b := startBackend(t)
+		return b, newResources(t, b.URL)
+	}
+	projectScope := func(resourceURI string) v2.ResourceScope {
+		return *v2.NewResourceScope().Project(projectName(1)).Resource(resourceURI)
+	}
+	serviceScope := func(resourceURI string) v2.ResourceScope {
+		return *v2.NewResourceScope().Project(projectName(1)).Stage(SeededStages[0]).Service(serviceName(1)).Resource(resourceURI)
+	}
+
+	t.Run("CreateProjectResources creates the resources", func(t *testing.T) {
+		_, resources := setup(t)
+		version, err := resources.CreateProjectResources(context.Background(), projectName(1), threeResources(), v2.ResourcesCreateProjectResourcesOptions{})
+		require.NoError(t, err)
+		assert.NotEmpty(t, version)
+
+		resource, err := resources.GetResource(context.Background(), projectScope("resource-2.yaml"), v2.ResourcesGetResourceOptions{})
+		require.NoError(t, err)
+		assert.Equal(t, "resource-2.yaml", *resource.ResourceURI)
+		assert.Equal(t, "content of resource-2.yaml", resource.ResourceContent)
+	})
+
+	t.Run("CreateProjectResources returns an error for an existing resource", func(t *testing.T) {
+		_, resources := setup(t)
+		_, err := resources.CreateProjectResources(context.Background(), projectName(1), threeResources(), v2.ResourcesCreateProjectResourcesOptions{})
+		require.NoError(t, err)
+
+		_, err = resources.CreateProjectResources(context.Background(), projectName(1), threeResources()[:1], v2.ResourcesCreateProjectResourcesOptions{})
+		requireErrorMessage(t, err, "resource resource-1.yaml already exists")
+	})
+
+	t.Run("CreateResources creates the resources of the service", func(t *testing.T) {
+		_, resources := setup(t)
+		_, mErr := resources.CreateResources(context.Background(), projectName(1), SeededStages[0], serviceName(1), threeResources(), v2.ResourcesCreateResourcesOptions{})
+		require.Nil(t, mErr)
+
+		result, err := resources.GetAllServiceResources(context.Background(), projectName(1), SeededStages[0], serviceName(1), v2.ResourcesGetAllServiceResourcesOptions{})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"resource-1.yaml", "resource-2.yaml", "resource-3.yaml"}, resourceURIs(result))
+	})
+
+	t.Run("CreateResources creates the resources of the project", func(t *testing.T) {
+		_, resources := setup(t)
+		_, mErr := resources.CreateResources(context.Background(), projectName(1), "", "", threeResources(), v2.ResourcesCreateResourcesOptions{})
+		require.Nil(t, mErr)
+
+		resource, err := resources.GetResource(context.Background(), projectScope("resource-3.yaml"), v2.ResourcesGetResourceOptions{})
+		require.NoError(t, err)
+		assert.Equal(t, "content of resource-3.yaml", resource.ResourceContent)
+	})
+
+	t.Run("CreateResource creates the resources at the scope", func(t *testing.T) {
+		_, resources := setup(t)
+		scope := *v2.NewResourceScope().Project(projectName(1)).Stage(SeededStages[0])
+		version, err := resources.CreateResource(context.Background(), threeResources(), scope, v2.ResourcesCreateResourceOptions{})
+		require.NoError(t, err)
+		assert.NotEmpty(t, version)
+
+		result, err := resources.GetAllStageResources(context.Background(), projectName(1), SeededStages[0], v2.ResourcesGetAllStageResourcesOptions{})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"resource-1.yaml", "resource-2.yaml", "resource-3.yaml"}, resourceURIs(result))
+	})
+
+	t.Run("GetAllStageResources returns an error for an unknown stage", func(t *testing.T) {
+		_, resources := setup(t)
+		_, err := resources.GetAllStageResources(context.Background(), projectName(1), "unknown", v2.ResourcesGetAllStageResourcesOptions{})
+		requireErrorMessage(t, err, "stage unknown not found")
+	})
+
+	t.Run("GetAllServiceResources returns an empty list for a service without resources", func(t *testing.T) {
+		_, resources := setup(t)
+		result, err := resources.GetAllServiceResources(context.Background(), projectName(1), SeededStages[0], serviceName(1), v2.ResourcesGetAllServiceResourcesOptions{})
+		require.NoError(t, err)
+		assert.Empty(t, result)
+	})
+
+	t.Run("UpdateProjectResources updates the resources", func(t *testing.T) {
+		_, resources := setup(t)
+		_, err := resources.CreateProjectResources(context.Background(), projectName(1), threeResources(), v2.ResourcesCreateProjectResourcesOptions{})
+		require.NoError(t, err)
+
+		updated := []*models.Resource{newResource("resource-1.yaml", "updated content")}
+		version, err := resources.UpdateProjectResources(context.Background(), projectName(1), updated, v2.ResourcesUpdateProjectResourcesOptions{})
+		require.NoError(t, err)
+		assert.NotEmpty(t, version)
+
+		resource, err := resources.GetResource(context.Background(), projectScope("resource-1.yaml"), v2.ResourcesGetResourceOptions{})
+		require.NoError(t, err)
+		assert.Equal(t, "updated content", resource.ResourceContent)
+	})
+
+	t.Run("UpdateServiceResources creates and updates the resources", func(t *testing.T) {
+		_, resources := setup(t)
+		_, err := resources.UpdateServiceResources(context.Background(), projectName(1), SeededStages[0], serviceName(1), threeResources(), v2.ResourcesUpdateServiceResourcesOptions{})
+		require.NoError(t, err)
+
+		updated := []*models.Resource{newResource("resource-3.yaml", "updated content")}
+		_, err = resources.UpdateServiceResources(context.Background(), projectName(1), SeededStages[0], serviceName(1), updated, v2.ResourcesUpdateServiceResourcesOptions{})
+		require.NoError(t, err)
+
+		resource, err := resources.GetResource(context.Background(), serviceScope("resource-3.yaml"), v2.ResourcesGetResourceOptions{})
+		require.NoError(t, err)
+		assert.Equal(t, "updated content", resource.ResourceContent)
+	})
+
+	t.Run("UpdateResource updates the resource of the scope", func(t *testing.T) {
+		_, resources := setup(t)
+		resourceURI := "helm/values.yaml"
+		version, err := resources.UpdateResource(context.Background(), newResource(resourceURI, "replicas: 2"), serviceScope(resourceURI), v2.ResourcesUpdateResourceOptions{})
+		require.NoError(t, err)
+		assert.NotEmpty(t, version)
+
+		resource, err := resources.GetResource(context.Background(), serviceScope(resourceURI), v2.ResourcesGetResourceOptions{})
+		require.NoError(t, err)
+		assert.Equal(t, resourceURI, *resource.ResourceURI)
+		assert.Equal(t, "replicas: 2", resource.ResourceContent)
+	})
+
+	t.Run("GetResource returns ResourceNotFoundError for an unknown resource", func(t *testing.T) {
+		_, resources := setup(t)
+		resource, err := resources.GetResource(context.Background(), projectScope("unknown.yaml"), v2.ResourcesGetResourceOptions{})
+		assert.Nil(t, resource)
+		require.ErrorIs(t, err, v2.ResourceNotFoundError)
+	})
+
+	t.Run("DeleteResource deletes the resource", func(t *testing.T) {
+		_, resources := setup(t)
+		_, err := resources.CreateProjectResources(context.Background(), projectName(1), threeResources(), v2.ResourcesCreateProjectResourcesOptions{})
+		require.NoError(t, err)
+
+		err = resources.DeleteResource(context.Background(), projectScope("resource-1.yaml"), v2.ResourcesDeleteResourceOptions{})
+		require.NoError(t, err)
+
+		_, err = resources.GetResource(context.Background(), projectScope("resource-1.yaml"), v2.ResourcesGetResourceOptions{})
+		require.ErrorIs(t, err, v2.ResourceNotFoundError)
+		_, err = resources.GetResource(context.Background(), projectScope("resource-2.yaml"), v2.ResourcesGetResourceOptions{})
+		require.NoError(t, err)
+	})
+
+	t.Run("DeleteResource returns ResourceNotFoundError for an unknown resource", func(t *testing.T) {
+		_, resources := setup(t)
+		err := resources.DeleteResource(context.Background(), projectScope("unknown.yaml"), v2.ResourcesDeleteResourceOptions{})
+		require.ErrorIs(t, err, v2.ResourceNotFoundError)
+	})
+
+	t.Run("errors of the API are returned", func(t *testing.T) {
+		b, resources := setup(t)
+		b.setFailing(true)
+
+		_, mErr := resources.CreateResources(context.Background(), projectName(1), SeededStages[0], serviceName(1), threeResources(), v2.ResourcesCreateResourcesOptions{})
+		requireErrorMessage(t, toError(mErr), "internal server error")
+
+		_, err := resources.CreateProjectResources(context.Background(), projectName(1), threeResources(), v2.ResourcesCreateProjectResourcesOptions{})
+		requireErrorMessage(t, err, "internal server error")
+
+		_, err = resources.GetAllStageResources(context.Background(), projectName(1), SeededStages[0], v2.ResourcesGetAllStageResourcesOptions{})
+		requireErrorMessage(t, err, "internal server error")
+
+		_, err = resources.GetResource(context.Background(), projectScope("resource-1.yaml"), v2.ResourcesGetResourceOptions{})
+		requireErrorMessage(t, err, "internal server error")
+
+		_, err = resources.UpdateResource(context.Background(), newResource("resource-1.yaml", "content"), projectScope("resource-1.yaml"), v2.ResourcesUpdateResourceOptions{})
+		requireErrorMessage(t, err, "internal server error")
+
+		err = resources.DeleteResource(context.Background(), projectScope("resource-1.yaml"), v2.ResourcesDeleteResourceOptions{})
+		requireErrorMessage(t, err, "internal server error")
+	})
+
+	t.Run("GetResource respects the context", func(t *testing.T) {
+		b, resources := setup(t)
+		testContextCancellation(t, b, func(ctx context.Context) error {
+			_, err := resources.GetResource(ctx, projectScope("resource-1.yaml"), v2.ResourcesGetResourceOptions{})
+			return err
+		})
+	})
+
+	t.Run("GetAllServiceResources respects the context", func(t *testing.T) {
+		b, resources := setup(t)
+		testContextCancellation(t, b, func(ctx context.Context) error {
+			_, err := resources.GetAllServiceResources(ctx, projectName(1), SeededStages[0], serviceName(1), v2.ResourcesGetAllServiceResourcesOptions{})
+			return err
+		})
+	})
+
+	t.Run("CreateProjectResources respects the context", func(t *testing.T) {
+		b, resources := setup(t)
+		testContextCancellation(t, b, func(ctx context.Context) error {
+			_, err := resources.CreateProjectResources(ctx, projectName(1), threeResources(), v2.ResourcesCreateProjectResourcesOptions{})
+			return err
+		})
+	})
+
+	t.Run("DeleteResource respects the context", func(t *testing.T) {
+		b, resources := setup(t)
+		testContextCancellation(t, b, func(ctx context.Context) error {
+			return resources.DeleteResource(ctx, projectScope("resource-1.yaml"), v2.ResourcesDeleteResourceOptions{})
+		})
+	})
+}
+
+func newResource(resourceURI string, content string) *models.Resource {
+	return &models.Resource{ResourceURI: &resourceURI, ResourceContent: content}
+}
+
+// threeResources returns the resources resource-1.yaml to resource-3.yaml, which span two pages of the backend
+func threeResources() []*models.Resource {
+	resources := []*models.Resource{}
+	for i := 1; i <= 3; i++ {
+		resourceURI := fmt.Sprintf("resource-%d.yaml", i)
+		resources = append(resources, newResource(resourceURI, "content of "+resourceURI))
+	}
+	return resources
+}
+
+func resourceURIs(resources []*models.Resource) []string {
+	uris := []string{}
+	for _, resource := range resources {
+		uris = append(uris, *resource.ResourceURI)
+	}
+	return uris
+}
@@ -0,0 +1,126 @@
+package conformance
+
+import (
+	"context"
+	"testing"
+
+	"github.com/keptn/go-utils/pkg/api/models"
+	v2 "github.com/keptn/go-utils/pkg/api/utils/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// defaultSecretScope is the scope of the secrets created by the conformance tests
+const defaultSecretScope = "keptn-default"
+
+// SecretsFactory creates the SecretsInterface under test, which needs to use the Keptn API at the given base URL
+type SecretsFactory func(t *testing.T, baseURL string) v2.SecretsInterface
+
+// RunSecretsInterfaceTests runs the conformance tests for v2.SecretsInterface against the implementations
+// created by newSecrets. Every test creates a new implementation
+func RunSecretsInterfaceTests(t *testing.T, newSecrets SecretsFactory) {
+	setup := func(t *testing.T) (*backend, v2.SecretsInterface) {
+		b := startBackend(t)
+		return b, newSecrets(t, b.URL)
+	}
+
+	t.Run("CreateSecret creates the secret", func(t *testing.T) {
+		_, secrets := setup(t)
+		err := secrets.CreateSecret(context.Background(), newSecret("my-secret", "user", "password"), v2.SecretsCreateSecretOptions{})
+		require.NoError(t, err)
+
+		result, err := secrets.GetSecrets(context.Background(), v2.SecretsGetSecretsOptions{})
+		require.NoError(t, err)
+		require.Len(t, result.Secrets, 1)
+		assert.Equal(t, "my-secret", *result.Secrets[0].Name)
+		assert.Equal(t, defaultSecretScope, *result.Secrets[0].Scope)
+		assert.Equal(t, []string{"password", "user"}, result.Secrets[0].Keys)
+	})
+
+	t.Run("CreateSecret returns an error for an existing secret", func(t *testing.T) {
+		_, secrets := setup(t)
+		require.NoError(t, secrets.CreateSecret(context.Background(), newSecret("my-secret", "user"), v2.SecretsCreateSecretOptions{}))
+
+		err := secrets.CreateSecret(context.Background(), newSecret("my-secret", "user"), v2.SecretsCreateSecretOptions{})
+		requireErrorMessage(t, err, "secret my-secret already exists")
+	})
+
+	t.Run("UpdateSecret updates the secret", func(t *testing.T) {
+		_, secrets := setup(t)
+		require.NoError(t, secrets.CreateSecret(context.Background(), newSecret("my-secret", "user"), v2.SecretsCreateSecretOptions{}))
+
+		err := secrets.UpdateSecret(context.Background(), newSecret("my-secret", "token"), v2.SecretsUpdateSecretOptions{})
+		require.NoError(t, err)
+
+		result, err := secrets.GetSecrets(context.Background(), v2.SecretsGetSecretsOptions{})
+		require.NoError(t, err)
+		require.Len(t, result.Secrets, 1)
+		assert.Equal(t, []string{"token"}, result.Secrets[0].Keys)
+	})
+
+	t.Run("UpdateSecret returns an error for an unknown secret", func(t *testing.T) {
+		_, secrets := setup(t)
+		err := secrets.UpdateSecret(context.Background(), newSecret("unknown", "user"), v2.SecretsUpdateSecretOptions{})
+		requireErrorMessage(t, err, "secret unknown not found")
+	})
+
+	t.Run("DeleteSecret deletes the secret", func(t *testing.T) {
+		_, secrets := setup(t)
+		require.NoError(t, secrets.CreateSecret(context.Background(), newSecret("my-secret", "user"), v2.SecretsCreateSecretOptions{}))
+
+		err := secrets.DeleteSecret(context.Background(), "my-secret", defaultSecretScope, v2.SecretsDeleteSecretOptions{})
+		require.NoError(t, err)
+
+		result, err := secrets.GetSecrets(context.Background(), v2.SecretsGetSecretsOptions{})
+		require.NoError(t, err)
+		assert.Empty(t, result.Secrets)
+	})
+
+	t.Run("DeleteSecret returns an error for an unknown secret", func(t *testing.T) {
+		_, secrets := setup(t)
+		err := secrets.DeleteSecret(context.Background(), "unknown", defaultSecretScope, v2.SecretsDeleteSecretOptions{})
+		requireErrorMessage(t, err, "secret unknown not found")
+	})
+
+	t.Run("errors of the API are returned", func(t *testing.T) {
+		b, secrets := setup(t)
+		b.setFailing(true)
+
+		err := secrets.CreateSecret(context.Background(), newSecret("my-secret", "user"), v2.SecretsCreateSecretOptions{})
+		requireErrorMessage(t, err, "internal server error")
+
+		err = secrets.UpdateSecret(context.Background(), newSecret("my-secret", "user"), v2.SecretsUpdateSecretOptions{})
+		requireErrorMessage(t, err, "internal server error")
+
+		err = secrets.DeleteSecret(context.Background(), "my-secret", defaultSecretScope, v2.SecretsDeleteSecretOptions{})
+		requireErrorMessage(t, err, "internal server error")
+
+		_, err = secrets.GetSecrets(context.Background(), v2.SecretsGetSecretsOptions{})
+		requireErrorMessage(t, err, "internal server error")
+	})
+
+	t.Run("CreateSecret respects the context", func(t *testing.T) {
+		b, secrets := setup(t)
+		testContextCancellation(t, b, func(ctx context.Context) error {
+			return secrets.CreateSecret(ctx, newSecret("my-secret", "user"), v2.SecretsCreateSecretOptions{})
+		})
+	})
+
+	t.Run("GetSecrets respects the context", func(t *testing.T) {
+		b, secrets := setup(t)
+		testContextCancellation(t, b, func(ctx context.Context) error {
+			_, err := secrets.GetSecrets(ctx, v2.SecretsGetSecretsOptions{})
+			return err
+		})
+	})
+}
+
+// newSecret returns a secret of the default scope with the given keys
+func newSecret(name string, keys ...string) models.Secret {
+	scope := defaultSecretScope
+	data := map[string]string{}
+	for _, key := range keys {
+		data[key] = "value of " + key
+	}
+	return models.Secret{Data: data, SecretMetadata: models.SecretMetadata{Name: &name, Scope: &scope}}
+}
@@ -0,0 +1,136 @@
+package conformance
+
+import (
+	"context"
+	"testing"
+
+	"github.com/keptn/go-utils/pkg/api/models"
+	v2 "github.com/keptn/go-utils/pkg/api/utils/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// SequencesFactory creates the SequencesInterface under test, which needs to use the Keptn API at the given base URL
+type SequencesFactory func(t *testing.T, baseURL string) v2.SequencesInterface
+
+// RunSequencesInterfaceTests runs the conformance tests for v2.SequencesInterface against the implementations
+// created by newSequences. Every test creates a new implementation
+func RunSequencesInterfaceTests(t *testing.T, newSequences SequencesFactory) {
+	setup := func(t *testing.T) (*backend, v2.SequencesInterface) {
+		b := startBackend(t)
+		return b, newSequences(t, b.URL)
+	}
+	sequenceState := func(t *testing.T, sequences v2.SequencesInterface, keptnContext string) string {
+		t.Helper()
+		states, err := sequences.GetSequenceStates(context.Background(), v2.SequenceStateFilter{Project: projectName(1), KeptnContext: keptnContext}, v2.SequencesGetSequenceStatesOptions{})
+		require.NoError(t, err)
+		require.Len(t, states, 1)
+		return states[0].State
+	}
+
+	t.Run("GetSequenceStates returns the sequences of all pages", func(t *testing.T) {
+		_, sequences := setup(t)
+		states, err := sequences.GetSequenceStates(context.Background(), v2.SequenceStateFilter{Project: projectName(1)}, v2.SequencesGetSequenceStatesOptions{})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"context-1", "context-2", "context-3"}, keptnContexts(states))
+	})
+
+	t.Run("GetSequenceStates applies the filter", func(t *testing.T) {
+		_, sequences := setup(t)
+		states, err := sequences.GetSequenceStates(context.Background(), v2.SequenceStateFilter{Project: projectName(1), State: models.SequenceFinished}, v2.SequencesGetSequenceStatesOptions{})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"context-2"}, keptnContexts(states))
+
+		states, err = sequences.GetSequenceStates(context.Background(), v2.SequenceStateFilter{Project: projectName(1), Stage: SeededStages[1]}, v2.SequencesGetSequenceStatesOptions{})
+		require.NoError(t, err)
+		assert.Empty(t, states)
+
+		states, err = sequences.GetSequenceStates(context.Background(), v2.SequenceStateFilter{Project: projectName(2)}, v2.SequencesGetSequenceStatesOptions{})
+		require.NoError(t, err)
+		assert.Empty(t, states)
+	})
+
+	t.Run("GetSequenceStates returns an error for an unknown project", func(t *testing.T) {
+		_, sequences := setup(t)
+		_, err := sequences.GetSequenceStates(context.Background(), v2.SequenceStateFilter{Project: "unknown"}, v2.SequencesGetSequenceStatesOptions{})
+		requireErrorMessage(t, err, "project unknown not found")
+	})
+
+	t.Run("ControlSequence changes the state of the sequence", func(t *testing.T) {
+		_, sequences := setup(t)
+		params := v2.SequenceControlParams{Project: projectName(1), KeptnContext: "context-1", State: string(models.PauseSequence)}
+		require.NoError(t, sequences.ControlSequence(context.Background(), params, v2.SequencesControlSequenceOptions{}))
+		assert.Equal(t, models.SequencePaused, sequenceState(t, sequences, "context-1"))
+
+		params.State = string(models.ResumeSequence)
+		require.NoError(t, sequences.ControlSequence(context.Background(), params, v2.SequencesControlSequenceOptions{}))
+		assert.Equal(t, models.SequenceStartedState, sequenceState(t, sequences, "context-1"))
+	})
+
+	t.Run("ControlSequence returns an error for an unknown sequence", func(t *testing.T) {
+		_, sequences := setup(t)
+		params := v2.SequenceControlParams{Project: projectName(1), KeptnContext: "unknown", State: string(models.AbortSequence)}
+		err := sequences.ControlSequence(context.Background(), params, v2.SequencesControlSequenceOptions{})
+		requireErrorMessage(t, err, "sequence unknown not found")
+	})
+
+	t.Run("ControlSequence rejects an unknown state", func(t *testing.T) {
+		_, sequences := setup(t)
+		params := v2.SequenceControlParams{Project: projectName(1), KeptnContext: "context-1", State: "unknown"}
+		require.Error(t, sequences.ControlSequence(context.Background(), params, v2.SequencesControlSequenceOptions{}))
+		assert.Equal(t, models.SequenceStartedState, sequenceState(t, sequences, "context-1"))
+	})
+
+	t.Run("BulkControlSequences skips the sequences which are done", func(t *testing.T) {
+		_, sequences := setup(t)
+		results, err := sequences.BulkControlSequences(context.Background(), v2.SequenceStateFilter{Project: projectName(1)}, models.AbortSequence, v2.SequencesBulkControlSequencesOptions{})
+		require.NoError(t, err)
+		require.Len(t, results, SeededSequences)
+		for _, result := range results {
+			assert.NoError(t, result.Err)
+			assert.Equal(t, result.KeptnContext == "context-2", result.Skipped, "unexpected result for %s", result.KeptnContext)
+		}
+		assert.Equal(t, models.SequenceAborted, sequenceState(t, sequences, "context-1"))
+		assert.Equal(t, models.SequenceFinished, sequenceState(t, sequences, "context-2"))
+		assert.Equal(t, models.SequenceAborted, sequenceState(t, sequences, "context-3"))
+	})
+
+	t.Run("errors of the API are returned", func(t *testing.T) {
+		b, sequences := setup(t)
+		b.setFailing(true)
+
+		_, err := sequences.GetSequenceStates(context.Background(), v2.SequenceStateFilter{Project: projectName(1)}, v2.SequencesGetSequenceStatesOptions{})
+		requireErrorMessage(t, err, "internal server error")
+
+		params := v2.SequenceControlParams{Project: projectName(1), KeptnContext: "context-1", State: string(models.PauseSequence)}
+		err = sequences.ControlSequence(context.Background(), params, v2.SequencesControlSequenceOptions{})
+		requireErrorMessage(t, err, "internal server error")
+
+		_, err = sequences.BulkControlSequences(context.Background(), v2.SequenceStateFilter{Project: projectName(1)}, models.PauseSequence, v2.SequencesBulkControlSequencesOptions{})
+		requireErrorMessage(t, err, "internal server error")
+	})
+
+	t.Run("GetSequenceStates respects the context", func(t *testing.T) {
+		b, sequences := setup(t)
+		testContextCancellation(t, b, func(ctx context.Context) error {
+			_, err := sequences.GetSequenceStates(ctx, v2.SequenceStateFilter{Project: projectName(1)}, v2.SequencesGetSequenceStatesOptions{})
+			return err
+		})
+	})
+
+	t.Run("ControlSequence respects the context", func(t *testing.T) {
+		b, sequences := setup(t)
+		testContextCancellation(t, b, func(ctx context.Context) error {
+			params := v2.SequenceControlParams{Project: projectName(1), KeptnContext: "context-1", State: string(models.PauseSequence)}
+			return sequences.ControlSequence(ctx, params, v2.SequencesControlSequenceOptions{})
+		})
+	})
+}
+
+func keptnContexts(states []models.SequenceState) []string {
+	contexts := []string{}
+	for _, state := range states {
+		contexts = append(contexts, state.Shkeptncontext)
+	}
+	return contexts
+}
@@ -0,0 +1,112 @@
+package conformance
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	v2 "github.com/keptn/go-utils/pkg/api/utils/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// ServicesFactory creates the ServicesInterface under test, which needs to use the Keptn API at the given base URL
+type ServicesFactory func(t *testing.T, baseURL string) v2.ServicesInterface
+
+// RunServicesInterfaceTests runs the conformance tests for v2.ServicesInterface against the implementations
+// created by newServices. Every test creates a new implementation
+func RunServicesInterfaceTests(t *testing.T, newServices ServicesFactory) {
+	setup := func(t *testing.T) (*backend, v2.ServicesInterface) {
+		b := startBackend(t)
+		return b, newServices(t, b.URL)
+	}
+	stage := SeededStages[0]
+
+	t.Run("GetAllServices returns the services of all pages", func(t *testing.T) {
+		_, services := setup(t)
+		result, err := services.GetAllServices(context.Background(), projectName(1), stage, v2.ServicesGetAllServicesOptions{})
+		require.NoError(t, err)
+		require.Len(t, result, SeededServices)
+		for i, service := range result {
+			assert.Equal(t, serviceName(i+1), service.ServiceName)
+		}
+	})
+
+	t.Run("GetService returns the service", func(t *testing.T) {
+		_, services := setup(t)
+		service, err := services.GetService(context.Background(), projectName(1), stage, serviceName(2), v2.ServicesGetServiceOptions{})
+		require.NoError(t, err)
+		require.NotNil(t, service)
+		assert.Equal(t, serviceName(2), service.ServiceName)
+	})
+
+	t.Run("GetService returns an error for an unknown service", func(t *testing.T) {
+		_, services := setup(t)
+		service, err := services.GetService(context.Background(), projectName(1), stage, "unknown", v2.ServicesGetServiceOptions{})
+		assert.Nil(t, service)
+		requireErrorMessage(t, err, "service unknown not found")
+	})
+
+	t.Run("CreateServiceInStage creates the service", func(t *testing.T) {
+		_, services := setup(t)
+		eventContext, mErr := services.CreateServiceInStage(context.Background(), projectName(1), stage, "new-service", v2.ServicesCreateServiceInStageOptions{})
+		require.Nil(t, mErr)
+		requireKeptnContext(t, eventContext)
+
+		service, err := services.GetService(context.Background(), projectName(1), stage, "new-service", v2.ServicesGetServiceOptions{})
+		require.NoError(t, err)
+		assert.Equal(t, "new-service", service.ServiceName)
+	})
+
+	t.Run("CreateServiceInStage returns 409 for an existing service", func(t *testing.T) {
+		_, services := setup(t)
+		eventContext, mErr := services.CreateServiceInStage(context.Background(), projectName(1), stage, serviceName(1), v2.ServicesCreateServiceInStageOptions{})
+		assert.Nil(t, eventContext)
+		requireAPIError(t, mErr, http.StatusConflict, "service service-1 already exists")
+	})
+
+	t.Run("DeleteServiceFromStage deletes the service", func(t *testing.T) {
+		_, services := setup(t)
+		eventContext, mErr := services.DeleteServiceFromStage(context.Background(), projectName(1), stage, serviceName(1), v2.ServicesDeleteServiceFromStageOptions{})
+		require.Nil(t, mErr)
+		requireKeptnContext(t, eventContext)
+
+		result, err := services.GetAllServices(context.Background(), projectName(1), stage, v2.ServicesGetAllServicesOptions{})
+		require.NoError(t, err)
+		assert.Len(t, result, SeededServices-1)
+	})
+
+	t.Run("DeleteServiceFromStage returns 404 for an unknown service", func(t *testing.T) {
+		_, services := setup(t)
+		eventContext, mErr := services.DeleteServiceFromStage(context.Background(), projectName(1), stage, "unknown", v2.ServicesDeleteServiceFromStageOptions{})
+		assert.Nil(t, eventContext)
+		requireAPIError(t, mErr, http.StatusNotFound, "service unknown not found")
+	})
+
+	t.Run("errors of the API are returned", func(t *testing.T) {
+		b, services := setup(t)
+		b.setFailing(true)
+
+		_, err := services.GetAllServices(context.Background(), projectName(1), stage, v2.ServicesGetAllServicesOptions{})
+		requireErrorMessage(t, err, "internal server error")
+
+		_, err = services.GetService(context.Background(), projectName(1), stage, serviceName(1), v2.ServicesGetServiceOptions{})
+		requireErrorMessage(t, err, "internal server error")
+	})
+
+	t.Run("GetAllServices respects the context", func(t *testing.T) {
+		b, services := setup(t)
+		testContextCancellation(t, b, func(ctx context.Context) error {
+			_, err := services.GetAllServices(ctx, projectName(1), stage, v2.ServicesGetAllServicesOptions{})
+			return err
+		})
+	})
+
+	t.Run("GetService respects the context", func(t *testing.T) {
+		b, services := setup(t)
+		testContextCancellation(t, b, func(ctx context.Context) error {
+			_, err := services.GetService(ctx, projectName(1), stage, serviceName(1), v2.ServicesGetServiceOptions{})
+			return err
+		})
+	})
+}
@@ -0,0 +1,69 @@
+package conformance
+
+import (
+	"context"
+	"testing"
+
+	v2 "github.com/keptn/go-utils/pkg/api/utils/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// ShipyardControlFactory creates the ShipyardControlInterface under test, which needs to use the Keptn API at the given base URL
+type ShipyardControlFactory func(t *testing.T, baseURL string) v2.ShipyardControlInterface
+
+// RunShipyardControlInterfaceTests runs the conformance tests for v2.ShipyardControlInterface against the implementations
+// created by newShipyardControl. Every test creates a new implementation.
+// The backend regards all seeded events as open triggered events
+func RunShipyardControlInterfaceTests(t *testing.T, newShipyardControl ShipyardControlFactory) {
+	setup := func(t *testing.T) (*backend, v2.ShipyardControlInterface) {
+		b := startBackend(t)
+		return b, newShipyardControl(t, b.URL)
+	}
+
+	t.Run("GetOpenTriggeredEvents returns the events of all pages", func(t *testing.T) {
+		_, shipyardControl := setup(t)
+		result, err := shipyardControl.GetOpenTriggeredEvents(context.Background(), v2.EventFilter{EventType: TriggeredEventType}, v2.ShipyardControlGetOpenTriggeredEventsOptions{})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"event-1", "event-2", "event-3", "event-4", "event-5"}, eventIDs(result))
+	})
+
+	t.Run("GetOpenTriggeredEvents returns the requested number of pages", func(t *testing.T) {
+		_, shipyardControl := setup(t)
+		result, err := shipyardControl.GetOpenTriggeredEvents(context.Background(), v2.EventFilter{EventType: TriggeredEventType, NumberOfPages: 1}, v2.ShipyardControlGetOpenTriggeredEventsOptions{})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"event-1", "event-2"}, eventIDs(result))
+	})
+
+	t.Run("GetOpenTriggeredEvents applies the filter", func(t *testing.T) {
+		_, shipyardControl := setup(t)
+		filter := v2.EventFilter{EventType: TriggeredEventType, Project: projectName(1), Stage: SeededStages[0], Service: serviceName(1), EventID: "event-3"}
+		result, err := shipyardControl.GetOpenTriggeredEvents(context.Background(), filter, v2.ShipyardControlGetOpenTriggeredEventsOptions{})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"event-3"}, eventIDs(result))
+
+		result, err = shipyardControl.GetOpenTriggeredEvents(context.Background(), v2.EventFilter{EventType: TriggeredEventType, Project: projectName(2)}, v2.ShipyardControlGetOpenTriggeredEventsOptions{})
+		require.NoError(t, err)
+		assert.Empty(t, result)
+
+		result, err = shipyardControl.GetOpenTriggeredEvents(context.Background(), v2.EventFilter{EventType: "sh.keptn.event.deployment.triggered"}, v2.ShipyardControlGetOpenTriggeredEventsOptions{})
+		require.NoError(t, err)
+		assert.Empty(t, result)
+	})
+
+	t.Run("errors of the API are returned", func(t *testing.T) {
+		b, shipyardControl := setup(t)
+		b.setFailing(true)
+
+		_, err := shipyardControl.GetOpenTriggeredEvents(context.Background(), v2.EventFilter{EventType: TriggeredEventType}, v2.ShipyardControlGetOpenTriggeredEventsOptions{})
+		requireErrorMessage(t, err, "internal server error")
+	})
+
+	t.Run("GetOpenTriggeredEvents respects the context", func(t *testing.T) {
+		b, shipyardControl := setup(t)
+		testContextCancellation(t, b, func(ctx context.Context) error {
+			_, err := shipyardControl.GetOpenTriggeredEvents(ctx, v2.EventFilter{EventType: TriggeredEventType}, v2.ShipyardControlGetOpenTriggeredEventsOptions{})
+			return err
+		})
+	})
+}
@@ -0,0 +1,85 @@
+package conformance
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	v2 "github.com/keptn/go-utils/pkg/api/utils/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// StagesFactory creates the StagesInterface under test, which needs to use the Keptn API at the given base URL
+type StagesFactory func(t *testing.T, baseURL string) v2.StagesInterface
+
+// RunStagesInterfaceTests runs the conformance tests for v2.StagesInterface against the implementations
+// created by newStages. Every test creates a new implementation
+func RunStagesInterfaceTests(t *testing.T, newStages StagesFactory) {
+	setup := func(t *testing.T) (*backend, v2.StagesInterface) {
+		b := startBackend(t)
+		return b, newStages(t, b.URL)
+	}
+
+	t.Run("GetAllStages returns the stages of all pages", func(t *testing.T) {
+		_, stages := setup(t)
+		result, err := stages.GetAllStages(context.Background(), projectName(1), v2.StagesGetAllStagesOptions{})
+		require.NoError(t, err)
+		require.Len(t, result, len(SeededStages))
+		for i, stage := range result {
+			assert.Equal(t, SeededStages[i], stage.StageName)
+		}
+	})
+
+	t.Run("GetAllStages returns an error for an unknown project", func(t *testing.T) {
+		_, stages := setup(t)
+		_, err := stages.GetAllStages(context.Background(), "unknown", v2.StagesGetAllStagesOptions{})
+		requireErrorMessage(t, err, "project unknown not found")
+	})
+
+	t.Run("CreateStage creates the stage", func(t *testing.T) {
+		_, stages := setup(t)
+		eventContext, mErr := stages.CreateStage(context.Background(), projectName(1), "hardening", v2.StagesCreateStageOptions{})
+		require.Nil(t, mErr)
+		requireKeptnContext(t, eventContext)
+
+		result, err := stages.GetAllStages(context.Background(), projectName(1), v2.StagesGetAllStagesOptions{})
+		require.NoError(t, err)
+		require.Len(t, result, len(SeededStages)+1)
+		assert.Equal(t, "hardening", result[len(SeededStages)].StageName)
+	})
+
+	t.Run("CreateStage returns 409 for an existing stage", func(t *testing.T) {
+		_, stages := setup(t)
+		eventContext, mErr := stages.CreateStage(context.Background(), projectName(1), SeededStages[0], v2.StagesCreateStageOptions{})
+		assert.Nil(t, eventContext)
+		requireAPIError(t, mErr, http.StatusConflict, "already exists")
+	})
+
+	t.Run("errors of the API are returned", func(t *testing.T) {
+		b, stages := setup(t)
+		b.setFailing(true)
+
+		_, err := stages.GetAllStages(context.Background(), projectName(1), v2.StagesGetAllStagesOptions{})
+		requireErrorMessage(t, err, "internal server error")
+
+		_, mErr := stages.CreateStage(context.Background(), projectName(1), "hardening", v2.StagesCreateStageOptions{})
+		requireAPIError(t, mErr, http.StatusInternalServerError, "internal server error")
+	})
+
+	t.Run("GetAllStages respects the context", func(t *testing.T) {
+		b, stages := setup(t)
+		testContextCancellation(t, b, func(ctx context.Context) error {
+			_, err := stages.GetAllStages(ctx, projectName(1), v2.StagesGetAllStagesOptions{})
+			return err
+		})
+	})
+
+	t.Run("CreateStage respects the context", func(t *testing.T) {
+		b, stages := setup(t)
+		testContextCancellation(t, b, func(ctx context.Context) error {
+			_, mErr := stages.CreateStage(ctx, projectName(1), "hardening", v2.StagesCreateStageOptions{})
+			return toError(mErr)
+		})
+	})
+}
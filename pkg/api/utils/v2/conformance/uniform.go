@@ -0,0 +1,148 @@
+package conformance
+
+import (
+	"context"
+	"testing"
+
+	"github.com/keptn/go-utils/pkg/api/models"
+	v2 "github.com/keptn/go-utils/pkg/api/utils/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// UniformFactory creates the UniformInterface under test, which needs to use the Keptn API at the given base URL
+type UniformFactory func(t *testing.T, baseURL string) v2.UniformInterface
+
+// RunUniformInterfaceTests runs the conformance tests for v2.UniformInterface against the implementations
+// created by newUniform. Every test creates a new implementation
+func RunUniformInterfaceTests(t *testing.T, newUniform UniformFactory) {
+	setup := func(t *testing.T) (*backend, v2.UniformInterface) {
+		b := startBackend(t)
+		return b, newUniform(t, b.URL)
+	}
+	register := func(t *testing.T, uniform v2.UniformInterface) string {
+		t.Helper()
+		id, err := uniform.RegisterIntegration(context.Background(), newIntegration("my-integration"), v2.UniformRegisterIntegrationOptions{})
+		require.NoError(t, err)
+		require.NotEmpty(t, id)
+		return id
+	}
+
+	t.Run("RegisterIntegration registers the integration", func(t *testing.T) {
+		_, uniform := setup(t)
+		id := register(t, uniform)
+
+		registrations, err := uniform.GetRegistrations(context.Background(), v2.UniformGetRegistrationsOptions{})
+		require.NoError(t, err)
+		require.Len(t, registrations, 1)
+		assert.Equal(t, id, registrations[0].ID)
+		assert.Equal(t, "my-integration", registrations[0].Name)
+	})
+
+	t.Run("RegisterIntegration keeps the ID of a registered integration", func(t *testing.T) {
+		_, uniform := setup(t)
+		id := register(t, uniform)
+		assert.Equal(t, id, register(t, uniform))
+
+		registrations, err := uniform.GetRegistrations(context.Background(), v2.UniformGetRegistrationsOptions{})
+		require.NoError(t, err)
+		assert.Len(t, registrations, 1)
+	})
+
+	t.Run("Ping returns the integration", func(t *testing.T) {
+		_, uniform := setup(t)
+		id := register(t, uniform)
+
+		integration, err := uniform.Ping(context.Background(), id, v2.UniformPingOptions{})
+		require.NoError(t, err)
+		assert.Equal(t, id, integration.ID)
+		assert.False(t, integration.MetaData.LastSeen.IsZero())
+	})
+
+	t.Run("Ping returns an error for an unknown integration", func(t *testing.T) {
+		_, uniform := setup(t)
+		_, err := uniform.Ping(context.Background(), "unknown", v2.UniformPingOptions{})
+		requireErrorMessage(t, err, "integration unknown not found")
+	})
+
+	t.Run("CreateSubscription adds the subscription to the integration", func(t *testing.T) {
+		_, uniform := setup(t)
+		id := register(t, uniform)
+
+		subscription := models.EventSubscription{Event: TriggeredEventType, Filter: models.EventSubscriptionFilter{Projects: []string{projectName(1)}}}
+		subscriptionID, err := uniform.CreateSubscription(context.Background(), id, subscription, v2.UniformCreateSubscriptionOptions{})
+		require.NoError(t, err)
+		require.NotEmpty(t, subscriptionID)
+
+		registrations, err := uniform.GetRegistrations(context.Background(), v2.UniformGetRegistrationsOptions{})
+		require.NoError(t, err)
+		require.Len(t, registrations, 1)
+		require.Len(t, registrations[0].Subscriptions, 1)
+		assert.Equal(t, subscriptionID, registrations[0].Subscriptions[0].ID)
+		assert.Equal(t, TriggeredEventType, registrations[0].Subscriptions[0].Event)
+	})
+
+	t.Run("CreateSubscription returns an error for an unknown integration", func(t *testing.T) {
+		_, uniform := setup(t)
+		_, err := uniform.CreateSubscription(context.Background(), "unknown", models.EventSubscription{Event: TriggeredEventType}, v2.UniformCreateSubscriptionOptions{})
+		requireErrorMessage(t, err, "integration unknown not found")
+	})
+
+	t.Run("UnregisterIntegration removes the integration", func(t *testing.T) {
+		_, uniform := setup(t)
+		id := register(t, uniform)
+
+		require.NoError(t, uniform.UnregisterIntegration(context.Background(), id, v2.UniformUnregisterIntegrationOptions{}))
+
+		registrations, err := uniform.GetRegistrations(context.Background(), v2.UniformGetRegistrationsOptions{})
+		require.NoError(t, err)
+		assert.Empty(t, registrations)
+	})
+
+	t.Run("UnregisterIntegration returns an error for an unknown integration", func(t *testing.T) {
+		_, uniform := setup(t)
+		err := uniform.UnregisterIntegration(context.Background(), "unknown", v2.UniformUnregisterIntegrationOptions{})
+		requireErrorMessage(t, err, "integration unknown not found")
+	})
+
+	t.Run("errors of the API are returned", func(t *testing.T) {
+		b, uniform := setup(t)
+		b.setFailing(true)
+
+		_, err := uniform.RegisterIntegration(context.Background(), newIntegration("my-integration"), v2.UniformRegisterIntegrationOptions{})
+		requireErrorMessage(t, err, "internal server error")
+
+		_, err = uniform.Ping(context.Background(), "my-integration-id", v2.UniformPingOptions{})
+		requireErrorMessage(t, err, "internal server error")
+
+		_, err = uniform.GetRegistrations(context.Background(), v2.UniformGetRegistrationsOptions{})
+		requireErrorMessage(t, err, "internal server error")
+	})
+
+	t.Run("RegisterIntegration respects the context", func(t *testing.T) {
+		b, uniform := setup(t)
+		testContextCancellation(t, b, func(ctx context.Context) error {
+			_, err := uniform.RegisterIntegration(ctx, newIntegration("my-integration"), v2.UniformRegisterIntegrationOptions{})
+			return err
+		})
+	})
+
+	t.Run("GetRegistrations respects the context", func(t *testing.T) {
+		b, uniform := setup(t)
+		testContextCancellation(t, b, func(ctx context.Context) error {
+			_, err := uniform.GetRegistrations(ctx, v2.UniformGetRegistrationsOptions{})
+			return err
+		})
+	})
+}
+
+// newIntegration returns an integration with the given name, which runs in the namespace keptn on the node node-1
+func newIntegration(name string) models.Integration {
+	return models.Integration{
+		Name: name,
+		MetaData: models.MetaData{
+			Hostname:           "node-1",
+			KubernetesMetaData: models.KubernetesMetaData{Namespace: "keptn"},
+		},
+	}
+}
@@ -0,0 +1,194 @@
+package v2
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/keptn/go-utils/pkg/api/models"
+	"github.com/keptn/go-utils/pkg/common/sliceutils"
+)
+
+// DriftKind describes how an entity differs between two Keptn installations
+type DriftKind string
+
+const (
+	// DriftOnlyInSource means that the entity only exists in the source installation
+	DriftOnlyInSource DriftKind = "onlyInSource"
+	// DriftOnlyInTarget means that the entity only exists in the target installation
+	DriftOnlyInTarget DriftKind = "onlyInTarget"
+	// DriftModified means that the entity exists in both installations, but with different content
+	DriftModified DriftKind = "modified"
+)
+
+// DriftEntry is a single difference between two Keptn installations.
+// Depending on the kind of the entity, Stage, Service and Resource are empty
+type DriftEntry struct {
+	Kind     DriftKind `json:"kind"`
+	Project  string    `json:"project"`
+	Stage    string    `json:"stage,omitempty"`
+	Service  string    `json:"service,omitempty"`
+	Resource string    `json:"resource,omitempty"`
+}
+
+// Path returns the path of the entity, e.g. my-project/dev/my-service/helm/chart.tgz
+func (e DriftEntry) Path() string {
+	parts := []string{e.Project}
+	for _, part := range []string{e.Stage, e.Service, strings.TrimPrefix(e.Resource, "/")} {
+		if part != "" {
+			parts = append(parts, part)
+		}
+	}
+	return strings.Join(parts, "/")
+}
+
+// DriftReport lists the differences between two Keptn installations, sorted by path
+type DriftReport struct {
+	Entries []DriftEntry `json:"entries"`
+}
+
+// HasDrift returns whether the installations differ
+func (r DriftReport) HasDrift() bool {
+	return len(r.Entries) > 0
+}
+
+// DriftOptions configures CompareInstallations
+type DriftOptions struct {
+	// Projects limits the comparison to the given projects. If empty, all projects are compared
+	Projects []string
+	// SkipResources only compares projects, stages and services, but not their resources
+	SkipResources bool
+}
+
+// driftKey identifies an entity of a Keptn installation
+type driftKey struct {
+	project  string
+	stage    string
+	service  string
+	resource string
+}
+
+// projectResourcesLister is implemented by ResourceHandler, which lists the resources of projects as well
+type projectResourcesLister interface {
+	GetAllProjectResources(ctx context.Context, project string, opts ResourcesGetAllProjectResourcesOptions) ([]*models.Resource, error)
+}
+
+// CompareInstallations compares the projects, stages, services and resources of two Keptn installations,
+// e.g. the staging and the production control plane, and returns the differences.
+// Resources are compared by their checksums. If the configuration-service does not list the checksum of a resource,
+// the resource is retrieved to compute it. Resources of projects are only compared if the ResourcesInterface
+// of the installation lists them, like ResourceHandler does
+func CompareInstallations(ctx context.Context, source KeptnInterface, target KeptnInterface, opts DriftOptions) (*DriftReport, error) {
+	sourceEntities, err := collectEntities(ctx, source, opts)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read source installation: %w", err)
+	}
+	targetEntities, err := collectEntities(ctx, target, opts)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read target installation: %w", err)
+	}
+
+	report := &DriftReport{Entries: []DriftEntry{}}
+	for key, sourceContent := range sourceEntities {
+		targetContent, ok := targetEntities[key]
+		switch {
+		case !ok:
+			report.Entries = append(report.Entries, newDriftEntry(DriftOnlyInSource, key))
+		case sourceContent != targetContent:
+			report.Entries = append(report.Entries, newDriftEntry(DriftModified, key))
+		}
+	}
+	for key := range targetEntities {
+		if _, ok := sourceEntities[key]; !ok {
+			report.Entries = append(report.Entries, newDriftEntry(DriftOnlyInTarget, key))
+		}
+	}
+	sort.Slice(report.Entries, func(i, j int) bool {
+		if report.Entries[i].Path() != report.Entries[j].Path() {
+			return report.Entries[i].Path() < report.Entries[j].Path()
+		}
+		return report.Entries[i].Kind < report.Entries[j].Kind
+	})
+	return report, nil
+}
+
+func newDriftEntry(kind DriftKind, key driftKey) DriftEntry {
+	return DriftEntry{Kind: kind, Project: key.project, Stage: key.stage, Service: key.service, Resource: key.resource}
+}
+
+// collectEntities returns all entities of an installation, mapped to their checksum.
+// Only resources have a checksum, all other entities are mapped to an empty string
+func collectEntities(ctx context.Context, api KeptnInterface, opts DriftOptions) (map[driftKey]string, error) {
+	projects, err := api.Projects().GetAllProjects(ctx, ProjectsGetAllProjectsOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	entities := map[driftKey]string{}
+	for _, project := range projects {
+		if len(opts.Projects) > 0 && !sliceutils.ContainsStr(opts.Projects, project.ProjectName) {
+			continue
+		}
+		projectKey := driftKey{project: project.ProjectName}
+		entities[projectKey] = ""
+		if lister, ok := api.Resources().(projectResourcesLister); ok && !opts.SkipResources {
+			resources, err := lister.GetAllProjectResources(ctx, project.ProjectName, ResourcesGetAllProjectResourcesOptions{})
+			if err != nil {
+				return nil, fmt.Errorf("unable to get resources of project %s: %w", project.ProjectName, err)
+			}
+			if err := addResources(ctx, api, entities, projectKey, resources); err != nil {
+				return nil, err
+			}
+		}
+		for _, stage := range project.Stages {
+			stageKey := driftKey{project: project.ProjectName, stage: stage.StageName}
+			entities[stageKey] = ""
+			if !opts.SkipResources {
+				resources, err := api.Resources().GetAllStageResources(ctx, project.ProjectName, stage.StageName, ResourcesGetAllStageResourcesOptions{})
+				if err != nil {
+					return nil, fmt.Errorf("unable to get resources of stage %s of project %s: %w", stage.StageName, project.ProjectName, err)
+				}
+				if err := addResources(ctx, api, entities, stageKey, resources); err != nil {
+					return nil, err
+				}
+			}
+			for _, service := range stage.Services {
+				serviceKey := driftKey{project: project.ProjectName, stage: stage.StageName, service: service.ServiceName}
+				entities[serviceKey] = ""
+				if !opts.SkipResources {
+					resources, err := api.Resources().GetAllServiceResources(ctx, project.ProjectName, stage.StageName, service.ServiceName, ResourcesGetAllServiceResourcesOptions{})
+					if err != nil {
+						return nil, fmt.Errorf("unable to get resources of service %s in stage %s of project %s: %w", service.ServiceName, stage.StageName, project.ProjectName, err)
+					}
+					if err := addResources(ctx, api, entities, serviceKey, resources); err != nil {
+						return nil, err
+					}
+				}
+			}
+		}
+	}
+	return entities, nil
+}
+
+// addResources maps the resources of the given parent to their checksums
+func addResources(ctx context.Context, api KeptnInterface, entities map[driftKey]string, parent driftKey, resources []*models.Resource) error {
+	for _, resource := range resources {
+		if resource == nil || resource.ResourceURI == nil {
+			continue
+		}
+		key := parent
+		key.resource = *resource.ResourceURI
+		checksum := resource.Checksum
+		if checksum == "" {
+			scope := NewResourceScope().Project(key.project).Stage(key.stage).Service(key.service).Resource(key.resource)
+			retrieved, err := api.Resources().GetResource(ctx, *scope, ResourcesGetResourceOptions{})
+			if err != nil {
+				return fmt.Errorf("unable to get resource %s: %w", key.resource, err)
+			}
+			checksum = ResourceChecksum(retrieved.ResourceContent)
+		}
+		entities[key] = strings.ToLower(checksum)
+	}
+	return nil
+}
@@ -0,0 +1,106 @@
+package v2
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/keptn/go-utils/pkg/api/models"
+	"github.com/keptn/go-utils/pkg/common/strutils"
+	"github.com/stretchr/testify/require"
+)
+
+// newInstallationServer returns a server serving the given projects and the resources of the projects, stages and services,
+// which are keyed by the path below /v1/project, e.g. my-project/stage/dev/resource. The listed resources are served
+// by their URI as well, e.g. at my-project/stage/dev/resource/%2Fslo.yaml
+func newInstallationServer(projects []*models.Project, resources map[string][]*models.Resource) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if strings.HasSuffix(r.URL.Path, "/controlPlane"+v1ProjectPath) {
+			json.NewEncoder(w).Encode(models.Projects{Projects: projects})
+			return
+		}
+		path := strings.TrimPrefix(r.URL.Path, "/configuration-service"+v1ProjectPath+"/")
+		if parts := strings.SplitN(path, pathToResource+"/", 2); len(parts) == 2 {
+			for _, resource := range resources[parts[0]+pathToResource] {
+				if *resource.ResourceURI == parts[1] {
+					json.NewEncoder(w).Encode(resource)
+					return
+				}
+			}
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		json.NewEncoder(w).Encode(models.Resources{Resources: resources[path]})
+	}))
+}
+
+func newResourceWithContent(uri string, content string) *models.Resource {
+	return &models.Resource{ResourceURI: strutils.Stringp(uri), ResourceContent: content}
+}
+
+func newResourceWithChecksum(uri string, checksum string) *models.Resource {
+	return &models.Resource{ResourceURI: strutils.Stringp(uri), Checksum: checksum}
+}
+
+func TestCompareInstallations(t *testing.T) {
+	source := newInstallationServer(
+		[]*models.Project{
+			{ProjectName: "my-project", Stages: []*models.Stage{
+				{StageName: "dev", Services: []*models.Service{{ServiceName: "carts"}, {ServiceName: "orders"}}},
+				{StageName: "prod", Services: []*models.Service{{ServiceName: "carts"}}},
+			}},
+			{ProjectName: "other-project"},
+		},
+		map[string][]*models.Resource{
+			"my-project/resource":                         {newResourceWithContent("/shipyard.yaml", "c2hpcHlhcmQ6IHYx")},
+			"my-project/stage/dev/resource":               {newResourceWithContent("/slo.yaml", "c2xvOiB2MQ==")},
+			"my-project/stage/dev/service/carts/resource": {newResourceWithContent("/helm/carts.tgz", "Y2hhcnQ="), newResourceWithChecksum("/sli.yaml", ResourceChecksum("sli"))},
+		},
+	)
+	defer source.Close()
+	target := newInstallationServer(
+		[]*models.Project{
+			{ProjectName: "my-project", Stages: []*models.Stage{
+				{StageName: "dev", Services: []*models.Service{{ServiceName: "carts"}}},
+				{StageName: "prod", Services: []*models.Service{{ServiceName: "carts"}, {ServiceName: "payment"}}},
+			}},
+		},
+		map[string][]*models.Resource{
+			"my-project/resource":                         {newResourceWithContent("/shipyard.yaml", "c2hpcHlhcmQ6IHYy")},
+			"my-project/stage/dev/resource":               {newResourceWithContent("/slo.yaml", "c2xvOiB2Mg==")},
+			"my-project/stage/dev/service/carts/resource": {newResourceWithContent("/helm/carts.tgz", "Y2hhcnQ="), newResourceWithChecksum("/sli.yaml", strings.ToUpper(ResourceChecksum("sli")))},
+		},
+	)
+	defer target.Close()
+
+	sourceAPI, err := New(source.URL)
+	require.Nil(t, err)
+	targetAPI, err := New(target.URL)
+	require.Nil(t, err)
+
+	report, err := CompareInstallations(context.TODO(), sourceAPI, targetAPI, DriftOptions{})
+	require.Nil(t, err)
+	require.True(t, report.HasDrift())
+	require.Equal(t, []DriftEntry{
+		{Kind: DriftOnlyInSource, Project: "my-project", Stage: "dev", Service: "orders"},
+		{Kind: DriftModified, Project: "my-project", Stage: "dev", Resource: "/slo.yaml"},
+		{Kind: DriftOnlyInTarget, Project: "my-project", Stage: "prod", Service: "payment"},
+		{Kind: DriftModified, Project: "my-project", Resource: "/shipyard.yaml"},
+		{Kind: DriftOnlyInSource, Project: "other-project"},
+	}, report.Entries)
+	require.Equal(t, "my-project/dev/slo.yaml", report.Entries[1].Path())
+
+	report, err = CompareInstallations(context.TODO(), sourceAPI, targetAPI, DriftOptions{Projects: []string{"my-project"}, SkipResources: true})
+	require.Nil(t, err)
+	require.Equal(t, []DriftEntry{
+		{Kind: DriftOnlyInSource, Project: "my-project", Stage: "dev", Service: "orders"},
+		{Kind: DriftOnlyInTarget, Project: "my-project", Stage: "prod", Service: "payment"},
+	}, report.Entries)
+
+	report, err = CompareInstallations(context.TODO(), sourceAPI, sourceAPI, DriftOptions{})
+	require.Nil(t, err)
+	require.False(t, report.HasDrift())
+}
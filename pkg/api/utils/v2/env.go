@@ -0,0 +1,48 @@
+package v2
+
+import (
+	"fmt"
+	"net/url"
+	"os"
+)
+
+// Environment variables NewAPISetFromEnv reads the connection to the Keptn API from
+const (
+	EnvVarKeptnAPIEndpoint = "KEPTN_API_ENDPOINT"
+	EnvVarKeptnAPIToken    = "KEPTN_API_TOKEN"
+)
+
+// EndpointFromEnv returns the Keptn API endpoint set via KEPTN_API_ENDPOINT as well as its scheme.
+// An error is returned if the endpoint is not set or is not an absolute http(s) URL
+func EndpointFromEnv() (string, string, error) {
+	endpoint := os.Getenv(EnvVarKeptnAPIEndpoint)
+	if endpoint == "" {
+		return "", "", fmt.Errorf("environment variable %s is not set", EnvVarKeptnAPIEndpoint)
+	}
+	parsed, err := url.ParseRequestURI(endpoint)
+	if err != nil {
+		return "", "", fmt.Errorf("environment variable %s does not contain a valid URL: %w", EnvVarKeptnAPIEndpoint, err)
+	}
+	if parsed.Scheme != "http" && parsed.Scheme != "https" {
+		return "", "", fmt.Errorf("environment variable %s must be an http or https URL, e.g. http://keptn.example.com/api, but is %s", EnvVarKeptnAPIEndpoint, endpoint)
+	}
+	if parsed.Host == "" {
+		return "", "", fmt.Errorf("environment variable %s does not contain a host: %s", EnvVarKeptnAPIEndpoint, endpoint)
+	}
+	return endpoint, parsed.Scheme, nil
+}
+
+// NewAPISetFromEnv creates a new APISet for the Keptn API set via KEPTN_API_ENDPOINT, authenticating
+// with the token set via KEPTN_API_TOKEN. The given options are applied after the ones derived
+// from the environment, so they can be used to override them
+func NewAPISetFromEnv(options ...func(*APISet)) (*APISet, error) {
+	endpoint, scheme, err := EndpointFromEnv()
+	if err != nil {
+		return nil, fmt.Errorf("unable to create apiset: %w", err)
+	}
+	envOptions := []func(*APISet){WithScheme(scheme)}
+	if token := os.Getenv(EnvVarKeptnAPIToken); token != "" {
+		envOptions = append(envOptions, WithAuthToken(token))
+	}
+	return New(endpoint, append(envOptions, options...)...)
+}
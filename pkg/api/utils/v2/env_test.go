@@ -0,0 +1,56 @@
+package v2
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestEndpointFromEnv(t *testing.T) {
+	tests := []struct {
+		endpoint   string
+		wantScheme string
+		wantErr    bool
+	}{
+		{endpoint: "http://keptn.example.com/api", wantScheme: "http"},
+		{endpoint: "https://keptn.example.com/api", wantScheme: "https"},
+		{endpoint: "", wantErr: true},
+		{endpoint: "keptn.example.com/api", wantErr: true},
+		{endpoint: "ftp://keptn.example.com/api", wantErr: true},
+		{endpoint: "http:///api", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.endpoint, func(t *testing.T) {
+			t.Setenv(EnvVarKeptnAPIEndpoint, tt.endpoint)
+			endpoint, scheme, err := EndpointFromEnv()
+			if tt.wantErr {
+				require.NotNil(t, err)
+				require.Contains(t, err.Error(), EnvVarKeptnAPIEndpoint)
+				return
+			}
+			require.Nil(t, err)
+			require.Equal(t, tt.endpoint, endpoint)
+			require.Equal(t, tt.wantScheme, scheme)
+		})
+	}
+}
+
+func TestNewAPISetFromEnv(t *testing.T) {
+	t.Setenv(EnvVarKeptnAPIEndpoint, "https://keptn.example.com/api")
+	t.Setenv(EnvVarKeptnAPIToken, "my-token")
+
+	apiSet, err := NewAPISetFromEnv()
+	require.Nil(t, err)
+	require.Equal(t, "keptn.example.com", apiSet.Endpoint().Host)
+	require.Equal(t, "https", apiSet.scheme)
+	require.Equal(t, "my-token", apiSet.Token())
+
+	apiSet, err = NewAPISetFromEnv(WithAuthToken("other-token"), WithScheme("http"))
+	require.Nil(t, err)
+	require.Equal(t, "other-token", apiSet.Token())
+	require.Equal(t, "http", apiSet.scheme)
+
+	t.Setenv(EnvVarKeptnAPIEndpoint, "")
+	_, err = NewAPISetFromEnv()
+	require.NotNil(t, err)
+}
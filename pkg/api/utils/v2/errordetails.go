@@ -0,0 +1,105 @@
+package v2
+
+import (
+	"fmt"
+	"unicode/utf8"
+
+	"github.com/keptn/go-utils/pkg/api/models"
+)
+
+// ErrorVerbosity controls how much of the body of a failed response is carried in errors
+type ErrorVerbosity string
+
+const (
+	// ErrorVerbosityMinimal does not embed response bodies in error messages
+	ErrorVerbosityMinimal ErrorVerbosity = "minimal"
+	// ErrorVerbosityNormal embeds response bodies in error messages, truncated to the maximum body size
+	ErrorVerbosityNormal ErrorVerbosity = "normal"
+	// ErrorVerbosityDebug additionally keeps the full response body in models.Error.ResponseBody
+	ErrorVerbosityDebug ErrorVerbosity = "debug"
+)
+
+// DefaultMaxErrorBodySize is the default number of bytes of a response body embedded in an error message
+const DefaultMaxErrorBodySize = 1024
+
+// ErrorDetails configures how much of the response bodies is carried in the errors returned by the API utils
+type ErrorDetails struct {
+	// Verbosity defaults to ErrorVerbosityNormal
+	Verbosity ErrorVerbosity
+	// MaxBodySize is the maximum number of bytes of a response body or error message embedded in an error.
+	// If it is not positive, DefaultMaxErrorBodySize is used
+	MaxBodySize int
+}
+
+// WithErrorDetails sets how much of the response bodies is carried in the errors returned by the handlers of the APISet.
+// Without this option, response bodies and error messages are embedded in errors completely
+func WithErrorDetails(details ErrorDetails) func(*APISet) {
+	if details.Verbosity == "" {
+		details.Verbosity = ErrorVerbosityNormal
+	}
+	if details.MaxBodySize <= 0 {
+		details.MaxBodySize = DefaultMaxErrorBodySize
+	}
+	return func(a *APISet) {
+		a.errorDetails = &details
+	}
+}
+
+// errorDetailsSetting is embedded by the handlers to carry the ErrorDetails of their APISet
+type errorDetailsSetting struct {
+	errorDetails *ErrorDetails
+}
+
+// getErrorDetails returns the ErrorDetails of the handler. Without a setting, bodies are not truncated
+func (s errorDetailsSetting) getErrorDetails() ErrorDetails {
+	if s.errorDetails == nil {
+		return ErrorDetails{Verbosity: ErrorVerbosityNormal}
+	}
+	return *s.errorDetails
+}
+
+// errorDetailsOf returns the ErrorDetails of the given handler
+func errorDetailsOf(api APIService) ErrorDetails {
+	if holder, ok := api.(interface{ getErrorDetails() ErrorDetails }); ok {
+		return holder.getErrorDetails()
+	}
+	return errorDetailsSetting{}.getErrorDetails()
+}
+
+// buildParseErrorResponse builds the error for a response body which could not be parsed
+func buildParseErrorResponse(err error, body []byte, details ErrorDetails) *models.Error {
+	message := err.Error()
+	if details.Verbosity != ErrorVerbosityMinimal {
+		message += "\n" + "-----DETAILS-----" + truncateBody(body, details.MaxBodySize)
+	}
+	return withResponseBody(buildErrorResponse(message), body, details)
+}
+
+// responseBodyMessage returns the message of an error for a failed response whose body is not an error object
+func responseBodyMessage(statusCode int, body []byte, details ErrorDetails) string {
+	if details.Verbosity == ErrorVerbosityMinimal || len(body) == 0 {
+		return fmt.Sprintf(ErrWithStatusCode, statusCode)
+	}
+	return truncateBody(body, details.MaxBodySize)
+}
+
+// withResponseBody keeps the full response body in the error if debug verbosity is enabled
+func withResponseBody(err *models.Error, body []byte, details ErrorDetails) *models.Error {
+	if details.Verbosity == ErrorVerbosityDebug && len(body) > 0 {
+		err.ResponseBody = body
+	}
+	return err
+}
+
+// truncateBody returns at most maxSize bytes of the body, without splitting a multi-byte character.
+// If maxSize is not positive, the whole body is returned
+func truncateBody(body []byte, maxSize int) string {
+	if maxSize <= 0 || len(body) <= maxSize {
+		return string(body)
+	}
+	end := maxSize
+	for end > 0 && !utf8.RuneStart(body[end]) {
+		end--
+	}
+	return fmt.Sprintf("%s... (%d more bytes)", body[:end], len(body)-end)
+}
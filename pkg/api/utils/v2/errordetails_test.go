@@ -0,0 +1,103 @@
+package v2
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestTruncateBody(t *testing.T) {
+	require.Equal(t, "short", truncateBody([]byte("short"), 10))
+	require.Equal(t, "0123... (6 more bytes)", truncateBody([]byte("0123456789"), 4))
+	// multi-byte characters are not split
+	require.Equal(t, "ab... (5 more bytes)", truncateBody([]byte("abäöx"), 3))
+}
+
+func TestWithErrorDetails_Defaults(t *testing.T) {
+	apiSet, err := New("http://localhost", WithErrorDetails(ErrorDetails{}))
+	require.Nil(t, err)
+	require.Equal(t, ErrorDetails{Verbosity: ErrorVerbosityNormal, MaxBodySize: DefaultMaxErrorBodySize}, apiSet.eventHandler.getErrorDetails())
+
+	// without the option, bodies are not truncated
+	apiSet, err = New("http://localhost")
+	require.Nil(t, err)
+	require.Equal(t, ErrorDetails{Verbosity: ErrorVerbosityNormal}, apiSet.eventHandler.getErrorDetails())
+	require.Equal(t, ErrorDetails{Verbosity: ErrorVerbosityNormal}, errorDetailsOf(NewEventHandler("http://localhost")))
+}
+
+func TestErrorDetails(t *testing.T) {
+	invalidBody := "<html>" + strings.Repeat("x", 100) + "</html>"
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodPost:
+			w.Write([]byte(invalidBody))
+		default:
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(`{"code":400,"message":"` + strings.Repeat("m", 100) + `"}`))
+		}
+	}))
+	defer server.Close()
+
+	tests := []struct {
+		name            string
+		details         ErrorDetails
+		wantParseError  string
+		wantMessage     string
+		wantFullBodyErr bool
+	}{
+		{
+			name:           "default",
+			wantParseError: "invalid character '<' looking for beginning of value\n-----DETAILS-----" + invalidBody,
+			wantMessage:    strings.Repeat("m", 100),
+		},
+		{
+			name:           "minimal",
+			details:        ErrorDetails{Verbosity: ErrorVerbosityMinimal, MaxBodySize: 10},
+			wantParseError: "invalid character '<' looking for beginning of value",
+			wantMessage:    "mmmmmmmmmm... (90 more bytes)",
+		},
+		{
+			name:           "normal",
+			details:        ErrorDetails{Verbosity: ErrorVerbosityNormal, MaxBodySize: 10},
+			wantParseError: "invalid character '<' looking for beginning of value\n-----DETAILS-----<html>xxxx... (103 more bytes)",
+			wantMessage:    "mmmmmmmmmm... (90 more bytes)",
+		},
+		{
+			name:            "debug",
+			details:         ErrorDetails{Verbosity: ErrorVerbosityDebug, MaxBodySize: 10},
+			wantParseError:  "invalid character '<' looking for beginning of value\n-----DETAILS-----<html>xxxx... (103 more bytes)",
+			wantMessage:     "mmmmmmmmmm... (90 more bytes)",
+			wantFullBodyErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			options := []func(*APISet){}
+			if tt.details != (ErrorDetails{}) {
+				options = append(options, WithErrorDetails(tt.details))
+			}
+			apiSet, err := New(server.URL, options...)
+			require.Nil(t, err)
+			handler := apiSet.eventHandler
+
+			_, errObj := postWithEventContext(context.TODO(), server.URL, []byte("{}"), handler)
+			require.NotNil(t, errObj)
+			require.Equal(t, tt.wantParseError, errObj.GetMessage())
+
+			_, errObj = getAndExpectOK(context.TODO(), server.URL, handler)
+			require.NotNil(t, errObj)
+			require.Equal(t, tt.wantMessage, errObj.GetMessage())
+			require.Equal(t, int64(http.StatusBadRequest), errObj.Code)
+
+			if tt.wantFullBodyErr {
+				require.Contains(t, string(errObj.GetResponseBody()), strings.Repeat("m", 100))
+			} else {
+				require.Nil(t, errObj.GetResponseBody())
+			}
+		})
+	}
+}
@@ -2,6 +2,7 @@ package v2
 
 import (
 	"fmt"
+	"net/http"
 
 	"github.com/keptn/go-utils/pkg/api/models"
 )
@@ -9,11 +10,20 @@ import (
 // ErrWithStatusCode message
 const ErrWithStatusCode = "error with status code %d"
 
-func handleErrStatusCode(statusCode int, body []byte) *models.Error {
+// handleErrStatusCode builds the error of a failed request from the response body.
+// If the body does not contain an error code, the status code of the response is used.
+// The message is truncated and the body is kept according to the given ErrorDetails
+func handleErrStatusCode(statusCode int, header http.Header, body []byte, details ErrorDetails) *models.Error {
 	respErr := &models.Error{}
-	if err := respErr.FromJSON(body); err == nil && respErr != nil {
-		return respErr
+	if err := respErr.FromJSON(body); err != nil || respErr == nil {
+		respErr = buildErrorResponse(fmt.Sprintf(ErrWithStatusCode, statusCode))
+	} else if message := respErr.GetMessage(); details.MaxBodySize > 0 && len(message) > details.MaxBodySize {
+		message = truncateBody([]byte(message), details.MaxBodySize)
+		respErr.Message = &message
 	}
-
-	return buildErrorResponse(fmt.Sprintf(ErrWithStatusCode, statusCode))
+	if respErr.Code == 0 {
+		respErr.Code = int64(statusCode)
+	}
+	respErr.ContentLanguage = header.Get("Content-Language")
+	return withResponseBody(respErr, body, details)
 }
@@ -0,0 +1,165 @@
+package v2
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/keptn/go-utils/pkg/api/models"
+)
+
+const (
+	evaluationFinishedEventType    = "sh.keptn.event.evaluation.finished"
+	evaluationInvalidatedEventType = "sh.keptn.event.evaluation.invalidated"
+)
+
+// ErrEvaluationNotFound is returned by APISet.InvalidateEvaluation if the evaluation to invalidate does not exist
+var ErrEvaluationNotFound = errors.New("evaluation not found")
+
+// EvaluationsListEvaluationsOptions are options for APISet.ListEvaluations().
+type EvaluationsListEvaluationsOptions struct {
+	// ExcludeInvalidated removes the evaluations which have been invalidated from the result
+	ExcludeInvalidated bool
+}
+
+// EvaluationsListInvalidatedEvaluationsOptions are options for APISet.ListInvalidatedEvaluations().
+type EvaluationsListInvalidatedEvaluationsOptions struct{}
+
+// EvaluationsInvalidateEvaluationOptions are options for APISet.InvalidateEvaluation().
+type EvaluationsInvalidateEvaluationOptions struct {
+	// Project is the project of the evaluation. It is optional, but narrows down the search for the evaluation
+	Project string
+	// Source is the source of the evaluation.invalidated event. If it is empty, the source configured via WithEventSource is used
+	Source string
+}
+
+// evaluationInvalidatedData is the data of an evaluation.invalidated event, see v0_2_0.EventData
+type evaluationInvalidatedData struct {
+	Project string            `json:"project"`
+	Stage   string            `json:"stage"`
+	Service string            `json:"service"`
+	Labels  map[string]string `json:"labels,omitempty"`
+	Status  string            `json:"status"`
+	Result  string            `json:"result"`
+	Message string            `json:"message,omitempty"`
+}
+
+// ListEvaluations returns the evaluation.finished events of the service in the stage of the project.
+// Empty stage or service match all stages or services. If opts.ExcludeInvalidated is set,
+// the evaluations which have been invalidated are removed, see ExcludeInvalidatedEvaluations
+func (c *APISet) ListEvaluations(ctx context.Context, project string, stage string, service string, opts EvaluationsListEvaluationsOptions) ([]*models.KeptnContextExtendedCE, error) {
+	evaluations, mErr := c.eventHandler.GetEvents(ctx, &EventFilter{
+		Project:   project,
+		Stage:     stage,
+		Service:   service,
+		EventType: evaluationFinishedEventType,
+	}, EventsGetEventsOptions{})
+	if mErr != nil {
+		return nil, fmt.Errorf("could not retrieve evaluations of project %s: %w", project, mErr.ToError())
+	}
+	if !opts.ExcludeInvalidated {
+		return evaluations, nil
+	}
+	invalidated, err := c.ListInvalidatedEvaluations(ctx, project, stage, service, EvaluationsListInvalidatedEvaluationsOptions{})
+	if err != nil {
+		return nil, err
+	}
+	return ExcludeInvalidatedEvaluations(evaluations, invalidated), nil
+}
+
+// ListInvalidatedEvaluations returns the evaluation.invalidated events of the service in the stage of the project.
+// Empty stage or service match all stages or services
+func (c *APISet) ListInvalidatedEvaluations(ctx context.Context, project string, stage string, service string, opts EvaluationsListInvalidatedEvaluationsOptions) ([]*models.KeptnContextExtendedCE, error) {
+	invalidated, mErr := c.eventHandler.GetEvents(ctx, &EventFilter{
+		Project:   project,
+		Stage:     stage,
+		Service:   service,
+		EventType: evaluationInvalidatedEventType,
+	}, EventsGetEventsOptions{})
+	if mErr != nil {
+		return nil, fmt.Errorf("could not retrieve invalidated evaluations of project %s: %w", project, mErr.ToError())
+	}
+	return invalidated, nil
+}
+
+// InvalidateEvaluation invalidates the evaluation with the given evaluation.finished event ID by sending an
+// evaluation.invalidated event. As the events of a task, it references the evaluation.triggered event of the evaluation
+// via its triggeredid. The reason is sent as message of the event and may be empty.
+// If the evaluation does not exist, an error wrapping ErrEvaluationNotFound is returned
+func (c *APISet) InvalidateEvaluation(ctx context.Context, evaluationFinishedID string, reason string, opts EvaluationsInvalidateEvaluationOptions) (*models.EventContext, error) {
+	if evaluationFinishedID == "" {
+		return nil, errors.New("evaluation.finished event ID must not be empty")
+	}
+	events, mErr := c.eventHandler.GetEvents(ctx, &EventFilter{
+		Project:   opts.Project,
+		EventType: evaluationFinishedEventType,
+		EventID:   evaluationFinishedID,
+	}, EventsGetEventsOptions{})
+	if mErr != nil {
+		return nil, fmt.Errorf("could not retrieve evaluation %s: %w", evaluationFinishedID, mErr.ToError())
+	}
+	var finishedEvent *models.KeptnContextExtendedCE
+	for _, event := range events {
+		if event.ID == evaluationFinishedID {
+			finishedEvent = event
+			break
+		}
+	}
+	if finishedEvent == nil {
+		return nil, fmt.Errorf("%w: %s", ErrEvaluationNotFound, evaluationFinishedID)
+	}
+	if finishedEvent.Triggeredid == "" {
+		return nil, fmt.Errorf("evaluation %s does not reference its evaluation.triggered event", evaluationFinishedID)
+	}
+
+	data := evaluationInvalidatedData{}
+	if err := finishedEvent.DataAs(&data); err != nil {
+		return nil, fmt.Errorf("could not decode data of evaluation %s: %w", evaluationFinishedID, err)
+	}
+	data.Status = "succeeded"
+	data.Result = "pass"
+	data.Message = reason
+
+	invalidatedType := evaluationInvalidatedEventType
+	invalidated := models.KeptnContextExtendedCE{
+		Contenttype:        "application/json",
+		Data:               data,
+		Shkeptncontext:     finishedEvent.Shkeptncontext,
+		Shkeptnspecversion: finishedEvent.Shkeptnspecversion,
+		Specversion:        finishedEvent.Specversion,
+		Triggeredid:        finishedEvent.Triggeredid,
+		Type:               &invalidatedType,
+	}
+	if opts.Source != "" {
+		invalidated.Source = &opts.Source
+	}
+	eventContext, mErr := c.apiHandler.SendEvent(ctx, invalidated, APISendEventOptions{})
+	if mErr != nil {
+		return nil, mErr.ToError()
+	}
+	return eventContext, nil
+}
+
+// ExcludeInvalidatedEvaluations returns the evaluations which are not invalidated by any of the given
+// evaluation.invalidated events, i.e. whose triggeredid is not referenced by an invalidated event of the same Keptn context.
+// The order of the evaluations is kept
+func ExcludeInvalidatedEvaluations(evaluations []*models.KeptnContextExtendedCE, invalidated []*models.KeptnContextExtendedCE) []*models.KeptnContextExtendedCE {
+	type evaluationKey struct {
+		keptnContext string
+		triggeredID  string
+	}
+	invalidatedKeys := make(map[evaluationKey]struct{}, len(invalidated))
+	for _, event := range invalidated {
+		if event.Triggeredid != "" {
+			invalidatedKeys[evaluationKey{event.Shkeptncontext, event.Triggeredid}] = struct{}{}
+		}
+	}
+	result := make([]*models.KeptnContextExtendedCE, 0, len(evaluations))
+	for _, evaluation := range evaluations {
+		if _, ok := invalidatedKeys[evaluationKey{evaluation.Shkeptncontext, evaluation.Triggeredid}]; ok && evaluation.Triggeredid != "" {
+			continue
+		}
+		result = append(result, evaluation)
+	}
+	return result
+}
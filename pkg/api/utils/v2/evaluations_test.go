@@ -0,0 +1,131 @@
+package v2
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/keptn/go-utils/pkg/api/models"
+	"github.com/stretchr/testify/require"
+)
+
+const evaluationsResponse = `{"events":[
+	{"id":"evaluation-1","shkeptncontext":"context-1","triggeredid":"triggered-1","specversion":"1.0","shkeptnspecversion":"0.2.4","type":"sh.keptn.event.evaluation.finished",
+	 "data":{"project":"my-project","stage":"hardening","service":"carts","labels":{"owner":"team-a"},"result":"fail","status":"succeeded"}},
+	{"id":"evaluation-2","shkeptncontext":"context-2","triggeredid":"triggered-2","type":"sh.keptn.event.evaluation.finished",
+	 "data":{"project":"my-project","stage":"hardening","service":"carts","result":"pass","status":"succeeded"}}
+]}`
+
+const invalidatedEvaluationsResponse = `{"events":[
+	{"id":"invalidated-1","shkeptncontext":"context-1","triggeredid":"triggered-1","type":"sh.keptn.event.evaluation.invalidated",
+	 "data":{"project":"my-project","stage":"hardening","service":"carts"}}
+]}`
+
+func newEvaluationServer() *recordingServer {
+	return newRecordingServer(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodGet && strings.HasSuffix(r.URL.Path, "/mongodb-datastore/event"):
+			switch r.URL.Query().Get("type") {
+			case "sh.keptn.event.evaluation.invalidated":
+				w.Write([]byte(invalidatedEvaluationsResponse))
+			case "sh.keptn.event.evaluation.finished":
+				if eventID := r.URL.Query().Get("eventID"); eventID != "" && eventID != "evaluation-1" {
+					w.Write([]byte(`{"events":[]}`))
+					return
+				}
+				w.Write([]byte(evaluationsResponse))
+			}
+		case r.Method == http.MethodPost && strings.HasSuffix(r.URL.Path, "/v1/event"):
+			w.Write([]byte(`{"keptnContext":"context-1"}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+}
+
+func TestAPISet_ListEvaluations(t *testing.T) {
+	server := newEvaluationServer()
+	defer server.Close()
+	apiSet, err := New(server.URL)
+	require.Nil(t, err)
+
+	evaluations, err := apiSet.ListEvaluations(context.TODO(), "my-project", "hardening", "carts", EvaluationsListEvaluationsOptions{})
+	require.Nil(t, err)
+	require.Len(t, evaluations, 2)
+
+	evaluations, err = apiSet.ListEvaluations(context.TODO(), "my-project", "hardening", "carts", EvaluationsListEvaluationsOptions{ExcludeInvalidated: true})
+	require.Nil(t, err)
+	require.Len(t, evaluations, 1)
+	require.Equal(t, "evaluation-2", evaluations[0].ID)
+	require.Equal(t, []string{
+		"project=my-project&service=carts&stage=hardening&type=sh.keptn.event.evaluation.finished",
+		"project=my-project&service=carts&stage=hardening&type=sh.keptn.event.evaluation.finished",
+		"project=my-project&service=carts&stage=hardening&type=sh.keptn.event.evaluation.invalidated",
+	}, server.queries(http.MethodGet, "/mongodb-datastore/event"))
+}
+
+func TestAPISet_ListInvalidatedEvaluations(t *testing.T) {
+	server := newEvaluationServer()
+	defer server.Close()
+	apiSet, err := New(server.URL)
+	require.Nil(t, err)
+
+	invalidated, err := apiSet.ListInvalidatedEvaluations(context.TODO(), "my-project", "", "", EvaluationsListInvalidatedEvaluationsOptions{})
+	require.Nil(t, err)
+	require.Len(t, invalidated, 1)
+	require.Equal(t, "triggered-1", invalidated[0].Triggeredid)
+}
+
+func TestAPISet_InvalidateEvaluation(t *testing.T) {
+	server := newEvaluationServer()
+	defer server.Close()
+	apiSet, err := New(server.URL, WithEventSource("my-cli"))
+	require.Nil(t, err)
+
+	eventContext, err := apiSet.InvalidateEvaluation(context.TODO(), "evaluation-1", "load test was broken", EvaluationsInvalidateEvaluationOptions{Project: "my-project"})
+	require.Nil(t, err)
+	require.Equal(t, "context-1", *eventContext.KeptnContext)
+
+	sent := server.sentEvents(t)
+	require.Len(t, sent, 1)
+	invalidated := sent[0]
+	require.Equal(t, "sh.keptn.event.evaluation.invalidated", *invalidated.Type)
+	require.Equal(t, "triggered-1", invalidated.Triggeredid)
+	require.Equal(t, "context-1", invalidated.Shkeptncontext)
+	require.Equal(t, "my-cli", *invalidated.Source)
+	data := evaluationInvalidatedData{}
+	require.Nil(t, invalidated.DataAs(&data))
+	require.Equal(t, evaluationInvalidatedData{
+		Project: "my-project",
+		Stage:   "hardening",
+		Service: "carts",
+		Labels:  map[string]string{"owner": "team-a"},
+		Status:  "succeeded",
+		Result:  "pass",
+		Message: "load test was broken",
+	}, data)
+
+	_, err = apiSet.InvalidateEvaluation(context.TODO(), "unknown", "", EvaluationsInvalidateEvaluationOptions{})
+	require.True(t, errors.Is(err, ErrEvaluationNotFound))
+	_, err = apiSet.InvalidateEvaluation(context.TODO(), "", "", EvaluationsInvalidateEvaluationOptions{})
+	require.Error(t, err)
+}
+
+func TestExcludeInvalidatedEvaluations(t *testing.T) {
+	evaluations := []*models.KeptnContextExtendedCE{
+		{ID: "evaluation-1", Shkeptncontext: "context-1", Triggeredid: "triggered-1"},
+		{ID: "evaluation-2", Shkeptncontext: "context-2", Triggeredid: "triggered-1"},
+		{ID: "evaluation-3", Shkeptncontext: "context-1"},
+	}
+	invalidated := []*models.KeptnContextExtendedCE{
+		{ID: "invalidated-1", Shkeptncontext: "context-1", Triggeredid: "triggered-1"},
+		{ID: "invalidated-2", Shkeptncontext: "context-1"},
+	}
+
+	result := ExcludeInvalidatedEvaluations(evaluations, invalidated)
+	require.Len(t, result, 2)
+	require.Equal(t, "evaluation-2", result[0].ID)
+	require.Equal(t, "evaluation-3", result[1].ID)
+}
@@ -2,23 +2,57 @@ package v2
 
 import (
 	"context"
+	"errors"
 	"fmt"
-	"log"
 	"net/http"
 	"net/url"
-	"strconv"
+	"sort"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/keptn/go-utils/pkg/api/models"
 	"github.com/keptn/go-utils/pkg/common/httputils"
+	"github.com/keptn/go-utils/pkg/common/retry"
 )
 
+// minRetrySleepTime is the shortest time GetEventsWithRetry waits between two attempts
+const minRetrySleepTime = time.Millisecond
+
 // EventsGetEventsOptions are options for EventsInterface.GetEvents().
-type EventsGetEventsOptions struct{}
+type EventsGetEventsOptions struct {
+	// Delta enables delta polling: once events have been seen, fromTime is set to the time of the newest seen event
+	// minus the overlap window of the tracker, and events which have already been returned are removed from the result
+	Delta *EventDeltaTracker
+	// PageRetries overrides the page retries of the handler for this call
+	PageRetries *PageRetries
+	// OnProgress is called after each fetched page
+	OnProgress PageProgressFunc
+	// MemoryBudget overrides the memory budget of the handler for this call, see WithMemoryBudget.
+	// A negative budget disables the limit
+	MemoryBudget int64
+	// Cursor continues the listing at the given page, e.g. at the cursor of a *BudgetExceededError
+	Cursor models.Cursor
+}
 
 // EventsGetEventsWithRetryOptions are options for EventsInterface.GetEventsWithRetry().
-type EventsGetEventsWithRetryOptions struct{}
+type EventsGetEventsWithRetryOptions struct {
+	// PageRetries overrides the page retries of the handler for this call
+	PageRetries *PageRetries
+}
+
+// DefaultGetEventsByContextsConcurrency is the default number of parallel requests made by GetEventsByContexts
+const DefaultGetEventsByContextsConcurrency = 5
+
+// EventsGetEventsByContextsOptions are options for GetEventsByContexts().
+type EventsGetEventsByContextsOptions struct {
+	// Concurrency is the maximum number of parallel requests. If it is not positive, DefaultGetEventsByContextsConcurrency is used
+	Concurrency int
+	// Filter restricts the events of each context further, e.g. to a project or an event type. Its KeptnContext is ignored
+	Filter *EventFilter
+	// PageRetries overrides the page retries of the handler for this call
+	PageRetries *PageRetries
+}
 
 type EventsInterface interface {
 	// GetEvents returns all events matching the properties in the passed filter object.
@@ -28,12 +62,19 @@ type EventsInterface interface {
 	GetEventsWithRetry(ctx context.Context, filter *EventFilter, maxRetries int, retrySleepTime time.Duration, opts EventsGetEventsWithRetryOptions) ([]*models.KeptnContextExtendedCE, error)
 }
 
+// EventHandler handles events.
+// It is safe for concurrent use by multiple goroutines: it holds no state of individual requests and is not modified
+// after it has been created. Settings which differ between calls are passed via the options of each method,
+// e.g. EventsGetEventsOptions.PageRetries overrides the page retries of the handler for a single call
 type EventHandler struct {
-	baseURL    string
-	authToken  string
-	authHeader string
-	httpClient *http.Client
-	scheme     string
+	baseURL      string
+	authToken    string
+	authHeader   string
+	httpClient   *http.Client
+	scheme       string
+	pageRetries  pageRetryPolicy
+	memoryBudget int64
+	errorDetailsSetting
 }
 
 // EventFilter allows to filter events based on the provided properties
@@ -61,13 +102,10 @@ func NewEventHandlerWithHTTPClient(baseURL string, httpClient *http.Client) *Eve
 
 const mongodbDatastoreServiceBaseUrl = "mongodb-datastore"
 
-// NewAuthenticatedEventHandler returns a new EventHandler that authenticates at the endpoint via the provided token
-func NewAuthenticatedEventHandler(baseURL string, authToken string, authHeader string, httpClient *http.Client, scheme string) *EventHandler {
-	baseURL = strings.TrimRight(baseURL, "/")
-	if !strings.HasSuffix(baseURL, mongodbDatastoreServiceBaseUrl) {
-		baseURL += "/" + mongodbDatastoreServiceBaseUrl
-	}
-
+// NewAuthenticatedEventHandler returns a new EventHandler that authenticates at the endpoint via the provided token.
+// The base path of the service is added to the base URL according to basePathMode (default AppendMissingBasePath)
+func NewAuthenticatedEventHandler(baseURL string, authToken string, authHeader string, httpClient *http.Client, scheme string, basePathMode ...BasePathMode) *EventHandler {
+	baseURL = basePathModeOf(basePathMode).HandlerBaseURL(baseURL, HandlerEvents)
 	return createEventHandler(baseURL, authToken, authHeader, httpClient, scheme)
 }
 
@@ -98,14 +136,33 @@ func (e *EventHandler) getHTTPClient() *http.Client {
 }
 
 // GetEvents returns all events matching the properties in the passed filter object.
+// If the memory budget is exceeded, the events retrieved so far are returned along with an error wrapping a *BudgetExceededError
 func (e *EventHandler) GetEvents(ctx context.Context, filter *EventFilter, opts EventsGetEventsOptions) ([]*models.KeptnContextExtendedCE, *models.Error) {
 	u, err := url.Parse(e.scheme + "://" + e.getBaseURL() + "/event?")
 	if err != nil {
-		log.Fatal("error parsing url")
+		return nil, invalidURLResponse(err)
 	}
 
-	query := u.Query()
+	query := eventFilterQuery(filter)
+	if opts.Delta != nil {
+		if fromTime := opts.Delta.FromTime(); fromTime != "" {
+			query.Set("fromTime", fromTime)
+		}
+	}
+
+	u.RawQuery = query.Encode()
 
+	budget := newMemoryBudget(e.memoryBudget, opts.MemoryBudget)
+	events, errObj := e.getEvents(ctx, u.String(), filter.NumberOfPages, e.pageRetries.override(opts.PageRetries), opts.OnProgress, budget, opts.Cursor)
+	if errObj != nil || opts.Delta == nil {
+		return events, errObj
+	}
+	return opts.Delta.Filter(events), nil
+}
+
+// eventFilterQuery returns the query parameters of the datastore selecting the events matching the filter
+func eventFilterQuery(filter *EventFilter) url.Values {
+	query := url.Values{}
 	if filter.Project != "" {
 		query.Set("project", filter.Project)
 	}
@@ -130,40 +187,209 @@ func (e *EventHandler) GetEvents(ctx context.Context, filter *EventFilter, opts
 	if filter.FromTime != "" {
 		query.Set("fromTime", filter.FromTime)
 	}
+	return query
+}
+
+// EventsRetryError is returned by GetEventsWithRetry if no matching events have been retrieved
+type EventsRetryError struct {
+	// Err is the reason why retrying has been stopped, e.g. that the maximum number of retries has been reached,
+	// the context is done (a *retry.CancelledError) or the API returned an error which is not worth retrying
+	Err error
+	// Errors are the distinct errors returned by the API while retrying, in the order in which they first occurred
+	Errors []*models.Error
+}
+
+func (e *EventsRetryError) Error() string {
+	if len(e.Errors) == 0 {
+		return e.Err.Error()
+	}
+	messages := make([]string, 0, len(e.Errors))
+	for _, err := range e.Errors {
+		if err.Code != 0 {
+			messages = append(messages, fmt.Sprintf("%d: %s", err.Code, err.GetMessage()))
+		} else {
+			messages = append(messages, err.GetMessage())
+		}
+	}
+	return fmt.Sprintf("%s: errors while retrieving events: %s", e.Err.Error(), strings.Join(messages, "; "))
+}
 
-	u.RawQuery = query.Encode()
+func (e *EventsRetryError) Unwrap() error {
+	return e.Err
+}
 
-	return e.getEvents(ctx, u.String(), filter.NumberOfPages)
+func (e *EventsRetryError) add(err *models.Error) {
+	for _, known := range e.Errors {
+		if known.Code == err.Code && known.GetMessage() == err.GetMessage() {
+			return
+		}
+	}
+	e.Errors = append(e.Errors, err)
+}
+
+// isRetryableError returns whether retrying a request may succeed after it failed with the given error.
+// Errors without status code, e.g. connection errors, timeouts, rate limits and server errors are retryable,
+// unless the certificate of the server could not be verified
+func isRetryableError(err *models.Error) bool {
+	switch {
+	case errors.Is(err.Err, ErrReadOnly), errors.Is(err.Err, ErrTLSVerification), errors.Is(err.Err, ErrBudgetExceeded), errors.Is(err.Err, ErrInvalidResponse):
+		return false
+	case err.Code == 0:
+		return true
+	case err.Code == http.StatusRequestTimeout, err.Code == http.StatusTooManyRequests:
+		return true
+	}
+	return err.Code >= http.StatusInternalServerError
 }
 
 // GetEventsWithRetry tries to retrieve events matching the passed filter.
+// It stops once matching events have been found, maxRetries attempts have been made or the context is done.
+// Errors returned by the API are retried, unless they are not worth retrying, e.g. a 400 or 404 response, in which case
+// it stops immediately. If no events have been found, an *EventsRetryError containing the errors returned by the API is returned
 func (e *EventHandler) GetEventsWithRetry(ctx context.Context, filter *EventFilter, maxRetries int, retrySleepTime time.Duration, opts EventsGetEventsWithRetryOptions) ([]*models.KeptnContextExtendedCE, error) {
-	for i := 0; i < maxRetries; i = i + 1 {
-		events, errObj := e.GetEvents(ctx, filter, EventsGetEventsOptions{})
+	retryErr := &EventsRetryError{Err: fmt.Errorf("could not find matching event after %d x %s", maxRetries, retrySleepTime.String())}
+	if maxRetries <= 0 {
+		return nil, retryErr
+	}
+	if retrySleepTime < minRetrySleepTime {
+		retrySleepTime = minRetrySleepTime
+	}
+
+	var events []*models.KeptnContextExtendedCE
+	attempts := 0
+	err := retry.Poll(ctx, retrySleepTime, retrySleepTime, func(ctx context.Context) (bool, error) {
+		var errObj *models.Error
+		events, errObj = e.GetEvents(ctx, filter, EventsGetEventsOptions{PageRetries: opts.PageRetries})
 		if errObj == nil && len(events) > 0 {
-			return events, nil
+			return true, nil
+		}
+		if ctx.Err() == nil {
+			// errors caused by the cancellation itself would hide the state observed before
+			state := "no matching events"
+			if errObj != nil {
+				state = errObj.GetMessage()
+			}
+			retry.ObserveState(ctx, state)
+		}
+		if errObj != nil {
+			retryErr.add(errObj)
+			if !isRetryableError(errObj) {
+				return false, fmt.Errorf("stopped retrying after non-retryable error in attempt %d", attempts+1)
+			}
+		}
+		attempts++
+		if attempts >= maxRetries {
+			return false, retryErr.Err
+		}
+		return false, nil
+	})
+	if err != nil {
+		retryErr.Err = err
+		return nil, retryErr
+	}
+	return events, nil
+}
+
+// EventsByContextsError is returned by GetEventsByContexts if the events of some of the keptnContexts could not be retrieved
+type EventsByContextsError struct {
+	// Errors contains the error of each keptnContext whose events could not be retrieved
+	Errors map[string]*models.Error
+}
+
+func (e *EventsByContextsError) Error() string {
+	keptnContexts := make([]string, 0, len(e.Errors))
+	for keptnContext := range e.Errors {
+		keptnContexts = append(keptnContexts, keptnContext)
+	}
+	sort.Strings(keptnContexts)
+	messages := make([]string, 0, len(keptnContexts))
+	for _, keptnContext := range keptnContexts {
+		messages = append(messages, keptnContext+": "+e.Errors[keptnContext].GetMessage())
+	}
+	return fmt.Sprintf("could not retrieve events of %d keptnContexts: %s", len(e.Errors), strings.Join(messages, "; "))
+}
+
+// GetEventsByContexts returns the events of each of the passed keptnContexts, keyed by keptnContext, using any EventsInterface.
+// The Keptn API does not support filtering by several keptnContexts at once, so the events are requested for each keptnContext,
+// with at most opts.Concurrency requests at the same time.
+// If the events of some keptnContexts could not be retrieved, the events of the other keptnContexts are returned
+// together with an *EventsByContextsError
+func GetEventsByContexts(ctx context.Context, events EventsInterface, keptnContexts []string, opts EventsGetEventsByContextsOptions) (map[string][]*models.KeptnContextExtendedCE, error) {
+	concurrency := opts.Concurrency
+	if concurrency <= 0 {
+		concurrency = DefaultGetEventsByContextsConcurrency
+	}
+	filter := EventFilter{}
+	if opts.Filter != nil {
+		filter = *opts.Filter
+	}
+
+	queue := make(chan string, len(keptnContexts))
+	queued := map[string]bool{}
+	for _, keptnContext := range keptnContexts {
+		if !queued[keptnContext] {
+			queued[keptnContext] = true
+			queue <- keptnContext
 		}
-		<-time.After(retrySleepTime)
 	}
-	return nil, fmt.Errorf("could not find matching event after %d x %s", maxRetries, retrySleepTime.String())
+	close(queue)
+
+	mutex := sync.Mutex{}
+	result := make(map[string][]*models.KeptnContextExtendedCE, len(queued))
+	errs := map[string]*models.Error{}
+	wg := sync.WaitGroup{}
+	for i := 0; i < concurrency && i < len(queued); i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for keptnContext := range queue {
+				contextFilter := filter
+				contextFilter.KeptnContext = keptnContext
+				var contextEvents []*models.KeptnContextExtendedCE
+				var errObj *models.Error
+				if err := ctx.Err(); err != nil {
+					errObj = buildErrorResponse(err.Error())
+				} else {
+					contextEvents, errObj = events.GetEvents(ctx, &contextFilter, EventsGetEventsOptions{PageRetries: opts.PageRetries})
+				}
+
+				mutex.Lock()
+				if errObj != nil {
+					errs[keptnContext] = errObj
+				} else {
+					result[keptnContext] = contextEvents
+				}
+				mutex.Unlock()
+			}
+		}()
+	}
+	wg.Wait()
+
+	if len(errs) > 0 {
+		return result, &EventsByContextsError{Errors: errs}
+	}
+	return result, nil
 }
 
-func (e *EventHandler) getEvents(ctx context.Context, uri string, numberOfPages int) ([]*models.KeptnContextExtendedCE, *models.Error) {
+func (e *EventHandler) getEvents(ctx context.Context, uri string, numberOfPages int, pageRetries pageRetryPolicy, onProgress PageProgressFunc, budget *memoryBudget, cursor models.Cursor) ([]*models.KeptnContextExtendedCE, *models.Error) {
 	events := []*models.KeptnContextExtendedCE{}
-	nextPageKey := ""
+	pages := 0
 
 	for {
+		if !budget.admitsNextPage() {
+			return events, budgetExceededResponse(budget.exceeded(cursor))
+		}
 		url, err := url.Parse(uri)
 		if err != nil {
 			return nil, buildErrorResponse(err.Error())
 		}
 		q := url.Query()
-		if nextPageKey != "" {
+		if nextPageKey := cursor.Encode(); nextPageKey != "" {
 			q.Set("nextPageKey", nextPageKey)
 			url.RawQuery = q.Encode()
 		}
 
-		body, mErr := getAndExpectOK(ctx, url.String(), e)
+		body, mErr := pageRetries.getPage(ctx, url.String(), e)
 		if mErr != nil {
 			return nil, mErr
 		}
@@ -172,20 +398,20 @@ func (e *EventHandler) getEvents(ctx context.Context, uri string, numberOfPages
 		if err = received.FromJSON(body); err != nil {
 			return nil, buildErrorResponse(err.Error())
 		}
+		if !budget.add(len(received.Events), len(body)) {
+			return events, budgetExceededResponse(budget.exceeded(cursor))
+		}
 
 		events = append(events, received.Events...)
 
-		if received.NextPageKey == "" || received.NextPageKey == "0" {
-			break
+		if cursor, err = received.Cursor(); err != nil {
+			return nil, buildErrorResponse(err.Error())
 		}
-
-		nextPageKeyInt, _ := strconv.Atoi(received.NextPageKey)
-
-		if numberOfPages > 0 && nextPageKeyInt >= numberOfPages {
+		pages++
+		onProgress.report(PageProgress{Pages: pages, Items: len(events), TotalCount: int(received.TotalCount), Cursor: cursor})
+		if cursor.IsEnd() || reachedNumberOfPages(cursor, numberOfPages) {
 			break
 		}
-
-		nextPageKey = received.NextPageKey
 	}
 
 	return events, nil
@@ -0,0 +1,228 @@
+package v2
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/keptn/go-utils/pkg/common/retry"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEventHandler_GetEventsWithRetry(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if requests < 3 {
+			w.Write([]byte(`{"events":[]}`))
+			return
+		}
+		w.Write([]byte(`{"events":[{"id":"my-event"}]}`))
+	}))
+	defer server.Close()
+
+	events, err := NewEventHandler(server.URL).GetEventsWithRetry(context.TODO(), &EventFilter{KeptnContext: "my-context"}, 5, time.Millisecond, EventsGetEventsWithRetryOptions{})
+	require.Nil(t, err)
+	require.Len(t, events, 1)
+	require.Equal(t, "my-event", events[0].ID)
+	require.Equal(t, 3, requests)
+
+	requests = -10
+	_, err = NewEventHandler(server.URL).GetEventsWithRetry(context.TODO(), &EventFilter{KeptnContext: "my-context"}, 2, time.Millisecond, EventsGetEventsWithRetryOptions{})
+	require.EqualError(t, err, "could not find matching event after 2 x 1ms")
+	require.Equal(t, -8, requests)
+
+	ctx, cancel := context.WithCancel(context.TODO())
+	cancel()
+	requests = -10
+	_, err = NewEventHandler(server.URL).GetEventsWithRetry(ctx, &EventFilter{KeptnContext: "my-context"}, 5, time.Millisecond, EventsGetEventsWithRetryOptions{})
+	require.ErrorIs(t, err, context.Canceled)
+}
+
+func TestEventHandler_GetEventsWithRetry_ReportsCancellation(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"events":[]}`))
+	}))
+	defer server.Close()
+
+	ctx, cancel := context.WithTimeout(context.TODO(), 50*time.Millisecond)
+	defer cancel()
+	_, err := NewEventHandler(server.URL).GetEventsWithRetry(ctx, &EventFilter{KeptnContext: "my-context"}, 1000, time.Millisecond, EventsGetEventsWithRetryOptions{})
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+	cancelled := &retry.CancelledError{}
+	require.ErrorAs(t, err, &cancelled)
+	require.Greater(t, cancelled.Attempts, 1)
+	require.Equal(t, "no matching events", cancelled.LastState)
+}
+
+func TestEventHandler_GetEventsWithRetry_AggregatesErrors(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		switch requests % 3 {
+		case 0:
+			w.Write([]byte(`{"events":[]}`))
+		case 1:
+			w.WriteHeader(http.StatusServiceUnavailable)
+			w.Write([]byte(`{"code":503,"message":"datastore unavailable"}`))
+		default:
+			w.WriteHeader(http.StatusTooManyRequests)
+			w.Write([]byte(`{"code":429,"message":"too many requests"}`))
+		}
+	}))
+	defer server.Close()
+
+	_, err := NewEventHandler(server.URL).GetEventsWithRetry(context.TODO(), &EventFilter{KeptnContext: "my-context"}, 6, time.Millisecond, EventsGetEventsWithRetryOptions{})
+	require.Equal(t, 6, requests)
+	require.EqualError(t, err, "could not find matching event after 6 x 1ms: errors while retrieving events: 503: datastore unavailable; 429: too many requests")
+
+	var retryErr *EventsRetryError
+	require.ErrorAs(t, err, &retryErr)
+	require.Len(t, retryErr.Errors, 2)
+	require.Equal(t, int64(http.StatusServiceUnavailable), retryErr.Errors[0].Code)
+	require.Equal(t, int64(http.StatusTooManyRequests), retryErr.Errors[1].Code)
+}
+
+func TestEventHandler_GetEventsWithRetry_StopsOnNonRetryableError(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(`{"code":400,"message":"invalid keptnContext"}`))
+	}))
+	defer server.Close()
+
+	_, err := NewEventHandler(server.URL).GetEventsWithRetry(context.TODO(), &EventFilter{KeptnContext: "my-context"}, 5, time.Millisecond, EventsGetEventsWithRetryOptions{})
+	require.Equal(t, 1, requests)
+	require.EqualError(t, err, "stopped retrying after non-retryable error in attempt 1: errors while retrieving events: 400: invalid keptnContext")
+
+	var retryErr *EventsRetryError
+	require.ErrorAs(t, err, &retryErr)
+	require.Len(t, retryErr.Errors, 1)
+	require.Equal(t, "invalid keptnContext", retryErr.Errors[0].GetMessage())
+}
+
+func TestGetEventsByContexts(t *testing.T) {
+	var running, maxRunning int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := atomic.AddInt32(&running, 1)
+		defer atomic.AddInt32(&running, -1)
+		for {
+			max := atomic.LoadInt32(&maxRunning)
+			if n <= max || atomic.CompareAndSwapInt32(&maxRunning, max, n) {
+				break
+			}
+		}
+		time.Sleep(10 * time.Millisecond)
+
+		keptnContext := r.URL.Query().Get("keptnContext")
+		require.Equal(t, "my-project", r.URL.Query().Get("project"))
+		if keptnContext == "ctx-failing" {
+			w.WriteHeader(http.StatusInternalServerError)
+			w.Write([]byte(`{"code":500,"message":"datastore unavailable"}`))
+			return
+		}
+		w.Write([]byte(`{"events":[{"id":"` + keptnContext + `-event","shkeptncontext":"` + keptnContext + `"}]}`))
+	}))
+	defer server.Close()
+
+	keptnContexts := []string{"ctx-1", "ctx-2", "ctx-3", "ctx-4", "ctx-1"}
+	events, err := GetEventsByContexts(context.TODO(), NewEventHandler(server.URL), keptnContexts, EventsGetEventsByContextsOptions{
+		Concurrency: 2,
+		Filter:      &EventFilter{Project: "my-project", KeptnContext: "ignored"},
+	})
+	require.Nil(t, err)
+	require.Len(t, events, 4)
+	for _, keptnContext := range keptnContexts {
+		require.Len(t, events[keptnContext], 1)
+		require.Equal(t, keptnContext+"-event", events[keptnContext][0].ID)
+	}
+	require.LessOrEqual(t, maxRunning, int32(2))
+
+	events, err = GetEventsByContexts(context.TODO(), NewEventHandler(server.URL), []string{"ctx-1", "ctx-failing"}, EventsGetEventsByContextsOptions{
+		Filter: &EventFilter{Project: "my-project"},
+	})
+	require.EqualError(t, err, "could not retrieve events of 1 keptnContexts: ctx-failing: datastore unavailable")
+	var byContextsErr *EventsByContextsError
+	require.ErrorAs(t, err, &byContextsErr)
+	require.Contains(t, byContextsErr.Errors, "ctx-failing")
+	require.Len(t, events, 1)
+	require.Len(t, events["ctx-1"], 1)
+}
+
+// newContextEchoServer returns two pages of events of the requested keptnContext. The second page of each
+// keptnContext fails once with 503 Service Unavailable
+func newContextEchoServer() *httptest.Server {
+	var mtx sync.Mutex
+	failed := map[string]bool{}
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		keptnContext := r.URL.Query().Get("keptnContext")
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Query().Get("nextPageKey") == "" {
+			fmt.Fprintf(w, `{"events":[{"id":"%s-1","shkeptncontext":"%s"}],"nextPageKey":"1"}`, keptnContext, keptnContext)
+			return
+		}
+		mtx.Lock()
+		fail := !failed[keptnContext]
+		failed[keptnContext] = true
+		mtx.Unlock()
+		if fail {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			w.Write([]byte(`{"message":"page unavailable"}`))
+			return
+		}
+		fmt.Fprintf(w, `{"events":[{"id":"%s-2","shkeptncontext":"%s"}]}`, keptnContext, keptnContext)
+	}))
+}
+
+func TestEventHandler_ConcurrentUseWithPerCallOptions(t *testing.T) {
+	server := newContextEchoServer()
+	defer server.Close()
+	eventHandler := NewEventHandler(server.URL)
+
+	const goroutines = 200
+	errs := make(chan error, goroutines)
+	wg := sync.WaitGroup{}
+	for i := 0; i < goroutines; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			keptnContext := fmt.Sprintf("context-%d", i)
+			filter := &EventFilter{KeptnContext: keptnContext}
+			pageRetries := &PageRetries{MaxRetries: 1, Interval: time.Millisecond}
+
+			// every other goroutine does not retry pages, so that its second page fails
+			if i%2 == 1 {
+				if _, mErr := eventHandler.GetEvents(context.TODO(), filter, EventsGetEventsOptions{}); mErr == nil {
+					errs <- fmt.Errorf("%s: expected the second page to fail without page retries", keptnContext)
+					return
+				}
+			}
+			events, mErr := eventHandler.GetEvents(context.TODO(), filter, EventsGetEventsOptions{PageRetries: pageRetries})
+			if mErr != nil {
+				errs <- fmt.Errorf("%s: %s", keptnContext, mErr.GetMessage())
+				return
+			}
+			if len(events) != 2 || events[0].ID != keptnContext+"-1" || events[1].ID != keptnContext+"-2" {
+				errs <- fmt.Errorf("%s: received events of another call: %v", keptnContext, events)
+			}
+		}(i)
+	}
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		require.NoError(t, err)
+	}
+}
+
+func TestEventHandler_GetEvents_MalformedBaseURL(t *testing.T) {
+	events, err := NewEventHandler("local host:8080").GetEvents(context.TODO(), &EventFilter{KeptnContext: "my-context"}, EventsGetEventsOptions{})
+	require.Nil(t, events)
+	require.NotNil(t, err)
+	require.ErrorIs(t, err.ToError(), ErrInvalidConfiguration)
+}
@@ -0,0 +1,100 @@
+package v2
+
+import (
+	"sync"
+	"time"
+
+	"github.com/keptn/go-utils/pkg/api/models"
+	"github.com/keptn/go-utils/pkg/common/timeutils"
+)
+
+// DefaultDeltaOverlap is the default time window before the newest seen event in which events are requested again,
+// so that events which are stored with a slight delay are not missed
+const DefaultDeltaOverlap = 5 * time.Second
+
+// EventDeltaTracker tracks the newest event time seen by a poller, so that subsequent calls only request events
+// newer than that time. Because events within the overlap window are requested again, the tracker also removes
+// events which have already been returned.
+// An EventDeltaTracker must only be used for one filter, and it is safe for concurrent use
+type EventDeltaTracker struct {
+	mutex   sync.Mutex
+	overlap time.Duration
+	newest  time.Time
+	seen    map[string]time.Time
+}
+
+// NewEventDeltaTracker creates a new EventDeltaTracker with the given overlap window.
+// If overlap is not positive, DefaultDeltaOverlap is used
+func NewEventDeltaTracker(overlap time.Duration) *EventDeltaTracker {
+	if overlap <= 0 {
+		overlap = DefaultDeltaOverlap
+	}
+	return &EventDeltaTracker{
+		overlap: overlap,
+		seen:    map[string]time.Time{},
+	}
+}
+
+// FromTime returns the value of the fromTime filter for the next call, i.e. the time of the newest seen event minus the overlap window.
+// It returns an empty string if no event has been seen yet
+func (t *EventDeltaTracker) FromTime() string {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	fromTime := t.fromTime()
+	if fromTime.IsZero() {
+		return ""
+	}
+	return fromTime.UTC().Format(timeutils.KeptnTimeFormatISO8601)
+}
+
+func (t *EventDeltaTracker) fromTime() time.Time {
+	if t.newest.IsZero() {
+		return time.Time{}
+	}
+	return t.newest.Add(-t.overlap)
+}
+
+// Filter removes the events which have already been returned by a previous call and records the remaining ones.
+// The order of the events is kept
+func (t *EventDeltaTracker) Filter(events []*models.KeptnContextExtendedCE) []*models.KeptnContextExtendedCE {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	result := make([]*models.KeptnContextExtendedCE, 0, len(events))
+	fromTime := t.fromTime()
+	for _, event := range events {
+		if event == nil {
+			continue
+		}
+		if _, ok := t.seen[event.ID]; ok {
+			continue
+		}
+		// events before the overlap window have been returned by a previous call, or are too late to be taken into account
+		if !fromTime.IsZero() && !event.Time.IsZero() && event.Time.Before(fromTime) {
+			continue
+		}
+		result = append(result, event)
+		if event.Time.After(t.newest) {
+			t.newest = event.Time
+		}
+	}
+	for _, event := range result {
+		seenAt := event.Time
+		if seenAt.IsZero() {
+			// events without time are kept until they are outside of the overlap window of the newest event
+			seenAt = t.newest
+		}
+		t.seen[event.ID] = seenAt
+	}
+
+	// events outside of the overlap window are not requested again and do not need to be remembered
+	threshold := t.fromTime()
+	seen := make(map[string]time.Time, len(t.seen))
+	for id, seenAt := range t.seen {
+		if !seenAt.Before(threshold) {
+			seen[id] = seenAt
+		}
+	}
+	t.seen = seen
+	return result
+}
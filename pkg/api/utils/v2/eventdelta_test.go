@@ -0,0 +1,60 @@
+package v2
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/keptn/go-utils/pkg/api/models"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEventDeltaTracker(t *testing.T) {
+	base := time.Date(2022, 3, 1, 10, 0, 0, 0, time.UTC)
+	tracker := NewEventDeltaTracker(5 * time.Second)
+	require.Equal(t, "", tracker.FromTime())
+
+	events := tracker.Filter([]*models.KeptnContextExtendedCE{
+		{ID: "1", Time: base},
+		{ID: "2", Time: base.Add(10 * time.Second)},
+	})
+	require.Len(t, events, 2)
+	require.Equal(t, "2022-03-01T10:00:05.000Z", tracker.FromTime())
+
+	// events within the overlap window are returned again by the API and are removed
+	events = tracker.Filter([]*models.KeptnContextExtendedCE{
+		{ID: "2", Time: base.Add(10 * time.Second)},
+		{ID: "3", Time: base.Add(8 * time.Second)},
+		{ID: "4", Time: base.Add(20 * time.Second)},
+	})
+	require.Len(t, events, 2)
+	require.Equal(t, "3", events[0].ID)
+	require.Equal(t, "4", events[1].ID)
+	require.Equal(t, "2022-03-01T10:00:15.000Z", tracker.FromTime())
+
+	// events outside of the overlap window are forgotten
+	require.Len(t, tracker.seen, 1)
+}
+
+func TestEventHandler_GetEvents_Delta(t *testing.T) {
+	var fromTimes []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fromTimes = append(fromTimes, r.URL.Query().Get("fromTime"))
+		w.Write([]byte(`{"events":[{"id":"1","time":"2022-03-01T10:00:00.000Z"},{"id":"2","time":"2022-03-01T10:00:10.000Z"}]}`))
+	}))
+	defer server.Close()
+
+	handler := NewEventHandler(server.URL)
+	opts := EventsGetEventsOptions{Delta: NewEventDeltaTracker(time.Second)}
+
+	events, errObj := handler.GetEvents(context.TODO(), &EventFilter{Project: "my-project"}, opts)
+	require.Nil(t, errObj)
+	require.Len(t, events, 2)
+
+	events, errObj = handler.GetEvents(context.TODO(), &EventFilter{Project: "my-project"}, opts)
+	require.Nil(t, errObj)
+	require.Empty(t, events)
+	require.Equal(t, []string{"", "2022-03-01T10:00:09.000Z"}, fromTimes)
+}
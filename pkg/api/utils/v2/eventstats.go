@@ -0,0 +1,192 @@
+package v2
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/keptn/go-utils/pkg/common/timeutils"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/metric/global"
+	"go.opentelemetry.io/otel/metric/instrument"
+	"go.opentelemetry.io/otel/metric/instrument/asyncint64"
+)
+
+// DefaultEventStatsWindow is the default time window in which events are counted by an EventStatsExporter
+const DefaultEventStatsWindow = time.Hour
+
+// DefaultEventStatsInterval is the default interval in which an EventStatsExporter queries the event counts
+const DefaultEventStatsInterval = time.Minute
+
+// eventStatsGaugeName is the name of the OpenTelemetry gauge the event counts are emitted to
+const eventStatsGaugeName = "keptn.events.recent"
+
+// EventStatsProjectAttribute and EventStatsTypeAttribute are the attributes of the counts emitted as keptn.events.recent
+const (
+	EventStatsProjectAttribute = attribute.Key("keptn.project")
+	EventStatsTypeAttribute    = attribute.Key("event.type")
+)
+
+// EventStatsExporterOptions are options for NewEventStatsExporter()
+type EventStatsExporterOptions struct {
+	// EventTypes are the types of the counted events, e.g. sh.keptn.event.deployment.finished. At least one type is required
+	EventTypes []string
+	// Projects are the projects whose events are counted. If it is empty, the events of all projects are counted
+	Projects []string
+	// Window is the time window in which events are counted. If it is not positive, DefaultEventStatsWindow is used
+	Window time.Duration
+	// Interval is the interval in which Run queries the counts. If it is not positive, DefaultEventStatsInterval is used
+	Interval time.Duration
+	// Meter is the OpenTelemetry meter the gauge is created with. If it is nil, the meter of the global MeterProvider is used
+	Meter metric.Meter
+	// OnError is called if Run fails to query the counts. The counts of the previous query are still emitted
+	OnError func(err error)
+}
+
+// EventCount is the number of events of a type in a project within the window of an EventStatsExporter
+type EventCount struct {
+	Project   string
+	EventType string
+	Count     int64
+}
+
+// EventStatsExporter periodically queries the number of events per type and project received by the datastore
+// within a time window and emits them as OpenTelemetry gauge keptn.events.recent with the attributes keptn.project
+// and event.type. Exported via an OpenMetrics/Prometheus exporter, the gauge allows alerting on anomalies
+// of the event flow, e.g. no deployment.finished events within an hour.
+// Counts of 0 are emitted as well, so that missing events can be told apart from a missing exporter
+type EventStatsExporter struct {
+	api      KeptnInterface
+	opts     EventStatsExporterOptions
+	gauge    asyncint64.Gauge
+	mtx      sync.RWMutex
+	counts   []EventCount
+	lastTime time.Time
+}
+
+// NewEventStatsExporter creates an EventStatsExporter querying the events via the given KeptnInterface and registers its gauge.
+// The counts are only emitted once they have been queried by Collect or Run
+func NewEventStatsExporter(api KeptnInterface, opts EventStatsExporterOptions) (*EventStatsExporter, error) {
+	if len(opts.EventTypes) == 0 {
+		return nil, errors.New("unable to create event stats exporter: at least one event type is required")
+	}
+	if opts.Window <= 0 {
+		opts.Window = DefaultEventStatsWindow
+	}
+	if opts.Interval <= 0 {
+		opts.Interval = DefaultEventStatsInterval
+	}
+	if opts.Meter == nil {
+		opts.Meter = global.Meter("github.com/keptn/go-utils/pkg/api/utils/v2")
+	}
+	e := &EventStatsExporter{api: api, opts: opts}
+
+	gauge, err := opts.Meter.AsyncInt64().Gauge(
+		eventStatsGaugeName,
+		instrument.WithDescription(fmt.Sprintf("number of events per project and type received within the last %s", opts.Window)),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create event stats exporter: %w", err)
+	}
+	e.gauge = gauge
+	if err := opts.Meter.RegisterCallback([]instrument.Asynchronous{gauge}, e.observe); err != nil {
+		return nil, fmt.Errorf("unable to create event stats exporter: %w", err)
+	}
+	return e, nil
+}
+
+// Run queries the counts in the interval of the exporter until the context is done
+func (e *EventStatsExporter) Run(ctx context.Context) {
+	ticker := time.NewTicker(e.opts.Interval)
+	defer ticker.Stop()
+	for {
+		if err := e.Collect(ctx); err != nil && ctx.Err() == nil && e.opts.OnError != nil {
+			e.opts.OnError(err)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Collect queries the current counts once. If a query fails, the counts of the previous query are kept
+func (e *EventStatsExporter) Collect(ctx context.Context) error {
+	projects := e.opts.Projects
+	if len(projects) == 0 {
+		allProjects, err := e.api.Projects().GetAllProjects(ctx, ProjectsGetAllProjectsOptions{})
+		if err != nil {
+			return fmt.Errorf("could not retrieve projects: %w", err)
+		}
+		for _, project := range allProjects {
+			projects = append(projects, project.ProjectName)
+		}
+	}
+
+	now := time.Now().UTC()
+	fromTime := timeutils.GetKeptnTimeStamp(now.Add(-e.opts.Window))
+	counts := make([]EventCount, 0, len(projects)*len(e.opts.EventTypes))
+	for _, project := range projects {
+		for _, eventType := range e.opts.EventTypes {
+			count, err := e.count(ctx, project, eventType, fromTime)
+			if err != nil {
+				return fmt.Errorf("could not count %s events of project %s: %w", eventType, project, err)
+			}
+			counts = append(counts, EventCount{Project: project, EventType: eventType, Count: count})
+		}
+	}
+	sort.Slice(counts, func(i, j int) bool {
+		if counts[i].Project != counts[j].Project {
+			return counts[i].Project < counts[j].Project
+		}
+		return counts[i].EventType < counts[j].EventType
+	})
+
+	e.mtx.Lock()
+	defer e.mtx.Unlock()
+	e.counts = counts
+	e.lastTime = now
+	return nil
+}
+
+// count returns the number of events, which is taken from the total count of the first page of a single event
+func (e *EventStatsExporter) count(ctx context.Context, project string, eventType string, fromTime string) (int64, error) {
+	var totalCount int
+	events, mErr := e.api.Events().GetEvents(ctx, &EventFilter{
+		Project:       project,
+		EventType:     eventType,
+		FromTime:      fromTime,
+		PageSize:      "1",
+		NumberOfPages: 1,
+	}, EventsGetEventsOptions{
+		OnProgress: func(progress PageProgress) {
+			totalCount = progress.TotalCount
+		},
+	})
+	if mErr != nil {
+		return 0, mErr.ToError()
+	}
+	if totalCount < len(events) {
+		totalCount = len(events)
+	}
+	return int64(totalCount), nil
+}
+
+// Counts returns the counts of the last successful query and the time of the query, which is zero if there was none
+func (e *EventStatsExporter) Counts() ([]EventCount, time.Time) {
+	e.mtx.RLock()
+	defer e.mtx.RUnlock()
+	return append([]EventCount{}, e.counts...), e.lastTime
+}
+
+func (e *EventStatsExporter) observe(ctx context.Context) {
+	counts, _ := e.Counts()
+	for _, count := range counts {
+		e.gauge.Observe(ctx, count.Count, EventStatsProjectAttribute.String(count.Project), EventStatsTypeAttribute.String(count.EventType))
+	}
+}
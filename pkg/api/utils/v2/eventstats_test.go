@@ -0,0 +1,121 @@
+package v2
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func newEventStatsServer(t *testing.T, failEvents *int32) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case strings.HasSuffix(r.URL.Path, "/v1/project"):
+			w.Write([]byte(`{"projects":[{"projectName":"sockshop"},{"projectName":"podtato"}],"totalCount":2}`))
+		case strings.HasSuffix(r.URL.Path, "/mongodb-datastore/event"):
+			if atomic.LoadInt32(failEvents) == 1 {
+				w.WriteHeader(http.StatusInternalServerError)
+				return
+			}
+			query := r.URL.Query()
+			require.Equal(t, "1", query.Get("pageSize"))
+			require.NotEmpty(t, query.Get("fromTime"))
+			totalCount := 0
+			if query.Get("project") == "sockshop" && query.Get("type") == "sh.keptn.event.deployment.finished" {
+				totalCount = 7
+			}
+			events := `[]`
+			if totalCount > 0 {
+				events = `[{"id":"1"}]`
+			}
+			fmt.Fprintf(w, `{"events":%s,"totalCount":%d}`, events, totalCount)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+}
+
+func TestEventStatsExporter_Collect(t *testing.T) {
+	var failEvents int32
+	server := newEventStatsServer(t, &failEvents)
+	defer server.Close()
+
+	apiSet, err := New(server.URL)
+	require.Nil(t, err)
+
+	exporter, err := NewEventStatsExporter(apiSet, EventStatsExporterOptions{
+		EventTypes: []string{"sh.keptn.event.deployment.finished", "sh.keptn.event.evaluation.finished"},
+	})
+	require.Nil(t, err)
+
+	counts, lastTime := exporter.Counts()
+	require.Empty(t, counts)
+	require.True(t, lastTime.IsZero())
+
+	require.Nil(t, exporter.Collect(context.TODO()))
+	expected := []EventCount{
+		{Project: "podtato", EventType: "sh.keptn.event.deployment.finished", Count: 0},
+		{Project: "podtato", EventType: "sh.keptn.event.evaluation.finished", Count: 0},
+		{Project: "sockshop", EventType: "sh.keptn.event.deployment.finished", Count: 7},
+		{Project: "sockshop", EventType: "sh.keptn.event.evaluation.finished", Count: 0},
+	}
+	counts, lastTime = exporter.Counts()
+	require.Equal(t, expected, counts)
+	require.False(t, lastTime.IsZero())
+
+	// the counts of the previous query are kept if a query fails
+	atomic.StoreInt32(&failEvents, 1)
+	require.NotNil(t, exporter.Collect(context.TODO()))
+	counts, _ = exporter.Counts()
+	require.Equal(t, expected, counts)
+}
+
+func TestEventStatsExporter_Run(t *testing.T) {
+	var failEvents int32 = 1
+	server := newEventStatsServer(t, &failEvents)
+	defer server.Close()
+
+	apiSet, err := New(server.URL)
+	require.Nil(t, err)
+
+	errs := make(chan error, 10)
+	exporter, err := NewEventStatsExporter(apiSet, EventStatsExporterOptions{
+		EventTypes: []string{"sh.keptn.event.deployment.finished"},
+		Projects:   []string{"sockshop"},
+		Interval:   10 * time.Millisecond,
+		OnError: func(err error) {
+			select {
+			case errs <- err:
+			default:
+			}
+		},
+	})
+	require.Nil(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		exporter.Run(ctx)
+		close(done)
+	}()
+	require.NotNil(t, <-errs)
+
+	atomic.StoreInt32(&failEvents, 0)
+	require.Eventually(t, func() bool {
+		counts, _ := exporter.Counts()
+		return len(counts) == 1 && counts[0].Count == 7
+	}, time.Second, 10*time.Millisecond)
+	cancel()
+	<-done
+}
+
+func TestNewEventStatsExporter_RequiresEventTypes(t *testing.T) {
+	_, err := NewEventStatsExporter(nil, EventStatsExporterOptions{})
+	require.NotNil(t, err)
+}
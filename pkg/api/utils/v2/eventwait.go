@@ -0,0 +1,179 @@
+package v2
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/keptn/go-utils/pkg/api/models"
+	"github.com/keptn/go-utils/pkg/common/retry"
+	"github.com/keptn/go-utils/pkg/common/timeutils"
+)
+
+// DefaultWaitPollInterval is the default delay between two requests of WaitForNewEvents if the datastore does not support long-polling
+const DefaultWaitPollInterval = time.Second
+
+// DefaultLongPollWait is the default time the datastore is asked to hold a request of WaitForNewEvents until new events are stored
+const DefaultLongPollWait = 30 * time.Second
+
+// EventsWaitForNewEventsOptions are options for EventHandler.WaitForNewEvents().
+type EventsWaitForNewEventsOptions struct {
+	// PollInterval is the delay between two requests if the datastore does not support long-polling.
+	// If it is not positive, DefaultWaitPollInterval is used
+	PollInterval time.Duration
+	// LongPollWait is the time the datastore is asked to hold a request until new events are stored.
+	// If it is not positive, DefaultLongPollWait is used
+	LongPollWait time.Duration
+}
+
+// WaitForNewEvents blocks until events matching the filter which are newer than since have been stored, and returns them
+// sorted by time. All pages of events newer than since are retrieved, so callers can call it again with the time of the newest
+// returned event without missing any.
+//
+// The datastore is asked to hold each request until new events are stored via the header "Prefer: wait=<seconds>" (RFC 7240).
+// If it supports long-polling, i.e. it responds with a Preference-Applied header, the next request is sent immediately.
+// Otherwise, WaitForNewEvents falls back to polling every PollInterval. In both cases, the ETag of the previous response
+// is sent as If-None-Match, so that datastores supporting conditional requests can answer 304 Not Modified without events.
+// Requests are sent with the LongPollOperation class, see WithOperationClasses.
+// If the context is cancelled, the returned error contains a *retry.CancelledError
+func (e *EventHandler) WaitForNewEvents(ctx context.Context, filter *EventFilter, since time.Time, opts EventsWaitForNewEventsOptions) ([]*models.KeptnContextExtendedCE, *models.Error) {
+	pollInterval := opts.PollInterval
+	if pollInterval <= 0 {
+		pollInterval = DefaultWaitPollInterval
+	}
+	longPollWait := opts.LongPollWait
+	if longPollWait <= 0 {
+		longPollWait = DefaultLongPollWait
+	}
+
+	query := eventFilterQuery(filter)
+	query.Set("fromTime", since.UTC().Format(timeutils.KeptnTimeFormatISO8601))
+	u, err := url.Parse(e.scheme + "://" + e.getBaseURL() + "/event")
+	if err != nil {
+		return nil, invalidURLResponse(err)
+	}
+	u.RawQuery = query.Encode()
+
+	w := &eventWaiter{
+		handler: e,
+		uri:     u.String(),
+		since:   since,
+		wait:    longPollWait,
+	}
+	var events []*models.KeptnContextExtendedCE
+	var mErr *models.Error
+	err = retry.Poll(ContextWithOperationClass(ctx, LongPollOperation), pollInterval, pollInterval, func(ctx context.Context) (bool, error) {
+		for {
+			var longPolled bool
+			events, longPolled, mErr = w.request(ctx)
+			if mErr != nil {
+				// errors caused by cancelling the context are reported via retry.CancelledError
+				return ctx.Err() == nil, nil
+			}
+			if len(events) > 0 {
+				return true, nil
+			}
+			retry.ObserveState(ctx, "no new events")
+			if !longPolled || ctx.Err() != nil {
+				return false, nil
+			}
+		}
+	})
+	if err != nil {
+		return nil, requestErrorResponse(err)
+	}
+	return events, mErr
+}
+
+// eventWaiter holds the state of the requests of a single call of WaitForNewEvents
+type eventWaiter struct {
+	handler *EventHandler
+	uri     string
+	since   time.Time
+	wait    time.Duration
+	etag    string
+}
+
+// request requests the events newer than since once, including the following pages. It returns whether the datastore
+// applied the long-poll preference, i.e. it has already waited for new events
+func (w *eventWaiter) request(ctx context.Context) ([]*models.KeptnContextExtendedCE, bool, *models.Error) {
+	ctx, requestID := ensureRequestID(ctx)
+	header := http.Header{}
+	header.Set("Prefer", "wait="+strconv.Itoa(int(w.wait.Seconds())))
+	if w.etag != "" {
+		header.Set("If-None-Match", w.etag)
+	}
+	body, statusCode, status, respHeader, mErr := getWithHeader(ctx, w.uri, header, w.handler)
+	if mErr != nil {
+		return nil, false, mErr
+	}
+	longPolled := strings.Contains(strings.ToLower(respHeader.Get("Preference-Applied")), "wait")
+
+	switch {
+	case statusCode == http.StatusNotModified:
+		return nil, longPolled, nil
+	case statusCode != http.StatusOK && len(body) > 0:
+		return nil, false, withRequestID(handleErrStatusCode(statusCode, respHeader, body, w.handler.getErrorDetails()), requestID)
+	case statusCode != http.StatusOK:
+		return nil, false, withRequestID(buildErrorResponse(fmt.Sprintf("Received unexpected response: %d %s", statusCode, status)), requestID)
+	}
+
+	if etag := respHeader.Get("ETag"); etag != "" {
+		w.etag = etag
+	}
+	received := &models.Events{}
+	if err := received.FromJSON(body); err != nil {
+		return nil, false, buildErrorResponse(err.Error())
+	}
+	events, reachedSince := w.newEvents(nil, received.Events)
+	// the datastore returns the newest events first, so the following pages have to be retrieved until since is reached
+	for !reachedSince {
+		cursor, err := received.Cursor()
+		if err != nil {
+			return nil, false, buildErrorResponse(err.Error())
+		}
+		if cursor.IsEnd() {
+			break
+		}
+		u, err := url.Parse(w.uri)
+		if err != nil {
+			return nil, false, invalidURLResponse(err)
+		}
+		q := u.Query()
+		q.Set("nextPageKey", cursor.Encode())
+		u.RawQuery = q.Encode()
+		body, mErr := getAndExpectOK(ctx, u.String(), w.handler)
+		if mErr != nil {
+			return nil, false, mErr
+		}
+		received = &models.Events{}
+		if err := received.FromJSON(body); err != nil {
+			return nil, false, buildErrorResponse(err.Error())
+		}
+		events, reachedSince = w.newEvents(events, received.Events)
+	}
+	SortByTime(events)
+	return events, longPolled, nil
+}
+
+// newEvents appends the events of a page which are newer than since to the given events.
+// It returns whether the page contains an event which is not newer than since, i.e. whether since has been reached
+func (w *eventWaiter) newEvents(events []*models.KeptnContextExtendedCE, page []*models.KeptnContextExtendedCE) ([]*models.KeptnContextExtendedCE, bool) {
+	reachedSince := false
+	for _, event := range page {
+		if event == nil {
+			continue
+		}
+		// fromTime is inclusive, and its precision is milliseconds
+		if event.Time.After(w.since) {
+			events = append(events, event)
+		} else {
+			reachedSince = true
+		}
+	}
+	return events, reachedSince
+}
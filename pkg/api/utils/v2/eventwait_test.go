@@ -0,0 +1,131 @@
+package v2
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/keptn/go-utils/pkg/common/retry"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEventHandler_WaitForNewEventsLongPoll(t *testing.T) {
+	since := time.Date(2022, 1, 1, 10, 0, 0, 0, time.UTC)
+	stored := make(chan struct{})
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		require.Equal(t, "wait=1", r.Header.Get("Prefer"))
+		require.Equal(t, "2022-01-01T10:00:00.000Z", r.URL.Query().Get("fromTime"))
+		require.Equal(t, "sh.keptn.event.dev.delivery.triggered", r.URL.Query().Get("type"))
+		w.Header().Set("Preference-Applied", "wait=1")
+		select {
+		case <-stored:
+			w.Write([]byte(`{"events":[{"id":"new","time":"2022-01-01T10:00:02.000Z"},{"id":"old","time":"2022-01-01T10:00:00.000Z"},{"id":"newer","time":"2022-01-01T10:00:01.000Z"}]}`))
+		case <-time.After(20 * time.Millisecond):
+			w.Write([]byte(`{"events":[]}`))
+		}
+	}))
+	defer server.Close()
+
+	go func() {
+		time.Sleep(50 * time.Millisecond)
+		close(stored)
+	}()
+	events, mErr := NewEventHandler(server.URL).WaitForNewEvents(context.TODO(), &EventFilter{EventType: "sh.keptn.event.dev.delivery.triggered"}, since, EventsWaitForNewEventsOptions{
+		// polling would make the test time out
+		PollInterval: time.Hour,
+		LongPollWait: time.Second,
+	})
+	require.Nil(t, mErr)
+	require.Len(t, events, 2)
+	require.Equal(t, "newer", events[0].ID)
+	require.Equal(t, "new", events[1].ID)
+	require.Greater(t, requests, 1)
+}
+
+func TestEventHandler_WaitForNewEventsPolling(t *testing.T) {
+	mtx := sync.Mutex{}
+	ifNoneMatch := []string{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mtx.Lock()
+		defer mtx.Unlock()
+		ifNoneMatch = append(ifNoneMatch, r.Header.Get("If-None-Match"))
+		switch len(ifNoneMatch) {
+		case 1:
+			w.Header().Set("ETag", `"1"`)
+			w.Write([]byte(`{"events":[]}`))
+		case 2:
+			w.WriteHeader(http.StatusNotModified)
+		default:
+			w.Write([]byte(`{"events":[{"id":"new","time":"2022-01-01T10:00:01.000Z"}]}`))
+		}
+	}))
+	defer server.Close()
+
+	since := time.Date(2022, 1, 1, 10, 0, 0, 0, time.UTC)
+	events, mErr := NewEventHandler(server.URL).WaitForNewEvents(context.TODO(), &EventFilter{}, since, EventsWaitForNewEventsOptions{PollInterval: time.Millisecond})
+	require.Nil(t, mErr)
+	require.Len(t, events, 1)
+	require.Equal(t, []string{"", `"1"`, `"1"`}, ifNoneMatch)
+}
+
+func TestEventHandler_WaitForNewEventsMultiplePages(t *testing.T) {
+	nextPageKeys := []string{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		nextPageKey := r.URL.Query().Get("nextPageKey")
+		nextPageKeys = append(nextPageKeys, nextPageKey)
+		require.Equal(t, "2022-01-01T10:00:00.000Z", r.URL.Query().Get("fromTime"))
+		switch nextPageKey {
+		case "":
+			w.Write([]byte(`{"events":[{"id":"4","time":"2022-01-01T10:00:04.000Z"},{"id":"3","time":"2022-01-01T10:00:03.000Z"}],"nextPageKey":"2"}`))
+		case "2":
+			w.Write([]byte(`{"events":[{"id":"2","time":"2022-01-01T10:00:02.000Z"},{"id":"1","time":"2022-01-01T10:00:01.000Z"}],"nextPageKey":"4"}`))
+		default:
+			w.Write([]byte(`{"events":[{"id":"0","time":"2022-01-01T10:00:00.000Z"}],"nextPageKey":"6"}`))
+		}
+	}))
+	defer server.Close()
+
+	since := time.Date(2022, 1, 1, 10, 0, 0, 0, time.UTC)
+	events, mErr := NewEventHandler(server.URL).WaitForNewEvents(context.TODO(), &EventFilter{}, since, EventsWaitForNewEventsOptions{PollInterval: time.Millisecond})
+	require.Nil(t, mErr)
+	require.Len(t, events, 4)
+	for i, event := range events {
+		require.Equal(t, strconv.Itoa(i+1), event.ID)
+	}
+	require.Equal(t, []string{"", "2", "4"}, nextPageKeys)
+}
+
+func TestEventHandler_WaitForNewEventsError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(`{"code":400,"message":"invalid filter"}`))
+	}))
+	defer server.Close()
+
+	_, mErr := NewEventHandler(server.URL).WaitForNewEvents(context.TODO(), &EventFilter{}, time.Now(), EventsWaitForNewEventsOptions{PollInterval: time.Millisecond})
+	require.NotNil(t, mErr)
+	require.Equal(t, int64(http.StatusBadRequest), mErr.Code)
+	require.Equal(t, "invalid filter", mErr.GetMessage())
+}
+
+func TestEventHandler_WaitForNewEventsCancelled(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"events":[]}`))
+	}))
+	defer server.Close()
+
+	ctx, cancel := context.WithTimeout(context.TODO(), 50*time.Millisecond)
+	defer cancel()
+	_, mErr := NewEventHandler(server.URL).WaitForNewEvents(ctx, &EventFilter{}, time.Now(), EventsWaitForNewEventsOptions{PollInterval: time.Millisecond})
+	require.NotNil(t, mErr)
+	require.ErrorIs(t, mErr.ToError(), context.DeadlineExceeded)
+	cancelled := &retry.CancelledError{}
+	require.ErrorAs(t, mErr.ToError(), &cancelled)
+	require.Equal(t, "no new events", cancelled.LastState)
+}
@@ -3,7 +3,6 @@ package v2
 import (
 	"context"
 	"log"
-	"sort"
 	"time"
 
 	"github.com/keptn/go-utils/pkg/api/models"
@@ -58,7 +57,7 @@ func (ew *EventWatcher) queryEvents(filter EventFilter) []*models.KeptnContextEx
 	filter.FromTime = ew.nextCEFetchTime.Format("2006-01-02T15:04:05.000Z")
 	events, err := ew.eventHandler.GetEvents(&filter)
 	if err != nil {
-		log.Printf("Unable to fetch events: %s", *err.Message)
+		log.Printf("Unable to fetch events (request ID %s): %s", err.RequestID, *err.Message)
 	}
 	SortByTime(events)
 	if len(events) > 0 {
@@ -129,9 +128,8 @@ type EventHandlerInterface interface {
 // EventManipulatorFunc can be used to manipulate a slice of events
 type EventManipulatorFunc func([]*models.KeptnContextExtendedCE)
 
-// SortByTime sorts the event slice by time (oldest to newest)
+// SortByTime sorts the event slice by time (oldest to newest).
+// Events with the same time are sorted by ID, see models.EventOrderKey
 func SortByTime(events []*models.KeptnContextExtendedCE) {
-	sort.Slice(events, func(i, j int) bool {
-		return events[i].Time.Before(events[j].Time)
-	})
+	models.SortEvents(events)
 }
@@ -134,3 +134,15 @@ func TestSortedGetter(t *testing.T) {
 		fmt.Println(e.Time)
 	}
 }
+
+func TestSortByTimeWithCollidingTimes(t *testing.T) {
+	events := []*models.KeptnContextExtendedCE{
+		{ID: "b", Time: t0},
+		{ID: "c", Time: t0.Add(-time.Millisecond)},
+		{ID: "a", Time: t0},
+	}
+	SortByTime(events)
+	assert.Equal(t, "c", events[0].ID)
+	assert.Equal(t, "a", events[1].ID)
+	assert.Equal(t, "b", events[2].ID)
+}
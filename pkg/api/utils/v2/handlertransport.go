@@ -0,0 +1,162 @@
+package v2
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"net/http"
+	"sort"
+)
+
+// Names of the handlers of an APISet, as used by WithHandlerTransport and as keys of APISetStats
+const (
+	HandlerAPI             = "api"
+	HandlerAuth            = "auth"
+	HandlerEvents          = "events"
+	HandlerLogs            = "logs"
+	HandlerProjects        = "projects"
+	HandlerResources       = "resources"
+	HandlerSecrets         = "secrets"
+	HandlerSequences       = "sequences"
+	HandlerServices        = "services"
+	HandlerShipyardControl = "shipyardControl"
+	HandlerStages          = "stages"
+	HandlerUniform         = "uniform"
+)
+
+// handlerNames contains the names of all handlers of an APISet
+var handlerNames = map[string]bool{
+	HandlerAPI: true, HandlerAuth: true, HandlerEvents: true, HandlerLogs: true, HandlerProjects: true, HandlerResources: true,
+	HandlerSecrets: true, HandlerSequences: true, HandlerServices: true, HandlerShipyardControl: true, HandlerStages: true, HandlerUniform: true,
+}
+
+// HandlerTransport overrides the scheme and transport settings of a single handler of an APISet, see WithHandlerTransport
+type HandlerTransport struct {
+	// Scheme is used by the handler instead of the scheme of the APISet, e.g. "http" for a service which is reached
+	// within the cluster while the gateway terminates TLS. It is checked against the SchemePolicy of the APISet
+	Scheme string
+	// TLSConfig is used for the connections of the handler instead of the TLS config of the APISet,
+	// e.g. to trust the CA of a single service. Certificates pinned via WithPinnedCertificates or WithSPKIPins are still checked
+	TLSConfig *tls.Config
+	// Transport is used for the connections of the handler instead of the transport of the APISet.
+	// If it is a *http.Transport, its TLS config and proxy are kept unless TLSConfig is set, or it has no proxy while
+	// the APISet has proxy settings. Certificates pinned for the APISet are checked after its VerifyPeerCertificate, if any.
+	// If it is nil, a new *http.Transport with the same defaults as the transport of the APISet is created
+	Transport http.RoundTripper
+}
+
+// WithHandlerTransport overrides the scheme and transport settings of the handler with the given name, e.g. HandlerResources.
+// All other settings, e.g. auth, retries, policies and the allowed hosts, are shared with the other handlers of the APISet.
+// New fails for unknown handler names
+func WithHandlerTransport(handler string, transport HandlerTransport) func(*APISet) {
+	return func(a *APISet) {
+		if a.handlerTransports == nil {
+			a.handlerTransports = map[string]HandlerTransport{}
+		}
+		a.handlerTransports[handler] = transport
+	}
+}
+
+// handlerScheme returns the scheme used by the handler with the given name
+func (c *APISet) handlerScheme(name string) string {
+	if override, ok := c.handlerTransports[name]; ok && override.Scheme != "" {
+		return override.Scheme
+	}
+	return c.scheme
+}
+
+// resolveHandlerSchemes checks the schemes of the handler transports against the SchemePolicy of the APISet
+func (c *APISet) resolveHandlerSchemes(baseURL string) error {
+	for _, name := range sortedHandlerTransports(c.handlerTransports) {
+		override := c.handlerTransports[name]
+		if override.Scheme == "" {
+			continue
+		}
+		scheme, err := c.schemePolicy.resolveScheme(baseURL, override.Scheme)
+		if err != nil {
+			return fmt.Errorf("handler %s: %w", name, err)
+		}
+		if err := validateBaseURL(baseURL, scheme); err != nil {
+			return fmt.Errorf("handler %s: %w", name, err)
+		}
+		override.Scheme = scheme
+		c.handlerTransports[name] = override
+	}
+	return nil
+}
+
+func sortedHandlerTransports(overrides map[string]HandlerTransport) []string {
+	names := make([]string, 0, len(overrides))
+	for name := range overrides {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+type handlerContextKey struct{}
+
+// handlerNameTransport is a http.RoundTripper marking the requests sent through it with the name of a handler,
+// so that handlerDispatchTransport can send them via the transport of the handler and slowCallTransport can report them
+type handlerNameTransport struct {
+	base http.RoundTripper
+	name string
+}
+
+func (t *handlerNameTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	return t.base.RoundTrip(req.WithContext(context.WithValue(req.Context(), handlerContextKey{}, t.name)))
+}
+
+// handlerDispatchTransport is a http.RoundTripper sending requests via the transport of the handler they have been sent by,
+// or via the transport of the APISet for handlers without a HandlerTransport
+type handlerDispatchTransport struct {
+	base     http.RoundTripper
+	handlers map[string]http.RoundTripper
+}
+
+// newHandlerDispatchTransport creates the transports of the handlers with a HandlerTransport containing transport settings.
+// The certificate pins and proxy settings of the APISet are applied to them, if any
+func newHandlerDispatchTransport(base http.RoundTripper, overrides map[string]HandlerTransport, pins *certificatePins, proxy *proxyConfig) (*handlerDispatchTransport, error) {
+	t := &handlerDispatchTransport{base: base, handlers: map[string]http.RoundTripper{}}
+	for _, name := range sortedHandlerTransports(overrides) {
+		override := overrides[name]
+		if override.TLSConfig == nil && override.Transport == nil {
+			continue
+		}
+		rt := override.Transport
+		if rt == nil {
+			// the same defaults as for the transport of the APISet, see getClientTransport
+			rt = &http.Transport{TLSClientConfig: &tls.Config{InsecureSkipVerify: true}, Proxy: http.ProxyFromEnvironment}
+		}
+		if tr, ok := rt.(*http.Transport); ok {
+			tr = tr.Clone()
+			if override.TLSConfig != nil {
+				tr.TLSClientConfig = override.TLSConfig.Clone()
+			}
+			if pins != nil {
+				if tr.TLSClientConfig == nil {
+					tr.TLSClientConfig = &tls.Config{}
+				}
+				tr.TLSClientConfig.VerifyPeerCertificate = chainVerifyPeerCertificate(tr.TLSClientConfig.VerifyPeerCertificate, pins.verifyPeerCertificate)
+			}
+			// a proxy set on the transport of the handler takes precedence over the proxy settings of the APISet
+			if proxy != nil && (override.Transport == nil || tr.Proxy == nil) {
+				proxy.apply(tr)
+			}
+			rt = tr
+		} else if override.TLSConfig != nil {
+			return nil, fmt.Errorf("handler %s: a TLS config requires a *http.Transport", name)
+		}
+		t.handlers[name] = wrapOtelTransport(rt)
+	}
+	return t, nil
+}
+
+func (t *handlerDispatchTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if name, ok := req.Context().Value(handlerContextKey{}).(string); ok {
+		if rt, ok := t.handlers[name]; ok {
+			return rt.RoundTrip(req)
+		}
+	}
+	return t.base.RoundTrip(req)
+}
@@ -0,0 +1,111 @@
+package v2
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/keptn/go-utils/pkg/api/models"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWithHandlerTransport_TLSConfig(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"events":[]}`))
+	}))
+	defer server.Close()
+
+	trusted := x509.NewCertPool()
+	trusted.AddCert(server.Certificate())
+	apiSet, err := New(server.URL,
+		WithHandlerTransport(HandlerEvents, HandlerTransport{TLSConfig: &tls.Config{RootCAs: trusted}}),
+		WithHandlerTransport(HandlerProjects, HandlerTransport{TLSConfig: &tls.Config{RootCAs: x509.NewCertPool()}}),
+	)
+	require.Nil(t, err)
+
+	_, mErr := apiSet.Events().GetEvents(context.TODO(), &EventFilter{}, EventsGetEventsOptions{})
+	require.Nil(t, mErr)
+	require.Equal(t, int64(1), apiSet.Stats()[HandlerEvents].Requests)
+
+	_, mErr = apiSet.Projects().GetProject(context.TODO(), models.Project{ProjectName: "sockshop"}, ProjectsGetProjectOptions{})
+	require.NotNil(t, mErr)
+	require.ErrorIs(t, mErr.ToError(), ErrTLSVerification)
+
+	// handlers without override keep skipping the verification
+	_, err = apiSet.Stages().GetAllStages(context.TODO(), "sockshop", StagesGetAllStagesOptions{})
+	require.Nil(t, err)
+}
+
+func TestWithHandlerTransport_KeepsTLSConfigOfTransport(t *testing.T) {
+	server := newPinningTestServer()
+	defer server.Close()
+
+	trusted := x509.NewCertPool()
+	trusted.AddCert(server.Certificate())
+	verified := 0
+	apiSet, err := New(server.URL,
+		WithPinnedCertificates(certificateFingerprint(server)),
+		WithHandlerTransport(HandlerProjects, HandlerTransport{Transport: &http.Transport{TLSClientConfig: &tls.Config{
+			RootCAs: trusted,
+			VerifyPeerCertificate: func(rawCerts [][]byte, verifiedChains [][]*x509.Certificate) error {
+				verified++
+				return nil
+			},
+		}}}),
+		WithHandlerTransport(HandlerStages, HandlerTransport{Transport: &http.Transport{TLSClientConfig: &tls.Config{RootCAs: x509.NewCertPool()}}}),
+	)
+	require.Nil(t, err)
+
+	_, mErr := apiSet.Projects().GetProject(context.TODO(), models.Project{ProjectName: "sockshop"}, ProjectsGetProjectOptions{})
+	require.Nil(t, mErr)
+	require.Equal(t, 1, verified)
+
+	// the TLS config of the transport is not replaced by the one of the APISet, which skips the verification
+	_, err = apiSet.Stages().GetAllStages(context.TODO(), "sockshop", StagesGetAllStagesOptions{})
+	require.ErrorIs(t, err, ErrTLSVerification)
+}
+
+func TestWithHandlerTransport_Scheme(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"events":[]}`))
+	}))
+	defer server.Close()
+
+	apiSet, err := New(server.URL, WithScheme("https"), WithHandlerTransport(HandlerEvents, HandlerTransport{Scheme: "http"}))
+	require.Nil(t, err)
+
+	_, mErr := apiSet.Events().GetEvents(context.TODO(), &EventFilter{}, EventsGetEventsOptions{})
+	require.Nil(t, mErr)
+	_, mErr = apiSet.Projects().GetProject(context.TODO(), models.Project{ProjectName: "sockshop"}, ProjectsGetProjectOptions{})
+	require.NotNil(t, mErr)
+}
+
+func TestWithHandlerTransport_Invalid(t *testing.T) {
+	_, err := New("https://keptn.example.com", WithHandlerTransport("unknown", HandlerTransport{}))
+	require.ErrorIs(t, err, ErrInvalidConfiguration)
+
+	_, err = New("https://keptn.example.com", WithSchemePolicy(RequireHTTPS), WithHandlerTransport(HandlerResources, HandlerTransport{Scheme: "http"}))
+	require.ErrorIs(t, err, ErrPlaintextEndpoint)
+
+	_, err = New("https://keptn.example.com", WithHandlerTransport(HandlerResources, HandlerTransport{Scheme: "ftp"}))
+	require.ErrorIs(t, err, ErrInvalidConfiguration)
+
+	_, err = New("https://keptn.example.com", WithHandlerTransport(HandlerResources, HandlerTransport{
+		TLSConfig: &tls.Config{},
+		Transport: http.NewFileTransport(http.Dir(".")),
+	}))
+	require.NotNil(t, err)
+}
+
+func TestWithHandlerTransport_Capabilities(t *testing.T) {
+	apiSet, err := New("https://keptn.example.com",
+		WithHandlerTransport(HandlerResources, HandlerTransport{Scheme: "http"}),
+		WithHandlerTransport(HandlerEvents, HandlerTransport{TLSConfig: &tls.Config{}}),
+	)
+	require.Nil(t, err)
+	require.Equal(t, []string{HandlerEvents, HandlerResources}, apiSet.Capabilities().HandlerTransports)
+	require.Contains(t, apiSet.Capabilities().String(), "handlerTransports=events,resources")
+}
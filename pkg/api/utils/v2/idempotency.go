@@ -0,0 +1,62 @@
+package v2
+
+import (
+	"net/http"
+
+	"github.com/keptn/go-utils/pkg/api/models"
+)
+
+// idempotencyOptions configures which failed create and delete requests are treated as success,
+// see WithIgnoreNotFoundOnDelete and WithAcceptAlreadyExists
+type idempotencyOptions struct {
+	ignoreNotFoundOnDelete bool
+	acceptAlreadyExists    bool
+}
+
+// notFoundOnDelete returns whether the given error of a delete request is a 404 Not Found that is treated as success
+func (o idempotencyOptions) notFoundOnDelete(err *models.Error) bool {
+	return o.ignoreNotFoundOnDelete && err != nil && err.Code == http.StatusNotFound
+}
+
+// alreadyExists returns whether the given error of a create request is a 409 Conflict that is treated as success
+func (o idempotencyOptions) alreadyExists(err *models.Error) bool {
+	return o.acceptAlreadyExists && err != nil && err.Code == http.StatusConflict
+}
+
+// WithIgnoreNotFoundOnDelete treats a 404 Not Found response to a delete request as success,
+// so that deleting an entity that does not exist anymore does not fail.
+// Results of such requests have their AlreadyDeleted flag set, if they provide one
+func WithIgnoreNotFoundOnDelete() func(*APISet) {
+	return func(a *APISet) {
+		a.idempotency.ignoreNotFoundOnDelete = true
+	}
+}
+
+// WithAcceptAlreadyExists treats a 409 Conflict response to a create request as success,
+// so that creating an entity that already exists does not fail.
+// Results of such requests have their AlreadyExists flag set, if they provide one.
+// APIHandler.CreateProject and APIHandler.CreateService, whose results do not provide the flag, return an empty response instead.
+// Use ProjectsInterface.CreateProject and ServicesInterface.CreateServiceInStage to find out whether the entity already existed
+func WithAcceptAlreadyExists() func(*APISet) {
+	return func(a *APISet) {
+		a.idempotency.acceptAlreadyExists = true
+	}
+}
+
+// created returns the result of a create request, replacing an accepted 409 Conflict with an event context
+// that has its AlreadyExists flag set
+func (o idempotencyOptions) created(eventContext *models.EventContext, err *models.Error) (*models.EventContext, *models.Error) {
+	if o.alreadyExists(err) {
+		return &models.EventContext{AlreadyExists: true}, nil
+	}
+	return eventContext, err
+}
+
+// deleted returns the result of a delete request, replacing an ignored 404 Not Found with an event context
+// that has its AlreadyDeleted flag set
+func (o idempotencyOptions) deleted(eventContext *models.EventContext, err *models.Error) (*models.EventContext, *models.Error) {
+	if o.notFoundOnDelete(err) {
+		return &models.EventContext{AlreadyDeleted: true}, nil
+	}
+	return eventContext, err
+}
@@ -0,0 +1,92 @@
+package v2
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/keptn/go-utils/pkg/api/models"
+	"github.com/keptn/go-utils/pkg/common/strutils"
+	"github.com/stretchr/testify/require"
+)
+
+func newStatusServer(status int, body string) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(status)
+		w.Write([]byte(body))
+	}))
+}
+
+func TestHandleErrStatusCode_SetsCode(t *testing.T) {
+	require.Equal(t, int64(http.StatusConflict), handleErrStatusCode(http.StatusConflict, nil, []byte("not json"), ErrorDetails{}).Code)
+	require.Equal(t, int64(http.StatusNotFound), handleErrStatusCode(http.StatusNotFound, nil, []byte(`{"message":"project not found"}`), ErrorDetails{}).Code)
+	require.Equal(t, int64(422), handleErrStatusCode(http.StatusBadRequest, nil, []byte(`{"code":422,"message":"invalid"}`), ErrorDetails{}).Code)
+}
+
+func TestWithIgnoreNotFoundOnDelete(t *testing.T) {
+	server := newStatusServer(http.StatusNotFound, `{"code":404,"message":"project not found"}`)
+	defer server.Close()
+
+	apiSet, err := New(server.URL)
+	require.Nil(t, err)
+	_, mErr := apiSet.Projects().DeleteProject(context.TODO(), models.Project{ProjectName: "my-project"}, ProjectsDeleteProjectOptions{})
+	require.NotNil(t, mErr)
+	require.Equal(t, "project not found", mErr.GetMessage())
+
+	apiSet, err = New(server.URL, WithIgnoreNotFoundOnDelete())
+	require.Nil(t, err)
+	eventContext, mErr := apiSet.Projects().DeleteProject(context.TODO(), models.Project{ProjectName: "my-project"}, ProjectsDeleteProjectOptions{})
+	require.Nil(t, mErr)
+	require.True(t, eventContext.AlreadyDeleted)
+
+	deleteResponse, mErr := apiSet.API().DeleteProject(context.TODO(), models.Project{ProjectName: "my-project"}, APIDeleteProjectOptions{})
+	require.Nil(t, mErr)
+	require.True(t, deleteResponse.AlreadyDeleted)
+
+	deleteServiceResponse, mErr := apiSet.API().DeleteService(context.TODO(), "my-project", "my-service", APIDeleteServiceOptions{})
+	require.Nil(t, mErr)
+	require.True(t, deleteServiceResponse.AlreadyDeleted)
+
+	require.Nil(t, apiSet.Secrets().DeleteSecret(context.TODO(), "my-secret", "my-scope", SecretsDeleteSecretOptions{}))
+	require.Nil(t, apiSet.Uniform().UnregisterIntegration(context.TODO(), "my-integration", UniformUnregisterIntegrationOptions{}))
+
+	// creating is not affected
+	_, mErr = apiSet.Stages().CreateStage(context.TODO(), "my-project", "dev", StagesCreateStageOptions{})
+	require.NotNil(t, mErr)
+}
+
+func TestWithAcceptAlreadyExists(t *testing.T) {
+	server := newStatusServer(http.StatusConflict, `{"code":409,"message":"project already exists"}`)
+	defer server.Close()
+
+	apiSet, err := New(server.URL)
+	require.Nil(t, err)
+	_, mErr := apiSet.Projects().CreateProject(context.TODO(), models.Project{ProjectName: "my-project"}, ProjectsCreateProjectOptions{})
+	require.NotNil(t, mErr)
+	require.Equal(t, "project already exists", mErr.GetMessage())
+
+	apiSet, err = New(server.URL, WithAcceptAlreadyExists())
+	require.Nil(t, err)
+	eventContext, mErr := apiSet.Projects().CreateProject(context.TODO(), models.Project{ProjectName: "my-project"}, ProjectsCreateProjectOptions{})
+	require.Nil(t, mErr)
+	require.True(t, eventContext.AlreadyExists)
+
+	eventContext, mErr = apiSet.Services().CreateServiceInStage(context.TODO(), "my-project", "dev", "my-service", ServicesCreateServiceInStageOptions{})
+	require.Nil(t, mErr)
+	require.True(t, eventContext.AlreadyExists)
+
+	resp, mErr := apiSet.API().CreateProject(context.TODO(), models.CreateProject{Name: strutils.Stringp("my-project")}, APICreateProjectOptions{})
+	require.Nil(t, mErr)
+	require.Empty(t, resp)
+
+	resp, mErr = apiSet.API().CreateService(context.TODO(), "my-project", models.CreateService{ServiceName: strutils.Stringp("my-service")}, APICreateServiceOptions{})
+	require.Nil(t, mErr)
+	require.Empty(t, resp)
+
+	require.Nil(t, apiSet.Secrets().CreateSecret(context.TODO(), models.Secret{}, SecretsCreateSecretOptions{}))
+
+	// deleting is not affected
+	_, mErr = apiSet.Projects().DeleteProject(context.TODO(), models.Project{ProjectName: "my-project"}, ProjectsDeleteProjectOptions{})
+	require.NotNil(t, mErr)
+}
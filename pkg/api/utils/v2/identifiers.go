@@ -0,0 +1,79 @@
+package v2
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/keptn/go-utils/pkg/api/models"
+)
+
+// ErrInvalidIdentifier is returned if a project, stage, service or other identifier cannot be sent to the Keptn API
+var ErrInvalidIdentifier = errors.New("invalid identifier")
+
+// EscapeIdentifier escapes an identifier, such as the name of a project, stage or service, an integration ID or an event type,
+// so that it is sent as a single path segment, i.e. a slash is escaped as well. Unlike url.PathEscape, a plus sign is escaped,
+// since some servers and proxies decode it as a space. EscapeIdentifier is used by all handlers when placing identifiers into paths
+func EscapeIdentifier(identifier string) string {
+	return strings.ReplaceAll(url.PathEscape(identifier), "+", "%2B")
+}
+
+// UnescapeIdentifier returns the identifier contained in a path segment escaped by EscapeIdentifier
+func UnescapeIdentifier(segment string) (string, error) {
+	identifier, err := url.PathUnescape(segment)
+	if err != nil {
+		return "", fmt.Errorf("%w: %s", ErrInvalidIdentifier, err.Error())
+	}
+	return identifier, nil
+}
+
+// ValidateIdentifier returns an error wrapping ErrInvalidIdentifier if the identifier cannot be used safely in a path,
+// i.e. if it is empty, "." or "..", is not valid UTF-8 or contains control characters. The kind, e.g. "project", is used in the error
+func ValidateIdentifier(kind string, identifier string) error {
+	switch {
+	case identifier == "":
+		return fmt.Errorf("%w: %s must not be empty", ErrInvalidIdentifier, kind)
+	case identifier == "." || identifier == "..":
+		return fmt.Errorf("%w: %s must not be %q", ErrInvalidIdentifier, kind, identifier)
+	case !utf8.ValidString(identifier):
+		return fmt.Errorf("%w: %s must be valid UTF-8", ErrInvalidIdentifier, kind)
+	}
+	for _, r := range identifier {
+		if unicode.IsControl(r) {
+			return fmt.Errorf("%w: %s %q must not contain control characters", ErrInvalidIdentifier, kind, identifier)
+		}
+	}
+	return nil
+}
+
+// splitEscapedPath splits an escaped path, see url.URL.EscapedPath, into its unescaped segments,
+// so that identifiers containing an escaped slash are kept as a single segment
+func splitEscapedPath(escapedPath string) []string {
+	segments := strings.Split(strings.Trim(escapedPath, "/"), "/")
+	for i, segment := range segments {
+		if identifier, err := UnescapeIdentifier(segment); err == nil {
+			segments[i] = identifier
+		}
+	}
+	return segments
+}
+
+// checkIdentifiers validates the given pairs of kind and identifier, see ValidateIdentifier.
+// Empty identifiers are skipped, as the handlers leave it to the Keptn API to reject them
+func checkIdentifiers(kindsAndIdentifiers ...string) *models.Error {
+	for i := 0; i+1 < len(kindsAndIdentifiers); i += 2 {
+		if kindsAndIdentifiers[i+1] == "" {
+			continue
+		}
+		if err := ValidateIdentifier(kindsAndIdentifiers[i], kindsAndIdentifiers[i+1]); err != nil {
+			mErr := buildErrorResponse(err.Error())
+			mErr.Code = 400
+			mErr.Err = err
+			return mErr
+		}
+	}
+	return nil
+}
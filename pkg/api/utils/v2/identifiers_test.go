@@ -0,0 +1,97 @@
+package v2
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/keptn/go-utils/pkg/api/models"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEscapeIdentifier(t *testing.T) {
+	tests := []struct {
+		identifier string
+		escaped    string
+	}{
+		{identifier: "carts", escaped: "carts"},
+		{identifier: "carts v2", escaped: "carts%20v2"},
+		{identifier: "carts+v2", escaped: "carts%2Bv2"},
+		{identifier: "team/carts", escaped: "team%2Fcarts"},
+		{identifier: "what?#%", escaped: "what%3F%23%25"},
+		{identifier: "sh.keptn.event.dev.delivery.triggered", escaped: "sh.keptn.event.dev.delivery.triggered"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.identifier, func(t *testing.T) {
+			require.Equal(t, tt.escaped, EscapeIdentifier(tt.identifier))
+			identifier, err := UnescapeIdentifier(tt.escaped)
+			require.Nil(t, err)
+			require.Equal(t, tt.identifier, identifier)
+		})
+	}
+
+	_, err := UnescapeIdentifier("%zz")
+	require.True(t, errors.Is(err, ErrInvalidIdentifier))
+}
+
+func TestValidateIdentifier(t *testing.T) {
+	require.Nil(t, ValidateIdentifier("project", "sock shop+v2/eu"))
+	for _, identifier := range []string{"", ".", "..", "carts\n", "\xff"} {
+		require.True(t, errors.Is(ValidateIdentifier("project", identifier), ErrInvalidIdentifier), identifier)
+	}
+}
+
+func TestHandlers_EscapeIdentifiers(t *testing.T) {
+	var paths []string
+	var queries []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		paths = append(paths, r.URL.EscapedPath())
+		queries = append(queries, r.URL.RawQuery)
+		w.Write([]byte(`{}`))
+	}))
+	defer server.Close()
+
+	apiSet, err := New(server.URL)
+	require.Nil(t, err)
+
+	_, mErr := apiSet.Services().CreateServiceInStage(context.TODO(), "sock shop", "dev/eu", "carts+v2", ServicesCreateServiceInStageOptions{})
+	require.Nil(t, mErr)
+	_, err = apiSet.Services().GetService(context.TODO(), "sock shop", "dev/eu", "carts+v2", ServicesGetServiceOptions{})
+	require.Nil(t, err)
+	_, mErr = apiSet.Projects().GetProject(context.TODO(), models.Project{ProjectName: "sock shop"}, ProjectsGetProjectOptions{})
+	require.Nil(t, mErr)
+	_, err = apiSet.Resources().GetAllServiceResources(context.TODO(), "sock shop", "dev/eu", "carts+v2", ResourcesGetAllServiceResourcesOptions{})
+	require.Nil(t, err)
+	require.Nil(t, apiSet.Secrets().DeleteSecret(context.TODO(), "my secret&x=y", "keptn-default", SecretsDeleteSecretOptions{}))
+
+	require.Equal(t, []string{
+		"/controlPlane/v1/project/sock%20shop/stage/dev%2Feu/service",
+		"/controlPlane/v1/project/sock%20shop/stage/dev%2Feu/service/carts%2Bv2",
+		"/controlPlane/v1/project/sock%20shop",
+		"/configuration-service/v1/project/sock%20shop/stage/dev%2Feu/service/carts%2Bv2/resource",
+		"/secrets/v1/secret",
+	}, paths)
+	require.Equal(t, "name=my+secret%26x%3Dy&scope=keptn-default", queries[4])
+}
+
+func TestHandlers_RejectInvalidIdentifiers(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+	}))
+	defer server.Close()
+
+	apiSet, err := New(server.URL)
+	require.Nil(t, err)
+
+	_, mErr := apiSet.Projects().DeleteProject(context.TODO(), models.Project{ProjectName: ".."}, ProjectsDeleteProjectOptions{})
+	require.NotNil(t, mErr)
+	require.Equal(t, int64(400), mErr.Code)
+	require.True(t, errors.Is(mErr.ToError(), ErrInvalidIdentifier))
+
+	_, err = apiSet.Stages().GetAllStages(context.TODO(), "sockshop\n", StagesGetAllStagesOptions{})
+	require.True(t, errors.Is(err, ErrInvalidIdentifier))
+	require.Zero(t, requests)
+}
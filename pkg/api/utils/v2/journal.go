@@ -0,0 +1,242 @@
+package v2
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"sync"
+	"time"
+	"unicode/utf8"
+
+	"github.com/keptn/go-utils/pkg/common/scrubutils"
+)
+
+// DefaultRequestJournalCapacity is the default number of requests kept by a RequestJournal
+const DefaultRequestJournalCapacity = 100
+
+// DefaultRequestJournalMaxBodySize is the default number of bytes of request and response bodies kept by a RequestJournal
+const DefaultRequestJournalMaxBodySize = 2048
+
+// JournalEntry is a request recorded by a RequestJournal
+type JournalEntry struct {
+	Time      time.Time `json:"time"`
+	Method    string    `json:"method"`
+	URL       string    `json:"url"`
+	RequestID string    `json:"requestId,omitempty"`
+	// StatusCode is 0 if the request failed without a response
+	StatusCode int           `json:"statusCode,omitempty"`
+	Duration   time.Duration `json:"duration"`
+	// RequestBody and ResponseBody are truncated to the maximum body size of the journal, with the values of secrets redacted.
+	// The request bodies of the secret endpoint are never recorded, since all values of their data are secrets
+	RequestBody  string `json:"requestBody,omitempty"`
+	ResponseBody string `json:"responseBody,omitempty"`
+	Error        string `json:"error,omitempty"`
+}
+
+// RequestJournalOptions are options for NewRequestJournal()
+type RequestJournalOptions struct {
+	// Capacity is the number of requests kept. If it is not positive, DefaultRequestJournalCapacity is used
+	Capacity int
+	// MaxBodySize is the number of bytes of request and response bodies kept.
+	// If it is 0, DefaultRequestJournalMaxBodySize is used. A negative value disables recording bodies
+	MaxBodySize int
+	// Scrubber is used to redact the values of secrets in URLs and bodies. If it is nil, a scrubutils.New() scrubber is used
+	Scrubber *scrubutils.Scrubber
+}
+
+// RequestJournal keeps the most recent requests sent to the Keptn API in a ring buffer, so that hard to reproduce
+// interactions with the control plane can be diagnosed after the fact. Only the X-Request-ID header of a request is kept
+// and the values of secrets in query parameters and bodies are redacted.
+// A RequestJournal is configured via WithRequestJournal and can be shared by several APISets
+type RequestJournal struct {
+	mtx         sync.Mutex
+	entries     []JournalEntry
+	next        int
+	full        bool
+	maxBodySize int
+	scrubber    *scrubutils.Scrubber
+}
+
+// NewRequestJournal creates a new, empty RequestJournal
+func NewRequestJournal(opts RequestJournalOptions) *RequestJournal {
+	capacity := opts.Capacity
+	if capacity <= 0 {
+		capacity = DefaultRequestJournalCapacity
+	}
+	maxBodySize := opts.MaxBodySize
+	if maxBodySize == 0 {
+		maxBodySize = DefaultRequestJournalMaxBodySize
+	}
+	scrubber := opts.Scrubber
+	if scrubber == nil {
+		scrubber = scrubutils.New()
+	}
+	return &RequestJournal{
+		entries:     make([]JournalEntry, capacity),
+		maxBodySize: maxBodySize,
+		scrubber:    scrubber,
+	}
+}
+
+// WithRequestJournal records all requests sent by the APISet, including retries, in the given RequestJournal
+func WithRequestJournal(journal *RequestJournal) func(*APISet) {
+	return func(a *APISet) {
+		a.requestJournal = journal
+	}
+}
+
+// RequestJournal returns the RequestJournal configured via WithRequestJournal, or nil if there is none
+func (c *APISet) RequestJournal() *RequestJournal {
+	return c.requestJournal
+}
+
+// Entries returns the recorded requests, starting with the oldest one
+func (j *RequestJournal) Entries() []JournalEntry {
+	j.mtx.Lock()
+	defer j.mtx.Unlock()
+	if !j.full {
+		return append([]JournalEntry{}, j.entries[:j.next]...)
+	}
+	return append(append([]JournalEntry{}, j.entries[j.next:]...), j.entries[:j.next]...)
+}
+
+// Dump writes the recorded requests to w as JSON, one entry per line, starting with the oldest one
+func (j *RequestJournal) Dump(w io.Writer) error {
+	encoder := json.NewEncoder(w)
+	for _, entry := range j.Entries() {
+		if err := encoder.Encode(entry); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// DumpToFile writes the recorded requests to the file with the given path, see Dump. An existing file is replaced
+func (j *RequestJournal) DumpToFile(path string) error {
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0600)
+	if err != nil {
+		return fmt.Errorf("could not dump request journal: %w", err)
+	}
+	if err := j.Dump(file); err != nil {
+		file.Close()
+		return fmt.Errorf("could not dump request journal: %w", err)
+	}
+	return file.Close()
+}
+
+// DumpOnPanic writes the recorded requests to the file with the given path if the goroutine panics, and then continues panicking.
+// It has to be deferred directly, e.g. defer journal.DumpOnPanic("/tmp/keptn-requests.jsonl")
+func (j *RequestJournal) DumpOnPanic(path string) {
+	if r := recover(); r != nil {
+		if err := j.DumpToFile(path); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+		}
+		panic(r)
+	}
+}
+
+func (j *RequestJournal) record(entry JournalEntry) {
+	j.mtx.Lock()
+	defer j.mtx.Unlock()
+	j.entries[j.next] = entry
+	j.next = (j.next + 1) % len(j.entries)
+	if j.next == 0 {
+		j.full = true
+	}
+}
+
+// redactURL removes the password of the URL and the values of query parameters containing secrets
+func (j *RequestJournal) redactURL(u *url.URL) string {
+	redacted := *u
+	query := redacted.Query()
+	for key := range query {
+		if j.scrubber.IsSecretKey(key) {
+			query.Set(key, scrubutils.Redacted)
+		}
+	}
+	if len(query) > 0 {
+		redacted.RawQuery = query.Encode()
+	}
+	return redacted.Redacted()
+}
+
+// redactBody returns the first bytes of the body, with the values of secrets redacted.
+// Secrets are redacted before the body is truncated, so that a secret cut off by the truncation is not kept partially
+func (j *RequestJournal) redactBody(body []byte) string {
+	redacted := j.scrubber.RedactSecrets(string(body))
+	if len(redacted) <= j.maxBodySize {
+		return redacted
+	}
+	end := j.maxBodySize
+	for end > 0 && !utf8.RuneStart(redacted[end]) {
+		end--
+	}
+	return redacted[:end] + "...(truncated)"
+}
+
+// journalTransport is a http.RoundTripper which records all requests sent through it in a RequestJournal
+type journalTransport struct {
+	base    http.RoundTripper
+	journal *RequestJournal
+}
+
+func newJournalTransport(base http.RoundTripper, journal *RequestJournal) *journalTransport {
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	return &journalTransport{base: base, journal: journal}
+}
+
+func (t *journalTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	entry := JournalEntry{
+		Time:      time.Now().UTC(),
+		Method:    req.Method,
+		URL:       t.journal.redactURL(req.URL),
+		RequestID: req.Header.Get(RequestIDHeader),
+	}
+	if isSecretRequest(req) {
+		entry.RequestBody = scrubutils.Redacted
+	} else if t.journal.maxBodySize > 0 && req.GetBody != nil {
+		if body, err := req.GetBody(); err == nil {
+			prefix, _ := ioutil.ReadAll(io.LimitReader(body, int64(t.journal.maxBodySize)+1))
+			body.Close()
+			entry.RequestBody = t.journal.redactBody(prefix)
+		}
+	}
+
+	resp, err := t.base.RoundTrip(req)
+	entry.Duration = time.Since(entry.Time)
+	if err != nil {
+		entry.Error = err.Error()
+		t.journal.record(entry)
+		return resp, err
+	}
+	entry.StatusCode = resp.StatusCode
+	if t.journal.maxBodySize > 0 && resp.Body != nil {
+		entry.ResponseBody = t.journal.redactBody(peekBody(resp, t.journal.maxBodySize+1))
+	}
+	t.journal.record(entry)
+	return resp, nil
+}
+
+// isSecretRequest returns whether the request is sent to the secret endpoint, e.g. to create or update a secret
+func isSecretRequest(req *http.Request) bool {
+	return strings.HasSuffix(strings.TrimRight(req.URL.Path, "/"), v1SecretPath)
+}
+
+// peekBody returns up to limit bytes of the body of the response.
+// The body is restored, so that it can still be read by the caller
+func peekBody(resp *http.Response, limit int) []byte {
+	prefix, _ := ioutil.ReadAll(io.LimitReader(resp.Body, int64(limit)))
+	resp.Body = struct {
+		io.Reader
+		io.Closer
+	}{io.MultiReader(bytes.NewReader(prefix), resp.Body), resp.Body}
+	return prefix
+}
@@ -0,0 +1,27 @@
+package v2
+
+import (
+	"net/http"
+)
+
+// acceptLanguageTransport is a http.RoundTripper which sets the Accept-Language header
+// of all requests that do not specify one, so that the Keptn API can localize its error messages
+type acceptLanguageTransport struct {
+	base           http.RoundTripper
+	acceptLanguage string
+}
+
+func newAcceptLanguageTransport(base http.RoundTripper, acceptLanguage string) *acceptLanguageTransport {
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	return &acceptLanguageTransport{base: base, acceptLanguage: acceptLanguage}
+}
+
+func (t *acceptLanguageTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.Header.Get("Accept-Language") == "" {
+		req = req.Clone(req.Context())
+		req.Header.Set("Accept-Language", t.acceptLanguage)
+	}
+	return t.base.RoundTrip(req)
+}
@@ -0,0 +1,38 @@
+package v2
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestAPISet_WithAcceptLanguage(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Accept-Language") == "de-AT, de;q=0.9" {
+			w.Header().Set("Content-Language", "de")
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"message":"Projekt nicht gefunden"}`))
+			return
+		}
+		w.WriteHeader(http.StatusNotFound)
+		w.Write([]byte(`{"message":"project not found"}`))
+	}))
+	defer server.Close()
+
+	apiSet, err := New(server.URL, WithAcceptLanguage("de-AT, de;q=0.9"))
+	require.Nil(t, err)
+	_, mErr := apiSet.API().GetMetadata(context.TODO(), APIGetMetadataOptions{})
+	require.NotNil(t, mErr)
+	require.Equal(t, "Projekt nicht gefunden", mErr.GetMessage())
+	require.Equal(t, "de", mErr.ContentLanguage)
+
+	apiSet, err = New(server.URL)
+	require.Nil(t, err)
+	_, mErr = apiSet.API().GetMetadata(context.TODO(), APIGetMetadataOptions{})
+	require.NotNil(t, mErr)
+	require.Equal(t, "project not found", mErr.GetMessage())
+	require.Empty(t, mErr.ContentLanguage)
+}
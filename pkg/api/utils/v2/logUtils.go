@@ -4,10 +4,8 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"log"
 	"net/http"
 	"net/url"
-	"strings"
 	"sync"
 	"time"
 
@@ -62,6 +60,8 @@ type LogHandler struct {
 	theClock     clock.Clock
 	syncInterval time.Duration
 	lock         sync.Mutex
+	compression  logCompression
+	errorDetailsSetting
 }
 
 // NewLogHandler returns a new LogHandler
@@ -74,13 +74,10 @@ func NewLogHandlerWithHTTPClient(baseURL string, httpClient *http.Client) *LogHa
 	return createLogHandler(baseURL, "", "", httpClient, "http")
 }
 
-// NewAuthenticatedLogHandler returns a new LogHandler that authenticates at the endpoint via the provided token
-func NewAuthenticatedLogHandler(baseURL string, authToken string, authHeader string, httpClient *http.Client, scheme string) *LogHandler {
-	baseURL = strings.TrimRight(baseURL, "/")
-	if !strings.HasSuffix(baseURL, shipyardControllerBaseURL) {
-		baseURL += "/" + shipyardControllerBaseURL
-	}
-
+// NewAuthenticatedLogHandler returns a new LogHandler that authenticates at the endpoint via the provided token.
+// The base path of the service is added to the base URL according to basePathMode (default AppendMissingBasePath)
+func NewAuthenticatedLogHandler(baseURL string, authToken string, authHeader string, httpClient *http.Client, scheme string, basePathMode ...BasePathMode) *LogHandler {
+	baseURL = basePathModeOf(basePathMode).HandlerBaseURL(baseURL, HandlerLogs)
 	return createLogHandler(baseURL, authToken, authHeader, httpClient, scheme)
 }
 
@@ -94,6 +91,7 @@ func createLogHandler(baseURL string, authToken string, authHeader string, httpC
 		logCache:     []models.LogEntry{},
 		theClock:     clock.New(),
 		syncInterval: defaultSyncInterval,
+		compression:  logCompression{threshold: DefaultLogCompressionThreshold},
 	}
 }
 
@@ -124,7 +122,7 @@ func (lh *LogHandler) Log(logs []models.LogEntry, opts LogsLogOptions) {
 func (lh *LogHandler) GetLogs(ctx context.Context, params models.GetLogsParams, opts LogsGetLogsOptions) (*models.GetLogsResponse, error) {
 	u, err := url.Parse(lh.scheme + "://" + lh.getBaseURL() + v1LogPath)
 	if err != nil {
-		log.Fatal("error parsing url")
+		return nil, invalidURLError(err)
 	}
 
 	query := u.Query()
@@ -135,6 +133,9 @@ func (lh *LogHandler) GetLogs(ctx context.Context, params models.GetLogsParams,
 	if params.PageSize != 0 {
 		query.Set("pageSize", fmt.Sprintf("%d", params.PageSize))
 	}
+	if params.NextPageKey != 0 {
+		query.Set("nextPageKey", fmt.Sprintf("%d", params.NextPageKey))
+	}
 	if params.FromTime != "" {
 		query.Set("fromTime", params.FromTime)
 	}
@@ -161,7 +162,7 @@ func (lh *LogHandler) GetLogs(ctx context.Context, params models.GetLogsParams,
 func (lh *LogHandler) DeleteLogs(ctx context.Context, params models.LogFilter, opts LogsDeleteLogsOptions) error {
 	u, err := url.Parse(lh.scheme + "://" + lh.getBaseURL() + v1LogPath)
 	if err != nil {
-		log.Fatal("error parsing url")
+		return invalidURLError(err)
 	}
 
 	query := u.Query()
@@ -175,7 +176,9 @@ func (lh *LogHandler) DeleteLogs(ctx context.Context, params models.LogFilter, o
 	if params.BeforeTime != "" {
 		query.Set("beforeTime", params.BeforeTime)
 	}
-	if _, err := delete(ctx, u.String(), lh); err != nil {
+	u.RawQuery = query.Encode()
+
+	if _, err := deleteRequest(ctx, u.String(), lh); err != nil {
 		return errors.New(err.GetMessage())
 	}
 	return nil
@@ -196,6 +199,7 @@ func (lh *LogHandler) Start(ctx context.Context, opts LogsStartOptions) {
 }
 
 // Flush flushes the log cache.
+// If at least DefaultLogCompressionThreshold entries are buffered, they are sent gzip compressed, see WithLogCompression
 func (lh *LogHandler) Flush(ctx context.Context, opts LogsFlushOptions) error {
 	lh.lock.Lock()
 	defer lh.lock.Unlock()
@@ -210,7 +214,7 @@ func (lh *LogHandler) Flush(ctx context.Context, opts LogsFlushOptions) error {
 	if err != nil {
 		return err
 	}
-	if _, err := post(ctx, lh.scheme+"://"+lh.getBaseURL()+v1LogPath, bodyStr, lh); err != nil {
+	if _, err := lh.compression.post(ctx, lh.scheme+"://"+lh.getBaseURL()+v1LogPath, bodyStr, len(lh.logCache), lh); err != nil {
 		return errors.New(err.GetMessage())
 	}
 	lh.logCache = []models.LogEntry{}
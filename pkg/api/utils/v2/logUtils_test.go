@@ -218,3 +218,14 @@ func TestLogHandler_Start(t *testing.T) {
 		t.Log("endpoint was called as expected")
 	}
 }
+
+func TestLogHandler_MalformedBaseURL(t *testing.T) {
+	lh := NewLogHandler("local host:8080")
+
+	logs, err := lh.GetLogs(context.Background(), models.GetLogsParams{}, LogsGetLogsOptions{})
+	require.Nil(t, logs)
+	require.ErrorIs(t, err, ErrInvalidConfiguration)
+
+	err = lh.DeleteLogs(context.Background(), models.LogFilter{}, LogsDeleteLogsOptions{})
+	require.ErrorIs(t, err, ErrInvalidConfiguration)
+}
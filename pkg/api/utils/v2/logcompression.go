@@ -0,0 +1,75 @@
+package v2
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"net/http"
+	"sync/atomic"
+
+	"github.com/keptn/go-utils/pkg/api/models"
+)
+
+// DefaultLogCompressionThreshold is the number of buffered log entries from which on LogsInterface.Flush
+// sends the logs gzip compressed
+const DefaultLogCompressionThreshold = 100
+
+// logCompression decides whether the logs flushed by a LogHandler are compressed.
+// Whether the Keptn API accepts compressed bodies is detected with the first compressed request:
+// if it is rejected, the logs are sent again uncompressed and compression is disabled from then on
+type logCompression struct {
+	// threshold is the minimum number of entries to compress. If it is not positive, the logs are never compressed
+	threshold int
+	// unsupported is set to 1 once the Keptn API has rejected a compressed body
+	unsupported int32
+}
+
+// WithLogCompression sets the number of buffered log entries from which on the LogHandler sends the logs
+// gzip compressed (default DefaultLogCompressionThreshold). A threshold which is not positive disables compression
+func WithLogCompression(threshold int) func(*APISet) {
+	return func(a *APISet) {
+		a.logCompressionThreshold = &threshold
+	}
+}
+
+func (c *logCompression) enabled(entries int) bool {
+	return c.threshold > 0 && entries >= c.threshold && atomic.LoadInt32(&c.unsupported) == 0
+}
+
+// post sends the logs, compressed if there are enough entries and the Keptn API supports it
+func (c *logCompression) post(ctx context.Context, uri string, data []byte, entries int, api APIService) (string, *models.Error) {
+	if !c.enabled(entries) {
+		return post(ctx, uri, data, api)
+	}
+	compressed, err := gzipBody(data)
+	if err != nil {
+		return post(ctx, uri, data, api)
+	}
+	resp, mErr := postEncoded(ctx, uri, compressed, "gzip", api)
+	if mErr == nil || !rejectsEncoding(mErr) {
+		return resp, mErr
+	}
+	resp, mErr = post(ctx, uri, data, api)
+	if mErr == nil {
+		// the request only failed because of the compression
+		atomic.StoreInt32(&c.unsupported, 1)
+	}
+	return resp, mErr
+}
+
+// rejectsEncoding returns whether the error may have been caused by a server which does not support compressed bodies
+func rejectsEncoding(err *models.Error) bool {
+	return err.Code == http.StatusUnsupportedMediaType || err.Code == http.StatusBadRequest
+}
+
+func gzipBody(data []byte) ([]byte, error) {
+	buf := &bytes.Buffer{}
+	w := gzip.NewWriter(buf)
+	if _, err := w.Write(data); err != nil {
+		return nil, err
+	}
+	if err := w.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,111 @@
+package v2
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/keptn/go-utils/pkg/api/models"
+	"github.com/stretchr/testify/require"
+)
+
+type logRequest struct {
+	encoding string
+	logs     models.CreateLogsRequest
+}
+
+func newLogRecordingServer(rejectCompressed bool) *recordingServer {
+	return newRecordingServer(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Content-Encoding") == "gzip" && rejectCompressed {
+			w.WriteHeader(http.StatusUnsupportedMediaType)
+			w.Write([]byte(`{"code":415, "message":"unsupported content encoding"}`))
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	})
+}
+
+// receivedLogs decodes the logs sent to the server, decompressing them if needed
+func receivedLogs(t *testing.T, server *recordingServer) []logRequest {
+	requests := []logRequest{}
+	for _, r := range server.received() {
+		encoding := r.Header.Get("Content-Encoding")
+		content := r.Body
+		if encoding == "gzip" {
+			reader, err := gzip.NewReader(bytes.NewReader(r.Body))
+			require.NoError(t, err)
+			content, err = ioutil.ReadAll(reader)
+			require.NoError(t, err)
+		}
+		logs := models.CreateLogsRequest{}
+		require.NoError(t, logs.FromJSON(content))
+		requests = append(requests, logRequest{encoding: encoding, logs: logs})
+	}
+	return requests
+}
+
+func logEntries(n int) []models.LogEntry {
+	entries := make([]models.LogEntry, n)
+	for i := range entries {
+		entries[i] = models.LogEntry{IntegrationID: "my-id", Message: "a verbose task log message"}
+	}
+	return entries
+}
+
+func TestLogHandler_FlushCompressesLargeBatches(t *testing.T) {
+	server := newLogRecordingServer(false)
+	defer server.Close()
+
+	lh := NewLogHandler(server.URL)
+	lh.compression.threshold = 3
+
+	lh.Log(logEntries(2), LogsLogOptions{})
+	require.NoError(t, lh.Flush(context.TODO(), LogsFlushOptions{}))
+
+	lh.Log(logEntries(3), LogsLogOptions{})
+	require.NoError(t, lh.Flush(context.TODO(), LogsFlushOptions{}))
+
+	got := receivedLogs(t, server)
+	require.Len(t, got, 2)
+	require.Equal(t, "", got[0].encoding)
+	require.Len(t, got[0].logs.Logs, 2)
+	require.Equal(t, "gzip", got[1].encoding)
+	require.Len(t, got[1].logs.Logs, 3)
+	require.Empty(t, lh.logCache)
+}
+
+func TestLogHandler_FlushFallsBackIfCompressionIsNotSupported(t *testing.T) {
+	server := newLogRecordingServer(true)
+	defer server.Close()
+
+	lh := NewLogHandler(server.URL)
+	lh.compression.threshold = 1
+
+	lh.Log(logEntries(2), LogsLogOptions{})
+	require.NoError(t, lh.Flush(context.TODO(), LogsFlushOptions{}))
+	lh.Log(logEntries(2), LogsLogOptions{})
+	require.NoError(t, lh.Flush(context.TODO(), LogsFlushOptions{}))
+
+	// the compressed request is rejected, so the logs are sent uncompressed and compression is not tried again
+	got := receivedLogs(t, server)
+	require.Len(t, got, 3)
+	require.Equal(t, "gzip", got[0].encoding)
+	for _, r := range got[1:] {
+		require.Equal(t, "", r.encoding)
+		require.Len(t, r.logs.Logs, 2)
+	}
+	require.False(t, lh.compression.enabled(2))
+}
+
+func TestWithLogCompression(t *testing.T) {
+	api, err := New("http://localhost:8080", WithLogCompression(0))
+	require.NoError(t, err)
+	require.False(t, api.logHandler.compression.enabled(1000))
+
+	api, err = New("http://localhost:8080")
+	require.NoError(t, err)
+	require.Equal(t, DefaultLogCompressionThreshold, api.logHandler.compression.threshold)
+}
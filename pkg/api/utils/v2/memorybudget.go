@@ -0,0 +1,95 @@
+package v2
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/keptn/go-utils/pkg/api/models"
+)
+
+// ErrBudgetExceeded is wrapped by the *BudgetExceededError returned by paginated listings
+// if the next page would exceed the memory budget, see WithMemoryBudget
+var ErrBudgetExceeded = errors.New("memory budget exceeded")
+
+// BudgetExceededError is returned by paginated listings, i.e. ProjectsInterface.GetAllProjects, the listings of resources
+// and the retrieval of events, if the next page would exceed the memory budget. The items retrieved so far are returned along with it,
+// and the listing can be continued at Cursor, e.g. after the retrieved items have been processed
+type BudgetExceededError struct {
+	// Budget is the memory budget in bytes
+	Budget int64
+	// Used is the estimated size in bytes of the items retrieved so far
+	Used int64
+	// Cursor points to the first page which has not been retrieved
+	Cursor models.Cursor
+}
+
+func (e *BudgetExceededError) Error() string {
+	return fmt.Sprintf("%s: retrieved items use about %d of %d bytes, continue at cursor %q", ErrBudgetExceeded.Error(), e.Used, e.Budget, e.Cursor.Encode())
+}
+
+func (e *BudgetExceededError) Unwrap() error {
+	return ErrBudgetExceeded
+}
+
+// WithMemoryBudget limits the estimated size in bytes of the items retrieved by a paginated listing,
+// i.e. ProjectsInterface.GetAllProjects, the listings of resources and the retrieval of events, so that services with
+// small memory limits can page through large lists. The size of an item is estimated from the size of the encoded pages
+// retrieved so far. A listing stops before a page which would exceed the budget and returns a *BudgetExceededError.
+// The first page of a listing is always retrieved, even if it exceeds the budget on its own, so that a listing
+// continued at the Cursor of the error makes progress.
+// A budget which is not positive disables the limit
+func WithMemoryBudget(bytes int64) func(*APISet) {
+	return func(a *APISet) {
+		a.memoryBudget = bytes
+	}
+}
+
+// memoryBudget accounts for the items retrieved by a single paginated listing
+type memoryBudget struct {
+	limit int64
+	used  int64
+	items int64
+	// lastPageItems is the number of items of the last page, which is expected for the next page as well
+	lastPageItems int64
+}
+
+// newMemoryBudget returns the budget of a single listing. override is the budget set for the call, if any
+func newMemoryBudget(limit int64, override int64) *memoryBudget {
+	if override != 0 {
+		limit = override
+	}
+	return &memoryBudget{limit: limit}
+}
+
+// admitsNextPage returns whether the next page is expected to fit into the budget,
+// based on the average size of the items retrieved so far and the number of items of the last page
+func (b *memoryBudget) admitsNextPage() bool {
+	if b.limit <= 0 || b.items == 0 {
+		return true
+	}
+	return b.used+b.used/b.items*b.lastPageItems <= b.limit
+}
+
+// add accounts for a retrieved page with the given number of items and encoded size in bytes.
+// It returns false, without accounting for the page, if the page exceeds the budget. The first page is always accounted for
+func (b *memoryBudget) add(items int, size int) bool {
+	if b.limit > 0 && b.used > 0 && b.used+int64(size) > b.limit {
+		return false
+	}
+	b.used += int64(size)
+	b.items += int64(items)
+	b.lastPageItems = int64(items)
+	return true
+}
+
+// exceeded returns the error of a listing which stops before the page the cursor points to
+func (b *memoryBudget) exceeded(cursor models.Cursor) *BudgetExceededError {
+	return &BudgetExceededError{Budget: b.limit, Used: b.used, Cursor: cursor}
+}
+
+// budgetExceededResponse returns the given error as *models.Error
+func budgetExceededResponse(err *BudgetExceededError) *models.Error {
+	mErr := buildErrorResponse(err.Error())
+	mErr.Err = err
+	return mErr
+}
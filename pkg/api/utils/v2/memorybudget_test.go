@@ -0,0 +1,166 @@
+package v2
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/keptn/go-utils/pkg/api/models"
+	"github.com/stretchr/testify/require"
+)
+
+// budgetPage returns the page starting at the given offset of a list of 6 events, resources or projects with 2 items per page
+func budgetPage(path string, offset int) string {
+	items := []string{}
+	for i := offset + 1; i <= offset+2; i++ {
+		switch {
+		case strings.HasSuffix(path, "/event"):
+			items = append(items, fmt.Sprintf(`{"id":"event-%d"}`, i))
+		case strings.HasSuffix(path, "/resource"):
+			items = append(items, fmt.Sprintf(`{"resourceURI":"resource-%d"}`, i))
+		default:
+			items = append(items, fmt.Sprintf(`{"projectName":"project-%d"}`, i))
+		}
+	}
+	nextPageKey := ""
+	if offset+2 < 6 {
+		nextPageKey = strconv.Itoa(offset + 2)
+	}
+	list := "projects"
+	switch {
+	case strings.HasSuffix(path, "/event"):
+		list = "events"
+	case strings.HasSuffix(path, "/resource"):
+		list = "resources"
+	}
+	return fmt.Sprintf(`{%q:[%s],"nextPageKey":%q,"totalCount":6}`, list, strings.Join(items, ","), nextPageKey)
+}
+
+func newBudgetPagingServer() *recordingServer {
+	return newRecordingServer(func(w http.ResponseWriter, r *http.Request) {
+		offset, _ := strconv.Atoi(r.URL.Query().Get("nextPageKey"))
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(budgetPage(r.URL.Path, offset)))
+	})
+}
+
+func TestGetEvents_MemoryBudget(t *testing.T) {
+	server := newBudgetPagingServer()
+	defer server.Close()
+	// two pages fit into the budget, but not a third one
+	budget := int64(len(budgetPage("/event", 0)) + len(budgetPage("/event", 2)) + len(budgetPage("/event", 0))/2)
+	apiSet, err := New(server.URL, WithMemoryBudget(budget))
+	require.NoError(t, err)
+
+	events, mErr := apiSet.Events().GetEvents(context.TODO(), &EventFilter{Project: "my-project"}, EventsGetEventsOptions{})
+	require.NotNil(t, mErr)
+	require.Len(t, events, 4)
+	budgetErr := &BudgetExceededError{}
+	require.True(t, errors.As(mErr.ToError(), &budgetErr))
+	require.ErrorIs(t, mErr.ToError(), ErrBudgetExceeded)
+	require.Equal(t, budget, budgetErr.Budget)
+	require.Equal(t, "4", budgetErr.Cursor.Encode())
+	require.Equal(t, []string{"", "2"}, server.nextPageKeys())
+
+	events, mErr = apiSet.Events().GetEvents(context.TODO(), &EventFilter{Project: "my-project"}, EventsGetEventsOptions{Cursor: budgetErr.Cursor})
+	require.Nil(t, mErr)
+	require.Len(t, events, 2)
+	require.Equal(t, "event-5", events[0].ID)
+
+	events, mErr = apiSet.Events().GetEvents(context.TODO(), &EventFilter{Project: "my-project"}, EventsGetEventsOptions{MemoryBudget: -1})
+	require.Nil(t, mErr)
+	require.Len(t, events, 6)
+}
+
+func TestGetEvents_MemoryBudgetSmallerThanFirstPage(t *testing.T) {
+	server := newBudgetPagingServer()
+	defer server.Close()
+	handler := NewEventHandler(server.URL)
+
+	// the first page is always retrieved, so that continuing at the cursor makes progress
+	cursor := models.Cursor{}
+	for i := 0; i < 3; i++ {
+		events, mErr := handler.GetEvents(context.TODO(), &EventFilter{Project: "my-project"}, EventsGetEventsOptions{MemoryBudget: 10, Cursor: cursor})
+		require.Len(t, events, 2)
+		require.Equal(t, fmt.Sprintf("event-%d", 2*i+1), events[0].ID)
+		if i == 2 {
+			require.Nil(t, mErr)
+			break
+		}
+		require.NotNil(t, mErr)
+		budgetErr := &BudgetExceededError{}
+		require.True(t, errors.As(mErr.ToError(), &budgetErr))
+		require.Equal(t, int64(len(budgetPage("/event", 2*i))), budgetErr.Used)
+		cursor = budgetErr.Cursor
+	}
+	require.Equal(t, []string{"", "2", "4"}, server.nextPageKeys())
+}
+
+func TestGetEventsWithRetry_DoesNotRetryExceededMemoryBudget(t *testing.T) {
+	require.False(t, isRetryableError(budgetExceededResponse(newMemoryBudget(10, 0).exceeded(models.Cursor{}))))
+}
+
+func TestGetAllProjects_MemoryBudget(t *testing.T) {
+	server := newBudgetPagingServer()
+	defer server.Close()
+	budget := int64(len(budgetPage("/project", 0)) + len(budgetPage("/project", 2)) + len(budgetPage("/project", 0))/2)
+	handler := NewProjectHandler(server.URL)
+
+	projects, err := handler.GetAllProjects(context.TODO(), ProjectsGetAllProjectsOptions{MemoryBudget: budget})
+	require.ErrorIs(t, err, ErrBudgetExceeded)
+	require.Len(t, projects, 4)
+	budgetErr := &BudgetExceededError{}
+	require.True(t, errors.As(err, &budgetErr))
+	require.Equal(t, "4", budgetErr.Cursor.Encode())
+
+	projects, err = handler.GetAllProjects(context.TODO(), ProjectsGetAllProjectsOptions{MemoryBudget: budget, Cursor: budgetErr.Cursor})
+	require.NoError(t, err)
+	require.Len(t, projects, 2)
+	require.Equal(t, "project-5", projects[0].ProjectName)
+	require.Equal(t, []string{"", "2", "4"}, server.nextPageKeys())
+}
+
+func TestGetAllResources_MemoryBudget(t *testing.T) {
+	server := newBudgetPagingServer()
+	defer server.Close()
+	budget := int64(len(budgetPage("/resource", 0)) + len(budgetPage("/resource", 2)) + len(budgetPage("/resource", 0))/2)
+	apiSet, err := New(server.URL, WithMemoryBudget(budget))
+	require.NoError(t, err)
+
+	resources, err := apiSet.Resources().GetAllStageResources(context.TODO(), "my-project", "dev", ResourcesGetAllStageResourcesOptions{})
+	require.ErrorIs(t, err, ErrBudgetExceeded)
+	require.Len(t, resources, 4)
+	budgetErr := &BudgetExceededError{}
+	require.True(t, errors.As(err, &budgetErr))
+	require.Equal(t, "4", budgetErr.Cursor.Encode())
+
+	resources, err = apiSet.Resources().GetAllStageResources(context.TODO(), "my-project", "dev", ResourcesGetAllStageResourcesOptions{Cursor: budgetErr.Cursor})
+	require.NoError(t, err)
+	require.Len(t, resources, 2)
+	require.Equal(t, "resource-5", *resources[0].ResourceURI)
+	require.Equal(t, []string{"", "2", "4"}, server.nextPageKeys())
+}
+
+func TestMemoryBudget_AdmitsNextPage(t *testing.T) {
+	budget := newMemoryBudget(100, 0)
+	require.True(t, budget.admitsNextPage())
+	require.True(t, budget.add(4, 40))
+	// the next page is expected to have 4 items of 10 bytes as well
+	require.True(t, budget.admitsNextPage())
+	require.True(t, budget.add(4, 40))
+	require.False(t, budget.admitsNextPage())
+	require.False(t, budget.add(1, 30))
+	require.Equal(t, int64(80), budget.used)
+
+	firstPage := newMemoryBudget(10, 0)
+	require.True(t, firstPage.add(4, 40))
+	require.False(t, firstPage.admitsNextPage())
+
+	unlimited := newMemoryBudget(100, -1)
+	require.True(t, unlimited.add(10, 1000))
+	require.True(t, unlimited.admitsNextPage())
+}
@@ -0,0 +1,154 @@
+package v2
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/keptn/go-utils/pkg/api/models"
+)
+
+// DefaultMetadataCacheTTL is the default time for which a MetadataCache keeps projects and stages
+const DefaultMetadataCacheTTL = time.Minute
+
+// MetadataCacheStats contains the number of lookups of a MetadataCache which have been answered from the cache
+// and the number of lookups which required a call to the Keptn API
+type MetadataCacheStats struct {
+	Hits   int64
+	Misses int64
+}
+
+type cachedProjects struct {
+	projects []*models.Project
+	expires  time.Time
+}
+
+type cachedStages struct {
+	stages  []*models.Stage
+	expires time.Time
+}
+
+// MetadataCache is a read-through cache for rarely changing data, i.e. the list of projects and the stages of a project,
+// so that e.g. event handlers which need to look up the topology of a project for every event do not call the Keptn API every time.
+// Entries expire after the TTL of the cache and can be invalidated explicitly, e.g. after a project has been updated.
+// The returned projects and stages are shared between callers and must not be modified.
+// A MetadataCache is safe for concurrent use
+type MetadataCache struct {
+	projects ProjectsInterface
+	stages   StagesInterface
+	ttl      time.Duration
+	now      func() time.Time
+
+	mtx           sync.Mutex
+	projectList   *cachedProjects
+	projectStages map[string]cachedStages
+	stats         MetadataCacheStats
+	// generation is increased by every invalidation, so that lookups which have been started before
+	// do not store outdated data in the cache
+	generation uint64
+}
+
+// NewMetadataCache creates a new MetadataCache using the given APIs. If ttl is not positive, DefaultMetadataCacheTTL is used
+func NewMetadataCache(api KeptnInterface, ttl time.Duration) *MetadataCache {
+	if ttl <= 0 {
+		ttl = DefaultMetadataCacheTTL
+	}
+	return &MetadataCache{
+		projects:      api.Projects(),
+		stages:        api.Stages(),
+		ttl:           ttl,
+		now:           time.Now,
+		projectStages: map[string]cachedStages{},
+	}
+}
+
+// GetAllProjects returns all projects, from the cache if they have been retrieved within the TTL
+func (c *MetadataCache) GetAllProjects(ctx context.Context) ([]*models.Project, error) {
+	c.mtx.Lock()
+	if c.projectList != nil && c.now().Before(c.projectList.expires) {
+		c.stats.Hits++
+		projects := c.projectList.projects
+		c.mtx.Unlock()
+		return projects, nil
+	}
+	c.stats.Misses++
+	generation := c.generation
+	c.mtx.Unlock()
+
+	projects, err := c.projects.GetAllProjects(ctx, ProjectsGetAllProjectsOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	if generation == c.generation {
+		c.projectList = &cachedProjects{projects: projects, expires: c.now().Add(c.ttl)}
+	}
+	return projects, nil
+}
+
+// GetProject returns the project with the given name from the list of all projects, or nil if it does not exist
+func (c *MetadataCache) GetProject(ctx context.Context, project string) (*models.Project, error) {
+	projects, err := c.GetAllProjects(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, p := range projects {
+		if p != nil && p.ProjectName == project {
+			return p, nil
+		}
+	}
+	return nil, nil
+}
+
+// GetAllStages returns all stages of the given project, from the cache if they have been retrieved within the TTL
+func (c *MetadataCache) GetAllStages(ctx context.Context, project string) ([]*models.Stage, error) {
+	c.mtx.Lock()
+	if cached, ok := c.projectStages[project]; ok && c.now().Before(cached.expires) {
+		c.stats.Hits++
+		c.mtx.Unlock()
+		return cached.stages, nil
+	}
+	c.stats.Misses++
+	generation := c.generation
+	c.mtx.Unlock()
+
+	stages, err := c.stages.GetAllStages(ctx, project, StagesGetAllStagesOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	if generation == c.generation {
+		c.projectStages[project] = cachedStages{stages: stages, expires: c.now().Add(c.ttl)}
+	}
+	return stages, nil
+}
+
+// Invalidate removes the stages of the given project and the list of projects from the cache,
+// so that they are retrieved from the Keptn API on the next lookup
+func (c *MetadataCache) Invalidate(project string) {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	c.generation++
+	c.projectList = nil
+	delete(c.projectStages, project)
+}
+
+// InvalidateAll removes all entries from the cache
+func (c *MetadataCache) InvalidateAll() {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	c.generation++
+	c.projectList = nil
+	c.projectStages = map[string]cachedStages{}
+}
+
+// Stats returns the number of cache hits and misses since the cache has been created
+func (c *MetadataCache) Stats() MetadataCacheStats {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	return c.stats
+}
@@ -0,0 +1,82 @@
+package v2
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestMetadataCache(t *testing.T) {
+	requests := map[string]int{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case strings.HasSuffix(r.URL.Path, "/v1/project"):
+			requests["projects"]++
+			w.Write([]byte(`{"projects":[{"projectName":"sockshop"},{"projectName":"podtato"}]}`))
+		case strings.HasSuffix(r.URL.Path, "/v1/project/sockshop/stage"):
+			requests["stages"]++
+			w.Write([]byte(`{"stages":[{"stageName":"dev"},{"stageName":"prod"}]}`))
+		case strings.HasSuffix(r.URL.Path, "/v1/project/unknown/stage"):
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"code":404,"message":"project not found"}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	apiSet, err := New(server.URL)
+	require.Nil(t, err)
+	now := time.Date(2022, 3, 1, 10, 0, 0, 0, time.UTC)
+	cache := NewMetadataCache(apiSet, time.Minute)
+	cache.now = func() time.Time { return now }
+
+	projects, err := cache.GetAllProjects(context.TODO())
+	require.Nil(t, err)
+	require.Len(t, projects, 2)
+	project, err := cache.GetProject(context.TODO(), "podtato")
+	require.Nil(t, err)
+	require.Equal(t, "podtato", project.ProjectName)
+	project, err = cache.GetProject(context.TODO(), "unknown")
+	require.Nil(t, err)
+	require.Nil(t, project)
+	require.Equal(t, 1, requests["projects"])
+
+	for i := 0; i < 3; i++ {
+		stages, err := cache.GetAllStages(context.TODO(), "sockshop")
+		require.Nil(t, err)
+		require.Len(t, stages, 2)
+	}
+	require.Equal(t, 1, requests["stages"])
+	require.Equal(t, MetadataCacheStats{Hits: 4, Misses: 2}, cache.Stats())
+
+	// errors are not cached
+	_, err = cache.GetAllStages(context.TODO(), "unknown")
+	require.NotNil(t, err)
+
+	cache.Invalidate("sockshop")
+	cache.Invalidate("never-cached")
+	require.Empty(t, cache.projectStages)
+	_, err = cache.GetAllStages(context.TODO(), "sockshop")
+	require.Nil(t, err)
+	_, err = cache.GetAllProjects(context.TODO())
+	require.Nil(t, err)
+	require.Equal(t, 2, requests["stages"])
+	require.Equal(t, 2, requests["projects"])
+
+	now = now.Add(2 * time.Minute)
+	_, err = cache.GetAllStages(context.TODO(), "sockshop")
+	require.Nil(t, err)
+	require.Equal(t, 3, requests["stages"])
+
+	cache.InvalidateAll()
+	_, err = cache.GetAllProjects(context.TODO())
+	require.Nil(t, err)
+	require.Equal(t, 3, requests["projects"])
+	require.Equal(t, MetadataCacheStats{Hits: 4, Misses: 7}, cache.Stats())
+}
@@ -0,0 +1,82 @@
+package v2
+
+import (
+	"context"
+	"crypto/x509"
+	"errors"
+	"net"
+	"syscall"
+)
+
+// Categories of network-level failures of requests, see ClassifyNetworkError
+var (
+	// ErrDNS is the category of requests whose host name could not be resolved
+	ErrDNS = errors.New("DNS lookup failed")
+	// ErrTLSVerification is the category of requests to servers whose certificate could not be verified, e.g. because
+	// it is signed by an unknown authority, expired, issued for another host or not pinned via WithPinnedCertificates
+	ErrTLSVerification = errors.New("TLS verification failed")
+	// ErrTimeout is the category of requests which timed out, e.g. while connecting or waiting for the response
+	ErrTimeout = errors.New("request timed out")
+	// ErrConnectionRefused is the category of requests to hosts which refused the connection
+	ErrConnectionRefused = errors.New("connection refused")
+)
+
+// NetworkError is a request failure classified into one of the categories ErrDNS, ErrTLSVerification, ErrTimeout
+// and ErrConnectionRefused. The category can be checked via errors.Is, while errors.As still reaches the underlying error.
+// The *models.Error of a request failing with a NetworkError contains it as Err
+type NetworkError struct {
+	// Category is one of ErrDNS, ErrTLSVerification, ErrTimeout and ErrConnectionRefused
+	Category error
+	// Err is the error returned by the http client
+	Err error
+}
+
+func (e *NetworkError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *NetworkError) Unwrap() error {
+	return e.Err
+}
+
+// Is reports whether the target is the category of the error
+func (e *NetworkError) Is(target error) bool {
+	return target == e.Category
+}
+
+// ClassifyNetworkError wraps the error of a failed request into a *NetworkError if it belongs to one of the categories
+// ErrDNS, ErrTLSVerification, ErrTimeout and ErrConnectionRefused. Other errors, including nil, are returned as they are
+func ClassifyNetworkError(err error) error {
+	if category := networkErrorCategory(err); category != nil {
+		return &NetworkError{Category: category, Err: err}
+	}
+	return err
+}
+
+func networkErrorCategory(err error) error {
+	if err == nil {
+		return nil
+	}
+	var networkErr *NetworkError
+	if errors.As(err, &networkErr) {
+		return nil
+	}
+	var dnsErr *net.DNSError
+	if errors.As(err, &dnsErr) {
+		return ErrDNS
+	}
+	var unknownAuthorityErr x509.UnknownAuthorityError
+	var invalidCertErr x509.CertificateInvalidError
+	var hostnameErr x509.HostnameError
+	if errors.As(err, &unknownAuthorityErr) || errors.As(err, &invalidCertErr) || errors.As(err, &hostnameErr) || errors.Is(err, ErrCertificateNotPinned) {
+		return ErrTLSVerification
+	}
+	if errors.Is(err, syscall.ECONNREFUSED) {
+		return ErrConnectionRefused
+	}
+	var netErr net.Error
+	if errors.Is(err, context.DeadlineExceeded) || (errors.As(err, &netErr) && netErr.Timeout()) {
+		return ErrTimeout
+	}
+	return nil
+}
@@ -0,0 +1,175 @@
+package v2
+
+import (
+	"context"
+	"crypto/sha256"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"strings"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/keptn/go-utils/pkg/api/models"
+	"github.com/keptn/go-utils/pkg/common/strutils"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClassifyNetworkError(t *testing.T) {
+	tests := []struct {
+		name     string
+		err      error
+		category error
+	}{
+		{
+			name:     "DNS",
+			err:      &url.Error{Op: "Get", URL: "http://keptn.invalid", Err: &net.OpError{Op: "dial", Err: &net.DNSError{Err: "no such host", Name: "keptn.invalid", IsNotFound: true}}},
+			category: ErrDNS,
+		},
+		{
+			name:     "unknown authority",
+			err:      &url.Error{Op: "Get", URL: "https://keptn.example.com", Err: x509.UnknownAuthorityError{}},
+			category: ErrTLSVerification,
+		},
+		{
+			name:     "wrong host",
+			err:      &url.Error{Op: "Get", URL: "https://keptn.example.com", Err: x509.HostnameError{Certificate: &x509.Certificate{}, Host: "keptn.example.com"}},
+			category: ErrTLSVerification,
+		},
+		{
+			name:     "expired certificate",
+			err:      &url.Error{Op: "Get", URL: "https://keptn.example.com", Err: x509.CertificateInvalidError{Reason: x509.Expired}},
+			category: ErrTLSVerification,
+		},
+		{
+			name:     "connection refused",
+			err:      &url.Error{Op: "Get", URL: "http://localhost:1", Err: &net.OpError{Op: "dial", Err: os.NewSyscallError("connect", syscall.ECONNREFUSED)}},
+			category: ErrConnectionRefused,
+		},
+		{
+			name:     "deadline exceeded",
+			err:      &url.Error{Op: "Get", URL: "http://localhost", Err: context.DeadlineExceeded},
+			category: ErrTimeout,
+		},
+		{
+			name:     "dial timeout",
+			err:      &url.Error{Op: "Get", URL: "http://localhost", Err: &net.OpError{Op: "dial", Err: os.ErrDeadlineExceeded}},
+			category: ErrTimeout,
+		},
+		{
+			name: "other error",
+			err:  errors.New("unexpected EOF"),
+		},
+		{
+			name: "cancelled",
+			err:  &url.Error{Op: "Get", URL: "http://localhost", Err: context.Canceled},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ClassifyNetworkError(tt.err)
+			if tt.category == nil {
+				require.Equal(t, tt.err, err)
+				return
+			}
+			require.ErrorIs(t, err, tt.category)
+			require.Equal(t, tt.err.Error(), err.Error())
+			for _, other := range []error{ErrDNS, ErrTLSVerification, ErrTimeout, ErrConnectionRefused} {
+				if other != tt.category {
+					require.False(t, errors.Is(err, other))
+				}
+			}
+			urlErr := &url.Error{}
+			require.ErrorAs(t, err, &urlErr)
+			require.Equal(t, err, ClassifyNetworkError(err))
+		})
+	}
+	require.Nil(t, ClassifyNetworkError(nil))
+}
+
+func TestAPISet_NetworkErrors(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.Nil(t, err)
+	refusedURL := "http://" + listener.Addr().String()
+	listener.Close()
+
+	slowServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-r.Context().Done():
+		case <-time.After(time.Second):
+		}
+	}))
+	defer slowServer.Close()
+
+	tlsServer := newPinningTestServer()
+	defer tlsServer.Close()
+
+	tests := []struct {
+		name     string
+		baseURL  string
+		options  []func(*APISet)
+		timeout  time.Duration
+		category error
+	}{
+		{
+			name:     "connection refused",
+			baseURL:  refusedURL,
+			category: ErrConnectionRefused,
+		},
+		{
+			name:     "timeout",
+			baseURL:  slowServer.URL,
+			timeout:  20 * time.Millisecond,
+			category: ErrTimeout,
+		},
+		{
+			name:     "certificate not pinned",
+			baseURL:  tlsServer.URL,
+			options:  []func(*APISet){WithPinnedCertificates(strings.Repeat("ab", sha256.Size))},
+			category: ErrTLSVerification,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			apiSet, err := New(tt.baseURL, tt.options...)
+			require.Nil(t, err)
+			ctx := context.TODO()
+			if tt.timeout > 0 {
+				var cancel context.CancelFunc
+				ctx, cancel = context.WithTimeout(ctx, tt.timeout)
+				defer cancel()
+			}
+			_, mErr := apiSet.Projects().GetProject(ctx, models.Project{ProjectName: "my-project"}, ProjectsGetProjectOptions{})
+			require.NotNil(t, mErr)
+			require.ErrorIs(t, mErr.ToError(), tt.category, fmt.Sprintf("%v", mErr.ToError()))
+			require.Equal(t, tt.category != ErrTLSVerification, isRetryableError(mErr))
+		})
+	}
+}
+
+func TestResourceHandler_NetworkErrors(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.Nil(t, err)
+	refusedURL := "http://" + listener.Addr().String()
+	listener.Close()
+
+	apiSet, err := New(refusedURL)
+	require.Nil(t, err)
+	scope := NewResourceScope().Project("my-project").Resource("shipyard.yaml")
+	resource := &models.Resource{ResourceURI: strutils.Stringp("shipyard.yaml"), ResourceContent: "content"}
+
+	_, err = apiSet.Resources().CreateResource(context.TODO(), []*models.Resource{resource}, *scope, ResourcesCreateResourceOptions{})
+	require.ErrorIs(t, err, ErrConnectionRefused)
+	_, err = apiSet.Resources().UpdateResource(context.TODO(), resource, *scope, ResourcesUpdateResourceOptions{})
+	require.ErrorIs(t, err, ErrConnectionRefused)
+	err = apiSet.Resources().DeleteResource(context.TODO(), *scope, ResourcesDeleteResourceOptions{})
+	require.ErrorIs(t, err, ErrConnectionRefused)
+	_, err = apiSet.UploadResource(context.TODO(), *scope, strings.NewReader("content"), ResourcesUploadResourceOptions{})
+	require.ErrorIs(t, err, ErrConnectionRefused)
+}
@@ -0,0 +1,252 @@
+package v2
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/keptn/go-utils/pkg/api/models"
+	"github.com/keptn/go-utils/pkg/common/strutils"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	// OfflineProjectsFile is the fixture file containing the projects, stages and services of an offline APISet,
+	// in the same format as returned by the Keptn API, i.e. a list of projects below the key "projects"
+	OfflineProjectsFile = "projects.yaml"
+	// OfflineEventsFile is the fixture file containing the events of an offline APISet, one JSON encoded event per line
+	OfflineEventsFile = "events.jsonl"
+
+	offlineBaseURL = "http://keptn.offline"
+)
+
+// NewOffline creates an APISet which is backed by the fixture files projects.yaml and events.jsonl in the given directory
+// instead of a Keptn API, so that CLI tools and tests can run demos and dry-runs without any network access.
+// Both files are optional. Reads are answered from the fixtures, while creating, updating or deleting entities
+// succeeds without changing the fixtures. Requests to data that is not part of the fixtures fail with 404 Not Found
+func NewOffline(fixtureDir string, options ...func(*APISet)) (*APISet, error) {
+	fixtures, err := loadOfflineFixtures(fixtureDir)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create offline apiset: %w", err)
+	}
+	options = append(options, WithHTTPClient(&http.Client{Transport: fixtures}))
+	return New(offlineBaseURL, options...)
+}
+
+// offlineFixtures is a http.RoundTripper which answers requests to the Keptn API from fixture files
+type offlineFixtures struct {
+	projects []*models.Project
+	events   []*models.KeptnContextExtendedCE
+}
+
+func loadOfflineFixtures(dir string) (*offlineFixtures, error) {
+	fixtures := &offlineFixtures{projects: []*models.Project{}, events: []*models.KeptnContextExtendedCE{}}
+
+	projectsFile, err := os.ReadFile(filepath.Join(dir, OfflineProjectsFile))
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return nil, err
+	}
+	if err == nil {
+		projects, err := parseOfflineProjects(projectsFile)
+		if err != nil {
+			return nil, fmt.Errorf("unable to parse %s: %w", OfflineProjectsFile, err)
+		}
+		fixtures.projects = projects
+	}
+
+	eventsFile, err := os.Open(filepath.Join(dir, OfflineEventsFile))
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return nil, err
+	}
+	if err == nil {
+		defer eventsFile.Close()
+		events, err := parseOfflineEvents(eventsFile)
+		if err != nil {
+			return nil, fmt.Errorf("unable to parse %s: %w", OfflineEventsFile, err)
+		}
+		fixtures.events = events
+	}
+	return fixtures, nil
+}
+
+// parseOfflineProjects parses the YAML fixture of the projects. As the models only define JSON field names,
+// the YAML document is converted to JSON first
+func parseOfflineProjects(content []byte) ([]*models.Project, error) {
+	var document interface{}
+	if err := yaml.Unmarshal(content, &document); err != nil {
+		return nil, err
+	}
+	if document == nil {
+		return []*models.Project{}, nil
+	}
+	jsonContent, err := json.Marshal(document)
+	if err != nil {
+		return nil, err
+	}
+	projects := &models.Projects{}
+	if err := projects.FromJSON(jsonContent); err != nil {
+		return nil, err
+	}
+	if projects.Projects == nil {
+		return []*models.Project{}, nil
+	}
+	return projects.Projects, nil
+}
+
+func parseOfflineEvents(r io.Reader) ([]*models.KeptnContextExtendedCE, error) {
+	events := []*models.KeptnContextExtendedCE{}
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), 10*1024*1024)
+	for line := 1; scanner.Scan(); line++ {
+		if strings.TrimSpace(scanner.Text()) == "" {
+			continue
+		}
+		event := &models.KeptnContextExtendedCE{}
+		if err := event.FromJSON(scanner.Bytes()); err != nil {
+			return nil, fmt.Errorf("line %d: %w", line, err)
+		}
+		events = append(events, event)
+	}
+	return events, scanner.Err()
+}
+
+func (f *offlineFixtures) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.Body != nil {
+		req.Body.Close()
+	}
+	if req.Method != http.MethodGet {
+		// dry-run: pretend that the change has been applied
+		return offlineResponse(req, http.StatusOK, &models.EventContext{KeptnContext: strutils.Stringp(uuid.New().String())})
+	}
+
+	segments := splitEscapedPath(req.URL.EscapedPath())
+	switch {
+	case len(segments) >= 2 && segments[len(segments)-1] == "event" && segments[len(segments)-2] == mongodbDatastoreServiceBaseUrl:
+		return offlineResponse(req, http.StatusOK, &models.Events{Events: f.filterEvents(req)})
+	case len(segments) >= 3 && segments[0] == shipyardControllerBaseURL && "/"+segments[1]+"/"+segments[2] == v1ProjectPath:
+		return f.getProjectEntities(req, segments[3:])
+	}
+	return offlineNotFound(req)
+}
+
+// getProjectEntities answers requests for projects, stages and services, where the path is given relative to /v1/project
+func (f *offlineFixtures) getProjectEntities(req *http.Request, path []string) (*http.Response, error) {
+	if len(path) == 0 {
+		return offlineResponse(req, http.StatusOK, &models.Projects{Projects: f.projects, TotalCount: float64(len(f.projects))})
+	}
+	project := f.project(path[0])
+	if project == nil {
+		return offlineNotFound(req)
+	}
+	switch {
+	case len(path) == 1:
+		return offlineResponse(req, http.StatusOK, project)
+	case len(path) == 2 && path[1] == "stage":
+		return offlineResponse(req, http.StatusOK, &models.Stages{Stages: project.Stages, TotalCount: float64(len(project.Stages))})
+	}
+
+	if len(path) < 3 || path[1] != "stage" {
+		return offlineNotFound(req)
+	}
+	var stage *models.Stage
+	for _, s := range project.Stages {
+		if s.StageName == path[2] {
+			stage = s
+		}
+	}
+	if stage == nil {
+		return offlineNotFound(req)
+	}
+	switch {
+	case len(path) == 3:
+		return offlineResponse(req, http.StatusOK, stage)
+	case len(path) == 4 && path[3] == "service":
+		return offlineResponse(req, http.StatusOK, &models.Services{Services: stage.Services, TotalCount: float64(len(stage.Services))})
+	case len(path) == 5 && path[3] == "service":
+		for _, service := range stage.Services {
+			if service.ServiceName == path[4] {
+				return offlineResponse(req, http.StatusOK, service)
+			}
+		}
+	}
+	return offlineNotFound(req)
+}
+
+func (f *offlineFixtures) project(name string) *models.Project {
+	for _, project := range f.projects {
+		if project.ProjectName == name {
+			return project
+		}
+	}
+	return nil
+}
+
+// filterEvents returns the events matching the query parameters sent by EventHandler.GetEvents
+func (f *offlineFixtures) filterEvents(req *http.Request) []*models.KeptnContextExtendedCE {
+	query := req.URL.Query()
+	var fromTime time.Time
+	if value := query.Get("fromTime"); value != "" {
+		fromTime, _ = time.Parse(time.RFC3339, value)
+	}
+
+	events := []*models.KeptnContextExtendedCE{}
+	for _, event := range f.events {
+		data := struct {
+			Project string `json:"project"`
+			Stage   string `json:"stage"`
+			Service string `json:"service"`
+		}{}
+		_ = event.DataAs(&data)
+		eventType := ""
+		if event.Type != nil {
+			eventType = *event.Type
+		}
+		if !matchesQuery(query.Get("project"), data.Project) ||
+			!matchesQuery(query.Get("stage"), data.Stage) ||
+			!matchesQuery(query.Get("service"), data.Service) ||
+			!matchesQuery(query.Get("keptnContext"), event.Shkeptncontext) ||
+			!matchesQuery(query.Get("eventID"), event.ID) ||
+			!matchesQuery(query.Get("type"), eventType) ||
+			event.Time.Before(fromTime) {
+			continue
+		}
+		events = append(events, event)
+	}
+	return events
+}
+
+func matchesQuery(expected string, actual string) bool {
+	return expected == "" || expected == actual
+}
+
+func offlineResponse(req *http.Request, statusCode int, body interface{}) (*http.Response, error) {
+	content, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", statusCode, http.StatusText(statusCode)),
+		StatusCode:    statusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        http.Header{"Content-Type": []string{"application/json"}},
+		Body:          io.NopCloser(bytes.NewReader(content)),
+		ContentLength: int64(len(content)),
+		Request:       req,
+	}, nil
+}
+
+func offlineNotFound(req *http.Request) (*http.Response, error) {
+	message := fmt.Sprintf("%s is not available in offline mode", req.URL.Path)
+	return offlineResponse(req, http.StatusNotFound, &models.Error{Code: http.StatusNotFound, Message: &message})
+}
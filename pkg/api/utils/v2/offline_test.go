@@ -0,0 +1,108 @@
+package v2
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/keptn/go-utils/pkg/api/models"
+	"github.com/stretchr/testify/require"
+)
+
+const offlineProjectsFixture = `projects:
+  - projectName: sockshop
+    shipyardVersion: spec.keptn.sh/0.2.3
+    stages:
+      - stageName: dev
+        services:
+          - serviceName: carts
+          - serviceName: orders
+      - stageName: prod
+        services:
+          - serviceName: carts
+`
+
+const offlineEventsFixture = `{"id":"1","shkeptncontext":"ctx-1","type":"sh.keptn.event.deployment.triggered","time":"2022-06-01T10:00:00.000Z","data":{"project":"sockshop","stage":"dev","service":"carts"}}
+{"id":"2","shkeptncontext":"ctx-1","type":"sh.keptn.event.deployment.finished","time":"2022-06-01T10:05:00.000Z","data":{"project":"sockshop","stage":"dev","service":"carts"}}
+
+{"id":"3","shkeptncontext":"ctx-2","type":"sh.keptn.event.deployment.triggered","time":"2022-06-02T10:00:00.000Z","data":{"project":"sockshop","stage":"prod","service":"carts"}}
+`
+
+func newOfflineFixtureDir(t *testing.T) string {
+	dir := t.TempDir()
+	require.Nil(t, os.WriteFile(filepath.Join(dir, OfflineProjectsFile), []byte(offlineProjectsFixture), 0644))
+	require.Nil(t, os.WriteFile(filepath.Join(dir, OfflineEventsFile), []byte(offlineEventsFixture), 0644))
+	return dir
+}
+
+func TestNewOffline_Projects(t *testing.T) {
+	apiSet, err := NewOffline(newOfflineFixtureDir(t))
+	require.Nil(t, err)
+
+	projects, err := apiSet.Projects().GetAllProjects(context.TODO(), ProjectsGetAllProjectsOptions{})
+	require.Nil(t, err)
+	require.Len(t, projects, 1)
+	require.Equal(t, "sockshop", projects[0].ProjectName)
+
+	project, mErr := apiSet.Projects().GetProject(context.TODO(), models.Project{ProjectName: "sockshop"}, ProjectsGetProjectOptions{})
+	require.Nil(t, mErr)
+	require.Equal(t, "spec.keptn.sh/0.2.3", project.ShipyardVersion)
+
+	stages, err := apiSet.Stages().GetAllStages(context.TODO(), "sockshop", StagesGetAllStagesOptions{})
+	require.Nil(t, err)
+	require.Len(t, stages, 2)
+
+	service, err := apiSet.Services().GetService(context.TODO(), "sockshop", "dev", "orders", ServicesGetServiceOptions{})
+	require.Nil(t, err)
+	require.Equal(t, "orders", service.ServiceName)
+
+	_, mErr = apiSet.Projects().GetProject(context.TODO(), models.Project{ProjectName: "unknown"}, ProjectsGetProjectOptions{})
+	require.NotNil(t, mErr)
+	require.Equal(t, int64(404), mErr.Code)
+}
+
+func TestNewOffline_Events(t *testing.T) {
+	apiSet, err := NewOffline(newOfflineFixtureDir(t))
+	require.Nil(t, err)
+
+	events, mErr := apiSet.Events().GetEvents(context.TODO(), &EventFilter{KeptnContext: "ctx-1"}, EventsGetEventsOptions{})
+	require.Nil(t, mErr)
+	require.Len(t, events, 2)
+
+	events, mErr = apiSet.Events().GetEvents(context.TODO(), &EventFilter{Project: "sockshop", Stage: "prod", EventType: "sh.keptn.event.deployment.triggered"}, EventsGetEventsOptions{})
+	require.Nil(t, mErr)
+	require.Len(t, events, 1)
+	require.Equal(t, "3", events[0].ID)
+
+	events, mErr = apiSet.Events().GetEvents(context.TODO(), &EventFilter{FromTime: "2022-06-01T10:01:00.000Z"}, EventsGetEventsOptions{})
+	require.Nil(t, mErr)
+	require.Len(t, events, 2)
+}
+
+func TestNewOffline_DryRun(t *testing.T) {
+	apiSet, err := NewOffline(newOfflineFixtureDir(t))
+	require.Nil(t, err)
+
+	eventContext, mErr := apiSet.Services().CreateServiceInStage(context.TODO(), "sockshop", "dev", "payment", ServicesCreateServiceInStageOptions{})
+	require.Nil(t, mErr)
+	require.NotEmpty(t, *eventContext.KeptnContext)
+
+	services, err := apiSet.Services().GetAllServices(context.TODO(), "sockshop", "dev", ServicesGetAllServicesOptions{})
+	require.Nil(t, err)
+	require.Len(t, services, 2)
+}
+
+func TestNewOffline_MissingFixtures(t *testing.T) {
+	apiSet, err := NewOffline(t.TempDir())
+	require.Nil(t, err)
+
+	projects, err := apiSet.Projects().GetAllProjects(context.TODO(), ProjectsGetAllProjectsOptions{})
+	require.Nil(t, err)
+	require.Empty(t, projects)
+
+	dir := t.TempDir()
+	require.Nil(t, os.WriteFile(filepath.Join(dir, OfflineEventsFile), []byte("{invalid"), 0644))
+	_, err = NewOffline(dir)
+	require.NotNil(t, err)
+}
@@ -0,0 +1,276 @@
+package v2
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"time"
+
+	"github.com/keptn/go-utils/pkg/api/models"
+	"github.com/keptn/go-utils/pkg/common/retry"
+	"github.com/keptn/go-utils/pkg/lib/v0_2_0/shipyard"
+)
+
+const (
+	// DefaultOnboardingReadinessTimeout is the time APISet.OnboardProject waits for the project to become ready by default
+	DefaultOnboardingReadinessTimeout = time.Minute
+	onboardingReadinessInterval       = time.Second
+	onboardingReadinessMaxInterval    = 5 * time.Second
+)
+
+// OnboardingStep is a step of APISet.OnboardProject
+type OnboardingStep string
+
+const (
+	OnboardingStepCreateProject   OnboardingStep = "createProject"
+	OnboardingStepCreateServices  OnboardingStep = "createServices"
+	OnboardingStepUploadResources OnboardingStep = "uploadResources"
+	OnboardingStepVerifyReadiness OnboardingStep = "verifyReadiness"
+)
+
+// OnboardingResource is a resource uploaded by APISet.OnboardProject
+type OnboardingResource struct {
+	// Stage is the stage of the resource. If Stage and Service are empty, it is a resource of the project
+	Stage string
+	// Service is the service of the resource. If Stage is empty, the resource is uploaded to the service in all stages
+	Service string
+	URI     string
+	// Content is the content of the resource. It is base64 encoded while it is sent
+	Content string
+}
+
+// OnboardingSpec describes a project onboarded via APISet.OnboardProject
+type OnboardingSpec struct {
+	Project string
+	// Shipyard is the shipyard of the project, as YAML or already base64 encoded
+	Shipyard []byte
+	// GitCredentials are the credentials of the upstream repository of the project. They are optional
+	GitCredentials *models.GitAuthCredentials
+	// Services are created in all stages of the project
+	Services  []string
+	Resources []OnboardingResource
+}
+
+// OnboardingProgress is the progress of APISet.OnboardProject
+type OnboardingProgress struct {
+	Step OnboardingStep
+	// Item is the project, service or resource URI the step has just been completed for
+	Item string
+	// Done is the number of items of the step completed so far
+	Done  int
+	Total int
+}
+
+// OnboardingProgressFunc is called by APISet.OnboardProject after every completed item of a step
+type OnboardingProgressFunc func(progress OnboardingProgress)
+
+// report calls the OnboardingProgressFunc, if set
+func (f OnboardingProgressFunc) report(progress OnboardingProgress) {
+	if f != nil {
+		f(progress)
+	}
+}
+
+// OnboardingOnboardProjectOptions are options for APISet.OnboardProject().
+type OnboardingOnboardProjectOptions struct {
+	OnProgress OnboardingProgressFunc
+	// ReadinessTimeout is the time to wait for the project to become ready (default DefaultOnboardingReadinessTimeout).
+	// If it is negative, the readiness is not verified
+	ReadinessTimeout time.Duration
+}
+
+// OnboardingError is returned by APISet.OnboardProject if a step failed. The steps before have been completed,
+// so that onboarding can be continued, e.g. with an APISet created with WithAcceptAlreadyExists
+type OnboardingError struct {
+	Step OnboardingStep
+	// Item is the project, service or resource URI the step failed for
+	Item string
+	Err  error
+}
+
+func (e *OnboardingError) Error() string {
+	return fmt.Sprintf("onboarding failed in step %s for %s: %v", e.Step, e.Item, e.Err)
+}
+
+func (e *OnboardingError) Unwrap() error {
+	return e.Err
+}
+
+// OnboardProject creates the project of the spec with its shipyard, creates the services in all its stages and uploads
+// the resources, one after the other. Finally, it waits until the project is ready, i.e. the Keptn API is available,
+// the project contains all stages of the shipyard with all services, and its sequences can be queried.
+// The spec is validated before the first request is sent, and an *OnboardingError is returned if a step fails
+func (c *APISet) OnboardProject(ctx context.Context, spec OnboardingSpec, opts OnboardingOnboardProjectOptions) error {
+	encoded, stages, err := validateOnboardingSpec(spec)
+	if err != nil {
+		return &OnboardingError{Step: OnboardingStepCreateProject, Item: spec.Project, Err: err}
+	}
+
+	if _, mErr := c.apiHandler.CreateProject(ctx, models.CreateProject{
+		Name:           &spec.Project,
+		Shipyard:       &encoded,
+		GitCredentials: spec.GitCredentials,
+	}, APICreateProjectOptions{}); mErr != nil {
+		return &OnboardingError{Step: OnboardingStepCreateProject, Item: spec.Project, Err: mErr.ToError()}
+	}
+	opts.OnProgress.report(OnboardingProgress{Step: OnboardingStepCreateProject, Item: spec.Project, Done: 1, Total: 1})
+
+	for i, service := range spec.Services {
+		service := service
+		if _, mErr := c.apiHandler.CreateService(ctx, spec.Project, models.CreateService{ServiceName: &service}, APICreateServiceOptions{}); mErr != nil {
+			return &OnboardingError{Step: OnboardingStepCreateServices, Item: service, Err: mErr.ToError()}
+		}
+		opts.OnProgress.report(OnboardingProgress{Step: OnboardingStepCreateServices, Item: service, Done: i + 1, Total: len(spec.Services)})
+	}
+
+	scopes := onboardingResourceScopes(spec, stages)
+	for i, scope := range scopes {
+		resource := scope.resource
+		if _, err := c.resourceHandler.CreateResource(ctx, []*models.Resource{{ResourceURI: &resource.URI, ResourceContent: resource.Content}},
+			scope.scope, ResourcesCreateResourceOptions{}); err != nil {
+			return &OnboardingError{Step: OnboardingStepUploadResources, Item: resource.URI, Err: err}
+		}
+		opts.OnProgress.report(OnboardingProgress{Step: OnboardingStepUploadResources, Item: resource.URI, Done: i + 1, Total: len(scopes)})
+	}
+
+	if opts.ReadinessTimeout < 0 {
+		return nil
+	}
+	if err := c.waitForOnboardedProject(ctx, spec, stages, opts.ReadinessTimeout); err != nil {
+		return &OnboardingError{Step: OnboardingStepVerifyReadiness, Item: spec.Project, Err: err}
+	}
+	opts.OnProgress.report(OnboardingProgress{Step: OnboardingStepVerifyReadiness, Item: spec.Project, Done: 1, Total: 1})
+	return nil
+}
+
+// validateOnboardingSpec returns the base64 encoded shipyard of the spec and the names of its stages
+func validateOnboardingSpec(spec OnboardingSpec) (string, []string, error) {
+	encoded, err := encodeShipyard(spec.Project, spec.Shipyard)
+	if err != nil {
+		return "", nil, err
+	}
+	content, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		return "", nil, err
+	}
+	s, err := shipyard.Decode(content)
+	if err != nil {
+		return "", nil, err
+	}
+	stages := make([]string, 0, len(s.Spec.Stages))
+	stageNames := map[string]bool{}
+	for _, stage := range s.Spec.Stages {
+		stages = append(stages, stage.Name)
+		stageNames[stage.Name] = true
+	}
+
+	services := map[string]bool{}
+	for _, service := range spec.Services {
+		if err := ValidateIdentifier("service", service); err != nil {
+			return "", nil, err
+		}
+		if services[service] {
+			return "", nil, fmt.Errorf("service %s is declared more than once", service)
+		}
+		services[service] = true
+	}
+	for _, resource := range spec.Resources {
+		if resource.URI == "" {
+			return "", nil, fmt.Errorf("resource URI must be specified")
+		}
+		if resource.Stage != "" && !stageNames[resource.Stage] {
+			return "", nil, fmt.Errorf("stage %s of resource %s is not part of the shipyard", resource.Stage, resource.URI)
+		}
+		if resource.Service != "" && !services[resource.Service] {
+			return "", nil, fmt.Errorf("service %s of resource %s is not declared", resource.Service, resource.URI)
+		}
+	}
+	return encoded, stages, nil
+}
+
+// onboardingResourceScope is a resource of an OnboardingSpec together with the scope it is uploaded to
+type onboardingResourceScope struct {
+	resource OnboardingResource
+	scope    ResourceScope
+}
+
+// onboardingResourceScopes returns the resources of the spec with their scopes.
+// Resources of a service without a stage are returned once for every stage
+func onboardingResourceScopes(spec OnboardingSpec, stages []string) []onboardingResourceScope {
+	var scopes []onboardingResourceScope
+	for _, resource := range spec.Resources {
+		resourceStages := []string{resource.Stage}
+		if resource.Stage == "" && resource.Service != "" {
+			resourceStages = stages
+		}
+		for _, stage := range resourceStages {
+			scope := NewResourceScope().Project(spec.Project)
+			if stage != "" {
+				scope = scope.Stage(stage)
+			}
+			if resource.Service != "" {
+				scope = scope.Service(resource.Service)
+			}
+			scopes = append(scopes, onboardingResourceScope{resource: resource, scope: *scope})
+		}
+	}
+	return scopes
+}
+
+// waitForOnboardedProject waits until the Keptn API is available, the project contains the given stages with all
+// services of the spec, and its sequence states can be retrieved
+func (c *APISet) waitForOnboardedProject(ctx context.Context, spec OnboardingSpec, stages []string, timeout time.Duration) error {
+	if timeout == 0 {
+		timeout = DefaultOnboardingReadinessTimeout
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	return retry.Poll(ctx, onboardingReadinessInterval, onboardingReadinessMaxInterval, func(ctx context.Context) (bool, error) {
+		if _, mErr := c.apiHandler.GetMetadata(ctx, APIGetMetadataOptions{}); mErr != nil {
+			retry.ObserveState(ctx, "metadata not available: "+mErr.GetMessage())
+			return false, nil
+		}
+		project, mErr := c.projectHandler.GetProject(ctx, models.Project{ProjectName: spec.Project}, ProjectsGetProjectOptions{})
+		if mErr != nil {
+			retry.ObserveState(ctx, "project not available: "+mErr.GetMessage())
+			return false, nil
+		}
+		if missing := missingOnboardedEntity(project, stages, spec.Services); missing != "" {
+			retry.ObserveState(ctx, missing+" not available")
+			return false, nil
+		}
+		if _, err := c.sequenceControlHandler.GetSequenceStates(ctx, SequenceStateFilter{Project: spec.Project}, SequencesGetSequenceStatesOptions{}); err != nil {
+			retry.ObserveState(ctx, "sequence states not available: "+err.Error())
+			return false, nil
+		}
+		return true, nil
+	})
+}
+
+// missingOnboardedEntity returns the first of the given stages or services missing in the project, or "" if none is missing
+func missingOnboardedEntity(project *models.Project, stages []string, services []string) string {
+	projectStages := map[string]*models.Stage{}
+	for _, stage := range project.Stages {
+		if stage != nil {
+			projectStages[stage.StageName] = stage
+		}
+	}
+	for _, stageName := range stages {
+		stage, ok := projectStages[stageName]
+		if !ok {
+			return "stage " + stageName
+		}
+		stageServices := map[string]bool{}
+		for _, service := range stage.Services {
+			if service != nil {
+				stageServices[service.ServiceName] = true
+			}
+		}
+		for _, service := range services {
+			if !stageServices[service] {
+				return fmt.Sprintf("service %s in stage %s", service, stageName)
+			}
+		}
+	}
+	return ""
+}
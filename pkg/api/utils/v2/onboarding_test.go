@@ -0,0 +1,199 @@
+package v2
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/keptn/go-utils/pkg/api/models"
+	"github.com/stretchr/testify/require"
+)
+
+const onboardingShipyard = `apiVersion: spec.keptn.sh/0.2.3
+kind: Shipyard
+metadata:
+  name: shipyard
+spec:
+  stages:
+    - name: dev
+      sequences:
+        - name: delivery
+          tasks:
+            - name: deployment
+    - name: production
+      sequences:
+        - name: delivery
+          triggeredOn:
+            - event: dev.delivery.finished
+          tasks:
+            - name: deployment
+`
+
+// onboardingServer simulates the endpoints used by APISet.OnboardProject. The created services are added
+// to the stages of the project once readyAfter requests for the project have been answered
+type onboardingServer struct {
+	*recordingServer
+	mtx        sync.Mutex
+	services   []string
+	readyAfter int
+}
+
+func newOnboardingServer(readyAfter int) *onboardingServer {
+	s := &onboardingServer{readyAfter: readyAfter}
+	s.recordingServer = newRecordingServer(s.serveHTTP)
+	return s
+}
+
+func (s *onboardingServer) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	w.Header().Set("Content-Type", "application/json")
+	switch {
+	case r.Method == http.MethodPost && r.URL.Path == "/controlPlane/v1/project/my-project/service":
+		service := models.CreateService{}
+		json.NewDecoder(r.Body).Decode(&service)
+		s.services = append(s.services, *service.ServiceName)
+		w.Write([]byte(`{}`))
+	case r.Method == http.MethodPost:
+		w.Write([]byte(`{}`))
+	case r.URL.Path == "/v1/metadata":
+		w.Write([]byte(`{"keptnversion":"0.17.0"}`))
+	case r.URL.Path == "/controlPlane/v1/project/my-project":
+		project := models.Project{ProjectName: "my-project"}
+		s.readyAfter--
+		for _, stageName := range []string{"dev", "production"} {
+			stage := &models.Stage{StageName: stageName}
+			if s.readyAfter < 0 {
+				for _, service := range s.services {
+					stage.Services = append(stage.Services, &models.Service{ServiceName: service})
+				}
+			}
+			project.Stages = append(project.Stages, stage)
+		}
+		json.NewEncoder(w).Encode(project)
+	case r.URL.Path == "/controlPlane/v1/sequence/my-project":
+		w.Write([]byte(`{"states":[],"totalCount":0}`))
+	default:
+		w.WriteHeader(http.StatusNotFound)
+		w.Write([]byte(`{"code":404,"message":"not found"}`))
+	}
+}
+
+// getRequests returns the method and path of the received requests
+func (s *onboardingServer) getRequests() []string {
+	requests := []string{}
+	for _, r := range s.received() {
+		requests = append(requests, r.Method+" "+r.URL.Path)
+	}
+	return requests
+}
+
+func TestOnboardProject(t *testing.T) {
+	server := newOnboardingServer(1)
+	defer server.Close()
+	apiSet, err := New(server.URL)
+	require.NoError(t, err)
+
+	var progress []OnboardingProgress
+	err = apiSet.OnboardProject(context.TODO(), OnboardingSpec{
+		Project:  "my-project",
+		Shipyard: []byte(onboardingShipyard),
+		Services: []string{"carts", "orders"},
+		Resources: []OnboardingResource{
+			{URI: "metadata.yaml", Content: "owner: team-a"},
+			{Stage: "dev", URI: "slo.yaml", Content: "spec_version: '1.0'"},
+			{Service: "carts", URI: "helm/carts.tgz", Content: "chart"},
+		},
+	}, OnboardingOnboardProjectOptions{
+		OnProgress: func(p OnboardingProgress) { progress = append(progress, p) },
+	})
+	require.NoError(t, err)
+
+	requests := server.getRequests()
+	require.Equal(t, []string{
+		"POST /controlPlane/v1/project",
+		"POST /controlPlane/v1/project/my-project/service",
+		"POST /controlPlane/v1/project/my-project/service",
+		"POST /configuration-service/v1/project/my-project/resource",
+		"POST /configuration-service/v1/project/my-project/stage/dev/resource",
+		"POST /configuration-service/v1/project/my-project/stage/dev/service/carts/resource",
+		"POST /configuration-service/v1/project/my-project/stage/production/service/carts/resource",
+	}, requests[:7])
+	// the project becomes ready in the second readiness check
+	require.Equal(t, 2, strings.Count(strings.Join(requests[7:], "\n"), "GET /controlPlane/v1/project/my-project\n"))
+	require.Equal(t, "GET /controlPlane/v1/sequence/my-project", requests[len(requests)-1])
+
+	require.Len(t, progress, 8)
+	require.Equal(t, OnboardingProgress{Step: OnboardingStepCreateProject, Item: "my-project", Done: 1, Total: 1}, progress[0])
+	require.Equal(t, OnboardingProgress{Step: OnboardingStepCreateServices, Item: "orders", Done: 2, Total: 2}, progress[2])
+	require.Equal(t, OnboardingProgress{Step: OnboardingStepUploadResources, Item: "helm/carts.tgz", Done: 4, Total: 4}, progress[6])
+	require.Equal(t, OnboardingStepVerifyReadiness, progress[7].Step)
+}
+
+func TestOnboardProject_NotReady(t *testing.T) {
+	server := newOnboardingServer(1000)
+	defer server.Close()
+	apiSet, err := New(server.URL)
+	require.NoError(t, err)
+
+	err = apiSet.OnboardProject(context.TODO(), OnboardingSpec{
+		Project:  "my-project",
+		Shipyard: []byte(onboardingShipyard),
+		Services: []string{"carts"},
+	}, OnboardingOnboardProjectOptions{ReadinessTimeout: 100 * time.Millisecond})
+	onboardingErr := &OnboardingError{}
+	require.True(t, errors.As(err, &onboardingErr))
+	require.Equal(t, OnboardingStepVerifyReadiness, onboardingErr.Step)
+	require.Contains(t, err.Error(), "service carts in stage dev not available")
+}
+
+func TestOnboardProject_InvalidSpec(t *testing.T) {
+	server := newOnboardingServer(0)
+	defer server.Close()
+	apiSet, err := New(server.URL)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name        string
+		spec        OnboardingSpec
+		wantMessage string
+	}{
+		{
+			name:        "invalid shipyard",
+			spec:        OnboardingSpec{Project: "my-project", Shipyard: []byte("kind: Shipyard")},
+			wantMessage: "invalid shipyard",
+		},
+		{
+			name:        "duplicate service",
+			spec:        OnboardingSpec{Project: "my-project", Shipyard: []byte(onboardingShipyard), Services: []string{"carts", "carts"}},
+			wantMessage: "service carts is declared more than once",
+		},
+		{
+			name: "unknown stage",
+			spec: OnboardingSpec{Project: "my-project", Shipyard: []byte(onboardingShipyard),
+				Resources: []OnboardingResource{{Stage: "staging", URI: "slo.yaml"}}},
+			wantMessage: "stage staging of resource slo.yaml is not part of the shipyard",
+		},
+		{
+			name: "undeclared service",
+			spec: OnboardingSpec{Project: "my-project", Shipyard: []byte(onboardingShipyard),
+				Resources: []OnboardingResource{{Service: "carts", URI: "slo.yaml"}}},
+			wantMessage: "service carts of resource slo.yaml is not declared",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := apiSet.OnboardProject(context.TODO(), tt.spec, OnboardingOnboardProjectOptions{})
+			onboardingErr := &OnboardingError{}
+			require.True(t, errors.As(err, &onboardingErr))
+			require.Equal(t, OnboardingStepCreateProject, onboardingErr.Step)
+			require.Contains(t, err.Error(), tt.wantMessage)
+		})
+	}
+	require.Empty(t, server.getRequests())
+}
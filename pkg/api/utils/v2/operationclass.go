@@ -0,0 +1,233 @@
+package v2
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/keptn/go-utils/pkg/common/retry"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric/global"
+	"go.opentelemetry.io/otel/metric/instrument"
+	"go.opentelemetry.io/otel/metric/instrument/syncint64"
+)
+
+// OperationClass groups the requests to the Keptn API which share a timeout and retry policy
+type OperationClass string
+
+const (
+	// ReadOperation are requests retrieving data, i.e. GET and HEAD requests
+	ReadOperation OperationClass = "read"
+	// WriteOperation are requests creating, updating or deleting entities
+	WriteOperation OperationClass = "write"
+	// EventSendOperation are requests sending events to the Keptn API
+	EventSendOperation OperationClass = "event-send"
+	// LongPollOperation are requests which are expected to take long, e.g. waiting for events.
+	// Requests are only assigned to this class via ContextWithOperationClass
+	LongPollOperation OperationClass = "long-poll"
+)
+
+// operationAttemptsCounterName is the name of the OpenTelemetry counter of the attempts per OperationClass
+const operationAttemptsCounterName = "keptn.api.attempts"
+
+// OperationClassAttribute is the attribute containing the OperationClass of the attempts counted in keptn.api.attempts
+const OperationClassAttribute = attribute.Key("keptn.operation.class")
+
+// OperationPolicy is the timeout and retry policy of an OperationClass
+type OperationPolicy struct {
+	// Timeout is the maximum duration of a single attempt, including reading the response body. 0 disables the timeout
+	Timeout time.Duration
+	// MaxRetries is the maximum number of times a request which failed with a retryable error,
+	// e.g. a connection error or a 503 response, is sent again. 0 disables retries
+	MaxRetries int
+	// InitialBackoff is the delay before the first retry. It doubles with every retry until it reaches MaxBackoff
+	InitialBackoff time.Duration
+	// MaxBackoff is the maximum delay between two attempts
+	MaxBackoff time.Duration
+}
+
+// DefaultOperationPolicies returns the policies used by WithOperationClasses for classes without an override.
+// Writes and sent events are not retried, since the Keptn API might have applied a request whose response got lost,
+// and an event sent twice would e.g. trigger a sequence twice. Retries of events can be enabled by overriding EventSendOperation
+func DefaultOperationPolicies() map[OperationClass]OperationPolicy {
+	return map[OperationClass]OperationPolicy{
+		ReadOperation:      {Timeout: 30 * time.Second, MaxRetries: 3, InitialBackoff: 200 * time.Millisecond, MaxBackoff: 5 * time.Second},
+		WriteOperation:     {Timeout: 60 * time.Second},
+		EventSendOperation: {Timeout: 30 * time.Second},
+		LongPollOperation:  {MaxRetries: 3, InitialBackoff: time.Second, MaxBackoff: 30 * time.Second},
+	}
+}
+
+type operationClassContextKey struct{}
+
+// ContextWithOperationClass returns a context which makes all requests sent with it use the policy of the given class,
+// e.g. to use LongPollOperation for a listing which is expected to take long
+func ContextWithOperationClass(ctx context.Context, class OperationClass) context.Context {
+	return context.WithValue(ctx, operationClassContextKey{}, class)
+}
+
+// OperationClassFromContext returns the OperationClass stored in the context, or an empty string if there is none
+func OperationClassFromContext(ctx context.Context) OperationClass {
+	class, _ := ctx.Value(operationClassContextKey{}).(OperationClass)
+	return class
+}
+
+// WithOperationClasses applies a timeout and retry policy to each request depending on its OperationClass.
+// The class of a request is taken from its context, see ContextWithOperationClass, or derived from the request:
+// GET and HEAD requests are reads, POST requests to /v1/event send events, and all other requests are writes.
+// The given policies override the ones of DefaultOperationPolicies for their classes.
+// The number of attempts per class is emitted as OpenTelemetry counter keptn.api.attempts
+func WithOperationClasses(overrides map[OperationClass]OperationPolicy) func(*APISet) {
+	return func(a *APISet) {
+		policies := DefaultOperationPolicies()
+		for class, policy := range overrides {
+			policies[class] = policy
+		}
+		a.operationPolicies = policies
+	}
+}
+
+// classifyRequest returns the OperationClass of the request
+func classifyRequest(req *http.Request) OperationClass {
+	if class := OperationClassFromContext(req.Context()); class != "" {
+		return class
+	}
+	switch {
+	case req.Method == http.MethodGet || req.Method == http.MethodHead:
+		return ReadOperation
+	case req.Method == http.MethodPost && strings.HasSuffix(req.URL.Path, v1EventPath):
+		return EventSendOperation
+	}
+	return WriteOperation
+}
+
+// operationClassTransport is a http.RoundTripper applying the OperationPolicy of the class of each request
+type operationClassTransport struct {
+	base     http.RoundTripper
+	policies map[OperationClass]OperationPolicy
+	attempts syncint64.Counter
+}
+
+func newOperationClassTransport(base http.RoundTripper, policies map[OperationClass]OperationPolicy) *operationClassTransport {
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	t := &operationClassTransport{base: base, policies: policies}
+	attempts, err := global.Meter("github.com/keptn/go-utils/pkg/api/utils/v2").SyncInt64().Counter(
+		operationAttemptsCounterName,
+		instrument.WithDescription("number of requests sent to the Keptn API per operation class, including retries"),
+	)
+	if err == nil {
+		t.attempts = attempts
+	}
+	return t
+}
+
+func (t *operationClassTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	class := classifyRequest(req)
+	policy := t.policies[class]
+	retries := policy.MaxRetries
+	if retries <= 0 {
+		return t.attempt(req, class, policy)
+	}
+	if !isReplayable(req) {
+		return t.attemptOnce(req, class, policy)
+	}
+
+	var resp *http.Response
+	var err error
+	attempts := 0
+	backoff := policy.InitialBackoff
+	if backoff <= 0 {
+		backoff = DefaultPageRetryInterval
+	}
+	pollErr := retry.Poll(req.Context(), backoff, policy.MaxBackoff, func(ctx context.Context) (bool, error) {
+		attemptReq := req
+		if attempts > 0 && req.GetBody != nil {
+			attemptReq = req.Clone(ctx)
+			if attemptReq.Body, err = req.GetBody(); err != nil {
+				return false, err
+			}
+		}
+		resp, err = t.attempt(attemptReq, class, policy)
+		attempts++
+		if attempts > retries || !isRetryableAttempt(req.Context(), resp, err) {
+			return true, nil
+		}
+		if resp != nil {
+			io.Copy(ioutil.Discard, resp.Body)
+			resp.Body.Close()
+		}
+		return false, nil
+	})
+	if pollErr != nil {
+		return nil, pollErr
+	}
+	return resp, err
+}
+
+// attemptOnce sends a request whose body cannot be re-created. It fails with ErrBodyNotReplayable
+// if the attempt would have been retried
+func (t *operationClassTransport) attemptOnce(req *http.Request, class OperationClass, policy OperationPolicy) (*http.Response, error) {
+	resp, err := t.attempt(req, class, policy)
+	if !isRetryableAttempt(req.Context(), resp, err) {
+		return resp, err
+	}
+	if err != nil {
+		return nil, fmt.Errorf("could not retry request: %v: %w", err, ErrBodyNotReplayable)
+	}
+	io.Copy(ioutil.Discard, resp.Body)
+	resp.Body.Close()
+	return nil, fmt.Errorf("could not retry request after status %d: %w", resp.StatusCode, ErrBodyNotReplayable)
+}
+
+// attempt sends the request once, bounded by the timeout of the policy
+func (t *operationClassTransport) attempt(req *http.Request, class OperationClass, policy OperationPolicy) (*http.Response, error) {
+	if t.attempts != nil {
+		t.attempts.Add(req.Context(), 1, OperationClassAttribute.String(string(class)))
+	}
+	if policy.Timeout <= 0 {
+		return t.base.RoundTrip(req)
+	}
+	ctx, cancel := context.WithTimeout(req.Context(), policy.Timeout)
+	resp, err := t.base.RoundTrip(req.WithContext(ctx))
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+	// the timeout also applies to reading the body, so it is only released once the body is closed
+	resp.Body = &cancelOnClose{ReadCloser: resp.Body, cancel: cancel}
+	return resp, nil
+}
+
+// isRetryableAttempt returns whether a request is worth sending again, i.e. whether it failed with a connection error,
+// a timeout of the attempt or a response indicating a temporary problem of the Keptn API
+func isRetryableAttempt(ctx context.Context, resp *http.Response, err error) bool {
+	if err != nil {
+		// if the context of the caller is done, further attempts would fail as well,
+		// just like attempts to reach a server whose certificate cannot be verified
+		return ctx.Err() == nil && !errors.Is(ClassifyNetworkError(err), ErrTLSVerification)
+	}
+	switch resp.StatusCode {
+	case http.StatusRequestTimeout, http.StatusTooManyRequests, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	}
+	return false
+}
+
+// cancelOnClose releases the context of a request once its response body is closed
+type cancelOnClose struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
+
+func (c *cancelOnClose) Close() error {
+	err := c.ReadCloser.Close()
+	c.cancel()
+	return err
+}
@@ -0,0 +1,127 @@
+package v2
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/keptn/go-utils/pkg/api/models"
+	"github.com/keptn/go-utils/pkg/common/strutils"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClassifyRequest(t *testing.T) {
+	tests := []struct {
+		name   string
+		ctx    context.Context
+		method string
+		path   string
+		want   OperationClass
+	}{
+		{name: "get", ctx: context.TODO(), method: http.MethodGet, path: "/controlPlane/v1/project", want: ReadOperation},
+		{name: "send event", ctx: context.TODO(), method: http.MethodPost, path: "/api/v1/event", want: EventSendOperation},
+		{name: "create project", ctx: context.TODO(), method: http.MethodPost, path: "/api/controlPlane/v1/project", want: WriteOperation},
+		{name: "delete", ctx: context.TODO(), method: http.MethodDelete, path: "/api/controlPlane/v1/project/my-project", want: WriteOperation},
+		{name: "class from context", ctx: ContextWithOperationClass(context.TODO(), LongPollOperation), method: http.MethodGet, path: "/api/mongodb-datastore/event", want: LongPollOperation},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req, err := http.NewRequestWithContext(tt.ctx, tt.method, "http://localhost"+tt.path, nil)
+			require.Nil(t, err)
+			require.Equal(t, tt.want, classifyRequest(req))
+		})
+	}
+}
+
+// newFailingServer responds with 503 to the first failures requests
+func newFailingServer(failures int32) *recordingServer {
+	var attempts int32
+	return newRecordingServer(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&attempts, 1) <= failures {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			w.Write([]byte(`{"code":503,"message":"unavailable"}`))
+			return
+		}
+		w.Write([]byte(`{"keptnContext":"my-context"}`))
+	})
+}
+
+func TestWithOperationClasses_EventsAreNotRetriedByDefault(t *testing.T) {
+	server := newFailingServer(1)
+	defer server.Close()
+
+	apiSet, err := New(server.URL, WithOperationClasses(nil))
+	require.Nil(t, err)
+
+	_, mErr := apiSet.API().SendEvent(context.TODO(), models.KeptnContextExtendedCE{
+		Type:   strutils.Stringp("sh.keptn.event.echo.triggered"),
+		Source: strutils.Stringp("my-service"),
+	}, APISendEventOptions{})
+	require.NotNil(t, mErr)
+	require.Equal(t, int64(http.StatusServiceUnavailable), mErr.Code)
+	require.Len(t, server.received(), 1)
+}
+
+func TestWithOperationClasses_RetriesPerClass(t *testing.T) {
+	server := newFailingServer(2)
+	defer server.Close()
+
+	classes := map[OperationClass]OperationPolicy{
+		EventSendOperation: {MaxRetries: 3, InitialBackoff: time.Millisecond, MaxBackoff: time.Millisecond},
+	}
+	apiSet, err := New(server.URL, WithOperationClasses(classes))
+	require.Nil(t, err)
+
+	// events are sent again after a 503 response if retries are enabled
+	eventContext, mErr := apiSet.API().SendEvent(context.TODO(), models.KeptnContextExtendedCE{
+		Type:   strutils.Stringp("sh.keptn.event.echo.triggered"),
+		Source: strutils.Stringp("my-service"),
+	}, APISendEventOptions{})
+	require.Nil(t, mErr)
+	require.Equal(t, "my-context", *eventContext.KeptnContext)
+	require.Len(t, server.received(), 3)
+
+	// writes are not retried by default
+	writeServer := newFailingServer(2)
+	defer writeServer.Close()
+	apiSet, err = New(writeServer.URL, WithOperationClasses(classes))
+	require.Nil(t, err)
+	_, mErr = apiSet.API().CreateProject(context.TODO(), models.CreateProject{Name: strutils.Stringp("my-project")}, APICreateProjectOptions{})
+	require.NotNil(t, mErr)
+	require.Equal(t, int64(http.StatusServiceUnavailable), mErr.Code)
+	require.Len(t, writeServer.received(), 1)
+}
+
+func TestWithOperationClasses_TimeoutPerAttempt(t *testing.T) {
+	var requests int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&requests, 1) == 1 {
+			select {
+			case <-r.Context().Done():
+			case <-time.After(time.Second):
+			}
+			return
+		}
+		w.Write([]byte(`{"projects":[{"projectName":"my-project"}]}`))
+	}))
+	defer server.Close()
+
+	apiSet, err := New(server.URL, WithOperationClasses(map[OperationClass]OperationPolicy{
+		ReadOperation: {Timeout: 50 * time.Millisecond, MaxRetries: 1, InitialBackoff: time.Millisecond},
+	}))
+	require.Nil(t, err)
+
+	projects, err := apiSet.Projects().GetAllProjects(context.TODO(), ProjectsGetAllProjectsOptions{})
+	require.Nil(t, err)
+	require.Len(t, projects, 1)
+	require.Equal(t, int32(2), atomic.LoadInt32(&requests))
+}
+
+func TestDefaultOperationPolicies_AreCopies(t *testing.T) {
+	policies := DefaultOperationPolicies()
+	policies[ReadOperation] = OperationPolicy{}
+	require.NotEqual(t, OperationPolicy{}, DefaultOperationPolicies()[ReadOperation])
+}
@@ -0,0 +1,207 @@
+package v2
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// RequestIDHeader is the header used to correlate a request of the client with the logs of the Keptn API
+const RequestIDHeader = "X-Request-ID"
+
+// maxOperationResultBodySize is the maximum size of a response body that is inspected for a keptnContext
+const maxOperationResultBodySize = 64 * 1024
+
+// OperationTarget identifies the entity affected by an operation.
+// Depending on the endpoint, some of the fields are empty
+type OperationTarget struct {
+	Project  string `json:"project,omitempty"`
+	Stage    string `json:"stage,omitempty"`
+	Service  string `json:"service,omitempty"`
+	Resource string `json:"resource,omitempty"`
+}
+
+// OperationResult describes a create, update or delete request sent to the Keptn API
+type OperationResult struct {
+	// Method is the HTTP method of the request
+	Method string `json:"method"`
+	// URL is the URL of the request, without query parameters
+	URL string `json:"url"`
+	// Class is the OperationClass of the request
+	Class OperationClass `json:"class"`
+	// StatusCode is the HTTP status code of the response, or 0 if no response was received
+	StatusCode int `json:"statusCode"`
+	// KeptnContext is the keptnContext returned by the Keptn API, if any
+	KeptnContext string `json:"keptnContext,omitempty"`
+	// RequestID is the request ID returned by the Keptn API. If the server did not return one,
+	// it is the request ID sent by the client
+	RequestID string `json:"requestID"`
+	// Target identifies the project, stage, service and resource the operation was applied to
+	Target OperationTarget `json:"target"`
+	// StartedAt is the time at which the request was sent
+	StartedAt time.Time `json:"startedAt"`
+	// FinishedAt is the time at which the response was received
+	FinishedAt time.Time `json:"finishedAt"`
+	// Error contains the transport error, if the request could not be sent
+	Error string `json:"error,omitempty"`
+}
+
+// OperationResultHandler is called after every create, update or delete request sent to the Keptn API
+type OperationResultHandler func(result OperationResult)
+
+// WithOperationResultHandler configures an OperationResultHandler which is called with an OperationResult
+// for every POST, PUT, PATCH and DELETE request, so that automation can log and correlate changes end-to-end.
+// The request ID of the OperationResult can be set via ContextWithRequestID
+func WithOperationResultHandler(handler OperationResultHandler) func(*APISet) {
+	return func(a *APISet) {
+		a.operationResultHandler = handler
+	}
+}
+
+// operationResultTransport is a http.RoundTripper which reports an OperationResult for each mutating request
+type operationResultTransport struct {
+	base    http.RoundTripper
+	handler OperationResultHandler
+}
+
+func newOperationResultTransport(base http.RoundTripper, handler OperationResultHandler) *operationResultTransport {
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	return &operationResultTransport{base: base, handler: handler}
+}
+
+func (t *operationResultTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if !isMutatingMethod(req.Method) {
+		return t.base.RoundTrip(req)
+	}
+	requestID := req.Header.Get(RequestIDHeader)
+	if requestID == "" {
+		requestID = uuid.New().String()
+		req = req.Clone(req.Context())
+		req.Header.Set(RequestIDHeader, requestID)
+	}
+
+	result := OperationResult{
+		Method:    req.Method,
+		URL:       req.URL.Scheme + "://" + req.URL.Host + req.URL.Path,
+		Class:     classifyRequest(req),
+		RequestID: requestID,
+		Target:    parseOperationTarget(req),
+		StartedAt: time.Now().UTC(),
+	}
+	resp, err := t.base.RoundTrip(req)
+	result.FinishedAt = time.Now().UTC()
+	if err != nil {
+		result.Error = err.Error()
+		t.handler(result)
+		return resp, err
+	}
+
+	result.StatusCode = resp.StatusCode
+	if id := resp.Header.Get(RequestIDHeader); id != "" {
+		result.RequestID = id
+	}
+	result.KeptnContext = readKeptnContext(resp)
+	t.handler(result)
+	return resp, nil
+}
+
+func isMutatingMethod(method string) bool {
+	switch method {
+	case http.MethodPost, http.MethodPut, http.MethodPatch, http.MethodDelete:
+		return true
+	}
+	return false
+}
+
+// readKeptnContext returns the keptnContext contained in a JSON response body.
+// The body is restored, so that it can still be read by the caller
+func readKeptnContext(resp *http.Response) string {
+	if resp.Body == nil || resp.ContentLength > maxOperationResultBodySize {
+		return ""
+	}
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxOperationResultBodySize+1))
+	resp.Body = struct {
+		io.Reader
+		io.Closer
+	}{io.MultiReader(bytes.NewReader(body), resp.Body), resp.Body}
+	if err != nil || len(body) > maxOperationResultBodySize {
+		return ""
+	}
+
+	eventContext := struct {
+		KeptnContext string `json:"keptnContext"`
+	}{}
+	if err := json.Unmarshal(body, &eventContext); err != nil {
+		return ""
+	}
+	return eventContext.KeptnContext
+}
+
+// parseOperationTarget extracts the project, stage, service and resource from the path of a request.
+// Entities that are created via POST are usually only named in the request body, which is used to fill in the missing fields
+func parseOperationTarget(req *http.Request) OperationTarget {
+	target := parseOperationTargetPath(req.URL.EscapedPath())
+	if req.GetBody == nil {
+		return target
+	}
+	body, err := req.GetBody()
+	if err != nil {
+		return target
+	}
+	defer body.Close()
+	entity := struct {
+		Name        string `json:"name"`
+		ProjectName string `json:"projectName"`
+		StageName   string `json:"stageName"`
+		ServiceName string `json:"serviceName"`
+	}{}
+	if err := json.NewDecoder(io.LimitReader(body, maxOperationResultBodySize)).Decode(&entity); err != nil {
+		return target
+	}
+	if target.Project == "" {
+		target.Project = entity.ProjectName
+	}
+	if target.Project == "" && strings.HasSuffix(req.URL.Path, v1ProjectPath) {
+		// projects are created with their name in the name field
+		target.Project = entity.Name
+	}
+	if target.Stage == "" {
+		target.Stage = entity.StageName
+	}
+	if target.Service == "" {
+		target.Service = entity.ServiceName
+	}
+	return target
+}
+
+// parseOperationTargetPath extracts the project, stage, service and resource from the escaped path of a request,
+// e.g. /configuration-service/v1/project/my-project/stage/dev/service/carts/resource/helm/chart.tgz
+func parseOperationTargetPath(path string) OperationTarget {
+	target := OperationTarget{}
+	segments := splitEscapedPath(path)
+	for i := 0; i+1 < len(segments); i++ {
+		value := segments[i+1]
+		switch segments[i] {
+		case "project":
+			target.Project = value
+		case "stage":
+			target.Stage = value
+		case "service":
+			target.Service = value
+		case "resource":
+			target.Resource = strings.Join(segments[i+1:], "/")
+			return target
+		default:
+			continue
+		}
+		i++
+	}
+	return target
+}
@@ -0,0 +1,84 @@
+package v2
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/keptn/go-utils/pkg/api/models"
+	"github.com/keptn/go-utils/pkg/common/strutils"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_parseOperationTargetPath(t *testing.T) {
+	tests := []struct {
+		path string
+		want OperationTarget
+	}{
+		{path: "/controlPlane/v1/project", want: OperationTarget{}},
+		{path: "/controlPlane/v1/project/my-project", want: OperationTarget{Project: "my-project"}},
+		{path: "/controlPlane/v1/project/my-project/stage/dev/service", want: OperationTarget{Project: "my-project", Stage: "dev"}},
+		{path: "/controlPlane/v1/project/my-project/service/carts", want: OperationTarget{Project: "my-project", Service: "carts"}},
+		{
+			path: "/configuration-service/v1/project/my-project/stage/dev/service/carts/resource/helm/chart.tgz",
+			want: OperationTarget{Project: "my-project", Stage: "dev", Service: "carts", Resource: "helm/chart.tgz"},
+		},
+		{
+			path: "/controlPlane/v1/project/my%2Fproject/stage/dev%20eu/service/carts%2Bv2",
+			want: OperationTarget{Project: "my/project", Stage: "dev eu", Service: "carts+v2"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			require.Equal(t, tt.want, parseOperationTargetPath(tt.path))
+		})
+	}
+}
+
+func TestAPISet_WithOperationResultHandler(t *testing.T) {
+	var receivedRequestIDs []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		receivedRequestIDs = append(receivedRequestIDs, r.Header.Get(RequestIDHeader))
+		if r.Method == http.MethodDelete {
+			w.Header().Set(RequestIDHeader, "server-request-id")
+		}
+		w.Write([]byte(`{"keptnContext":"my-context"}`))
+	}))
+	defer server.Close()
+
+	results := []OperationResult{}
+	apiSet, err := New(server.URL, WithOperationResultHandler(func(result OperationResult) {
+		results = append(results, result)
+	}))
+	require.Nil(t, err)
+
+	_, mErr := apiSet.API().GetMetadata(context.TODO(), APIGetMetadataOptions{})
+	require.Nil(t, mErr)
+	require.Empty(t, results)
+
+	eventContext, mErr := apiSet.Services().CreateServiceInStage(context.TODO(), "my-project", "dev", "carts", ServicesCreateServiceInStageOptions{})
+	require.Nil(t, mErr)
+	require.Equal(t, "my-context", *eventContext.KeptnContext)
+	deleteResponse, mErr := apiSet.API().DeleteProject(context.TODO(), models.Project{ProjectName: "my-project"}, APIDeleteProjectOptions{})
+	require.Nil(t, mErr)
+	require.NotNil(t, deleteResponse)
+	_, mErr = apiSet.API().CreateProject(context.TODO(), models.CreateProject{Name: strutils.Stringp("other-project")}, APICreateProjectOptions{})
+	require.Nil(t, mErr)
+
+	require.Len(t, results, 3)
+	require.Equal(t, http.MethodPost, results[0].Method)
+	require.Equal(t, http.StatusOK, results[0].StatusCode)
+	require.Equal(t, "my-context", results[0].KeptnContext)
+	require.Equal(t, OperationTarget{Project: "my-project", Stage: "dev", Service: "carts"}, results[0].Target)
+	require.NotEmpty(t, results[0].RequestID)
+	require.Equal(t, receivedRequestIDs[1], results[0].RequestID)
+	require.False(t, results[0].FinishedAt.Before(results[0].StartedAt))
+
+	require.Equal(t, http.MethodDelete, results[1].Method)
+	require.Equal(t, "server-request-id", results[1].RequestID)
+	require.Equal(t, OperationTarget{Project: "my-project"}, results[1].Target)
+
+	require.Equal(t, OperationTarget{Project: "other-project"}, results[2].Target)
+	require.Equal(t, []OperationClass{WriteOperation, WriteOperation, WriteOperation}, []OperationClass{results[0].Class, results[1].Class, results[2].Class})
+}
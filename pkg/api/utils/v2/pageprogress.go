@@ -0,0 +1,26 @@
+package v2
+
+import "github.com/keptn/go-utils/pkg/api/models"
+
+// PageProgress is the progress of a call fetching a list from the Keptn API page by page
+type PageProgress struct {
+	// Pages is the number of pages fetched so far
+	Pages int
+	// Items is the number of items fetched so far
+	Items int
+	// TotalCount is the total number of items reported by the Keptn API, or 0 if it is unknown
+	TotalCount int
+	// Cursor points to the next page. It is at its end once the last page has been fetched
+	Cursor models.Cursor
+}
+
+// PageProgressFunc is called after each page fetched by a paginated call, e.g. to render a progress bar
+// or to log a heartbeat during long listings. It is called on the goroutine of the call
+type PageProgressFunc func(progress PageProgress)
+
+// report calls the PageProgressFunc, if set
+func (f PageProgressFunc) report(progress PageProgress) {
+	if f != nil {
+		f(progress)
+	}
+}
@@ -0,0 +1,46 @@
+package v2
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/keptn/go-utils/pkg/api/models"
+	"github.com/stretchr/testify/require"
+)
+
+// progressRecorder records the progress reported by a paginated call without the cursors,
+// which are opaque to the caller
+func progressRecorder() (PageProgressFunc, *[]PageProgress, *[]bool) {
+	progress := []PageProgress{}
+	ends := []bool{}
+	return func(p PageProgress) {
+		ends = append(ends, p.Cursor.IsEnd())
+		p.Cursor = models.Cursor{}
+		progress = append(progress, p)
+	}, &progress, &ends
+}
+
+func TestGetAllProjects_ReportsProgress(t *testing.T) {
+	server := newFlakyPagingServer(0, http.StatusServiceUnavailable)
+	defer server.Close()
+
+	onProgress, progress, ends := progressRecorder()
+	projects, err := NewProjectHandler(server.URL).GetAllProjects(context.TODO(), ProjectsGetAllProjectsOptions{OnProgress: onProgress})
+	require.NoError(t, err)
+	require.Len(t, projects, 2)
+	require.Equal(t, []PageProgress{{Pages: 1, Items: 1}, {Pages: 2, Items: 2}}, *progress)
+	require.Equal(t, []bool{false, true}, *ends)
+}
+
+func TestGetEvents_ReportsProgress(t *testing.T) {
+	server := newFlakyPagingServer(0, http.StatusServiceUnavailable)
+	defer server.Close()
+
+	onProgress, progress, ends := progressRecorder()
+	events, err := NewEventHandler(server.URL).GetEvents(context.TODO(), &EventFilter{Project: "my-project"}, EventsGetEventsOptions{OnProgress: onProgress})
+	require.Nil(t, err)
+	require.Len(t, events, 2)
+	require.Equal(t, []PageProgress{{Pages: 1, Items: 1}, {Pages: 2, Items: 2}}, *progress)
+	require.Equal(t, []bool{false, true}, *ends)
+}
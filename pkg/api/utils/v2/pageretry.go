@@ -0,0 +1,88 @@
+package v2
+
+import (
+	"context"
+	"time"
+
+	"github.com/keptn/go-utils/pkg/api/models"
+	"github.com/keptn/go-utils/pkg/common/retry"
+)
+
+// DefaultPageRetryInterval is the default delay before a failed page of a paginated listing is requested again
+const DefaultPageRetryInterval = 500 * time.Millisecond
+
+// maxPageRetryInterval is the maximum delay between two attempts to retrieve a page
+const maxPageRetryInterval = 10 * time.Second
+
+// pageRetryPolicy configures how often a page of a paginated listing is requested again after a transient failure,
+// see WithPageRetries
+type pageRetryPolicy struct {
+	maxRetries int
+	interval   time.Duration
+}
+
+// WithPageRetries makes paginated listings, e.g. ProjectsInterface.GetAllProjects, StagesInterface.GetAllStages
+// and the retrieval of events or resources, request a page which failed with a retryable error, e.g. a timeout or a 503 response, again up to maxRetries times.
+// The listing continues with the cursor of the failed page instead of starting again from the first page.
+// The delay between two attempts starts at interval and doubles with every attempt.
+// If interval is not positive, DefaultPageRetryInterval is used
+func WithPageRetries(maxRetries int, interval time.Duration) func(*APISet) {
+	return func(a *APISet) {
+		if interval <= 0 {
+			interval = DefaultPageRetryInterval
+		}
+		a.pageRetries = pageRetryPolicy{maxRetries: maxRetries, interval: interval}
+	}
+}
+
+// PageRetries overrides the page retries configured via WithPageRetries for a single call,
+// e.g. to retry the pages of an important listing more often than usual
+type PageRetries struct {
+	// MaxRetries is the maximum number of times a failed page is requested again. 0 disables retrying pages
+	MaxRetries int
+	// Interval is the delay before the first retry. If it is not positive, DefaultPageRetryInterval is used
+	Interval time.Duration
+}
+
+// override returns the policy to use for a single call, i.e. the given override if it is set
+func (p pageRetryPolicy) override(o *PageRetries) pageRetryPolicy {
+	if o == nil {
+		return p
+	}
+	interval := o.Interval
+	if interval <= 0 {
+		interval = DefaultPageRetryInterval
+	}
+	return pageRetryPolicy{maxRetries: o.MaxRetries, interval: interval}
+}
+
+// getPage retrieves a page of a paginated listing, retrying it according to the policy if it fails with a retryable error
+func (p pageRetryPolicy) getPage(ctx context.Context, uri string, api APIService) ([]byte, *models.Error) {
+	if p.maxRetries <= 0 {
+		return getAndExpectOK(ctx, uri, api)
+	}
+
+	var body []byte
+	var mErr *models.Error
+	attempts := 0
+	maxInterval := maxPageRetryInterval
+	if p.interval > maxInterval {
+		maxInterval = p.interval
+	}
+	err := retry.Poll(ctx, p.interval, maxInterval, func(ctx context.Context) (bool, error) {
+		body, mErr = getAndExpectOK(ctx, uri, api)
+		if mErr == nil || !isRetryableError(mErr) {
+			return true, nil
+		}
+		if ctx.Err() == nil {
+			retry.ObserveState(ctx, mErr.GetMessage())
+		}
+		attempts++
+		return attempts > p.maxRetries, nil
+	})
+	if err != nil {
+		// the page retries have been cancelled, see retry.CancelledError
+		return nil, requestErrorResponse(err)
+	}
+	return body, mErr
+}
@@ -0,0 +1,246 @@
+package v2
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// newFlakyPagingServer serves two pages of projects and events. The second page fails with the given status
+// for the given number of requests before it succeeds
+func newFlakyPagingServer(failures int, status int) *recordingServer {
+	var mtx sync.Mutex
+	return newRecordingServer(func(w http.ResponseWriter, r *http.Request) {
+		nextPageKey := r.URL.Query().Get("nextPageKey")
+		mtx.Lock()
+		fail := nextPageKey == "1" && failures > 0
+		if fail {
+			failures--
+		}
+		mtx.Unlock()
+
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case fail:
+			w.WriteHeader(status)
+			w.Write([]byte(`{"message":"page unavailable"}`))
+		case nextPageKey == "" && strings.HasSuffix(r.URL.Path, "/event"):
+			w.Write([]byte(`{"events":[{"id":"event-1"}],"nextPageKey":"1"}`))
+		case strings.HasSuffix(r.URL.Path, "/event"):
+			w.Write([]byte(`{"events":[{"id":"event-2"}]}`))
+		case nextPageKey == "":
+			w.Write([]byte(`{"projects":[{"projectName":"project-1"}],"nextPageKey":"1"}`))
+		default:
+			w.Write([]byte(`{"projects":[{"projectName":"project-2"}]}`))
+		}
+	})
+}
+
+func TestWithPageRetries_GetAllProjectsResumesFailedPage(t *testing.T) {
+	server := newFlakyPagingServer(2, http.StatusServiceUnavailable)
+	defer server.Close()
+
+	projectHandler := NewProjectHandler(server.URL)
+	projectHandler.pageRetries = pageRetryPolicy{maxRetries: 2, interval: time.Millisecond}
+
+	projects, err := projectHandler.GetAllProjects(context.TODO(), ProjectsGetAllProjectsOptions{})
+	require.NoError(t, err)
+	require.Len(t, projects, 2)
+	require.Equal(t, "project-2", projects[1].ProjectName)
+	// the first page is not requested again
+	require.Equal(t, []string{"", "1", "1", "1"}, server.nextPageKeys())
+}
+
+func TestWithPageRetries_GetEventsResumesFailedPage(t *testing.T) {
+	server := newFlakyPagingServer(1, http.StatusBadGateway)
+	defer server.Close()
+
+	apiSet, err := New(server.URL, WithPageRetries(1, time.Millisecond))
+	require.NoError(t, err)
+
+	events, mErr := apiSet.Events().GetEvents(context.TODO(), &EventFilter{Project: "my-project"}, EventsGetEventsOptions{})
+	require.Nil(t, mErr)
+	require.Len(t, events, 2)
+	require.Equal(t, []string{"", "1", "1"}, server.nextPageKeys())
+}
+
+func TestWithPageRetries_StopsAfterMaxRetries(t *testing.T) {
+	server := newFlakyPagingServer(3, http.StatusServiceUnavailable)
+	defer server.Close()
+
+	projectHandler := NewProjectHandler(server.URL)
+	projectHandler.pageRetries = pageRetryPolicy{maxRetries: 2, interval: time.Millisecond}
+
+	_, err := projectHandler.GetAllProjects(context.TODO(), ProjectsGetAllProjectsOptions{})
+	require.EqualError(t, err, "page unavailable")
+	require.Equal(t, []string{"", "1", "1", "1"}, server.nextPageKeys())
+}
+
+func TestWithPageRetries_DoesNotRetryNonRetryableErrors(t *testing.T) {
+	server := newFlakyPagingServer(1, http.StatusBadRequest)
+	defer server.Close()
+
+	projectHandler := NewProjectHandler(server.URL)
+	projectHandler.pageRetries = pageRetryPolicy{maxRetries: 2, interval: time.Millisecond}
+
+	_, err := projectHandler.GetAllProjects(context.TODO(), ProjectsGetAllProjectsOptions{})
+	require.EqualError(t, err, "page unavailable")
+	require.Equal(t, []string{"", "1"}, server.nextPageKeys())
+}
+
+func TestWithPageRetries_DisabledByDefault(t *testing.T) {
+	server := newFlakyPagingServer(1, http.StatusServiceUnavailable)
+	defer server.Close()
+
+	apiSet, err := New(server.URL)
+	require.NoError(t, err)
+
+	_, err = apiSet.Projects().GetAllProjects(context.TODO(), ProjectsGetAllProjectsOptions{})
+	require.EqualError(t, err, "page unavailable")
+	require.Equal(t, []string{"", "1"}, server.nextPageKeys())
+}
+
+func TestPageRetries_OverridePerCall(t *testing.T) {
+	server := newFlakyPagingServer(1, http.StatusServiceUnavailable)
+	defer server.Close()
+
+	apiSet, err := New(server.URL)
+	require.NoError(t, err)
+
+	events, mErr := apiSet.Events().GetEvents(context.TODO(), &EventFilter{Project: "my-project"}, EventsGetEventsOptions{
+		PageRetries: &PageRetries{MaxRetries: 1, Interval: time.Millisecond},
+	})
+	require.Nil(t, mErr)
+	require.Len(t, events, 2)
+	require.Equal(t, []string{"", "1", "1"}, server.nextPageKeys())
+}
+
+func TestPageRetries_OverridePerCallOfGetAllProjects(t *testing.T) {
+	server := newFlakyPagingServer(1, http.StatusServiceUnavailable)
+	defer server.Close()
+
+	apiSet, err := New(server.URL)
+	require.NoError(t, err)
+
+	projects, err := apiSet.Projects().GetAllProjects(context.TODO(), ProjectsGetAllProjectsOptions{
+		PageRetries: &PageRetries{MaxRetries: 1, Interval: time.Millisecond},
+	})
+	require.NoError(t, err)
+	require.Len(t, projects, 2)
+	require.Equal(t, []string{"", "1", "1"}, server.nextPageKeys())
+}
+
+// newProjectPagingServer serves two pages of the stages, services and resources of the requested project.
+// The second page of each listing fails once with 503 Service Unavailable
+func newProjectPagingServer() *httptest.Server {
+	var mtx sync.Mutex
+	failed := map[string]bool{}
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// e.g. /v1/project/my-project/stage/dev/service
+		segments := strings.Split(r.URL.Path, "/")
+		project, kind := "", segments[len(segments)-1]
+		for i, segment := range segments[:len(segments)-1] {
+			if segment == "project" {
+				project = segments[i+1]
+			}
+		}
+		page, nextPageKey := 1, `,"nextPageKey":"1"`
+		if r.URL.Query().Get("nextPageKey") != "" {
+			mtx.Lock()
+			fail := !failed[r.URL.Path]
+			failed[r.URL.Path] = true
+			mtx.Unlock()
+			if fail {
+				w.WriteHeader(http.StatusServiceUnavailable)
+				w.Write([]byte(`{"message":"page unavailable"}`))
+				return
+			}
+			page, nextPageKey = 2, ""
+		}
+		w.Header().Set("Content-Type", "application/json")
+		switch kind {
+		case "stage":
+			fmt.Fprintf(w, `{"stages":[{"stageName":"%s-%d"}]%s}`, project, page, nextPageKey)
+		case "service":
+			fmt.Fprintf(w, `{"services":[{"serviceName":"%s-%d"}]%s}`, project, page, nextPageKey)
+		default:
+			fmt.Fprintf(w, `{"resources":[{"resourceURI":"%s-%d"}]%s}`, project, page, nextPageKey)
+		}
+	}))
+}
+
+func TestHandlers_ConcurrentUseWithPerCallPageRetries(t *testing.T) {
+	server := newProjectPagingServer()
+	defer server.Close()
+	stageHandler := NewStageHandler(server.URL)
+	serviceHandler := NewServiceHandler(server.URL)
+	resourceHandler := NewResourceHandler(server.URL)
+
+	listings := map[string]func(project string, pageRetries *PageRetries) ([]string, error){
+		"stages": func(project string, pageRetries *PageRetries) ([]string, error) {
+			stages, err := stageHandler.GetAllStages(context.TODO(), project, StagesGetAllStagesOptions{PageRetries: pageRetries})
+			names := []string{}
+			for _, stage := range stages {
+				names = append(names, stage.StageName)
+			}
+			return names, err
+		},
+		"services": func(project string, pageRetries *PageRetries) ([]string, error) {
+			services, err := serviceHandler.GetAllServices(context.TODO(), project, "dev", ServicesGetAllServicesOptions{PageRetries: pageRetries})
+			names := []string{}
+			for _, service := range services {
+				names = append(names, service.ServiceName)
+			}
+			return names, err
+		},
+		"resources": func(project string, pageRetries *PageRetries) ([]string, error) {
+			resources, err := resourceHandler.GetAllStageResources(context.TODO(), project, "dev", ResourcesGetAllStageResourcesOptions{PageRetries: pageRetries})
+			names := []string{}
+			for _, resource := range resources {
+				names = append(names, *resource.ResourceURI)
+			}
+			return names, err
+		},
+	}
+
+	const goroutines = 100
+	errs := make(chan error, goroutines*len(listings))
+	wg := sync.WaitGroup{}
+	for kind, list := range listings {
+		for i := 0; i < goroutines; i++ {
+			wg.Add(1)
+			go func(kind string, list func(string, *PageRetries) ([]string, error), i int) {
+				defer wg.Done()
+				project := fmt.Sprintf("project-%d", i)
+
+				// every other goroutine does not retry pages, so that its second page fails
+				if i%2 == 1 {
+					if _, err := list(project, nil); err == nil {
+						errs <- fmt.Errorf("%s of %s: expected the second page to fail without page retries", kind, project)
+						return
+					}
+				}
+				names, err := list(project, &PageRetries{MaxRetries: 1, Interval: time.Millisecond})
+				if err != nil {
+					errs <- fmt.Errorf("%s of %s: %w", kind, project, err)
+					return
+				}
+				if len(names) != 2 || names[0] != project+"-1" || names[1] != project+"-2" {
+					errs <- fmt.Errorf("%s of %s: received items of another call: %v", kind, project, names)
+				}
+			}(kind, list, i)
+		}
+	}
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		require.NoError(t, err)
+	}
+}
@@ -0,0 +1,70 @@
+package v2
+
+import (
+	"fmt"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// pathTemplateVariableRegex matches the variables of a path template, e.g. {tenant}
+var pathTemplateVariableRegex = regexp.MustCompile(`\{([^{}/]*)\}`)
+
+// pathTemplate is the path template of an APISet, see WithPathTemplate
+type pathTemplate struct {
+	template  string
+	variables map[string]string
+}
+
+// WithPathTemplate sets the path under which an API gateway exposes the Keptn API, e.g. /tenants/{tenant}/keptn/api.
+// The variables of the template, written in curly braces, are replaced by the path escaped values of the given map.
+// The expanded path is appended to the base URL of the APISet, and the base paths of the Keptn services are appended
+// to it as configured via WithBasePathMode. New returns an error wrapping ErrInvalidConfiguration if the template
+// contains a variable without value
+func WithPathTemplate(template string, variables map[string]string) func(*APISet) {
+	return func(a *APISet) {
+		t := &pathTemplate{template: template, variables: map[string]string{}}
+		for name, value := range variables {
+			t.variables[name] = value
+		}
+		a.pathTemplate = t
+	}
+}
+
+// expand returns the path of the template with all variables replaced by their values
+func (t *pathTemplate) expand() (string, error) {
+	var missing []string
+	path := pathTemplateVariableRegex.ReplaceAllStringFunc(t.template, func(variable string) string {
+		name := variable[1 : len(variable)-1]
+		value, ok := t.variables[name]
+		if !ok || value == "" {
+			missing = append(missing, name)
+			return variable
+		}
+		return url.PathEscape(value)
+	})
+	if len(missing) > 0 {
+		sort.Strings(missing)
+		return "", fmt.Errorf("%w: path template %q has no value for %s", ErrInvalidConfiguration, t.template, strings.Join(missing, ", "))
+	}
+	if strings.ContainsAny(path, "{}?#") {
+		return "", fmt.Errorf("%w: path template %q is malformed", ErrInvalidConfiguration, t.template)
+	}
+	return strings.Trim(path, "/"), nil
+}
+
+// apply appends the expanded path of the template to the given base URL
+func (t *pathTemplate) apply(baseURL string) (string, error) {
+	if t == nil {
+		return baseURL, nil
+	}
+	path, err := t.expand()
+	if err != nil {
+		return "", err
+	}
+	if path == "" {
+		return baseURL, nil
+	}
+	return strings.TrimRight(baseURL, "/") + "/" + path, nil
+}
@@ -0,0 +1,48 @@
+package v2
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestWithPathTemplate(t *testing.T) {
+	apiSet, err := New("http://keptn/", WithPathTemplate("/tenants/{tenant}/keptn/api/", map[string]string{"tenant": "acme corp"}))
+	require.NoError(t, err)
+	require.Equal(t, "keptn/tenants/acme%20corp/keptn/api/mongodb-datastore", apiSet.eventHandler.getBaseURL())
+	require.Equal(t, "keptn/tenants/acme%20corp/keptn/api/controlPlane", apiSet.projectHandler.getBaseURL())
+	require.Equal(t, "keptn/tenants/acme%20corp/keptn/api", apiSet.authHandler.getBaseURL())
+	require.Equal(t, "keptn/tenants/acme%20corp/keptn/api", apiSet.apiHandler.getAPIServicePath())
+	require.Equal(t, "/tenants/{tenant}/keptn/api/", apiSet.Capabilities().PathTemplate)
+
+	apiSet, err = New("http://keptn", WithPathTemplate("{tenant}/{region}", map[string]string{"tenant": "acme", "region": "eu"}), WithExactBaseURL())
+	require.NoError(t, err)
+	require.Equal(t, "keptn/acme/eu", apiSet.resourceHandler.getBaseURL())
+}
+
+func TestWithPathTemplate_InvalidTemplate(t *testing.T) {
+	_, err := New("http://keptn", WithPathTemplate("/tenants/{tenant}/{region}/api", map[string]string{"region": ""}))
+	require.ErrorIs(t, err, ErrInvalidConfiguration)
+	require.Contains(t, err.Error(), "no value for region, tenant")
+
+	_, err = New("http://keptn", WithPathTemplate("/tenants/{tenant/api", map[string]string{"tenant": "acme"}))
+	require.ErrorIs(t, err, ErrInvalidConfiguration)
+}
+
+func TestWithPathTemplate_SendsRequestsToExpandedPath(t *testing.T) {
+	var paths []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		paths = append(paths, r.URL.Path)
+		w.Write([]byte(`{"events":[]}`))
+	}))
+	defer server.Close()
+
+	apiSet, err := New(server.URL, WithPathTemplate("/tenants/{tenant}/keptn/api", map[string]string{"tenant": "acme"}))
+	require.NoError(t, err)
+	_, mErr := apiSet.Events().GetEvents(context.TODO(), &EventFilter{Project: "my-project"}, EventsGetEventsOptions{})
+	require.Nil(t, mErr)
+	require.Equal(t, []string{"/tenants/acme/keptn/api/mongodb-datastore/event"}, paths)
+}
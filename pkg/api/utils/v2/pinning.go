@@ -0,0 +1,83 @@
+package v2
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// ErrCertificateNotPinned is returned when the certificate presented by the server matches none
+// of the fingerprints configured via WithPinnedCertificates or WithSPKIPins
+var ErrCertificateNotPinned = errors.New("server certificate does not match any of the pinned certificates")
+
+// certificatePins holds the SHA-256 hashes a server certificate is validated against
+type certificatePins struct {
+	certificates [][]byte
+	spkis        [][]byte
+}
+
+// newCertificatePins parses the given hex encoded certificate fingerprints (colons are allowed as separators)
+// and base64 encoded SPKI hashes (optionally prefixed with "sha256/")
+func newCertificatePins(certificateFingerprints []string, spkiPins []string) (*certificatePins, error) {
+	pins := &certificatePins{}
+	for _, fingerprint := range certificateFingerprints {
+		hash, err := hex.DecodeString(strings.ReplaceAll(strings.TrimSpace(fingerprint), ":", ""))
+		if err != nil || len(hash) != sha256.Size {
+			return nil, fmt.Errorf("invalid SHA-256 certificate fingerprint %q", fingerprint)
+		}
+		pins.certificates = append(pins.certificates, hash)
+	}
+	for _, pin := range spkiPins {
+		hash, err := base64.StdEncoding.DecodeString(strings.TrimPrefix(strings.TrimSpace(pin), "sha256/"))
+		if err != nil || len(hash) != sha256.Size {
+			return nil, fmt.Errorf("invalid SHA-256 SPKI pin %q", pin)
+		}
+		pins.spkis = append(pins.spkis, hash)
+	}
+	return pins, nil
+}
+
+// chainVerifyPeerCertificate returns a tls.Config.VerifyPeerCertificate calling verify after the given existing one, if any
+func chainVerifyPeerCertificate(existing, verify func([][]byte, [][]*x509.Certificate) error) func([][]byte, [][]*x509.Certificate) error {
+	if existing == nil {
+		return verify
+	}
+	return func(rawCerts [][]byte, verifiedChains [][]*x509.Certificate) error {
+		if err := existing(rawCerts, verifiedChains); err != nil {
+			return err
+		}
+		return verify(rawCerts, verifiedChains)
+	}
+}
+
+// verifyPeerCertificate can be used as tls.Config.VerifyPeerCertificate. It only checks the leaf certificate,
+// since the chain sent by the server is not verified and any other certificate in it could be forged
+func (p *certificatePins) verifyPeerCertificate(rawCerts [][]byte, _ [][]*x509.Certificate) error {
+	if len(rawCerts) == 0 {
+		return ErrCertificateNotPinned
+	}
+	certHash := sha256.Sum256(rawCerts[0])
+	for _, pin := range p.certificates {
+		if bytes.Equal(pin, certHash[:]) {
+			return nil
+		}
+	}
+	if len(p.spkis) > 0 {
+		cert, err := x509.ParseCertificate(rawCerts[0])
+		if err != nil {
+			return fmt.Errorf("could not parse server certificate: %w", err)
+		}
+		spkiHash := sha256.Sum256(cert.RawSubjectPublicKeyInfo)
+		for _, pin := range p.spkis {
+			if bytes.Equal(pin, spkiHash[:]) {
+				return nil
+			}
+		}
+	}
+	return ErrCertificateNotPinned
+}
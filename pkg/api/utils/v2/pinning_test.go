@@ -0,0 +1,145 @@
+package v2
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/keptn/go-utils/pkg/api/models"
+	"github.com/stretchr/testify/require"
+)
+
+func newPinningTestServer() *httptest.Server {
+	return httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{}`))
+	}))
+}
+
+func certificateFingerprint(server *httptest.Server) string {
+	hash := sha256.Sum256(server.Certificate().Raw)
+	return hex.EncodeToString(hash[:])
+}
+
+func spkiPin(server *httptest.Server) string {
+	hash := sha256.Sum256(server.Certificate().RawSubjectPublicKeyInfo)
+	return base64.StdEncoding.EncodeToString(hash[:])
+}
+
+func colonSeparated(fingerprint string) string {
+	parts := []string{}
+	for i := 0; i < len(fingerprint); i += 2 {
+		parts = append(parts, fingerprint[i:i+2])
+	}
+	return strings.ToUpper(strings.Join(parts, ":"))
+}
+
+func TestAPISet_CertificatePinning(t *testing.T) {
+	server := newPinningTestServer()
+	defer server.Close()
+
+	otherFingerprint := strings.Repeat("ab", sha256.Size)
+	otherSPKIPin := base64.StdEncoding.EncodeToString(make([]byte, sha256.Size))
+
+	tests := []struct {
+		name    string
+		options []func(*APISet)
+		wantErr bool
+	}{
+		{
+			name:    "matching certificate fingerprint",
+			options: []func(*APISet){WithPinnedCertificates(otherFingerprint, certificateFingerprint(server))},
+		},
+		{
+			name:    "matching colon separated certificate fingerprint",
+			options: []func(*APISet){WithPinnedCertificates(colonSeparated(certificateFingerprint(server)))},
+		},
+		{
+			name:    "matching SPKI pin",
+			options: []func(*APISet){WithSPKIPins("sha256/" + spkiPin(server))},
+		},
+		{
+			name:    "matching SPKI pin and other certificate fingerprint",
+			options: []func(*APISet){WithPinnedCertificates(otherFingerprint), WithSPKIPins(spkiPin(server))},
+		},
+		{
+			name:    "other certificate fingerprint",
+			options: []func(*APISet){WithPinnedCertificates(otherFingerprint)},
+			wantErr: true,
+		},
+		{
+			name:    "other SPKI pin",
+			options: []func(*APISet){WithSPKIPins(otherSPKIPin)},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			apiSet, err := New(server.URL, tt.options...)
+			require.Nil(t, err)
+
+			_, mErr := apiSet.Projects().GetProject(context.TODO(), models.Project{ProjectName: "my-project"}, ProjectsGetProjectOptions{})
+			if tt.wantErr {
+				require.NotNil(t, mErr)
+				require.Contains(t, mErr.GetMessage(), ErrCertificateNotPinned.Error())
+			} else {
+				require.Nil(t, mErr)
+			}
+		})
+	}
+}
+
+func TestAPISet_CertificatePinningWithCustomHTTPClient(t *testing.T) {
+	server := newPinningTestServer()
+	defer server.Close()
+
+	apiSet, err := New(server.URL, WithHTTPClient(&http.Client{Transport: &http.Transport{}}), WithSPKIPins(spkiPin(server)))
+	require.Nil(t, err)
+	_, mErr := apiSet.Projects().GetProject(context.TODO(), models.Project{ProjectName: "my-project"}, ProjectsGetProjectOptions{})
+	require.Nil(t, mErr)
+
+	_, err = New(server.URL, WithHTTPClient(&http.Client{Transport: roundTripperFunc(http.DefaultTransport.RoundTrip)}), WithSPKIPins(spkiPin(server)))
+	require.NotNil(t, err)
+}
+
+func TestNewCertificatePins_InvalidPins(t *testing.T) {
+	tests := []struct {
+		name         string
+		fingerprints []string
+		spkiPins     []string
+	}{
+		{name: "not hex encoded", fingerprints: []string{strings.Repeat("zz", sha256.Size)}},
+		{name: "SHA-1 fingerprint", fingerprints: []string{strings.Repeat("ab", 20)}},
+		{name: "not base64 encoded", spkiPins: []string{"not base64"}},
+		{name: "short SPKI pin", spkiPins: []string{base64.StdEncoding.EncodeToString([]byte("short"))}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := newCertificatePins(tt.fingerprints, tt.spkiPins)
+			require.NotNil(t, err)
+
+			_, err = New("https://localhost", WithPinnedCertificates(tt.fingerprints...), WithSPKIPins(tt.spkiPins...))
+			require.NotNil(t, err)
+		})
+	}
+}
+
+func TestCertificatePins_verifyPeerCertificate(t *testing.T) {
+	pins, err := newCertificatePins([]string{strings.Repeat("ab", sha256.Size)}, nil)
+	require.Nil(t, err)
+
+	require.Equal(t, ErrCertificateNotPinned, pins.verifyPeerCertificate(nil, nil))
+
+	pinnedCert := []byte(fmt.Sprintf("%x", "certificate"))
+	hash := sha256.Sum256(pinnedCert)
+	pins, err = newCertificatePins([]string{hex.EncodeToString(hash[:])}, nil)
+	require.Nil(t, err)
+	require.Nil(t, pins.verifyPeerCertificate([][]byte{pinnedCert}, nil))
+	// only the leaf certificate is checked
+	require.Equal(t, ErrCertificateNotPinned, pins.verifyPeerCertificate([][]byte{[]byte("leaf"), pinnedCert}, nil))
+}
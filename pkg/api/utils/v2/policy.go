@@ -0,0 +1,79 @@
+package v2
+
+import (
+	"io"
+	"net/http"
+
+	"github.com/keptn/go-utils/pkg/common/policy"
+)
+
+// PolicyAnnotationHeaderPrefix is the prefix of the headers carrying the annotations a policy.Decider attached to a request
+const PolicyAnnotationHeaderPrefix = "X-Keptn-Annotation-"
+
+// WithPolicyDecider configures a policy.Decider which is consulted before each POST, PUT, PATCH and DELETE request.
+// Denied requests are not sent and fail with an error containing the reason of the denial.
+// Annotations are sent along with the request as headers prefixed with X-Keptn-Annotation-
+func WithPolicyDecider(decider policy.Decider) func(*APISet) {
+	return func(a *APISet) {
+		a.policyDecider = decider
+	}
+}
+
+// policyTransport is a http.RoundTripper which only sends mutating requests that are allowed by its policy.Decider
+type policyTransport struct {
+	base    http.RoundTripper
+	decider policy.Decider
+}
+
+func newPolicyTransport(base http.RoundTripper, decider policy.Decider) *policyTransport {
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	return &policyTransport{base: base, decider: decider}
+}
+
+func (t *policyTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if !isMutatingMethod(req.Method) {
+		return t.base.RoundTrip(req)
+	}
+	annotations, err := policy.Check(req.Context(), t.decider, newPolicyInput(req))
+	if err != nil {
+		if req.Body != nil {
+			req.Body.Close()
+		}
+		return nil, err
+	}
+	if len(annotations) > 0 {
+		req = req.Clone(req.Context())
+		for key, value := range annotations {
+			req.Header.Set(PolicyAnnotationHeaderPrefix+key, value)
+		}
+	}
+	return t.base.RoundTrip(req)
+}
+
+func newPolicyInput(req *http.Request) policy.Input {
+	target := parseOperationTarget(req)
+	input := policy.Input{
+		Operation: policy.OperationUpdate,
+		Target: policy.Target{
+			Project:  target.Project,
+			Stage:    target.Stage,
+			Service:  target.Service,
+			Resource: target.Resource,
+		},
+	}
+	switch req.Method {
+	case http.MethodPost:
+		input.Operation = policy.OperationCreate
+	case http.MethodDelete:
+		input.Operation = policy.OperationDelete
+	}
+	if req.GetBody != nil {
+		if body, err := req.GetBody(); err == nil {
+			input.Payload, _ = io.ReadAll(body)
+			body.Close()
+		}
+	}
+	return input
+}
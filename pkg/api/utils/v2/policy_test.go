@@ -0,0 +1,53 @@
+package v2
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/keptn/go-utils/pkg/common/policy"
+	"github.com/stretchr/testify/require"
+)
+
+type policyDeciderMock struct {
+	inputs []policy.Input
+	result policy.Result
+}
+
+func (p *policyDeciderMock) Decide(ctx context.Context, input policy.Input) (policy.Result, error) {
+	p.inputs = append(p.inputs, input)
+	return p.result, nil
+}
+
+func TestAPISet_WithPolicyDecider(t *testing.T) {
+	var requests []*http.Request
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r)
+		w.Write([]byte(`{"keptnContext":"my-context"}`))
+	}))
+	defer server.Close()
+
+	decider := &policyDeciderMock{result: policy.Result{Decision: policy.Deny, Reason: "no prod deletes"}}
+	apiSet, err := New(server.URL, WithPolicyDecider(decider))
+	require.Nil(t, err)
+
+	_, mErr := apiSet.Services().DeleteServiceFromStage(context.TODO(), "sockshop", "prod", "carts", ServicesDeleteServiceFromStageOptions{})
+	require.NotNil(t, mErr)
+	require.Contains(t, mErr.GetMessage(), "delete operation has been denied by policy: no prod deletes")
+	require.Empty(t, requests)
+	require.Equal(t, policy.Input{Operation: policy.OperationDelete, Target: policy.Target{Project: "sockshop", Stage: "prod", Service: "carts"}}, decider.inputs[0])
+
+	decider.result = policy.Result{Decision: policy.Annotate, Annotations: map[string]string{"Audited-By": "guardrails"}}
+	_, mErr = apiSet.Services().CreateServiceInStage(context.TODO(), "sockshop", "dev", "carts", ServicesCreateServiceInStageOptions{})
+	require.Nil(t, mErr)
+	require.Len(t, requests, 1)
+	require.Equal(t, "guardrails", requests[0].Header.Get(PolicyAnnotationHeaderPrefix+"Audited-By"))
+	require.Equal(t, policy.OperationCreate, decider.inputs[1].Operation)
+	require.Contains(t, string(decider.inputs[1].Payload), `"serviceName":"carts"`)
+
+	// reads are not checked
+	_, mErr = apiSet.API().GetMetadata(context.TODO(), APIGetMetadataOptions{})
+	require.Nil(t, mErr)
+	require.Len(t, decider.inputs, 2)
+}
@@ -2,10 +2,8 @@ package v2
 
 import (
 	"context"
-	"crypto/tls"
 	"net/http"
 	"net/url"
-	"strings"
 
 	"github.com/keptn/go-utils/pkg/common/httputils"
 
@@ -24,7 +22,17 @@ type ProjectsDeleteProjectOptions struct{}
 type ProjectsGetProjectOptions struct{}
 
 // ProjectsGetAllProjectsOptions are options for ProjectsInterface.GetAllProjects().
-type ProjectsGetAllProjectsOptions struct{}
+type ProjectsGetAllProjectsOptions struct {
+	// OnProgress is called after each fetched page
+	OnProgress PageProgressFunc
+	// MemoryBudget overrides the memory budget of the handler for this call, see WithMemoryBudget.
+	// A negative budget disables the limit
+	MemoryBudget int64
+	// Cursor continues the listing at the given page, e.g. at the cursor of a *BudgetExceededError
+	Cursor models.Cursor
+	// PageRetries overrides the page retries of the handler for this call
+	PageRetries *PageRetries
+}
 
 // ProjectsUpdateConfigurationServiceProjectOptions are options for ProjectsInterface.UpdateConfigurationServiceProject().
 type ProjectsUpdateConfigurationServiceProjectOptions struct{}
@@ -46,13 +54,19 @@ type ProjectsInterface interface {
 	UpdateConfigurationServiceProject(ctx context.Context, project models.Project, opts ProjectsUpdateConfigurationServiceProjectOptions) (*models.EventContext, *models.Error)
 }
 
-// ProjectHandler handles projects
+// ProjectHandler handles projects.
+// It is safe for concurrent use by multiple goroutines. Settings which differ between calls are passed via the options
+// of each method, e.g. ProjectsGetAllProjectsOptions.PageRetries and ProjectsGetAllProjectsOptions.MemoryBudget
 type ProjectHandler struct {
-	baseURL    string
-	authToken  string
-	authHeader string
-	httpClient *http.Client
-	scheme     string
+	baseURL      string
+	authToken    string
+	authHeader   string
+	httpClient   *http.Client
+	scheme       string
+	idempotency  idempotencyOptions
+	pageRetries  pageRetryPolicy
+	memoryBudget int64
+	errorDetailsSetting
 }
 
 // NewProjectHandler returns a new ProjectHandler which sends all requests directly to the configuration-service
@@ -65,14 +79,11 @@ func NewProjectHandlerWithHTTPClient(baseURL string, httpClient *http.Client) *P
 	return createProjectHandler(baseURL, "", "", httpClient, "http")
 }
 
-// NewAuthenticatedProjectHandler returns a new ProjectHandler that authenticates at the api via the provided token
+// NewAuthenticatedProjectHandler returns a new ProjectHandler that authenticates at the api via the provided token.
+// The base path of the service is added to the base URL according to basePathMode (default AppendMissingBasePath)
 // and sends all requests directly to the configuration-service
-func NewAuthenticatedProjectHandler(baseURL string, authToken string, authHeader string, httpClient *http.Client, scheme string) *ProjectHandler {
-	baseURL = strings.TrimRight(baseURL, "/")
-	if !strings.HasSuffix(baseURL, shipyardControllerBaseURL) {
-		baseURL += "/" + shipyardControllerBaseURL
-	}
-
+func NewAuthenticatedProjectHandler(baseURL string, authToken string, authHeader string, httpClient *http.Client, scheme string, basePathMode ...BasePathMode) *ProjectHandler {
+	baseURL = basePathModeOf(basePathMode).HandlerBaseURL(baseURL, HandlerProjects)
 	return createProjectHandler(baseURL, authToken, authHeader, httpClient, scheme)
 }
 
@@ -108,17 +119,23 @@ func (p *ProjectHandler) CreateProject(ctx context.Context, project models.Proje
 	if err != nil {
 		return nil, buildErrorResponse(err.Error())
 	}
-	return postWithEventContext(ctx, p.scheme+"://"+p.getBaseURL()+v1ProjectPath, bodyStr, p)
+	return p.idempotency.created(postWithEventContext(ctx, p.scheme+"://"+p.getBaseURL()+v1ProjectPath, bodyStr, p))
 }
 
 // DeleteProject deletes a project.
 func (p *ProjectHandler) DeleteProject(ctx context.Context, project models.Project, opts ProjectsDeleteProjectOptions) (*models.EventContext, *models.Error) {
-	return deleteWithEventContext(ctx, p.scheme+"://"+p.getBaseURL()+v1ProjectPath+"/"+project.ProjectName, p)
+	if mErr := checkIdentifiers("project", project.ProjectName); mErr != nil {
+		return nil, mErr
+	}
+	return p.idempotency.deleted(deleteWithEventContext(ctx, p.scheme+"://"+p.getBaseURL()+v1ProjectPath+"/"+EscapeIdentifier(project.ProjectName), p))
 }
 
 // GetProject returns a project.
 func (p *ProjectHandler) GetProject(ctx context.Context, project models.Project, opts ProjectsGetProjectOptions) (*models.Project, *models.Error) {
-	body, mErr := getAndExpectSuccess(ctx, p.scheme+"://"+p.getBaseURL()+v1ProjectPath+"/"+project.ProjectName, p)
+	if mErr := checkIdentifiers("project", project.ProjectName); mErr != nil {
+		return nil, mErr
+	}
+	body, mErr := getAndExpectSuccess(ctx, p.scheme+"://"+p.getBaseURL()+v1ProjectPath+"/"+EscapeIdentifier(project.ProjectName), p)
 	if mErr != nil {
 		return nil, mErr
 	}
@@ -132,24 +149,31 @@ func (p *ProjectHandler) GetProject(ctx context.Context, project models.Project,
 }
 
 // GetAllProjects returns all projects.
+// If the memory budget is exceeded, the projects retrieved so far are returned along with a *BudgetExceededError
 func (p *ProjectHandler) GetAllProjects(ctx context.Context, opts ProjectsGetAllProjectsOptions) ([]*models.Project, error) {
-	http.DefaultTransport.(*http.Transport).TLSClientConfig = &tls.Config{InsecureSkipVerify: true}
+	skipDefaultTransportVerification()
 	projects := []*models.Project{}
 
-	nextPageKey := ""
+	budget := newMemoryBudget(p.memoryBudget, opts.MemoryBudget)
+	pageRetries := p.pageRetries.override(opts.PageRetries)
+	cursor := opts.Cursor
+	pages := 0
 
 	for {
+		if !budget.admitsNextPage() {
+			return projects, budget.exceeded(cursor)
+		}
 		url, err := url.Parse(p.scheme + "://" + p.getBaseURL() + v1ProjectPath)
 		if err != nil {
 			return nil, err
 		}
 		q := url.Query()
-		if nextPageKey != "" {
+		if nextPageKey := cursor.Encode(); nextPageKey != "" {
 			q.Set("nextPageKey", nextPageKey)
 			url.RawQuery = q.Encode()
 		}
 
-		body, mErr := getAndExpectOK(ctx, url.String(), p)
+		body, mErr := pageRetries.getPage(ctx, url.String(), p)
 		if mErr != nil {
 			return nil, mErr.ToError()
 		}
@@ -158,12 +182,19 @@ func (p *ProjectHandler) GetAllProjects(ctx context.Context, opts ProjectsGetAll
 		if err = received.FromJSON(body); err != nil {
 			return nil, err
 		}
+		if !budget.add(len(received.Projects), len(body)) {
+			return projects, budget.exceeded(cursor)
+		}
 		projects = append(projects, received.Projects...)
 
-		if received.NextPageKey == "" || received.NextPageKey == "0" {
+		if cursor, err = received.Cursor(); err != nil {
+			return nil, err
+		}
+		pages++
+		opts.OnProgress.report(PageProgress{Pages: pages, Items: len(projects), TotalCount: int(received.TotalCount), Cursor: cursor})
+		if cursor.IsEnd() {
 			break
 		}
-		nextPageKey = received.NextPageKey
 	}
 
 	return projects, nil
@@ -175,5 +206,8 @@ func (p *ProjectHandler) UpdateConfigurationServiceProject(ctx context.Context,
 	if err != nil {
 		return nil, buildErrorResponse(err.Error())
 	}
-	return putWithEventContext(ctx, p.scheme+"://"+p.getBaseURL()+v1ProjectPath+"/"+project.ProjectName, bodyStr, p)
+	if mErr := checkIdentifiers("project", project.ProjectName); mErr != nil {
+		return nil, mErr
+	}
+	return putWithEventContext(ctx, p.scheme+"://"+p.getBaseURL()+v1ProjectPath+"/"+EscapeIdentifier(project.ProjectName), bodyStr, p)
 }
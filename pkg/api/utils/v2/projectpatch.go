@@ -0,0 +1,161 @@
+package v2
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"strings"
+
+	"github.com/keptn/go-utils/pkg/api/models"
+)
+
+// MergePatchContentType is the content type of JSON merge patches as defined in RFC 7386
+const MergePatchContentType = "application/merge-patch+json"
+
+// ProjectsPatchProjectOptions are options for ProjectHandler.PatchProject().
+type ProjectsPatchProjectOptions struct {
+	// FallbackToUpdate patches the project by retrieving and updating it via UpdateConfigurationServiceProject
+	// if the Keptn API does not support patching projects. Unlike a patch, this may overwrite changes made by others
+	// in the meantime, so it has to be enabled explicitly
+	FallbackToUpdate bool
+}
+
+// ProjectPatch builds a JSON merge patch of a project for ProjectHandler.PatchProject. Fields which are not set are left unchanged
+type ProjectPatch struct {
+	fields map[string]interface{}
+}
+
+// NewProjectPatch returns an empty ProjectPatch
+func NewProjectPatch() *ProjectPatch {
+	return &ProjectPatch{fields: map[string]interface{}{}}
+}
+
+// SetShipyardVersion sets the shipyard version of the project
+func (p *ProjectPatch) SetShipyardVersion(version string) *ProjectPatch {
+	p.fields["shipyardVersion"] = version
+	return p
+}
+
+// SetUpstreamCredentials replaces the credentials of the git upstream of the project. Unlike a plain merge of the credentials,
+// fields which are not set in the given credentials are removed, e.g. the ssh credentials when switching to https
+func (p *ProjectPatch) SetUpstreamCredentials(credentials models.GitAuthCredentials) *ProjectPatch {
+	p.fields["gitCredentials"] = replacementPatch(reflect.ValueOf(credentials))
+	return p
+}
+
+// RemoveUpstreamCredentials removes the git upstream of the project
+func (p *ProjectPatch) RemoveUpstreamCredentials() *ProjectPatch {
+	p.fields["gitCredentials"] = nil
+	return p
+}
+
+// ToJSON returns the JSON merge patch
+func (p *ProjectPatch) ToJSON() ([]byte, error) {
+	return json.Marshal(p.fields)
+}
+
+// PatchProject changes the fields of the project contained in the given JSON merge patch (RFC 7386), e.g. built via ProjectPatch,
+// so that automation can change a single field without sending the whole project.
+// If the Keptn API does not support patching projects, it responds with 405 Method Not Allowed, which is returned
+// unless ProjectsPatchProjectOptions.FallbackToUpdate is set. In that case, the project is retrieved, patched and updated
+// via UpdateConfigurationServiceProject instead, which may overwrite changes to the project made by others in the meantime
+func (p *ProjectHandler) PatchProject(ctx context.Context, projectName string, patch []byte, opts ProjectsPatchProjectOptions) (*models.EventContext, *models.Error) {
+	if mErr := checkIdentifiers("project", projectName); mErr != nil {
+		return nil, mErr
+	}
+	fields := map[string]interface{}{}
+	if err := json.Unmarshal(patch, &fields); err != nil {
+		return nil, invalidProjectError(fmt.Errorf("invalid merge patch of project %s: %w", projectName, err))
+	}
+	if name, ok := fields["projectName"]; ok && name != projectName {
+		return nil, invalidProjectError(fmt.Errorf("the name of project %s cannot be changed", projectName))
+	}
+
+	eventContext, mErr := writeWithEventContext(ctx, http.MethodPatch, p.scheme+"://"+p.getBaseURL()+v1ProjectPath+"/"+EscapeIdentifier(projectName), MergePatchContentType, patch, p)
+	if mErr == nil || mErr.Code != http.StatusMethodNotAllowed || !opts.FallbackToUpdate {
+		return eventContext, mErr
+	}
+
+	project, mErr := p.GetProject(ctx, models.Project{ProjectName: projectName}, ProjectsGetProjectOptions{})
+	if mErr != nil {
+		return nil, mErr
+	}
+	patched, err := applyMergePatch(project, fields)
+	if err != nil {
+		return nil, buildErrorResponse(fmt.Sprintf("could not patch project %s: %s", projectName, err.Error()))
+	}
+	return p.UpdateConfigurationServiceProject(ctx, *patched, ProjectsUpdateConfigurationServiceProjectOptions{})
+}
+
+// replacementPatch returns a merge patch replacing an object with the given struct. Empty fields which are omitted
+// from the JSON representation of the struct are set to null, so that they are removed from the object
+func replacementPatch(v reflect.Value) interface{} {
+	if v.Kind() == reflect.Ptr {
+		if v.IsNil() {
+			return nil
+		}
+		v = v.Elem()
+	}
+	if v.Kind() != reflect.Struct {
+		return v.Interface()
+	}
+	patch := map[string]interface{}{}
+	for i := 0; i < v.NumField(); i++ {
+		tag := strings.Split(v.Type().Field(i).Tag.Get("json"), ",")
+		if tag[0] == "-" || tag[0] == "" {
+			continue
+		}
+		if len(tag) > 1 && tag[1] == "omitempty" && v.Field(i).IsZero() {
+			patch[tag[0]] = nil
+			continue
+		}
+		patch[tag[0]] = replacementPatch(v.Field(i))
+	}
+	return patch
+}
+
+// applyMergePatch returns a copy of the project with the merge patch applied
+func applyMergePatch(project *models.Project, patch map[string]interface{}) (*models.Project, error) {
+	data, err := json.Marshal(project)
+	if err != nil {
+		return nil, err
+	}
+	var target interface{}
+	if err := json.Unmarshal(data, &target); err != nil {
+		return nil, err
+	}
+	if data, err = json.Marshal(mergePatch(target, patch)); err != nil {
+		return nil, err
+	}
+	patched := &models.Project{}
+	if err := json.Unmarshal(data, patched); err != nil {
+		return nil, err
+	}
+	return patched, nil
+}
+
+// mergePatch applies a JSON merge patch to the target as described in RFC 7386
+func mergePatch(target interface{}, patch interface{}) interface{} {
+	patchObject, ok := patch.(map[string]interface{})
+	if !ok {
+		return patch
+	}
+	targetObject, ok := target.(map[string]interface{})
+	if !ok {
+		targetObject = map[string]interface{}{}
+	}
+	merged := make(map[string]interface{}, len(targetObject))
+	for key, value := range targetObject {
+		if _, patched := patchObject[key]; !patched {
+			merged[key] = value
+		}
+	}
+	for key, value := range patchObject {
+		if value != nil {
+			merged[key] = mergePatch(targetObject[key], value)
+		}
+	}
+	return merged
+}
@@ -0,0 +1,115 @@
+package v2
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/keptn/go-utils/pkg/api/models"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProjectHandler_PatchProject(t *testing.T) {
+	var method, contentType, body string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		data, _ := ioutil.ReadAll(r.Body)
+		method, contentType, body = r.Method, r.Header.Get("Content-Type"), string(data)
+		require.Equal(t, "/v1/project/sockshop", r.URL.Path)
+		w.Write([]byte(`{}`))
+	}))
+	defer server.Close()
+
+	patch, err := NewProjectPatch().SetShipyardVersion("spec.keptn.sh/0.2.3").ToJSON()
+	require.Nil(t, err)
+	_, mErr := NewProjectHandler(server.URL).PatchProject(context.TODO(), "sockshop", patch, ProjectsPatchProjectOptions{})
+	require.Nil(t, mErr)
+	require.Equal(t, http.MethodPatch, method)
+	require.Equal(t, MergePatchContentType, contentType)
+	require.JSONEq(t, `{"shipyardVersion":"spec.keptn.sh/0.2.3"}`, body)
+}
+
+func TestProjectHandler_PatchProjectFallsBackToUpdate(t *testing.T) {
+	var updated string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodPatch:
+			w.WriteHeader(http.StatusMethodNotAllowed)
+			w.Write([]byte(`{"code":405,"message":"method not allowed"}`))
+		case http.MethodGet:
+			w.Write([]byte(`{"projectName":"sockshop","shipyardVersion":"spec.keptn.sh/0.2.3","stages":[{"stageName":"dev"}],
+				"gitCredentials":{"remoteURL":"git@github.com:keptn/sockshop.git","user":"keptn","ssh":{"privateKey":"key"}}}`))
+		case http.MethodPut:
+			data, _ := ioutil.ReadAll(r.Body)
+			updated = string(data)
+			w.Write([]byte(`{}`))
+		}
+	}))
+	defer server.Close()
+
+	patch, err := NewProjectPatch().SetUpstreamCredentials(models.GitAuthCredentials{
+		RemoteURL: "https://github.com/keptn/sockshop.git",
+		HttpsAuth: &models.HttpsGitAuth{Token: "token"},
+	}).ToJSON()
+	require.Nil(t, err)
+	require.JSONEq(t, `{"gitCredentials":{"remoteURL":"https://github.com/keptn/sockshop.git","user":null,"ssh":null,
+		"https":{"token":"token","certificate":null,"insecureSkipTLS":false,"proxy":null}}}`, string(patch))
+
+	_, mErr := NewProjectHandler(server.URL).PatchProject(context.TODO(), "sockshop", patch, ProjectsPatchProjectOptions{})
+	require.NotNil(t, mErr)
+	require.Equal(t, int64(http.StatusMethodNotAllowed), mErr.Code)
+	require.Empty(t, updated)
+
+	_, mErr = NewProjectHandler(server.URL).PatchProject(context.TODO(), "sockshop", patch, ProjectsPatchProjectOptions{FallbackToUpdate: true})
+	require.Nil(t, mErr)
+
+	project := models.Project{}
+	require.Nil(t, json.Unmarshal([]byte(updated), &project))
+	require.Equal(t, "sockshop", project.ProjectName)
+	require.Equal(t, "spec.keptn.sh/0.2.3", project.ShipyardVersion)
+	require.Len(t, project.Stages, 1)
+	require.Equal(t, &models.GitAuthCredentials{RemoteURL: "https://github.com/keptn/sockshop.git", HttpsAuth: &models.HttpsGitAuth{Token: "token"}}, project.GitCredentials)
+}
+
+func TestProjectHandler_PatchProjectInvalidPatch(t *testing.T) {
+	handler := NewProjectHandler("http://localhost")
+
+	_, mErr := handler.PatchProject(context.TODO(), "sockshop", []byte(`[]`), ProjectsPatchProjectOptions{})
+	require.NotNil(t, mErr)
+	require.Equal(t, int64(http.StatusBadRequest), mErr.Code)
+
+	_, mErr = handler.PatchProject(context.TODO(), "sockshop", []byte(`{"projectName":"other"}`), ProjectsPatchProjectOptions{})
+	require.NotNil(t, mErr)
+	require.Equal(t, int64(http.StatusBadRequest), mErr.Code)
+}
+
+func TestMergePatch(t *testing.T) {
+	// examples of RFC 7386
+	tests := []struct {
+		target string
+		patch  string
+		result string
+	}{
+		{target: `{"a":"b"}`, patch: `{"a":"c"}`, result: `{"a":"c"}`},
+		{target: `{"a":"b"}`, patch: `{"b":"c"}`, result: `{"a":"b","b":"c"}`},
+		{target: `{"a":"b"}`, patch: `{"a":null}`, result: `{}`},
+		{target: `{"a":"b","b":"c"}`, patch: `{"a":null}`, result: `{"b":"c"}`},
+		{target: `{"a":["b"]}`, patch: `{"a":"c"}`, result: `{"a":"c"}`},
+		{target: `{"a":{"b":"c"}}`, patch: `{"a":{"b":"d","c":null}}`, result: `{"a":{"b":"d"}}`},
+		{target: `{"a":[{"b":"c"}]}`, patch: `{"a":[1]}`, result: `{"a":[1]}`},
+		{target: `{"e":null}`, patch: `{"a":1}`, result: `{"e":null,"a":1}`},
+		{target: `{}`, patch: `{"a":{"bb":{"ccc":null}}}`, result: `{"a":{"bb":{}}}`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.patch, func(t *testing.T) {
+			var target, patch interface{}
+			require.Nil(t, json.Unmarshal([]byte(tt.target), &target))
+			require.Nil(t, json.Unmarshal([]byte(tt.patch), &patch))
+			result, err := json.Marshal(mergePatch(target, patch))
+			require.Nil(t, err)
+			require.JSONEq(t, tt.result, string(result))
+		})
+	}
+}
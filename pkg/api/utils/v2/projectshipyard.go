@@ -0,0 +1,76 @@
+package v2
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"regexp"
+	"strings"
+
+	"github.com/keptn/go-utils/pkg/api/models"
+	"github.com/keptn/go-utils/pkg/lib/v0_2_0/shipyard"
+)
+
+// projectNamePattern is the pattern of valid project names, matching the rules of the Keptn API for entity names
+var projectNamePattern = regexp.MustCompile(`^[a-z][a-z0-9-]*[a-z0-9]$|^[a-z]$`)
+
+// APICreateProjectWithShipyardOptions are options for APIHandler.CreateProjectWithShipyard() and APIHandler.CreateProjectWithShipyardFile().
+type APICreateProjectWithShipyardOptions struct {
+	// GitCredentials are the credentials of the upstream repository of the project. They are optional
+	GitCredentials *models.GitAuthCredentials
+}
+
+// CreateProjectWithShipyard creates a new project using the given shipyard. The shipyard may be passed as YAML or
+// already base64 encoded. It is validated before it is sent, so that an invalid project name or shipyard fails with
+// a *models.Error with code 400 listing all problems, without sending a request to the Keptn API
+func (a *APIHandler) CreateProjectWithShipyard(ctx context.Context, projectName string, shipyardContent []byte, opts APICreateProjectWithShipyardOptions) (string, *models.Error) {
+	encoded, err := encodeShipyard(projectName, shipyardContent)
+	if err != nil {
+		return "", invalidProjectError(err)
+	}
+	return a.CreateProject(ctx, models.CreateProject{
+		Name:           &projectName,
+		Shipyard:       &encoded,
+		GitCredentials: opts.GitCredentials,
+	}, APICreateProjectOptions{})
+}
+
+// CreateProjectWithShipyardFile creates a new project using the shipyard stored in the file at the given path,
+// see CreateProjectWithShipyard
+func (a *APIHandler) CreateProjectWithShipyardFile(ctx context.Context, projectName string, path string, opts APICreateProjectWithShipyardOptions) (string, *models.Error) {
+	content, err := ioutil.ReadFile(path)
+	if err != nil {
+		return "", invalidProjectError(fmt.Errorf("could not read shipyard: %w", err))
+	}
+	return a.CreateProjectWithShipyard(ctx, projectName, content, opts)
+}
+
+// encodeShipyard validates the project name and shipyard and returns the base64 encoded shipyard
+func encodeShipyard(projectName string, content []byte) (string, error) {
+	if !projectNamePattern.MatchString(projectName) {
+		return "", fmt.Errorf("project name %q must start with a lower case letter and contain only lower case letters, digits and hyphens", projectName)
+	}
+	if len(strings.TrimSpace(string(content))) == 0 {
+		return "", fmt.Errorf("shipyard is empty")
+	}
+	// a shipyard which has already been encoded is accepted as well, instead of encoding it twice
+	if decoded, err := base64.StdEncoding.DecodeString(strings.TrimSpace(string(content))); err == nil {
+		content = decoded
+	}
+	s, err := shipyard.Decode(content)
+	if err != nil {
+		return "", fmt.Errorf("shipyard is not valid YAML: %w", err)
+	}
+	if err := shipyard.Validate(s); err != nil {
+		return "", err
+	}
+	return base64.StdEncoding.EncodeToString(content), nil
+}
+
+func invalidProjectError(err error) *models.Error {
+	mErr := buildErrorResponse(err.Error())
+	mErr.Code = http.StatusBadRequest
+	return mErr
+}
@@ -0,0 +1,101 @@
+package v2
+
+import (
+	"context"
+	"encoding/base64"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/keptn/go-utils/pkg/api/models"
+	"github.com/stretchr/testify/require"
+)
+
+const testShipyard = `apiVersion: spec.keptn.sh/0.2.3
+kind: Shipyard
+metadata:
+  name: shipyard
+spec:
+  stages:
+    - name: dev
+      sequences:
+        - name: delivery
+          tasks:
+            - name: deployment
+`
+
+func newCreateProjectServer() *recordingServer {
+	return newRecordingServer(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{}`))
+	})
+}
+
+// receivedProjects decodes the projects sent to the server
+func receivedProjects(t *testing.T, server *recordingServer) []models.CreateProject {
+	projects := []models.CreateProject{}
+	for _, r := range server.received() {
+		project := models.CreateProject{}
+		require.Nil(t, project.FromJSON(r.Body))
+		projects = append(projects, project)
+	}
+	return projects
+}
+
+func TestAPIHandler_CreateProjectWithShipyard(t *testing.T) {
+	server := newCreateProjectServer()
+	defer server.Close()
+	apiHandler := NewAuthenticatedAPIHandler(server.URL, "", "", &http.Client{}, "http")
+	encoded := base64.StdEncoding.EncodeToString([]byte(testShipyard))
+
+	_, mErr := apiHandler.CreateProjectWithShipyard(context.TODO(), "my-project", []byte(testShipyard), APICreateProjectWithShipyardOptions{})
+	require.Nil(t, mErr)
+	// an already encoded shipyard is not encoded twice
+	_, mErr = apiHandler.CreateProjectWithShipyard(context.TODO(), "my-project", []byte(encoded), APICreateProjectWithShipyardOptions{})
+	require.Nil(t, mErr)
+
+	path := filepath.Join(t.TempDir(), "shipyard.yaml")
+	require.Nil(t, os.WriteFile(path, []byte(testShipyard), 0600))
+	_, mErr = apiHandler.CreateProjectWithShipyardFile(context.TODO(), "my-project", path, APICreateProjectWithShipyardOptions{
+		GitCredentials: &models.GitAuthCredentials{RemoteURL: "https://git.example.com/my-project"},
+	})
+	require.Nil(t, mErr)
+
+	received := receivedProjects(t, server)
+	require.Len(t, received, 3)
+	for _, project := range received {
+		require.Equal(t, "my-project", *project.Name)
+		require.Equal(t, encoded, *project.Shipyard)
+	}
+	require.Equal(t, "https://git.example.com/my-project", received[2].GitCredentials.RemoteURL)
+}
+
+func TestAPIHandler_CreateProjectWithShipyardFailsClientSide(t *testing.T) {
+	server := newCreateProjectServer()
+	defer server.Close()
+	apiHandler := NewAuthenticatedAPIHandler(server.URL, "", "", &http.Client{}, "http")
+
+	tests := []struct {
+		name        string
+		projectName string
+		shipyard    string
+		wantMessage string
+	}{
+		{name: "invalid project name", projectName: "My_Project", shipyard: testShipyard, wantMessage: `project name "My_Project" must start with a lower case letter and contain only lower case letters, digits and hyphens`},
+		{name: "empty shipyard", projectName: "my-project", shipyard: " \n", wantMessage: "shipyard is empty"},
+		{name: "invalid YAML", projectName: "my-project", shipyard: "spec: [", wantMessage: "shipyard is not valid YAML: yaml: line 1: did not find expected node content"},
+		{name: "invalid shipyard", projectName: "my-project", shipyard: "apiVersion: spec.keptn.sh/0.2.3\nkind: Shipyard\n", wantMessage: "invalid shipyard: spec.stages: at least one stage is required"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, mErr := apiHandler.CreateProjectWithShipyard(context.TODO(), tt.projectName, []byte(tt.shipyard), APICreateProjectWithShipyardOptions{})
+			require.NotNil(t, mErr)
+			require.Equal(t, int64(http.StatusBadRequest), mErr.Code)
+			require.Equal(t, tt.wantMessage, mErr.GetMessage())
+		})
+	}
+
+	_, mErr := apiHandler.CreateProjectWithShipyardFile(context.TODO(), "my-project", filepath.Join(t.TempDir(), "missing.yaml"), APICreateProjectWithShipyardOptions{})
+	require.NotNil(t, mErr)
+	require.Empty(t, server.received())
+}
@@ -0,0 +1,231 @@
+package v2
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/keptn/go-utils/pkg/api/models"
+	"github.com/keptn/go-utils/pkg/lib/v0_2_0/shipyard"
+)
+
+const (
+	shipyardResourceURI          = "shipyard.yaml"
+	deploymentTriggeredEventType = "sh.keptn.event.deployment.triggered"
+)
+
+// ErrNoNextStage is returned by APISet.PromoteToNextStage if the shipyard has no stage to promote to
+var ErrNoNextStage = errors.New("no next stage")
+
+// ErrNotPromotable is returned by APISet.PromoteToNextStage if the finished event failed and
+// no sequence of the shipyard is triggered by its failure
+var ErrNotPromotable = errors.New("event cannot be promoted")
+
+// PromotionPromoteToNextStageOptions are options for APISet.PromoteToNextStage().
+type PromotionPromoteToNextStageOptions struct {
+	// Sequence is the sequence triggered in the next stage. It is required if the finished event is a task event,
+	// e.g. release.finished. For a sequence event, e.g. sh.keptn.event.dev.delivery.finished, the sequence of the event is used by default
+	Sequence string
+	// Stage is the stage the event is promoted to. If it is empty, the next stage is determined from the shipyard
+	Stage string
+	// Labels are added to the labels of the finished event
+	Labels map[string]string
+	// Source is the source of the triggered event. If it is empty, the source configured via WithEventSource is used
+	Source string
+}
+
+// PromoteToNextStage sends the triggered event of the sequence of the next stage for the given finished event,
+// e.g. sh.keptn.event.hardening.delivery.triggered for sh.keptn.event.dev.delivery.finished.
+// The next stage is taken from the shipyard of the project: it is the stage with a sequence triggered on the finished
+// sequence whose selector matches the finished event, or else the stage following the stage of the event, if it has
+// the sequence as well. Failed or errored events are only promoted to a sequence triggered on them explicitly.
+// The triggered event is sent in the Keptn context of the finished event and carries its data, i.e. the project,
+// service and labels as well as the configuration change of the deployment of the sequence
+func (c *APISet) PromoteToNextStage(ctx context.Context, finishedEvent models.KeptnContextExtendedCE, opts PromotionPromoteToNextStageOptions) (*models.EventContext, error) {
+	if finishedEvent.Type == nil || !strings.HasPrefix(*finishedEvent.Type, "sh.keptn.event.") || !strings.HasSuffix(*finishedEvent.Type, ".finished") {
+		return nil, fmt.Errorf("event %s is not a finished event", finishedEvent.ID)
+	}
+	data := map[string]interface{}{}
+	if err := finishedEvent.DataAs(&data); err != nil {
+		return nil, fmt.Errorf("could not decode data of event %s: %w", finishedEvent.ID, err)
+	}
+	project, _ := data["project"].(string)
+	stage, _ := data["stage"].(string)
+	// sequence events have the type sh.keptn.event.<stage>.<sequence>.finished
+	finishedSequence := ""
+	if parts := strings.Split(*finishedEvent.Type, "."); len(parts) == 6 {
+		stage = parts[3]
+		finishedSequence = parts[4]
+	}
+	sequence := opts.Sequence
+	if sequence == "" {
+		sequence = finishedSequence
+	}
+	if project == "" || stage == "" {
+		return nil, fmt.Errorf("event %s does not contain a project and stage", finishedEvent.ID)
+	}
+	if sequence == "" {
+		return nil, fmt.Errorf("the sequence to trigger must be specified for task event %s", *finishedEvent.Type)
+	}
+
+	s, err := c.getShipyard(ctx, project)
+	if err != nil {
+		return nil, err
+	}
+	nextStage, nextSequence, err := nextStageOf(s, stage, finishedSequence, data, opts.Stage, sequence)
+	if err != nil {
+		return nil, fmt.Errorf("could not promote event %s of stage %s: %w", finishedEvent.ID, stage, err)
+	}
+
+	// the outcome of the finished sequence is not part of the triggered event
+	triggeredData := map[string]interface{}{}
+	for key, value := range data {
+		if key != "status" && key != "result" && key != "message" {
+			triggeredData[key] = value
+		}
+	}
+	triggeredData["stage"] = nextStage
+	if _, ok := triggeredData["configurationChange"]; !ok {
+		configurationChange, err := c.getConfigurationChange(ctx, project, stage, finishedEvent.Shkeptncontext)
+		if err != nil {
+			return nil, err
+		}
+		if configurationChange != nil {
+			triggeredData["configurationChange"] = configurationChange
+		}
+	}
+	if len(opts.Labels) > 0 {
+		labels, _ := triggeredData["labels"].(map[string]interface{})
+		if labels == nil {
+			labels = map[string]interface{}{}
+		}
+		for key, value := range opts.Labels {
+			labels[key] = value
+		}
+		triggeredData["labels"] = labels
+	}
+
+	triggeredType := "sh.keptn.event." + nextStage + "." + nextSequence + ".triggered"
+	triggered := models.KeptnContextExtendedCE{
+		Contenttype:        "application/json",
+		Data:               triggeredData,
+		Shkeptncontext:     finishedEvent.Shkeptncontext,
+		Shkeptnspecversion: finishedEvent.Shkeptnspecversion,
+		Specversion:        finishedEvent.Specversion,
+		GitCommitID:        finishedEvent.GitCommitID,
+		Type:               &triggeredType,
+	}
+	if opts.Source != "" {
+		triggered.Source = &opts.Source
+	}
+	eventContext, mErr := c.apiHandler.SendEvent(ctx, triggered, APISendEventOptions{})
+	if mErr != nil {
+		return nil, mErr.ToError()
+	}
+	return eventContext, nil
+}
+
+func (c *APISet) getShipyard(ctx context.Context, project string) (*shipyard.Shipyard, error) {
+	resource, err := c.resourceHandler.GetResource(ctx, *NewResourceScope().Project(project).Resource(shipyardResourceURI), ResourcesGetResourceOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("could not retrieve shipyard of project %s: %w", project, err)
+	}
+	s, err := shipyard.Decode([]byte(resource.ResourceContent))
+	if err != nil {
+		return nil, fmt.Errorf("could not decode shipyard of project %s: %w", project, err)
+	}
+	return s, nil
+}
+
+// getConfigurationChange returns the configuration change of the most recent deployment in the stage and Keptn context, if any
+func (c *APISet) getConfigurationChange(ctx context.Context, project string, stage string, keptnContext string) (interface{}, error) {
+	if keptnContext == "" {
+		return nil, nil
+	}
+	events, mErr := c.eventHandler.GetEvents(ctx, &EventFilter{
+		Project:      project,
+		Stage:        stage,
+		EventType:    deploymentTriggeredEventType,
+		KeptnContext: keptnContext,
+	}, EventsGetEventsOptions{})
+	if mErr != nil {
+		return nil, fmt.Errorf("could not retrieve deployment of stage %s: %w", stage, mErr.ToError())
+	}
+	for _, event := range events {
+		data := map[string]interface{}{}
+		if err := event.DataAs(&data); err != nil {
+			continue
+		}
+		if configurationChange, ok := data["configurationChange"]; ok {
+			return configurationChange, nil
+		}
+	}
+	return nil, nil
+}
+
+// nextStageOf returns the stage and sequence to promote the event of the stage to. The finished sequence is empty for task events.
+// If the target stage is empty, the next stage is determined from the shipyard
+func nextStageOf(s *shipyard.Shipyard, stage string, finishedSequence string, data map[string]interface{}, targetStage string, sequence string) (string, string, error) {
+	finished := stage + "." + finishedSequence + ".finished"
+	stageIndex := -1
+	for i, st := range s.Spec.Stages {
+		if st.Name == stage {
+			stageIndex = i
+		}
+		if finishedSequence == "" || (targetStage != "" && st.Name != targetStage) {
+			continue
+		}
+		for _, seq := range st.Sequences {
+			if sequence != finishedSequence && seq.Name != sequence {
+				continue
+			}
+			for _, trigger := range seq.TriggeredOn {
+				if trigger.Event == finished && selectorMatches(trigger.Selector, data) {
+					return st.Name, seq.Name, nil
+				}
+			}
+		}
+	}
+	if stageIndex < 0 {
+		return "", "", fmt.Errorf("stage %s is not part of the shipyard", stage)
+	}
+
+	result, _ := data["result"].(string)
+	status, _ := data["status"].(string)
+	if result == "fail" || status == "errored" {
+		return "", "", fmt.Errorf("%w: result is %q and status is %q", ErrNotPromotable, result, status)
+	}
+
+	var next *shipyard.Stage
+	if targetStage != "" {
+		for i := range s.Spec.Stages {
+			if s.Spec.Stages[i].Name == targetStage {
+				next = &s.Spec.Stages[i]
+			}
+		}
+		if next == nil {
+			return "", "", fmt.Errorf("stage %s is not part of the shipyard", targetStage)
+		}
+	} else if stageIndex+1 < len(s.Spec.Stages) {
+		next = &s.Spec.Stages[stageIndex+1]
+	} else {
+		return "", "", fmt.Errorf("%w: %s is the last stage", ErrNoNextStage, stage)
+	}
+	for _, seq := range next.Sequences {
+		if seq.Name == sequence {
+			return next.Name, seq.Name, nil
+		}
+	}
+	return "", "", fmt.Errorf("%w: stage %s has no sequence %s", ErrNoNextStage, next.Name, sequence)
+}
+
+// selectorMatches returns whether all properties of the selector match the data of the event
+func selectorMatches(selector shipyard.Selector, data map[string]interface{}) bool {
+	for key, expected := range selector.Match {
+		if actual, _ := data[key].(string); actual != expected {
+			return false
+		}
+	}
+	return true
+}
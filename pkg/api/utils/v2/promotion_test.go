@@ -0,0 +1,175 @@
+package v2
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/keptn/go-utils/pkg/api/models"
+	"github.com/stretchr/testify/require"
+)
+
+const promotionShipyard = `apiVersion: "spec.keptn.sh/0.2.3"
+kind: "Shipyard"
+metadata:
+  name: "shipyard-sockshop"
+spec:
+  stages:
+    - name: "dev"
+      sequences:
+        - name: "delivery"
+          tasks:
+            - name: "deployment"
+            - name: "release"
+    - name: "hardening"
+      sequences:
+        - name: "delivery"
+          tasks:
+            - name: "deployment"
+            - name: "evaluation"
+    - name: "production"
+      sequences:
+        - name: "delivery"
+          tasks:
+            - name: "deployment"
+            - name: "release"
+        - name: "rollback"
+          triggeredOn:
+            - event: "production.delivery.finished"
+              selector:
+                match:
+                  result: "fail"
+          tasks:
+            - name: "rollback"
+`
+
+func newPromotionServer(t *testing.T) *recordingServer {
+	return newRecordingServer(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodGet && strings.HasSuffix(r.URL.Path, "/sockshop/resource/shipyard.yaml"):
+			w.Write([]byte(`{"resourceURI":"shipyard.yaml","resourceContent":"` + base64.StdEncoding.EncodeToString([]byte(promotionShipyard)) + `"}`))
+		case r.Method == http.MethodGet && strings.HasSuffix(r.URL.Path, "/mongodb-datastore/event"):
+			require.Equal(t, "sh.keptn.event.deployment.triggered", r.URL.Query().Get("type"))
+			require.Equal(t, "my-context", r.URL.Query().Get("keptnContext"))
+			w.Write([]byte(`{"events":[{"id":"deployment-1","shkeptncontext":"my-context","type":"sh.keptn.event.deployment.triggered",
+				"data":{"project":"sockshop","stage":"` + r.URL.Query().Get("stage") + `","service":"carts","configurationChange":{"values":{"image":"carts:0.13.1"}}}}],"totalCount":1}`))
+		case r.Method == http.MethodPost && strings.HasSuffix(r.URL.Path, "/v1/event"):
+			w.Write([]byte(`{"keptnContext":"my-context"}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+}
+
+func newFinishedEvent(eventType string, data map[string]interface{}) models.KeptnContextExtendedCE {
+	return models.KeptnContextExtendedCE{
+		ID:                 "finished-1",
+		Data:               data,
+		Shkeptncontext:     "my-context",
+		Shkeptnspecversion: "0.2.4",
+		Specversion:        "1.0",
+		Type:               &eventType,
+	}
+}
+
+func TestAPISet_PromoteToNextStage(t *testing.T) {
+	server := newPromotionServer(t)
+	defer server.Close()
+	apiSet, err := New(server.URL, WithEventSource("my-promoter"))
+	require.Nil(t, err)
+
+	finished := newFinishedEvent("sh.keptn.event.dev.delivery.finished", map[string]interface{}{
+		"project": "sockshop", "stage": "dev", "service": "carts", "labels": map[string]interface{}{"buildId": "1"},
+		"status": "succeeded", "result": "pass", "message": "delivered",
+	})
+	eventContext, err := apiSet.PromoteToNextStage(context.TODO(), finished, PromotionPromoteToNextStageOptions{Labels: map[string]string{"promotedBy": "ci"}})
+	require.Nil(t, err)
+	require.Equal(t, "my-context", *eventContext.KeptnContext)
+
+	sent := server.sentEvents(t)
+	require.Len(t, sent, 1)
+	triggered := sent[0]
+	require.Equal(t, "sh.keptn.event.hardening.delivery.triggered", *triggered.Type)
+	require.Equal(t, "my-context", triggered.Shkeptncontext)
+	require.Equal(t, "my-promoter", *triggered.Source)
+	require.Equal(t, map[string]interface{}{
+		"project":             "sockshop",
+		"stage":               "hardening",
+		"service":             "carts",
+		"labels":              map[string]interface{}{"buildId": "1", "promotedBy": "ci"},
+		"configurationChange": map[string]interface{}{"values": map[string]interface{}{"image": "carts:0.13.1"}},
+	}, triggered.Data)
+}
+
+func TestAPISet_PromoteToNextStageTriggeredOn(t *testing.T) {
+	server := newPromotionServer(t)
+	defer server.Close()
+	apiSet, err := New(server.URL, WithEventSource("my-promoter"))
+	require.Nil(t, err)
+
+	finished := newFinishedEvent("sh.keptn.event.production.delivery.finished", map[string]interface{}{
+		"project": "sockshop", "stage": "production", "service": "carts", "status": "succeeded", "result": "fail",
+		"configurationChange": map[string]interface{}{"values": map[string]interface{}{"image": "carts:0.13.2"}},
+	})
+	_, err = apiSet.PromoteToNextStage(context.TODO(), finished, PromotionPromoteToNextStageOptions{})
+	require.Nil(t, err)
+	sent := server.sentEvents(t)
+	require.Len(t, sent, 1)
+	require.Equal(t, "sh.keptn.event.production.rollback.triggered", *sent[0].Type)
+	require.Equal(t, map[string]interface{}{"values": map[string]interface{}{"image": "carts:0.13.2"}}, sent[0].Data.(map[string]interface{})["configurationChange"])
+}
+
+func TestAPISet_PromoteToNextStageTaskEvent(t *testing.T) {
+	server := newPromotionServer(t)
+	defer server.Close()
+	apiSet, err := New(server.URL)
+	require.Nil(t, err)
+
+	finished := newFinishedEvent("sh.keptn.event.release.finished", map[string]interface{}{
+		"project": "sockshop", "stage": "dev", "service": "carts", "status": "succeeded", "result": "pass",
+	})
+	_, err = apiSet.PromoteToNextStage(context.TODO(), finished, PromotionPromoteToNextStageOptions{})
+	require.EqualError(t, err, "the sequence to trigger must be specified for task event sh.keptn.event.release.finished")
+
+	_, err = apiSet.PromoteToNextStage(context.TODO(), finished, PromotionPromoteToNextStageOptions{Sequence: "delivery", Stage: "production", Source: "my-promoter"})
+	require.Nil(t, err)
+	sent := server.sentEvents(t)
+	require.Len(t, sent, 1)
+	require.Equal(t, "sh.keptn.event.production.delivery.triggered", *sent[0].Type)
+	require.Equal(t, "my-promoter", *sent[0].Source)
+}
+
+func TestAPISet_PromoteToNextStageNotPossible(t *testing.T) {
+	server := newPromotionServer(t)
+	defer server.Close()
+	apiSet, err := New(server.URL, WithEventSource("my-promoter"))
+	require.Nil(t, err)
+
+	tests := []struct {
+		name      string
+		eventType string
+		stage     string
+		result    string
+		opts      PromotionPromoteToNextStageOptions
+		err       error
+	}{
+		{name: "last stage", eventType: "sh.keptn.event.production.delivery.finished", stage: "production", result: "pass", err: ErrNoNextStage},
+		{name: "failed", eventType: "sh.keptn.event.hardening.delivery.finished", stage: "hardening", result: "fail", err: ErrNotPromotable},
+		{name: "unknown sequence", eventType: "sh.keptn.event.dev.delivery.finished", stage: "dev", result: "pass", opts: PromotionPromoteToNextStageOptions{Sequence: "canary"}, err: ErrNoNextStage},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			finished := newFinishedEvent(tt.eventType, map[string]interface{}{"project": "sockshop", "stage": tt.stage, "service": "carts", "result": tt.result})
+			_, err := apiSet.PromoteToNextStage(context.TODO(), finished, tt.opts)
+			require.True(t, errors.Is(err, tt.err), err)
+		})
+	}
+
+	triggered := newFinishedEvent("sh.keptn.event.dev.delivery.triggered", map[string]interface{}{"project": "sockshop", "stage": "dev"})
+	_, err = apiSet.PromoteToNextStage(context.TODO(), triggered, PromotionPromoteToNextStageOptions{})
+	require.EqualError(t, err, "event finished-1 is not a finished event")
+	require.Empty(t, server.sentEvents(t))
+}
@@ -0,0 +1,134 @@
+package v2
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// ProxyHeaderFunc returns the headers authenticating the APISet at the given proxy, e.g. a Proxy-Authorization
+// header carrying a bearer token. It is called for every CONNECT request, as well as for every plain http request
+// sent via the proxy, so that short-lived credentials can be refreshed
+type ProxyHeaderFunc func(ctx context.Context, proxyURL *url.URL) (http.Header, error)
+
+// ProxyAuth are the credentials of the egress proxy used by an APISet, see WithProxyAuth
+type ProxyAuth struct {
+	// Username and Password are sent as basic credentials to proxies whose URL does not contain credentials itself
+	Username string
+	Password string
+	// Header is used instead of basic credentials if it is set
+	Header ProxyHeaderFunc
+	// Proxies overrides the proxy configured in the environment (HTTP_PROXY, HTTPS_PROXY and NO_PROXY) per host.
+	// A key is either a host name or a host with a port, the latter taking precedence over the former. A value is the URL of the proxy,
+	// or an empty string if requests to the host are sent directly
+	Proxies map[string]string
+}
+
+// WithProxyAuth authenticates the APISet at the egress proxy, both for CONNECT requests of https connections and for
+// plain http requests sent via the proxy. The proxy is taken from the environment, unless it is overridden for the host
+// of a request via ProxyAuth.Proxies. The option requires the http client of the APISet to use a *http.Transport
+func WithProxyAuth(auth ProxyAuth) func(*APISet) {
+	return func(a *APISet) {
+		a.proxyAuth = &auth
+	}
+}
+
+// proxyConfig is the parsed ProxyAuth of an APISet
+type proxyConfig struct {
+	user    *url.Userinfo
+	header  ProxyHeaderFunc
+	proxies map[string]*url.URL
+}
+
+func newProxyConfig(auth ProxyAuth) (*proxyConfig, error) {
+	config := &proxyConfig{header: auth.Header, proxies: map[string]*url.URL{}}
+	if auth.Header == nil && auth.Username != "" {
+		config.user = url.UserPassword(auth.Username, auth.Password)
+	}
+	for host, proxy := range auth.Proxies {
+		if proxy == "" {
+			config.proxies[strings.ToLower(host)] = nil
+			continue
+		}
+		proxyURL, err := url.Parse(proxy)
+		if err != nil || proxyURL.Host == "" {
+			return nil, fmt.Errorf("invalid proxy URL %q for host %s", proxy, host)
+		}
+		config.proxies[strings.ToLower(host)] = proxyURL
+	}
+	return config, nil
+}
+
+// apply configures the proxy of the transport. It must be called after getClientTransport, which sets the proxy to the environment
+func (p *proxyConfig) apply(tr *http.Transport) {
+	tr.Proxy = p.proxy
+	if p.header != nil {
+		tr.GetProxyConnectHeader = func(ctx context.Context, proxyURL *url.URL, _ string) (http.Header, error) {
+			return p.header(ctx, proxyURL)
+		}
+	}
+}
+
+// proxy returns the proxy of the request, with basic credentials added if configured
+func (p *proxyConfig) proxy(req *http.Request) (*url.URL, error) {
+	hostname := strings.ToLower(req.URL.Hostname())
+	port := req.URL.Port()
+	if port == "" {
+		port = "80"
+		if req.URL.Scheme == "https" {
+			port = "443"
+		}
+	}
+	proxyURL, overridden := p.proxies[net.JoinHostPort(hostname, port)]
+	if !overridden {
+		proxyURL, overridden = p.proxies[hostname]
+	}
+	if !overridden {
+		var err error
+		if proxyURL, err = http.ProxyFromEnvironment(req); err != nil {
+			return nil, err
+		}
+	}
+	if proxyURL == nil || p.user == nil || proxyURL.User != nil {
+		return proxyURL, nil
+	}
+	withUser := *proxyURL
+	withUser.User = p.user
+	return &withUser, nil
+}
+
+// proxyHeaderTransport adds the headers of a ProxyHeaderFunc to plain http requests sent via a proxy.
+// Requests to https URLs are sent via CONNECT, so that the headers must not be added to them
+type proxyHeaderTransport struct {
+	base   http.RoundTripper
+	config *proxyConfig
+}
+
+func newProxyHeaderTransport(base http.RoundTripper, config *proxyConfig) *proxyHeaderTransport {
+	return &proxyHeaderTransport{base: base, config: config}
+}
+
+func (t *proxyHeaderTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.URL.Scheme != "http" {
+		return t.base.RoundTrip(req)
+	}
+	proxyURL, err := t.config.proxy(req)
+	if err != nil || proxyURL == nil {
+		return t.base.RoundTrip(req)
+	}
+	header, err := t.config.header(req.Context(), proxyURL)
+	if err != nil {
+		if req.Body != nil {
+			req.Body.Close()
+		}
+		return nil, fmt.Errorf("could not get proxy headers: %w", err)
+	}
+	req = req.Clone(req.Context())
+	for key, values := range header {
+		req.Header[http.CanonicalHeaderKey(key)] = values
+	}
+	return t.base.RoundTrip(req)
+}
@@ -0,0 +1,114 @@
+package v2
+
+import (
+	"context"
+	"encoding/base64"
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/keptn/go-utils/pkg/api/models"
+	"github.com/stretchr/testify/require"
+)
+
+// newRecordingProxy returns a proxy recording the requests it receives. Plain http requests are answered
+// with an empty project, CONNECT requests are refused
+func newRecordingProxy() *recordingServer {
+	return newRecordingServer(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodConnect {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"projectName":"my-project"}`))
+	})
+}
+
+func TestAPISet_WithProxyAuthBasicCredentials(t *testing.T) {
+	proxy := newRecordingProxy()
+	defer proxy.Close()
+
+	apiSet, err := New("http://keptn.example.com/api", WithProxyAuth(ProxyAuth{
+		Username: "user",
+		Password: "secret",
+		Proxies:  map[string]string{"keptn.example.com": proxy.URL},
+	}))
+	require.Nil(t, err)
+
+	project, mErr := apiSet.Projects().GetProject(context.TODO(), models.Project{ProjectName: "my-project"}, ProjectsGetProjectOptions{})
+	require.Nil(t, mErr)
+	require.Equal(t, "my-project", project.ProjectName)
+	requests := proxy.received()
+	require.Len(t, requests, 1)
+	require.Equal(t, "keptn.example.com", requests[0].Host)
+	require.Equal(t, "Basic "+base64.StdEncoding.EncodeToString([]byte("user:secret")), requests[0].Header.Get("Proxy-Authorization"))
+}
+
+func TestAPISet_WithProxyAuthHeader(t *testing.T) {
+	proxy := newRecordingProxy()
+	defer proxy.Close()
+
+	proxyURLs := []string{}
+	apiSet, err := New("http://keptn.example.com/api", WithProxyAuth(ProxyAuth{
+		Header: func(ctx context.Context, proxyURL *url.URL) (http.Header, error) {
+			proxyURLs = append(proxyURLs, proxyURL.String())
+			return http.Header{"Proxy-Authorization": []string{"Bearer proxy-token"}}, nil
+		},
+		Proxies: map[string]string{
+			"keptn.example.com":     proxy.URL,
+			"keptn.example.com:443": proxy.URL,
+		},
+	}))
+	require.Nil(t, err)
+
+	_, mErr := apiSet.Projects().GetProject(context.TODO(), models.Project{ProjectName: "my-project"}, ProjectsGetProjectOptions{})
+	require.Nil(t, mErr)
+
+	apiSet, err = New("https://keptn.example.com/api", WithProxyAuth(ProxyAuth{
+		Header: func(ctx context.Context, proxyURL *url.URL) (http.Header, error) {
+			proxyURLs = append(proxyURLs, proxyURL.String())
+			return http.Header{"Proxy-Authorization": []string{"Bearer proxy-token"}}, nil
+		},
+		Proxies: map[string]string{"keptn.example.com:443": proxy.URL},
+	}))
+	require.Nil(t, err)
+
+	_, mErr = apiSet.Projects().GetProject(context.TODO(), models.Project{ProjectName: "my-project"}, ProjectsGetProjectOptions{})
+	require.NotNil(t, mErr)
+
+	requests := proxy.received()
+	require.Len(t, requests, 2)
+	require.Equal(t, http.MethodGet, requests[0].Method)
+	require.Equal(t, "Bearer proxy-token", requests[0].Header.Get("Proxy-Authorization"))
+	require.Equal(t, http.MethodConnect, requests[1].Method)
+	require.Equal(t, "keptn.example.com:443", requests[1].Host)
+	require.Equal(t, "Bearer proxy-token", requests[1].Header.Get("Proxy-Authorization"))
+	require.Equal(t, []string{proxy.URL, proxy.URL}, proxyURLs)
+}
+
+func TestAPISet_WithProxyAuthDirectOverride(t *testing.T) {
+	target := newRecordingProxy()
+	defer target.Close()
+
+	targetURL, _ := url.Parse(target.URL)
+	apiSet, err := New(target.URL, WithProxyAuth(ProxyAuth{
+		Username: "user",
+		Password: "secret",
+		Proxies:  map[string]string{targetURL.Hostname(): "http://proxy.invalid:3128", targetURL.Host: ""},
+	}))
+	require.Nil(t, err)
+
+	_, mErr := apiSet.Projects().GetProject(context.TODO(), models.Project{ProjectName: "my-project"}, ProjectsGetProjectOptions{})
+	require.Nil(t, mErr)
+	requests := target.received()
+	require.Len(t, requests, 1)
+	require.Empty(t, requests[0].Header.Get("Proxy-Authorization"))
+}
+
+func TestAPISet_WithProxyAuthInvalidProxy(t *testing.T) {
+	_, err := New("http://keptn.example.com/api", WithProxyAuth(ProxyAuth{Proxies: map[string]string{"keptn.example.com": "proxy:3128:1"}}))
+	require.NotNil(t, err)
+
+	_, err = New("http://keptn.example.com/api", WithHTTPClient(&http.Client{Transport: newReadOnlyTransport(nil)}), WithProxyAuth(ProxyAuth{Username: "user"}))
+	require.NotNil(t, err)
+}
@@ -0,0 +1,54 @@
+package v2
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// ErrReadOnly is returned by all methods creating, updating or deleting entities or sending events
+// of an APISet created with WithReadOnly
+var ErrReadOnly = errors.New("apiset is read-only")
+
+// WithReadOnly turns the APISet read-only: all POST, PUT, PATCH and DELETE requests fail with an error
+// wrapping ErrReadOnly without being sent, except for the POST request of AuthInterface.Authenticate, which does not change anything, e.g. to audit a production Keptn without the risk of changing it,
+// or to let a dashboard reuse code paths which may call mutating methods.
+// The *models.Error returned by the methods of the handlers can be checked with errors.Is(mErr.ToError(), ErrReadOnly)
+func WithReadOnly() func(*APISet) {
+	return func(a *APISet) {
+		a.readOnly = true
+	}
+}
+
+// ReadOnly returns whether the APISet has been created with WithReadOnly
+func (c *APISet) ReadOnly() bool {
+	return c.readOnly
+}
+
+// readOnlyTransport is a http.RoundTripper which rejects all mutating requests
+type readOnlyTransport struct {
+	base http.RoundTripper
+}
+
+func newReadOnlyTransport(base http.RoundTripper) *readOnlyTransport {
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	return &readOnlyTransport{base: base}
+}
+
+func (t *readOnlyTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if isMutatingMethod(req.Method) && !isAuthRequest(req) {
+		if req.Body != nil {
+			req.Body.Close()
+		}
+		return nil, fmt.Errorf("%s %s: %w", req.Method, req.URL.Path, ErrReadOnly)
+	}
+	return t.base.RoundTrip(req)
+}
+
+// isAuthRequest returns whether the request checks the token via AuthInterface.Authenticate
+func isAuthRequest(req *http.Request) bool {
+	return req.Method == http.MethodPost && strings.HasSuffix(req.URL.Path, v1AuthPath)
+}
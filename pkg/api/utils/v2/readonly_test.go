@@ -0,0 +1,64 @@
+package v2
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/keptn/go-utils/pkg/api/models"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAPISet_WithReadOnly(t *testing.T) {
+	var requests []*http.Request
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r)
+		w.Write([]byte(`{"projectName":"sockshop"}`))
+	}))
+	defer server.Close()
+
+	apiSet, err := New(server.URL, WithReadOnly(), WithEventSource("my-service"))
+	require.Nil(t, err)
+	require.True(t, apiSet.ReadOnly())
+
+	_, mErr := apiSet.Services().DeleteServiceFromStage(context.TODO(), "sockshop", "prod", "carts", ServicesDeleteServiceFromStageOptions{})
+	require.NotNil(t, mErr)
+	require.True(t, errors.Is(mErr.ToError(), ErrReadOnly))
+
+	_, mErr = apiSet.API().SendEvent(context.TODO(), models.KeptnContextExtendedCE{}, APISendEventOptions{})
+	require.NotNil(t, mErr)
+	require.True(t, errors.Is(mErr.ToError(), ErrReadOnly))
+	require.False(t, isRetryableError(mErr))
+
+	_, err = apiSet.resourceHandler.CreateResourcesByURI(context.TODO(), server.URL+"/v1/project/sockshop/resource", []*models.Resource{{ResourceURI: stringp("test.txt")}})
+	require.True(t, errors.Is(err, ErrReadOnly))
+	require.Empty(t, requests)
+
+	// reads are still sent
+	project, mErr := apiSet.Projects().GetProject(context.TODO(), models.Project{ProjectName: "sockshop"}, ProjectsGetProjectOptions{})
+	require.Nil(t, mErr)
+	require.Equal(t, "sockshop", project.ProjectName)
+	require.Len(t, requests, 1)
+
+	// and so is the authentication, which does not change anything
+	_, mErr = apiSet.Auth().Authenticate(context.TODO(), AuthAuthenticateOptions{})
+	require.Nil(t, mErr)
+	require.Len(t, requests, 2)
+	require.Equal(t, http.MethodPost, requests[1].Method)
+}
+
+func TestAPISet_WithoutReadOnly(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"keptnContext":"my-context"}`))
+	}))
+	defer server.Close()
+
+	apiSet, err := New(server.URL)
+	require.Nil(t, err)
+	require.False(t, apiSet.ReadOnly())
+
+	_, mErr := apiSet.Services().DeleteServiceFromStage(context.TODO(), "sockshop", "prod", "carts", ServicesDeleteServiceFromStageOptions{})
+	require.Nil(t, mErr)
+}
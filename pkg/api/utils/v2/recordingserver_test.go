@@ -0,0 +1,102 @@
+package v2
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/keptn/go-utils/pkg/api/models"
+	"github.com/stretchr/testify/require"
+)
+
+// recordingServer is a test server recording the requests it receives before passing them to its handler,
+// so that tests can check the requests sent by the client afterwards
+type recordingServer struct {
+	*httptest.Server
+	mtx      sync.Mutex
+	requests []recordedRequest
+}
+
+// recordedRequest is a request received by a recordingServer
+type recordedRequest struct {
+	Method string
+	Host   string
+	URL    *url.URL
+	Header http.Header
+	Body   []byte
+}
+
+// newRecordingServer starts a recordingServer. The handler can still read the body of the requests
+func newRecordingServer(handler http.HandlerFunc) *recordingServer {
+	s := &recordingServer{}
+	s.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		r.Body = ioutil.NopCloser(bytes.NewReader(body))
+		s.mtx.Lock()
+		s.requests = append(s.requests, recordedRequest{Method: r.Method, Host: r.Host, URL: r.URL, Header: r.Header.Clone(), Body: body})
+		s.mtx.Unlock()
+		handler(w, r)
+	}))
+	return s
+}
+
+// received returns the requests received so far
+func (s *recordingServer) received() []recordedRequest {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	return append([]recordedRequest{}, s.requests...)
+}
+
+// receivedMatching returns the received requests with the given method whose path ends with the given suffix
+func (s *recordingServer) receivedMatching(method string, pathSuffix string) []recordedRequest {
+	matching := []recordedRequest{}
+	for _, r := range s.received() {
+		if r.Method == method && strings.HasSuffix(r.URL.Path, pathSuffix) {
+			matching = append(matching, r)
+		}
+	}
+	return matching
+}
+
+// queries returns the raw queries of the received requests with the given method whose path ends with the given suffix
+func (s *recordingServer) queries(method string, pathSuffix string) []string {
+	queries := []string{}
+	for _, r := range s.receivedMatching(method, pathSuffix) {
+		queries = append(queries, r.URL.RawQuery)
+	}
+	return queries
+}
+
+// headerValues returns the value of the given header of each received request
+func (s *recordingServer) headerValues(name string) []string {
+	values := []string{}
+	for _, r := range s.received() {
+		values = append(values, r.Header.Get(name))
+	}
+	return values
+}
+
+// nextPageKeys returns the nextPageKey query parameter of each received request
+func (s *recordingServer) nextPageKeys() []string {
+	nextPageKeys := []string{}
+	for _, r := range s.received() {
+		nextPageKeys = append(nextPageKeys, r.URL.Query().Get("nextPageKey"))
+	}
+	return nextPageKeys
+}
+
+// sentEvents returns the events sent to the server via POST /v1/event
+func (s *recordingServer) sentEvents(t *testing.T) []models.KeptnContextExtendedCE {
+	events := []models.KeptnContextExtendedCE{}
+	for _, r := range s.receivedMatching(http.MethodPost, "/v1/event") {
+		event := models.KeptnContextExtendedCE{}
+		require.Nil(t, event.FromJSON(r.Body))
+		events = append(events, event)
+	}
+	return events
+}
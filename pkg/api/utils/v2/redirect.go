@@ -0,0 +1,39 @@
+package v2
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// defaultMaxRedirects is the number of redirects an APISet follows by default,
+// which is the same limit the http.Client applies
+const defaultMaxRedirects = 10
+
+// ErrRedirectsDisabled is returned when the Keptn API responds with a redirect
+// although following redirects has been disabled via WithoutRedirects
+var ErrRedirectsDisabled = errors.New("following redirects is disabled")
+
+// redirectPolicy decides whether the APISet follows a redirect
+type redirectPolicy struct {
+	maxRedirects int
+	disabled     bool
+	authHeader   string
+}
+
+// checkRedirect can be used as http.Client.CheckRedirect. It limits the number of
+// followed redirects and removes the auth header if a redirect points to another host,
+// as the http.Client only does that for the well-known Authorization header
+func (p redirectPolicy) checkRedirect(req *http.Request, via []*http.Request) error {
+	if p.disabled {
+		return fmt.Errorf("redirect to %s: %w", req.URL.Redacted(), ErrRedirectsDisabled)
+	}
+	if len(via) > p.maxRedirects {
+		return fmt.Errorf("stopped after %d redirects", p.maxRedirects)
+	}
+	if p.authHeader != "" && len(via) > 0 && !strings.EqualFold(req.URL.Host, via[0].URL.Host) {
+		req.Header.Del(p.authHeader)
+	}
+	return nil
+}
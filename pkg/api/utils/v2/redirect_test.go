@@ -0,0 +1,127 @@
+package v2
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/keptn/go-utils/pkg/api/models"
+	"github.com/stretchr/testify/require"
+)
+
+// newRedirectingServer returns a server that redirects all requests to target
+func newRedirectingServer(target string) *recordingServer {
+	return newRecordingServer(func(w http.ResponseWriter, r *http.Request) {
+		http.Redirect(w, r, target+r.URL.Path, http.StatusTemporaryRedirect)
+	})
+}
+
+func newTokenRecordingServer() *recordingServer {
+	return newRecordingServer(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{}`))
+	})
+}
+
+func TestAPISet_RedirectToOtherHostStripsAuthHeader(t *testing.T) {
+	target := newTokenRecordingServer()
+	defer target.Close()
+
+	server := newRedirectingServer(target.URL)
+	defer server.Close()
+
+	apiSet, err := New(server.URL, WithAuthToken("my-token"))
+	require.Nil(t, err)
+
+	_, mErr := apiSet.Projects().GetProject(context.TODO(), models.Project{ProjectName: "my-project"}, ProjectsGetProjectOptions{})
+	require.Nil(t, mErr)
+	require.Equal(t, []string{"my-token"}, server.headerValues("x-token"))
+	require.Equal(t, []string{""}, target.headerValues("x-token"))
+}
+
+func TestAPISet_RedirectToSameHostKeepsAuthHeader(t *testing.T) {
+	server := newRecordingServer(func(w http.ResponseWriter, r *http.Request) {
+		if !strings.HasPrefix(r.URL.Path, "/moved") {
+			http.Redirect(w, r, "/moved"+r.URL.Path, http.StatusTemporaryRedirect)
+			return
+		}
+		w.Write([]byte(`{}`))
+	})
+	defer server.Close()
+
+	apiSet, err := New(server.URL, WithAuthToken("my-token"))
+	require.Nil(t, err)
+
+	_, mErr := apiSet.Projects().GetProject(context.TODO(), models.Project{ProjectName: "my-project"}, ProjectsGetProjectOptions{})
+	require.Nil(t, mErr)
+	require.Equal(t, []string{"my-token", "my-token"}, server.headerValues("x-token"))
+}
+
+func TestAPISet_RedirectWithTokenRefresherStripsAuthHeader(t *testing.T) {
+	target := newTokenRecordingServer()
+	defer target.Close()
+
+	server := newRedirectingServer(target.URL)
+	defer server.Close()
+
+	apiSet, err := New(server.URL, WithAuthToken("my-token"), WithTokenRefresher(func(ctx context.Context) (string, error) {
+		return "new-token", nil
+	}))
+	require.Nil(t, err)
+
+	_, mErr := apiSet.Projects().GetProject(context.TODO(), models.Project{ProjectName: "my-project"}, ProjectsGetProjectOptions{})
+	require.Nil(t, mErr)
+	require.Equal(t, []string{""}, target.headerValues("x-token"))
+}
+
+func TestAPISet_WithoutRedirects(t *testing.T) {
+	target := newTokenRecordingServer()
+	defer target.Close()
+
+	server := newRedirectingServer(target.URL)
+	defer server.Close()
+
+	apiSet, err := New(server.URL, WithAuthToken("my-token"), WithoutRedirects())
+	require.Nil(t, err)
+
+	_, mErr := apiSet.Projects().GetProject(context.TODO(), models.Project{ProjectName: "my-project"}, ProjectsGetProjectOptions{})
+	require.NotNil(t, mErr)
+	require.Contains(t, mErr.GetMessage(), ErrRedirectsDisabled.Error())
+	require.Len(t, server.received(), 1)
+	require.Empty(t, target.received())
+}
+
+func TestAPISet_WithMaxRedirects(t *testing.T) {
+	target := newTokenRecordingServer()
+	defer target.Close()
+
+	second := newRedirectingServer(target.URL)
+	defer second.Close()
+
+	first := newRedirectingServer(second.URL)
+	defer first.Close()
+
+	apiSet, err := New(first.URL, WithMaxRedirects(1))
+	require.Nil(t, err)
+	_, mErr := apiSet.Projects().GetProject(context.TODO(), models.Project{ProjectName: "my-project"}, ProjectsGetProjectOptions{})
+	require.NotNil(t, mErr)
+	require.Contains(t, mErr.GetMessage(), "stopped after 1 redirects")
+	require.Empty(t, target.received())
+
+	apiSet, err = New(first.URL, WithMaxRedirects(2))
+	require.Nil(t, err)
+	_, mErr = apiSet.Projects().GetProject(context.TODO(), models.Project{ProjectName: "my-project"}, ProjectsGetProjectOptions{})
+	require.Nil(t, mErr)
+	require.Len(t, target.received(), 1)
+}
+
+func TestAPISet_KeepsCheckRedirectOfCustomClient(t *testing.T) {
+	checkRedirect := func(req *http.Request, via []*http.Request) error {
+		return http.ErrUseLastResponse
+	}
+	apiSet, err := New("http://localhost", WithHTTPClient(&http.Client{CheckRedirect: checkRedirect}))
+	require.Nil(t, err)
+	require.NotNil(t, apiSet.httpClient.CheckRedirect)
+	require.Equal(t, http.ErrUseLastResponse, apiSet.httpClient.CheckRedirect(nil, nil))
+}
@@ -0,0 +1,83 @@
+package v2
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"strings"
+)
+
+// ErrBodyNotReplayable is returned if a request has to be sent again, e.g. after the token has been refreshed
+// or after a transient failure, but its body has already been consumed and cannot be re-created
+var ErrBodyNotReplayable = errors.New("request body cannot be sent again")
+
+// maxInMemoryBodySize is the size up to which request bodies read from an io.Reader are buffered in memory
+// so that they can be sent again, e.g. after the token has been refreshed. Larger bodies are buffered in a temporary file
+const maxInMemoryBodySize = 1 << 20
+
+// newReplayableRequest creates a request whose body can be re-created via GetBody, so that it can be sent again.
+// Bodies of types http.NewRequest already supports are used as they are, while any other io.Reader is read
+// into a buffer, or into a temporary file if it is larger than maxInMemoryBodySize.
+// The returned cleanup function removes the temporary file and must be called once the request is done
+func newReplayableRequest(ctx context.Context, method, uri string, body io.Reader) (*http.Request, func(), error) {
+	noCleanup := func() {}
+	switch body.(type) {
+	case nil, *bytes.Buffer, *bytes.Reader, *strings.Reader:
+		req, err := http.NewRequestWithContext(ctx, method, uri, body)
+		return req, noCleanup, err
+	}
+
+	buf := &bytes.Buffer{}
+	_, err := io.CopyN(buf, body, maxInMemoryBodySize+1)
+	if err == io.EOF {
+		req, err := http.NewRequestWithContext(ctx, method, uri, bytes.NewReader(buf.Bytes()))
+		return req, noCleanup, err
+	}
+	if err != nil {
+		return nil, noCleanup, fmt.Errorf("could not read request body: %w", err)
+	}
+
+	file, err := ioutil.TempFile("", "keptn-request-body-")
+	if err != nil {
+		return nil, noCleanup, fmt.Errorf("could not buffer request body: %w", err)
+	}
+	cleanup := func() {
+		os.Remove(file.Name())
+	}
+	size, err := io.Copy(file, io.MultiReader(buf, body))
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		cleanup()
+		return nil, noCleanup, fmt.Errorf("could not buffer request body: %w", err)
+	}
+
+	getBody := func() (io.ReadCloser, error) {
+		return os.Open(file.Name())
+	}
+	firstBody, err := getBody()
+	if err != nil {
+		cleanup()
+		return nil, noCleanup, fmt.Errorf("could not buffer request body: %w", err)
+	}
+	req, err := http.NewRequestWithContext(ctx, method, uri, firstBody)
+	if err != nil {
+		firstBody.Close()
+		cleanup()
+		return nil, noCleanup, err
+	}
+	req.ContentLength = size
+	req.GetBody = getBody
+	return req, cleanup, nil
+}
+
+// isReplayable returns whether the request can be sent again, i.e. whether it has no body or its body can be re-created via GetBody
+func isReplayable(req *http.Request) bool {
+	return req.Body == nil || req.Body == http.NoBody || req.GetBody != nil
+}
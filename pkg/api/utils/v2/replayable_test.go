@@ -0,0 +1,158 @@
+package v2
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// streamingReader hides the type of the underlying reader, like e.g. a file or a network stream would
+type streamingReader struct {
+	r io.Reader
+}
+
+func (s *streamingReader) Read(p []byte) (int, error) {
+	return s.r.Read(p)
+}
+
+func readReplayedBodies(t *testing.T, req *http.Request, times int) []string {
+	bodies := []string{}
+	body, err := ioutil.ReadAll(req.Body)
+	require.Nil(t, err)
+	require.Nil(t, req.Body.Close())
+	bodies = append(bodies, string(body))
+	for i := 1; i < times; i++ {
+		replayed, err := req.GetBody()
+		require.Nil(t, err)
+		body, err := ioutil.ReadAll(replayed)
+		require.Nil(t, err)
+		require.Nil(t, replayed.Close())
+		bodies = append(bodies, string(body))
+	}
+	return bodies
+}
+
+func TestNewReplayableRequest(t *testing.T) {
+	tests := []struct {
+		name string
+		body []byte
+	}{
+		{name: "empty body", body: []byte{}},
+		{name: "small body", body: []byte(`{"project":"my-project"}`)},
+		{name: "body at threshold", body: bytes.Repeat([]byte("a"), maxInMemoryBodySize)},
+		{name: "body above threshold", body: bytes.Repeat([]byte("a"), maxInMemoryBodySize+10)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req, cleanup, err := newReplayableRequest(context.TODO(), http.MethodPost, "http://keptn.example.com/api", &streamingReader{bytes.NewReader(tt.body)})
+			require.Nil(t, err)
+			defer cleanup()
+
+			require.NotNil(t, req.GetBody)
+			require.Equal(t, int64(len(tt.body)), req.ContentLength)
+			for _, body := range readReplayedBodies(t, req, 3) {
+				require.Equal(t, string(tt.body), body)
+			}
+		})
+	}
+}
+
+func TestNewReplayableRequest_TemporaryFileIsRemoved(t *testing.T) {
+	tmpDir := t.TempDir()
+	t.Setenv("TMPDIR", tmpDir)
+
+	body := bytes.Repeat([]byte("a"), maxInMemoryBodySize+1)
+	req, cleanup, err := newReplayableRequest(context.TODO(), http.MethodPut, "http://keptn.example.com/api", &streamingReader{bytes.NewReader(body)})
+	require.Nil(t, err)
+	readReplayedBodies(t, req, 2)
+
+	files, err := os.ReadDir(tmpDir)
+	require.Nil(t, err)
+	require.Len(t, files, 1)
+
+	cleanup()
+	files, err = os.ReadDir(tmpDir)
+	require.Nil(t, err)
+	require.Empty(t, files)
+}
+
+func TestNewReplayableRequest_KnownBodyTypes(t *testing.T) {
+	req, cleanup, err := newReplayableRequest(context.TODO(), http.MethodPost, "http://keptn.example.com/api", bytes.NewReader([]byte("body")))
+	require.Nil(t, err)
+	defer cleanup()
+	require.Equal(t, []string{"body", "body"}, readReplayedBodies(t, req, 2))
+
+	req, cleanup, err = newReplayableRequest(context.TODO(), http.MethodDelete, "http://keptn.example.com/api", nil)
+	require.Nil(t, err)
+	defer cleanup()
+	require.Nil(t, req.Body)
+}
+
+func TestRefreshingTransport_RetriesStreamedBody(t *testing.T) {
+	server := newTokenCheckingServer("new-token")
+	defer server.Close()
+
+	transport := newRefreshingTransport(http.DefaultTransport, "x-token", "old-token", func(ctx context.Context) (string, error) {
+		return "new-token", nil
+	})
+	body := bytes.Repeat([]byte("a"), maxInMemoryBodySize+1)
+	req, cleanup, err := newReplayableRequest(context.TODO(), http.MethodPost, server.URL, &streamingReader{bytes.NewReader(body)})
+	require.Nil(t, err)
+	defer cleanup()
+
+	resp, err := (&http.Client{Transport: transport}).Do(req)
+	require.Nil(t, err)
+	resp.Body.Close()
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	received := server.received()
+	require.Len(t, received, 2)
+	require.Equal(t, body, received[0].Body)
+	require.Equal(t, body, received[1].Body)
+}
+
+func TestRefreshingTransport_BodyNotReplayable(t *testing.T) {
+	server := newTokenCheckingServer("new-token")
+	defer server.Close()
+
+	transport := newRefreshingTransport(http.DefaultTransport, "x-token", "old-token", func(ctx context.Context) (string, error) {
+		return "new-token", nil
+	})
+	req, err := http.NewRequestWithContext(context.TODO(), http.MethodPost, server.URL, &streamingReader{bytes.NewReader([]byte("body"))})
+	require.Nil(t, err)
+
+	_, err = (&http.Client{Transport: transport}).Do(req)
+	require.ErrorIs(t, err, ErrBodyNotReplayable)
+	require.Len(t, server.received(), 1)
+	// the refreshed token is used by subsequent requests nevertheless
+	require.Equal(t, "new-token", transport.currentToken())
+}
+
+func TestOperationClassTransport_BodyNotReplayable(t *testing.T) {
+	server := newFailingServer(1)
+	defer server.Close()
+
+	transport := newOperationClassTransport(http.DefaultTransport, map[OperationClass]OperationPolicy{
+		WriteOperation: {MaxRetries: 2, InitialBackoff: time.Millisecond},
+	})
+	req, err := http.NewRequestWithContext(context.TODO(), http.MethodPut, server.URL, &streamingReader{bytes.NewReader([]byte("body"))})
+	require.Nil(t, err)
+
+	_, err = (&http.Client{Transport: transport}).Do(req)
+	require.ErrorIs(t, err, ErrBodyNotReplayable)
+	require.Len(t, server.received(), 1)
+
+	// requests which do not have to be retried are not affected
+	req, err = http.NewRequestWithContext(context.TODO(), http.MethodPut, server.URL, &streamingReader{bytes.NewReader([]byte("body"))})
+	require.Nil(t, err)
+	resp, err := (&http.Client{Transport: transport}).Do(req)
+	require.Nil(t, err)
+	resp.Body.Close()
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+}
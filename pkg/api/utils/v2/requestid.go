@@ -0,0 +1,52 @@
+package v2
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/keptn/go-utils/pkg/api/models"
+)
+
+type requestIDContextKey struct{}
+
+// ContextWithRequestID returns a context which makes all requests sent with it use the given request ID,
+// e.g. to propagate the ID of an incoming request to the Keptn API.
+// Without a request ID in the context, a new one is generated for each request
+func ContextWithRequestID(ctx context.Context, requestID string) context.Context {
+	return context.WithValue(ctx, requestIDContextKey{}, requestID)
+}
+
+// RequestIDFromContext returns the request ID stored in the context, or an empty string if there is none
+func RequestIDFromContext(ctx context.Context) string {
+	requestID, _ := ctx.Value(requestIDContextKey{}).(string)
+	return requestID
+}
+
+// ensureRequestID returns a context containing a request ID, which is generated if the given context does not contain one
+func ensureRequestID(ctx context.Context) (context.Context, string) {
+	if requestID := RequestIDFromContext(ctx); requestID != "" {
+		return ctx, requestID
+	}
+	requestID := uuid.New().String()
+	return ContextWithRequestID(ctx, requestID), requestID
+}
+
+// addRequestIDHeader sets the X-Request-ID header of the request to the request ID of its context,
+// or to a newly generated one, and returns the request ID
+func addRequestIDHeader(req *http.Request) string {
+	requestID := RequestIDFromContext(req.Context())
+	if requestID == "" {
+		requestID = uuid.New().String()
+	}
+	req.Header.Set(RequestIDHeader, requestID)
+	return requestID
+}
+
+// withRequestID adds the request ID to the error, so that it can be correlated with the logs of the Keptn API
+func withRequestID(err *models.Error, requestID string) *models.Error {
+	if err != nil {
+		err.RequestID = requestID
+	}
+	return err
+}
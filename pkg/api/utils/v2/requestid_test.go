@@ -0,0 +1,39 @@
+package v2
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestAPISet_RequestID(t *testing.T) {
+	var receivedRequestIDs []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		receivedRequestIDs = append(receivedRequestIDs, r.Header.Get(RequestIDHeader))
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte(`{"message":"internal error"}`))
+	}))
+	defer server.Close()
+
+	apiSet, err := New(server.URL)
+	require.Nil(t, err)
+
+	_, mErr := apiSet.API().GetMetadata(context.TODO(), APIGetMetadataOptions{})
+	require.NotNil(t, mErr)
+	_, mErr = apiSet.Services().CreateServiceInStage(context.TODO(), "my-project", "dev", "carts", ServicesCreateServiceInStageOptions{})
+	require.NotNil(t, mErr)
+
+	require.Len(t, receivedRequestIDs, 2)
+	require.NotEmpty(t, receivedRequestIDs[0])
+	require.NotEqual(t, receivedRequestIDs[0], receivedRequestIDs[1])
+	require.Equal(t, receivedRequestIDs[1], mErr.RequestID)
+
+	ctx := ContextWithRequestID(context.TODO(), "my-request-id")
+	_, mErr = apiSet.API().GetMetadata(ctx, APIGetMetadataOptions{})
+	require.Equal(t, "my-request-id", receivedRequestIDs[2])
+	require.Equal(t, "my-request-id", mErr.RequestID)
+	require.Equal(t, "internal error", mErr.GetMessage())
+}
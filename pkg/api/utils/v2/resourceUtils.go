@@ -3,12 +3,10 @@ package v2
 import (
 	"bytes"
 	"context"
-	"crypto/tls"
 	b64 "encoding/base64"
 	"encoding/json"
 	"errors"
 	"fmt"
-	"io/ioutil"
 	"net/http"
 	"net/url"
 	"strings"
@@ -36,11 +34,38 @@ type ResourcesUpdateProjectResourcesOptions struct{}
 // ResourcesUpdateServiceResourcesOptions are options for ResourcesInterface.UpdateServiceResources().
 type ResourcesUpdateServiceResourcesOptions struct{}
 
+// ResourcesGetAllProjectResourcesOptions are options for ResourceHandler.GetAllProjectResources().
+type ResourcesGetAllProjectResourcesOptions struct {
+	// PageRetries overrides the page retries of the handler for this call
+	PageRetries *PageRetries
+	// MemoryBudget overrides the memory budget of the handler for this call, see WithMemoryBudget.
+	// A negative budget disables the limit
+	MemoryBudget int64
+	// Cursor continues the listing at the given page, e.g. at the cursor of a *BudgetExceededError
+	Cursor models.Cursor
+}
+
 // ResourcesGetAllStageResourcesOptions are options for ResourcesInterface.GetAllStageResources().
-type ResourcesGetAllStageResourcesOptions struct{}
+type ResourcesGetAllStageResourcesOptions struct {
+	// PageRetries overrides the page retries of the handler for this call
+	PageRetries *PageRetries
+	// MemoryBudget overrides the memory budget of the handler for this call, see WithMemoryBudget.
+	// A negative budget disables the limit
+	MemoryBudget int64
+	// Cursor continues the listing at the given page, e.g. at the cursor of a *BudgetExceededError
+	Cursor models.Cursor
+}
 
 // ResourcesGetAllServiceResourcesOptions are options for ResourcesInterface.GetAllServiceResources().
-type ResourcesGetAllServiceResourcesOptions struct{}
+type ResourcesGetAllServiceResourcesOptions struct {
+	// PageRetries overrides the page retries of the handler for this call
+	PageRetries *PageRetries
+	// MemoryBudget overrides the memory budget of the handler for this call, see WithMemoryBudget.
+	// A negative budget disables the limit
+	MemoryBudget int64
+	// Cursor continues the listing at the given page, e.g. at the cursor of a *BudgetExceededError
+	Cursor models.Cursor
+}
 
 // ResourcesGetResourceOptions are options for ResourcesInterface.GetResource().
 type ResourcesGetResourceOptions struct {
@@ -89,6 +114,7 @@ type ResourcesInterface interface {
 	GetResource(ctx context.Context, scope ResourceScope, opts ResourcesGetResourceOptions) (*models.Resource, error)
 
 	// DeleteResource delete a resource from the URI defined by ResourceScope.
+	// ResourceNotFoundError is returned if the resource does not exist.
 	DeleteResource(ctx context.Context, scope ResourceScope, opts ResourcesDeleteResourceOptions) error
 
 	// UpdateResource updates a resource from the URI defined by ResourceScope.
@@ -98,13 +124,17 @@ type ResourcesInterface interface {
 	CreateResource(ctx context.Context, resource []*models.Resource, scope ResourceScope, opts ResourcesCreateResourceOptions) (string, error)
 }
 
-// ResourceHandler handles resources
+// ResourceHandler handles resources.
+// It is safe for concurrent use by multiple goroutines, since it is not modified after it has been created
 type ResourceHandler struct {
-	baseURL    string
-	authToken  string
-	authHeader string
-	httpClient *http.Client
-	scheme     string
+	baseURL      string
+	authToken    string
+	authHeader   string
+	httpClient   *http.Client
+	scheme       string
+	pageRetries  pageRetryPolicy
+	memoryBudget int64
+	errorDetailsSetting
 }
 
 type resourceRequest struct {
@@ -148,22 +178,42 @@ func (s *ResourceScope) Resource(resource string) *ResourceScope {
 	return s
 }
 
+// GetProject returns the project of the resource scope
+func (s *ResourceScope) GetProject() string {
+	return s.project
+}
+
+// GetStage returns the stage of the resource scope, or an empty string if the stage is unset
+func (s *ResourceScope) GetStage() string {
+	return s.stage
+}
+
+// GetService returns the service of the resource scope, or an empty string if the service is unset
+func (s *ResourceScope) GetService() string {
+	return s.service
+}
+
+// GetResource returns the URI of the resource of the resource scope, or an empty string if the resource is unset
+func (s *ResourceScope) GetResource() string {
+	return s.resource
+}
+
 // GetProjectPath returns a string to construct the url to path eg. /<api-version>/project/<project-name>
 //or an empty string if the project is not set
 func (s *ResourceScope) GetProjectPath() string {
-	return buildPath(v1ProjectPath, s.project)
+	return buildPath(v1ProjectPath, EscapeIdentifier(s.project))
 }
 
 // GetStagePath returns a string to construct the url to a stage eg. /stage/<stage-name>
 //or an empty string if the stage is unset
 func (s *ResourceScope) GetStagePath() string {
-	return buildPath(pathToStage, s.stage)
+	return buildPath(pathToStage, EscapeIdentifier(s.stage))
 }
 
 // GetServicePath returns a string to construct the url to a service eg. /service/<service-name>
 //or an empty string if the service is unset
 func (s *ResourceScope) GetServicePath() string {
-	return buildPath(pathToService, url.QueryEscape(s.service))
+	return buildPath(pathToService, EscapeIdentifier(s.service))
 }
 
 // GetResourcePath returns a string to construct the url to a resource eg. /resource/<escaped-resource-name>
@@ -226,13 +276,10 @@ func NewResourceHandlerWithHTTPClient(baseURL string, httpClient *http.Client) *
 }
 
 // NewAuthenticatedResourceHandler returns a new ResourceHandler that authenticates at the api via the provided token
-// and sends all requests directly to the configuration-service
-func NewAuthenticatedResourceHandler(baseURL string, authToken string, authHeader string, httpClient *http.Client, scheme string) *ResourceHandler {
-	baseURL = strings.TrimRight(baseURL, "/")
-	if !strings.HasSuffix(baseURL, configurationServiceBaseURL) {
-		baseURL += "/" + configurationServiceBaseURL
-	}
-
+// and sends all requests directly to the configuration-service.
+// The base path of the service is added to the base URL according to basePathMode (default AppendMissingBasePath)
+func NewAuthenticatedResourceHandler(baseURL string, authToken string, authHeader string, httpClient *http.Client, scheme string, basePathMode ...BasePathMode) *ResourceHandler {
+	baseURL = basePathModeOf(basePathMode).HandlerBaseURL(baseURL, HandlerResources)
 	return createResourceHandler(baseURL, authToken, authHeader, httpClient, scheme)
 }
 
@@ -267,7 +314,7 @@ func (r *ResourceHandler) CreateResources(ctx context.Context, project string, s
 	copiedResources := make([]*models.Resource, len(resources), len(resources))
 	for i, val := range resources {
 		resourceContent := b64.StdEncoding.EncodeToString([]byte(val.ResourceContent))
-		copiedResources[i] = &models.Resource{ResourceURI: val.ResourceURI, ResourceContent: resourceContent}
+		copiedResources[i] = &models.Resource{ResourceURI: val.ResourceURI, ResourceContent: resourceContent, Checksum: ResourceChecksum(val.ResourceContent)}
 	}
 
 	resReq := &resourceRequest{
@@ -278,28 +325,31 @@ func (r *ResourceHandler) CreateResources(ctx context.Context, project string, s
 		return nil, buildErrorResponse(err.Error())
 	}
 
+	if mErr := checkIdentifiers("project", project, "stage", stage, "service", service); mErr != nil {
+		return nil, mErr
+	}
 	if project != "" && stage != "" && service != "" {
-		return postWithEventContext(ctx, r.scheme+"://"+r.baseURL+v1ProjectPath+"/"+project+pathToStage+"/"+stage+pathToService+"/"+service+pathToResource, requestStr, r)
+		return postWithEventContext(ctx, r.scheme+"://"+r.baseURL+v1ProjectPath+"/"+EscapeIdentifier(project)+pathToStage+"/"+EscapeIdentifier(stage)+pathToService+"/"+EscapeIdentifier(service)+pathToResource, requestStr, r)
 	} else if project != "" && stage != "" && service == "" {
-		return postWithEventContext(ctx, r.scheme+"://"+r.baseURL+v1ProjectPath+"/"+project+pathToStage+"/"+stage+pathToResource, requestStr, r)
+		return postWithEventContext(ctx, r.scheme+"://"+r.baseURL+v1ProjectPath+"/"+EscapeIdentifier(project)+pathToStage+"/"+EscapeIdentifier(stage)+pathToResource, requestStr, r)
 	} else {
-		return postWithEventContext(ctx, r.scheme+"://"+r.baseURL+v1ProjectPath+"/"+project+"/"+pathToResource, requestStr, r)
+		return postWithEventContext(ctx, r.scheme+"://"+r.baseURL+v1ProjectPath+"/"+EscapeIdentifier(project)+pathToResource, requestStr, r)
 	}
 }
 
 // CreateProjectResources creates multiple project resources.
 func (r *ResourceHandler) CreateProjectResources(ctx context.Context, project string, resources []*models.Resource, opts ResourcesCreateProjectResourcesOptions) (string, error) {
-	return r.CreateResourcesByURI(ctx, r.scheme+"://"+r.baseURL+v1ProjectPath+"/"+project+pathToResource, resources)
+	return r.CreateResourcesByURI(ctx, r.scheme+"://"+r.baseURL+v1ProjectPath+"/"+EscapeIdentifier(project)+pathToResource, resources)
 }
 
 // UpdateProjectResources updates multiple project resources.
 func (r *ResourceHandler) UpdateProjectResources(ctx context.Context, project string, resources []*models.Resource, opts ResourcesUpdateProjectResourcesOptions) (string, error) {
-	return r.UpdateResourcesByURI(ctx, r.scheme+"://"+r.baseURL+v1ProjectPath+"/"+project+pathToResource, resources)
+	return r.UpdateResourcesByURI(ctx, r.scheme+"://"+r.baseURL+v1ProjectPath+"/"+EscapeIdentifier(project)+pathToResource, resources)
 }
 
 // UpdateServiceResources updates multiple service resources.
 func (r *ResourceHandler) UpdateServiceResources(ctx context.Context, project string, stage string, service string, resources []*models.Resource, opts ResourcesUpdateServiceResourcesOptions) (string, error) {
-	return r.UpdateResourcesByURI(ctx, r.scheme+"://"+r.baseURL+v1ProjectPath+"/"+project+pathToStage+"/"+stage+pathToService+"/"+url.QueryEscape(service)+pathToResource, resources)
+	return r.UpdateResourcesByURI(ctx, r.scheme+"://"+r.baseURL+v1ProjectPath+"/"+EscapeIdentifier(project)+pathToStage+"/"+EscapeIdentifier(stage)+pathToService+"/"+EscapeIdentifier(service)+pathToResource, resources)
 }
 
 func (r *ResourceHandler) CreateResourcesByURI(ctx context.Context, uri string, resources []*models.Resource) (string, error) {
@@ -314,7 +364,7 @@ func (r *ResourceHandler) writeResources(ctx context.Context, uri string, method
 
 	copiedResources := make([]*models.Resource, len(resources), len(resources))
 	for i, val := range resources {
-		copiedResources[i] = &models.Resource{ResourceURI: val.ResourceURI, ResourceContent: b64.StdEncoding.EncodeToString([]byte(val.ResourceContent))}
+		copiedResources[i] = &models.Resource{ResourceURI: val.ResourceURI, ResourceContent: b64.StdEncoding.EncodeToString([]byte(val.ResourceContent)), Checksum: ResourceChecksum(val.ResourceContent)}
 	}
 	resReq := &resourceRequest{
 		Resources: copiedResources,
@@ -330,20 +380,21 @@ func (r *ResourceHandler) writeResources(ctx context.Context, uri string, method
 	}
 	req.Header.Set("Content-Type", "application/json")
 	addAuthHeader(req, r)
+	addRequestIDHeader(req)
 
 	resp, err := r.httpClient.Do(req)
 	if err != nil {
-		return "", err
+		return "", ClassifyNetworkError(err)
 	}
 	defer resp.Body.Close()
 
 	version := &models.Version{}
-	body, err := ioutil.ReadAll(resp.Body)
+	body, err := readBody(resp.Body)
 	if err != nil {
 		return "", err
 	}
 	if !(resp.StatusCode >= 200 && resp.StatusCode < 300) {
-		return "", errors.New(string(body))
+		return "", errors.New(responseBodyMessage(resp.StatusCode, body, r.getErrorDetails()))
 	}
 
 	if err = version.FromJSON(body); err != nil {
@@ -359,7 +410,8 @@ func (r *ResourceHandler) UpdateResourceByURI(ctx context.Context, uri string, r
 
 func (r *ResourceHandler) writeResource(ctx context.Context, uri string, method string, resource *models.Resource) (string, error) {
 
-	copiedResource := &models.Resource{ResourceURI: resource.ResourceURI, ResourceContent: b64.StdEncoding.EncodeToString([]byte(resource.ResourceContent))}
+	checksum := ResourceChecksum(resource.ResourceContent)
+	copiedResource := &models.Resource{ResourceURI: resource.ResourceURI, ResourceContent: b64.StdEncoding.EncodeToString([]byte(resource.ResourceContent)), Checksum: checksum}
 
 	resourceStr, err := copiedResource.ToJSON()
 	if err != nil {
@@ -370,21 +422,27 @@ func (r *ResourceHandler) writeResource(ctx context.Context, uri string, method
 		return "", err
 	}
 	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(ResourceChecksumHeader, checksum)
 	addAuthHeader(req, r)
+	addRequestIDHeader(req)
 
 	resp, err := r.httpClient.Do(req)
 	if err != nil {
-		return "", err
+		return "", ClassifyNetworkError(err)
 	}
 	defer resp.Body.Close()
 
-	body, err := ioutil.ReadAll(resp.Body)
+	body, err := readBody(resp.Body)
 	if err != nil {
 		return "", err
 	}
 
 	if !(resp.StatusCode >= 200 && resp.StatusCode < 300) {
-		return "", errors.New(string(body))
+		return "", errors.New(responseBodyMessage(resp.StatusCode, body, r.getErrorDetails()))
+	}
+
+	if received := resp.Header.Get(ResourceChecksumHeader); received != "" && !strings.EqualFold(received, checksum) {
+		return "", newResourceIntegrityError(resource.ResourceURI, checksum, received)
 	}
 
 	version := &models.Version{}
@@ -402,6 +460,7 @@ func (r *ResourceHandler) GetResource(ctx context.Context, scope ResourceScope,
 }
 
 //DeleteResource delete a resource from the URI defined by ResourceScope.
+// ResourceNotFoundError is returned if the resource does not exist.
 func (r *ResourceHandler) DeleteResource(ctx context.Context, scope ResourceScope, opts ResourcesDeleteResourceOptions) error {
 	buildURI := r.buildResourceURI(scope)
 	return r.DeleteResourceByURI(ctx, r.applyOptions(buildURI, opts.URIOptions))
@@ -420,8 +479,8 @@ func (r *ResourceHandler) CreateResource(ctx context.Context, resource []*models
 }
 
 func (r *ResourceHandler) GetResourceByURI(ctx context.Context, uri string) (*models.Resource, error) {
-	http.DefaultTransport.(*http.Transport).TLSClientConfig = &tls.Config{InsecureSkipVerify: true}
-	body, statusCode, status, mErr := get(ctx, uri, r)
+	skipDefaultTransportVerification()
+	body, statusCode, status, header, mErr := get(ctx, uri, r)
 	if mErr != nil {
 		return nil, mErr.ToError()
 	}
@@ -432,7 +491,7 @@ func (r *ResourceHandler) GetResourceByURI(ctx context.Context, uri string) (*mo
 	}
 	if !(statusCode >= 200 && statusCode < 300) {
 		if len(body) > 0 {
-			return nil, handleErrStatusCode(statusCode, body).ToError()
+			return nil, handleErrStatusCode(statusCode, header, body, r.getErrorDetails()).ToError()
 		}
 
 		return nil, buildErrorResponse(fmt.Sprintf("Received unexpected response: %d %s", statusCode, status)).ToError()
@@ -450,79 +509,137 @@ func (r *ResourceHandler) GetResourceByURI(ctx context.Context, uri string) (*mo
 	}
 	resource.ResourceContent = string(decodedStr)
 
+	if err := verifyResourceChecksum(resource.ResourceURI, resource.ResourceContent, resource.Checksum); err != nil {
+		return nil, err
+	}
+
 	return resource, nil
 }
 
 func (r *ResourceHandler) DeleteResourceByURI(ctx context.Context, uri string) error {
-	http.DefaultTransport.(*http.Transport).TLSClientConfig = &tls.Config{InsecureSkipVerify: true}
+	skipDefaultTransportVerification()
 	req, err := http.NewRequestWithContext(ctx, "DELETE", uri, nil)
 	if err != nil {
 		return err
 	}
 	req.Header.Set("Content-Type", "application/json")
 	addAuthHeader(req, r)
+	addRequestIDHeader(req)
 
 	resp, err := r.httpClient.Do(req)
 	if err != nil {
-		return err
+		return ClassifyNetworkError(err)
 	}
 	defer resp.Body.Close()
 
+	body, err := readBody(resp.Body)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode == http.StatusNotFound {
+		return ResourceNotFoundError
+	}
+	if !(resp.StatusCode >= 200 && resp.StatusCode < 300) {
+		return errors.New(responseBodyMessage(resp.StatusCode, body, r.getErrorDetails()))
+	}
 	return nil
 }
 
+// GetAllProjectResources returns a list of all resources of the project itself, i.e. not of its stages and services.
+// If the memory budget is exceeded, the resources retrieved so far are returned along with a *BudgetExceededError
+func (r *ResourceHandler) GetAllProjectResources(ctx context.Context, project string, opts ResourcesGetAllProjectResourcesOptions) ([]*models.Resource, error) {
+	myURL, err := url.Parse(r.scheme + "://" + r.getBaseURL() + v1ProjectPath + "/" + EscapeIdentifier(project) + pathToResource)
+	if err != nil {
+		return nil, err
+	}
+	return r.getAllResources(ctx, myURL, r.pageRetries.override(opts.PageRetries), newMemoryBudget(r.memoryBudget, opts.MemoryBudget), opts.Cursor)
+}
+
 // GetAllStageResources returns a list of all resources.
+// If the memory budget is exceeded, the resources retrieved so far are returned along with a *BudgetExceededError
 func (r *ResourceHandler) GetAllStageResources(ctx context.Context, project string, stage string, opts ResourcesGetAllStageResourcesOptions) ([]*models.Resource, error) {
-	myURL, err := url.Parse(r.scheme + "://" + r.getBaseURL() + v1ProjectPath + "/" + project + pathToStage + "/" + stage + pathToResource)
+	myURL, err := url.Parse(r.scheme + "://" + r.getBaseURL() + v1ProjectPath + "/" + EscapeIdentifier(project) + pathToStage + "/" + EscapeIdentifier(stage) + pathToResource)
 	if err != nil {
 		return nil, err
 	}
-	return r.getAllResources(ctx, myURL)
+	return r.getAllResources(ctx, myURL, r.pageRetries.override(opts.PageRetries), newMemoryBudget(r.memoryBudget, opts.MemoryBudget), opts.Cursor)
 }
 
 // GetAllServiceResources returns a list of all resources.
+// If the memory budget is exceeded, the resources retrieved so far are returned along with a *BudgetExceededError
 func (r *ResourceHandler) GetAllServiceResources(ctx context.Context, project string, stage string, service string, opts ResourcesGetAllServiceResourcesOptions) ([]*models.Resource, error) {
-	myURL, err := url.Parse(r.scheme + "://" + r.getBaseURL() + v1ProjectPath + "/" + project + pathToStage + "/" + stage +
-		pathToService + "/" + service + pathToResource)
+	myURL, err := url.Parse(r.scheme + "://" + r.getBaseURL() + v1ProjectPath + "/" + EscapeIdentifier(project) + pathToStage + "/" + EscapeIdentifier(stage) +
+		pathToService + "/" + EscapeIdentifier(service) + pathToResource)
 	if err != nil {
 		return nil, err
 	}
-	return r.getAllResources(ctx, myURL)
+	return r.getAllResources(ctx, myURL, r.pageRetries.override(opts.PageRetries), newMemoryBudget(r.memoryBudget, opts.MemoryBudget), opts.Cursor)
 }
 
-func (r *ResourceHandler) getAllResources(ctx context.Context, u *url.URL) ([]*models.Resource, error) {
-
-	http.DefaultTransport.(*http.Transport).TLSClientConfig = &tls.Config{InsecureSkipVerify: true}
+func (r *ResourceHandler) getAllResources(ctx context.Context, u *url.URL, pageRetries pageRetryPolicy, budget *memoryBudget, cursor models.Cursor) ([]*models.Resource, error) {
 	resources := []*models.Resource{}
+	err := r.forEachResourcePage(ctx, u, pageRetries, budget, cursor, func(page []*models.Resource) error {
+		resources = append(resources, page...)
+		return nil
+	})
+	var budgetErr *BudgetExceededError
+	if errors.As(err, &budgetErr) {
+		return resources, err
+	}
+	if err != nil {
+		return nil, err
+	}
+	return resources, nil
+}
+
+// forEachResourcePage calls fn with the resources of each page of the listing, starting at the given cursor,
+// so that large listings need not be kept in memory. It returns a *BudgetExceededError before a page exceeding the budget
+func (r *ResourceHandler) forEachResourcePage(ctx context.Context, u *url.URL, pageRetries pageRetryPolicy, budget *memoryBudget, cursor models.Cursor, fn func(page []*models.Resource) error) error {
 
-	nextPageKey := ""
+	skipDefaultTransportVerification()
 
 	for {
-		if nextPageKey != "" {
+		if !budget.admitsNextPage() {
+			return budget.exceeded(cursor)
+		}
+		if nextPageKey := cursor.Encode(); nextPageKey != "" {
 			q := u.Query()
 			q.Set("nextPageKey", nextPageKey)
 			u.RawQuery = q.Encode()
 		}
 
-		body, mErr := getAndExpectOK(ctx, u.String(), r)
+		body, mErr := pageRetries.getPage(ctx, u.String(), r)
 		if mErr != nil {
-			return nil, mErr.ToError()
+			return mErr.ToError()
 		}
 
 		received := &models.Resources{}
 		if err := received.FromJSON(body); err != nil {
-			return nil, err
+			return err
+		}
+		if !budget.add(len(received.Resources), len(body)) {
+			return budget.exceeded(cursor)
+		}
+		for _, resource := range received.Resources {
+			if err := verifyListedResourceChecksum(resource); err != nil {
+				return err
+			}
 		}
 
-		resources = append(resources, received.Resources...)
+		if err := fn(received.Resources); err != nil {
+			return err
+		}
 
-		if received.NextPageKey == "" || received.NextPageKey == "0" {
+		var err error
+		if cursor, err = received.Cursor(); err != nil {
+			return err
+		}
+		if cursor.IsEnd() {
 			break
 		}
-		nextPageKey = received.NextPageKey
 	}
 
-	return resources, nil
+	return nil
 }
 
 func buildPath(base, name string) string {
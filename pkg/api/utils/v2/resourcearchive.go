@@ -0,0 +1,74 @@
+package v2
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/keptn/go-utils/pkg/api/models"
+)
+
+// ResourcesGetAllResourcesAsArchiveOptions are options for APISet.GetAllResourcesAsArchive().
+type ResourcesGetAllResourcesAsArchiveOptions struct {
+	// PageRetries overrides the page retries of the handler for this call
+	PageRetries *PageRetries
+}
+
+// GetAllResourcesAsArchive writes all resources of the scope, i.e. of a project, a stage or a service, to w as tar.gz archive,
+// e.g. to attach the configuration of a service to a support ticket. The entries of the archive are named by the URIs of the resources.
+// The resources are listed page by page and written to the archive one by one, so that only a single resource is kept in memory.
+// The scope must not contain a resource. If an error occurs, the archive written to w so far is incomplete
+func (c *APISet) GetAllResourcesAsArchive(ctx context.Context, scope ResourceScope, w io.Writer, opts ResourcesGetAllResourcesAsArchiveOptions) error {
+	if scope.GetProject() == "" {
+		return errors.New("the scope of the archive must contain a project")
+	}
+	if scope.GetResource() != "" {
+		return fmt.Errorf("the scope of the archive must not contain a resource, but contains %s", scope.GetResource())
+	}
+	r := c.resourceHandler
+	u, err := url.Parse(r.buildResourceURI(scope))
+	if err != nil {
+		return err
+	}
+
+	gzipWriter := gzip.NewWriter(w)
+	tarWriter := tar.NewWriter(gzipWriter)
+	modTime := time.Now()
+	err = r.forEachResourcePage(ctx, u, r.pageRetries.override(opts.PageRetries), newMemoryBudget(0, 0), models.Cursor{}, func(page []*models.Resource) error {
+		for _, listed := range page {
+			if listed == nil || listed.ResourceURI == nil {
+				continue
+			}
+			resource, err := r.GetResource(ctx, *withResource(scope, *listed.ResourceURI), ResourcesGetResourceOptions{})
+			if err != nil {
+				return fmt.Errorf("could not retrieve resource %s: %w", *listed.ResourceURI, err)
+			}
+			header := &tar.Header{
+				Name:    strings.TrimPrefix(*listed.ResourceURI, "/"),
+				Mode:    0644,
+				Size:    int64(len(resource.ResourceContent)),
+				ModTime: modTime,
+			}
+			if err := tarWriter.WriteHeader(header); err != nil {
+				return err
+			}
+			if _, err := io.WriteString(tarWriter, resource.ResourceContent); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	if err := tarWriter.Close(); err != nil {
+		return err
+	}
+	return gzipWriter.Close()
+}
@@ -0,0 +1,68 @@
+package v2
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/base64"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestAPISet_GetAllResourcesAsArchive(t *testing.T) {
+	resources := map[string]string{"/slo.yaml": "slo", "/helm/carts.tgz": "chart", "/sli.yaml": "sli"}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.True(t, strings.HasPrefix(r.URL.Path, "/configuration-service/v1/project/sockshop/stage/dev/service/carts/resource"))
+		if strings.HasSuffix(r.URL.Path, "/resource") {
+			// two pages
+			if r.URL.Query().Get("nextPageKey") == "" {
+				w.Write([]byte(`{"resources":[{"resourceURI":"/slo.yaml"},{"resourceURI":"/helm/carts.tgz"}],"nextPageKey":"2"}`))
+				return
+			}
+			w.Write([]byte(`{"resources":[{"resourceURI":"/sli.yaml"}]}`))
+			return
+		}
+		uri := strings.SplitN(r.URL.Path, "/resource/", 2)[1]
+		w.Write([]byte(`{"resourceURI":"` + uri + `","resourceContent":"` + base64.StdEncoding.EncodeToString([]byte(resources[uri])) + `"}`))
+	}))
+	defer server.Close()
+	apiSet, err := New(server.URL)
+	require.Nil(t, err)
+
+	archive := &bytes.Buffer{}
+	scope := NewResourceScope().Project("sockshop").Stage("dev").Service("carts")
+	require.Nil(t, apiSet.GetAllResourcesAsArchive(context.TODO(), *scope, archive, ResourcesGetAllResourcesAsArchiveOptions{}))
+
+	gzipReader, err := gzip.NewReader(archive)
+	require.Nil(t, err)
+	tarReader := tar.NewReader(gzipReader)
+	entries := map[string]string{}
+	names := []string{}
+	for {
+		header, err := tarReader.Next()
+		if err == io.EOF {
+			break
+		}
+		require.Nil(t, err)
+		content, err := ioutil.ReadAll(tarReader)
+		require.Nil(t, err)
+		entries[header.Name] = string(content)
+		names = append(names, header.Name)
+	}
+	require.Equal(t, []string{"slo.yaml", "helm/carts.tgz", "sli.yaml"}, names)
+	require.Equal(t, map[string]string{"slo.yaml": "slo", "helm/carts.tgz": "chart", "sli.yaml": "sli"}, entries)
+}
+
+func TestAPISet_GetAllResourcesAsArchiveInvalidScope(t *testing.T) {
+	apiSet, err := New("http://localhost")
+	require.Nil(t, err)
+	require.NotNil(t, apiSet.GetAllResourcesAsArchive(context.TODO(), *NewResourceScope(), &bytes.Buffer{}, ResourcesGetAllResourcesAsArchiveOptions{}))
+	require.NotNil(t, apiSet.GetAllResourcesAsArchive(context.TODO(), *NewResourceScope().Project("sockshop").Resource("slo.yaml"), &bytes.Buffer{}, ResourcesGetAllResourcesAsArchiveOptions{}))
+}
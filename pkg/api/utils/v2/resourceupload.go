@@ -0,0 +1,301 @@
+package v2
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"hash"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/keptn/go-utils/pkg/api/models"
+)
+
+const (
+	resourceChunkManifestSuffix = ".chunks.json"
+	resourceChunkSuffix         = ".chunks/"
+)
+
+// ResourceUploadProgress is the progress of an upload via APISet.UploadResource
+type ResourceUploadProgress struct {
+	// Bytes is the number of bytes of the content read so far
+	Bytes int64
+	// Chunks is the number of chunks uploaded so far, including chunks skipped when resuming an upload.
+	// It is always 0 if the content is not uploaded in chunks
+	Chunks int
+	// SkippedChunks is the number of chunks which had been uploaded before and were not uploaded again
+	SkippedChunks int
+}
+
+// ResourceUploadProgressFunc is called while a resource is uploaded, e.g. to render a progress bar.
+// It is called on the goroutine of the upload, or on the goroutine encoding the content if it is not chunked
+type ResourceUploadProgressFunc func(progress ResourceUploadProgress)
+
+// report calls the ResourceUploadProgressFunc, if set
+func (f ResourceUploadProgressFunc) report(progress ResourceUploadProgress) {
+	if f != nil {
+		f(progress)
+	}
+}
+
+// ResourcesUploadResourceOptions are options for APISet.UploadResource().
+type ResourcesUploadResourceOptions struct {
+	// ChunkSize is the maximum size of a chunk in bytes. If it is positive, the content is uploaded in chunks,
+	// see APISet.UploadResource. Otherwise, it is sent to the Keptn API in a single request
+	ChunkSize int
+	// Resume skips the chunks uploaded by a previous, interrupted upload of the same content with the same ChunkSize
+	Resume bool
+	// OnProgress is called after each chunk, or after each read of the content if it is not uploaded in chunks
+	OnProgress ResourceUploadProgressFunc
+}
+
+// ResourcesDownloadResourceOptions are options for APISet.DownloadResource().
+type ResourcesDownloadResourceOptions struct{}
+
+// ResourceChunkManifest describes a resource uploaded in chunks. It is stored as resource
+// next to the chunks, at the URI of the resource with the suffix ".chunks.json"
+type ResourceChunkManifest struct {
+	ResourceURI string `json:"resourceURI"`
+	ChunkSize   int    `json:"chunkSize"`
+	// Size and Checksum are the size and checksum of the reassembled content. They are set once all chunks are uploaded
+	Size     int64           `json:"size,omitempty"`
+	Checksum string          `json:"checksum,omitempty"`
+	Chunks   []ResourceChunk `json:"chunks"`
+	Complete bool            `json:"complete"`
+}
+
+// ResourceChunk is a chunk of a resource uploaded in chunks
+type ResourceChunk struct {
+	ResourceURI string `json:"resourceURI"`
+	Size        int    `json:"size"`
+	Checksum    string `json:"checksum"`
+}
+
+// UploadResource uploads the content of a large resource, e.g. a test result archive, without holding the whole content in memory.
+// The scope must contain the URI of the resource.
+// By default, the content is base64 encoded and sent to the Keptn API in a single request. Large content is buffered
+// in a temporary file instead of in memory, so that the request can be sent again, e.g. after the token has been refreshed.
+// If ResourcesUploadResourceOptions.ChunkSize is set, the content is split into chunks which are uploaded one after the other
+// as separate resources, next to a ResourceChunkManifest recording the uploaded chunks. The manifest is updated after each chunk,
+// so that an interrupted upload can be resumed via ResourcesUploadResourceOptions.Resume. Resources uploaded in chunks
+// are read via APISet.DownloadResource, which reassembles the content. It returns the version of the last written resource
+func (c *APISet) UploadResource(ctx context.Context, scope ResourceScope, content io.Reader, opts ResourcesUploadResourceOptions) (string, error) {
+	if scope.GetResource() == "" {
+		return "", errors.New("the URI of the uploaded resource must be specified")
+	}
+	if opts.ChunkSize > 0 {
+		return c.resourceHandler.uploadChunks(ctx, scope, content, opts)
+	}
+	return c.resourceHandler.uploadStream(ctx, scope, content, opts.OnProgress)
+}
+
+// DownloadResource writes the content of the resource of the scope to w. Resources uploaded in chunks via APISet.UploadResource
+// are reassembled chunk by chunk and verified against the checksum of their manifest
+func (c *APISet) DownloadResource(ctx context.Context, scope ResourceScope, w io.Writer, opts ResourcesDownloadResourceOptions) error {
+	r := c.resourceHandler
+	manifest, err := r.getChunkManifest(ctx, scope)
+	if errors.Is(err, ResourceNotFoundError) {
+		resource, err := r.GetResource(ctx, scope, ResourcesGetResourceOptions{})
+		if err != nil {
+			return err
+		}
+		_, err = io.WriteString(w, resource.ResourceContent)
+		return err
+	}
+	if err != nil {
+		return err
+	}
+	if !manifest.Complete {
+		return fmt.Errorf("upload of resource %s in chunks is incomplete", scope.GetResource())
+	}
+	digest := sha256.New()
+	var size int64
+	for _, chunk := range manifest.Chunks {
+		resource, err := r.GetResource(ctx, *withResource(scope, chunk.ResourceURI), ResourcesGetResourceOptions{})
+		if err != nil {
+			return fmt.Errorf("could not retrieve chunk %s: %w", chunk.ResourceURI, err)
+		}
+		digest.Write([]byte(resource.ResourceContent))
+		size += int64(len(resource.ResourceContent))
+		if _, err := io.WriteString(w, resource.ResourceContent); err != nil {
+			return err
+		}
+	}
+	if actual := checksumOf(digest); size != manifest.Size || !strings.EqualFold(actual, manifest.Checksum) {
+		return newResourceIntegrityError(&manifest.ResourceURI, manifest.Checksum, actual)
+	}
+	return nil
+}
+
+// uploadChunks uploads the content in chunks, see APISet.UploadResource
+func (r *ResourceHandler) uploadChunks(ctx context.Context, scope ResourceScope, content io.Reader, opts ResourcesUploadResourceOptions) (string, error) {
+	previous := &ResourceChunkManifest{}
+	if opts.Resume {
+		var err error
+		if previous, err = r.getChunkManifest(ctx, scope); errors.Is(err, ResourceNotFoundError) {
+			previous = &ResourceChunkManifest{}
+		} else if err != nil {
+			return "", err
+		}
+		if previous.ChunkSize != opts.ChunkSize {
+			previous = &ResourceChunkManifest{}
+		}
+	}
+
+	manifest := &ResourceChunkManifest{ResourceURI: scope.GetResource(), ChunkSize: opts.ChunkSize, Chunks: []ResourceChunk{}}
+	progress := ResourceUploadProgress{}
+	digest := sha256.New()
+	buf := make([]byte, opts.ChunkSize)
+	for {
+		n, err := io.ReadFull(content, buf)
+		if err == io.EOF {
+			break
+		}
+		if err != nil && err != io.ErrUnexpectedEOF {
+			return "", fmt.Errorf("could not read content of resource %s: %w", scope.GetResource(), err)
+		}
+		data := string(buf[:n])
+		digest.Write(buf[:n])
+		index := len(manifest.Chunks)
+		chunk := ResourceChunk{
+			ResourceURI: fmt.Sprintf("%s%s%05d", scope.GetResource(), resourceChunkSuffix, index),
+			Size:        n,
+			Checksum:    ResourceChecksum(data),
+		}
+		manifest.Chunks = append(manifest.Chunks, chunk)
+		progress.Bytes += int64(n)
+		progress.Chunks++
+		if index < len(previous.Chunks) && previous.Chunks[index] == chunk {
+			progress.SkippedChunks++
+			opts.OnProgress.report(progress)
+			continue
+		}
+		chunkURI := chunk.ResourceURI
+		if _, err := r.writeResources(ctx, r.buildResourceURI(*withResource(scope, "")), http.MethodPut, []*models.Resource{{ResourceURI: &chunkURI, ResourceContent: data}}); err != nil {
+			return "", fmt.Errorf("could not upload chunk %s: %w", chunk.ResourceURI, err)
+		}
+		// the manifest records the uploaded chunks, so that the upload can be resumed from here
+		if _, err := r.writeChunkManifest(ctx, scope, manifest); err != nil {
+			return "", err
+		}
+		opts.OnProgress.report(progress)
+	}
+	manifest.Size = progress.Bytes
+	manifest.Checksum = checksumOf(digest)
+	manifest.Complete = true
+	return r.writeChunkManifest(ctx, scope, manifest)
+}
+
+func (r *ResourceHandler) getChunkManifest(ctx context.Context, scope ResourceScope) (*ResourceChunkManifest, error) {
+	resource, err := r.GetResource(ctx, *withResource(scope, scope.GetResource()+resourceChunkManifestSuffix), ResourcesGetResourceOptions{})
+	if err != nil {
+		return nil, err
+	}
+	manifest := &ResourceChunkManifest{}
+	if err := json.Unmarshal([]byte(resource.ResourceContent), manifest); err != nil {
+		return nil, fmt.Errorf("could not decode chunk manifest of resource %s: %w", scope.GetResource(), err)
+	}
+	return manifest, nil
+}
+
+func (r *ResourceHandler) writeChunkManifest(ctx context.Context, scope ResourceScope, manifest *ResourceChunkManifest) (string, error) {
+	data, err := json.Marshal(manifest)
+	if err != nil {
+		return "", err
+	}
+	manifestURI := scope.GetResource() + resourceChunkManifestSuffix
+	version, err := r.writeResources(ctx, r.buildResourceURI(*withResource(scope, "")), http.MethodPut, []*models.Resource{{ResourceURI: &manifestURI, ResourceContent: string(data)}})
+	if err != nil {
+		return "", fmt.Errorf("could not upload chunk manifest of resource %s: %w", scope.GetResource(), err)
+	}
+	return version, nil
+}
+
+// uploadStream sends the content in a single request. The encoded body is buffered in memory, or in a temporary file
+// if it is large, so that the request can be sent again, e.g. after the token has been refreshed or after a transient failure
+func (r *ResourceHandler) uploadStream(ctx context.Context, scope ResourceScope, content io.Reader, onProgress ResourceUploadProgressFunc) (string, error) {
+	resourceURI, err := json.Marshal(scope.GetResource())
+	if err != nil {
+		return "", err
+	}
+	body, writer := io.Pipe()
+	go func() {
+		writer.CloseWithError(writeResourceStream(writer, resourceURI, &progressReader{reader: content, onProgress: onProgress}))
+	}()
+	// closing the body stops the goroutine if the request fails before the content has been read
+	defer body.Close()
+
+	req, cleanup, err := newReplayableRequest(ctx, http.MethodPut, r.buildResourceURI(*withResource(scope, "")), body)
+	if err != nil {
+		return "", err
+	}
+	defer cleanup()
+	req.Header.Set("Content-Type", "application/json")
+	addAuthHeader(req, r)
+	addRequestIDHeader(req)
+
+	resp, err := r.httpClient.Do(req)
+	if err != nil {
+		return "", ClassifyNetworkError(err)
+	}
+	defer resp.Body.Close()
+
+	respBody, err := readBody(resp.Body)
+	if err != nil {
+		return "", err
+	}
+	if !(resp.StatusCode >= 200 && resp.StatusCode < 300) {
+		return "", errors.New(responseBodyMessage(resp.StatusCode, respBody, r.getErrorDetails()))
+	}
+	version := &models.Version{}
+	if err = version.FromJSON(respBody); err != nil {
+		return "", err
+	}
+	return version.Version, nil
+}
+
+// writeResourceStream writes the request body of uploadStream, i.e. a resource list containing the base64 encoded content
+func writeResourceStream(w io.Writer, resourceURI []byte, content io.Reader) error {
+	if _, err := io.WriteString(w, `{"resources":[{"resourceURI":`+string(resourceURI)+`,"resourceContent":"`); err != nil {
+		return err
+	}
+	encoder := base64.NewEncoder(base64.StdEncoding, w)
+	if _, err := io.Copy(encoder, content); err != nil {
+		return err
+	}
+	if err := encoder.Close(); err != nil {
+		return err
+	}
+	_, err := io.WriteString(w, `"}]}`)
+	return err
+}
+
+// progressReader reports the number of bytes read from the underlying reader
+type progressReader struct {
+	reader     io.Reader
+	onProgress ResourceUploadProgressFunc
+	bytes      int64
+}
+
+func (p *progressReader) Read(b []byte) (int, error) {
+	n, err := p.reader.Read(b)
+	if n > 0 {
+		p.bytes += int64(n)
+		p.onProgress.report(ResourceUploadProgress{Bytes: p.bytes})
+	}
+	return n, err
+}
+
+// withResource returns a copy of the scope with the given resource URI
+func withResource(scope ResourceScope, resource string) *ResourceScope {
+	return scope.Resource(resource)
+}
+
+func checksumOf(digest hash.Hash) string {
+	return sha256ChecksumPrefix + hex.EncodeToString(digest.Sum(nil))
+}
@@ -0,0 +1,174 @@
+package v2
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// resourceStoreServer is a fake configuration-service keeping the resources of a project in memory
+type resourceStoreServer struct {
+	*recordingServer
+	mtx       sync.Mutex
+	resources map[string]string
+	written   []string
+	// failWrite fails the next write of the resource with the given URI
+	failWrite string
+}
+
+func newResourceStoreServer(t *testing.T) *resourceStoreServer {
+	store := &resourceStoreServer{resources: map[string]string{}}
+	store.recordingServer = newRecordingServer(func(w http.ResponseWriter, r *http.Request) {
+		store.mtx.Lock()
+		defer store.mtx.Unlock()
+		switch {
+		case r.Method == http.MethodPut && strings.HasSuffix(r.URL.Path, "/project/sockshop/resource"):
+			received := resourceRequest{}
+			require.Nil(t, json.NewDecoder(r.Body).Decode(&received))
+			for _, resource := range received.Resources {
+				if *resource.ResourceURI == store.failWrite {
+					store.failWrite = ""
+					w.WriteHeader(http.StatusInternalServerError)
+					return
+				}
+				content, err := base64.StdEncoding.DecodeString(resource.ResourceContent)
+				require.Nil(t, err)
+				store.resources[*resource.ResourceURI] = string(content)
+				store.written = append(store.written, *resource.ResourceURI)
+			}
+			w.Write([]byte(`{"version":"v1"}`))
+		case r.Method == http.MethodGet && strings.Contains(r.URL.Path, "/project/sockshop/resource/"):
+			uri := strings.SplitN(r.URL.Path, "/resource/", 2)[1]
+			content, ok := store.resources[uri]
+			if !ok {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			w.Write([]byte(`{"resourceURI":"` + uri + `","resourceContent":"` + base64.StdEncoding.EncodeToString([]byte(content)) + `"}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+	return store
+}
+
+func TestAPISet_UploadResourceStream(t *testing.T) {
+	store := newResourceStoreServer(t)
+	defer store.Close()
+	apiSet, err := New(store.URL)
+	require.Nil(t, err)
+
+	content := strings.Repeat("test results ", 10000)
+	progress := []ResourceUploadProgress{}
+	scope := NewResourceScope().Project("sockshop").Resource("results.txt")
+	_, err = apiSet.UploadResource(context.TODO(), *scope, strings.NewReader(content), ResourcesUploadResourceOptions{
+		OnProgress: func(p ResourceUploadProgress) { progress = append(progress, p) },
+	})
+	require.Nil(t, err)
+	require.Equal(t, content, store.resources["results.txt"])
+	require.NotEmpty(t, progress)
+	require.Equal(t, int64(len(content)), progress[len(progress)-1].Bytes)
+
+	downloaded := &bytes.Buffer{}
+	require.Nil(t, apiSet.DownloadResource(context.TODO(), *scope, downloaded, ResourcesDownloadResourceOptions{}))
+	require.Equal(t, content, downloaded.String())
+}
+
+func TestAPISet_UploadResourceStreamIsSentAgainAfterTokenRefresh(t *testing.T) {
+	server := newTokenCheckingServer("new-token")
+	defer server.Close()
+	apiSet, err := New(server.URL, WithAuthToken("old-token"), WithTokenRefresher(func(ctx context.Context) (string, error) {
+		return "new-token", nil
+	}))
+	require.Nil(t, err)
+
+	content := strings.Repeat("test results ", 100000)
+	scope := NewResourceScope().Project("sockshop").Resource("results.txt")
+	_, err = apiSet.UploadResource(context.TODO(), *scope, &streamingReader{strings.NewReader(content)}, ResourcesUploadResourceOptions{})
+	require.Nil(t, err)
+
+	received := server.received()
+	require.Len(t, received, 2)
+	require.Equal(t, received[0].Body, received[1].Body)
+	require.Contains(t, string(received[1].Body), base64.StdEncoding.EncodeToString([]byte(content)))
+}
+
+func TestAPISet_UploadResourceChunks(t *testing.T) {
+	store := newResourceStoreServer(t)
+	defer store.Close()
+	apiSet, err := New(store.URL)
+	require.Nil(t, err)
+
+	content := strings.Repeat("0123456789", 350)
+	scope := NewResourceScope().Project("sockshop").Resource("results.txt")
+
+	store.failWrite = "results.txt.chunks/00002"
+	_, err = apiSet.UploadResource(context.TODO(), *scope, strings.NewReader(content), ResourcesUploadResourceOptions{ChunkSize: 1000})
+	require.NotNil(t, err)
+	require.Equal(t, []string{"results.txt.chunks/00000", "results.txt.chunks.json", "results.txt.chunks/00001", "results.txt.chunks.json"}, store.written)
+	require.NotNil(t, apiSet.DownloadResource(context.TODO(), *scope, &bytes.Buffer{}, ResourcesDownloadResourceOptions{}))
+
+	store.written = nil
+	progress := []ResourceUploadProgress{}
+	_, err = apiSet.UploadResource(context.TODO(), *scope, strings.NewReader(content), ResourcesUploadResourceOptions{
+		ChunkSize:  1000,
+		Resume:     true,
+		OnProgress: func(p ResourceUploadProgress) { progress = append(progress, p) },
+	})
+	require.Nil(t, err)
+	require.Equal(t, []string{"results.txt.chunks/00002", "results.txt.chunks.json", "results.txt.chunks/00003", "results.txt.chunks.json", "results.txt.chunks.json"}, store.written)
+	require.Equal(t, ResourceUploadProgress{Bytes: 3500, Chunks: 4, SkippedChunks: 2}, progress[len(progress)-1])
+	require.Equal(t, content[3000:], store.resources["results.txt.chunks/00003"])
+
+	manifest := ResourceChunkManifest{}
+	require.Nil(t, json.Unmarshal([]byte(store.resources["results.txt.chunks.json"]), &manifest))
+	require.True(t, manifest.Complete)
+	require.Equal(t, int64(3500), manifest.Size)
+	require.Equal(t, ResourceChecksum(content), manifest.Checksum)
+	require.Len(t, manifest.Chunks, 4)
+
+	downloaded := &bytes.Buffer{}
+	require.Nil(t, apiSet.DownloadResource(context.TODO(), *scope, downloaded, ResourcesDownloadResourceOptions{}))
+	require.Equal(t, content, downloaded.String())
+
+	store.resources["results.txt.chunks/00001"] = "corrupted"
+	err = apiSet.DownloadResource(context.TODO(), *scope, &bytes.Buffer{}, ResourcesDownloadResourceOptions{})
+	integrityErr := &ResourceIntegrityError{}
+	require.True(t, errors.As(err, &integrityErr))
+}
+
+func TestAPISet_UploadResourceWithoutURI(t *testing.T) {
+	apiSet, err := New("http://localhost")
+	require.Nil(t, err)
+	_, err = apiSet.UploadResource(context.TODO(), *NewResourceScope().Project("sockshop"), strings.NewReader(""), ResourcesUploadResourceOptions{})
+	require.NotNil(t, err)
+}
+
+func TestAPISet_UploadResourceEmptyChunks(t *testing.T) {
+	store := newResourceStoreServer(t)
+	defer store.Close()
+	apiSet, err := New(store.URL)
+	require.Nil(t, err)
+
+	scope := NewResourceScope().Project("sockshop").Resource("empty.txt")
+	_, err = apiSet.UploadResource(context.TODO(), *scope, strings.NewReader(""), ResourcesUploadResourceOptions{ChunkSize: 1000})
+	require.Nil(t, err)
+
+	manifest := ResourceChunkManifest{}
+	require.Nil(t, json.Unmarshal([]byte(store.resources["empty.txt.chunks.json"]), &manifest))
+	require.True(t, manifest.Complete)
+	require.Empty(t, manifest.Chunks)
+	require.Equal(t, ResourceChecksum(""), manifest.Checksum)
+
+	downloaded := &bytes.Buffer{}
+	require.Nil(t, apiSet.DownloadResource(context.TODO(), *scope, downloaded, ResourcesDownloadResourceOptions{}))
+	require.Empty(t, downloaded.String())
+}
@@ -0,0 +1,151 @@
+package v2
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"mime"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// ErrInvalidResponse is wrapped by the *ResponseValidationError returned for responses rejected by a
+// ResponseValidator, see WithResponseValidators
+var ErrInvalidResponse = errors.New("invalid response")
+
+// ResponseValidator checks a response of the Keptn API. duration is the time from sending the request until the
+// response headers have been received. A validator may read the body, as long as it replaces it with a body
+// returning the same content. An error makes the request fail without the response being processed
+type ResponseValidator func(resp *http.Response, duration time.Duration) error
+
+// ResponseValidationError is returned for a response which has been rejected by a ResponseValidator.
+// The *models.Error returned by the methods of the handlers can be checked with errors.Is(mErr.ToError(), ErrInvalidResponse)
+type ResponseValidationError struct {
+	Method string
+	// URL is the URL of the request, without query parameters
+	URL         string
+	StatusCode  int
+	ContentType string
+	// Err is the error returned by the validator
+	Err error
+}
+
+func (e *ResponseValidationError) Error() string {
+	return fmt.Sprintf("%s %s: %s: status %d, content type %q: %v", e.Method, e.URL, ErrInvalidResponse.Error(), e.StatusCode, e.ContentType, e.Err)
+}
+
+// Is makes errors.Is(err, ErrInvalidResponse) return true
+func (e *ResponseValidationError) Is(target error) bool {
+	return target == ErrInvalidResponse
+}
+
+func (e *ResponseValidationError) Unwrap() error {
+	return e.Err
+}
+
+// WithResponseValidators adds validators which check every response of the Keptn API in the given order,
+// e.g. to detect requests which have been routed to the login page of an ingress instead of the Keptn API,
+// before the response fails to be decoded. Validation errors are not retried
+func WithResponseValidators(validators ...ResponseValidator) func(*APISet) {
+	return func(a *APISet) {
+		for _, validator := range validators {
+			if validator != nil {
+				a.responseValidators = append(a.responseValidators, validator)
+			}
+		}
+	}
+}
+
+// RequireJSONContentType returns a ResponseValidator which rejects responses with a body whose content type is
+// neither application/json nor a JSON based type like application/problem+json
+func RequireJSONContentType() ResponseValidator {
+	return func(resp *http.Response, _ time.Duration) error {
+		if resp.StatusCode == http.StatusNoContent || resp.ContentLength == 0 || resp.Request.Method == http.MethodHead {
+			return nil
+		}
+		contentType := resp.Header.Get("Content-Type")
+		mediaType, _, err := mime.ParseMediaType(contentType)
+		if err != nil {
+			return fmt.Errorf("expected JSON, got content type %q", contentType)
+		}
+		if mediaType != "application/json" && !strings.HasSuffix(mediaType, "+json") {
+			return fmt.Errorf("expected JSON, got %s", mediaType)
+		}
+		return nil
+	}
+}
+
+// RequireJSONBody returns a ResponseValidator which rejects responses with a body which is not a JSON object or array.
+// The body is read into memory, so the validator should not be used together with large downloads
+func RequireJSONBody() ResponseValidator {
+	return func(resp *http.Response, _ time.Duration) error {
+		if resp.Body == nil || resp.Body == http.NoBody {
+			return nil
+		}
+		body, err := ioutil.ReadAll(resp.Body)
+		resp.Body.Close()
+		resp.Body = ioutil.NopCloser(bytes.NewReader(body))
+		if err != nil {
+			return fmt.Errorf("could not read body: %w", err)
+		}
+		trimmed := bytes.TrimSpace(body)
+		if len(trimmed) == 0 {
+			return nil
+		}
+		if (trimmed[0] != '{' && trimmed[0] != '[') || !json.Valid(trimmed) {
+			return fmt.Errorf("body is not a JSON object or array: %q", truncateBody(trimmed, 64))
+		}
+		return nil
+	}
+}
+
+// RequireMaxLatency returns a ResponseValidator which rejects responses whose headers took longer than max to arrive
+func RequireMaxLatency(max time.Duration) ResponseValidator {
+	return func(_ *http.Response, duration time.Duration) error {
+		if duration > max {
+			return fmt.Errorf("response took %s, more than the maximum of %s", duration, max)
+		}
+		return nil
+	}
+}
+
+// responseValidationTransport is a http.RoundTripper which runs the ResponseValidators of an APISet
+type responseValidationTransport struct {
+	base       http.RoundTripper
+	validators []ResponseValidator
+}
+
+func newResponseValidationTransport(base http.RoundTripper, validators []ResponseValidator) *responseValidationTransport {
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	return &responseValidationTransport{base: base, validators: validators}
+}
+
+func (t *responseValidationTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	start := time.Now()
+	resp, err := t.base.RoundTrip(req)
+	if err != nil {
+		return resp, err
+	}
+	duration := time.Since(start)
+	if resp.Request == nil {
+		resp.Request = req
+	}
+	for _, validator := range t.validators {
+		if err := validator(resp, duration); err != nil {
+			resp.Body.Close()
+			return nil, &ResponseValidationError{
+				Method:      req.Method,
+				URL:         req.URL.Scheme + "://" + req.URL.Host + req.URL.Path,
+				StatusCode:  resp.StatusCode,
+				ContentType: resp.Header.Get("Content-Type"),
+				Err:         err,
+			}
+		}
+	}
+	return resp, nil
+}
@@ -0,0 +1,100 @@
+package v2
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/keptn/go-utils/pkg/api/models"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWithResponseValidators_RejectsLoginPage(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.Header().Set("Content-Type", "text/html; charset=utf-8")
+		w.Write([]byte(`<html><body>Please log in</body></html>`))
+	}))
+	defer server.Close()
+
+	apiSet, err := New(server.URL, WithResponseValidators(RequireJSONContentType()))
+	require.NoError(t, err)
+	require.Equal(t, 1, apiSet.Capabilities().ResponseValidators)
+
+	_, mErr := apiSet.Projects().GetProject(context.TODO(), models.Project{ProjectName: "my-project"}, ProjectsGetProjectOptions{})
+	require.NotNil(t, mErr)
+	require.ErrorIs(t, mErr.ToError(), ErrInvalidResponse)
+	validationErr := &ResponseValidationError{}
+	require.True(t, errors.As(mErr.ToError(), &validationErr))
+	require.Equal(t, http.StatusOK, validationErr.StatusCode)
+	require.Equal(t, "text/html; charset=utf-8", validationErr.ContentType)
+	require.Contains(t, validationErr.Err.Error(), "expected JSON, got text/html")
+	require.False(t, isRetryableError(mErr))
+
+	_, err = apiSet.Events().GetEventsWithRetry(context.TODO(), &EventFilter{Project: "my-project"}, 3, minRetrySleepTime, EventsGetEventsWithRetryOptions{})
+	require.Error(t, err)
+	require.Equal(t, 2, requests)
+}
+
+func TestWithResponseValidators_AcceptsValidResponses(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"projectName":"my-project"}`))
+	}))
+	defer server.Close()
+
+	apiSet, err := New(server.URL, WithResponseValidators(RequireJSONContentType(), RequireJSONBody(), RequireMaxLatency(time.Minute), nil))
+	require.NoError(t, err)
+	require.Equal(t, 3, apiSet.Capabilities().ResponseValidators)
+
+	project, mErr := apiSet.Projects().GetProject(context.TODO(), models.Project{ProjectName: "my-project"}, ProjectsGetProjectOptions{})
+	require.Nil(t, mErr)
+	require.Equal(t, "my-project", project.ProjectName)
+}
+
+func TestRequireJSONContentType(t *testing.T) {
+	validate := RequireJSONContentType()
+	response := func(status int, contentType string, contentLength int64) *http.Response {
+		resp := &http.Response{StatusCode: status, Header: http.Header{}, ContentLength: contentLength, Request: &http.Request{Method: http.MethodGet}}
+		if contentType != "" {
+			resp.Header.Set("Content-Type", contentType)
+		}
+		return resp
+	}
+	require.NoError(t, validate(response(http.StatusOK, "application/json", -1), 0))
+	require.NoError(t, validate(response(http.StatusBadRequest, "application/problem+json", 10), 0))
+	require.NoError(t, validate(response(http.StatusNoContent, "", -1), 0))
+	require.NoError(t, validate(response(http.StatusOK, "", 0), 0))
+	require.Error(t, validate(response(http.StatusOK, "", -1), 0))
+	require.Error(t, validate(response(http.StatusBadGateway, "text/plain", 11), 0))
+}
+
+func TestRequireJSONBody(t *testing.T) {
+	validate := RequireJSONBody()
+	response := func(body string) *http.Response {
+		return &http.Response{StatusCode: http.StatusOK, Body: ioutil.NopCloser(strings.NewReader(body))}
+	}
+
+	resp := response(` {"projects":[]} `)
+	require.NoError(t, validate(resp, 0))
+	body, err := ioutil.ReadAll(resp.Body)
+	require.NoError(t, err)
+	require.Equal(t, ` {"projects":[]} `, string(body))
+
+	require.NoError(t, validate(response(""), 0))
+	require.Error(t, validate(response(`"just a string"`), 0))
+	require.Error(t, validate(response(`{"projects":[`), 0))
+	require.Error(t, validate(response(`<html></html>`), 0))
+}
+
+func TestRequireMaxLatency(t *testing.T) {
+	validate := RequireMaxLatency(time.Second)
+	require.NoError(t, validate(&http.Response{}, time.Second))
+	require.Error(t, validate(&http.Response{}, 2*time.Second))
+}
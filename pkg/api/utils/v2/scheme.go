@@ -0,0 +1,100 @@
+package v2
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"net/url"
+	"strings"
+
+	"github.com/keptn/go-utils/pkg/common/httputils"
+)
+
+// ErrPlaintextEndpoint is returned by New if the SchemePolicy RequireHTTPS is used for a plaintext HTTP endpoint outside of the cluster
+var ErrPlaintextEndpoint = errors.New("plaintext HTTP endpoint is not allowed")
+
+// SchemePolicy determines the scheme used for a base URL without scheme and whether plaintext HTTP endpoints are allowed
+type SchemePolicy int
+
+const (
+	// AllowHTTP uses "http" for base URLs without scheme and allows all endpoints. This is the default
+	AllowHTTP SchemePolicy = iota
+	// PreferHTTPS uses "https" for base URLs without scheme, but allows base URLs explicitly using "http"
+	PreferHTTPS
+	// RequireHTTPS uses "https" for base URLs without scheme and refuses plaintext HTTP endpoints,
+	// unless they are within the cluster, i.e. loopback addresses, Kubernetes service names like api-gateway-nginx
+	// and names within the cluster domain like api-gateway-nginx.keptn.svc.cluster.local
+	RequireHTTPS
+)
+
+// String returns the name of the policy
+func (p SchemePolicy) String() string {
+	switch p {
+	case AllowHTTP:
+		return "AllowHTTP"
+	case PreferHTTPS:
+		return "PreferHTTPS"
+	case RequireHTTPS:
+		return "RequireHTTPS"
+	}
+	return fmt.Sprintf("SchemePolicy(%d)", int(p))
+}
+
+// WithSchemePolicy sets the SchemePolicy applied to the base URL of the APISet (default AllowHTTP).
+// A scheme set via WithScheme takes precedence over the default scheme of the policy, but is checked by RequireHTTPS as well
+func WithSchemePolicy(policy SchemePolicy) func(*APISet) {
+	return func(a *APISet) {
+		a.schemePolicy = policy
+	}
+}
+
+// resolveScheme returns the scheme to use for the given base URL. scheme is the scheme set via WithScheme, if any
+func (p SchemePolicy) resolveScheme(baseURL string, scheme string) (string, error) {
+	if scheme == "" {
+		scheme = baseURLScheme(baseURL)
+	}
+	if scheme == "" {
+		scheme = "http"
+		if p != AllowHTTP {
+			scheme = "https"
+		}
+	}
+	if p == RequireHTTPS && strings.EqualFold(scheme, "http") {
+		if host := baseURLHost(baseURL); !isInClusterHost(host) {
+			return "", fmt.Errorf("%s: %w", host, ErrPlaintextEndpoint)
+		}
+	}
+	return scheme, nil
+}
+
+// baseURLScheme returns the scheme of the given base URL, or an empty string if it has none, e.g. for "localhost:8080"
+func baseURLScheme(baseURL string) string {
+	if !strings.Contains(baseURL, "://") {
+		return ""
+	}
+	if u, err := url.Parse(baseURL); err == nil {
+		return u.Scheme
+	}
+	return ""
+}
+
+// baseURLHost returns the host name of the given base URL, without port
+func baseURLHost(baseURL string) string {
+	u, err := url.Parse("//" + httputils.TrimHTTPScheme(baseURL))
+	if err != nil {
+		return baseURL
+	}
+	return u.Hostname()
+}
+
+// isInClusterHost returns whether the given host name is only reachable from within the cluster or the local machine
+func isInClusterHost(host string) bool {
+	host = strings.ToLower(strings.TrimSuffix(host, "."))
+	if host == "localhost" {
+		return true
+	}
+	if ip := net.ParseIP(host); ip != nil {
+		return ip.IsLoopback()
+	}
+	return !strings.Contains(host, ".") || strings.HasSuffix(host, ".svc") || strings.HasSuffix(host, ".svc.cluster.local")
+}
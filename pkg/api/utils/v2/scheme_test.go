@@ -0,0 +1,51 @@
+package v2
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestWithSchemePolicy(t *testing.T) {
+	tests := []struct {
+		name       string
+		baseURL    string
+		options    []func(*APISet)
+		wantScheme string
+		wantErr    error
+	}{
+		{name: "AllowHTTP defaults to http", baseURL: "keptn.example.com/api", wantScheme: "http"},
+		{name: "AllowHTTP uses scheme of base URL", baseURL: "https://keptn.example.com/api", wantScheme: "https"},
+		{name: "AllowHTTP handles host with port", baseURL: "keptn.example.com:8080", wantScheme: "http"},
+		{name: "PreferHTTPS defaults to https", baseURL: "keptn.example.com/api", options: []func(*APISet){WithSchemePolicy(PreferHTTPS)}, wantScheme: "https"},
+		{name: "PreferHTTPS allows explicit http", baseURL: "http://keptn.example.com/api", options: []func(*APISet){WithSchemePolicy(PreferHTTPS)}, wantScheme: "http"},
+		{name: "RequireHTTPS defaults to https", baseURL: "keptn.example.com/api", options: []func(*APISet){WithSchemePolicy(RequireHTTPS)}, wantScheme: "https"},
+		{name: "RequireHTTPS refuses public http endpoint", baseURL: "http://keptn.example.com/api", options: []func(*APISet){WithSchemePolicy(RequireHTTPS)}, wantErr: ErrPlaintextEndpoint},
+		{name: "RequireHTTPS refuses http set via WithScheme", baseURL: "keptn.example.com/api", options: []func(*APISet){WithSchemePolicy(RequireHTTPS), WithScheme("http")}, wantErr: ErrPlaintextEndpoint},
+		{name: "RequireHTTPS refuses public IP", baseURL: "http://203.0.113.10:8080", options: []func(*APISet){WithSchemePolicy(RequireHTTPS)}, wantErr: ErrPlaintextEndpoint},
+		{name: "RequireHTTPS allows service name", baseURL: "http://api-gateway-nginx:80/api", options: []func(*APISet){WithSchemePolicy(RequireHTTPS)}, wantScheme: "http"},
+		{name: "RequireHTTPS allows cluster domain", baseURL: "http://api-gateway-nginx.keptn.svc.cluster.local/api", options: []func(*APISet){WithSchemePolicy(RequireHTTPS)}, wantScheme: "http"},
+		{name: "RequireHTTPS allows loopback", baseURL: "http://127.0.0.1:8080", options: []func(*APISet){WithSchemePolicy(RequireHTTPS)}, wantScheme: "http"},
+		{name: "RequireHTTPS allows localhost", baseURL: "http://localhost:8080", options: []func(*APISet){WithSchemePolicy(RequireHTTPS)}, wantScheme: "http"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			apiSet, err := New(tt.baseURL, tt.options...)
+			if tt.wantErr != nil {
+				require.True(t, errors.Is(err, tt.wantErr), "expected %v, got %v", tt.wantErr, err)
+				require.Nil(t, apiSet)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.wantScheme, apiSet.scheme)
+		})
+	}
+}
+
+func TestSchemePolicy_String(t *testing.T) {
+	require.Equal(t, "AllowHTTP", AllowHTTP.String())
+	require.Equal(t, "PreferHTTPS", PreferHTTPS.String())
+	require.Equal(t, "RequireHTTPS", RequireHTTPS.String())
+	require.Equal(t, "SchemePolicy(7)", SchemePolicy(7).String())
+}
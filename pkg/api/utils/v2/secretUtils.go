@@ -4,7 +4,7 @@ import (
 	"context"
 	"errors"
 	"net/http"
-	"strings"
+	"net/url"
 
 	"github.com/keptn/go-utils/pkg/api/models"
 	"github.com/keptn/go-utils/pkg/common/httputils"
@@ -42,11 +42,13 @@ type SecretsInterface interface {
 
 // SecretHandler handles secrets
 type SecretHandler struct {
-	baseURL    string
-	authToken  string
-	authHeader string
-	httpClient *http.Client
-	scheme     string
+	baseURL     string
+	authToken   string
+	authHeader  string
+	httpClient  *http.Client
+	scheme      string
+	idempotency idempotencyOptions
+	errorDetailsSetting
 }
 
 // NewSecretHandler returns a new SecretHandler which sends all requests directly to the secret-service
@@ -59,14 +61,11 @@ func NewSecretHandlerWithHTTPClient(baseURL string, httpClient *http.Client) *Se
 	return createSecretHandler(baseURL, "", "", httpClient, "http")
 }
 
-// NewAuthenticatedSecretHandler returns a new SecretHandler that authenticates at the api via the provided token
+// NewAuthenticatedSecretHandler returns a new SecretHandler that authenticates at the api via the provided token.
+// The base path of the service is added to the base URL according to basePathMode (default AppendMissingBasePath)
 // and sends all requests directly to the secret-service
-func NewAuthenticatedSecretHandler(baseURL string, authToken string, authHeader string, httpClient *http.Client, scheme string) *SecretHandler {
-	baseURL = strings.TrimRight(baseURL, "/")
-	if !strings.HasSuffix(baseURL, secretServiceBaseURL) {
-		baseURL += "/" + secretServiceBaseURL
-	}
-
+func NewAuthenticatedSecretHandler(baseURL string, authToken string, authHeader string, httpClient *http.Client, scheme string, basePathMode ...BasePathMode) *SecretHandler {
+	baseURL = basePathModeOf(basePathMode).HandlerBaseURL(baseURL, HandlerSecrets)
 	return createSecretHandler(baseURL, authToken, authHeader, httpClient, scheme)
 }
 
@@ -103,7 +102,7 @@ func (s *SecretHandler) CreateSecret(ctx context.Context, secret models.Secret,
 		return err
 	}
 	_, errObj := post(ctx, s.scheme+"://"+s.baseURL+v1SecretPath, body, s)
-	if errObj != nil {
+	if errObj != nil && !s.idempotency.alreadyExists(errObj) {
 		return errors.New(errObj.GetMessage())
 	}
 	return nil
@@ -124,8 +123,11 @@ func (s *SecretHandler) UpdateSecret(ctx context.Context, secret models.Secret,
 
 // DeleteSecret deletes a secret.
 func (s *SecretHandler) DeleteSecret(ctx context.Context, secretName, secretScope string, opts SecretsDeleteSecretOptions) error {
-	_, err := delete(ctx, s.scheme+"://"+s.baseURL+v1SecretPath+"?name="+secretName+"&scope="+secretScope, s)
-	if err != nil {
+	query := url.Values{}
+	query.Set("name", secretName)
+	query.Set("scope", secretScope)
+	_, err := deleteRequest(ctx, s.scheme+"://"+s.baseURL+v1SecretPath+"?"+query.Encode(), s)
+	if err != nil && !s.idempotency.notFoundOnDelete(err) {
 		return errors.New(err.GetMessage())
 	}
 	return nil
@@ -3,28 +3,51 @@ package v2
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
+	"net/url"
 	"strings"
 
+	"github.com/keptn/go-utils/pkg/api/models"
 	"github.com/keptn/go-utils/pkg/common/httputils"
 )
 
 const v1SequenceControlPath = "/v1/sequence/%s/%s/control"
+const v1SequenceStatePath = "/v1/sequence/%s"
 
 // SequencesControlSequenceOptions are options for SequencesInterface.ControlSequence().
 type SequencesControlSequenceOptions struct{}
 
+// SequencesGetSequenceStatesOptions are options for SequencesInterface.GetSequenceStates().
+type SequencesGetSequenceStatesOptions struct {
+	// PageRetries overrides the page retries of the handler for this call
+	PageRetries *PageRetries
+}
+
+// SequencesBulkControlSequencesOptions are options for SequencesInterface.BulkControlSequences().
+type SequencesBulkControlSequencesOptions struct{}
+
 type SequencesInterface interface {
 	ControlSequence(ctx context.Context, params SequenceControlParams, opts SequencesControlSequenceOptions) error
+
+	// GetSequenceStates returns the states of all sequences matching the passed filter.
+	GetSequenceStates(ctx context.Context, filter SequenceStateFilter, opts SequencesGetSequenceStatesOptions) ([]models.SequenceState, error)
+
+	// BulkControlSequences applies the passed state to all sequences matching the passed filter.
+	BulkControlSequences(ctx context.Context, filter SequenceStateFilter, state models.SequenceControlState, opts SequencesBulkControlSequencesOptions) ([]SequenceControlResult, error)
 }
 
+// SequenceControlHandler controls sequences.
+// It is safe for concurrent use by multiple goroutines, since it is not modified after it has been created
 type SequenceControlHandler struct {
-	baseURL    string
-	authToken  string
-	authHeader string
-	httpClient *http.Client
-	scheme     string
+	baseURL     string
+	authToken   string
+	authHeader  string
+	httpClient  *http.Client
+	scheme      string
+	pageRetries pageRetryPolicy
+	errorDetailsSetting
 }
 
 type SequenceControlParams struct {
@@ -34,6 +57,11 @@ type SequenceControlParams struct {
 	State        string `json:"state"`
 }
 
+// ControlState returns the State as models.SequenceControlState, e.g. to check it with its Validate method
+func (s *SequenceControlParams) ControlState() models.SequenceControlState {
+	return models.SequenceControlState(s.State)
+}
+
 func (s *SequenceControlParams) Validate() error {
 	var errMsg []string
 	if s.Project == "" {
@@ -44,6 +72,8 @@ func (s *SequenceControlParams) Validate() error {
 	}
 	if s.State == "" {
 		errMsg = append(errMsg, "sequence state parameter not set")
+	} else if err := s.ControlState().Validate(); err != nil {
+		errMsg = append(errMsg, err.Error())
 	}
 	errStr := strings.Join(errMsg, ",")
 
@@ -58,6 +88,11 @@ type SequenceControlBody struct {
 	State string `json:"state"`
 }
 
+// ControlState returns the State as models.SequenceControlState, e.g. to check it with its Validate method
+func (s *SequenceControlBody) ControlState() models.SequenceControlState {
+	return models.SequenceControlState(s.State)
+}
+
 // Converts object to JSON string
 func (s *SequenceControlBody) ToJSON() ([]byte, error) {
 	return json.Marshal(s)
@@ -83,13 +118,10 @@ func NewSequenceControlHandlerWithHTTPClient(baseURL string, httpClient *http.Cl
 	return createSequenceControlHandler(baseURL, "", "", httpClient, "http")
 }
 
-// NewAuthenticatedSequenceControlHandler returns a new SequenceControlHandler that authenticates at the api via the provided token
-func NewAuthenticatedSequenceControlHandler(baseURL string, authToken string, authHeader string, httpClient *http.Client, scheme string) *SequenceControlHandler {
-	baseURL = strings.TrimRight(baseURL, "/")
-	if !strings.HasSuffix(baseURL, shipyardControllerBaseURL) {
-		baseURL += "/" + shipyardControllerBaseURL
-	}
-
+// NewAuthenticatedSequenceControlHandler returns a new SequenceControlHandler that authenticates at the api via the provided token.
+// The base path of the service is added to the base URL according to basePathMode (default AppendMissingBasePath)
+func NewAuthenticatedSequenceControlHandler(baseURL string, authToken string, authHeader string, httpClient *http.Client, scheme string, basePathMode ...BasePathMode) *SequenceControlHandler {
+	baseURL = basePathModeOf(basePathMode).HandlerBaseURL(baseURL, HandlerSequences)
 	return createSequenceControlHandler(baseURL, authToken, authHeader, httpClient, scheme)
 }
 
@@ -126,7 +158,10 @@ func (s *SequenceControlHandler) ControlSequence(ctx context.Context, params Seq
 	}
 
 	baseurl := fmt.Sprintf("%s://%s", s.scheme, s.getBaseURL())
-	path := fmt.Sprintf(v1SequenceControlPath, params.Project, params.KeptnContext)
+	if mErr := checkIdentifiers("project", params.Project, "keptn context", params.KeptnContext); mErr != nil {
+		return mErr.ToError()
+	}
+	path := fmt.Sprintf(v1SequenceControlPath, EscapeIdentifier(params.Project), EscapeIdentifier(params.KeptnContext))
 
 	body := SequenceControlBody{
 		Stage: params.Stage,
@@ -145,3 +180,128 @@ func (s *SequenceControlHandler) ControlSequence(ctx context.Context, params Seq
 
 	return nil
 }
+
+// SequenceStateFilter selects the sequences returned by GetSequenceStates and controlled by BulkControlSequences
+type SequenceStateFilter struct {
+	// Project is required
+	Project string
+	// Name is the name of the sequence, e.g. delivery
+	Name string
+	// Stage restricts the sequences to the ones which have reached the given stage.
+	// BulkControlSequences only pauses, resumes or aborts the sequences in this stage
+	Stage        string
+	State        string
+	KeptnContext string
+	FromTime     string
+	BeforeTime   string
+}
+
+// SequenceControlResult is the result of controlling a single sequence with BulkControlSequences
+type SequenceControlResult struct {
+	Project      string
+	Name         string
+	KeptnContext string
+	Stage        string
+	// Skipped is set if the sequence has not been controlled because it has already finished, been aborted or timed out
+	Skipped bool
+	// Err is the error returned when controlling the sequence, if any
+	Err error
+}
+
+// GetSequenceStates returns the states of all sequences matching the passed filter.
+func (s *SequenceControlHandler) GetSequenceStates(ctx context.Context, filter SequenceStateFilter, opts SequencesGetSequenceStatesOptions) ([]models.SequenceState, error) {
+	if filter.Project == "" {
+		return nil, errors.New("project parameter not set")
+	}
+	if mErr := checkIdentifiers("project", filter.Project); mErr != nil {
+		return nil, mErr.ToError()
+	}
+	states := []models.SequenceState{}
+	cursor := models.Cursor{}
+	pageRetries := s.pageRetries.override(opts.PageRetries)
+	for {
+		u, err := url.Parse(fmt.Sprintf("%s://%s"+v1SequenceStatePath, s.scheme, s.getBaseURL(), EscapeIdentifier(filter.Project)))
+		if err != nil {
+			return nil, err
+		}
+		q := u.Query()
+		for key, value := range map[string]string{
+			"name":         filter.Name,
+			"state":        filter.State,
+			"keptnContext": filter.KeptnContext,
+			"fromTime":     filter.FromTime,
+			"beforeTime":   filter.BeforeTime,
+			"nextPageKey":  cursor.Encode(),
+		} {
+			if value != "" {
+				q.Set(key, value)
+			}
+		}
+		u.RawQuery = q.Encode()
+
+		body, mErr := pageRetries.getPage(ctx, u.String(), s)
+		if mErr != nil {
+			return nil, mErr.ToError()
+		}
+
+		received := &models.SequenceStates{}
+		if err := json.Unmarshal(body, received); err != nil {
+			return nil, err
+		}
+		for _, state := range received.States {
+			if filter.Stage == "" || hasSequenceStage(state, filter.Stage) {
+				states = append(states, state)
+			}
+		}
+
+		if cursor = received.Cursor(); cursor.IsEnd() {
+			break
+		}
+	}
+	return states, nil
+}
+
+// BulkControlSequences applies the passed state to all sequences matching the passed filter, e.g. to pause all sequences
+// in the production stage of a project during maintenance. Sequences which have already finished, been aborted or timed out are skipped.
+// The sequences are controlled one after the other, and a failure to control a sequence does not stop the remaining ones;
+// the outcome for each sequence is contained in the returned results. An error is only returned if the sequences could not be listed
+func (s *SequenceControlHandler) BulkControlSequences(ctx context.Context, filter SequenceStateFilter, state models.SequenceControlState, opts SequencesBulkControlSequencesOptions) ([]SequenceControlResult, error) {
+	if err := state.Validate(); err != nil {
+		return nil, err
+	}
+	states, err := s.GetSequenceStates(ctx, filter, SequencesGetSequenceStatesOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("could not list sequences: %w", err)
+	}
+
+	results := make([]SequenceControlResult, 0, len(states))
+	for _, sequence := range states {
+		result := SequenceControlResult{
+			Project:      sequence.Project,
+			Name:         sequence.Name,
+			KeptnContext: sequence.Shkeptncontext,
+			Stage:        filter.Stage,
+		}
+		if sequence.Status().IsDone() {
+			result.Skipped = true
+		} else {
+			result.Err = s.ControlSequence(ctx, SequenceControlParams{
+				Project:      filter.Project,
+				KeptnContext: sequence.Shkeptncontext,
+				Stage:        filter.Stage,
+				State:        string(state),
+			}, SequencesControlSequenceOptions{})
+		}
+		results = append(results, result)
+	}
+	return results, nil
+}
+
+func hasSequenceStage(state models.SequenceState, stage string) bool {
+	for _, s := range state.Stages {
+		if s.Name == stage {
+			return true
+		}
+	}
+	return false
+}
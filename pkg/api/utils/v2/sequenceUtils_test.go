@@ -7,6 +7,7 @@ import (
 	"net/http/httptest"
 	"testing"
 
+	"github.com/keptn/go-utils/pkg/api/models"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -31,7 +32,7 @@ func TestSequenceControlHandler_ControlSequence(t *testing.T) {
 				Project:      "",
 				KeptnContext: "c1",
 				Stage:        "s1",
-				State:        "s1",
+				State:        "pause",
 			}, true},
 		{"test control sequence - missing context",
 			nil,
@@ -39,7 +40,7 @@ func TestSequenceControlHandler_ControlSequence(t *testing.T) {
 				Project:      "p1",
 				KeptnContext: "",
 				Stage:        "s1",
-				State:        "s1",
+				State:        "abort",
 			}, true},
 		{"test control sequence - missing state",
 			nil,
@@ -49,6 +50,14 @@ func TestSequenceControlHandler_ControlSequence(t *testing.T) {
 				Stage:        "s1",
 				State:        "",
 			}, true},
+		{"test control sequence - unknown state",
+			nil,
+			SequenceControlParams{
+				Project:      "p1",
+				KeptnContext: "c1",
+				Stage:        "s1",
+				State:        "stt1",
+			}, true},
 		{"test control sequence - valid params",
 			func(writer http.ResponseWriter, request *http.Request) {
 				assert.Equal(t, "/v1/sequence/p1/c1/control", request.RequestURI)
@@ -58,13 +67,13 @@ func TestSequenceControlHandler_ControlSequence(t *testing.T) {
 				params := &SequenceControlBody{}
 				params.FromJSON(payload)
 				assert.Equal(t, "stg1", params.Stage)
-				assert.Equal(t, "stt1", params.State)
+				assert.Equal(t, "abort", params.State)
 			},
 			SequenceControlParams{
 				Project:      "p1",
 				KeptnContext: "c1",
 				Stage:        "stg1",
-				State:        "stt1",
+				State:        "abort",
 			}, false},
 	}
 
@@ -79,3 +88,59 @@ func TestSequenceControlHandler_ControlSequence(t *testing.T) {
 		})
 	}
 }
+
+func TestSequenceControlHandler_BulkControlSequences(t *testing.T) {
+	var controlled []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/v1/sequence/p1":
+			assert.Equal(t, "delivery", r.URL.Query().Get("name"))
+			if r.URL.Query().Get("nextPageKey") == "" {
+				w.Write([]byte(`{"states":[
+					{"name":"delivery","project":"p1","shkeptncontext":"c1","state":"started","stages":[{"name":"dev"},{"name":"prod"}]},
+					{"name":"delivery","project":"p1","shkeptncontext":"c2","state":"started","stages":[{"name":"dev"}]}
+				],"nextPageKey":2}`))
+				return
+			}
+			w.Write([]byte(`{"states":[
+				{"name":"delivery","project":"p1","shkeptncontext":"c3","state":"finished","stages":[{"name":"prod"}]},
+				{"name":"delivery","project":"p1","shkeptncontext":"c4","state":"started","stages":[{"name":"prod"}]}
+			]}`))
+		case r.Method == http.MethodPost:
+			body := SequenceControlBody{}
+			b, _ := io.ReadAll(r.Body)
+			assert.Nil(t, body.FromJSON(b))
+			assert.Equal(t, "prod", body.Stage)
+			assert.Equal(t, models.PauseSequence, body.ControlState())
+			controlled = append(controlled, r.URL.Path)
+			if r.URL.Path == "/v1/sequence/p1/c4/control" {
+				w.WriteHeader(http.StatusConflict)
+				w.Write([]byte(`{"code":409,"message":"sequence is not running"}`))
+			}
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	handler := NewSequenceControlHandler(server.URL)
+	filter := SequenceStateFilter{Project: "p1", Name: "delivery", Stage: "prod"}
+
+	states, err := handler.GetSequenceStates(context.TODO(), filter, SequencesGetSequenceStatesOptions{})
+	assert.Nil(t, err)
+	assert.Len(t, states, 3)
+
+	results, err := handler.BulkControlSequences(context.TODO(), filter, models.PauseSequence, SequencesBulkControlSequencesOptions{})
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"/v1/sequence/p1/c1/control", "/v1/sequence/p1/c4/control"}, controlled)
+	assert.Len(t, results, 3)
+	assert.Equal(t, "c1", results[0].KeptnContext)
+	assert.Nil(t, results[0].Err)
+	assert.True(t, results[1].Skipped)
+	assert.EqualError(t, results[2].Err, "sequence is not running")
+
+	_, err = handler.BulkControlSequences(context.TODO(), filter, "stop", SequencesBulkControlSequencesOptions{})
+	assert.NotNil(t, err)
+	_, err = handler.BulkControlSequences(context.TODO(), SequenceStateFilter{}, models.PauseSequence, SequencesBulkControlSequencesOptions{})
+	assert.EqualError(t, err, "could not list sequences: project parameter not set")
+}
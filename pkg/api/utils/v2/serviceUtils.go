@@ -2,10 +2,8 @@ package v2
 
 import (
 	"context"
-	"crypto/tls"
 	"net/http"
 	"net/url"
-	"strings"
 
 	"github.com/keptn/go-utils/pkg/api/models"
 	"github.com/keptn/go-utils/pkg/common/httputils"
@@ -21,7 +19,10 @@ type ServicesDeleteServiceFromStageOptions struct{}
 type ServicesGetServiceOptions struct{}
 
 // ServicesGetAllServicesOptions are options for ServicesInterface.GetAllServices().
-type ServicesGetAllServicesOptions struct{}
+type ServicesGetAllServicesOptions struct {
+	// PageRetries overrides the page retries of the handler for this call
+	PageRetries *PageRetries
+}
 
 type ServicesInterface interface {
 
@@ -38,13 +39,17 @@ type ServicesInterface interface {
 	GetAllServices(ctx context.Context, project string, stage string, opts ServicesGetAllServicesOptions) ([]*models.Service, error)
 }
 
-// ServiceHandler handles services
+// ServiceHandler handles services.
+// It is safe for concurrent use by multiple goroutines, since it is not modified after it has been created
 type ServiceHandler struct {
-	baseURL    string
-	authToken  string
-	authHeader string
-	httpClient *http.Client
-	scheme     string
+	baseURL     string
+	authToken   string
+	authHeader  string
+	httpClient  *http.Client
+	scheme      string
+	idempotency idempotencyOptions
+	pageRetries pageRetryPolicy
+	errorDetailsSetting
 }
 
 // NewServiceHandler returns a new ServiceHandler which sends all requests directly to the configuration-service
@@ -57,14 +62,11 @@ func NewServiceHandlerWithHTTPClient(baseURL string, httpClient *http.Client) *S
 	return createServiceHandler(baseURL, "", "", httpClient, "http")
 }
 
-// NewAuthenticatedServiceHandler returns a new ServiceHandler that authenticates at the api via the provided token
+// NewAuthenticatedServiceHandler returns a new ServiceHandler that authenticates at the api via the provided token.
+// The base path of the service is added to the base URL according to basePathMode (default AppendMissingBasePath)
 // and sends all requests directly to the configuration-service
-func NewAuthenticatedServiceHandler(baseURL string, authToken string, authHeader string, httpClient *http.Client, scheme string) *ServiceHandler {
-	baseURL = strings.TrimRight(baseURL, "/")
-	if !strings.HasSuffix(baseURL, shipyardControllerBaseURL) {
-		baseURL += "/" + shipyardControllerBaseURL
-	}
-
+func NewAuthenticatedServiceHandler(baseURL string, authToken string, authHeader string, httpClient *http.Client, scheme string, basePathMode ...BasePathMode) *ServiceHandler {
+	baseURL = basePathModeOf(basePathMode).HandlerBaseURL(baseURL, HandlerServices)
 	return createServiceHandler(baseURL, authToken, authHeader, httpClient, scheme)
 }
 
@@ -101,19 +103,28 @@ func (s *ServiceHandler) CreateServiceInStage(ctx context.Context, project strin
 	if err != nil {
 		return nil, buildErrorResponse(err.Error())
 	}
-	return postWithEventContext(ctx, s.scheme+"://"+s.baseURL+v1ProjectPath+"/"+project+pathToStage+"/"+stage+pathToService, body, s)
+	if mErr := checkIdentifiers("project", project, "stage", stage); mErr != nil {
+		return nil, mErr
+	}
+	return s.idempotency.created(postWithEventContext(ctx, s.scheme+"://"+s.baseURL+v1ProjectPath+"/"+EscapeIdentifier(project)+pathToStage+"/"+EscapeIdentifier(stage)+pathToService, body, s))
 }
 
 // DeleteServiceFromStage deletes a service from a stage.
 func (s *ServiceHandler) DeleteServiceFromStage(ctx context.Context, project string, stage string, serviceName string, opts ServicesDeleteServiceFromStageOptions) (*models.EventContext, *models.Error) {
-	return deleteWithEventContext(ctx, s.scheme+"://"+s.baseURL+v1ProjectPath+"/"+project+pathToStage+"/"+stage+pathToService+"/"+serviceName, s)
+	if mErr := checkIdentifiers("project", project, "stage", stage, "service", serviceName); mErr != nil {
+		return nil, mErr
+	}
+	return s.idempotency.deleted(deleteWithEventContext(ctx, s.scheme+"://"+s.baseURL+v1ProjectPath+"/"+EscapeIdentifier(project)+pathToStage+"/"+EscapeIdentifier(stage)+pathToService+"/"+EscapeIdentifier(serviceName), s))
 }
 
 // GetService gets a service.
 func (s *ServiceHandler) GetService(ctx context.Context, project, stage, service string, opts ServicesGetServiceOptions) (*models.Service, error) {
-	http.DefaultTransport.(*http.Transport).TLSClientConfig = &tls.Config{InsecureSkipVerify: true}
+	if mErr := checkIdentifiers("project", project, "stage", stage, "service", service); mErr != nil {
+		return nil, mErr.ToError()
+	}
+	skipDefaultTransportVerification()
 
-	url, err := url.Parse(s.scheme + "://" + s.getBaseURL() + v1ProjectPath + "/" + project + pathToStage + "/" + stage + pathToService + "/" + service)
+	url, err := url.Parse(s.scheme + "://" + s.getBaseURL() + v1ProjectPath + "/" + EscapeIdentifier(project) + pathToStage + "/" + EscapeIdentifier(stage) + pathToService + "/" + EscapeIdentifier(service))
 	if err != nil {
 		return nil, err
 	}
@@ -132,24 +143,27 @@ func (s *ServiceHandler) GetService(ctx context.Context, project, stage, service
 
 // GetAllServices returns a list of all services.
 func (s *ServiceHandler) GetAllServices(ctx context.Context, project string, stage string, opts ServicesGetAllServicesOptions) ([]*models.Service, error) {
-
-	http.DefaultTransport.(*http.Transport).TLSClientConfig = &tls.Config{InsecureSkipVerify: true}
+	if mErr := checkIdentifiers("project", project, "stage", stage); mErr != nil {
+		return nil, mErr.ToError()
+	}
+	skipDefaultTransportVerification()
 	services := []*models.Service{}
 
-	nextPageKey := ""
+	cursor := models.Cursor{}
+	pageRetries := s.pageRetries.override(opts.PageRetries)
 
 	for {
-		url, err := url.Parse(s.scheme + "://" + s.getBaseURL() + v1ProjectPath + "/" + project + pathToStage + "/" + stage + pathToService)
+		url, err := url.Parse(s.scheme + "://" + s.getBaseURL() + v1ProjectPath + "/" + EscapeIdentifier(project) + pathToStage + "/" + EscapeIdentifier(stage) + pathToService)
 		if err != nil {
 			return nil, err
 		}
 		q := url.Query()
-		if nextPageKey != "" {
+		if nextPageKey := cursor.Encode(); nextPageKey != "" {
 			q.Set("nextPageKey", nextPageKey)
 			url.RawQuery = q.Encode()
 		}
 
-		body, mErr := getAndExpectOK(ctx, url.String(), s)
+		body, mErr := pageRetries.getPage(ctx, url.String(), s)
 		if mErr != nil {
 			return nil, mErr.ToError()
 		}
@@ -160,10 +174,12 @@ func (s *ServiceHandler) GetAllServices(ctx context.Context, project string, sta
 		}
 		services = append(services, received.Services...)
 
-		if received.NextPageKey == "" || received.NextPageKey == "0" {
+		if cursor, err = received.Cursor(); err != nil {
+			return nil, err
+		}
+		if cursor.IsEnd() {
 			break
 		}
-		nextPageKey = received.NextPageKey
 	}
 
 	return services, nil
@@ -2,11 +2,8 @@ package v2
 
 import (
 	"context"
-	"crypto/tls"
 	"net/http"
 	"net/url"
-	"strconv"
-	"strings"
 
 	"github.com/keptn/go-utils/pkg/api/models"
 	"github.com/keptn/go-utils/pkg/common/httputils"
@@ -15,19 +12,26 @@ import (
 const shipyardControllerBaseURL = "controlPlane"
 
 // ShipyardControlGetOpenTriggeredEventsOptions are options for ShipyardControlInterface.GetOpenTriggeredEvents().
-type ShipyardControlGetOpenTriggeredEventsOptions struct{}
+type ShipyardControlGetOpenTriggeredEventsOptions struct {
+	// PageRetries overrides the page retries of the handler for this call
+	PageRetries *PageRetries
+}
 
 type ShipyardControlInterface interface {
 	// GetOpenTriggeredEvents returns all open triggered events.
 	GetOpenTriggeredEvents(ctx context.Context, filter EventFilter, opts ShipyardControlGetOpenTriggeredEventsOptions) ([]*models.KeptnContextExtendedCE, error)
 }
 
+// ShipyardControllerHandler handles the open triggered events of the shipyard controller.
+// It is safe for concurrent use by multiple goroutines, since it is not modified after it has been created
 type ShipyardControllerHandler struct {
-	baseURL    string
-	authToken  string
-	authHeader string
-	httpClient *http.Client
-	scheme     string
+	baseURL     string
+	authToken   string
+	authHeader  string
+	httpClient  *http.Client
+	scheme      string
+	pageRetries pageRetryPolicy
+	errorDetailsSetting
 }
 
 // NewShipyardControllerHandler returns a new ShipyardControllerHandler which sends all requests directly to the configuration-service
@@ -40,14 +44,11 @@ func NewShipyardControllerHandlerWithHTTPClient(baseURL string, httpClient *http
 	return createShipyardControllerHandler(baseURL, "", "", httpClient, "http")
 }
 
-// NewAuthenticatedShipyardControllerHandler returns a new ShipyardControllerHandler that authenticates at the api via the provided token
+// NewAuthenticatedShipyardControllerHandler returns a new ShipyardControllerHandler that authenticates at the api via the provided token.
+// The base path of the service is added to the base URL according to basePathMode (default AppendMissingBasePath)
 // and sends all requests directly to the configuration-service
-func NewAuthenticatedShipyardControllerHandler(baseURL string, authToken string, authHeader string, httpClient *http.Client, scheme string) *ShipyardControllerHandler {
-	baseURL = strings.TrimRight(baseURL, "/")
-	if !strings.HasSuffix(baseURL, shipyardControllerBaseURL) {
-		baseURL += "/" + shipyardControllerBaseURL
-	}
-
+func NewAuthenticatedShipyardControllerHandler(baseURL string, authToken string, authHeader string, httpClient *http.Client, scheme string, basePathMode ...BasePathMode) *ShipyardControllerHandler {
+	baseURL = basePathModeOf(basePathMode).HandlerBaseURL(baseURL, HandlerShipyardControl)
 	return createShipyardControllerHandler(baseURL, authToken, authHeader, httpClient, scheme)
 }
 
@@ -79,16 +80,17 @@ func (s *ShipyardControllerHandler) getHTTPClient() *http.Client {
 
 // GetOpenTriggeredEvents returns all open triggered events.
 func (s *ShipyardControllerHandler) GetOpenTriggeredEvents(ctx context.Context, filter EventFilter, opts ShipyardControlGetOpenTriggeredEventsOptions) ([]*models.KeptnContextExtendedCE, error) {
-	http.DefaultTransport.(*http.Transport).TLSClientConfig = &tls.Config{InsecureSkipVerify: true}
+	skipDefaultTransportVerification()
 
 	events := []*models.KeptnContextExtendedCE{}
-	nextPageKey := ""
+	cursor := models.Cursor{}
+	pageRetries := s.pageRetries.override(opts.PageRetries)
 
 	for {
-		url, err := url.Parse(s.scheme + "://" + s.getBaseURL() + v1EventPath + "/triggered/" + filter.EventType)
+		url, err := url.Parse(s.scheme + "://" + s.getBaseURL() + v1EventPath + "/triggered/" + EscapeIdentifier(filter.EventType))
 
 		q := url.Query()
-		if nextPageKey != "" {
+		if nextPageKey := cursor.Encode(); nextPageKey != "" {
 			q.Set("nextPageKey", nextPageKey)
 			url.RawQuery = q.Encode()
 		}
@@ -101,6 +103,12 @@ func (s *ShipyardControllerHandler) GetOpenTriggeredEvents(ctx context.Context,
 		if filter.Stage != "" {
 			q.Set("stage", filter.Stage)
 		}
+		if filter.FromTime != "" {
+			q.Set("fromTime", filter.FromTime)
+		}
+		if filter.EventID != "" {
+			q.Set("eventID", filter.EventID)
+		}
 
 		url.RawQuery = q.Encode()
 
@@ -108,7 +116,7 @@ func (s *ShipyardControllerHandler) GetOpenTriggeredEvents(ctx context.Context,
 			return nil, err
 		}
 
-		body, mErr := getAndExpectOK(ctx, url.String(), s)
+		body, mErr := pageRetries.getPage(ctx, url.String(), s)
 		if mErr != nil {
 			return nil, mErr.ToError()
 		}
@@ -119,17 +127,12 @@ func (s *ShipyardControllerHandler) GetOpenTriggeredEvents(ctx context.Context,
 		}
 		events = append(events, received.Events...)
 
-		if received.NextPageKey == "" || received.NextPageKey == "0" {
-			break
+		if cursor, err = received.Cursor(); err != nil {
+			return nil, err
 		}
-
-		nextPageKeyInt, _ := strconv.Atoi(received.NextPageKey)
-
-		if filter.NumberOfPages > 0 && nextPageKeyInt >= filter.NumberOfPages {
+		if cursor.IsEnd() || reachedNumberOfPages(cursor, filter.NumberOfPages) {
 			break
 		}
-
-		nextPageKey = received.NextPageKey
 	}
 	return events, nil
 }
@@ -0,0 +1,164 @@
+package v2
+
+import (
+	"crypto/tls"
+	"log"
+	"net/http"
+	"net/http/httptrace"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// slowCallEventName is the name of the span event recorded for a slow call
+const slowCallEventName = "keptn.slow_call"
+
+// CallTiming is the breakdown of the duration of a call, as recorded via httptrace.
+// Phases which did not happen, e.g. the DNS lookup of a call using an idle connection, have a duration of 0
+type CallTiming struct {
+	// GetConnection is the time until a connection has been obtained, including DNS, Connect and TLSHandshake
+	GetConnection time.Duration `json:"getConnection"`
+	DNS           time.Duration `json:"dns"`
+	Connect       time.Duration `json:"connect"`
+	TLSHandshake  time.Duration `json:"tlsHandshake"`
+	// ServerProcessing is the time from writing the request until the first byte of the response has been received
+	ServerProcessing time.Duration `json:"serverProcessing"`
+	// ReusedConnection is true if the call used an idle connection
+	ReusedConnection bool `json:"reusedConnection"`
+}
+
+// SlowCall describes a call to the Keptn API which took longer than the threshold configured via WithSlowCallThreshold
+type SlowCall struct {
+	// Operation names the call by its handler, method and path, e.g. "projects GET /api/controlPlane/v1/project"
+	Operation string `json:"operation"`
+	// Handler is the name of the handler which sent the call, e.g. HandlerProjects
+	Handler string `json:"handler"`
+	Method  string `json:"method"`
+	// URL is the URL of the call, without query parameters
+	URL string `json:"url"`
+	// StatusCode is the HTTP status code of the response, or 0 if no response was received
+	StatusCode int `json:"statusCode"`
+	// Error contains the transport error, if the call failed
+	Error string `json:"error,omitempty"`
+	// Duration is the time until the response headers have been received. Reading the response body is not included
+	Duration time.Duration `json:"duration"`
+	Timing   CallTiming    `json:"timing"`
+}
+
+// SlowCallHandler is called for every call to the Keptn API which took longer than the slow call threshold
+type SlowCallHandler func(call SlowCall)
+
+// WithSlowCallThreshold reports every call to the Keptn API which takes longer than threshold, so that slow endpoints
+// can be found without a tracing backend. A slow call is written to the log, recorded as event keptn.slow_call on the
+// span of the context of the call, if any, and passed to the handler, which may be nil.
+// Each attempt of a call retried via WithOperationClasses is reported on its own. A threshold which is not positive disables the reports
+func WithSlowCallThreshold(threshold time.Duration, handler SlowCallHandler) func(*APISet) {
+	return func(a *APISet) {
+		a.slowCallThreshold = threshold
+		a.slowCallHandler = handler
+	}
+}
+
+// slowCallTransport is a http.RoundTripper which reports the requests taking longer than the threshold.
+// The requests are reported with the name of the handler they have been sent by, see handlerNameTransport
+type slowCallTransport struct {
+	base      http.RoundTripper
+	threshold time.Duration
+	report    SlowCallHandler
+}
+
+func (t *slowCallTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	recorder := &callTimingRecorder{}
+	start := time.Now()
+	ctx := httptrace.WithClientTrace(req.Context(), recorder.clientTrace(start))
+	resp, err := t.base.RoundTrip(req.WithContext(ctx))
+	duration := time.Since(start)
+	if duration <= t.threshold {
+		return resp, err
+	}
+
+	handler, _ := req.Context().Value(handlerContextKey{}).(string)
+	path := req.URL.Path
+	call := SlowCall{
+		Operation: handler + " " + req.Method + " " + path,
+		Handler:   handler,
+		Method:    req.Method,
+		URL:       req.URL.Scheme + "://" + req.URL.Host + path,
+		Duration:  duration,
+		Timing:    recorder.get(),
+	}
+	if err != nil {
+		call.Error = err.Error()
+	} else {
+		call.StatusCode = resp.StatusCode
+	}
+
+	log.Printf("Slow call to Keptn API: %s took %s (connection: %s, server processing: %s)",
+		call.Operation, call.Duration, call.Timing.GetConnection, call.Timing.ServerProcessing)
+	trace.SpanFromContext(req.Context()).AddEvent(slowCallEventName, trace.WithAttributes(
+		attribute.String("keptn.operation", call.Operation),
+		attribute.Int64("keptn.duration_ms", call.Duration.Milliseconds()),
+		attribute.Int64("keptn.server_processing_ms", call.Timing.ServerProcessing.Milliseconds()),
+		attribute.Int("http.status_code", call.StatusCode),
+	))
+	if t.report != nil {
+		t.report(call)
+	}
+	return resp, err
+}
+
+// callTimingRecorder records the CallTiming of a single call.
+// The hooks of the httptrace.ClientTrace may be called from different goroutines
+type callTimingRecorder struct {
+	mtx    sync.Mutex
+	timing CallTiming
+}
+
+func (r *callTimingRecorder) get() CallTiming {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+	return r.timing
+}
+
+func (r *callTimingRecorder) update(fn func(timing *CallTiming)) {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+	fn(&r.timing)
+}
+
+func (r *callTimingRecorder) clientTrace(start time.Time) *httptrace.ClientTrace {
+	var dnsStart, connectStart, tlsStart, wroteRequest time.Time
+	mark := func(t *time.Time) {
+		r.update(func(*CallTiming) { *t = time.Now() })
+	}
+	return &httptrace.ClientTrace{
+		GotConn: func(info httptrace.GotConnInfo) {
+			r.update(func(timing *CallTiming) {
+				timing.GetConnection = time.Since(start)
+				timing.ReusedConnection = info.Reused
+			})
+		},
+		DNSStart: func(httptrace.DNSStartInfo) { mark(&dnsStart) },
+		DNSDone: func(httptrace.DNSDoneInfo) {
+			r.update(func(timing *CallTiming) { timing.DNS = time.Since(dnsStart) })
+		},
+		ConnectStart: func(string, string) { mark(&connectStart) },
+		ConnectDone: func(string, string, error) {
+			r.update(func(timing *CallTiming) { timing.Connect = time.Since(connectStart) })
+		},
+		TLSHandshakeStart: func() { mark(&tlsStart) },
+		TLSHandshakeDone: func(tls.ConnectionState, error) {
+			r.update(func(timing *CallTiming) { timing.TLSHandshake = time.Since(tlsStart) })
+		},
+		WroteRequest: func(httptrace.WroteRequestInfo) { mark(&wroteRequest) },
+		GotFirstResponseByte: func() {
+			r.update(func(timing *CallTiming) {
+				if !wroteRequest.IsZero() {
+					timing.ServerProcessing = time.Since(wroteRequest)
+				}
+			})
+		},
+	}
+}
@@ -0,0 +1,109 @@
+package v2
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/keptn/go-utils/pkg/api/models"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWithSlowCallThreshold(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("slow") == "true" {
+			time.Sleep(100 * time.Millisecond)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"projectName":"my-project"}`))
+	}))
+	defer server.Close()
+
+	var mtx sync.Mutex
+	var calls []SlowCall
+	apiSet, err := New(server.URL, WithSlowCallThreshold(50*time.Millisecond, func(call SlowCall) {
+		mtx.Lock()
+		defer mtx.Unlock()
+		calls = append(calls, call)
+	}))
+	require.NoError(t, err)
+	require.Equal(t, 50*time.Millisecond, apiSet.Capabilities().SlowCallThreshold)
+
+	client := apiSet.projectHandler.httpClient
+	resp, err := client.Get(server.URL + "/api/controlPlane/v1/project/my-project")
+	require.NoError(t, err)
+	resp.Body.Close()
+	require.Empty(t, calls)
+
+	req, err := http.NewRequestWithContext(context.TODO(), http.MethodGet, server.URL+"/api/controlPlane/v1/project/my-project?slow=true", nil)
+	require.NoError(t, err)
+	resp, err = client.Do(req)
+	require.NoError(t, err)
+	resp.Body.Close()
+
+	mtx.Lock()
+	defer mtx.Unlock()
+	require.Len(t, calls, 1)
+	call := calls[0]
+	require.Equal(t, "projects GET /api/controlPlane/v1/project/my-project", call.Operation)
+	require.Equal(t, HandlerProjects, call.Handler)
+	require.Equal(t, server.URL+"/api/controlPlane/v1/project/my-project", call.URL)
+	require.Equal(t, http.StatusOK, call.StatusCode)
+	require.Empty(t, call.Error)
+	require.GreaterOrEqual(t, call.Duration, 100*time.Millisecond)
+	require.GreaterOrEqual(t, call.Timing.ServerProcessing, 100*time.Millisecond)
+}
+
+func TestWithSlowCallThreshold_ReportsEachAttempt(t *testing.T) {
+	var mtx sync.Mutex
+	attempts := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mtx.Lock()
+		attempts++
+		attempt := attempts
+		mtx.Unlock()
+		time.Sleep(60 * time.Millisecond)
+		if attempt == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.Write([]byte(`{"projectName":"my-project"}`))
+	}))
+	defer server.Close()
+
+	var calls []SlowCall
+	apiSet, err := New(server.URL,
+		WithOperationClasses(map[OperationClass]OperationPolicy{ReadOperation: {MaxRetries: 1, InitialBackoff: time.Millisecond}}),
+		WithSlowCallThreshold(50*time.Millisecond, func(call SlowCall) {
+			mtx.Lock()
+			defer mtx.Unlock()
+			calls = append(calls, call)
+		}),
+	)
+	require.NoError(t, err)
+
+	_, mErr := apiSet.Projects().GetProject(context.TODO(), models.Project{ProjectName: "my-project"}, ProjectsGetProjectOptions{})
+	require.Nil(t, mErr)
+
+	mtx.Lock()
+	defer mtx.Unlock()
+	require.Len(t, calls, 2)
+	require.Equal(t, http.StatusServiceUnavailable, calls[0].StatusCode)
+	require.Equal(t, http.StatusOK, calls[1].StatusCode)
+	for _, call := range calls {
+		require.Equal(t, HandlerProjects, call.Handler)
+		require.Less(t, call.Duration, 120*time.Millisecond)
+	}
+}
+
+func TestWithSlowCallThreshold_Disabled(t *testing.T) {
+	apiSet, err := New("http://localhost:8080", WithSlowCallThreshold(0, nil))
+	require.NoError(t, err)
+	// requests are only marked with the name of their handler if they are reported
+	_, ok := apiSet.projectHandler.httpClient.Transport.(*handlerNameTransport)
+	require.False(t, ok)
+	require.Zero(t, apiSet.Capabilities().SlowCallThreshold)
+}
@@ -2,10 +2,8 @@ package v2
 
 import (
 	"context"
-	"crypto/tls"
 	"net/http"
 	"net/url"
-	"strings"
 
 	"github.com/keptn/go-utils/pkg/api/models"
 	"github.com/keptn/go-utils/pkg/common/httputils"
@@ -16,7 +14,10 @@ import (
 type StagesCreateStageOptions struct{}
 
 // StagesGetAllStagesOptions are options for StagesInterface.GetAllStages().
-type StagesGetAllStagesOptions struct{}
+type StagesGetAllStagesOptions struct {
+	// PageRetries overrides the page retries of the handler for this call
+	PageRetries *PageRetries
+}
 
 type StagesInterface interface {
 
@@ -27,13 +28,17 @@ type StagesInterface interface {
 	GetAllStages(ctx context.Context, project string, opts StagesGetAllStagesOptions) ([]*models.Stage, error)
 }
 
-// StageHandler handles stages
+// StageHandler handles stages.
+// It is safe for concurrent use by multiple goroutines, since it is not modified after it has been created
 type StageHandler struct {
-	baseURL    string
-	authToken  string
-	authHeader string
-	httpClient *http.Client
-	scheme     string
+	baseURL     string
+	authToken   string
+	authHeader  string
+	httpClient  *http.Client
+	scheme      string
+	idempotency idempotencyOptions
+	pageRetries pageRetryPolicy
+	errorDetailsSetting
 }
 
 // NewStageHandler returns a new StageHandler which sends all requests directly to the configuration-service
@@ -46,14 +51,11 @@ func NewStageHandlerWithHTTPClient(baseURL string, httpClient *http.Client) *Sta
 	return createStageHandler(baseURL, "", "", httpClient, "http")
 }
 
-// NewAuthenticatedStageHandler returns a new StageHandler that authenticates at the api via the provided token
+// NewAuthenticatedStageHandler returns a new StageHandler that authenticates at the api via the provided token.
+// The base path of the service is added to the base URL according to basePathMode (default AppendMissingBasePath)
 // and sends all requests directly to the configuration-service
-func NewAuthenticatedStageHandler(baseURL string, authToken string, authHeader string, httpClient *http.Client, scheme string) *StageHandler {
-	baseURL = strings.TrimRight(baseURL, "/")
-	if !strings.HasSuffix(baseURL, shipyardControllerBaseURL) {
-		baseURL += "/" + shipyardControllerBaseURL
-	}
-
+func NewAuthenticatedStageHandler(baseURL string, authToken string, authHeader string, httpClient *http.Client, scheme string, basePathMode ...BasePathMode) *StageHandler {
+	baseURL = basePathModeOf(basePathMode).HandlerBaseURL(baseURL, HandlerStages)
 	return createStageHandler(baseURL, authToken, authHeader, httpClient, scheme)
 }
 
@@ -90,27 +92,34 @@ func (s *StageHandler) CreateStage(ctx context.Context, project string, stageNam
 	if err != nil {
 		return nil, buildErrorResponse(err.Error())
 	}
-	return postWithEventContext(ctx, s.scheme+"://"+s.baseURL+v1ProjectPath+"/"+project+pathToStage, body, s)
+	if mErr := checkIdentifiers("project", project); mErr != nil {
+		return nil, mErr
+	}
+	return s.idempotency.created(postWithEventContext(ctx, s.scheme+"://"+s.baseURL+v1ProjectPath+"/"+EscapeIdentifier(project)+pathToStage, body, s))
 }
 
 // GetAllStages returns a list of all stages.
 func (s *StageHandler) GetAllStages(ctx context.Context, project string, opts StagesGetAllStagesOptions) ([]*models.Stage, error) {
-	http.DefaultTransport.(*http.Transport).TLSClientConfig = &tls.Config{InsecureSkipVerify: true}
+	if mErr := checkIdentifiers("project", project); mErr != nil {
+		return nil, mErr.ToError()
+	}
+	skipDefaultTransportVerification()
 	stages := []*models.Stage{}
 
-	nextPageKey := ""
+	cursor := models.Cursor{}
+	pageRetries := s.pageRetries.override(opts.PageRetries)
 	for {
-		url, err := url.Parse(s.scheme + "://" + s.getBaseURL() + v1ProjectPath + "/" + project + pathToStage)
+		url, err := url.Parse(s.scheme + "://" + s.getBaseURL() + v1ProjectPath + "/" + EscapeIdentifier(project) + pathToStage)
 		if err != nil {
 			return nil, err
 		}
 		q := url.Query()
-		if nextPageKey != "" {
+		if nextPageKey := cursor.Encode(); nextPageKey != "" {
 			q.Set("nextPageKey", nextPageKey)
 			url.RawQuery = q.Encode()
 		}
 
-		body, mErr := getAndExpectOK(ctx, url.String(), s)
+		body, mErr := pageRetries.getPage(ctx, url.String(), s)
 		if mErr != nil {
 			return nil, mErr.ToError()
 		}
@@ -121,10 +130,12 @@ func (s *StageHandler) GetAllStages(ctx context.Context, project string, opts St
 		}
 		stages = append(stages, received.Stages...)
 
-		if received.NextPageKey == "" || received.NextPageKey == "0" {
+		if cursor, err = received.Cursor(); err != nil {
+			return nil, err
+		}
+		if cursor.IsEnd() {
 			break
 		}
-		nextPageKey = received.NextPageKey
 	}
 	return stages, nil
 }
@@ -0,0 +1,152 @@
+package v2
+
+import (
+	"crypto/tls"
+	"net/http"
+	"net/http/httptrace"
+	"sync"
+	"time"
+)
+
+// TransportStats contains transport-level statistics about the requests sent by a handler
+type TransportStats struct {
+	// Requests is the number of requests sent
+	Requests int64
+	// NewConnections is the number of requests for which a new connection had to be established
+	NewConnections int64
+	// ReusedConnections is the number of requests that reused an idle connection
+	ReusedConnections int64
+	// DNSDuration is the total time spent on DNS lookups
+	DNSDuration time.Duration
+	// ConnectDuration is the total time spent on establishing TCP connections
+	ConnectDuration time.Duration
+	// TLSHandshakeDuration is the total time spent on TLS handshakes
+	TLSHandshakeDuration time.Duration
+	// InFlight is the number of requests which currently have a connection and whose response body has not been closed yet.
+	// Requests waiting for a free slot, see WithMaxConcurrentRequests, are not in flight
+	InFlight int64
+}
+
+// APISetStats contains the TransportStats of all handlers of an APISet, keyed by the name of the handler
+type APISetStats map[string]TransportStats
+
+// InFlight returns the number of requests all handlers currently have in flight
+func (s APISetStats) InFlight() int64 {
+	var inFlight int64
+	for _, stats := range s {
+		inFlight += stats.InFlight
+	}
+	return inFlight
+}
+
+// transportStatsCollector aggregates the TransportStats of the requests sent through it
+type transportStatsCollector struct {
+	mtx   sync.Mutex
+	stats TransportStats
+}
+
+func (c *transportStatsCollector) get() TransportStats {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	return c.stats
+}
+
+func (c *transportStatsCollector) update(fn func(stats *TransportStats)) {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	fn(&c.stats)
+}
+
+// clientTrace returns a httptrace.ClientTrace recording the statistics of a single request.
+// gotConn is called whenever the request obtained a connection. The hooks may be called from different goroutines,
+// so the start times are guarded by a mutex
+func (c *transportStatsCollector) clientTrace(gotConn func()) *httptrace.ClientTrace {
+	var mtx sync.Mutex
+	var dnsStart, connectStart, tlsStart time.Time
+	mark := func(t *time.Time) {
+		mtx.Lock()
+		defer mtx.Unlock()
+		*t = time.Now()
+	}
+	since := func(t *time.Time) time.Duration {
+		mtx.Lock()
+		defer mtx.Unlock()
+		return time.Since(*t)
+	}
+	return &httptrace.ClientTrace{
+		GotConn: func(info httptrace.GotConnInfo) {
+			gotConn()
+			c.update(func(stats *TransportStats) {
+				if info.Reused {
+					stats.ReusedConnections++
+				} else {
+					stats.NewConnections++
+				}
+			})
+		},
+		DNSStart: func(httptrace.DNSStartInfo) { mark(&dnsStart) },
+		DNSDone: func(httptrace.DNSDoneInfo) {
+			d := since(&dnsStart)
+			c.update(func(stats *TransportStats) { stats.DNSDuration += d })
+		},
+		ConnectStart: func(string, string) { mark(&connectStart) },
+		ConnectDone: func(string, string, error) {
+			d := since(&connectStart)
+			c.update(func(stats *TransportStats) { stats.ConnectDuration += d })
+		},
+		TLSHandshakeStart: func() { mark(&tlsStart) },
+		TLSHandshakeDone: func(tls.ConnectionState, error) {
+			d := since(&tlsStart)
+			c.update(func(stats *TransportStats) { stats.TLSHandshakeDuration += d })
+		},
+	}
+}
+
+// statsTransport is a http.RoundTripper which records transport-level statistics
+// for all requests sent through it
+type statsTransport struct {
+	base      http.RoundTripper
+	collector *transportStatsCollector
+}
+
+func (t *statsTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	t.collector.update(func(stats *TransportStats) { stats.Requests++ })
+	// a request is in flight from obtaining its first connection until it is done
+	var mtx sync.Mutex
+	var inFlight, finished bool
+	gotConn := func() {
+		mtx.Lock()
+		defer mtx.Unlock()
+		if !inFlight && !finished {
+			inFlight = true
+			t.collector.update(func(stats *TransportStats) { stats.InFlight++ })
+		}
+	}
+	done := func() {
+		mtx.Lock()
+		defer mtx.Unlock()
+		finished = true
+		if inFlight {
+			t.collector.update(func(stats *TransportStats) { stats.InFlight-- })
+		}
+	}
+	ctx := httptrace.WithClientTrace(req.Context(), t.collector.clientTrace(gotConn))
+	resp, err := t.base.RoundTrip(req.WithContext(ctx))
+	if err != nil {
+		done()
+		return nil, err
+	}
+	return withReleaseOnClose(resp, done), nil
+}
+
+// withTransportStats returns a copy of the given http.Client whose transport
+// reports its statistics to the given collector
+func withTransportStats(httpClient *http.Client, collector *transportStatsCollector) *http.Client {
+	client := *httpClient
+	base := client.Transport
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	client.Transport = &statsTransport{base: base, collector: collector}
+	return &client
+}
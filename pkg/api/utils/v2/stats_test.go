@@ -0,0 +1,60 @@
+package v2
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/keptn/go-utils/pkg/common/testutils"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAPISet_Stats(t *testing.T) {
+	simulator := testutils.NewAPISimulator()
+	defer simulator.Close()
+
+	apiSet, err := New(simulator.URL)
+	require.Nil(t, err)
+
+	for i := 0; i < 3; i++ {
+		_, mErr := apiSet.Events().GetEvents(context.TODO(), &EventFilter{}, EventsGetEventsOptions{})
+		require.Nil(t, mErr)
+	}
+
+	stats := apiSet.Stats()
+	require.Len(t, stats, 12)
+	require.Equal(t, int64(3), stats["events"].Requests)
+	require.Equal(t, int64(1), stats["events"].NewConnections)
+	require.Equal(t, int64(2), stats["events"].ReusedConnections)
+	require.Equal(t, TransportStats{}, stats["projects"])
+}
+
+func TestAPISet_Stats_ConcurrentTLSRequests(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"events":[]}`))
+	}))
+	defer server.Close()
+
+	apiSet, err := New(server.URL)
+	require.Nil(t, err)
+
+	// the trace hooks of new connections are called from the goroutines dialing them, run with -race
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, mErr := apiSet.Events().GetEvents(context.TODO(), &EventFilter{}, EventsGetEventsOptions{})
+			require.Nil(t, mErr)
+		}()
+	}
+	wg.Wait()
+
+	stats := apiSet.Stats()[HandlerEvents]
+	require.Equal(t, int64(10), stats.Requests)
+	require.Equal(t, int64(10), stats.NewConnections+stats.ReusedConnections)
+	require.Positive(t, stats.NewConnections)
+	require.Positive(t, stats.TLSHandshakeDuration)
+}
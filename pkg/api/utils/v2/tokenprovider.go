@@ -0,0 +1,149 @@
+package v2
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"strings"
+	"time"
+
+	"golang.org/x/oauth2"
+)
+
+// tokenProviderTimeout bounds the time for resolving the initial token when the APISet is created
+const tokenProviderTimeout = 30 * time.Second
+
+// ErrNoToken is returned by a TokenProvider which has no token to provide, e.g. because an environment variable is not set
+var ErrNoToken = errors.New("no token available")
+
+// TokenProvider resolves the token used to authenticate at the Keptn API.
+// It is called when the APISet is created and again whenever the Keptn API rejects a request with 401 Unauthorized
+type TokenProvider interface {
+	Token(ctx context.Context) (string, error)
+}
+
+// TokenProviderFunc allows to use a function as TokenProvider, e.g. the TokenProvider method of kubeutils.APITokenProvider
+type TokenProviderFunc func(ctx context.Context) (string, error)
+
+// Token calls the function
+func (f TokenProviderFunc) Token(ctx context.Context) (string, error) {
+	return f(ctx)
+}
+
+// EnvTokenProvider provides the token set in the given environment variable, e.g. EnvVarKeptnAPIToken
+func EnvTokenProvider(envVar string) TokenProvider {
+	return TokenProviderFunc(func(ctx context.Context) (string, error) {
+		if token := os.Getenv(envVar); token != "" {
+			return token, nil
+		}
+		return "", fmt.Errorf("environment variable %s: %w", envVar, ErrNoToken)
+	})
+}
+
+// FileTokenProvider provides the token stored in the file at the given path, e.g. a mounted Kubernetes secret.
+// The file is read again every time the token is resolved, so that a rotated secret is picked up
+func FileTokenProvider(path string) TokenProvider {
+	return TokenProviderFunc(func(ctx context.Context) (string, error) {
+		content, err := ioutil.ReadFile(path)
+		if errors.Is(err, os.ErrNotExist) {
+			return "", fmt.Errorf("file %s: %w", path, ErrNoToken)
+		}
+		if err != nil {
+			return "", fmt.Errorf("could not read token from file %s: %w", path, err)
+		}
+		token := strings.TrimSpace(string(content))
+		if token == "" {
+			return "", fmt.Errorf("file %s is empty: %w", path, ErrNoToken)
+		}
+		return token, nil
+	})
+}
+
+// OAuthTokenProvider provides the access token of the given oauth2.TokenSource, including its type, e.g. "Bearer <token>".
+// It is meant to be used with the Authorization header, see WithTokenProvider
+func OAuthTokenProvider(source oauth2.TokenSource) TokenProvider {
+	return TokenProviderFunc(func(ctx context.Context) (string, error) {
+		token, err := source.Token()
+		if err != nil {
+			return "", fmt.Errorf("could not obtain OAuth token: %w", err)
+		}
+		return token.Type() + " " + token.AccessToken, nil
+	})
+}
+
+// tokenProviderChain is a TokenProvider which asks a list of TokenProviders in order of their priority
+type tokenProviderChain struct {
+	providers []TokenProvider
+}
+
+// ChainTokenProviders returns a TokenProvider which provides the token of the first of the given providers that returns one,
+// e.g. ChainTokenProviders(EnvTokenProvider(EnvVarKeptnAPIToken), FileTokenProvider("/var/run/secrets/keptn/token")).
+// If a token resolved by the chain is rejected by the Keptn API, it is resolved again. In this case, providers
+// which still return a rejected token are skipped, so that the next provider in the chain is used
+func ChainTokenProviders(providers ...TokenProvider) TokenProvider {
+	return &tokenProviderChain{providers: providers}
+}
+
+// Token returns the token of the first provider that returns one
+func (c *tokenProviderChain) Token(ctx context.Context) (string, error) {
+	return c.tokenExcept(ctx, nil)
+}
+
+// tokenExcept returns the token of the first provider that returns a token which has not been rejected
+func (c *tokenProviderChain) tokenExcept(ctx context.Context, rejected map[string]bool) (string, error) {
+	var messages []string
+	for _, provider := range c.providers {
+		token, err := provider.Token(ctx)
+		if err != nil {
+			messages = append(messages, err.Error())
+			continue
+		}
+		if token != "" && !rejected[token] {
+			return token, nil
+		}
+	}
+	if len(messages) == 0 {
+		return "", ErrNoToken
+	}
+	return "", fmt.Errorf("%w: %s", ErrNoToken, strings.Join(messages, "; "))
+}
+
+// WithTokenProvider configures a TokenProvider which resolves the token when the APISet is created and again
+// whenever a request is rejected with 401 Unauthorized, like a TokenRefresher which it replaces.
+// Optionally a custom auth header can be set (default x-token), e.g. Authorization for an OAuthTokenProvider.
+// All providers of a chain use the same auth header
+func WithTokenProvider(provider TokenProvider, authHeader ...string) func(*APISet) {
+	aHeader := "x-token"
+	if len(authHeader) > 0 {
+		aHeader = authHeader[0]
+	}
+	return func(a *APISet) {
+		a.tokenProvider = provider
+		a.authHeader = aHeader
+	}
+}
+
+// providerRefresher returns a TokenRefresher which resolves the token via the given provider.
+// All tokens rejected so far are remembered, so that a chain does not alternate between rejected tokens of different providers.
+// Tokens are refreshed one at a time by the refreshingTransport, so no further synchronization is needed
+func providerRefresher(provider TokenProvider, initialToken string) TokenRefresher {
+	current := initialToken
+	rejected := map[string]bool{}
+	return func(ctx context.Context) (string, error) {
+		rejected[current] = true
+		var token string
+		var err error
+		if chain, ok := provider.(*tokenProviderChain); ok {
+			token, err = chain.tokenExcept(ctx, rejected)
+		} else {
+			token, err = provider.Token(ctx)
+		}
+		if err != nil {
+			return "", err
+		}
+		current = token
+		return token, nil
+	}
+}
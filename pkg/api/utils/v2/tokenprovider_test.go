@@ -0,0 +1,118 @@
+package v2
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/keptn/go-utils/pkg/api/models"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/oauth2"
+)
+
+func TestEnvTokenProvider(t *testing.T) {
+	t.Setenv("KEPTN_TEST_TOKEN", "")
+	_, err := EnvTokenProvider("KEPTN_TEST_TOKEN").Token(context.TODO())
+	require.True(t, errors.Is(err, ErrNoToken))
+
+	t.Setenv("KEPTN_TEST_TOKEN", "env-token")
+	token, err := EnvTokenProvider("KEPTN_TEST_TOKEN").Token(context.TODO())
+	require.Nil(t, err)
+	require.Equal(t, "env-token", token)
+}
+
+func TestFileTokenProvider(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "token")
+	provider := FileTokenProvider(path)
+
+	_, err := provider.Token(context.TODO())
+	require.True(t, errors.Is(err, ErrNoToken))
+
+	require.Nil(t, os.WriteFile(path, []byte("file-token\n"), 0600))
+	token, err := provider.Token(context.TODO())
+	require.Nil(t, err)
+	require.Equal(t, "file-token", token)
+
+	// a rotated token is picked up
+	require.Nil(t, os.WriteFile(path, []byte("rotated-token"), 0600))
+	token, err = provider.Token(context.TODO())
+	require.Nil(t, err)
+	require.Equal(t, "rotated-token", token)
+}
+
+func TestOAuthTokenProvider(t *testing.T) {
+	token, err := OAuthTokenProvider(oauth2.StaticTokenSource(&oauth2.Token{AccessToken: "access-token"})).Token(context.TODO())
+	require.Nil(t, err)
+	require.Equal(t, "Bearer access-token", token)
+}
+
+func TestChainTokenProviders(t *testing.T) {
+	t.Setenv("KEPTN_TEST_TOKEN", "")
+	failing := TokenProviderFunc(func(ctx context.Context) (string, error) {
+		return "", errors.New("secret not found")
+	})
+	static := TokenProviderFunc(func(ctx context.Context) (string, error) {
+		return "static-token", nil
+	})
+
+	token, err := ChainTokenProviders(EnvTokenProvider("KEPTN_TEST_TOKEN"), failing, static).Token(context.TODO())
+	require.Nil(t, err)
+	require.Equal(t, "static-token", token)
+
+	_, err = ChainTokenProviders(EnvTokenProvider("KEPTN_TEST_TOKEN"), failing).Token(context.TODO())
+	require.True(t, errors.Is(err, ErrNoToken))
+	require.EqualError(t, err, "no token available: environment variable KEPTN_TEST_TOKEN: no token available; secret not found")
+}
+
+func TestAPISet_WithTokenProvider(t *testing.T) {
+	server := newTokenCheckingServer("file-token")
+	defer server.Close()
+
+	t.Setenv("KEPTN_TEST_TOKEN", "stale-env-token")
+	path := filepath.Join(t.TempDir(), "token")
+	require.Nil(t, os.WriteFile(path, []byte("file-token"), 0600))
+
+	apiSet, err := New(server.URL, WithTokenProvider(ChainTokenProviders(EnvTokenProvider("KEPTN_TEST_TOKEN"), FileTokenProvider(path))))
+	require.Nil(t, err)
+	require.Equal(t, "stale-env-token", apiSet.Token())
+
+	// the rejected token of the environment variable is skipped when resolving the token again
+	_, mErr := apiSet.Projects().CreateProject(context.TODO(), models.Project{ProjectName: "my-project"}, ProjectsCreateProjectOptions{})
+	require.Nil(t, mErr)
+	require.Equal(t, "file-token", apiSet.Token())
+	require.Len(t, server.received(), 2)
+}
+
+func TestAPISet_WithTokenProviderFails(t *testing.T) {
+	t.Setenv("KEPTN_TEST_TOKEN", "")
+	apiSet, err := New("http://localhost", WithTokenProvider(EnvTokenProvider("KEPTN_TEST_TOKEN")))
+	require.Nil(t, apiSet)
+	require.True(t, errors.Is(err, ErrNoToken))
+}
+
+func TestAPISet_WithTokenProviderDoesNotAlternateBetweenRejectedTokens(t *testing.T) {
+	server := newTokenCheckingServer("valid-token")
+	defer server.Close()
+
+	env := TokenProviderFunc(func(ctx context.Context) (string, error) {
+		return "env-token", nil
+	})
+	file := TokenProviderFunc(func(ctx context.Context) (string, error) {
+		return "file-token", nil
+	})
+	apiSet, err := New(server.URL, WithTokenProvider(ChainTokenProviders(env, file)))
+	require.Nil(t, err)
+
+	// the token of the environment is rejected, and the token of the file is rejected on the retry
+	_, mErr := apiSet.Projects().CreateProject(context.TODO(), models.Project{ProjectName: "my-project"}, ProjectsCreateProjectOptions{})
+	require.NotNil(t, mErr)
+	require.Equal(t, "file-token", apiSet.Token())
+
+	// both tokens have been rejected, so the request is not retried with the token of the environment again
+	_, mErr = apiSet.Projects().CreateProject(context.TODO(), models.Project{ProjectName: "my-project"}, ProjectsCreateProjectOptions{})
+	require.NotNil(t, mErr)
+	require.Equal(t, "file-token", apiSet.Token())
+	require.Len(t, server.received(), 3)
+}
@@ -0,0 +1,104 @@
+package v2
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"sync"
+)
+
+// TokenRefresher is called when the Keptn API rejects a request with 401 Unauthorized.
+// It returns a new token, e.g. by re-reading a mounted secret, which is used
+// for retrying the rejected request as well as for all subsequent requests
+type TokenRefresher func(ctx context.Context) (string, error)
+
+// refreshingTransport is a http.RoundTripper which obtains a new token via a TokenRefresher
+// when a request is rejected with 401 Unauthorized and retries the request once
+type refreshingTransport struct {
+	base       http.RoundTripper
+	authHeader string
+	refresher  TokenRefresher
+	mtx        sync.RWMutex
+	token      string
+}
+
+func newRefreshingTransport(base http.RoundTripper, authHeader string, token string, refresher TokenRefresher) *refreshingTransport {
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	return &refreshingTransport{
+		base:       base,
+		authHeader: authHeader,
+		refresher:  refresher,
+		token:      token,
+	}
+}
+
+func (t *refreshingTransport) currentToken() string {
+	t.mtx.RLock()
+	defer t.mtx.RUnlock()
+	return t.token
+}
+
+func (t *refreshingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	token := t.currentToken()
+	resp, err := t.base.RoundTrip(t.withToken(req, token))
+	if err != nil || resp.StatusCode != http.StatusUnauthorized {
+		return resp, err
+	}
+
+	newToken, err := t.refresh(req.Context(), token)
+	if err != nil {
+		return resp, nil
+	}
+	if !isReplayable(req) {
+		// the body has already been consumed, so the request is failed instead of returning the rejection
+		// as if the new token had been rejected as well
+		_, _ = io.Copy(ioutil.Discard, resp.Body)
+		resp.Body.Close()
+		return nil, fmt.Errorf("could not send request again after refreshing the token: %w", ErrBodyNotReplayable)
+	}
+
+	retryReq := t.withToken(req, newToken)
+	if req.GetBody != nil {
+		body, err := req.GetBody()
+		if err != nil {
+			return resp, nil
+		}
+		retryReq.Body = body
+	}
+	// the first response is discarded in favor of the response of the retry
+	_, _ = io.Copy(ioutil.Discard, resp.Body)
+	resp.Body.Close()
+	return t.base.RoundTrip(retryReq)
+}
+
+// refresh obtains a new token unless another request already refreshed the given (stale) token in the meantime
+func (t *refreshingTransport) refresh(ctx context.Context, staleToken string) (string, error) {
+	t.mtx.Lock()
+	defer t.mtx.Unlock()
+	if t.token != staleToken {
+		return t.token, nil
+	}
+	newToken, err := t.refresher(ctx)
+	if err != nil {
+		return "", fmt.Errorf("could not refresh token: %w", err)
+	}
+	t.token = newToken
+	return newToken, nil
+}
+
+func (t *refreshingTransport) withToken(req *http.Request, token string) *http.Request {
+	if t.authHeader == "" || token == "" {
+		return req
+	}
+	if req.Response != nil && req.Header.Get(t.authHeader) == "" {
+		// the auth header has been removed from a redirected request by the redirect policy
+		return req
+	}
+	r := req.Clone(req.Context())
+	r.Header.Set(t.authHeader, token)
+	return r
+}
@@ -0,0 +1,82 @@
+package v2
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/keptn/go-utils/pkg/api/models"
+	"github.com/keptn/go-utils/pkg/common/strutils"
+	"github.com/stretchr/testify/require"
+)
+
+func newTokenCheckingServer(validToken string) *recordingServer {
+	return newRecordingServer(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("x-token") != validToken {
+			w.WriteHeader(http.StatusUnauthorized)
+			w.Write([]byte(`{"code":401,"message":"invalid token"}`))
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{}`))
+	})
+}
+
+func TestAPISet_WithTokenRefresher(t *testing.T) {
+	server := newTokenCheckingServer("new-token")
+	defer server.Close()
+
+	refresherCalls := 0
+	apiSet, err := New(server.URL, WithAuthToken("old-token"), WithTokenRefresher(func(ctx context.Context) (string, error) {
+		refresherCalls++
+		return "new-token", nil
+	}))
+	require.Nil(t, err)
+
+	_, mErr := apiSet.API().CreateProject(context.TODO(), models.CreateProject{Name: strutils.Stringp("my-project")}, APICreateProjectOptions{})
+	require.Nil(t, mErr)
+	require.Equal(t, 1, refresherCalls)
+	require.Equal(t, "new-token", apiSet.Token())
+	require.Len(t, server.received(), 2)
+	require.Equal(t, server.received()[0].Body, server.received()[1].Body)
+
+	// subsequent requests use the new token right away
+	_, mErr = apiSet.API().CreateProject(context.TODO(), models.CreateProject{}, APICreateProjectOptions{})
+	require.Nil(t, mErr)
+	require.Equal(t, 1, refresherCalls)
+	require.Len(t, server.received(), 3)
+}
+
+func TestAPISet_WithTokenRefresherFails(t *testing.T) {
+	server := newTokenCheckingServer("new-token")
+	defer server.Close()
+
+	apiSet, err := New(server.URL, WithAuthToken("old-token"), WithTokenRefresher(func(ctx context.Context) (string, error) {
+		return "", errors.New("secret not found")
+	}))
+	require.Nil(t, err)
+
+	_, mErr := apiSet.API().CreateProject(context.TODO(), models.CreateProject{}, APICreateProjectOptions{})
+	require.NotNil(t, mErr)
+	require.Equal(t, "invalid token", mErr.GetMessage())
+	require.Len(t, server.received(), 1)
+	require.Equal(t, "old-token", apiSet.Token())
+}
+
+func TestAPISet_WithTokenRefresherStillUnauthorized(t *testing.T) {
+	server := newTokenCheckingServer("valid-token")
+	defer server.Close()
+
+	refresherCalls := 0
+	apiSet, err := New(server.URL, WithAuthToken("old-token"), WithTokenRefresher(func(ctx context.Context) (string, error) {
+		refresherCalls++
+		return "new-token", nil
+	}))
+	require.Nil(t, err)
+
+	_, mErr := apiSet.API().CreateProject(context.TODO(), models.CreateProject{}, APICreateProjectOptions{})
+	require.NotNil(t, mErr)
+	require.Equal(t, 1, refresherCalls)
+	require.Len(t, server.received(), 2)
+}
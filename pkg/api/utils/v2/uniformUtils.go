@@ -7,7 +7,6 @@ import (
 	"fmt"
 	"net/http"
 	"net/url"
-	"strings"
 
 	"github.com/keptn/go-utils/pkg/api/models"
 	"github.com/keptn/go-utils/pkg/common/httputils"
@@ -40,11 +39,13 @@ type UniformInterface interface {
 }
 
 type UniformHandler struct {
-	baseURL    string
-	authToken  string
-	authHeader string
-	httpClient *http.Client
-	scheme     string
+	baseURL     string
+	authToken   string
+	authHeader  string
+	httpClient  *http.Client
+	scheme      string
+	idempotency idempotencyOptions
+	errorDetailsSetting
 }
 
 // NewUniformHandler returns a new UniformHandler
@@ -57,13 +58,10 @@ func NewUniformHandlerWithHTTPClient(baseURL string, httpClient *http.Client) *U
 	return createUniformHandler(baseURL, "", "", httpClient, "http")
 }
 
-// NewAuthenticatedUniformHandler returns a new UniformHandler that authenticates at the api via the provided token
-func NewAuthenticatedUniformHandler(baseURL string, authToken string, authHeader string, httpClient *http.Client, scheme string) *UniformHandler {
-	baseURL = strings.TrimRight(baseURL, "/")
-	if !strings.HasSuffix(baseURL, shipyardControllerBaseURL) {
-		baseURL += "/" + shipyardControllerBaseURL
-	}
-
+// NewAuthenticatedUniformHandler returns a new UniformHandler that authenticates at the api via the provided token.
+// The base path of the service is added to the base URL according to basePathMode (default AppendMissingBasePath)
+func NewAuthenticatedUniformHandler(baseURL string, authToken string, authHeader string, httpClient *http.Client, scheme string, basePathMode ...BasePathMode) *UniformHandler {
+	baseURL = basePathModeOf(basePathMode).HandlerBaseURL(baseURL, HandlerUniform)
 	return createUniformHandler(baseURL, authToken, authHeader, httpClient, scheme)
 }
 
@@ -98,7 +96,10 @@ func (u *UniformHandler) Ping(ctx context.Context, integrationID string, opts Un
 		return nil, errors.New("could not ping an invalid IntegrationID")
 	}
 
-	resp, err := put(ctx, u.scheme+"://"+u.getBaseURL()+v1UniformPath+"/"+integrationID+"/ping", nil, u)
+	if mErr := checkIdentifiers("integration ID", integrationID); mErr != nil {
+		return nil, mErr.ToError()
+	}
+	resp, err := put(ctx, u.scheme+"://"+u.getBaseURL()+v1UniformPath+"/"+EscapeIdentifier(integrationID)+"/ping", nil, u)
 	if err != nil {
 		return nil, errors.New(err.GetMessage())
 	}
@@ -135,7 +136,10 @@ func (u *UniformHandler) CreateSubscription(ctx context.Context, integrationID s
 	if err != nil {
 		return "", err
 	}
-	resp, errResponse := post(ctx, u.scheme+"://"+u.getBaseURL()+v1UniformPath+"/"+integrationID+"/subscription", bodyStr, u)
+	if mErr := checkIdentifiers("integration ID", integrationID); mErr != nil {
+		return "", mErr.ToError()
+	}
+	resp, errResponse := post(ctx, u.scheme+"://"+u.getBaseURL()+v1UniformPath+"/"+EscapeIdentifier(integrationID)+"/subscription", bodyStr, u)
 	if errResponse != nil {
 		return "", fmt.Errorf(errResponse.GetMessage())
 	}
@@ -150,8 +154,11 @@ func (u *UniformHandler) CreateSubscription(ctx context.Context, integrationID s
 }
 
 func (u *UniformHandler) UnregisterIntegration(ctx context.Context, integrationID string, opts UniformUnregisterIntegrationOptions) error {
-	_, err := delete(ctx, u.scheme+"://"+u.getBaseURL()+v1UniformPath+"/"+integrationID, u)
-	if err != nil {
+	if mErr := checkIdentifiers("integration ID", integrationID); mErr != nil {
+		return mErr.ToError()
+	}
+	_, err := deleteRequest(ctx, u.scheme+"://"+u.getBaseURL()+v1UniformPath+"/"+EscapeIdentifier(integrationID), u)
+	if err != nil && !u.idempotency.notFoundOnDelete(err) {
 		return fmt.Errorf(err.GetMessage())
 	}
 	return nil
@@ -0,0 +1,161 @@
+package v2
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/keptn/go-utils/pkg/api/models"
+	"github.com/keptn/go-utils/pkg/common/httputils"
+)
+
+// ErrInvalidConfiguration is returned by New and the Validate methods of the handlers if the base URL, scheme
+// or authentication of a handler are configured in a way which cannot work
+var ErrInvalidConfiguration = errors.New("invalid handler configuration")
+
+// invalidURLError returns the error of a request whose URL cannot be built, e.g. because the base URL of the handler is malformed
+func invalidURLError(err error) error {
+	return fmt.Errorf("%w: could not build request URL: %v", ErrInvalidConfiguration, err)
+}
+
+// invalidURLResponse returns the invalidURLError of the given error as *models.Error
+func invalidURLResponse(err error) *models.Error {
+	err = invalidURLError(err)
+	mErr := buildErrorResponse(err.Error())
+	mErr.Err = err
+	return mErr
+}
+
+// validateHandlerConfig checks the base URL, scheme and authentication of the given handler
+func validateHandlerConfig(api APIService, scheme string) error {
+	if err := validateBaseURL(api.getBaseURL(), scheme); err != nil {
+		return err
+	}
+	return validateAuth(api.getAuthToken(), api.getAuthHeader())
+}
+
+// validateBaseURL checks that the base URL consists of a host with an optional port and path and fits the scheme
+func validateBaseURL(baseURL string, scheme string) error {
+	if baseURL == "" {
+		return fmt.Errorf("%w: base URL is empty, e.g. use keptn.example.com/api", ErrInvalidConfiguration)
+	}
+	if strings.TrimSpace(baseURL) != baseURL || strings.ContainsAny(baseURL, " \t\r\n") {
+		return fmt.Errorf("%w: base URL %q contains whitespace", ErrInvalidConfiguration, baseURL)
+	}
+	switch strings.ToLower(scheme) {
+	case "http", "https":
+	default:
+		return fmt.Errorf("%w: scheme %q is not supported, use http or https", ErrInvalidConfiguration, scheme)
+	}
+	trimmed := httputils.TrimHTTPScheme(baseURL)
+	if strings.Contains(trimmed, "://") {
+		return fmt.Errorf("%w: base URL %q has an unsupported or duplicate scheme, use http:// or https://", ErrInvalidConfiguration, baseURL)
+	}
+	u, err := url.Parse("//" + trimmed)
+	if err != nil {
+		return fmt.Errorf("%w: base URL %q cannot be parsed: %v", ErrInvalidConfiguration, baseURL, err)
+	}
+	if u.Hostname() == "" {
+		return fmt.Errorf("%w: base URL %q has no host, e.g. use keptn.example.com/api", ErrInvalidConfiguration, baseURL)
+	}
+	if port := u.Port(); port != "" {
+		p, err := strconv.Atoi(port)
+		if err != nil || p < 1 || p > 65535 {
+			return fmt.Errorf("%w: port %s of base URL %q is out of range, use a port between 1 and 65535", ErrInvalidConfiguration, port, baseURL)
+		}
+		if p == 443 && strings.EqualFold(scheme, "http") {
+			return fmt.Errorf("%w: base URL %q uses port 443 with scheme http, use scheme https or the HTTP port of the endpoint", ErrInvalidConfiguration, baseURL)
+		}
+	}
+	return nil
+}
+
+// validateAuth checks that a token is sent with a valid auth header. An auth header without token is allowed,
+// since the token may be set by a TokenRefresher later on
+func validateAuth(authToken string, authHeader string) error {
+	if authToken == "" {
+		return nil
+	}
+	if authHeader == "" {
+		return fmt.Errorf("%w: an auth token is set, but no auth header to send it with, e.g. use x-token", ErrInvalidConfiguration)
+	}
+	if strings.ContainsAny(authHeader, " \t\r\n:") {
+		return fmt.Errorf("%w: auth header %q is not a valid header name", ErrInvalidConfiguration, authHeader)
+	}
+	if strings.ContainsAny(authToken, "\r\n") {
+		return fmt.Errorf("%w: auth token contains a line break, which is not allowed in a header value; trim the token, e.g. when reading it from a file", ErrInvalidConfiguration)
+	}
+	return nil
+}
+
+// Validate checks the configuration of the handler and returns an error wrapping ErrInvalidConfiguration if it cannot work
+func (a *APIHandler) Validate() error {
+	return validateHandlerConfig(a, a.scheme)
+}
+
+// Validate checks the configuration of the handler and returns an error wrapping ErrInvalidConfiguration if it cannot work
+func (a *AuthHandler) Validate() error {
+	return validateHandlerConfig(a, a.scheme)
+}
+
+// Validate checks the configuration of the handler and returns an error wrapping ErrInvalidConfiguration if it cannot work
+func (e *EventHandler) Validate() error {
+	return validateHandlerConfig(e, e.scheme)
+}
+
+// Validate checks the configuration of the handler and returns an error wrapping ErrInvalidConfiguration if it cannot work
+func (lh *LogHandler) Validate() error {
+	return validateHandlerConfig(lh, lh.scheme)
+}
+
+// Validate checks the configuration of the handler and returns an error wrapping ErrInvalidConfiguration if it cannot work
+func (p *ProjectHandler) Validate() error {
+	return validateHandlerConfig(p, p.scheme)
+}
+
+// Validate checks the configuration of the handler and returns an error wrapping ErrInvalidConfiguration if it cannot work
+func (r *ResourceHandler) Validate() error {
+	return validateHandlerConfig(r, r.scheme)
+}
+
+// Validate checks the configuration of the handler and returns an error wrapping ErrInvalidConfiguration if it cannot work
+func (s *SecretHandler) Validate() error {
+	return validateHandlerConfig(s, s.scheme)
+}
+
+// Validate checks the configuration of the handler and returns an error wrapping ErrInvalidConfiguration if it cannot work
+func (s *SequenceControlHandler) Validate() error {
+	return validateHandlerConfig(s, s.scheme)
+}
+
+// Validate checks the configuration of the handler and returns an error wrapping ErrInvalidConfiguration if it cannot work
+func (s *ServiceHandler) Validate() error {
+	return validateHandlerConfig(s, s.scheme)
+}
+
+// Validate checks the configuration of the handler and returns an error wrapping ErrInvalidConfiguration if it cannot work
+func (s *ShipyardControllerHandler) Validate() error {
+	return validateHandlerConfig(s, s.scheme)
+}
+
+// Validate checks the configuration of the handler and returns an error wrapping ErrInvalidConfiguration if it cannot work
+func (s *StageHandler) Validate() error {
+	return validateHandlerConfig(s, s.scheme)
+}
+
+// Validate checks the configuration of the handler and returns an error wrapping ErrInvalidConfiguration if it cannot work
+func (u *UniformHandler) Validate() error {
+	return validateHandlerConfig(u, u.scheme)
+}
+
+// MustNew is like New, but panics if the APISet cannot be created, e.g. because of an invalid configuration.
+// It is meant for base URLs and options known at compile time
+func MustNew(baseURL string, options ...func(*APISet)) *APISet {
+	as, err := New(baseURL, options...)
+	if err != nil {
+		panic(err)
+	}
+	return as
+}
@@ -0,0 +1,60 @@
+package v2
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNew_ValidatesConfiguration(t *testing.T) {
+	tests := []struct {
+		name    string
+		baseURL string
+		options []func(*APISet)
+		wantErr string
+	}{
+		{name: "valid base URL", baseURL: "keptn.example.com/api"},
+		{name: "valid base URL with port", baseURL: "https://keptn.example.com:8443/api"},
+		{name: "empty base URL", baseURL: "", wantErr: "unable to create apiset: invalid handler configuration: base URL is empty, e.g. use keptn.example.com/api"},
+		{name: "whitespace", baseURL: "keptn.example.com/api ", wantErr: `unable to create apiset: invalid handler configuration: base URL "keptn.example.com/api " contains whitespace`},
+		{name: "no host", baseURL: "http:///api", wantErr: `unable to create apiset: invalid handler configuration: base URL "http:///api" has no host, e.g. use keptn.example.com/api`},
+		{name: "unsupported scheme", baseURL: "ftp://keptn.example.com", wantErr: `unable to create apiset: invalid handler configuration: scheme "ftp" is not supported, use http or https`},
+		{name: "duplicate scheme", baseURL: "http://http://keptn.example.com", wantErr: `unable to create apiset: invalid handler configuration: base URL "http://http://keptn.example.com" has an unsupported or duplicate scheme, use http:// or https://`},
+		{name: "port out of range", baseURL: "keptn.example.com:70000", wantErr: `unable to create apiset: invalid handler configuration: port 70000 of base URL "keptn.example.com:70000" is out of range, use a port between 1 and 65535`},
+		{name: "https on port 80", baseURL: "https://keptn.example.com:80"},
+		{name: "http on port 443", baseURL: "keptn.example.com:443", wantErr: `unable to create apiset: invalid handler configuration: base URL "keptn.example.com:443" uses port 443 with scheme http, use scheme https or the HTTP port of the endpoint`},
+		{name: "token without header", baseURL: "keptn.example.com", options: []func(*APISet){WithAuthToken("a-token", "")}, wantErr: "unable to create apiset: invalid handler configuration: an auth token is set, but no auth header to send it with, e.g. use x-token"},
+		{name: "invalid header", baseURL: "keptn.example.com", options: []func(*APISet){WithAuthToken("a-token", "x-token:")}, wantErr: `unable to create apiset: invalid handler configuration: auth header "x-token:" is not a valid header name`},
+		{name: "token with line break", baseURL: "keptn.example.com", options: []func(*APISet){WithAuthToken("a-token\n")}, wantErr: "unable to create apiset: invalid handler configuration: auth token contains a line break, which is not allowed in a header value; trim the token, e.g. when reading it from a file"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			apiSet, err := New(tt.baseURL, tt.options...)
+			if tt.wantErr != "" {
+				require.EqualError(t, err, tt.wantErr)
+				require.True(t, errors.Is(err, ErrInvalidConfiguration))
+				require.Nil(t, apiSet)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestHandler_Validate(t *testing.T) {
+	require.NoError(t, NewProjectHandler("http://localhost:8080").Validate())
+	require.NoError(t, NewAuthenticatedEventHandler("keptn.example.com/api", "a-token", "x-token", &http.Client{}, "https").Validate())
+
+	err := NewAuthenticatedStageHandler("keptn.example.com:0", "", "", &http.Client{}, "http").Validate()
+	require.True(t, errors.Is(err, ErrInvalidConfiguration))
+
+	err = NewAuthenticatedServiceHandler("keptn.example.com", "a-token", "x-token", &http.Client{}, "htps").Validate()
+	require.EqualError(t, err, `invalid handler configuration: scheme "htps" is not supported, use http or https`)
+}
+
+func TestMustNew(t *testing.T) {
+	require.NotNil(t, MustNew("keptn.example.com"))
+	require.Panics(t, func() { MustNew("keptn.example.com:0") })
+}
@@ -0,0 +1,236 @@
+package v2
+
+import (
+	"container/list"
+	"log"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// maxLoggedWarnings is the number of warnings remembered as already logged. Beyond that, the least recently
+// seen warnings are forgotten and logged again when they occur the next time
+const maxLoggedWarnings = 1000
+
+// WarningHeader is a single warning sent by the server via the Warning header (RFC 7234)
+type WarningHeader struct {
+	// Code is the three digit warn-code, e.g. 299 for a miscellaneous persistent warning
+	Code int
+	// Agent is the name or host of the server that added the warning
+	Agent string
+	// Text is the human readable warning
+	Text string
+	// Date is the optional date of the warning
+	Date *time.Time
+}
+
+// ResponseWarning contains the warnings and deprecation information sent by the server along with a response
+type ResponseWarning struct {
+	// Method is the HTTP method of the request
+	Method string
+	// URL is the URL of the request
+	URL string
+	// Warnings contains the parsed Warning headers
+	Warnings []WarningHeader
+	// Deprecated indicates whether the endpoint has been marked as deprecated via the Deprecation header
+	Deprecated bool
+	// DeprecationDate is the date at which the endpoint has been or will be deprecated, if sent by the server
+	DeprecationDate *time.Time
+	// Sunset is the date at which the endpoint will stop working, as sent via the Sunset header (RFC 8594)
+	Sunset *time.Time
+}
+
+// ResponseWarningHandler is called whenever the server responds with Warning, Deprecation or Sunset headers
+type ResponseWarningHandler func(warning ResponseWarning)
+
+// warningTransport is a http.RoundTripper which inspects the responses for
+// Warning, Deprecation and Sunset headers and reports them
+type warningTransport struct {
+	base    http.RoundTripper
+	handler ResponseWarningHandler
+	logged  *loggedWarnings
+}
+
+func newWarningTransport(base http.RoundTripper, handler ResponseWarningHandler) *warningTransport {
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	return &warningTransport{base: base, handler: handler, logged: newLoggedWarnings(maxLoggedWarnings)}
+}
+
+func (t *warningTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	resp, err := t.base.RoundTrip(req)
+	if err != nil {
+		return resp, err
+	}
+	warning, found := parseResponseWarning(req, resp.Header)
+	if !found {
+		return resp, nil
+	}
+	t.log(warning)
+	if t.handler != nil {
+		t.handler(warning)
+	}
+	return resp, nil
+}
+
+// log writes the warning to the log. Identical warnings for the same endpoint are only logged once
+func (t *warningTransport) log(warning ResponseWarning) {
+	for _, w := range warning.Warnings {
+		if t.logged.add(warning.Method + " " + warning.URL + " " + w.Text) {
+			log.Printf("Warning from Keptn API for %s %s: %s", warning.Method, warning.URL, w.Text)
+		}
+	}
+	if warning.Deprecated || warning.Sunset != nil {
+		if t.logged.add(warning.Method + " " + warning.URL) {
+			msg := "Keptn API endpoint " + warning.Method + " " + warning.URL + " is deprecated"
+			if warning.Sunset != nil {
+				msg += " and will be removed at " + warning.Sunset.Format(time.RFC3339)
+			}
+			log.Println(msg)
+		}
+	}
+}
+
+// loggedWarnings is a set of the most recently logged warnings, bounded to a maximum size
+type loggedWarnings struct {
+	mtx   sync.Mutex
+	max   int
+	order *list.List
+	// entries maps the keys to their elements in order
+	entries map[string]*list.Element
+}
+
+func newLoggedWarnings(max int) *loggedWarnings {
+	return &loggedWarnings{max: max, order: list.New(), entries: map[string]*list.Element{}}
+}
+
+// add adds the key to the set and returns true if it has not been contained yet.
+// If the set is full, the least recently added or seen key is removed
+func (l *loggedWarnings) add(key string) bool {
+	l.mtx.Lock()
+	defer l.mtx.Unlock()
+	if element, ok := l.entries[key]; ok {
+		l.order.MoveToFront(element)
+		return false
+	}
+	l.entries[key] = l.order.PushFront(key)
+	if l.order.Len() > l.max {
+		oldest := l.order.Back()
+		l.order.Remove(oldest)
+		delete(l.entries, oldest.Value.(string))
+	}
+	return true
+}
+
+// parseResponseWarning extracts the warnings and deprecation information from the given response headers
+func parseResponseWarning(req *http.Request, header http.Header) (ResponseWarning, bool) {
+	warning := ResponseWarning{
+		Method:   req.Method,
+		URL:      req.URL.Scheme + "://" + req.URL.Host + req.URL.Path,
+		Warnings: []WarningHeader{},
+	}
+	for _, value := range header.Values("Warning") {
+		warning.Warnings = append(warning.Warnings, parseWarningHeader(value)...)
+	}
+	if deprecation := strings.TrimSpace(header.Get("Deprecation")); deprecation != "" && deprecation != "false" {
+		warning.Deprecated = true
+		warning.DeprecationDate = parseDeprecationDate(deprecation)
+	}
+	if sunset := header.Get("Sunset"); sunset != "" {
+		if t, err := http.ParseTime(sunset); err == nil {
+			warning.Sunset = &t
+		}
+	}
+	return warning, len(warning.Warnings) > 0 || warning.Deprecated || warning.Sunset != nil
+}
+
+// parseDeprecationDate parses the value of a Deprecation header, which can either be "true",
+// a unix timestamp prefixed with @ or a HTTP date (as used by earlier drafts of the specification)
+func parseDeprecationDate(value string) *time.Time {
+	if strings.HasPrefix(value, "@") {
+		if seconds, err := strconv.ParseInt(value[1:], 10, 64); err == nil {
+			t := time.Unix(seconds, 0).UTC()
+			return &t
+		}
+		return nil
+	}
+	if t, err := http.ParseTime(value); err == nil {
+		return &t
+	}
+	return nil
+}
+
+// parseWarningHeader parses the value of a Warning header, which contains a comma separated
+// list of warnings in the format: warn-code SP warn-agent SP warn-text [ SP warn-date ]
+func parseWarningHeader(value string) []WarningHeader {
+	warnings := []WarningHeader{}
+	rest := strings.TrimSpace(value)
+	for rest != "" {
+		var w WarningHeader
+		var ok bool
+		w, rest, ok = parseSingleWarning(rest)
+		if !ok {
+			break
+		}
+		warnings = append(warnings, w)
+		rest = strings.TrimLeft(rest, " ,")
+	}
+	return warnings
+}
+
+func parseSingleWarning(value string) (WarningHeader, string, bool) {
+	fields := strings.SplitN(value, " ", 3)
+	if len(fields) < 3 {
+		return WarningHeader{}, "", false
+	}
+	code, err := strconv.Atoi(fields[0])
+	if err != nil {
+		return WarningHeader{}, "", false
+	}
+	w := WarningHeader{Code: code, Agent: fields[1]}
+
+	text, rest, ok := parseQuotedString(fields[2])
+	if !ok {
+		return WarningHeader{}, "", false
+	}
+	w.Text = text
+
+	rest = strings.TrimLeft(rest, " ")
+	if strings.HasPrefix(rest, `"`) {
+		var date string
+		date, rest, ok = parseQuotedString(rest)
+		if !ok {
+			return WarningHeader{}, "", false
+		}
+		if t, err := http.ParseTime(date); err == nil {
+			w.Date = &t
+		}
+	}
+	return w, rest, true
+}
+
+// parseQuotedString parses a quoted-string at the beginning of the given value
+// and returns the unquoted content as well as the remainder of the value
+func parseQuotedString(value string) (string, string, bool) {
+	if !strings.HasPrefix(value, `"`) {
+		return "", "", false
+	}
+	sb := strings.Builder{}
+	for i := 1; i < len(value); i++ {
+		switch value[i] {
+		case '\\':
+			if i+1 < len(value) {
+				i++
+				sb.WriteByte(value[i])
+			}
+		case '"':
+			return sb.String(), value[i+1:], true
+		default:
+			sb.WriteByte(value[i])
+		}
+	}
+	return "", "", false
+}
@@ -0,0 +1,113 @@
+package v2
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_parseWarningHeader(t *testing.T) {
+	date := time.Date(2022, time.June, 1, 10, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name  string
+		value string
+		want  []WarningHeader
+	}{
+		{
+			name:  "single warning",
+			value: `299 - "endpoint is deprecated"`,
+			want:  []WarningHeader{{Code: 299, Agent: "-", Text: "endpoint is deprecated"}},
+		},
+		{
+			name:  "warning with date",
+			value: `299 keptn "endpoint is deprecated" "Wed, 01 Jun 2022 10:00:00 GMT"`,
+			want:  []WarningHeader{{Code: 299, Agent: "keptn", Text: "endpoint is deprecated", Date: &date}},
+		},
+		{
+			name:  "multiple warnings with escaped quotes",
+			value: `299 - "use \"v2\" instead", 199 api-service "something else"`,
+			want: []WarningHeader{
+				{Code: 299, Agent: "-", Text: `use "v2" instead`},
+				{Code: 199, Agent: "api-service", Text: "something else"},
+			},
+		},
+		{
+			name:  "invalid warning",
+			value: `abc - "text"`,
+			want:  []WarningHeader{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, parseWarningHeader(tt.value))
+		})
+	}
+}
+
+func Test_parseDeprecationDate(t *testing.T) {
+	require.Nil(t, parseDeprecationDate("true"))
+	require.Equal(t, time.Unix(1654077600, 0).UTC(), *parseDeprecationDate("@1654077600"))
+	require.Equal(t, time.Date(2022, time.June, 1, 10, 0, 0, 0, time.UTC), *parseDeprecationDate("Wed, 01 Jun 2022 10:00:00 GMT"))
+}
+
+func Test_loggedWarnings(t *testing.T) {
+	logged := newLoggedWarnings(2)
+	require.True(t, logged.add("a"))
+	require.True(t, logged.add("b"))
+	require.False(t, logged.add("a"))
+	// b is the least recently seen warning and is forgotten
+	require.True(t, logged.add("c"))
+	require.Equal(t, 2, logged.order.Len())
+	require.False(t, logged.add("a"))
+	require.True(t, logged.add("b"))
+}
+
+func TestAPISet_WithResponseWarningHandler(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Add("Warning", `299 - "this endpoint is deprecated"`)
+		w.Header().Add("Deprecation", "true")
+		w.Header().Add("Sunset", "Sat, 31 Dec 2022 23:59:59 GMT")
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{}`))
+	}))
+	defer server.Close()
+
+	warnings := []ResponseWarning{}
+	apiSet, err := New(server.URL, WithResponseWarningHandler(func(warning ResponseWarning) {
+		warnings = append(warnings, warning)
+	}))
+	require.Nil(t, err)
+
+	_, mErr := apiSet.API().GetMetadata(context.TODO(), APIGetMetadataOptions{})
+	require.Nil(t, mErr)
+
+	require.Len(t, warnings, 1)
+	require.Equal(t, http.MethodGet, warnings[0].Method)
+	require.Equal(t, server.URL+"/v1/metadata", warnings[0].URL)
+	require.Equal(t, []WarningHeader{{Code: 299, Agent: "-", Text: "this endpoint is deprecated"}}, warnings[0].Warnings)
+	require.True(t, warnings[0].Deprecated)
+	require.Nil(t, warnings[0].DeprecationDate)
+	require.Equal(t, time.Date(2022, time.December, 31, 23, 59, 59, 0, time.UTC), *warnings[0].Sunset)
+}
+
+func TestAPISet_NoResponseWarning(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{}`))
+	}))
+	defer server.Close()
+
+	called := false
+	apiSet, err := New(server.URL, WithResponseWarningHandler(func(warning ResponseWarning) {
+		called = true
+	}))
+	require.Nil(t, err)
+
+	_, mErr := apiSet.API().GetMetadata(context.TODO(), APIGetMetadataOptions{})
+	require.Nil(t, mErr)
+	require.False(t, called)
+}
@@ -0,0 +1,138 @@
+package contextutils
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/attribute"
+)
+
+type keptnContextKey struct{}
+type projectKey struct{}
+type stageKey struct{}
+type serviceKey struct{}
+
+// Span attribute keys of the Keptn identifiers, see Attributes
+const (
+	KeptnContextAttribute = attribute.Key("keptn.context")
+	ProjectAttribute      = attribute.Key("keptn.project")
+	StageAttribute        = attribute.Key("keptn.stage")
+	ServiceAttribute      = attribute.Key("keptn.service")
+)
+
+// Identifiers contains the Keptn identifiers of an event
+type Identifiers struct {
+	KeptnContext string
+	Project      string
+	Stage        string
+	Service      string
+}
+
+// ContextWithKeptnContext returns a context carrying the given Keptn context, i.e. the shkeptncontext of an event
+func ContextWithKeptnContext(ctx context.Context, keptnContext string) context.Context {
+	return context.WithValue(ctx, keptnContextKey{}, keptnContext)
+}
+
+// KeptnContextFromContext returns the Keptn context stored in the context, or an empty string if there is none
+func KeptnContextFromContext(ctx context.Context) string {
+	keptnContext, _ := ctx.Value(keptnContextKey{}).(string)
+	return keptnContext
+}
+
+// ContextWithProject returns a context carrying the given project name
+func ContextWithProject(ctx context.Context, project string) context.Context {
+	return context.WithValue(ctx, projectKey{}, project)
+}
+
+// ProjectFromContext returns the project name stored in the context, or an empty string if there is none
+func ProjectFromContext(ctx context.Context) string {
+	project, _ := ctx.Value(projectKey{}).(string)
+	return project
+}
+
+// ContextWithStage returns a context carrying the given stage name
+func ContextWithStage(ctx context.Context, stage string) context.Context {
+	return context.WithValue(ctx, stageKey{}, stage)
+}
+
+// StageFromContext returns the stage name stored in the context, or an empty string if there is none
+func StageFromContext(ctx context.Context) string {
+	stage, _ := ctx.Value(stageKey{}).(string)
+	return stage
+}
+
+// ContextWithService returns a context carrying the given service name
+func ContextWithService(ctx context.Context, service string) context.Context {
+	return context.WithValue(ctx, serviceKey{}, service)
+}
+
+// ServiceFromContext returns the service name stored in the context, or an empty string if there is none
+func ServiceFromContext(ctx context.Context) string {
+	service, _ := ctx.Value(serviceKey{}).(string)
+	return service
+}
+
+// ContextWithIdentifiers returns a context carrying all non-empty identifiers.
+// Identifiers which are empty do not replace the ones already stored in the context
+func ContextWithIdentifiers(ctx context.Context, ids Identifiers) context.Context {
+	if ids.KeptnContext != "" {
+		ctx = ContextWithKeptnContext(ctx, ids.KeptnContext)
+	}
+	if ids.Project != "" {
+		ctx = ContextWithProject(ctx, ids.Project)
+	}
+	if ids.Stage != "" {
+		ctx = ContextWithStage(ctx, ids.Stage)
+	}
+	if ids.Service != "" {
+		ctx = ContextWithService(ctx, ids.Service)
+	}
+	return ctx
+}
+
+// IdentifiersFromContext returns all identifiers stored in the context
+func IdentifiersFromContext(ctx context.Context) Identifiers {
+	return Identifiers{
+		KeptnContext: KeptnContextFromContext(ctx),
+		Project:      ProjectFromContext(ctx),
+		Stage:        StageFromContext(ctx),
+		Service:      ServiceFromContext(ctx),
+	}
+}
+
+// Attributes returns the identifiers stored in the context as span attributes, e.g. to be passed to trace.Span.SetAttributes.
+// Identifiers which are not set are omitted
+func Attributes(ctx context.Context) []attribute.KeyValue {
+	ids := IdentifiersFromContext(ctx)
+	attrs := []attribute.KeyValue{}
+	if ids.KeptnContext != "" {
+		attrs = append(attrs, KeptnContextAttribute.String(ids.KeptnContext))
+	}
+	if ids.Project != "" {
+		attrs = append(attrs, ProjectAttribute.String(ids.Project))
+	}
+	if ids.Stage != "" {
+		attrs = append(attrs, StageAttribute.String(ids.Stage))
+	}
+	if ids.Service != "" {
+		attrs = append(attrs, ServiceAttribute.String(ids.Service))
+	}
+	return attrs
+}
+
+// LogFields returns the identifiers stored in the context as structured log fields, e.g. to be passed to
+// logrus.WithFields. Identifiers which are not set are omitted
+func LogFields(ctx context.Context) map[string]interface{} {
+	ids := IdentifiersFromContext(ctx)
+	fields := map[string]interface{}{}
+	for key, value := range map[string]string{
+		"keptnContext": ids.KeptnContext,
+		"project":      ids.Project,
+		"stage":        ids.Stage,
+		"service":      ids.Service,
+	} {
+		if value != "" {
+			fields[key] = value
+		}
+	}
+	return fields
+}
@@ -0,0 +1,39 @@
+package contextutils
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+func TestIdentifiers(t *testing.T) {
+	ctx := context.TODO()
+	require.Equal(t, Identifiers{}, IdentifiersFromContext(ctx))
+
+	ctx = ContextWithKeptnContext(ctx, "my-context")
+	ctx = ContextWithProject(ctx, "my-project")
+	ctx = ContextWithStage(ctx, "my-stage")
+	ctx = ContextWithService(ctx, "my-service")
+	require.Equal(t, "my-context", KeptnContextFromContext(ctx))
+	require.Equal(t, "my-project", ProjectFromContext(ctx))
+	require.Equal(t, "my-stage", StageFromContext(ctx))
+	require.Equal(t, "my-service", ServiceFromContext(ctx))
+
+	// empty identifiers do not replace the existing ones
+	ctx = ContextWithIdentifiers(ctx, Identifiers{Stage: "other-stage"})
+	require.Equal(t, Identifiers{KeptnContext: "my-context", Project: "my-project", Stage: "other-stage", Service: "my-service"}, IdentifiersFromContext(ctx))
+}
+
+func TestAttributesAndLogFields(t *testing.T) {
+	ctx := ContextWithIdentifiers(context.TODO(), Identifiers{KeptnContext: "my-context", Project: "my-project"})
+	require.Equal(t, []attribute.KeyValue{
+		attribute.String("keptn.context", "my-context"),
+		attribute.String("keptn.project", "my-project"),
+	}, Attributes(ctx))
+	require.Equal(t, map[string]interface{}{"keptnContext": "my-context", "project": "my-project"}, LogFields(ctx))
+
+	require.Empty(t, Attributes(context.TODO()))
+	require.Empty(t, LogFields(context.TODO()))
+}
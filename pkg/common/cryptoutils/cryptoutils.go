@@ -0,0 +1,91 @@
+package cryptoutils
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+)
+
+// ErrCiphertextTooShort is returned when decrypting data that cannot have been produced by Encrypt
+var ErrCiphertextTooShort = errors.New("ciphertext too short")
+
+// KeyProvider returns the key used for encrypting data at rest, e.g. by calling a key management service.
+// The key must be 16, 24 or 32 bytes long to select AES-128, AES-192 or AES-256
+type KeyProvider func(ctx context.Context) ([]byte, error)
+
+// EnvKeyProvider returns a KeyProvider which reads a base64 encoded key from the given environment variable
+func EnvKeyProvider(envVar string) KeyProvider {
+	return func(ctx context.Context) ([]byte, error) {
+		value := os.Getenv(envVar)
+		if value == "" {
+			return nil, fmt.Errorf("environment variable %s is not set", envVar)
+		}
+		key, err := base64.StdEncoding.DecodeString(value)
+		if err != nil {
+			return nil, fmt.Errorf("environment variable %s does not contain a base64 encoded key: %w", envVar, err)
+		}
+		return key, nil
+	}
+}
+
+// AESGCM encrypts and decrypts data using AES in Galois/Counter Mode.
+// It is meant for protecting sensitive data, like event payloads or tokens, that is persisted on disk
+type AESGCM struct {
+	keyProvider KeyProvider
+}
+
+// NewAESGCM creates a new AESGCM which obtains the key from the given KeyProvider on every call.
+// The encrypted data does not identify the key it was encrypted with, so after the provider switched to a new key,
+// data encrypted with the previous key can no longer be decrypted. Stored data has to be decrypted with the
+// previous key and encrypted again with the new one when rotating keys
+func NewAESGCM(keyProvider KeyProvider) *AESGCM {
+	return &AESGCM{keyProvider: keyProvider}
+}
+
+// Encrypt encrypts and authenticates the given plaintext. The returned data contains the random nonce followed by the ciphertext
+func (a *AESGCM) Encrypt(ctx context.Context, plaintext []byte) ([]byte, error) {
+	aead, err := a.aead(ctx)
+	if err != nil {
+		return nil, err
+	}
+	nonce := make([]byte, aead.NonceSize(), aead.NonceSize()+len(plaintext)+aead.Overhead())
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return nil, fmt.Errorf("could not generate nonce: %w", err)
+	}
+	return aead.Seal(nonce, nonce, plaintext, nil), nil
+}
+
+// Decrypt decrypts data produced by Encrypt. It fails if the data has been modified or was encrypted with another key
+func (a *AESGCM) Decrypt(ctx context.Context, data []byte) ([]byte, error) {
+	aead, err := a.aead(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(data) < aead.NonceSize()+aead.Overhead() {
+		return nil, ErrCiphertextTooShort
+	}
+	nonce, ciphertext := data[:aead.NonceSize()], data[aead.NonceSize():]
+	plaintext, err := aead.Open(nil, nonce, ciphertext, nil)
+	if err != nil {
+		return nil, fmt.Errorf("could not decrypt data: %w", err)
+	}
+	return plaintext, nil
+}
+
+func (a *AESGCM) aead(ctx context.Context) (cipher.AEAD, error) {
+	key, err := a.keyProvider(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("could not obtain encryption key: %w", err)
+	}
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
@@ -0,0 +1,86 @@
+package cryptoutils
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"errors"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func staticKey(key []byte) KeyProvider {
+	return func(ctx context.Context) ([]byte, error) {
+		return key, nil
+	}
+}
+
+func TestAESGCM_EncryptDecrypt(t *testing.T) {
+	for _, keySize := range []int{16, 24, 32} {
+		c := NewAESGCM(staticKey(bytes.Repeat([]byte{1}, keySize)))
+		plaintext := []byte(`{"project":"sockshop","token":"secret"}`)
+
+		encrypted, err := c.Encrypt(context.TODO(), plaintext)
+		require.Nil(t, err)
+		require.False(t, bytes.Contains(encrypted, []byte("secret")))
+
+		encryptedAgain, err := c.Encrypt(context.TODO(), plaintext)
+		require.Nil(t, err)
+		require.NotEqual(t, encrypted, encryptedAgain)
+
+		decrypted, err := c.Decrypt(context.TODO(), encrypted)
+		require.Nil(t, err)
+		require.Equal(t, plaintext, decrypted)
+	}
+}
+
+func TestAESGCM_DecryptModifiedData(t *testing.T) {
+	c := NewAESGCM(staticKey(bytes.Repeat([]byte{1}, 32)))
+	encrypted, err := c.Encrypt(context.TODO(), []byte("payload"))
+	require.Nil(t, err)
+
+	encrypted[len(encrypted)-1] ^= 0xff
+	_, err = c.Decrypt(context.TODO(), encrypted)
+	require.NotNil(t, err)
+
+	_, err = c.Decrypt(context.TODO(), []byte("short"))
+	require.Equal(t, ErrCiphertextTooShort, err)
+}
+
+func TestAESGCM_DecryptWithOtherKey(t *testing.T) {
+	encrypted, err := NewAESGCM(staticKey(bytes.Repeat([]byte{1}, 32))).Encrypt(context.TODO(), []byte("payload"))
+	require.Nil(t, err)
+
+	_, err = NewAESGCM(staticKey(bytes.Repeat([]byte{2}, 32))).Decrypt(context.TODO(), encrypted)
+	require.NotNil(t, err)
+}
+
+func TestAESGCM_InvalidKey(t *testing.T) {
+	_, err := NewAESGCM(staticKey([]byte("invalid"))).Encrypt(context.TODO(), []byte("payload"))
+	require.NotNil(t, err)
+
+	_, err = NewAESGCM(func(ctx context.Context) ([]byte, error) {
+		return nil, errors.New("kms not available")
+	}).Encrypt(context.TODO(), []byte("payload"))
+	require.NotNil(t, err)
+}
+
+func TestEnvKeyProvider(t *testing.T) {
+	const envVar = "CRYPTOUTILS_TEST_KEY"
+	key := bytes.Repeat([]byte{3}, 32)
+
+	_, err := EnvKeyProvider(envVar)(context.TODO())
+	require.NotNil(t, err)
+
+	os.Setenv(envVar, "not base64")
+	defer os.Unsetenv(envVar)
+	_, err = EnvKeyProvider(envVar)(context.TODO())
+	require.NotNil(t, err)
+
+	os.Setenv(envVar, base64.StdEncoding.EncodeToString(key))
+	actual, err := EnvKeyProvider(envVar)(context.TODO())
+	require.Nil(t, err)
+	require.Equal(t, key, actual)
+}
@@ -0,0 +1,187 @@
+package diffutils
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/keptn/go-utils/pkg/api/models"
+)
+
+// ChangeType describes how a value differs between the actual and the expected document
+type ChangeType string
+
+const (
+	// Added means that the value is only present in the actual document
+	Added ChangeType = "added"
+	// Removed means that the value is only present in the expected document
+	Removed ChangeType = "removed"
+	// Changed means that the value differs between both documents
+	Changed ChangeType = "changed"
+)
+
+// identifierPattern matches keys that can be written in dot notation in a JSON path
+var identifierPattern = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+// Change is a single difference between two JSON documents
+type Change struct {
+	// Path is the JSON path of the value, e.g. $.data.labels.owner or $.data.items[0]
+	Path string     `json:"path"`
+	Type ChangeType `json:"type"`
+	// Expected is the value in the expected document, if any
+	Expected interface{} `json:"expected,omitempty"`
+	// Actual is the value in the actual document, if any
+	Actual interface{} `json:"actual,omitempty"`
+}
+
+func (c Change) String() string {
+	switch c.Type {
+	case Added:
+		return fmt.Sprintf("%s: unexpected %s", c.Path, formatValue(c.Actual))
+	case Removed:
+		return fmt.Sprintf("%s: missing, expected %s", c.Path, formatValue(c.Expected))
+	}
+	return fmt.Sprintf("%s: expected %s, got %s", c.Path, formatValue(c.Expected), formatValue(c.Actual))
+}
+
+// Changes is a list of differences between two JSON documents, ordered by the keys of the objects and the indexes of the arrays
+type Changes []Change
+
+// Empty returns whether the documents are equal
+func (c Changes) Empty() bool {
+	return len(c) == 0
+}
+
+// String returns a human readable list of the changes, one per line
+func (c Changes) String() string {
+	lines := make([]string, 0, len(c))
+	for _, change := range c {
+		lines = append(lines, change.String())
+	}
+	return strings.Join(lines, "\n")
+}
+
+// Diff compares the JSON representations of two values and returns the differences of actual compared to expected
+func Diff(actual interface{}, expected interface{}) (Changes, error) {
+	actualDocument, err := toDocument(actual)
+	if err != nil {
+		return nil, fmt.Errorf("unable to encode actual value: %w", err)
+	}
+	expectedDocument, err := toDocument(expected)
+	if err != nil {
+		return nil, fmt.Errorf("unable to encode expected value: %w", err)
+	}
+	changes := Changes{}
+	diff("$", actualDocument, expectedDocument, &changes)
+	return changes, nil
+}
+
+// DiffEvents compares two events, including their data
+func DiffEvents(actual models.KeptnContextExtendedCE, expected models.KeptnContextExtendedCE) (Changes, error) {
+	return Diff(actual, expected)
+}
+
+// DiffEventData compares the data of an event with an expected payload, e.g. a fixture.
+// The paths of the changes are relative to the data of the event
+func DiffEventData(actual models.KeptnContextExtendedCE, expected interface{}) (Changes, error) {
+	return Diff(actual.Data, expected)
+}
+
+// toDocument converts a value into its generic JSON representation.
+// JSON encoded byte slices and json.RawMessages are decoded instead of being encoded again
+func toDocument(value interface{}) (interface{}, error) {
+	var content []byte
+	switch v := value.(type) {
+	case json.RawMessage:
+		content = v
+	case []byte:
+		content = v
+	default:
+		var err error
+		if content, err = json.Marshal(value); err != nil {
+			return nil, err
+		}
+	}
+	var document interface{}
+	if err := json.Unmarshal(content, &document); err != nil {
+		return nil, err
+	}
+	return document, nil
+}
+
+func diff(path string, actual interface{}, expected interface{}, changes *Changes) {
+	switch expectedValue := expected.(type) {
+	case map[string]interface{}:
+		if actualValue, ok := actual.(map[string]interface{}); ok {
+			diffObjects(path, actualValue, expectedValue, changes)
+			return
+		}
+	case []interface{}:
+		if actualValue, ok := actual.([]interface{}); ok {
+			diffArrays(path, actualValue, expectedValue, changes)
+			return
+		}
+	default:
+		if actual == expected {
+			return
+		}
+	}
+	*changes = append(*changes, Change{Path: path, Type: Changed, Expected: expected, Actual: actual})
+}
+
+func diffObjects(path string, actual map[string]interface{}, expected map[string]interface{}, changes *Changes) {
+	keys := make([]string, 0, len(actual)+len(expected))
+	for key := range expected {
+		keys = append(keys, key)
+	}
+	for key := range actual {
+		if _, ok := expected[key]; !ok {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		expectedValue, inExpected := expected[key]
+		actualValue, inActual := actual[key]
+		switch {
+		case !inActual:
+			*changes = append(*changes, Change{Path: childPath(path, key), Type: Removed, Expected: expectedValue})
+		case !inExpected:
+			*changes = append(*changes, Change{Path: childPath(path, key), Type: Added, Actual: actualValue})
+		default:
+			diff(childPath(path, key), actualValue, expectedValue, changes)
+		}
+	}
+}
+
+func diffArrays(path string, actual []interface{}, expected []interface{}, changes *Changes) {
+	for i := 0; i < len(actual) || i < len(expected); i++ {
+		elementPath := fmt.Sprintf("%s[%d]", path, i)
+		switch {
+		case i >= len(actual):
+			*changes = append(*changes, Change{Path: elementPath, Type: Removed, Expected: expected[i]})
+		case i >= len(expected):
+			*changes = append(*changes, Change{Path: elementPath, Type: Added, Actual: actual[i]})
+		default:
+			diff(elementPath, actual[i], expected[i], changes)
+		}
+	}
+}
+
+func childPath(path string, key string) string {
+	if identifierPattern.MatchString(key) {
+		return path + "." + key
+	}
+	return path + "['" + strings.ReplaceAll(key, "'", `\'`) + "']"
+}
+
+func formatValue(value interface{}) string {
+	b, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Sprintf("%v", value)
+	}
+	return string(b)
+}
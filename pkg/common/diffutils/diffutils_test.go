@@ -0,0 +1,63 @@
+package diffutils
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/keptn/go-utils/pkg/api/models"
+	"github.com/keptn/go-utils/pkg/common/strutils"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDiff(t *testing.T) {
+	actual := map[string]interface{}{
+		"project": "sockshop",
+		"stage":   "prod",
+		"labels":  map[string]interface{}{"owner": "jane", "app.kubernetes.io/name": "carts"},
+		"items":   []interface{}{1, 2, 3},
+	}
+	expected := json.RawMessage(`{"project":"sockshop","stage":"dev","service":"carts","labels":{"owner":"jane"},"items":[1,5]}`)
+
+	changes, err := Diff(actual, expected)
+	require.Nil(t, err)
+	require.Equal(t, Changes{
+		{Path: "$.items[1]", Type: Changed, Expected: float64(5), Actual: float64(2)},
+		{Path: "$.items[2]", Type: Added, Actual: float64(3)},
+		{Path: "$.labels['app.kubernetes.io/name']", Type: Added, Actual: "carts"},
+		{Path: "$.service", Type: Removed, Expected: "carts"},
+		{Path: "$.stage", Type: Changed, Expected: "dev", Actual: "prod"},
+	}, changes)
+	require.Equal(t, `$.items[1]: expected 5, got 2
+$.items[2]: unexpected 3
+$.labels['app.kubernetes.io/name']: unexpected "carts"
+$.service: missing, expected "carts"
+$.stage: expected "dev", got "prod"`, changes.String())
+
+	changes, err = Diff(actual, actual)
+	require.Nil(t, err)
+	require.True(t, changes.Empty())
+
+	_, err = Diff(actual, []byte("{invalid"))
+	require.NotNil(t, err)
+}
+
+func TestDiffTypeMismatch(t *testing.T) {
+	changes, err := Diff(map[string]interface{}{"a": []interface{}{"x"}}, map[string]interface{}{"a": map[string]interface{}{"b": "x"}})
+	require.Nil(t, err)
+	require.Equal(t, Changes{{Path: "$.a", Type: Changed, Expected: map[string]interface{}{"b": "x"}, Actual: []interface{}{"x"}}}, changes)
+}
+
+func TestDiffEvents(t *testing.T) {
+	actual := models.KeptnContextExtendedCE{ID: "1", Type: strutils.Stringp("sh.keptn.event.deployment.finished"), Data: map[string]interface{}{"result": "fail"}}
+	expected := models.KeptnContextExtendedCE{ID: "1", Type: strutils.Stringp("sh.keptn.event.deployment.finished"), Data: map[string]interface{}{"result": "pass"}}
+
+	changes, err := DiffEvents(actual, expected)
+	require.Nil(t, err)
+	require.Equal(t, Changes{{Path: "$.data.result", Type: Changed, Expected: "pass", Actual: "fail"}}, changes)
+
+	changes, err = DiffEventData(actual, struct {
+		Result string `json:"result"`
+	}{Result: "pass"})
+	require.Nil(t, err)
+	require.Equal(t, Changes{{Path: "$.result", Type: Changed, Expected: "pass", Actual: "fail"}}, changes)
+}
@@ -33,3 +33,11 @@ func (a *APITokenProvider) GetKeptnAPITokenFromSecret(ctx context.Context, names
 	}
 	return "", fmt.Errorf("data 'keptn-api-token' not found")
 }
+
+// TokenProvider returns a function which reads the Keptn API token from the given secret every time it is called.
+// It can be used as a v2.TokenProviderFunc, e.g. as part of a chain of token providers
+func (a *APITokenProvider) TokenProvider(namespace string, secretName string) func(ctx context.Context) (string, error) {
+	return func(ctx context.Context) (string, error) {
+		return a.GetKeptnAPITokenFromSecret(ctx, namespace, secretName)
+	}
+}
@@ -49,3 +49,23 @@ func TestAPITokenProvider_GetKeptnAPITokenFromSecret_ValidData(t *testing.T) {
 	require.Nil(t, err)
 
 }
+
+func TestAPITokenProvider_TokenProvider(t *testing.T) {
+	kubernetes := fake.NewSimpleClientset()
+	token := "token"
+	kubernetes.Fake.PrependReactor("get", "secrets", func(action k8stesting.Action) (handled bool, ret runtime.Object, err error) {
+		return true, &v1.Secret{Data: map[string][]byte{"keptn-api-token": []byte(token)}}, nil
+	})
+	apiTokenProvider := &APITokenProvider{clientSet: kubernetes}
+	provider := apiTokenProvider.TokenProvider("keptn", "secret")
+
+	res, err := provider(context.TODO())
+	require.Nil(t, err)
+	require.Equal(t, "token", res)
+
+	// the secret is read again on every call
+	token = "rotated-token"
+	res, err = provider(context.TODO())
+	require.Nil(t, err)
+	require.Equal(t, "rotated-token", res)
+}
@@ -0,0 +1,96 @@
+package policy
+
+import (
+	"context"
+	"fmt"
+)
+
+// Operation is the kind of an outgoing operation that is checked against the policies
+type Operation string
+
+const (
+	// OperationCreate creates an entity via the Keptn API
+	OperationCreate Operation = "create"
+	// OperationUpdate updates an entity via the Keptn API
+	OperationUpdate Operation = "update"
+	// OperationDelete deletes an entity via the Keptn API
+	OperationDelete Operation = "delete"
+	// OperationSendEvent sends an event
+	OperationSendEvent Operation = "sendEvent"
+)
+
+// Decision is the result of evaluating the policies for an operation
+type Decision string
+
+const (
+	// Allow lets the operation pass
+	Allow Decision = "allow"
+	// Deny rejects the operation
+	Deny Decision = "deny"
+	// Annotate lets the operation pass, but attaches annotations to it
+	Annotate Decision = "annotate"
+)
+
+// Target identifies the entity affected by an operation. Depending on the operation, some of the fields are empty
+type Target struct {
+	Project   string
+	Stage     string
+	Service   string
+	Resource  string
+	EventType string
+}
+
+// Input describes an outgoing operation
+type Input struct {
+	Operation Operation
+	Target    Target
+	// Payload is the body of the API request or the data of the event, if any
+	Payload []byte
+}
+
+// Result is the decision of a Decider
+type Result struct {
+	Decision Decision
+	// Reason explains the decision, e.g. which rule denied the operation
+	Reason string
+	// Annotations are attached to the operation if the decision is Annotate
+	Annotations map[string]string
+}
+
+// Decider is consulted before mutating API calls are sent and before events are sent,
+// so that organization wide guardrails can be enforced, e.g. that nothing is deleted in production from a CI pipeline.
+// If Decide returns an error, the operation is denied
+type Decider interface {
+	Decide(ctx context.Context, input Input) (Result, error)
+}
+
+// DeniedError is returned when an operation has been denied by a Decider
+type DeniedError struct {
+	Input  Input
+	Reason string
+}
+
+func (e *DeniedError) Error() string {
+	if e.Reason == "" {
+		return fmt.Sprintf("%s operation has been denied by policy", e.Input.Operation)
+	}
+	return fmt.Sprintf("%s operation has been denied by policy: %s", e.Input.Operation, e.Reason)
+}
+
+// Check consults the decider for the given input. It returns the annotations to attach to the operation,
+// or a *DeniedError if the operation must not be executed
+func Check(ctx context.Context, decider Decider, input Input) (map[string]string, error) {
+	result, err := decider.Decide(ctx, input)
+	if err != nil {
+		return nil, &DeniedError{Input: input, Reason: fmt.Sprintf("unable to evaluate policies: %v", err)}
+	}
+	switch result.Decision {
+	case Allow:
+		return nil, nil
+	case Annotate:
+		return result.Annotations, nil
+	case Deny:
+		return nil, &DeniedError{Input: input, Reason: result.Reason}
+	}
+	return nil, &DeniedError{Input: input, Reason: fmt.Sprintf("unknown decision %q", result.Decision)}
+}
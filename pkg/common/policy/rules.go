@@ -0,0 +1,171 @@
+package policy
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Rule is a single rule of a RuleSet. All conditions of a rule must match for the rule to apply.
+// Empty conditions match everything; the conditions on the target and the environment are glob patterns as supported by path.Match
+type Rule struct {
+	// Name identifies the rule in the reason of a decision
+	Name string `yaml:"name"`
+	// Decision is applied if the rule matches
+	Decision Decision `yaml:"decision"`
+	// Reason is reported if the rule denies an operation
+	Reason string `yaml:"reason,omitempty"`
+	// Operations the rule applies to. If empty, the rule applies to all operations
+	Operations []Operation `yaml:"operations,omitempty"`
+	Project    string      `yaml:"project,omitempty"`
+	Stage      string      `yaml:"stage,omitempty"`
+	Service    string      `yaml:"service,omitempty"`
+	Resource   string      `yaml:"resource,omitempty"`
+	EventType  string      `yaml:"eventType,omitempty"`
+	// Env contains patterns the given environment variables must match, e.g. CI: "true"
+	Env map[string]string `yaml:"env,omitempty"`
+	// Annotations are attached to the operation if the decision is Annotate
+	Annotations map[string]string `yaml:"annotations,omitempty"`
+}
+
+// RuleSet is a Decider which evaluates a list of rules, e.g. loaded from a YAML file:
+//
+//	rules:
+//	  - name: no-prod-deletes-from-ci
+//	    decision: deny
+//	    operations: [delete]
+//	    stage: prod
+//	    env:
+//	      CI: "true"
+//	    reason: deleting in production is not allowed from CI pipelines
+//
+// Annotate rules add their annotations and evaluation continues. The first matching allow or deny rule decides.
+// If no allow or deny rule matches, the operation is allowed
+type RuleSet struct {
+	Rules  []Rule `yaml:"rules"`
+	getenv func(string) string
+}
+
+// LoadRuleSet reads a RuleSet from the given YAML file
+func LoadRuleSet(file string) (*RuleSet, error) {
+	content, err := os.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRuleSet(content)
+}
+
+// ParseRuleSet parses and validates a RuleSet in YAML format
+func ParseRuleSet(content []byte) (*RuleSet, error) {
+	ruleSet := &RuleSet{}
+	if err := yaml.Unmarshal(content, ruleSet); err != nil {
+		return nil, fmt.Errorf("unable to parse rules: %w", err)
+	}
+	for i, rule := range ruleSet.Rules {
+		if err := rule.validate(); err != nil {
+			return nil, fmt.Errorf("invalid rule %d (%s): %w", i, rule.Name, err)
+		}
+	}
+	return ruleSet, nil
+}
+
+// Decide evaluates the rules for the given input
+func (r *RuleSet) Decide(ctx context.Context, input Input) (Result, error) {
+	annotations := map[string]string{}
+	for _, rule := range r.Rules {
+		if !rule.matches(input, r.lookupEnv) {
+			continue
+		}
+		switch rule.Decision {
+		case Annotate:
+			for key, value := range rule.Annotations {
+				annotations[key] = value
+			}
+		case Deny:
+			return Result{Decision: Deny, Reason: rule.reason()}, nil
+		case Allow:
+			return withAnnotations(Result{Decision: Allow, Reason: rule.reason()}, annotations), nil
+		}
+	}
+	return withAnnotations(Result{Decision: Allow}, annotations), nil
+}
+
+func (r *RuleSet) lookupEnv(key string) string {
+	if r.getenv != nil {
+		return r.getenv(key)
+	}
+	return os.Getenv(key)
+}
+
+func withAnnotations(result Result, annotations map[string]string) Result {
+	if len(annotations) > 0 {
+		result.Decision = Annotate
+		result.Annotations = annotations
+	}
+	return result
+}
+
+func (r Rule) validate() error {
+	switch r.Decision {
+	case Allow, Deny, Annotate:
+	default:
+		return fmt.Errorf("unknown decision %q", r.Decision)
+	}
+	patterns := []string{r.Project, r.Stage, r.Service, r.Resource, r.EventType}
+	for _, pattern := range r.Env {
+		patterns = append(patterns, pattern)
+	}
+	for _, pattern := range patterns {
+		if _, err := path.Match(pattern, ""); err != nil {
+			return fmt.Errorf("invalid pattern %q: %w", pattern, err)
+		}
+	}
+	return nil
+}
+
+func (r Rule) matches(input Input, getenv func(string) string) bool {
+	if len(r.Operations) > 0 && !containsOperation(r.Operations, input.Operation) {
+		return false
+	}
+	conditions := [][2]string{
+		{r.Project, input.Target.Project},
+		{r.Stage, input.Target.Stage},
+		{r.Service, input.Target.Service},
+		{r.Resource, input.Target.Resource},
+		{r.EventType, input.Target.EventType},
+	}
+	for key, pattern := range r.Env {
+		conditions = append(conditions, [2]string{pattern, getenv(key)})
+	}
+	for _, condition := range conditions {
+		if condition[0] == "" {
+			continue
+		}
+		if matched, _ := path.Match(condition[0], condition[1]); !matched {
+			return false
+		}
+	}
+	return true
+}
+
+func (r Rule) reason() string {
+	switch {
+	case r.Name != "" && r.Reason != "":
+		return r.Name + ": " + r.Reason
+	case r.Name != "":
+		return r.Name
+	}
+	return r.Reason
+}
+
+func containsOperation(operations []Operation, operation Operation) bool {
+	for _, o := range operations {
+		if o == operation {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,111 @@
+package policy
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+const testRules = `rules:
+  - name: audit
+    decision: annotate
+    annotations:
+      audited-by: guardrails
+  - name: no-prod-deletes-from-ci
+    decision: deny
+    operations: [delete]
+    stage: prod
+    env:
+      CI: "true"
+    reason: deleting in production is not allowed from CI pipelines
+  - name: no-test-events
+    decision: deny
+    operations: [sendEvent]
+    eventType: "sh.keptn.event.test.*"
+`
+
+func TestRuleSet_Decide(t *testing.T) {
+	ruleSet, err := ParseRuleSet([]byte(testRules))
+	require.Nil(t, err)
+	env := map[string]string{"CI": "true"}
+	ruleSet.getenv = func(key string) string { return env[key] }
+
+	tests := []struct {
+		name  string
+		input Input
+		want  Result
+	}{
+		{
+			name:  "delete in prod from CI is denied",
+			input: Input{Operation: OperationDelete, Target: Target{Project: "sockshop", Stage: "prod"}},
+			want:  Result{Decision: Deny, Reason: "no-prod-deletes-from-ci: deleting in production is not allowed from CI pipelines"},
+		},
+		{
+			name:  "delete in dev is annotated",
+			input: Input{Operation: OperationDelete, Target: Target{Project: "sockshop", Stage: "dev"}},
+			want:  Result{Decision: Annotate, Annotations: map[string]string{"audited-by": "guardrails"}},
+		},
+		{
+			name:  "create in prod is annotated",
+			input: Input{Operation: OperationCreate, Target: Target{Project: "sockshop", Stage: "prod"}},
+			want:  Result{Decision: Annotate, Annotations: map[string]string{"audited-by": "guardrails"}},
+		},
+		{
+			name:  "event type pattern",
+			input: Input{Operation: OperationSendEvent, Target: Target{EventType: "sh.keptn.event.test.finished"}},
+			want:  Result{Decision: Deny, Reason: "no-test-events"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := ruleSet.Decide(context.TODO(), tt.input)
+			require.Nil(t, err)
+			require.Equal(t, tt.want, result)
+		})
+	}
+
+	env = map[string]string{}
+	result, err := ruleSet.Decide(context.TODO(), Input{Operation: OperationDelete, Target: Target{Stage: "prod"}})
+	require.Nil(t, err)
+	require.Equal(t, Annotate, result.Decision)
+}
+
+func TestParseRuleSet_Invalid(t *testing.T) {
+	_, err := ParseRuleSet([]byte("rules:\n  - decision: maybe\n"))
+	require.ErrorContains(t, err, `unknown decision "maybe"`)
+
+	_, err = ParseRuleSet([]byte("rules:\n  - decision: deny\n    stage: \"[\"\n"))
+	require.ErrorContains(t, err, "invalid pattern")
+
+	_, err = ParseRuleSet([]byte("rules: {"))
+	require.NotNil(t, err)
+}
+
+func TestLoadRuleSet(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "rules.yaml")
+	require.Nil(t, os.WriteFile(file, []byte(testRules), 0644))
+	ruleSet, err := LoadRuleSet(file)
+	require.Nil(t, err)
+	require.Len(t, ruleSet.Rules, 3)
+
+	_, err = LoadRuleSet(filepath.Join(t.TempDir(), "missing.yaml"))
+	require.NotNil(t, err)
+}
+
+func TestCheck(t *testing.T) {
+	ruleSet, err := ParseRuleSet([]byte(testRules))
+	require.Nil(t, err)
+	ruleSet.getenv = func(key string) string { return "true" }
+
+	annotations, err := Check(context.TODO(), ruleSet, Input{Operation: OperationUpdate})
+	require.Nil(t, err)
+	require.Equal(t, map[string]string{"audited-by": "guardrails"}, annotations)
+
+	_, err = Check(context.TODO(), ruleSet, Input{Operation: OperationDelete, Target: Target{Stage: "prod"}})
+	var deniedErr *DeniedError
+	require.ErrorAs(t, err, &deniedErr)
+	require.Equal(t, "delete operation has been denied by policy: no-prod-deletes-from-ci: deleting in production is not allowed from CI pipelines", err.Error())
+}
@@ -0,0 +1,91 @@
+package retry
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+)
+
+// CancelledError is returned by Retry and Poll if the context is done before the operation succeeded.
+// It wraps the cause of the cancellation, i.e. the error passed to the cancel function of a context
+// created with context.WithCancelCause on Go 1.20 or later, or else the error of the context, and describes
+// how far the operation progressed, so that callers can log more than a bare "context canceled"
+type CancelledError struct {
+	// Operation is the cancelled operation, i.e. "retry" or "polling"
+	Operation string
+	// Attempts is the number of attempts made before the operation was cancelled
+	Attempts int
+	// Elapsed is the time spent on the operation
+	Elapsed time.Duration
+	// LastState is the state last observed via ObserveState, if any
+	LastState string
+	// LastErr is the error of the last attempt, if any
+	LastErr error
+	// Cause is the cause of the cancellation
+	Cause error
+
+	ctxErr error
+}
+
+func newCancelledError(ctx context.Context, operation string, attempts int, started time.Time) *CancelledError {
+	return &CancelledError{
+		Operation: operation,
+		Attempts:  attempts,
+		Elapsed:   time.Since(started),
+		Cause:     contextCause(ctx),
+		ctxErr:    ctx.Err(),
+	}
+}
+
+func (e *CancelledError) Error() string {
+	msg := fmt.Sprintf("%s cancelled after %d attempt(s) in %s: %v", e.Operation, e.Attempts, e.Elapsed.Round(time.Millisecond), e.Cause)
+	var details []string
+	if e.LastState != "" {
+		details = append(details, "last state: "+e.LastState)
+	}
+	if e.LastErr != nil {
+		details = append(details, "last error: "+e.LastErr.Error())
+	}
+	if len(details) > 0 {
+		msg += " (" + strings.Join(details, ", ") + ")"
+	}
+	return msg
+}
+
+// Unwrap returns the cause of the cancellation
+func (e *CancelledError) Unwrap() error {
+	return e.Cause
+}
+
+// Is reports whether the target is the error of the cancelled context, i.e. context.Canceled or context.DeadlineExceeded,
+// which differs from the cause if the context has been cancelled with a cause
+func (e *CancelledError) Is(target error) bool {
+	return target != nil && target == e.ctxErr
+}
+
+type stateRecorderKey struct{}
+
+// stateRecorder keeps the state last observed by a ConditionFunc
+type stateRecorder struct {
+	mtx   sync.Mutex
+	state string
+}
+
+func (r *stateRecorder) get() string {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+	return r.state
+}
+
+// ObserveState records the state observed by a ConditionFunc, e.g. the state of a sequence which is polled until it is finished.
+// If polling is cancelled, the last observed state is reported in the CancelledError returned by Poll.
+// It does nothing if the context has not been passed to the ConditionFunc by Poll
+func ObserveState(ctx context.Context, state string) {
+	if recorder, ok := ctx.Value(stateRecorderKey{}).(*stateRecorder); ok {
+		recorder.mtx.Lock()
+		recorder.state = state
+		recorder.mtx.Unlock()
+	}
+}
@@ -0,0 +1,60 @@
+package retry_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/keptn/go-utils/pkg/common/retry"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPollReportsCancellation(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.TODO(), 50*time.Millisecond)
+	defer cancel()
+	var count int
+	err := retry.Poll(ctx, time.Millisecond, 5*time.Millisecond, func(ctx context.Context) (bool, error) {
+		count++
+		retry.ObserveState(ctx, "started")
+		return false, nil
+	})
+	cancelled := &retry.CancelledError{}
+	require.ErrorAs(t, err, &cancelled)
+	assert.Equal(t, "polling", cancelled.Operation)
+	assert.Equal(t, count, cancelled.Attempts)
+	assert.Equal(t, "started", cancelled.LastState)
+	assert.GreaterOrEqual(t, cancelled.Elapsed, 50*time.Millisecond)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.Contains(t, err.Error(), "polling cancelled after")
+	assert.Contains(t, err.Error(), "(last state: started)")
+}
+
+func TestRetryReportsCancellation(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.TODO())
+	attemptErr := errors.New("connection refused")
+	var count int
+	err := retry.Retry(func() error {
+		count++
+		if count == 3 {
+			cancel()
+		}
+		return attemptErr
+	}, retry.Context(ctx), retry.DelayBetweenRetries(time.Millisecond))
+
+	cancelled := &retry.CancelledError{}
+	require.ErrorAs(t, err, &cancelled)
+	assert.Equal(t, "retry", cancelled.Operation)
+	assert.Equal(t, 3, cancelled.Attempts)
+	assert.Equal(t, attemptErr, cancelled.LastErr)
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.Contains(t, err.Error(), "retry cancelled after 3 attempt(s)")
+	assert.Contains(t, err.Error(), "last error: connection refused")
+}
+
+func TestObserveStateOutsidePoll(t *testing.T) {
+	assert.NotPanics(t, func() {
+		retry.ObserveState(context.TODO(), "started")
+	})
+}
@@ -0,0 +1,11 @@
+//go:build !go1.20
+// +build !go1.20
+
+package retry
+
+import "context"
+
+// contextCause returns the error of the context, since causes of cancellations are not supported before Go 1.20
+func contextCause(ctx context.Context) error {
+	return ctx.Err()
+}
@@ -0,0 +1,26 @@
+//go:build go1.20
+// +build go1.20
+
+package retry_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/keptn/go-utils/pkg/common/retry"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPollWrapsCancellationCause(t *testing.T) {
+	cause := errors.New("shutting down")
+	ctx, cancel := context.WithCancelCause(context.TODO())
+	cancel(cause)
+	err := retry.Poll(ctx, time.Millisecond, time.Millisecond, func(ctx context.Context) (bool, error) {
+		return false, nil
+	})
+	assert.ErrorIs(t, err, cause)
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.Contains(t, err.Error(), "shutting down")
+}
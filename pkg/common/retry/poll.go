@@ -0,0 +1,60 @@
+package retry
+
+import (
+	"context"
+	"errors"
+	"math/rand"
+	"time"
+)
+
+// jitterFactor is the share of the current interval by which the actual delay between two polls is randomized
+const jitterFactor = 0.5
+
+// ConditionFunc is checked by Poll. It returns true once the condition is met.
+// If it returns an error, polling is stopped and the error is returned by Poll
+type ConditionFunc func(ctx context.Context) (done bool, err error)
+
+// Poll checks the condition until it is met, it returns an error or the context is done.
+// The delay between two checks starts at interval and doubles after every unsuccessful check until it reaches maxInterval.
+// Each delay is randomized by up to 50% so that several pollers do not hit an API at the same time.
+// To bound the total time spent polling, use a context with a timeout or deadline. If the context is done before the
+// condition is met, a *CancelledError is returned, containing the state last observed by the condition via ObserveState
+func Poll(ctx context.Context, interval time.Duration, maxInterval time.Duration, condition ConditionFunc) error {
+	if interval <= 0 {
+		return errors.New("polling interval must be positive")
+	}
+	if maxInterval < interval {
+		maxInterval = interval
+	}
+	recorder := &stateRecorder{}
+	conditionCtx := context.WithValue(ctx, stateRecorderKey{}, recorder)
+	started := time.Now()
+	for attempts := 1; ; attempts++ {
+		done, err := condition(conditionCtx)
+		if err != nil {
+			return err
+		}
+		if done {
+			return nil
+		}
+		timer := time.NewTimer(withJitter(interval))
+		select {
+		case <-timer.C:
+		case <-ctx.Done():
+			timer.Stop()
+			cancelled := newCancelledError(ctx, "polling", attempts, started)
+			cancelled.LastState = recorder.get()
+			return cancelled
+		}
+		interval *= 2
+		if interval > maxInterval {
+			interval = maxInterval
+		}
+	}
+}
+
+// withJitter returns a random duration within jitterFactor of the given one
+func withJitter(d time.Duration) time.Duration {
+	delta := jitterFactor * float64(d)
+	return time.Duration(float64(d) - delta + rand.Float64()*2*delta)
+}
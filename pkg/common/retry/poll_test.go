@@ -0,0 +1,62 @@
+package retry_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/keptn/go-utils/pkg/common/retry"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPollUntilConditionIsMet(t *testing.T) {
+	var count int
+	err := retry.Poll(context.TODO(), time.Millisecond, 4*time.Millisecond, func(ctx context.Context) (bool, error) {
+		count++
+		return count == 5, nil
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, 5, count)
+}
+
+func TestPollStopsOnError(t *testing.T) {
+	var count int
+	conditionErr := errors.New("test")
+	err := retry.Poll(context.TODO(), time.Millisecond, time.Millisecond, func(ctx context.Context) (bool, error) {
+		count++
+		return false, conditionErr
+	})
+	assert.ErrorIs(t, err, conditionErr)
+	assert.Equal(t, 1, count)
+}
+
+func TestPollStopsWhenContextIsDone(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.TODO(), 50*time.Millisecond)
+	defer cancel()
+	err := retry.Poll(ctx, time.Millisecond, 10*time.Millisecond, func(ctx context.Context) (bool, error) {
+		return false, nil
+	})
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+}
+
+func TestPollIntervalGrowsExponentially(t *testing.T) {
+	var calls []time.Time
+	err := retry.Poll(context.TODO(), 10*time.Millisecond, 40*time.Millisecond, func(ctx context.Context) (bool, error) {
+		calls = append(calls, time.Now())
+		return len(calls) == 5, nil
+	})
+	assert.Nil(t, err)
+	// with a jitter of up to 50%, the delays are at least 5, 10, 20 and 20 milliseconds
+	minDelays := []time.Duration{5 * time.Millisecond, 10 * time.Millisecond, 20 * time.Millisecond, 20 * time.Millisecond}
+	for i, minDelay := range minDelays {
+		assert.GreaterOrEqual(t, calls[i+1].Sub(calls[i]), minDelay)
+	}
+}
+
+func TestPollWithInvalidInterval(t *testing.T) {
+	err := retry.Poll(context.TODO(), 0, time.Second, func(ctx context.Context) (bool, error) {
+		return true, nil
+	})
+	assert.NotNil(t, err)
+}
@@ -40,7 +40,8 @@ type RetryConfiguration struct {
 type RetryFunc func() error
 
 // Retry executes the retryFunc repeatedly until it was successful or canceled by the context
-// The default number of retries is 20 and the default delay between retries is 5 seconds
+// The default number of retries is 20 and the default delay between retries is 5 seconds.
+// If the context is cancelled, a *CancelledError containing the error of the last attempt is returned
 func Retry(retryFunc RetryFunc, opts ...Option) error {
 	configuration := &RetryConfiguration{
 		numberOfRetries:     DefaultNumberOfRetries,
@@ -51,13 +52,16 @@ func Retry(retryFunc RetryFunc, opts ...Option) error {
 	}
 
 	var i uint
+	started := time.Now()
 	for i < configuration.numberOfRetries {
 		err := retryFunc()
 		if err != nil {
 			select {
 			case <-time.After(configuration.delayBetweenRetries):
 			case <-configuration.context.Done():
-				return fmt.Errorf("retry cancelled")
+				cancelled := newCancelledError(configuration.context, "retry", int(i)+1, started)
+				cancelled.LastErr = err
+				return cancelled
 			}
 		} else {
 			return nil
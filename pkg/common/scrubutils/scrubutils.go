@@ -0,0 +1,270 @@
+package scrubutils
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/keptn/go-utils/pkg/api/models"
+)
+
+// Redacted replaces the values of secrets, which are removed entirely
+const Redacted = "[REDACTED]"
+
+// defaultSecretKeys matches the keys whose values are considered secrets by default
+var defaultSecretKeys = regexp.MustCompile(`(?i)(password|passwd|secret|token|api[-_]?key|authorization|credentials?|private[-_]?key)`)
+
+// jsonStringMember matches a member of a JSON object with a string value, capturing its key.
+// The value may be cut off by the end of the text
+var jsonStringMember = regexp.MustCompile(`"((?:[^"\\]|\\.)*)"(\s*:\s*)"(?:[^"\\]|\\.)*(?:"|\\?$)`)
+
+// defaultPatterns are replaced in all string values by default.
+// If a pattern contains a capturing group, only the captured text is replaced
+var defaultPatterns = []Pattern{
+	{Name: "user", Regexp: regexp.MustCompile(`[A-Za-z0-9._%+-]+@[A-Za-z0-9.-]+\.[A-Za-z]{2,}`)},
+	{Name: "host", Regexp: regexp.MustCompile(`(?i)\b[a-z][a-z0-9+.-]*://(?:[^/\s@]+@)?([^/\s:?#]+)`)},
+	// bare hostnames are only recognized by common top level domains and cluster internal suffixes,
+	// so that file names such as values.yaml are kept
+	{Name: "host", Regexp: regexp.MustCompile(`(?i)\b(?:[a-z0-9](?:[a-z0-9-]*[a-z0-9])?\.)+(?:com|net|org|io|dev|app|cloud|local|localdomain|internal|corp|lan|svc)\b`)},
+	{Name: "ip", Regexp: regexp.MustCompile(`\b(?:\d{1,3}\.){3}\d{1,3}\b`)},
+}
+
+// Pattern is a named regular expression whose matches are obfuscated.
+// If the expression contains a capturing group, only the text captured by the first group is obfuscated
+type Pattern struct {
+	Name   string
+	Regexp *regexp.Regexp
+}
+
+// Scrubber removes secrets and obfuscates hostnames, user identifiers and label values of events,
+// so that they can be shared as diagnostics. Obfuscated values are replaced by a salted hash,
+// so that equal values can still be correlated within the scrubbed events
+type Scrubber struct {
+	secretKeys []*regexp.Regexp
+	labelKeys  []*regexp.Regexp
+	patterns   []Pattern
+	salt       string
+}
+
+// Option configures a Scrubber
+type Option func(*Scrubber)
+
+// WithSecretKeys adds expressions matching keys whose values are removed.
+// By default, values of keys containing e.g. password, secret, token or apikey are removed
+func WithSecretKeys(keys ...*regexp.Regexp) Option {
+	return func(s *Scrubber) {
+		s.secretKeys = append(s.secretKeys, keys...)
+	}
+}
+
+// WithLabelKeys restricts the obfuscation of label values to the labels whose keys match one of the expressions.
+// By default, the values of all labels are obfuscated
+func WithLabelKeys(keys ...*regexp.Regexp) Option {
+	return func(s *Scrubber) {
+		s.labelKeys = keys
+	}
+}
+
+// WithPattern adds a pattern whose matches are obfuscated in all string values.
+// By default, email addresses, hostnames and IPv4 addresses are obfuscated
+func WithPattern(name string, expr *regexp.Regexp) Option {
+	return func(s *Scrubber) {
+		s.patterns = append(s.patterns, Pattern{Name: name, Regexp: expr})
+	}
+}
+
+// WithSalt sets the salt used for hashing obfuscated values, e.g. to correlate values scrubbed by different Scrubbers.
+// By default, a random salt is generated for each Scrubber, so that obfuscated values that are easy to guess,
+// such as well known hostnames, cannot be recovered. The salt must not be shared along with the scrubbed values
+func WithSalt(salt string) Option {
+	return func(s *Scrubber) {
+		s.salt = salt
+	}
+}
+
+// New creates a new Scrubber
+func New(opts ...Option) *Scrubber {
+	s := &Scrubber{
+		secretKeys: []*regexp.Regexp{defaultSecretKeys},
+		labelKeys:  []*regexp.Regexp{regexp.MustCompile(".*")},
+		patterns:   append([]Pattern{}, defaultPatterns...),
+		salt:       randomSalt(),
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
+}
+
+func randomSalt() string {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return strconv.FormatInt(time.Now().UnixNano(), 16)
+	}
+	return hex.EncodeToString(b)
+}
+
+// ScrubEvents returns scrubbed copies of the given events
+func (s *Scrubber) ScrubEvents(events []*models.KeptnContextExtendedCE) ([]*models.KeptnContextExtendedCE, error) {
+	scrubbed := make([]*models.KeptnContextExtendedCE, 0, len(events))
+	for _, event := range events {
+		e, err := s.ScrubEvent(event)
+		if err != nil {
+			return nil, err
+		}
+		scrubbed = append(scrubbed, e)
+	}
+	return scrubbed, nil
+}
+
+// ScrubEvent returns a scrubbed copy of the given event. The data, extensions and source of the event are scrubbed,
+// while its identifiers, such as the ID, keptn context and type, are kept to allow correlating the events
+func (s *Scrubber) ScrubEvent(event *models.KeptnContextExtendedCE) (*models.KeptnContextExtendedCE, error) {
+	if event == nil {
+		return nil, nil
+	}
+	b, err := event.ToJSON()
+	if err != nil {
+		return nil, err
+	}
+	scrubbed := &models.KeptnContextExtendedCE{}
+	if err := scrubbed.FromJSON(b); err != nil {
+		return nil, err
+	}
+	scrubbed.Data = s.Scrub(scrubbed.Data)
+	scrubbed.Extensions = s.Scrub(scrubbed.Extensions)
+	if scrubbed.Source != nil {
+		source := s.scrubString(*scrubbed.Source)
+		scrubbed.Source = &source
+	}
+	return scrubbed, nil
+}
+
+// ScrubJSON scrubs an arbitrary JSON document, e.g. an export of events or projects
+func (s *Scrubber) ScrubJSON(content []byte) ([]byte, error) {
+	var document interface{}
+	if err := json.Unmarshal(content, &document); err != nil {
+		return nil, err
+	}
+	return json.Marshal(s.Scrub(document))
+}
+
+// Scrub scrubs a value as produced by decoding JSON into an interface{}, i.e. consisting of
+// maps, slices and primitive values. The value is modified in place and returned
+func (s *Scrubber) Scrub(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for key, item := range v {
+			switch {
+			case s.IsSecretKey(key):
+				v[key] = Redacted
+			case key == "labels":
+				v[key] = s.scrubLabels(item)
+			default:
+				v[key] = s.Scrub(item)
+			}
+		}
+		return v
+	case []interface{}:
+		for i, item := range v {
+			v[i] = s.Scrub(item)
+		}
+		return v
+	case string:
+		return s.scrubString(v)
+	default:
+		return v
+	}
+}
+
+func (s *Scrubber) scrubLabels(value interface{}) interface{} {
+	labels, ok := value.(map[string]interface{})
+	if !ok {
+		return s.Scrub(value)
+	}
+	for key, label := range labels {
+		switch {
+		case s.IsSecretKey(key):
+			labels[key] = Redacted
+		case s.isLabelKey(key):
+			if str, ok := label.(string); ok {
+				labels[key] = s.obfuscate("label", str)
+			} else {
+				labels[key] = s.Scrub(label)
+			}
+		default:
+			labels[key] = s.Scrub(label)
+		}
+	}
+	return labels
+}
+
+// RedactSecrets removes the string values of secret keys from a JSON text, e.g. a request body.
+// Unlike ScrubJSON, it does not obfuscate any other values and also works on truncated or otherwise invalid JSON
+func (s *Scrubber) RedactSecrets(text string) string {
+	return jsonStringMember.ReplaceAllStringFunc(text, func(member string) string {
+		match := jsonStringMember.FindStringSubmatch(member)
+		if !s.IsSecretKey(match[1]) {
+			return member
+		}
+		return `"` + match[1] + `"` + match[2] + `"` + Redacted + `"`
+	})
+}
+
+// IsSecretKey returns whether the value of the given key is considered a secret
+func (s *Scrubber) IsSecretKey(key string) bool {
+	return matchesAny(s.secretKeys, key)
+}
+
+func (s *Scrubber) isLabelKey(key string) bool {
+	return matchesAny(s.labelKeys, key)
+}
+
+func matchesAny(expressions []*regexp.Regexp, value string) bool {
+	for _, expr := range expressions {
+		if expr.MatchString(value) {
+			return true
+		}
+	}
+	return false
+}
+
+// scrubString obfuscates all matches of the patterns in the given string
+func (s *Scrubber) scrubString(value string) string {
+	for _, pattern := range s.patterns {
+		value = s.replaceMatches(value, pattern)
+	}
+	return value
+}
+
+func (s *Scrubber) replaceMatches(value string, pattern Pattern) string {
+	matches := pattern.Regexp.FindAllStringSubmatchIndex(value, -1)
+	if len(matches) == 0 {
+		return value
+	}
+	sb := strings.Builder{}
+	last := 0
+	for _, match := range matches {
+		start, end := match[0], match[1]
+		if len(match) >= 4 && match[2] >= 0 {
+			// only replace the text captured by the first group
+			start, end = match[2], match[3]
+		}
+		sb.WriteString(value[last:start])
+		sb.WriteString(s.obfuscate(pattern.Name, value[start:end]))
+		last = end
+	}
+	sb.WriteString(value[last:])
+	return sb.String()
+}
+
+// obfuscate replaces the value by its salted hash, prefixed with the given kind
+func (s *Scrubber) obfuscate(kind string, value string) string {
+	hash := sha256.Sum256([]byte(s.salt + value))
+	return kind + "-" + hex.EncodeToString(hash[:])[:10]
+}
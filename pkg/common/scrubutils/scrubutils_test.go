@@ -0,0 +1,121 @@
+package scrubutils
+
+import (
+	"encoding/json"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/keptn/go-utils/pkg/api/models"
+	"github.com/keptn/go-utils/pkg/common/strutils"
+	"github.com/stretchr/testify/require"
+)
+
+func TestScrubber_ScrubEvent(t *testing.T) {
+	event := &models.KeptnContextExtendedCE{
+		ID:             "my-id",
+		Shkeptncontext: "my-context",
+		Type:           strutils.Stringp("sh.keptn.event.deployment.finished"),
+		Source:         strutils.Stringp("helm-service"),
+		Data: map[string]interface{}{
+			"project": "sockshop",
+			"message": "deployed by jane.doe@example.com to https://carts.prod.example.com:8080/health",
+			"labels": map[string]interface{}{
+				"owner":    "jane.doe",
+				"apiToken": "abc",
+			},
+			"deployment": map[string]interface{}{
+				"deploymentURIsLocal": []interface{}{"http://10.0.0.12:80"},
+				"gitPassword":         "s3cr3t",
+			},
+		},
+	}
+
+	scrubbed, err := New(WithSalt("salt")).ScrubEvent(event)
+	require.Nil(t, err)
+
+	// the original event is not modified
+	require.Equal(t, "s3cr3t", event.Data.(map[string]interface{})["deployment"].(map[string]interface{})["gitPassword"])
+
+	require.Equal(t, "my-id", scrubbed.ID)
+	require.Equal(t, "my-context", scrubbed.Shkeptncontext)
+	require.Equal(t, "sh.keptn.event.deployment.finished", *scrubbed.Type)
+	require.Equal(t, "helm-service", *scrubbed.Source)
+
+	data := scrubbed.Data.(map[string]interface{})
+	require.Equal(t, "sockshop", data["project"])
+	message := data["message"].(string)
+	require.NotContains(t, message, "jane.doe@example.com")
+	require.NotContains(t, message, "carts.prod.example.com")
+	require.True(t, strings.HasPrefix(message, "deployed by user-"))
+	require.Contains(t, message, "https://host-")
+	require.Contains(t, message, ":8080/health")
+
+	labels := data["labels"].(map[string]interface{})
+	require.Equal(t, Redacted, labels["apiToken"])
+	require.True(t, strings.HasPrefix(labels["owner"].(string), "label-"))
+
+	deployment := data["deployment"].(map[string]interface{})
+	require.Equal(t, Redacted, deployment["gitPassword"])
+	require.NotContains(t, deployment["deploymentURIsLocal"].([]interface{})[0], "10.0.0.12")
+}
+
+func TestScrubber_ObfuscationIsStable(t *testing.T) {
+	s := New(WithSalt("salt"))
+	first := s.Scrub("contact jane.doe@example.com").(string)
+	second := s.Scrub("jane.doe@example.com wrote").(string)
+	require.Equal(t, strings.TrimPrefix(first, "contact "), strings.TrimSuffix(second, " wrote"))
+
+	other := New(WithSalt("other-salt")).Scrub("jane.doe@example.com").(string)
+	require.NotEqual(t, strings.TrimSuffix(second, " wrote"), other)
+}
+
+func TestScrubber_DefaultSalt(t *testing.T) {
+	// without a salt, each Scrubber obfuscates with its own random salt
+	first := New().Scrub("jane.doe@example.com").(string)
+	second := New().Scrub("jane.doe@example.com").(string)
+	require.NotEqual(t, first, second)
+}
+
+func TestScrubber_BareHostnames(t *testing.T) {
+	s := New(WithSalt("salt"))
+	scrubbed := s.Scrub("connecting to carts.sockshop-dev.svc.cluster.local failed, see values.yaml").(string)
+	require.NotContains(t, scrubbed, "carts.sockshop-dev")
+	require.Regexp(t, `^connecting to host-[0-9a-f]{10} failed, see values\.yaml$`, scrubbed)
+
+	// bare hostnames and hostnames of URLs are obfuscated alike, so that they can be correlated
+	require.Equal(t, "https://"+s.Scrub("api.example.com").(string)+"/v1", s.Scrub("https://api.example.com/v1"))
+}
+
+func TestScrubber_Options(t *testing.T) {
+	s := New(
+		WithSecretKeys(regexp.MustCompile(`^internal`)),
+		WithLabelKeys(regexp.MustCompile(`^owner$`)),
+		WithPattern("customer", regexp.MustCompile(`customer-(\d+)`)),
+	)
+	scrubbed, err := s.ScrubJSON([]byte(`{"internalNote":"x","labels":{"owner":"jane","team":"payments"},"message":"order of customer-1234"}`))
+	require.Nil(t, err)
+
+	document := map[string]interface{}{}
+	require.Nil(t, json.Unmarshal(scrubbed, &document))
+	require.Equal(t, Redacted, document["internalNote"])
+	labels := document["labels"].(map[string]interface{})
+	require.Equal(t, "payments", labels["team"])
+	require.NotEqual(t, "jane", labels["owner"])
+	require.Regexp(t, `^order of customer-customer-[0-9a-f]{10}$`, document["message"])
+
+	_, err = s.ScrubJSON([]byte(`{invalid`))
+	require.NotNil(t, err)
+}
+
+func TestScrubber_RedactSecrets(t *testing.T) {
+	s := New()
+	require.Equal(t,
+		`{"name":"my-secret","data":{"apiToken":"[REDACTED]","user":"jane"},"password" : "[REDACTED]"`,
+		s.RedactSecrets(`{"name":"my-secret","data":{"apiToken":"abc\"def","user":"jane"},"password" : "s3cr3t"`),
+	)
+	require.Equal(t, `{"user":"jane","token":"[REDACTED]"`, s.RedactSecrets(`{"user":"jane","token":"abc`))
+	require.Equal(t, "not json", s.RedactSecrets("not json"))
+	require.True(t, s.IsSecretKey("x-token"))
+	require.False(t, s.IsSecretKey("project"))
+}
@@ -0,0 +1,281 @@
+package testutils
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"math/rand"
+	"net"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+)
+
+// Fault is a failure injected by a ChaosTransport
+type Fault int
+
+const (
+	// NoFault passes the request on unchanged, apart from the latency of the rule
+	NoFault Fault = iota
+	// ConnectionReset fails the request with a connection reset error without sending it
+	ConnectionReset
+	// ServerError responds with a 5xx status code without sending the request
+	ServerError
+	// TruncatedBody cuts the body of the response, so that reading it fails with io.ErrUnexpectedEOF,
+	// as if the connection was closed while the body was transferred
+	TruncatedBody
+	// SlowBody delays each chunk of the body of the response
+	SlowBody
+)
+
+// String returns the name of the fault
+func (f Fault) String() string {
+	switch f {
+	case NoFault:
+		return "none"
+	case ConnectionReset:
+		return "connection reset"
+	case ServerError:
+		return "server error"
+	case TruncatedBody:
+		return "truncated body"
+	case SlowBody:
+		return "slow body"
+	}
+	return "unknown fault " + strconv.Itoa(int(f))
+}
+
+const (
+	defaultChaosStatusCode = http.StatusServiceUnavailable
+	defaultChaosChunkSize  = 16
+)
+
+// ChaosRule describes the failures injected into the requests matched by the rule
+type ChaosRule struct {
+	// Method restricts the rule to requests with the given method. An empty method matches all requests
+	Method string
+	// PathSuffix restricts the rule to requests whose URL path ends with the given suffix, e.g. /v1/event.
+	// An empty suffix matches all requests
+	PathSuffix string
+	// After is the number of matched requests which pass before the rule is applied
+	After int
+	// Count is the number of matched requests the rule is applied to, e.g. the length of a burst of server errors.
+	// 0 applies the rule to all requests following the first After requests
+	Count int
+	// Probability is the probability in (0,1) with which the rule is applied to a request it selects.
+	// Values <= 0 or >= 1 apply the rule to every selected request
+	Probability float64
+	// Latency delays the request before it is sent or the fault is injected
+	Latency time.Duration
+	// Fault is the failure injected
+	Fault Fault
+	// StatusCode is the status code of a ServerError (default 503)
+	StatusCode int
+	// TruncateAt is the number of bytes of the body kept by TruncatedBody (default half of the body)
+	TruncateAt int
+	// ChunkSize is the size of the chunks of a SlowBody in bytes (default 16)
+	ChunkSize int
+	// ChunkDelay is the delay before each chunk of a SlowBody
+	ChunkDelay time.Duration
+}
+
+func (r ChaosRule) matches(req *http.Request) bool {
+	if r.Method != "" && !strings.EqualFold(r.Method, req.Method) {
+		return false
+	}
+	return strings.HasSuffix(req.URL.Path, r.PathSuffix)
+}
+
+// ChaosScenario is the configuration of a ChaosTransport.
+// For each request, the first rule which matches it and selects it is applied
+type ChaosScenario struct {
+	Rules []ChaosRule
+	// Seed is the seed for rules with a Probability, which makes the injected failures reproducible
+	Seed int64
+}
+
+// ChaosTransport is a http.RoundTripper injecting latency, connection resets, server errors,
+// truncated bodies and slow bodies into requests according to a ChaosScenario.
+// It can be set as the transport of the http.Client of the API clients to test how they and the code using them
+// cope with an unreliable Keptn API, e.g. whether retries and backoff behave as expected
+type ChaosTransport struct {
+	base     http.RoundTripper
+	scenario ChaosScenario
+	mtx      sync.Mutex
+	matched  []int
+	injected map[Fault]int
+	random   *rand.Rand
+}
+
+// NewChaosTransport creates a new ChaosTransport sending the requests using the given base transport.
+// If base is nil, http.DefaultTransport is used
+func NewChaosTransport(base http.RoundTripper, scenario ChaosScenario) *ChaosTransport {
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	return &ChaosTransport{
+		base:     base,
+		scenario: scenario,
+		matched:  make([]int, len(scenario.Rules)),
+		injected: map[Fault]int{},
+		random:   rand.New(rand.NewSource(scenario.Seed)),
+	}
+}
+
+// Injected returns the number of requests each fault has been injected into.
+// Requests which have only been delayed are counted as NoFault
+func (c *ChaosTransport) Injected() map[Fault]int {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	injected := map[Fault]int{}
+	for fault, count := range c.injected {
+		injected[fault] = count
+	}
+	return injected
+}
+
+// RoundTrip sends the request, injecting the failures of the first rule selecting it
+func (c *ChaosTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	rule, ok := c.selectRule(req)
+	if !ok {
+		return c.base.RoundTrip(req)
+	}
+	if rule.Latency > 0 {
+		timer := time.NewTimer(rule.Latency)
+		select {
+		case <-timer.C:
+		case <-req.Context().Done():
+			timer.Stop()
+			closeRequestBody(req)
+			return nil, req.Context().Err()
+		}
+	}
+
+	switch rule.Fault {
+	case ConnectionReset:
+		closeRequestBody(req)
+		return nil, &net.OpError{Op: "read", Net: "tcp", Err: os.NewSyscallError("read", syscall.ECONNRESET)}
+	case ServerError:
+		closeRequestBody(req)
+		return serverErrorResponse(req, rule), nil
+	}
+
+	resp, err := c.base.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+	switch rule.Fault {
+	case TruncatedBody:
+		return truncateResponse(resp, rule)
+	case SlowBody:
+		resp.Body = &slowBody{body: resp.Body, chunkSize: rule.ChunkSize, delay: rule.ChunkDelay}
+	}
+	return resp, nil
+}
+
+// closeRequestBody closes the body of a request which is not sent, as required of a http.RoundTripper
+func closeRequestBody(req *http.Request) {
+	if req.Body != nil {
+		req.Body.Close()
+	}
+}
+
+func (c *ChaosTransport) selectRule(req *http.Request) (ChaosRule, bool) {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	for i, rule := range c.scenario.Rules {
+		if !rule.matches(req) {
+			continue
+		}
+		c.matched[i]++
+		n := c.matched[i]
+		if n <= rule.After || (rule.Count > 0 && n > rule.After+rule.Count) {
+			continue
+		}
+		if rule.Probability > 0 && rule.Probability < 1 && c.random.Float64() >= rule.Probability {
+			continue
+		}
+		c.injected[rule.Fault]++
+		return rule, true
+	}
+	return ChaosRule{}, false
+}
+
+func serverErrorResponse(req *http.Request, rule ChaosRule) *http.Response {
+	statusCode := rule.StatusCode
+	if statusCode == 0 {
+		statusCode = defaultChaosStatusCode
+	}
+	body := fmt.Sprintf(`{"code":%d,"message":"injected server error"}`, statusCode)
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", statusCode, http.StatusText(statusCode)),
+		StatusCode:    statusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        http.Header{"Content-Type": []string{"application/json"}},
+		Body:          ioutil.NopCloser(strings.NewReader(body)),
+		ContentLength: int64(len(body)),
+		Request:       req,
+	}
+}
+
+func truncateResponse(resp *http.Response, rule ChaosRule) (*http.Response, error) {
+	body, err := ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	keep := rule.TruncateAt
+	if keep <= 0 || keep > len(body) {
+		keep = len(body) / 2
+	}
+	resp.Body = &truncatedBody{Reader: bytes.NewReader(body[:keep])}
+	resp.ContentLength = int64(len(body))
+	return resp, nil
+}
+
+// truncatedBody fails with io.ErrUnexpectedEOF once the kept part of the body has been read
+type truncatedBody struct {
+	*bytes.Reader
+}
+
+func (b *truncatedBody) Read(p []byte) (int, error) {
+	n, err := b.Reader.Read(p)
+	if err == io.EOF {
+		err = io.ErrUnexpectedEOF
+	}
+	return n, err
+}
+
+func (b *truncatedBody) Close() error {
+	return nil
+}
+
+// slowBody delays each chunk of the wrapped body
+type slowBody struct {
+	body      io.ReadCloser
+	chunkSize int
+	delay     time.Duration
+}
+
+func (b *slowBody) Read(p []byte) (int, error) {
+	chunkSize := b.chunkSize
+	if chunkSize <= 0 {
+		chunkSize = defaultChaosChunkSize
+	}
+	if len(p) > chunkSize {
+		p = p[:chunkSize]
+	}
+	time.Sleep(b.delay)
+	return b.body.Read(p)
+}
+
+func (b *slowBody) Close() error {
+	return b.body.Close()
+}
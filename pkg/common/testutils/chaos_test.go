@@ -0,0 +1,142 @@
+package testutils
+
+import (
+	"context"
+	"errors"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+const chaosTestBody = `{"events":[{"id":"1"},{"id":"2"}],"totalCount":2}`
+
+func newChaosTestClient(t *testing.T, scenario ChaosScenario) (*http.Client, *ChaosTransport, string) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(chaosTestBody))
+	}))
+	t.Cleanup(server.Close)
+	transport := NewChaosTransport(nil, scenario)
+	return &http.Client{Transport: transport}, transport, server.URL
+}
+
+func getChaosTestBody(client *http.Client, url string) (int, string, error) {
+	resp, err := client.Get(url)
+	if err != nil {
+		return 0, "", err
+	}
+	defer resp.Body.Close()
+	body, err := ioutil.ReadAll(resp.Body)
+	return resp.StatusCode, string(body), err
+}
+
+func TestChaosTransport_ServerErrorBurst(t *testing.T) {
+	client, transport, url := newChaosTestClient(t, ChaosScenario{Rules: []ChaosRule{
+		{PathSuffix: "/event", After: 1, Count: 2, Fault: ServerError},
+	}})
+
+	statusCodes := []int{}
+	for i := 0; i < 4; i++ {
+		statusCode, _, err := getChaosTestBody(client, url+"/event")
+		require.Nil(t, err)
+		statusCodes = append(statusCodes, statusCode)
+	}
+	require.Equal(t, []int{200, 503, 503, 200}, statusCodes)
+
+	// requests to other paths are not matched by the rule
+	statusCode, body, err := getChaosTestBody(client, url+"/project")
+	require.Nil(t, err)
+	require.Equal(t, http.StatusOK, statusCode)
+	require.Equal(t, chaosTestBody, body)
+	require.Equal(t, map[Fault]int{ServerError: 2}, transport.Injected())
+}
+
+func TestChaosTransport_ConnectionReset(t *testing.T) {
+	client, _, url := newChaosTestClient(t, ChaosScenario{Rules: []ChaosRule{{Method: http.MethodGet, Fault: ConnectionReset}}})
+
+	_, _, err := getChaosTestBody(client, url)
+	require.True(t, errors.Is(err, syscall.ECONNRESET))
+}
+
+func TestChaosTransport_TruncatedBody(t *testing.T) {
+	client, _, url := newChaosTestClient(t, ChaosScenario{Rules: []ChaosRule{{Fault: TruncatedBody, TruncateAt: 10}}})
+
+	statusCode, body, err := getChaosTestBody(client, url)
+	require.Equal(t, http.StatusOK, statusCode)
+	require.ErrorIs(t, err, io.ErrUnexpectedEOF)
+	require.Equal(t, chaosTestBody[:10], body)
+}
+
+func TestChaosTransport_SlowBody(t *testing.T) {
+	client, _, url := newChaosTestClient(t, ChaosScenario{Rules: []ChaosRule{{Fault: SlowBody, ChunkSize: 10, ChunkDelay: 5 * time.Millisecond}}})
+
+	start := time.Now()
+	_, body, err := getChaosTestBody(client, url)
+	require.Nil(t, err)
+	require.Equal(t, chaosTestBody, body)
+	// the body consists of 5 chunks of 10 bytes, plus the final read
+	require.GreaterOrEqual(t, time.Since(start), 25*time.Millisecond)
+}
+
+func TestChaosTransport_LatencyRespectsContext(t *testing.T) {
+	client, _, url := newChaosTestClient(t, ChaosScenario{Rules: []ChaosRule{{Latency: time.Minute}}})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	require.Nil(t, err)
+	_, err = client.Do(req)
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+}
+
+func TestChaosTransport_ProbabilityIsReproducible(t *testing.T) {
+	scenario := ChaosScenario{Seed: 42, Rules: []ChaosRule{{Probability: 0.5, Fault: ServerError, StatusCode: http.StatusBadGateway}}}
+	run := func() []int {
+		client, _, url := newChaosTestClient(t, scenario)
+		statusCodes := []int{}
+		for i := 0; i < 20; i++ {
+			statusCode, _, err := getChaosTestBody(client, url)
+			require.Nil(t, err)
+			statusCodes = append(statusCodes, statusCode)
+		}
+		return statusCodes
+	}
+
+	first := run()
+	require.Equal(t, first, run())
+	require.Contains(t, first, http.StatusOK)
+	require.Contains(t, first, http.StatusBadGateway)
+}
+
+type closeRecordingBody struct {
+	io.Reader
+	closed bool
+}
+
+func (b *closeRecordingBody) Close() error {
+	b.closed = true
+	return nil
+}
+
+func TestChaosTransport_ClosesBodyOfRequestsNotSent(t *testing.T) {
+	for _, fault := range []Fault{ConnectionReset, ServerError} {
+		t.Run(fault.String(), func(t *testing.T) {
+			transport := NewChaosTransport(nil, ChaosScenario{Rules: []ChaosRule{{Fault: fault}}})
+			body := &closeRecordingBody{Reader: strings.NewReader(`{"type":"sh.keptn.event"}`)}
+			req, err := http.NewRequest(http.MethodPost, "http://keptn.invalid/v1/event", body)
+			require.NoError(t, err)
+
+			resp, _ := transport.RoundTrip(req)
+			if resp != nil {
+				resp.Body.Close()
+			}
+			require.True(t, body.closed)
+		})
+	}
+}
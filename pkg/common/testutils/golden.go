@@ -0,0 +1,140 @@
+package testutils
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"regexp"
+	"testing"
+
+	"github.com/keptn/go-utils/pkg/common/diffutils"
+	"gopkg.in/yaml.v3"
+)
+
+// GoldenDir is the directory, relative to the package of the test, in which golden files are stored
+const GoldenDir = "testdata"
+
+const (
+	// MaskedTimestamp replaces timestamps in normalized golden files
+	MaskedTimestamp = "<timestamp>"
+	// MaskedUUID replaces UUIDs in normalized golden files
+	MaskedUUID = "<uuid>"
+)
+
+var (
+	timestampPattern = regexp.MustCompile(`\d{4}-\d{2}-\d{2}T\d{2}:\d{2}:\d{2}(\.\d+)?(Z|[+-]\d{2}:\d{2})`)
+	uuidPattern      = regexp.MustCompile(`(?i)[0-9a-f]{8}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{12}`)
+)
+
+// UpdateGoldenEnv is the environment variable which makes the golden file helpers write the golden files instead of
+// comparing against them if it is set to 1, e.g. UPDATE_GOLDEN=1 go test ./...
+const UpdateGoldenEnv = "UPDATE_GOLDEN"
+
+// updateGoldenFiles returns whether the golden files are to be written. It is evaluated on each call, so that it does not
+// depend on the initialization order of packages and no flag is registered that could clash with the flags of a test
+func updateGoldenFiles() bool {
+	return os.Getenv(UpdateGoldenEnv) == "1"
+}
+
+// NormalizeGolden masks timestamps and UUIDs in the given content, so that it can be compared with a golden file
+// although it contains values which change with every run of a test
+func NormalizeGolden(content []byte) []byte {
+	content = timestampPattern.ReplaceAll(content, []byte(MaskedTimestamp))
+	return uuidPattern.ReplaceAll(content, []byte(MaskedUUID))
+}
+
+// GoldenPath returns the path of the golden file with the given name
+func GoldenPath(name string) string {
+	return filepath.Join(GoldenDir, name)
+}
+
+// ReadGolden reads the golden file with the given name
+func ReadGolden(t testing.TB, name string) []byte {
+	t.Helper()
+	content, err := os.ReadFile(GoldenPath(name))
+	if err != nil {
+		t.Fatalf("unable to read golden file %s: %v. Run the test with UPDATE_GOLDEN=1 to create it", GoldenPath(name), err)
+	}
+	return content
+}
+
+// WriteGolden writes the golden file with the given name, creating the golden directory if needed
+func WriteGolden(t testing.TB, name string, content []byte) {
+	t.Helper()
+	if err := os.MkdirAll(filepath.Dir(GoldenPath(name)), 0755); err != nil {
+		t.Fatalf("unable to create directory for golden file %s: %v", GoldenPath(name), err)
+	}
+	if err := os.WriteFile(GoldenPath(name), content, 0644); err != nil {
+		t.Fatalf("unable to write golden file %s: %v", GoldenPath(name), err)
+	}
+}
+
+// AssertGoldenJSON asserts that the JSON representation of the given value equals the golden file with the given name,
+// e.g. handler_finished_event.json, after timestamps and UUIDs have been masked.
+// When the tests are run with UPDATE_GOLDEN=1, the golden file is written instead
+func AssertGoldenJSON(t testing.TB, name string, actual interface{}) {
+	t.Helper()
+	content, err := json.MarshalIndent(actual, "", "  ")
+	if err != nil {
+		t.Fatalf("unable to encode value as JSON: %v", err)
+	}
+	assertGolden(t, name, append(NormalizeGolden(content), '\n'), func(b []byte) (interface{}, error) {
+		var document interface{}
+		err := json.Unmarshal(b, &document)
+		return document, err
+	})
+}
+
+// AssertGoldenYAML asserts that the YAML representation of the given value equals the golden file with the given name,
+// after timestamps and UUIDs have been masked. The value is encoded using its JSON field names.
+// When the tests are run with UPDATE_GOLDEN=1, the golden file is written instead
+func AssertGoldenYAML(t testing.TB, name string, actual interface{}) {
+	t.Helper()
+	jsonContent, err := json.Marshal(actual)
+	if err != nil {
+		t.Fatalf("unable to encode value: %v", err)
+	}
+	var document interface{}
+	if err := json.Unmarshal(jsonContent, &document); err != nil {
+		t.Fatalf("unable to encode value: %v", err)
+	}
+	buf := &bytes.Buffer{}
+	encoder := yaml.NewEncoder(buf)
+	encoder.SetIndent(2)
+	if err := encoder.Encode(document); err != nil {
+		t.Fatalf("unable to encode value as YAML: %v", err)
+	}
+	assertGolden(t, name, NormalizeGolden(buf.Bytes()), func(b []byte) (interface{}, error) {
+		var document interface{}
+		err := yaml.Unmarshal(b, &document)
+		return document, err
+	})
+}
+
+func assertGolden(t testing.TB, name string, actual []byte, decode func([]byte) (interface{}, error)) {
+	t.Helper()
+	if updateGoldenFiles() {
+		WriteGolden(t, name, actual)
+		return
+	}
+	expected := ReadGolden(t, name)
+	if bytes.Equal(actual, expected) {
+		return
+	}
+
+	// report the differing paths if both documents can be decoded, otherwise report the whole content
+	actualDocument, actualErr := decode(actual)
+	expectedDocument, expectedErr := decode(NormalizeGolden(expected))
+	if actualErr == nil && expectedErr == nil {
+		if changes, err := diffutils.Diff(actualDocument, expectedDocument); err == nil {
+			if changes.Empty() {
+				// the documents only differ in formatting
+				return
+			}
+			t.Fatalf("value differs from golden file %s:\n%s\nRun the test with UPDATE_GOLDEN=1 to update the golden file", GoldenPath(name), changes)
+			return
+		}
+	}
+	t.Fatalf("value differs from golden file %s.\nexpected:\n%s\nactual:\n%s\nRun the test with UPDATE_GOLDEN=1 to update the golden file", GoldenPath(name), expected, actual)
+}
@@ -0,0 +1,129 @@
+package testutils
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+type goldenEvent struct {
+	ID      string            `json:"id"`
+	Time    string            `json:"time"`
+	Type    string            `json:"type"`
+	Labels  map[string]string `json:"labels,omitempty"`
+	Results []string          `json:"results,omitempty"`
+}
+
+// fatalRecorder records failures instead of stopping the test
+type fatalRecorder struct {
+	testing.TB
+	failures []string
+}
+
+func (r *fatalRecorder) Helper() {}
+
+func (r *fatalRecorder) Fatalf(format string, args ...interface{}) {
+	r.failures = append(r.failures, fmt.Sprintf(format, args...))
+}
+
+func chdirTemp(t *testing.T) string {
+	dir := t.TempDir()
+	wd, err := os.Getwd()
+	require.Nil(t, err)
+	require.Nil(t, os.Chdir(dir))
+	t.Cleanup(func() {
+		_ = os.Chdir(wd)
+	})
+	return dir
+}
+
+func setUpdateGoldenFiles(t *testing.T, update bool) {
+	value := ""
+	if update {
+		value = "1"
+	}
+	t.Setenv(UpdateGoldenEnv, value)
+}
+
+func TestNormalizeGolden(t *testing.T) {
+	content := []byte(`{"id":"6de83495-4f83-481c-8dbe-fcceb2e0243b","time":"2022-03-01T10:11:12.123456Z","other":"2022-03-01T10:11:12+01:00"}`)
+	require.Equal(t, `{"id":"<uuid>","time":"<timestamp>","other":"<timestamp>"}`, string(NormalizeGolden(content)))
+}
+
+func TestAssertGoldenJSON(t *testing.T) {
+	dir := chdirTemp(t)
+	event := goldenEvent{
+		ID:      "6de83495-4f83-481c-8dbe-fcceb2e0243b",
+		Time:    "2022-03-01T10:11:12.123Z",
+		Type:    "sh.keptn.event.test.finished",
+		Labels:  map[string]string{"owner": "team-a"},
+		Results: []string{"pass"},
+	}
+
+	setUpdateGoldenFiles(t, true)
+	AssertGoldenJSON(t, "event.json", event)
+	content, err := os.ReadFile(filepath.Join(dir, GoldenDir, "event.json"))
+	require.Nil(t, err)
+	require.Contains(t, string(content), `"id": "<uuid>"`)
+	require.Contains(t, string(content), `"time": "<timestamp>"`)
+
+	setUpdateGoldenFiles(t, false)
+	// a different ID and time still match the golden file
+	event.ID = "0f2b3c4d-1234-4abc-9def-0123456789ab"
+	event.Time = "2023-01-01T00:00:00Z"
+	AssertGoldenJSON(t, "event.json", event)
+
+	recorder := &fatalRecorder{TB: t}
+	event.Labels["owner"] = "team-b"
+	event.Results = append(event.Results, "warning")
+	AssertGoldenJSON(recorder, "event.json", event)
+	require.Len(t, recorder.failures, 1)
+	require.Contains(t, recorder.failures[0], `$.labels.owner: expected "team-a", got "team-b"`)
+	require.Contains(t, recorder.failures[0], `$.results[1]: unexpected "warning"`)
+}
+
+func TestAssertGoldenJSON_IgnoresFormatting(t *testing.T) {
+	chdirTemp(t)
+	WriteGolden(t, "event.json", []byte(`{"type":"sh.keptn.event.test.finished","id":"<uuid>","time":"<timestamp>"}`))
+
+	AssertGoldenJSON(t, "event.json", goldenEvent{
+		ID:   "6de83495-4f83-481c-8dbe-fcceb2e0243b",
+		Time: "2022-03-01T10:11:12Z",
+		Type: "sh.keptn.event.test.finished",
+	})
+}
+
+func TestAssertGoldenJSON_MissingGoldenFile(t *testing.T) {
+	chdirTemp(t)
+	recorder := &fatalRecorder{TB: t}
+
+	AssertGoldenJSON(recorder, "missing.json", goldenEvent{})
+	require.NotEmpty(t, recorder.failures)
+	require.Contains(t, recorder.failures[0], "UPDATE_GOLDEN=1")
+}
+
+func TestAssertGoldenYAML(t *testing.T) {
+	chdirTemp(t)
+	event := goldenEvent{
+		ID:     "6de83495-4f83-481c-8dbe-fcceb2e0243b",
+		Time:   "2022-03-01T10:11:12Z",
+		Type:   "sh.keptn.event.test.finished",
+		Labels: map[string]string{"owner": "team-a"},
+	}
+
+	setUpdateGoldenFiles(t, true)
+	AssertGoldenYAML(t, "event.yaml", event)
+	require.Equal(t, "id: <uuid>\nlabels:\n  owner: team-a\ntime: \"<timestamp>\"\ntype: sh.keptn.event.test.finished\n", string(ReadGolden(t, "event.yaml")))
+
+	setUpdateGoldenFiles(t, false)
+	AssertGoldenYAML(t, "event.yaml", event)
+
+	recorder := &fatalRecorder{TB: t}
+	event.Type = "sh.keptn.event.test.started"
+	AssertGoldenYAML(recorder, "event.yaml", event)
+	require.Len(t, recorder.failures, 1)
+	require.Contains(t, recorder.failures[0], `$.type: expected "sh.keptn.event.test.finished", got "sh.keptn.event.test.started"`)
+}
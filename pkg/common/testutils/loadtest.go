@@ -0,0 +1,113 @@
+package testutils
+
+import (
+	"context"
+	"fmt"
+	"runtime"
+	"sort"
+	"sync"
+	"time"
+)
+
+// LoadTestConfig configures the load generated by RunLoadTest
+type LoadTestConfig struct {
+	// Requests is the total number of times the operation is executed
+	Requests int
+	// Concurrency is the number of goroutines executing the operation in parallel
+	Concurrency int
+}
+
+// LoadTestResult contains the latency and allocation statistics of a load test
+type LoadTestResult struct {
+	Requests         int
+	Errors           int
+	Duration         time.Duration
+	P50              time.Duration
+	P90              time.Duration
+	P99              time.Duration
+	Max              time.Duration
+	AllocsPerRequest uint64
+	BytesPerRequest  uint64
+}
+
+// String returns a human readable summary of the load test result
+func (r LoadTestResult) String() string {
+	return fmt.Sprintf("requests=%d errors=%d duration=%s p50=%s p90=%s p99=%s max=%s allocs/req=%d bytes/req=%d",
+		r.Requests, r.Errors, r.Duration, r.P50, r.P90, r.P99, r.Max, r.AllocsPerRequest, r.BytesPerRequest)
+}
+
+// RunLoadTest executes the given operation as often as configured and reports latency
+// percentiles as well as allocation statistics. The allocation statistics are process-wide,
+// so other goroutines allocating memory while the load test is running distort the result.
+// If the context is cancelled, no further operations are started
+func RunLoadTest(ctx context.Context, config LoadTestConfig, operation func(ctx context.Context) error) LoadTestResult {
+	if config.Concurrency <= 0 {
+		config.Concurrency = 1
+	}
+
+	requests := make(chan struct{})
+	latencies := make([]time.Duration, 0, config.Requests)
+	errors := 0
+	mtx := sync.Mutex{}
+	wg := sync.WaitGroup{}
+
+	memStatsBefore := runtime.MemStats{}
+	runtime.ReadMemStats(&memStatsBefore)
+	start := time.Now()
+
+	for i := 0; i < config.Concurrency; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for range requests {
+				opStart := time.Now()
+				err := operation(ctx)
+				latency := time.Since(opStart)
+
+				mtx.Lock()
+				latencies = append(latencies, latency)
+				if err != nil {
+					errors++
+				}
+				mtx.Unlock()
+			}
+		}()
+	}
+
+	for i := 0; i < config.Requests && ctx.Err() == nil; i++ {
+		requests <- struct{}{}
+	}
+	close(requests)
+	wg.Wait()
+
+	duration := time.Since(start)
+	memStatsAfter := runtime.MemStats{}
+	runtime.ReadMemStats(&memStatsAfter)
+
+	result := LoadTestResult{
+		Requests: len(latencies),
+		Errors:   errors,
+		Duration: duration,
+	}
+	if len(latencies) == 0 {
+		return result
+	}
+
+	sort.Slice(latencies, func(i, j int) bool { return latencies[i] < latencies[j] })
+	result.P50 = percentile(latencies, 50)
+	result.P90 = percentile(latencies, 90)
+	result.P99 = percentile(latencies, 99)
+	result.Max = latencies[len(latencies)-1]
+	result.AllocsPerRequest = (memStatsAfter.Mallocs - memStatsBefore.Mallocs) / uint64(len(latencies))
+	result.BytesPerRequest = (memStatsAfter.TotalAlloc - memStatsBefore.TotalAlloc) / uint64(len(latencies))
+	return result
+}
+
+// percentile returns the p-th percentile of the given sorted latencies using the nearest-rank method
+func percentile(sortedLatencies []time.Duration, p int) time.Duration {
+	rank := (p*len(sortedLatencies) + 99) / 100
+	if rank < 1 {
+		rank = 1
+	}
+	return sortedLatencies[rank-1]
+}
@@ -0,0 +1,47 @@
+package testutils
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRunLoadTest(t *testing.T) {
+	var calls int32
+	result := RunLoadTest(context.TODO(), LoadTestConfig{Requests: 100, Concurrency: 4}, func(ctx context.Context) error {
+		n := atomic.AddInt32(&calls, 1)
+		if n%10 == 0 {
+			return errors.New("failed")
+		}
+		return nil
+	})
+
+	require.Equal(t, int32(100), calls)
+	require.Equal(t, 100, result.Requests)
+	require.Equal(t, 10, result.Errors)
+	require.LessOrEqual(t, result.P50, result.P90)
+	require.LessOrEqual(t, result.P90, result.P99)
+	require.LessOrEqual(t, result.P99, result.Max)
+}
+
+func TestRunLoadTest_Cancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.TODO())
+	cancel()
+	result := RunLoadTest(ctx, LoadTestConfig{Requests: 100}, func(ctx context.Context) error { return nil })
+	require.Equal(t, 0, result.Requests)
+}
+
+func Test_percentile(t *testing.T) {
+	latencies := []time.Duration{}
+	for i := 1; i <= 100; i++ {
+		latencies = append(latencies, time.Duration(i))
+	}
+	require.Equal(t, time.Duration(50), percentile(latencies, 50))
+	require.Equal(t, time.Duration(90), percentile(latencies, 90))
+	require.Equal(t, time.Duration(99), percentile(latencies, 99))
+	require.Equal(t, time.Duration(1), percentile(latencies[:1], 99))
+}
@@ -0,0 +1,170 @@
+package testutils
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/google/uuid"
+	"github.com/keptn/go-utils/pkg/api/models"
+)
+
+const defaultSimulatorPageSize = 20
+
+// APISimulator is a lightweight in-memory simulation of the Keptn API.
+// It serves the endpoints for sending and retrieving events as well as for retrieving projects
+// and can be used to exercise the API clients in tests and benchmarks without a running Keptn installation
+type APISimulator struct {
+	*httptest.Server
+	mtx      sync.RWMutex
+	events   []*models.KeptnContextExtendedCE
+	projects []*models.Project
+}
+
+// NewAPISimulator creates and starts a new APISimulator.
+// The simulator needs to be closed by the caller by calling Close()
+func NewAPISimulator() *APISimulator {
+	s := &APISimulator{
+		events:   []*models.KeptnContextExtendedCE{},
+		projects: []*models.Project{},
+	}
+	s.Server = httptest.NewServer(http.HandlerFunc(s.handle))
+	return s
+}
+
+// AddEvents adds the given events to the simulated datastore
+func (s *APISimulator) AddEvents(events ...*models.KeptnContextExtendedCE) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	s.events = append(s.events, events...)
+}
+
+// AddProjects adds the given projects to the simulated control plane
+func (s *APISimulator) AddProjects(projects ...*models.Project) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	s.projects = append(s.projects, projects...)
+}
+
+// Events returns all events known to the simulator
+func (s *APISimulator) Events() []*models.KeptnContextExtendedCE {
+	s.mtx.RLock()
+	defer s.mtx.RUnlock()
+	return append([]*models.KeptnContextExtendedCE{}, s.events...)
+}
+
+func (s *APISimulator) handle(w http.ResponseWriter, r *http.Request) {
+	switch {
+	case r.Method == http.MethodPost && strings.HasSuffix(r.URL.Path, "/v1/event"):
+		s.handleSendEvent(w, r)
+	case r.Method == http.MethodGet && strings.HasSuffix(r.URL.Path, "/event"):
+		s.handleGetEvents(w, r)
+	case r.Method == http.MethodGet && strings.HasSuffix(r.URL.Path, "/v1/project"):
+		s.handleGetProjects(w, r)
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+func (s *APISimulator) handleSendEvent(w http.ResponseWriter, r *http.Request) {
+	event := &models.KeptnContextExtendedCE{}
+	body, err := ioutil.ReadAll(r.Body)
+	if err != nil || event.FromJSON(body) != nil {
+		writeSimulatorError(w, http.StatusBadRequest, "could not decode event")
+		return
+	}
+	if event.Shkeptncontext == "" {
+		event.Shkeptncontext = uuid.New().String()
+	}
+	s.AddEvents(event)
+
+	eventContext := &models.EventContext{KeptnContext: &event.Shkeptncontext}
+	writeSimulatorResponse(w, eventContext)
+}
+
+func (s *APISimulator) handleGetEvents(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	s.mtx.RLock()
+	matching := []*models.KeptnContextExtendedCE{}
+	for _, e := range s.events {
+		if t := query.Get("type"); t != "" && (e.Type == nil || *e.Type != t) {
+			continue
+		}
+		if c := query.Get("keptnContext"); c != "" && e.Shkeptncontext != c {
+			continue
+		}
+		matching = append(matching, e)
+	}
+	s.mtx.RUnlock()
+
+	page, nextPageKey := paginate(len(matching), query.Get("pageSize"), query.Get("nextPageKey"))
+	writeSimulatorResponse(w, &models.Events{
+		Events:      matching[page.start:page.end],
+		NextPageKey: nextPageKey,
+		PageSize:    float64(page.end - page.start),
+		TotalCount:  float64(len(matching)),
+	})
+}
+
+func (s *APISimulator) handleGetProjects(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	s.mtx.RLock()
+	projects := s.projects
+	s.mtx.RUnlock()
+
+	page, nextPageKey := paginate(len(projects), query.Get("pageSize"), query.Get("nextPageKey"))
+	writeSimulatorResponse(w, &models.Projects{
+		Projects:    projects[page.start:page.end],
+		NextPageKey: nextPageKey,
+		PageSize:    float64(page.end - page.start),
+		TotalCount:  float64(len(projects)),
+	})
+}
+
+type pageBounds struct {
+	start int
+	end   int
+}
+
+// paginate determines the bounds of the requested page as well as the key of the next page.
+// The key of a page is the index of its first element
+func paginate(total int, pageSizeParam string, nextPageKeyParam string) (pageBounds, string) {
+	pageSize, err := strconv.Atoi(pageSizeParam)
+	if err != nil || pageSize <= 0 {
+		pageSize = defaultSimulatorPageSize
+	}
+	start, err := strconv.Atoi(nextPageKeyParam)
+	if err != nil || start < 0 || start > total {
+		start = 0
+	}
+	end := start + pageSize
+	if end >= total {
+		return pageBounds{start: start, end: total}, ""
+	}
+	return pageBounds{start: start, end: end}, strconv.Itoa(end)
+}
+
+type jsonModel interface {
+	ToJSON() ([]byte, error)
+}
+
+func writeSimulatorResponse(w http.ResponseWriter, response jsonModel) {
+	body, err := response.ToJSON()
+	if err != nil {
+		writeSimulatorError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write(body)
+}
+
+func writeSimulatorError(w http.ResponseWriter, code int, message string) {
+	body, _ := (&models.Error{Code: int64(code), Message: &message}).ToJSON()
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	_, _ = w.Write(body)
+}
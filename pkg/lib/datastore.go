@@ -106,7 +106,7 @@ func getLatestEvent(keptnContext string, eventType string, uri string, datastore
 			// find latest event
 			var latest *models.KeptnContextExtendedCE
 			for _, event := range response.Events {
-				if latest == nil || latest.Time.Before(event.Time) {
+				if latest == nil || latest.OrderKey().Less(event.OrderKey()) {
 					latest = event
 				}
 			}
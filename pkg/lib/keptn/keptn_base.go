@@ -4,7 +4,6 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"log"
 	"math/rand"
 	"net/url"
 	"os"
@@ -185,17 +184,16 @@ func getKeptnResourceFromLocal(resource string) ([]byte, error) {
 	}
 }
 
+// keptnEntityNameRegex matches valid project, service, and stage names
+var keptnEntityNameRegex = regexp.MustCompile(`(^[a-z][a-z0-9-]*[a-z0-9]$)|(^[a-z][a-z0-9]*)`)
+
 // ValidateKeptnEntityName checks whether the provided name represents a valid
 // project, service, or stage name
 func ValidateKeptnEntityName(name string) bool {
 	if len(name) == 0 {
 		return false
 	}
-	reg, err := regexp.Compile(`(^[a-z][a-z0-9-]*[a-z0-9]$)|(^[a-z][a-z0-9]*)`)
-	if err != nil {
-		log.Fatal(err)
-	}
-	processedString := reg.FindString(name)
+	processedString := keptnEntityNameRegex.FindString(name)
 	return len(processedString) == len(name)
 }
 
@@ -26,9 +26,25 @@ type ActionInfo struct {
 // ActionStartedEventData contains information about an action.started event
 type ActionStartedEventData struct {
 	EventData
+	// Action describes the action which has been started. It is optional
+	Action *ActionExecution `json:"action,omitempty"`
 }
 
 // ActionFinishedEventData contains information about the execution of an action
 type ActionFinishedEventData struct {
 	EventData
+	// Action describes the action which has been executed. It is optional
+	Action *ActionExecution `json:"action,omitempty"`
+}
+
+// ActionExecution describes the execution of a remediation action
+type ActionExecution struct {
+	// ActionIndex is the index of the action in the list of actions of the remediation
+	ActionIndex int `json:"actionIndex"`
+	// Name is the name of the action
+	Name string `json:"name,omitempty"`
+	// Action is the type of the action
+	Action string `json:"action,omitempty"`
+	// ErrorDetails contains the error which made the action fail, if any
+	ErrorDetails string `json:"errorDetails,omitempty"`
 }
@@ -0,0 +1,248 @@
+package v0_2_0
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/keptn/go-utils/pkg/api/models"
+	"github.com/keptn/go-utils/pkg/common/strutils"
+)
+
+// CDEventsSpecVersion is the version of the CDEvents specification supported by the mappers
+const CDEventsSpecVersion = "0.1.0"
+
+const cdEventsTypePrefix = "dev.cdevents."
+
+// CDEvent types supported by the mappers
+const (
+	CDEventServiceDeployed     = cdEventsTypePrefix + "service.deployed." + CDEventsSpecVersion
+	CDEventTestCaseRunQueued   = cdEventsTypePrefix + "testcaserun.queued." + CDEventsSpecVersion
+	CDEventTestCaseRunStarted  = cdEventsTypePrefix + "testcaserun.started." + CDEventsSpecVersion
+	CDEventTestCaseRunFinished = cdEventsTypePrefix + "testcaserun.finished." + CDEventsSpecVersion
+	CDEventArtifactPublished   = cdEventsTypePrefix + "artifact.published." + CDEventsSpecVersion
+)
+
+const cdEventOutcomePass = "pass"
+const cdEventOutcomeFail = "fail"
+const defaultCDEventDeliverySequence = "delivery"
+
+// CDEvent is an event following the CDEvents specification
+type CDEvent struct {
+	Context CDEventContext `json:"context"`
+	Subject CDEventSubject `json:"subject"`
+}
+
+// CDEventContext contains the context attributes of a CDEvent
+type CDEventContext struct {
+	Version   string    `json:"version"`
+	ID        string    `json:"id"`
+	Source    string    `json:"source"`
+	Type      string    `json:"type"`
+	Timestamp time.Time `json:"timestamp"`
+}
+
+// CDEventSubject contains the subject of a CDEvent, e.g. the deployed service or the test case run
+type CDEventSubject struct {
+	ID      string                 `json:"id"`
+	Source  string                 `json:"source,omitempty"`
+	Type    string                 `json:"type"`
+	Content map[string]interface{} `json:"content"`
+}
+
+// CDEventConversionOptions provide the Keptn specific information that is not contained in a CDEvent
+type CDEventConversionOptions struct {
+	// Project is the Keptn project the resulting event belongs to
+	Project string
+	// Stage is the Keptn stage the resulting event belongs to. If empty, the environment of the CDEvent is used
+	Stage string
+	// Service is the Keptn service the resulting event belongs to. If empty, the subject of a service event is used
+	Service string
+	// Sequence is the sequence triggered by artifact events (default: delivery)
+	Sequence string
+	// KeptnContext is the Keptn context of the resulting event
+	KeptnContext string
+}
+
+// ToCDEvent converts a Keptn event into a CDEvent. The following mappings are supported:
+//   - deployment.finished -> service.deployed
+//   - test.triggered -> testcaserun.queued
+//   - test.started -> testcaserun.started
+//   - test.finished -> testcaserun.finished
+//   - <stage>.<sequence>.triggered containing an image -> artifact.published
+func ToCDEvent(keptnEvent models.KeptnContextExtendedCE) (*CDEvent, error) {
+	if keptnEvent.Type == nil {
+		return nil, fmt.Errorf("event type must be set")
+	}
+	eventType := *keptnEvent.Type
+	eventData := EventData{}
+	if err := keptnEvent.DataAs(&eventData); err != nil {
+		return nil, fmt.Errorf("could not decode event data: %w", err)
+	}
+
+	cdEvent := &CDEvent{
+		Context: CDEventContext{
+			Version:   CDEventsSpecVersion,
+			ID:        keptnEvent.ID,
+			Source:    sourceOf(keptnEvent),
+			Timestamp: keptnEvent.Time,
+		},
+		Subject: CDEventSubject{
+			Source:  eventData.Project,
+			Content: map[string]interface{}{"environment": map[string]interface{}{"id": eventData.Stage}},
+		},
+	}
+
+	testCaseRunID := keptnEvent.Triggeredid
+	if testCaseRunID == "" {
+		testCaseRunID = keptnEvent.ID
+	}
+
+	switch eventType {
+	case GetFinishedEventType(DeploymentTaskName):
+		cdEvent.Context.Type = CDEventServiceDeployed
+		cdEvent.Subject.Type = "service"
+		cdEvent.Subject.ID = eventData.Service
+	case GetTriggeredEventType(TestTaskName):
+		cdEvent.Context.Type = CDEventTestCaseRunQueued
+		cdEvent.Subject.Type = "testCaseRun"
+		cdEvent.Subject.ID = keptnEvent.ID
+	case GetStartedEventType(TestTaskName):
+		cdEvent.Context.Type = CDEventTestCaseRunStarted
+		cdEvent.Subject.Type = "testCaseRun"
+		cdEvent.Subject.ID = testCaseRunID
+	case GetFinishedEventType(TestTaskName):
+		cdEvent.Context.Type = CDEventTestCaseRunFinished
+		cdEvent.Subject.Type = "testCaseRun"
+		cdEvent.Subject.ID = testCaseRunID
+		cdEvent.Subject.Content["outcome"] = cdEventOutcomePass
+		if eventData.Result == ResultFailed || eventData.Status == StatusErrored {
+			cdEvent.Subject.Content["outcome"] = cdEventOutcomeFail
+		}
+	default:
+		if !IsSequenceEventType(eventType) || !IsTriggeredEventType(eventType) {
+			return nil, fmt.Errorf("event type %s can not be converted to a CDEvent", eventType)
+		}
+		image, err := imageOf(keptnEvent)
+		if err != nil {
+			return nil, err
+		}
+		cdEvent.Context.Type = CDEventArtifactPublished
+		cdEvent.Subject.Type = "artifact"
+		cdEvent.Subject.ID = image
+		cdEvent.Subject.Content = map[string]interface{}{}
+	}
+	return cdEvent, nil
+}
+
+// FromCDEvent converts a CDEvent into a Keptn event. The following mappings are supported:
+//   - service.deployed -> deployment.finished
+//   - testcaserun.queued -> test.triggered
+//   - testcaserun.started -> test.started
+//   - testcaserun.finished -> test.finished
+//   - artifact.published -> <stage>.<sequence>.triggered containing the artifact as image
+func FromCDEvent(cdEvent CDEvent, opts CDEventConversionOptions) (models.KeptnContextExtendedCE, error) {
+	eventData := EventData{
+		Project: opts.Project,
+		Stage:   opts.Stage,
+		Service: opts.Service,
+	}
+	if eventData.Stage == "" {
+		eventData.Stage = environmentOf(cdEvent.Subject)
+	}
+
+	var eventType string
+	var payload interface{} = eventData
+	triggeredID := ""
+
+	switch cdEvent.Context.Type {
+	case CDEventServiceDeployed:
+		if eventData.Service == "" {
+			eventData.Service = cdEvent.Subject.ID
+		}
+		eventType = GetFinishedEventType(DeploymentTaskName)
+		eventData.Status = StatusSucceeded
+		eventData.Result = ResultPass
+		payload = DeploymentFinishedEventData{EventData: eventData}
+	case CDEventTestCaseRunQueued:
+		eventType = GetTriggeredEventType(TestTaskName)
+		payload = TestTriggeredEventData{EventData: eventData}
+	case CDEventTestCaseRunStarted:
+		eventType = GetStartedEventType(TestTaskName)
+		triggeredID = cdEvent.Subject.ID
+	case CDEventTestCaseRunFinished:
+		eventType = GetFinishedEventType(TestTaskName)
+		triggeredID = cdEvent.Subject.ID
+		eventData.Status = StatusSucceeded
+		eventData.Result = ResultPass
+		if outcome, _ := cdEvent.Subject.Content["outcome"].(string); outcome != "" && outcome != cdEventOutcomePass {
+			eventData.Result = ResultFailed
+		}
+		payload = TestFinishedEventData{EventData: eventData}
+	case CDEventArtifactPublished:
+		sequence := opts.Sequence
+		if sequence == "" {
+			sequence = defaultCDEventDeliverySequence
+		}
+		if eventData.Stage == "" {
+			return models.KeptnContextExtendedCE{}, fmt.Errorf("a stage is required to convert %s events", cdEvent.Context.Type)
+		}
+		eventType = GetTriggeredEventType(eventData.Stage + "." + sequence)
+		payload = DeploymentTriggeredEventData{
+			EventData:           eventData,
+			ConfigurationChange: ConfigurationChange{Values: map[string]interface{}{"image": cdEvent.Subject.ID}},
+		}
+	default:
+		return models.KeptnContextExtendedCE{}, fmt.Errorf("CDEvent type %s can not be converted to a Keptn event", cdEvent.Context.Type)
+	}
+
+	id := cdEvent.Context.ID
+	if id == "" {
+		id = uuid.NewString()
+	}
+	eventTime := cdEvent.Context.Timestamp
+	if eventTime.IsZero() {
+		eventTime = time.Now().UTC()
+	}
+
+	return models.KeptnContextExtendedCE{
+		Contenttype:    "application/json",
+		Data:           payload,
+		ID:             id,
+		Shkeptncontext: opts.KeptnContext,
+		Source:         strutils.Stringp(cdEvent.Context.Source),
+		Specversion:    defaultSpecVersion,
+		Time:           eventTime,
+		Triggeredid:    triggeredID,
+		Type:           strutils.Stringp(eventType),
+	}, nil
+}
+
+func sourceOf(keptnEvent models.KeptnContextExtendedCE) string {
+	if keptnEvent.Source == nil {
+		return ""
+	}
+	return *keptnEvent.Source
+}
+
+func environmentOf(subject CDEventSubject) string {
+	environment, ok := subject.Content["environment"].(map[string]interface{})
+	if !ok {
+		return ""
+	}
+	id, _ := environment["id"].(string)
+	return id
+}
+
+func imageOf(keptnEvent models.KeptnContextExtendedCE) (string, error) {
+	data := DeploymentTriggeredEventData{}
+	if err := keptnEvent.DataAs(&data); err != nil {
+		return "", fmt.Errorf("could not decode event data: %w", err)
+	}
+	image, _ := data.ConfigurationChange.Values["image"].(string)
+	if strings.TrimSpace(image) == "" {
+		return "", fmt.Errorf("event of type %s does not contain an image and can not be converted to a CDEvent", *keptnEvent.Type)
+	}
+	return image, nil
+}
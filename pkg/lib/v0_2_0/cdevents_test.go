@@ -0,0 +1,143 @@
+package v0_2_0
+
+import (
+	"testing"
+	"time"
+
+	"github.com/keptn/go-utils/pkg/api/models"
+	"github.com/keptn/go-utils/pkg/common/strutils"
+	"github.com/stretchr/testify/require"
+)
+
+func TestToCDEvent(t *testing.T) {
+	eventTime := time.Date(2022, 6, 1, 10, 0, 0, 0, time.UTC)
+	newEvent := func(eventType string, data interface{}) models.KeptnContextExtendedCE {
+		return models.KeptnContextExtendedCE{
+			ID:          "event-id",
+			Triggeredid: "triggered-id",
+			Source:      strutils.Stringp("my-service"),
+			Time:        eventTime,
+			Type:        strutils.Stringp(eventType),
+			Data:        data,
+		}
+	}
+	eventData := EventData{Project: "sockshop", Stage: "dev", Service: "carts"}
+
+	t.Run("deployment.finished", func(t *testing.T) {
+		cdEvent, err := ToCDEvent(newEvent(GetFinishedEventType(DeploymentTaskName), DeploymentFinishedEventData{EventData: eventData}))
+		require.Nil(t, err)
+		require.Equal(t, &CDEvent{
+			Context: CDEventContext{Version: CDEventsSpecVersion, ID: "event-id", Source: "my-service", Type: CDEventServiceDeployed, Timestamp: eventTime},
+			Subject: CDEventSubject{ID: "carts", Source: "sockshop", Type: "service", Content: map[string]interface{}{"environment": map[string]interface{}{"id": "dev"}}},
+		}, cdEvent)
+	})
+	t.Run("test.finished with failed result", func(t *testing.T) {
+		data := eventData
+		data.Result = ResultFailed
+		cdEvent, err := ToCDEvent(newEvent(GetFinishedEventType(TestTaskName), TestFinishedEventData{EventData: data}))
+		require.Nil(t, err)
+		require.Equal(t, CDEventTestCaseRunFinished, cdEvent.Context.Type)
+		require.Equal(t, "triggered-id", cdEvent.Subject.ID)
+		require.Equal(t, "fail", cdEvent.Subject.Content["outcome"])
+	})
+	t.Run("test.triggered", func(t *testing.T) {
+		cdEvent, err := ToCDEvent(newEvent(GetTriggeredEventType(TestTaskName), TestTriggeredEventData{EventData: eventData}))
+		require.Nil(t, err)
+		require.Equal(t, CDEventTestCaseRunQueued, cdEvent.Context.Type)
+		require.Equal(t, "event-id", cdEvent.Subject.ID)
+	})
+	t.Run("sequence.triggered with image", func(t *testing.T) {
+		cdEvent, err := ToCDEvent(newEvent("sh.keptn.event.dev.delivery.triggered", DeploymentTriggeredEventData{
+			EventData:           eventData,
+			ConfigurationChange: ConfigurationChange{Values: map[string]interface{}{"image": "carts:0.13.1"}},
+		}))
+		require.Nil(t, err)
+		require.Equal(t, CDEventArtifactPublished, cdEvent.Context.Type)
+		require.Equal(t, "carts:0.13.1", cdEvent.Subject.ID)
+	})
+	t.Run("sequence.triggered without image", func(t *testing.T) {
+		_, err := ToCDEvent(newEvent("sh.keptn.event.dev.delivery.triggered", eventData))
+		require.NotNil(t, err)
+	})
+	t.Run("unsupported event type", func(t *testing.T) {
+		_, err := ToCDEvent(newEvent(GetTriggeredEventType(EvaluationTaskName), eventData))
+		require.NotNil(t, err)
+	})
+}
+
+func TestFromCDEvent(t *testing.T) {
+	eventTime := time.Date(2022, 6, 1, 10, 0, 0, 0, time.UTC)
+	opts := CDEventConversionOptions{Project: "sockshop", KeptnContext: "keptn-context"}
+
+	t.Run("service.deployed", func(t *testing.T) {
+		keptnEvent, err := FromCDEvent(CDEvent{
+			Context: CDEventContext{Version: CDEventsSpecVersion, ID: "id", Source: "tekton", Type: CDEventServiceDeployed, Timestamp: eventTime},
+			Subject: CDEventSubject{ID: "carts", Type: "service", Content: map[string]interface{}{"environment": map[string]interface{}{"id": "dev"}}},
+		}, opts)
+		require.Nil(t, err)
+		require.Equal(t, GetFinishedEventType(DeploymentTaskName), *keptnEvent.Type)
+		require.Equal(t, "keptn-context", keptnEvent.Shkeptncontext)
+		require.Equal(t, eventTime, keptnEvent.Time)
+
+		data := DeploymentFinishedEventData{}
+		require.Nil(t, keptnEvent.DataAs(&data))
+		require.Equal(t, EventData{Project: "sockshop", Stage: "dev", Service: "carts", Status: StatusSucceeded, Result: ResultPass}, data.EventData)
+	})
+	t.Run("testcaserun.finished with failed outcome", func(t *testing.T) {
+		keptnEvent, err := FromCDEvent(CDEvent{
+			Context: CDEventContext{Type: CDEventTestCaseRunFinished},
+			Subject: CDEventSubject{ID: "triggered-id", Type: "testCaseRun", Content: map[string]interface{}{"outcome": "fail"}},
+		}, CDEventConversionOptions{Project: "sockshop", Stage: "dev", Service: "carts"})
+		require.Nil(t, err)
+		require.Equal(t, GetFinishedEventType(TestTaskName), *keptnEvent.Type)
+		require.Equal(t, "triggered-id", keptnEvent.Triggeredid)
+		require.NotEmpty(t, keptnEvent.ID)
+
+		data := TestFinishedEventData{}
+		require.Nil(t, keptnEvent.DataAs(&data))
+		require.Equal(t, ResultFailed, data.Result)
+	})
+	t.Run("artifact.published", func(t *testing.T) {
+		keptnEvent, err := FromCDEvent(CDEvent{
+			Context: CDEventContext{Type: CDEventArtifactPublished},
+			Subject: CDEventSubject{ID: "carts:0.13.1", Type: "artifact"},
+		}, CDEventConversionOptions{Project: "sockshop", Stage: "dev", Service: "carts"})
+		require.Nil(t, err)
+		require.Equal(t, "sh.keptn.event.dev.delivery.triggered", *keptnEvent.Type)
+
+		data := DeploymentTriggeredEventData{}
+		require.Nil(t, keptnEvent.DataAs(&data))
+		require.Equal(t, "carts:0.13.1", data.ConfigurationChange.Values["image"])
+	})
+	t.Run("artifact.published without stage", func(t *testing.T) {
+		_, err := FromCDEvent(CDEvent{Context: CDEventContext{Type: CDEventArtifactPublished}}, opts)
+		require.NotNil(t, err)
+	})
+	t.Run("unsupported type", func(t *testing.T) {
+		_, err := FromCDEvent(CDEvent{Context: CDEventContext{Type: "dev.cdevents.pipelinerun.started.0.1.0"}}, opts)
+		require.NotNil(t, err)
+	})
+}
+
+func TestCDEventRoundTrip(t *testing.T) {
+	keptnEvent := models.KeptnContextExtendedCE{
+		ID:     "event-id",
+		Source: strutils.Stringp("my-service"),
+		Time:   time.Date(2022, 6, 1, 10, 0, 0, 0, time.UTC),
+		Type:   strutils.Stringp(GetFinishedEventType(DeploymentTaskName)),
+		Data:   DeploymentFinishedEventData{EventData: EventData{Project: "sockshop", Stage: "dev", Service: "carts"}},
+	}
+	cdEvent, err := ToCDEvent(keptnEvent)
+	require.Nil(t, err)
+
+	converted, err := FromCDEvent(*cdEvent, CDEventConversionOptions{Project: "sockshop"})
+	require.Nil(t, err)
+	require.Equal(t, keptnEvent.ID, converted.ID)
+	require.Equal(t, *keptnEvent.Type, *converted.Type)
+	require.Equal(t, *keptnEvent.Source, *converted.Source)
+
+	data := EventData{}
+	require.Nil(t, converted.DataAs(&data))
+	require.Equal(t, "carts", data.Service)
+	require.Equal(t, "dev", data.Stage)
+}
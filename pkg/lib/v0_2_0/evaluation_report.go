@@ -0,0 +1,144 @@
+package v0_2_0
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/keptn/go-utils/pkg/api/models"
+)
+
+// EvaluationRecord is a single indicator result of an evaluation in a flat, tabular form
+type EvaluationRecord struct {
+	KeptnContext     string
+	Project          string
+	Stage            string
+	Service          string
+	Time             time.Time
+	EvaluationResult string
+	EvaluationScore  float64
+	Indicator        string
+	DisplayName      string
+	Value            float64
+	ComparedValue    float64
+	Success          bool
+	Message          string
+	PassTargets      string
+	WarningTargets   string
+	KeySLI           bool
+	Status           string
+	Score            float64
+}
+
+// evaluationCSVHeader contains the column names written by WriteEvaluationCSV
+var evaluationCSVHeader = []string{
+	"keptnContext", "project", "stage", "service", "time",
+	"evaluationResult", "evaluationScore",
+	"indicator", "displayName", "value", "comparedValue", "success", "message",
+	"passTargets", "warningTargets", "keySli", "status", "score",
+}
+
+// FlattenEvaluationDetails returns one EvaluationRecord per indicator result of the given evaluation.
+// Only the evaluation specific fields of the records are set
+func FlattenEvaluationDetails(details EvaluationDetails) []EvaluationRecord {
+	records := make([]EvaluationRecord, 0, len(details.IndicatorResults))
+	for _, result := range details.IndicatorResults {
+		if result == nil {
+			continue
+		}
+		record := EvaluationRecord{
+			EvaluationResult: details.Result,
+			EvaluationScore:  details.Score,
+			DisplayName:      result.DisplayName,
+			PassTargets:      formatSLITargets(result.PassTargets),
+			WarningTargets:   formatSLITargets(result.WarningTargets),
+			KeySLI:           result.KeySLI,
+			Status:           result.Status,
+			Score:            result.Score,
+		}
+		if result.Value != nil {
+			record.Indicator = result.Value.Metric
+			record.Value = result.Value.Value
+			record.ComparedValue = result.Value.ComparedValue
+			record.Success = result.Value.Success
+			record.Message = result.Value.Message
+		}
+		records = append(records, record)
+	}
+	return records
+}
+
+// FlattenEvaluationEvents returns the indicator results of all evaluation.finished events contained in events
+// as EvaluationRecords. Events of other types are ignored
+func FlattenEvaluationEvents(events []*models.KeptnContextExtendedCE) ([]EvaluationRecord, error) {
+	records := []EvaluationRecord{}
+	for _, event := range events {
+		if event == nil || event.Type == nil || *event.Type != GetFinishedEventType(EvaluationTaskName) {
+			continue
+		}
+		data := EvaluationFinishedEventData{}
+		if err := EventDataAs(*event, &data); err != nil {
+			return nil, fmt.Errorf("could not decode evaluation of event %s: %w", event.ID, err)
+		}
+		for _, record := range FlattenEvaluationDetails(data.Evaluation) {
+			record.KeptnContext = event.Shkeptncontext
+			record.Project = data.Project
+			record.Stage = data.Stage
+			record.Service = data.Service
+			record.Time = event.Time
+			records = append(records, record)
+		}
+	}
+	return records, nil
+}
+
+// WriteEvaluationCSV writes the given records including a header row as CSV to w
+func WriteEvaluationCSV(w io.Writer, records []EvaluationRecord) error {
+	writer := csv.NewWriter(w)
+	if err := writer.Write(evaluationCSVHeader); err != nil {
+		return err
+	}
+	for _, record := range records {
+		if err := writer.Write(record.csvRow()); err != nil {
+			return err
+		}
+	}
+	writer.Flush()
+	return writer.Error()
+}
+
+func (r EvaluationRecord) csvRow() []string {
+	timestamp := ""
+	if !r.Time.IsZero() {
+		timestamp = r.Time.UTC().Format(time.RFC3339)
+	}
+	return []string{
+		r.KeptnContext, r.Project, r.Stage, r.Service, timestamp,
+		r.EvaluationResult, formatFloat(r.EvaluationScore),
+		r.Indicator, r.DisplayName, formatFloat(r.Value), formatFloat(r.ComparedValue), strconv.FormatBool(r.Success), r.Message,
+		r.PassTargets, r.WarningTargets, strconv.FormatBool(r.KeySLI), r.Status, formatFloat(r.Score),
+	}
+}
+
+// formatSLITargets joins the criteria of the given targets, marking violated ones with a trailing "!"
+func formatSLITargets(targets []*SLITarget) string {
+	criteria := make([]string, 0, len(targets))
+	for _, target := range targets {
+		if target == nil {
+			continue
+		}
+		c := target.Criteria
+		if target.Violated {
+			c += "!"
+		}
+		criteria = append(criteria, c)
+	}
+	return strings.Join(criteria, ";")
+}
+
+func formatFloat(f float64) string {
+	return strconv.FormatFloat(f, 'f', -1, 64)
+}
@@ -0,0 +1,133 @@
+package v0_2_0
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/keptn/go-utils/pkg/api/models"
+	"github.com/keptn/go-utils/pkg/common/strutils"
+	"github.com/stretchr/testify/require"
+)
+
+func testEvaluationDetails() EvaluationDetails {
+	return EvaluationDetails{
+		Result: "warning",
+		Score:  75,
+		IndicatorResults: []*SLIEvaluationResult{
+			{
+				Score:       1,
+				Value:       &SLIResult{Metric: "response_time_p95", Value: 212.5, ComparedValue: 200, Success: true},
+				DisplayName: "Response time P95",
+				PassTargets: []*SLITarget{
+					{Criteria: "<=+10%", TargetValue: 220},
+					{Criteria: "<600", TargetValue: 600},
+				},
+				WarningTargets: []*SLITarget{{Criteria: "<=800", TargetValue: 800}},
+				KeySLI:         true,
+				Status:         "pass",
+			},
+			{
+				Score:          0.5,
+				Value:          &SLIResult{Metric: "error_rate", Value: 0.03, Success: true, Message: "close to limit"},
+				PassTargets:    []*SLITarget{{Criteria: "<0.02", TargetValue: 0.02, Violated: true}},
+				WarningTargets: []*SLITarget{{Criteria: "<0.05", TargetValue: 0.05}},
+				Status:         "warning",
+			},
+			nil,
+		},
+	}
+}
+
+func TestFlattenEvaluationDetails(t *testing.T) {
+	records := FlattenEvaluationDetails(testEvaluationDetails())
+
+	require.Equal(t, []EvaluationRecord{
+		{
+			EvaluationResult: "warning",
+			EvaluationScore:  75,
+			Indicator:        "response_time_p95",
+			DisplayName:      "Response time P95",
+			Value:            212.5,
+			ComparedValue:    200,
+			Success:          true,
+			PassTargets:      "<=+10%;<600",
+			WarningTargets:   "<=800",
+			KeySLI:           true,
+			Status:           "pass",
+			Score:            1,
+		},
+		{
+			EvaluationResult: "warning",
+			EvaluationScore:  75,
+			Indicator:        "error_rate",
+			Value:            0.03,
+			Success:          true,
+			Message:          "close to limit",
+			PassTargets:      "<0.02!",
+			WarningTargets:   "<0.05",
+			Status:           "warning",
+			Score:            0.5,
+		},
+	}, records)
+}
+
+func TestFlattenEvaluationEvents(t *testing.T) {
+	eventTime := time.Date(2022, 6, 1, 10, 0, 0, 0, time.UTC)
+	events := []*models.KeptnContextExtendedCE{
+		{
+			Shkeptncontext: "ctx-1",
+			Time:           eventTime,
+			Type:           strutils.Stringp(GetFinishedEventType(EvaluationTaskName)),
+			Data: EvaluationFinishedEventData{
+				EventData:  EventData{Project: "sockshop", Stage: "hardening", Service: "carts"},
+				Evaluation: testEvaluationDetails(),
+			},
+		},
+		{
+			Shkeptncontext: "ctx-1",
+			Type:           strutils.Stringp(GetTriggeredEventType(EvaluationTaskName)),
+			Data:           EventData{Project: "sockshop"},
+		},
+		nil,
+	}
+
+	records, err := FlattenEvaluationEvents(events)
+	require.Nil(t, err)
+	require.Len(t, records, 2)
+	for _, record := range records {
+		require.Equal(t, "ctx-1", record.KeptnContext)
+		require.Equal(t, "sockshop", record.Project)
+		require.Equal(t, "hardening", record.Stage)
+		require.Equal(t, "carts", record.Service)
+		require.Equal(t, eventTime, record.Time)
+	}
+	require.Equal(t, "error_rate", records[1].Indicator)
+}
+
+func TestFlattenEvaluationEvents_InvalidData(t *testing.T) {
+	events := []*models.KeptnContextExtendedCE{
+		{
+			Type: strutils.Stringp(GetFinishedEventType(EvaluationTaskName)),
+			Data: map[string]interface{}{"evaluation": "invalid"},
+		},
+	}
+
+	records, err := FlattenEvaluationEvents(events)
+	require.NotNil(t, err)
+	require.Nil(t, records)
+}
+
+func TestWriteEvaluationCSV(t *testing.T) {
+	records := FlattenEvaluationDetails(testEvaluationDetails())
+	records[0].Project = "sockshop"
+	records[0].Time = time.Date(2022, 6, 1, 10, 0, 0, 0, time.UTC)
+
+	buf := &bytes.Buffer{}
+	require.Nil(t, WriteEvaluationCSV(buf, records))
+
+	expected := "keptnContext,project,stage,service,time,evaluationResult,evaluationScore,indicator,displayName,value,comparedValue,success,message,passTargets,warningTargets,keySli,status,score\n" +
+		",sockshop,,,2022-06-01T10:00:00Z,warning,75,response_time_p95,Response time P95,212.5,200,true,,<=+10%;<600,<=800,true,pass,1\n" +
+		",,,,,warning,75,error_rate,,0.03,0,true,close to limit,<0.02!,<0.05,false,warning,0.5\n"
+	require.Equal(t, expected, buf.String())
+}
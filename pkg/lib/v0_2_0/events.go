@@ -30,11 +30,11 @@ const DefaultHTTPEventEndpoint = "http://localhost:8081/event"
 const defaultSpecVersion = "1.0"
 
 const keptnEventTypePrefix = "sh.keptn.event."
-const keptnTriggeredEventSuffix = ".triggered"
-const keptnStartedEventSuffix = ".started"
-const keptnStatusChangedEventSuffix = ".status.changed"
-const keptnFinishedEventSuffix = ".finished"
-const keptnInvalidatedEventSuffix = ".invalidated"
+const keptnTriggeredEventSuffix = "." + TriggeredEventKind
+const keptnStartedEventSuffix = "." + StartedEventKind
+const keptnStatusChangedEventSuffix = "." + StatusChangedEventKind
+const keptnFinishedEventSuffix = "." + FinishedEventKind
+const keptnInvalidatedEventSuffix = "." + InvalidatedEventKind
 
 const keptnContextCEExtension = "shkeptncontext"
 const keptnSpecVersionCEExtension = "shkeptnspecversion"
@@ -381,6 +381,7 @@ type KeptnEventBuilder struct {
 
 // Build creates a value of KeptnContextExtendedCE from the current builder
 // It also does basic validation like the presence of project, service and stage in the event data
+// and checks that the source is a valid URI-reference
 func (eb *KeptnEventBuilder) Build() (models.KeptnContextExtendedCE, error) {
 	commonEventData := EventData{}
 	if err := eb.DataAs(&commonEventData); err != nil {
@@ -389,6 +390,12 @@ func (eb *KeptnEventBuilder) Build() (models.KeptnContextExtendedCE, error) {
 	if commonEventData.Project == "" || commonEventData.Service == "" || commonEventData.Stage == "" {
 		return eb.KeptnContextExtendedCE, fmt.Errorf("cannot create keptn cloud event as it does not contain project, service and stage information")
 	}
+	if eb.Source == nil {
+		return eb.KeptnContextExtendedCE, fmt.Errorf("cannot create keptn cloud event as it does not contain a source")
+	}
+	if err := models.ValidateEventSource(*eb.Source); err != nil {
+		return eb.KeptnContextExtendedCE, fmt.Errorf("cannot create keptn cloud event: %w", err)
+	}
 
 	return eb.KeptnContextExtendedCE, nil
 }
@@ -417,6 +424,12 @@ func (eb *KeptnEventBuilder) WithGitCommitID(gitCommitID string) *KeptnEventBuil
 	return eb
 }
 
+// WithSource can be used to override the source the builder was created with
+func (eb *KeptnEventBuilder) WithSource(source string) *KeptnEventBuilder {
+	eb.Source = strutils.Stringp(source)
+	return eb
+}
+
 // WithID can be used to override the ID, which is auto generated by default
 func (eb *KeptnEventBuilder) WithID(id string) *KeptnEventBuilder {
 	eb.ID = id
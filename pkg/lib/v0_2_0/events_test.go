@@ -261,6 +261,24 @@ func TestCreateKeptnEvent_MissingInformation(t *testing.T) {
 		assert.NotNil(t, err)
 	})
 }
+func TestCreateKeptnEvent_Source(t *testing.T) {
+	testData := EventData{
+		Project: "my-project",
+		Stage:   "my-stage",
+		Service: "my-service",
+	}
+
+	event, err := KeptnEvent("sh.keptn.event.dev.delivery.triggered", "source", testData).WithSource("my-service").Build()
+	require.Nil(t, err)
+	require.Equal(t, strutils.Stringp("my-service"), event.Source)
+
+	_, err = KeptnEvent("sh.keptn.event.dev.delivery.triggered", "", testData).Build()
+	require.NotNil(t, err)
+
+	_, err = KeptnEvent("sh.keptn.event.dev.delivery.triggered", "my service", testData).Build()
+	require.NotNil(t, err)
+}
+
 func TestCreateSimpleKeptnEvent(t *testing.T) {
 
 	type TestData struct {
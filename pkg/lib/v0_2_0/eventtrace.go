@@ -0,0 +1,288 @@
+package v0_2_0
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/keptn/go-utils/pkg/api/models"
+)
+
+// EventTraceNode is a sequence or a task of an EventTrace
+type EventTraceNode struct {
+	// Name is the name of the sequence, e.g. delivery, or of the task, e.g. deployment
+	Name  string
+	Stage string
+	// Sequence is true if the node is a sequence. The children of a sequence are its tasks
+	Sequence bool
+	// TriggeredID is the ID of the triggered event of the sequence or task
+	TriggeredID string
+	// Triggered is the time of the triggered event
+	Triggered time.Time
+	// Finished is the time of the last finished event. It is zero if the sequence or task has not been finished yet
+	Finished time.Time
+	// Status and Result are taken from the finished events. If several finished events have been sent for a task,
+	// e.g. by multiple integrations, a failed or errored one takes precedence
+	Status   StatusType
+	Result   ResultType
+	Message  string
+	Children []*EventTraceNode
+}
+
+// Duration returns the time between the triggered event and the last finished event,
+// or 0 if the sequence or task has not been finished yet
+func (n *EventTraceNode) Duration() time.Duration {
+	if n.Finished.IsZero() {
+		return 0
+	}
+	return n.Finished.Sub(n.Triggered)
+}
+
+// Failed returns whether the sequence or task finished with result fail or status errored
+func (n *EventTraceNode) Failed() bool {
+	return n.Result.IsFailed() || n.Status.IsErrored()
+}
+
+// EventTrace is the tree of the sequences and tasks executed within a Keptn context
+type EventTrace struct {
+	KeptnContext string
+	// Nodes are the sequences in the order in which they have been triggered.
+	// Tasks which cannot be assigned to a sequence are contained as well
+	Nodes []*EventTraceNode
+}
+
+// NewEventTrace builds the EventTrace of the given events of a Keptn context.
+// A task is assigned to the most recently triggered sequence of its stage, and the finished events
+// are assigned to the sequences and tasks via their triggeredid. Events of other kinds are ignored
+func NewEventTrace(events []*models.KeptnContextExtendedCE) *EventTrace {
+	sorted := make([]*models.KeptnContextExtendedCE, 0, len(events))
+	for _, event := range events {
+		if event != nil && event.Type != nil {
+			sorted = append(sorted, event)
+		}
+	}
+	models.SortEvents(sorted)
+
+	trace := &EventTrace{}
+	nodes := map[string]*EventTraceNode{}
+	currentSequences := map[string]*EventTraceNode{}
+	var lastSequence *EventTraceNode
+	for _, event := range sorted {
+		if trace.KeptnContext == "" {
+			trace.KeptnContext = event.Shkeptncontext
+		}
+		if !IsTriggeredEventType(*event.Type) {
+			continue
+		}
+		data := EventData{}
+		_ = EventDataAs(*event, &data)
+		if stage, sequence, _, err := ParseSequenceEventType(*event.Type); err == nil {
+			node := &EventTraceNode{Name: sequence, Stage: stage, Sequence: true, TriggeredID: event.ID, Triggered: event.Time}
+			nodes[event.ID] = node
+			currentSequences[stage] = node
+			lastSequence = node
+			trace.Nodes = append(trace.Nodes, node)
+			continue
+		}
+		task, _, err := ParseTaskEventType(*event.Type)
+		if err != nil {
+			continue
+		}
+		node := &EventTraceNode{Name: task, Stage: data.Stage, TriggeredID: event.ID, Triggered: event.Time}
+		nodes[event.ID] = node
+		parent := currentSequences[data.Stage]
+		if data.Stage == "" {
+			parent = lastSequence
+		}
+		if parent != nil {
+			parent.Children = append(parent.Children, node)
+		} else {
+			trace.Nodes = append(trace.Nodes, node)
+		}
+	}
+
+	for _, event := range sorted {
+		node := nodes[event.Triggeredid]
+		if node == nil || !IsFinishedEventType(*event.Type) {
+			continue
+		}
+		data := EventData{}
+		_ = EventDataAs(*event, &data)
+		if event.Time.After(node.Finished) {
+			node.Finished = event.Time
+		}
+		if node.Failed() {
+			continue
+		}
+		node.Status = data.Status
+		node.Result = data.Result
+		if data.Message != "" {
+			node.Message = data.Message
+		}
+	}
+	return trace
+}
+
+// WriteDOT writes the trace as Graphviz DOT graph to w. Each stage is rendered as a cluster,
+// failed sequences and tasks are highlighted and sequences and tasks which have not been finished are dashed
+func (t *EventTrace) WriteDOT(w io.Writer) error {
+	g := t.graph()
+	b := &strings.Builder{}
+	fmt.Fprintf(b, "digraph %s {\n", dotQuote(t.KeptnContext))
+	b.WriteString("  rankdir=LR;\n")
+	b.WriteString("  node [shape=box];\n")
+	for i, stage := range g.stages {
+		indent := "  "
+		if stage != "" {
+			fmt.Fprintf(b, "  subgraph %s {\n", dotQuote(fmt.Sprintf("cluster_%d", i)))
+			fmt.Fprintf(b, "    label=%s;\n", dotQuote(stage))
+			indent = "    "
+		}
+		for _, node := range g.nodesByStage[stage] {
+			attributes := []string{"label=" + dotQuote(strings.Join(traceLabel(node), "\n"))}
+			if node.Sequence {
+				attributes = append(attributes, "shape=box3d")
+			}
+			if node.Failed() {
+				attributes = append(attributes, "color=red", "style=filled", `fillcolor="#f8d7da"`)
+			} else if node.Finished.IsZero() {
+				attributes = append(attributes, "style=dashed")
+			}
+			fmt.Fprintf(b, "%s%s [%s];\n", indent, g.ids[node], strings.Join(attributes, ", "))
+		}
+		if stage != "" {
+			b.WriteString("  }\n")
+		}
+	}
+	for _, e := range g.edges {
+		if e.promotion {
+			fmt.Fprintf(b, "  %s -> %s [style=dashed];\n", g.ids[e.from], g.ids[e.to])
+		} else {
+			fmt.Fprintf(b, "  %s -> %s;\n", g.ids[e.from], g.ids[e.to])
+		}
+	}
+	b.WriteString("}\n")
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+// WriteMermaid writes the trace as Mermaid flowchart to w. Each stage is rendered as a subgraph,
+// failed sequences and tasks are highlighted and sequences and tasks which have not been finished are dashed
+func (t *EventTrace) WriteMermaid(w io.Writer) error {
+	g := t.graph()
+	b := &strings.Builder{}
+	b.WriteString("flowchart LR\n")
+	var failed, unfinished []string
+	for i, stage := range g.stages {
+		indent := "  "
+		if stage != "" {
+			fmt.Fprintf(b, "  subgraph stage%d [%s]\n", i, mermaidQuote(stage))
+			indent = "    "
+		}
+		for _, node := range g.nodesByStage[stage] {
+			label := mermaidQuote(strings.Join(traceLabel(node), "<br/>"))
+			if node.Sequence {
+				fmt.Fprintf(b, "%s%s[[%s]]\n", indent, g.ids[node], label)
+			} else {
+				fmt.Fprintf(b, "%s%s[%s]\n", indent, g.ids[node], label)
+			}
+			if node.Failed() {
+				failed = append(failed, g.ids[node])
+			} else if node.Finished.IsZero() {
+				unfinished = append(unfinished, g.ids[node])
+			}
+		}
+		if stage != "" {
+			b.WriteString("  end\n")
+		}
+	}
+	for _, e := range g.edges {
+		if e.promotion {
+			fmt.Fprintf(b, "  %s -.-> %s\n", g.ids[e.from], g.ids[e.to])
+		} else {
+			fmt.Fprintf(b, "  %s --> %s\n", g.ids[e.from], g.ids[e.to])
+		}
+	}
+	if len(failed) > 0 {
+		b.WriteString("  classDef failed fill:#f8d7da,stroke:#cc0000\n")
+		fmt.Fprintf(b, "  class %s failed\n", strings.Join(failed, ","))
+	}
+	if len(unfinished) > 0 {
+		b.WriteString("  classDef unfinished stroke-dasharray:5 5\n")
+		fmt.Fprintf(b, "  class %s unfinished\n", strings.Join(unfinished, ","))
+	}
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+// traceGraph is the layout of an EventTrace shared by the DOT and Mermaid renderings
+type traceGraph struct {
+	// stages are the stages in the order of their first sequence or task. Nodes without stage are grouped under ""
+	stages       []string
+	nodesByStage map[string][]*EventTraceNode
+	ids          map[*EventTraceNode]string
+	edges        []traceEdge
+}
+
+// traceEdge connects a sequence with its first task and a task with the next task of the sequence.
+// Promotion edges connect consecutive sequences
+type traceEdge struct {
+	from, to  *EventTraceNode
+	promotion bool
+}
+
+func (t *EventTrace) graph() traceGraph {
+	g := traceGraph{nodesByStage: map[string][]*EventTraceNode{}, ids: map[*EventTraceNode]string{}}
+	add := func(node *EventTraceNode) {
+		if _, ok := g.nodesByStage[node.Stage]; !ok {
+			g.stages = append(g.stages, node.Stage)
+		}
+		g.nodesByStage[node.Stage] = append(g.nodesByStage[node.Stage], node)
+		g.ids[node] = fmt.Sprintf("n%d", len(g.ids))
+	}
+	var previousSequence *EventTraceNode
+	for _, node := range t.Nodes {
+		add(node)
+		if node.Sequence {
+			if previousSequence != nil {
+				g.edges = append(g.edges, traceEdge{from: previousSequence, to: node, promotion: true})
+			}
+			previousSequence = node
+		}
+		previous := node
+		for _, task := range node.Children {
+			add(task)
+			g.edges = append(g.edges, traceEdge{from: previous, to: task})
+			previous = task
+		}
+	}
+	return g
+}
+
+// traceLabel returns the lines of the label of the node: its name, its result or status and its duration
+func traceLabel(node *EventTraceNode) []string {
+	lines := []string{node.Name}
+	switch {
+	case node.Status.IsErrored():
+		lines = append(lines, string(node.Status))
+	case node.Result != "":
+		lines = append(lines, string(node.Result))
+	}
+	if node.Finished.IsZero() {
+		lines = append(lines, "not finished")
+	} else {
+		lines = append(lines, node.Duration().Round(time.Millisecond).String())
+	}
+	return lines
+}
+
+func dotQuote(s string) string {
+	s = strings.ReplaceAll(s, `\`, `\\`)
+	s = strings.ReplaceAll(s, `"`, `\"`)
+	return `"` + strings.ReplaceAll(s, "\n", `\n`) + `"`
+}
+
+func mermaidQuote(s string) string {
+	return `"` + strings.ReplaceAll(s, `"`, "#quot;") + `"`
+}
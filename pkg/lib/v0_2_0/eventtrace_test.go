@@ -0,0 +1,134 @@
+package v0_2_0
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/keptn/go-utils/pkg/api/models"
+	"github.com/keptn/go-utils/pkg/common/strutils"
+	"github.com/stretchr/testify/require"
+)
+
+func testTraceEvents() []*models.KeptnContextExtendedCE {
+	t0 := time.Date(2022, 6, 1, 10, 0, 0, 0, time.UTC)
+	event := func(id string, eventType string, triggeredID string, offset time.Duration, data map[string]interface{}) *models.KeptnContextExtendedCE {
+		return &models.KeptnContextExtendedCE{
+			ID:             id,
+			Shkeptncontext: "ctx-1",
+			Triggeredid:    triggeredID,
+			Time:           t0.Add(offset),
+			Type:           strutils.Stringp(eventType),
+			Data:           data,
+		}
+	}
+	// the events are not in chronological order on purpose
+	return []*models.KeptnContextExtendedCE{
+		event("seq-2", "sh.keptn.event.hardening.delivery.triggered", "", 100*time.Second, map[string]interface{}{"stage": "hardening"}),
+		event("seq-1", "sh.keptn.event.dev.delivery.triggered", "", 0, map[string]interface{}{"stage": "dev"}),
+		event("task-1", "sh.keptn.event.deployment.triggered", "", time.Second, map[string]interface{}{"stage": "dev"}),
+		event("task-1-started", "sh.keptn.event.deployment.started", "task-1", 2*time.Second, map[string]interface{}{"stage": "dev"}),
+		event("task-1-finished-a", "sh.keptn.event.deployment.finished", "task-1", 21*time.Second, map[string]interface{}{"stage": "dev", "status": "succeeded", "result": "pass"}),
+		event("task-1-finished-b", "sh.keptn.event.deployment.finished", "task-1", 31*time.Second, map[string]interface{}{"stage": "dev", "status": "succeeded", "result": "pass"}),
+		event("task-2", "sh.keptn.event.evaluation.triggered", "", 32*time.Second, map[string]interface{}{"stage": "dev"}),
+		event("task-2-finished", "sh.keptn.event.evaluation.finished", "task-2", 92*time.Second, map[string]interface{}{"stage": "dev", "status": "succeeded", "result": "fail", "message": "score too low"}),
+		event("seq-1-finished", "sh.keptn.event.dev.delivery.finished", "seq-1", 93*time.Second, map[string]interface{}{"stage": "dev", "status": "succeeded", "result": "fail"}),
+		event("task-3", "sh.keptn.event.deployment.triggered", "", 101*time.Second, map[string]interface{}{"stage": "hardening"}),
+		nil,
+	}
+}
+
+func TestNewEventTrace(t *testing.T) {
+	trace := NewEventTrace(testTraceEvents())
+
+	require.Equal(t, "ctx-1", trace.KeptnContext)
+	require.Len(t, trace.Nodes, 2)
+
+	dev := trace.Nodes[0]
+	require.Equal(t, "delivery", dev.Name)
+	require.Equal(t, "dev", dev.Stage)
+	require.True(t, dev.Sequence)
+	require.True(t, dev.Failed())
+	require.Equal(t, 93*time.Second, dev.Duration())
+	require.Len(t, dev.Children, 2)
+
+	deployment := dev.Children[0]
+	require.Equal(t, "deployment", deployment.Name)
+	require.Equal(t, "task-1", deployment.TriggeredID)
+	require.False(t, deployment.Sequence)
+	require.False(t, deployment.Failed())
+	require.Equal(t, ResultPass, deployment.Result)
+	require.Equal(t, 30*time.Second, deployment.Duration())
+
+	evaluation := dev.Children[1]
+	require.True(t, evaluation.Failed())
+	require.Equal(t, "score too low", evaluation.Message)
+
+	hardening := trace.Nodes[1]
+	require.Equal(t, "hardening", hardening.Stage)
+	require.Equal(t, time.Duration(0), hardening.Duration())
+	require.Len(t, hardening.Children, 1)
+	require.True(t, hardening.Children[0].Finished.IsZero())
+}
+
+func TestNewEventTrace_TaskWithoutSequence(t *testing.T) {
+	trace := NewEventTrace([]*models.KeptnContextExtendedCE{
+		{ID: "task-1", Shkeptncontext: "ctx-1", Type: strutils.Stringp("sh.keptn.event.deployment.triggered"), Data: map[string]interface{}{"stage": "dev"}},
+		{ID: "task-1-finished", Shkeptncontext: "ctx-1", Triggeredid: "task-1", Type: strutils.Stringp("sh.keptn.event.deployment.finished"), Data: map[string]interface{}{"status": "errored"}},
+	})
+
+	require.Len(t, trace.Nodes, 1)
+	require.Equal(t, "deployment", trace.Nodes[0].Name)
+	require.True(t, trace.Nodes[0].Failed())
+}
+
+func TestEventTrace_WriteDOT(t *testing.T) {
+	buf := &bytes.Buffer{}
+	require.Nil(t, NewEventTrace(testTraceEvents()).WriteDOT(buf))
+
+	require.Equal(t, `digraph "ctx-1" {
+  rankdir=LR;
+  node [shape=box];
+  subgraph "cluster_0" {
+    label="dev";
+    n0 [label="delivery\nfail\n1m33s", shape=box3d, color=red, style=filled, fillcolor="#f8d7da"];
+    n1 [label="deployment\npass\n30s"];
+    n2 [label="evaluation\nfail\n1m0s", color=red, style=filled, fillcolor="#f8d7da"];
+  }
+  subgraph "cluster_1" {
+    label="hardening";
+    n3 [label="delivery\nnot finished", shape=box3d, style=dashed];
+    n4 [label="deployment\nnot finished", style=dashed];
+  }
+  n0 -> n1;
+  n1 -> n2;
+  n0 -> n3 [style=dashed];
+  n3 -> n4;
+}
+`, buf.String())
+}
+
+func TestEventTrace_WriteMermaid(t *testing.T) {
+	buf := &bytes.Buffer{}
+	require.Nil(t, NewEventTrace(testTraceEvents()).WriteMermaid(buf))
+
+	require.Equal(t, `flowchart LR
+  subgraph stage0 ["dev"]
+    n0[["delivery<br/>fail<br/>1m33s"]]
+    n1["deployment<br/>pass<br/>30s"]
+    n2["evaluation<br/>fail<br/>1m0s"]
+  end
+  subgraph stage1 ["hardening"]
+    n3[["delivery<br/>not finished"]]
+    n4["deployment<br/>not finished"]
+  end
+  n0 --> n1
+  n1 --> n2
+  n0 -.-> n3
+  n3 --> n4
+  classDef failed fill:#f8d7da,stroke:#cc0000
+  class n0,n2 failed
+  classDef unfinished stroke-dasharray:5 5
+  class n3,n4 unfinished
+`, buf.String())
+}
@@ -0,0 +1,79 @@
+package v0_2_0
+
+import (
+	"fmt"
+	"strings"
+)
+
+// The kinds of Keptn events, i.e. the last element(s) of an event type like "sh.keptn.event.deployment.triggered"
+const (
+	TriggeredEventKind     = "triggered"
+	StartedEventKind       = "started"
+	StatusChangedEventKind = "status.changed"
+	FinishedEventKind      = "finished"
+	InvalidatedEventKind   = "invalidated"
+)
+
+// EventTypeWildcard matches exactly one element of an event type, e.g. "sh.keptn.event.*.triggered"
+const EventTypeWildcard = "*"
+
+// EventTypeMultiWildcard matches all remaining elements of an event type, e.g. "sh.keptn.event.>".
+// It can only be used as the last element
+const EventTypeMultiWildcard = ">"
+
+// GetEventType returns the event type for the given task and kind, e.g. "sh.keptn.event.deployment.triggered"
+// for the task "deployment" and the kind TriggeredEventKind. Wildcards can be used for both the task and the kind
+func GetEventType(task, kind string) string {
+	return keptnEventTypePrefix + task + "." + kind
+}
+
+// ParseEventType splits the given event type into the task and the kind of the event,
+// e.g. "sh.keptn.event.deployment.status.changed" into "deployment" and "status.changed".
+// For sequence event types the task contains the stage and the sequence name, e.g. "dev.delivery".
+// The event type may contain wildcards. An event type ending with EventTypeMultiWildcard
+// is parsed into the task preceding it and EventTypeMultiWildcard as kind,
+// or EventTypeMultiWildcard as task and an empty kind if there is no task
+func ParseEventType(eventType string) (string, string, error) {
+	if !strings.HasPrefix(eventType, keptnEventTypePrefix) {
+		return "", "", fmt.Errorf("%s is not a valid keptn event type: missing prefix %s", eventType, keptnEventTypePrefix)
+	}
+	rest := strings.TrimPrefix(eventType, keptnEventTypePrefix)
+	if rest == EventTypeMultiWildcard {
+		return EventTypeMultiWildcard, "", nil
+	}
+
+	var task, kind string
+	if strings.HasSuffix(rest, "."+StatusChangedEventKind) {
+		task, kind = strings.TrimSuffix(rest, "."+StatusChangedEventKind), StatusChangedEventKind
+	} else if i := strings.LastIndex(rest, "."); i >= 0 {
+		task, kind = rest[:i], rest[i+1:]
+	}
+	if task == "" || kind == "" {
+		return "", "", fmt.Errorf("%s is not a valid keptn event type: missing task or kind", eventType)
+	}
+	for _, element := range strings.Split(task, ".") {
+		if element == "" || strings.Contains(element, EventTypeMultiWildcard) {
+			return "", "", fmt.Errorf("%s is not a valid keptn event type: invalid task %q", eventType, task)
+		}
+	}
+	return task, kind, nil
+}
+
+// MatchEventType checks whether the given event type matches the pattern, which may contain
+// EventTypeWildcard and EventTypeMultiWildcard elements like e.g. "sh.keptn.event.*.triggered" or "sh.keptn.event.>"
+func MatchEventType(pattern, eventType string) bool {
+	patternElements := strings.Split(pattern, ".")
+	eventTypeElements := strings.Split(eventType, ".")
+	for i, element := range patternElements {
+		if element == EventTypeMultiWildcard && i == len(patternElements)-1 {
+			return len(eventTypeElements) > i
+		}
+		if i >= len(eventTypeElements) {
+			return false
+		}
+		if element != EventTypeWildcard && element != eventTypeElements[i] {
+			return false
+		}
+	}
+	return len(patternElements) == len(eventTypeElements)
+}
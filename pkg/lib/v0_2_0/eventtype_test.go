@@ -0,0 +1,71 @@
+package v0_2_0
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetEventType(t *testing.T) {
+	require.Equal(t, GetTriggeredEventType("deployment"), GetEventType("deployment", TriggeredEventKind))
+	require.Equal(t, GetStatusChangedEventType("deployment"), GetEventType("deployment", StatusChangedEventKind))
+	require.Equal(t, "sh.keptn.event.*.finished", GetEventType(EventTypeWildcard, FinishedEventKind))
+}
+
+func TestParseEventType(t *testing.T) {
+	tests := []struct {
+		eventType string
+		wantTask  string
+		wantKind  string
+		wantErr   bool
+	}{
+		{eventType: "sh.keptn.event.deployment.triggered", wantTask: "deployment", wantKind: TriggeredEventKind},
+		{eventType: "sh.keptn.event.deployment.status.changed", wantTask: "deployment", wantKind: StatusChangedEventKind},
+		{eventType: "sh.keptn.event.dev.delivery.finished", wantTask: "dev.delivery", wantKind: FinishedEventKind},
+		{eventType: "sh.keptn.event.*.triggered", wantTask: "*", wantKind: TriggeredEventKind},
+		{eventType: "sh.keptn.event.evaluation.*", wantTask: "evaluation", wantKind: "*"},
+		{eventType: "sh.keptn.event.evaluation.>", wantTask: "evaluation", wantKind: ">"},
+		{eventType: "sh.keptn.event.>", wantTask: ">"},
+		{eventType: "sh.keptn.event.deployment", wantErr: true},
+		{eventType: "sh.keptn.event..triggered", wantErr: true},
+		{eventType: "sh.keptn.event.>.triggered", wantErr: true},
+		{eventType: "sh.keptn.event.deployment.", wantErr: true},
+		{eventType: "sh.keptn.events.problem", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.eventType, func(t *testing.T) {
+			task, kind, err := ParseEventType(tt.eventType)
+			if tt.wantErr {
+				require.NotNil(t, err)
+				return
+			}
+			require.Nil(t, err)
+			require.Equal(t, tt.wantTask, task)
+			require.Equal(t, tt.wantKind, kind)
+		})
+	}
+}
+
+func TestMatchEventType(t *testing.T) {
+	tests := []struct {
+		pattern   string
+		eventType string
+		want      bool
+	}{
+		{pattern: "sh.keptn.event.deployment.triggered", eventType: "sh.keptn.event.deployment.triggered", want: true},
+		{pattern: "sh.keptn.event.deployment.triggered", eventType: "sh.keptn.event.deployment.finished", want: false},
+		{pattern: "sh.keptn.event.*.triggered", eventType: "sh.keptn.event.test.triggered", want: true},
+		{pattern: "sh.keptn.event.*.triggered", eventType: "sh.keptn.event.dev.delivery.triggered", want: false},
+		{pattern: "sh.keptn.event.deployment.*", eventType: "sh.keptn.event.deployment.started", want: true},
+		{pattern: "sh.keptn.event.>", eventType: "sh.keptn.event.dev.delivery.triggered", want: true},
+		{pattern: "sh.keptn.event.>", eventType: "sh.keptn.event", want: false},
+		{pattern: "sh.keptn.>", eventType: "sh.keptn.log.error", want: true},
+		{pattern: "sh.keptn.event.>.triggered", eventType: "sh.keptn.event.test.triggered", want: false},
+		{pattern: "sh.keptn.event.test", eventType: "sh.keptn.event.test.triggered", want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.pattern+"/"+tt.eventType, func(t *testing.T) {
+			require.Equal(t, tt.want, MatchEventType(tt.pattern, tt.eventType))
+		})
+	}
+}
@@ -0,0 +1,247 @@
+package fixtures
+
+import (
+	"encoding/json"
+
+	"github.com/keptn/go-utils/pkg/api/models"
+	keptn "github.com/keptn/go-utils/pkg/lib"
+	keptnv2 "github.com/keptn/go-utils/pkg/lib/v0_2_0"
+)
+
+// DeploymentTriggeredData returns the payload of DeploymentTriggered
+func DeploymentTriggeredData() keptnv2.DeploymentTriggeredEventData {
+	return keptnv2.DeploymentTriggeredEventData{
+		EventData: eventData(),
+		ConfigurationChange: keptnv2.ConfigurationChange{
+			Values: map[string]interface{}{"image": "docker.io/keptnexamples/carts:0.13.1"},
+		},
+		Deployment: keptnv2.DeploymentTriggeredData{
+			DeploymentURIsLocal: []string{"http://carts.sockshop-dev:80"},
+			DeploymentStrategy:  "direct",
+		},
+	}
+}
+
+// DeploymentTriggered returns a deployment.triggered event
+func DeploymentTriggered(opts ...Option) models.KeptnContextExtendedCE {
+	return newEvent(keptnv2.GetTriggeredEventType(keptnv2.DeploymentTaskName), DeploymentTriggeredData(), opts)
+}
+
+// DeploymentStarted returns a deployment.started event
+func DeploymentStarted(opts ...Option) models.KeptnContextExtendedCE {
+	data := keptnv2.DeploymentStartedEventData{EventData: eventData()}
+	data.Status = keptnv2.StatusSucceeded
+	return newEvent(keptnv2.GetStartedEventType(keptnv2.DeploymentTaskName), data, opts)
+}
+
+// DeploymentFinishedData returns the payload of DeploymentFinished
+func DeploymentFinishedData() keptnv2.DeploymentFinishedEventData {
+	return keptnv2.DeploymentFinishedEventData{
+		EventData: finishedEventData(keptnv2.ResultPass),
+		Deployment: keptnv2.DeploymentFinishedData{
+			DeploymentStrategy:   "direct",
+			DeploymentURIsLocal:  []string{"http://carts.sockshop-dev:80"},
+			DeploymentURIsPublic: []string{"http://carts.sockshop-dev.127.0.0.1.nip.io"},
+			DeploymentNames:      []string{"carts"},
+		},
+	}
+}
+
+// DeploymentFinished returns a successful deployment.finished event
+func DeploymentFinished(opts ...Option) models.KeptnContextExtendedCE {
+	return newEvent(keptnv2.GetFinishedEventType(keptnv2.DeploymentTaskName), DeploymentFinishedData(), opts)
+}
+
+// TestTriggeredData returns the payload of TestTriggered
+func TestTriggeredData() keptnv2.TestTriggeredEventData {
+	return keptnv2.TestTriggeredEventData{
+		EventData: eventData(),
+		Test:      keptnv2.TestTriggeredDetails{TestStrategy: "performance"},
+		Deployment: keptnv2.TestTriggeredDeploymentDetails{
+			DeploymentURIsLocal: []string{"http://carts.sockshop-dev:80"},
+		},
+	}
+}
+
+// TestTriggered returns a test.triggered event
+func TestTriggered(opts ...Option) models.KeptnContextExtendedCE {
+	return newEvent(keptnv2.GetTriggeredEventType(keptnv2.TestTaskName), TestTriggeredData(), opts)
+}
+
+// TestFinishedData returns the payload of TestFinished
+func TestFinishedData() keptnv2.TestFinishedEventData {
+	return keptnv2.TestFinishedEventData{
+		EventData: finishedEventData(keptnv2.ResultPass),
+		Test:      keptnv2.TestFinishedDetails{Start: Start, End: End},
+	}
+}
+
+// TestFinished returns a successful test.finished event
+func TestFinished(opts ...Option) models.KeptnContextExtendedCE {
+	return newEvent(keptnv2.GetFinishedEventType(keptnv2.TestTaskName), TestFinishedData(), opts)
+}
+
+// EvaluationTriggeredData returns the payload of EvaluationTriggered
+func EvaluationTriggeredData() keptnv2.EvaluationTriggeredEventData {
+	return keptnv2.EvaluationTriggeredEventData{
+		EventData:  eventData(),
+		Test:       keptnv2.Test{Start: Start, End: End},
+		Evaluation: keptnv2.Evaluation{Start: Start, End: End},
+		Deployment: keptnv2.Deployment{DeploymentNames: []string{"carts"}},
+	}
+}
+
+// EvaluationTriggered returns an evaluation.triggered event
+func EvaluationTriggered(opts ...Option) models.KeptnContextExtendedCE {
+	return newEvent(keptnv2.GetTriggeredEventType(keptnv2.EvaluationTaskName), EvaluationTriggeredData(), opts)
+}
+
+// EvaluationFinishedData returns the payload of EvaluationFinished
+func EvaluationFinishedData() keptnv2.EvaluationFinishedEventData {
+	return keptnv2.EvaluationFinishedEventData{
+		EventData: finishedEventData(keptnv2.ResultPass),
+		Evaluation: keptnv2.EvaluationDetails{
+			TimeStart: Start,
+			TimeEnd:   End,
+			Result:    string(keptnv2.ResultPass),
+			Score:     100,
+			IndicatorResults: []*keptnv2.SLIEvaluationResult{
+				{
+					Score:       1,
+					Value:       &keptnv2.SLIResult{Metric: "response_time_p95", Value: 212.5, Success: true},
+					DisplayName: "Response time P95",
+					PassTargets: []*keptnv2.SLITarget{{Criteria: "<=+10%", TargetValue: 233.75, Violated: false}},
+					Status:      string(keptnv2.ResultPass),
+				},
+			},
+		},
+	}
+}
+
+// EvaluationFinished returns a passed evaluation.finished event
+func EvaluationFinished(opts ...Option) models.KeptnContextExtendedCE {
+	return newEvent(keptnv2.GetFinishedEventType(keptnv2.EvaluationTaskName), EvaluationFinishedData(), opts)
+}
+
+// ApprovalTriggeredData returns the payload of ApprovalTriggered
+func ApprovalTriggeredData() keptnv2.ApprovalTriggeredEventData {
+	data := keptnv2.ApprovalTriggeredEventData{
+		EventData: eventData(),
+		Approval:  keptnv2.Approval{Pass: keptnv2.ApprovalAutomatic, Warning: keptnv2.ApprovalManual},
+	}
+	data.Result = keptnv2.ResultPass
+	return data
+}
+
+// ApprovalTriggered returns an approval.triggered event for a passed evaluation
+func ApprovalTriggered(opts ...Option) models.KeptnContextExtendedCE {
+	return newEvent(keptnv2.GetTriggeredEventType(keptnv2.ApprovalTaskName), ApprovalTriggeredData(), opts)
+}
+
+// ApprovalFinished returns an approval.finished event approving the delivery
+func ApprovalFinished(opts ...Option) models.KeptnContextExtendedCE {
+	data := keptnv2.ApprovalFinishedEventData{EventData: finishedEventData(keptnv2.ResultPass)}
+	return newEvent(keptnv2.GetFinishedEventType(keptnv2.ApprovalTaskName), data, opts)
+}
+
+// ProblemOpenData returns the payload of ProblemOpen
+func ProblemOpenData() keptn.ProblemEventData {
+	return keptn.ProblemEventData{
+		State:          "OPEN",
+		ProblemID:      "762",
+		ProblemTitle:   "cpu_usage_sockshop_carts",
+		ProblemDetails: json.RawMessage(`{"tags":"carts"}`),
+		PID:            "93a5-3fas-a09d-8ckf",
+		ImpactedEntity: "carts-primary",
+		Project:        Project,
+		Stage:          Stage,
+		Service:        Service,
+		Labels:         map[string]string{},
+	}
+}
+
+// ProblemOpen returns a problem.open event
+func ProblemOpen(opts ...Option) models.KeptnContextExtendedCE {
+	return newEvent(keptn.ProblemOpenEventType, ProblemOpenData(), opts)
+}
+
+// GetActionTriggeredData returns the payload of GetActionTriggered
+func GetActionTriggeredData() keptnv2.GetActionTriggeredEventData {
+	return keptnv2.GetActionTriggeredEventData{
+		EventData: eventData(),
+		Problem:   keptnv2.ProblemDetails{ProblemTitle: "cpu_usage_sockshop_carts", RootCause: "CPU usage of carts exceeds 90%"},
+		GetAction: keptnv2.GetActionData{ActionIndex: 0},
+	}
+}
+
+// GetActionTriggered returns a get-action.triggered event, which is sent when a remediation is started for a problem
+func GetActionTriggered(opts ...Option) models.KeptnContextExtendedCE {
+	return newEvent(keptnv2.GetTriggeredEventType(keptnv2.GetActionTaskName), GetActionTriggeredData(), opts)
+}
+
+// ActionTriggeredData returns the payload of ActionTriggered
+func ActionTriggeredData() keptnv2.ActionTriggeredEventData {
+	return keptnv2.ActionTriggeredEventData{
+		EventData: eventData(),
+		Action: keptnv2.ActionInfo{
+			Name:        "scale",
+			Action:      "scaling",
+			Description: "Scale up the carts service",
+			Value:       "1",
+		},
+		Problem: keptnv2.ProblemDetails{ProblemTitle: "cpu_usage_sockshop_carts", RootCause: "CPU usage of carts exceeds 90%"},
+	}
+}
+
+// ActionTriggered returns an action.triggered event of a remediation
+func ActionTriggered(opts ...Option) models.KeptnContextExtendedCE {
+	return newEvent(keptnv2.GetTriggeredEventType(keptnv2.ActionTaskName), ActionTriggeredData(), opts)
+}
+
+// ActionFinished returns a successful action.finished event of a remediation
+func ActionFinished(opts ...Option) models.KeptnContextExtendedCE {
+	data := keptnv2.ActionFinishedEventData{
+		EventData: finishedEventData(keptnv2.ResultPass),
+		Action:    &keptnv2.ActionExecution{ActionIndex: 0, Name: "scale", Action: "scaling"},
+	}
+	return newEvent(keptnv2.GetFinishedEventType(keptnv2.ActionTaskName), data, opts)
+}
+
+// GetSLITriggeredData returns the payload of GetSLITriggered
+func GetSLITriggeredData() keptnv2.GetSLITriggeredEventData {
+	return keptnv2.GetSLITriggeredEventData{
+		EventData:  eventData(),
+		Deployment: "canary",
+		GetSLI: keptnv2.GetSLI{
+			SLIProvider: "prometheus",
+			Start:       Start,
+			End:         End,
+			Indicators:  []string{"response_time_p95", "error_rate"},
+		},
+	}
+}
+
+// GetSLITriggered returns a get-sli.triggered event
+func GetSLITriggered(opts ...Option) models.KeptnContextExtendedCE {
+	return newEvent(keptnv2.GetTriggeredEventType(keptnv2.GetSLITaskName), GetSLITriggeredData(), opts)
+}
+
+// GetSLIFinishedData returns the payload of GetSLIFinished
+func GetSLIFinishedData() keptnv2.GetSLIFinishedEventData {
+	return keptnv2.GetSLIFinishedEventData{
+		EventData: finishedEventData(keptnv2.ResultPass),
+		GetSLI: keptnv2.GetSLIFinished{
+			Start: Start,
+			End:   End,
+			IndicatorValues: []*keptnv2.SLIResult{
+				{Metric: "response_time_p95", Value: 212.5, Success: true},
+				{Metric: "error_rate", Value: 0.01, Success: true},
+			},
+		},
+	}
+}
+
+// GetSLIFinished returns a successful get-sli.finished event
+func GetSLIFinished(opts ...Option) models.KeptnContextExtendedCE {
+	return newEvent(keptnv2.GetFinishedEventType(keptnv2.GetSLITaskName), GetSLIFinishedData(), opts)
+}
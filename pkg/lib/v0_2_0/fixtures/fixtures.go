@@ -0,0 +1,185 @@
+// Package fixtures provides realistic and valid sample events of all standard Keptn event types for tests,
+// so that tests do not need to embed hand-edited JSON that drifts from the Keptn spec.
+//
+// Each event type has a function returning the sample event, e.g. DeploymentTriggered, and a function returning
+// its payload, e.g. DeploymentTriggeredData. The fields of the event and the common fields of the payload can be
+// overridden via options, other fields of the payload by passing a modified payload to WithData:
+//
+//	data := fixtures.DeploymentTriggeredData()
+//	data.Deployment.DeploymentStrategy = "blue_green_service"
+//	event := fixtures.DeploymentTriggered(fixtures.WithData(data), fixtures.WithStage("production"))
+package fixtures
+
+import (
+	"reflect"
+	"time"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	"github.com/google/uuid"
+	"github.com/keptn/go-utils/config"
+	"github.com/keptn/go-utils/pkg/api/models"
+	"github.com/keptn/go-utils/pkg/common/strutils"
+	keptnv2 "github.com/keptn/go-utils/pkg/lib/v0_2_0"
+)
+
+// Default values of the fixtures
+const (
+	Project      = "sockshop"
+	Stage        = "dev"
+	Service      = "carts"
+	KeptnContext = "2d8ad5b6-4fc4-4e5c-a9b0-3e6a1c0b2b6f"
+	TriggeredID  = "7c2c890f-b3ac-4caf-8ec7-1f3c5d4e3f1a"
+	Source       = "fixtures"
+	// Start and End are the timeframe of tests, evaluations and SLIs
+	Start = "2022-01-26T10:05:53.931Z"
+	End   = "2022-01-26T10:10:53.931Z"
+)
+
+// Time is the default time of the fixtures
+var Time = time.Date(2022, 1, 26, 10, 10, 54, 0, time.UTC)
+
+// Option overrides a field of a fixture
+type Option func(event *models.KeptnContextExtendedCE)
+
+// WithID sets the ID of the event. By default, a new UUID is used
+func WithID(id string) Option {
+	return func(event *models.KeptnContextExtendedCE) {
+		event.ID = id
+	}
+}
+
+// WithKeptnContext sets the Keptn context of the event. By default, KeptnContext is used
+func WithKeptnContext(keptnContext string) Option {
+	return func(event *models.KeptnContextExtendedCE) {
+		event.Shkeptncontext = keptnContext
+	}
+}
+
+// WithTriggeredID sets the ID of the triggered event the event responds to. By default, events other than
+// triggered events respond to TriggeredID
+func WithTriggeredID(triggeredID string) Option {
+	return func(event *models.KeptnContextExtendedCE) {
+		event.Triggeredid = triggeredID
+	}
+}
+
+// WithSource sets the source of the event. By default, Source is used
+func WithSource(source string) Option {
+	return func(event *models.KeptnContextExtendedCE) {
+		event.Source = strutils.Stringp(source)
+	}
+}
+
+// WithTime sets the time of the event. By default, Time is used
+func WithTime(t time.Time) Option {
+	return func(event *models.KeptnContextExtendedCE) {
+		event.Time = t
+	}
+}
+
+// WithGitCommitID sets the git commit ID of the event
+func WithGitCommitID(gitCommitID string) Option {
+	return func(event *models.KeptnContextExtendedCE) {
+		event.GitCommitID = gitCommitID
+	}
+}
+
+// WithData replaces the payload of the event, e.g. by a modified payload of the fixture.
+// Options overriding fields of the payload have to be passed after WithData
+func WithData(data interface{}) Option {
+	return func(event *models.KeptnContextExtendedCE) {
+		event.Data = data
+	}
+}
+
+// WithProject sets the project in the payload of the event. By default, Project is used
+func WithProject(project string) Option {
+	return withDataField("Project", project)
+}
+
+// WithStage sets the stage in the payload of the event. By default, Stage is used
+func WithStage(stage string) Option {
+	return withDataField("Stage", stage)
+}
+
+// WithService sets the service in the payload of the event. By default, Service is used
+func WithService(service string) Option {
+	return withDataField("Service", service)
+}
+
+// WithLabels sets the labels in the payload of the event
+func WithLabels(labels map[string]string) Option {
+	return withDataField("Labels", labels)
+}
+
+// WithStatus sets the status in the payload of the event
+func WithStatus(status keptnv2.StatusType) Option {
+	return withDataField("Status", status)
+}
+
+// WithResult sets the result in the payload of the event
+func WithResult(result keptnv2.ResultType) Option {
+	return withDataField("Result", result)
+}
+
+// WithMessage sets the message in the payload of the event
+func WithMessage(message string) Option {
+	return withDataField("Message", message)
+}
+
+// withDataField sets the field with the given name of the payload of the event, if the payload has such a field.
+// The payloads of the fixtures are structs, which are copied, so that payloads passed to WithData are not modified
+func withDataField(name string, value interface{}) Option {
+	return func(event *models.KeptnContextExtendedCE) {
+		if event.Data == nil || reflect.TypeOf(event.Data).Kind() != reflect.Struct {
+			return
+		}
+		payload := reflect.New(reflect.TypeOf(event.Data)).Elem()
+		payload.Set(reflect.ValueOf(event.Data))
+		field := payload.FieldByName(name)
+		if !field.IsValid() || !field.CanSet() {
+			return
+		}
+		v := reflect.ValueOf(value)
+		if !v.Type().ConvertibleTo(field.Type()) {
+			return
+		}
+		field.Set(v.Convert(field.Type()))
+		event.Data = payload.Interface()
+	}
+}
+
+// newEvent creates a fixture of the given type with the given payload and applies the options
+func newEvent(eventType string, data interface{}, opts []Option) models.KeptnContextExtendedCE {
+	event := models.KeptnContextExtendedCE{
+		ID:                 uuid.NewString(),
+		Contenttype:        cloudevents.ApplicationJSON,
+		Data:               data,
+		Shkeptncontext:     KeptnContext,
+		Shkeptnspecversion: config.GetKeptnGoUtilsConfig().ShKeptnSpecVersion,
+		Source:             strutils.Stringp(Source),
+		Specversion:        "1.0",
+		Time:               Time,
+		Type:               strutils.Stringp(eventType),
+	}
+	if !keptnv2.IsTriggeredEventType(eventType) {
+		event.Triggeredid = TriggeredID
+	}
+	for _, opt := range opts {
+		opt(&event)
+	}
+	return event
+}
+
+// eventData returns the common payload of the fixtures
+func eventData() keptnv2.EventData {
+	return keptnv2.EventData{Project: Project, Stage: Stage, Service: Service}
+}
+
+// finishedEventData returns the common payload of the started and finished fixtures, which succeeded
+func finishedEventData(result keptnv2.ResultType) keptnv2.EventData {
+	data := eventData()
+	data.Status = keptnv2.StatusSucceeded
+	data.Result = result
+	return data
+}
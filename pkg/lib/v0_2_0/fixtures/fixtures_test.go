@@ -0,0 +1,111 @@
+package fixtures
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/keptn/go-utils/pkg/api/models"
+	keptn "github.com/keptn/go-utils/pkg/lib"
+	keptnv2 "github.com/keptn/go-utils/pkg/lib/v0_2_0"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFixturesAreValid(t *testing.T) {
+	tests := []struct {
+		event     models.KeptnContextExtendedCE
+		eventType string
+		data      interface{}
+	}{
+		{DeploymentTriggered(), "sh.keptn.event.deployment.triggered", &keptnv2.DeploymentTriggeredEventData{}},
+		{DeploymentStarted(), "sh.keptn.event.deployment.started", &keptnv2.DeploymentStartedEventData{}},
+		{DeploymentFinished(), "sh.keptn.event.deployment.finished", &keptnv2.DeploymentFinishedEventData{}},
+		{TestTriggered(), "sh.keptn.event.test.triggered", &keptnv2.TestTriggeredEventData{}},
+		{TestFinished(), "sh.keptn.event.test.finished", &keptnv2.TestFinishedEventData{}},
+		{EvaluationTriggered(), "sh.keptn.event.evaluation.triggered", &keptnv2.EvaluationTriggeredEventData{}},
+		{EvaluationFinished(), "sh.keptn.event.evaluation.finished", &keptnv2.EvaluationFinishedEventData{}},
+		{ApprovalTriggered(), "sh.keptn.event.approval.triggered", &keptnv2.ApprovalTriggeredEventData{}},
+		{ApprovalFinished(), "sh.keptn.event.approval.finished", &keptnv2.ApprovalFinishedEventData{}},
+		{ProblemOpen(), "sh.keptn.event.problem.open", &keptn.ProblemEventData{}},
+		{GetActionTriggered(), "sh.keptn.event.get-action.triggered", &keptnv2.GetActionTriggeredEventData{}},
+		{ActionTriggered(), "sh.keptn.event.action.triggered", &keptnv2.ActionTriggeredEventData{}},
+		{ActionFinished(), "sh.keptn.event.action.finished", &keptnv2.ActionFinishedEventData{}},
+		{GetSLITriggered(), "sh.keptn.event.get-sli.triggered", &keptnv2.GetSLITriggeredEventData{}},
+		{GetSLIFinished(), "sh.keptn.event.get-sli.finished", &keptnv2.GetSLIFinishedEventData{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.eventType, func(t *testing.T) {
+			require.Equal(t, tt.eventType, *tt.event.Type)
+			require.True(t, keptnv2.IsValidEventType(*tt.event.Type))
+			require.Equal(t, KeptnContext, tt.event.Shkeptncontext)
+			require.NotEmpty(t, tt.event.ID)
+			if keptnv2.IsTriggeredEventType(tt.eventType) {
+				require.Empty(t, tt.event.Triggeredid)
+			} else {
+				require.Equal(t, TriggeredID, tt.event.Triggeredid)
+			}
+
+			_, err := (&keptnv2.KeptnEventBuilder{KeptnContextExtendedCE: tt.event}).Build()
+			require.Nil(t, err)
+
+			// the payload survives a round trip through JSON without losing fields
+			raw, err := json.Marshal(tt.event)
+			require.Nil(t, err)
+			decoded := models.KeptnContextExtendedCE{}
+			require.Nil(t, json.Unmarshal(raw, &decoded))
+			require.Nil(t, decoded.DataAs(tt.data))
+			expected, err := json.Marshal(tt.event.Data)
+			require.Nil(t, err)
+			actual, err := json.Marshal(tt.data)
+			require.Nil(t, err)
+			require.JSONEq(t, string(expected), string(actual))
+		})
+	}
+}
+
+func TestFixtureOptions(t *testing.T) {
+	data := DeploymentFinishedData()
+	data.Deployment.DeploymentStrategy = "blue_green_service"
+	ts := time.Date(2022, 2, 1, 0, 0, 0, 0, time.UTC)
+	event := DeploymentFinished(
+		WithData(data),
+		WithID("my-id"),
+		WithKeptnContext("my-context"),
+		WithTriggeredID("my-triggered-id"),
+		WithSource("my-service"),
+		WithTime(ts),
+		WithGitCommitID("my-commit"),
+		WithProject("podtato-head"),
+		WithStage("production"),
+		WithService("helloservice"),
+		WithLabels(map[string]string{"buildId": "2"}),
+		WithStatus(keptnv2.StatusErrored),
+		WithResult(keptnv2.ResultFailed),
+		WithMessage("deployment failed"),
+	)
+
+	require.Equal(t, "my-id", event.ID)
+	require.Equal(t, "my-context", event.Shkeptncontext)
+	require.Equal(t, "my-triggered-id", event.Triggeredid)
+	require.Equal(t, "my-service", *event.Source)
+	require.Equal(t, ts, event.Time)
+	require.Equal(t, "my-commit", event.GitCommitID)
+
+	actual := event.Data.(keptnv2.DeploymentFinishedEventData)
+	require.Equal(t, keptnv2.EventData{
+		Project: "podtato-head",
+		Stage:   "production",
+		Service: "helloservice",
+		Labels:  map[string]string{"buildId": "2"},
+		Status:  keptnv2.StatusErrored,
+		Result:  keptnv2.ResultFailed,
+		Message: "deployment failed",
+	}, actual.EventData)
+	require.Equal(t, "blue_green_service", actual.Deployment.DeploymentStrategy)
+
+	// the payload passed to WithData is not modified
+	require.Equal(t, Project, data.Project)
+
+	problem := ProblemOpen(WithProject("podtato-head"), WithResult(keptnv2.ResultFailed))
+	require.Equal(t, "podtato-head", problem.Data.(keptn.ProblemEventData).Project)
+}
@@ -0,0 +1,222 @@
+package v0_2_0
+
+import (
+	"fmt"
+
+	"github.com/keptn/go-utils/config"
+	"github.com/keptn/go-utils/pkg/api/models"
+	"github.com/keptn/go-utils/pkg/common/strutils"
+)
+
+// Event types of the Keptn spec before 0.2.0, which can be migrated by MigrateEvent
+const (
+	LegacyEvaluationDoneEventType     = "sh.keptn.events.evaluation-done"
+	LegacyDeploymentFinishedEventType = "sh.keptn.events.deployment-finished"
+	LegacyTestsFinishedEventType      = "sh.keptn.events.tests-finished"
+)
+
+// legacyResultFailed is the result of failed evaluations and tests in early Keptn versions
+const legacyResultFailed = "failed"
+
+// eventMigration converts the data of an event of a legacy type into the data of the current event type
+type eventMigration struct {
+	// Type is the current type of the migrated event
+	Type string
+	// MigrateData converts the data of the legacy event
+	MigrateData func(data interface{}) (interface{}, error)
+}
+
+// eventMigrations are the migrations of the legacy event types
+var eventMigrations = map[string]eventMigration{
+	LegacyEvaluationDoneEventType:     {Type: GetFinishedEventType(EvaluationTaskName), MigrateData: migrateEvaluationDone},
+	LegacyDeploymentFinishedEventType: {Type: GetFinishedEventType(DeploymentTaskName), MigrateData: migrateDeploymentFinished},
+	LegacyTestsFinishedEventType:      {Type: GetFinishedEventType(TestTaskName), MigrateData: migrateTestsFinished},
+}
+
+// IsLegacyEvent returns whether the event has a legacy type that can be migrated by MigrateEvent
+func IsLegacyEvent(event models.KeptnContextExtendedCE) bool {
+	if event.Type == nil {
+		return false
+	}
+	_, ok := eventMigrations[*event.Type]
+	return ok
+}
+
+// MigrateEvent converts an event of a legacy type, e.g. sh.keptn.events.evaluation-done as stored in the datastore by
+// Keptn versions before 0.8, into an event of the current type with data in the current layout, e.g. an
+// evaluation.finished event whose data can be decoded into EvaluationFinishedEventData.
+// The ID, Keptn context, source and time of the event are kept. Events of other types are returned unchanged
+func MigrateEvent(event models.KeptnContextExtendedCE) (models.KeptnContextExtendedCE, error) {
+	if !IsLegacyEvent(event) {
+		return event, nil
+	}
+	migration := eventMigrations[*event.Type]
+	data, err := migration.MigrateData(event.Data)
+	if err != nil {
+		return event, fmt.Errorf("could not migrate %s event %s: %w", *event.Type, event.ID, err)
+	}
+	event.Data = data
+	event.Type = strutils.Stringp(migration.Type)
+	event.Shkeptnspecversion = config.GetKeptnGoUtilsConfig().ShKeptnSpecVersion
+	// early Keptn versions sent events as CloudEvents 0.2
+	if event.Specversion == "" || event.Specversion == "0.2" {
+		event.Specversion = defaultSpecVersion
+	}
+	return event, nil
+}
+
+// MigrateEvents migrates all events of legacy types, see MigrateEvent. The given events are not modified
+func MigrateEvents(events []*models.KeptnContextExtendedCE) ([]*models.KeptnContextExtendedCE, error) {
+	migrated := make([]*models.KeptnContextExtendedCE, 0, len(events))
+	for _, event := range events {
+		if event == nil {
+			continue
+		}
+		migratedEvent, err := MigrateEvent(*event)
+		if err != nil {
+			return nil, err
+		}
+		migrated = append(migrated, &migratedEvent)
+	}
+	return migrated, nil
+}
+
+// MigratedEventDataAs decodes the data of the event into out after migrating it, see MigrateEvent,
+// so that e.g. legacy evaluation-done and current evaluation.finished events can both be decoded into EvaluationFinishedEventData
+func MigratedEventDataAs(event models.KeptnContextExtendedCE, out interface{}) error {
+	migrated, err := MigrateEvent(event)
+	if err != nil {
+		return err
+	}
+	return EventDataAs(migrated, out)
+}
+
+// legacyEventData contains the fields shared by the legacy event types
+type legacyEventData struct {
+	Project            string            `json:"project"`
+	Stage              string            `json:"stage"`
+	Service            string            `json:"service"`
+	TestStrategy       string            `json:"teststrategy,omitempty"`
+	DeploymentStrategy string            `json:"deploymentstrategy,omitempty"`
+	Labels             map[string]string `json:"labels,omitempty"`
+}
+
+func (d legacyEventData) eventData() EventData {
+	return EventData{
+		Project: d.Project,
+		Stage:   d.Stage,
+		Service: d.Service,
+		Labels:  d.Labels,
+		Status:  StatusSucceeded,
+	}
+}
+
+type legacyEvaluationDoneData struct {
+	legacyEventData
+	Result            string                   `json:"result"`
+	EvaluationDetails *legacyEvaluationDetails `json:"evaluationdetails"`
+}
+
+type legacyEvaluationDetails struct {
+	TimeStart        string                       `json:"timeStart"`
+	TimeEnd          string                       `json:"timeEnd"`
+	Result           string                       `json:"result"`
+	Score            float64                      `json:"score"`
+	SLOFileContent   string                       `json:"sloFileContent"`
+	IndicatorResults []*legacySLIEvaluationResult `json:"indicatorResults"`
+	ComparedEvents   []string                     `json:"comparedEvents,omitempty"`
+}
+
+// legacySLIEvaluationResult contains a single list of targets, which are the pass targets of the current layout
+type legacySLIEvaluationResult struct {
+	Score   float64      `json:"score"`
+	Value   *SLIResult   `json:"value"`
+	Targets []*SLITarget `json:"targets"`
+	Status  string       `json:"status"`
+}
+
+func migrateEvaluationDone(data interface{}) (interface{}, error) {
+	legacy := legacyEvaluationDoneData{}
+	if err := Decode(data, &legacy); err != nil {
+		return nil, err
+	}
+	migrated := EvaluationFinishedEventData{EventData: legacy.eventData()}
+	migrated.Result = migrateResult(legacy.Result)
+	if details := legacy.EvaluationDetails; details != nil {
+		migrated.Evaluation = EvaluationDetails{
+			TimeStart:      details.TimeStart,
+			TimeEnd:        details.TimeEnd,
+			Result:         string(migrateResult(details.Result)),
+			Score:          details.Score,
+			SLOFileContent: details.SLOFileContent,
+			ComparedEvents: details.ComparedEvents,
+		}
+		if migrated.Result == "" {
+			migrated.Result = ResultType(migrated.Evaluation.Result)
+		}
+		for _, indicator := range details.IndicatorResults {
+			if indicator == nil {
+				continue
+			}
+			migrated.Evaluation.IndicatorResults = append(migrated.Evaluation.IndicatorResults, &SLIEvaluationResult{
+				Score:       indicator.Score,
+				Value:       indicator.Value,
+				PassTargets: indicator.Targets,
+				Status:      string(migrateResult(indicator.Status)),
+			})
+		}
+	}
+	return migrated, nil
+}
+
+type legacyDeploymentFinishedData struct {
+	legacyEventData
+	DeploymentURILocal  string `json:"deploymentURILocal,omitempty"`
+	DeploymentURIPublic string `json:"deploymentURIPublic,omitempty"`
+}
+
+func migrateDeploymentFinished(data interface{}) (interface{}, error) {
+	legacy := legacyDeploymentFinishedData{}
+	if err := Decode(data, &legacy); err != nil {
+		return nil, err
+	}
+	migrated := DeploymentFinishedEventData{EventData: legacy.eventData()}
+	migrated.Result = ResultPass
+	migrated.Deployment.DeploymentStrategy = legacy.DeploymentStrategy
+	if legacy.DeploymentURILocal != "" {
+		migrated.Deployment.DeploymentURIsLocal = []string{legacy.DeploymentURILocal}
+	}
+	if legacy.DeploymentURIPublic != "" {
+		migrated.Deployment.DeploymentURIsPublic = []string{legacy.DeploymentURIPublic}
+	}
+	return migrated, nil
+}
+
+type legacyTestsFinishedData struct {
+	legacyEventData
+	Start  string `json:"start"`
+	End    string `json:"end"`
+	Result string `json:"result"`
+}
+
+func migrateTestsFinished(data interface{}) (interface{}, error) {
+	legacy := legacyTestsFinishedData{}
+	if err := Decode(data, &legacy); err != nil {
+		return nil, err
+	}
+	migrated := TestFinishedEventData{EventData: legacy.eventData()}
+	migrated.Result = migrateResult(legacy.Result)
+	if migrated.Result == "" {
+		migrated.Result = ResultPass
+	}
+	migrated.Test = TestFinishedDetails{Start: legacy.Start, End: legacy.End}
+	return migrated, nil
+}
+
+// migrateResult converts the result of a legacy event, which used "failed" instead of "fail"
+func migrateResult(result string) ResultType {
+	if result == legacyResultFailed {
+		return ResultFailed
+	}
+	return ResultType(result)
+}
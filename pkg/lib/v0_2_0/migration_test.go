@@ -0,0 +1,124 @@
+package v0_2_0
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/keptn/go-utils/pkg/api/models"
+	"github.com/keptn/go-utils/pkg/common/strutils"
+	"github.com/stretchr/testify/require"
+)
+
+func legacyEvent(t *testing.T, eventType string, data string) models.KeptnContextExtendedCE {
+	event := models.KeptnContextExtendedCE{
+		ID:             "my-id",
+		Shkeptncontext: "my-context",
+		Source:         strutils.Stringp("lighthouse-service"),
+		Specversion:    "0.2",
+		Type:           strutils.Stringp(eventType),
+	}
+	require.NoError(t, json.Unmarshal([]byte(data), &event.Data))
+	return event
+}
+
+func TestMigrateEvent_EvaluationDone(t *testing.T) {
+	event := legacyEvent(t, LegacyEvaluationDoneEventType, `{
+		"project": "sockshop", "stage": "staging", "service": "carts",
+		"teststrategy": "performance", "deploymentstrategy": "blue_green_service",
+		"labels": {"buildId": "1"},
+		"result": "failed",
+		"evaluationpassed": false,
+		"evaluationdetails": {
+			"timeStart": "2020-01-01T10:00:00Z", "timeEnd": "2020-01-01T10:10:00Z",
+			"result": "failed", "score": 33.3, "sloFileContent": "c2xv",
+			"comparedEvents": ["event-1"],
+			"indicatorResults": [{
+				"score": 0, "status": "failed",
+				"value": {"metric": "response_time_p95", "value": 1200, "success": true},
+				"targets": [{"criteria": "<=800", "targetValue": 800, "violated": true}]
+			}]
+		}
+	}`)
+	require.True(t, IsLegacyEvent(event))
+
+	migrated, err := MigrateEvent(event)
+	require.NoError(t, err)
+	require.Equal(t, "sh.keptn.event.evaluation.finished", *migrated.Type)
+	require.Equal(t, "my-id", migrated.ID)
+	require.Equal(t, "my-context", migrated.Shkeptncontext)
+	require.Equal(t, defaultSpecVersion, migrated.Specversion)
+	require.NotEmpty(t, migrated.Shkeptnspecversion)
+	require.False(t, IsLegacyEvent(migrated))
+
+	data := EvaluationFinishedEventData{}
+	require.NoError(t, EventDataAs(migrated, &data))
+	require.Equal(t, EvaluationFinishedEventData{
+		EventData: EventData{
+			Project: "sockshop", Stage: "staging", Service: "carts",
+			Labels: map[string]string{"buildId": "1"},
+			Status: StatusSucceeded, Result: ResultFailed,
+		},
+		Evaluation: EvaluationDetails{
+			TimeStart: "2020-01-01T10:00:00Z", TimeEnd: "2020-01-01T10:10:00Z",
+			Result: "fail", Score: 33.3, SLOFileContent: "c2xv",
+			ComparedEvents: []string{"event-1"},
+			IndicatorResults: []*SLIEvaluationResult{{
+				Score:       0,
+				Status:      "fail",
+				Value:       &SLIResult{Metric: "response_time_p95", Value: 1200, Success: true},
+				PassTargets: []*SLITarget{{Criteria: "<=800", TargetValue: 800, Violated: true}},
+			}},
+		},
+	}, data)
+
+	// the legacy event is not modified
+	require.Equal(t, LegacyEvaluationDoneEventType, *event.Type)
+}
+
+func TestMigrateEvent_DeploymentFinished(t *testing.T) {
+	event := legacyEvent(t, LegacyDeploymentFinishedEventType, `{
+		"project": "sockshop", "stage": "dev", "service": "carts",
+		"deploymentstrategy": "direct", "image": "carts", "tag": "0.1.0",
+		"deploymentURILocal": "http://carts.sockshop-dev:80"
+	}`)
+
+	data := DeploymentFinishedEventData{}
+	require.NoError(t, MigratedEventDataAs(event, &data))
+	require.Equal(t, DeploymentFinishedEventData{
+		EventData: EventData{Project: "sockshop", Stage: "dev", Service: "carts", Status: StatusSucceeded, Result: ResultPass},
+		Deployment: DeploymentFinishedData{
+			DeploymentStrategy:  "direct",
+			DeploymentURIsLocal: []string{"http://carts.sockshop-dev:80"},
+		},
+	}, data)
+}
+
+func TestMigrateEvents(t *testing.T) {
+	testsFinished := legacyEvent(t, LegacyTestsFinishedEventType, `{
+		"project": "sockshop", "stage": "dev", "service": "carts", "teststrategy": "functional",
+		"start": "2020-01-01T10:00:00Z", "end": "2020-01-01T10:05:00Z"
+	}`)
+	current := models.KeptnContextExtendedCE{
+		ID:   "current-id",
+		Type: strutils.Stringp("sh.keptn.event.test.finished"),
+		Data: map[string]interface{}{"project": "sockshop", "result": "warning"},
+	}
+
+	migrated, err := MigrateEvents([]*models.KeptnContextExtendedCE{&testsFinished, nil, &current})
+	require.NoError(t, err)
+	require.Len(t, migrated, 2)
+
+	data := TestFinishedEventData{}
+	require.NoError(t, EventDataAs(*migrated[0], &data))
+	require.Equal(t, "sh.keptn.event.test.finished", *migrated[0].Type)
+	require.Equal(t, ResultPass, data.Result)
+	require.Equal(t, TestFinishedDetails{Start: "2020-01-01T10:00:00Z", End: "2020-01-01T10:05:00Z"}, data.Test)
+
+	require.Equal(t, current, *migrated[1])
+}
+
+func TestMigrateEvent_InvalidData(t *testing.T) {
+	event := legacyEvent(t, LegacyEvaluationDoneEventType, `{"evaluationdetails": "invalid"}`)
+	_, err := MigrateEvent(event)
+	require.Error(t, err)
+}
@@ -0,0 +1,155 @@
+package v0_2_0
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/keptn/go-utils/pkg/api/models"
+	"github.com/keptn/go-utils/pkg/lib/v0_1_4"
+	"gopkg.in/yaml.v3"
+)
+
+// RemediationResourceURI is the URI of the resource of a service defining its remediations
+const RemediationResourceURI = "remediation.yaml"
+
+// DefaultRemediationProblemType is the problem type of the remediation used for problems without a remediation of their own
+const DefaultRemediationProblemType = "default"
+
+// ErrNoMoreActions is returned by GetNextAction if all actions of the remediation of a problem have been executed
+var ErrNoMoreActions = errors.New("no more remediation actions")
+
+// ActionResult is the result of the execution of a remediation action, see NewActionFinishedEvent
+type ActionResult struct {
+	// ActionIndex is the index of the action in the list of actions of the remediation
+	ActionIndex int
+	// Result is the result of the action. If it is empty, ResultPass is used, or ResultFailed if Err is set
+	Result ResultType
+	// Message describes the result of the action
+	Message string
+	// Err is the error which made the action fail, if any. It sets the status of the event to StatusErrored
+	Err error
+}
+
+// GetNextAction returns the action with the given index of the remediation for the problem.
+// The remediation for the problem is the one with the problem title as problem type,
+// or the one with DefaultRemediationProblemType if there is none.
+// ErrNoMoreActions is returned if the remediation has no action with the given index
+func GetNextAction(remediation *v0_1_4.Remediation, problem ProblemDetails, actionIndex int) (*ActionInfo, error) {
+	if remediation == nil {
+		return nil, errors.New("remediation is empty")
+	}
+	if actionIndex < 0 {
+		return nil, fmt.Errorf("action index must not be negative, but is %d", actionIndex)
+	}
+	var actions []v0_1_4.RemediationActionsOnOpen
+	found := false
+	for _, r := range remediation.Spec.Remediations {
+		if r.ProblemType == problem.ProblemTitle {
+			actions = r.ActionsOnOpen
+			found = true
+			break
+		}
+		if r.ProblemType == DefaultRemediationProblemType && !found {
+			actions = r.ActionsOnOpen
+			found = true
+		}
+	}
+	if !found {
+		return nil, fmt.Errorf("no remediation found for problem %q", problem.ProblemTitle)
+	}
+	if actionIndex >= len(actions) {
+		return nil, fmt.Errorf("%w: the remediation for problem %q has %d actions", ErrNoMoreActions, problem.ProblemTitle, len(actions))
+	}
+	action := actions[actionIndex]
+	return &ActionInfo{
+		Name:        action.Name,
+		Action:      action.Action,
+		Description: action.Description,
+		Value:       action.Value,
+	}, nil
+}
+
+// GetNextAction reads the remediation resource of the service of the incoming event and returns
+// the action with the given index of the remediation for the problem, see GetNextAction
+func (k *Keptn) GetNextAction(problem ProblemDetails, actionIndex int) (*ActionInfo, error) {
+	content, err := k.GetKeptnResource(RemediationResourceURI)
+	if err != nil {
+		return nil, err
+	}
+	remediation := &v0_1_4.Remediation{}
+	if err := yaml.Unmarshal(content, remediation); err != nil {
+		return nil, fmt.Errorf("could not decode %s: %w", RemediationResourceURI, err)
+	}
+	return GetNextAction(remediation, problem, actionIndex)
+}
+
+// NewActionStartedEvent creates a builder for the action.started event responding to the given action.triggered event
+func NewActionStartedEvent(triggered models.KeptnContextExtendedCE, source string, actionIndex int) (*KeptnEventBuilder, error) {
+	triggeredData := &ActionTriggeredEventData{}
+	if err := triggered.DataAs(triggeredData); err != nil {
+		return nil, fmt.Errorf("could not decode data of action.triggered event: %w", err)
+	}
+	data := ActionStartedEventData{
+		EventData: actionEventData(triggeredData.EventData),
+		Action:    newActionExecution(triggeredData.Action, actionIndex, nil),
+	}
+	data.Status = StatusSucceeded
+	return respondTo(triggered, GetStartedEventType(ActionTaskName), source, data), nil
+}
+
+// NewActionFinishedEvent creates a builder for the action.finished event responding to the given action.triggered event
+func NewActionFinishedEvent(triggered models.KeptnContextExtendedCE, source string, result ActionResult) (*KeptnEventBuilder, error) {
+	triggeredData := &ActionTriggeredEventData{}
+	if err := triggered.DataAs(triggeredData); err != nil {
+		return nil, fmt.Errorf("could not decode data of action.triggered event: %w", err)
+	}
+	data := ActionFinishedEventData{
+		EventData: actionEventData(triggeredData.EventData),
+		Action:    newActionExecution(triggeredData.Action, result.ActionIndex, result.Err),
+	}
+	data.Status = StatusSucceeded
+	data.Result = result.Result
+	data.Message = result.Message
+	if result.Err != nil {
+		data.Status = StatusErrored
+		if data.Result == "" {
+			data.Result = ResultFailed
+		}
+		if data.Message == "" {
+			data.Message = result.Err.Error()
+		}
+	}
+	if data.Result == "" {
+		data.Result = ResultPass
+	}
+	return respondTo(triggered, GetFinishedEventType(ActionTaskName), source, data), nil
+}
+
+// actionEventData returns the project, stage, service and labels of the triggered event
+func actionEventData(triggered EventData) EventData {
+	return EventData{
+		Project: triggered.Project,
+		Stage:   triggered.Stage,
+		Service: triggered.Service,
+		Labels:  triggered.Labels,
+	}
+}
+
+func newActionExecution(action ActionInfo, actionIndex int, err error) *ActionExecution {
+	execution := &ActionExecution{
+		ActionIndex: actionIndex,
+		Name:        action.Name,
+		Action:      action.Action,
+	}
+	if err != nil {
+		execution.ErrorDetails = err.Error()
+	}
+	return execution
+}
+
+func respondTo(triggered models.KeptnContextExtendedCE, eventType string, source string, data interface{}) *KeptnEventBuilder {
+	return KeptnEvent(eventType, source, data).
+		WithKeptnContext(triggered.Shkeptncontext).
+		WithTriggeredID(triggered.ID).
+		WithGitCommitID(triggered.GitCommitID)
+}
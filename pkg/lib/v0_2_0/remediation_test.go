@@ -0,0 +1,148 @@
+package v0_2_0
+
+import (
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/keptn/go-utils/pkg/api/models"
+	api "github.com/keptn/go-utils/pkg/api/utils"
+	"github.com/keptn/go-utils/pkg/lib/keptn"
+	"github.com/keptn/go-utils/pkg/lib/v0_1_4"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+)
+
+const remediationYAML = `apiVersion: spec.keptn.sh/0.1.4
+kind: Remediation
+metadata:
+  name: carts-remediation
+spec:
+  remediations:
+    - problemType: default
+      actionsOnOpen:
+        - name: Toggle feature flag
+          action: togglefeature
+          value:
+            EnablePromotion: off
+    - problemType: Response time degradation
+      actionsOnOpen:
+        - name: Scale up
+          action: scaling
+          value: 1
+        - name: Restart pods
+          action: restart
+`
+
+func decodeRemediation(t *testing.T) *v0_1_4.Remediation {
+	remediation := &v0_1_4.Remediation{}
+	require.Nil(t, yaml.Unmarshal([]byte(remediationYAML), remediation))
+	return remediation
+}
+
+func TestGetNextAction(t *testing.T) {
+	remediation := decodeRemediation(t)
+
+	action, err := GetNextAction(remediation, ProblemDetails{ProblemTitle: "Response time degradation"}, 1)
+	require.Nil(t, err)
+	require.Equal(t, &ActionInfo{Name: "Restart pods", Action: "restart"}, action)
+
+	action, err = GetNextAction(remediation, ProblemDetails{ProblemTitle: "Failure rate increase"}, 0)
+	require.Nil(t, err)
+	require.Equal(t, "togglefeature", action.Action)
+
+	_, err = GetNextAction(remediation, ProblemDetails{ProblemTitle: "Response time degradation"}, 2)
+	require.True(t, errors.Is(err, ErrNoMoreActions))
+
+	_, err = GetNextAction(&v0_1_4.Remediation{}, ProblemDetails{ProblemTitle: "Response time degradation"}, 0)
+	require.NotNil(t, err)
+	require.False(t, errors.Is(err, ErrNoMoreActions))
+}
+
+func TestKeptn_GetNextAction(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !strings.HasSuffix(r.URL.Path, "/project/my-project/stage/production/service/carts/resource/remediation.yaml") {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		fmt.Fprintf(w, `{"resourceURI":"remediation.yaml","resourceContent":"%s"}`, base64.StdEncoding.EncodeToString([]byte(remediationYAML)))
+	}))
+	defer server.Close()
+
+	k := &Keptn{KeptnBase: keptn.KeptnBase{
+		Event:           &EventData{Project: "my-project", Stage: "production", Service: "carts"},
+		ResourceHandler: api.NewResourceHandler(server.URL),
+	}}
+	action, err := k.GetNextAction(ProblemDetails{ProblemTitle: "Response time degradation"}, 0)
+	require.Nil(t, err)
+	require.Equal(t, "scaling", action.Action)
+	require.Equal(t, 1, action.Value)
+}
+
+func TestNewActionEvents(t *testing.T) {
+	triggered, err := KeptnEvent(GetTriggeredEventType(ActionTaskName), "remediation-service", ActionTriggeredEventData{
+		EventData: EventData{Project: "my-project", Stage: "production", Service: "carts", Labels: map[string]string{"owner": "team-a"}},
+		Action:    ActionInfo{Name: "Scale up", Action: "scaling", Value: 1},
+		Problem:   ProblemDetails{ProblemTitle: "Response time degradation"},
+	}).WithKeptnContext("my-context").Build()
+	require.Nil(t, err)
+
+	started, err := NewActionStartedEvent(triggered, "my-action-provider", 0)
+	require.Nil(t, err)
+	startedEvent, err := started.Build()
+	require.Nil(t, err)
+	require.Equal(t, "sh.keptn.event.action.started", *startedEvent.Type)
+	require.Equal(t, triggered.ID, startedEvent.Triggeredid)
+	require.Equal(t, "my-context", startedEvent.Shkeptncontext)
+	startedData := ActionStartedEventData{}
+	require.Nil(t, startedEvent.DataAs(&startedData))
+	require.Equal(t, StatusSucceeded, startedData.Status)
+	require.Equal(t, map[string]string{"owner": "team-a"}, startedData.Labels)
+	require.Equal(t, &ActionExecution{ActionIndex: 0, Name: "Scale up", Action: "scaling"}, startedData.Action)
+
+	tests := []struct {
+		name   string
+		result ActionResult
+		want   ActionFinishedEventData
+	}{
+		{
+			name:   "succeeded",
+			result: ActionResult{ActionIndex: 1, Message: "scaled up to 3 replicas"},
+			want: ActionFinishedEventData{
+				EventData: EventData{Status: StatusSucceeded, Result: ResultPass, Message: "scaled up to 3 replicas"},
+				Action:    &ActionExecution{ActionIndex: 1, Name: "Scale up", Action: "scaling"},
+			},
+		},
+		{
+			name:   "failed",
+			result: ActionResult{ActionIndex: 1, Err: errors.New("quota exceeded")},
+			want: ActionFinishedEventData{
+				EventData: EventData{Status: StatusErrored, Result: ResultFailed, Message: "quota exceeded"},
+				Action:    &ActionExecution{ActionIndex: 1, Name: "Scale up", Action: "scaling", ErrorDetails: "quota exceeded"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			finished, err := NewActionFinishedEvent(triggered, "my-action-provider", tt.result)
+			require.Nil(t, err)
+			finishedEvent, err := finished.Build()
+			require.Nil(t, err)
+			require.Equal(t, "sh.keptn.event.action.finished", *finishedEvent.Type)
+			require.Equal(t, triggered.ID, finishedEvent.Triggeredid)
+
+			data := ActionFinishedEventData{}
+			require.Nil(t, finishedEvent.DataAs(&data))
+			tt.want.Project, tt.want.Stage, tt.want.Service = "my-project", "production", "carts"
+			tt.want.Labels = map[string]string{"owner": "team-a"}
+			require.Equal(t, tt.want, data)
+		})
+	}
+
+	_, err = NewActionFinishedEvent(models.KeptnContextExtendedCE{Data: "invalid"}, "my-action-provider", ActionResult{})
+	require.NotNil(t, err)
+}
@@ -1,5 +1,10 @@
 package v0_2_0
 
+import (
+	"fmt"
+	"strings"
+)
+
 type ResultType string
 
 const (
@@ -7,3 +12,52 @@ const (
 	ResultWarning ResultType = "warning"
 	ResultFailed  ResultType = "fail"
 )
+
+// Validate returns an error if the ResultType is not one of ResultPass, ResultWarning or ResultFailed
+func (r ResultType) Validate() error {
+	switch r {
+	case ResultPass, ResultWarning, ResultFailed:
+		return nil
+	}
+	return fmt.Errorf("invalid result %q: must be one of %q, %q, %q", string(r), ResultPass, ResultWarning, ResultFailed)
+}
+
+// IsPass returns whether the result is ResultPass
+func (r ResultType) IsPass() bool {
+	return r == ResultPass
+}
+
+// IsWarning returns whether the result is ResultWarning
+func (r ResultType) IsWarning() bool {
+	return r == ResultWarning
+}
+
+// IsFailed returns whether the result is ResultFailed
+func (r ResultType) IsFailed() bool {
+	return r == ResultFailed
+}
+
+// MarshalText implements encoding.TextMarshaler and rejects unknown results.
+// An empty result is accepted, since the result is optional in the event data
+func (r ResultType) MarshalText() ([]byte, error) {
+	if r != "" {
+		if err := r.Validate(); err != nil {
+			return nil, err
+		}
+	}
+	return []byte(r), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Known results are matched regardless of case and surrounding
+// whitespace, while unknown results are decoded as they are, so that they can be rejected via Validate
+func (r *ResultType) UnmarshalText(text []byte) error {
+	value := strings.TrimSpace(string(text))
+	for _, known := range []ResultType{ResultPass, ResultWarning, ResultFailed} {
+		if strings.EqualFold(value, string(known)) {
+			*r = known
+			return nil
+		}
+	}
+	*r = ResultType(text)
+	return nil
+}
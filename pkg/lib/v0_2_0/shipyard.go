@@ -1,65 +1,41 @@
 package v0_2_0
 
-import "gopkg.in/yaml.v3"
+import "github.com/keptn/go-utils/pkg/lib/v0_2_0/shipyard"
 
 ///// v0.2.0 Shipyard Spec ///////
+// The types are defined in the shipyard package, which can also be used by the API clients
 
 // Shipyard describes a shipyard specification according to Keptn spec 0.2.0
-type Shipyard struct {
-	ApiVersion string       `json:"apiVersion" yaml:"apiVersion"`
-	Kind       string       `json:"kind" yaml:"kind"`
-	Metadata   Metadata     `json:"metadata" yaml:"metadata"`
-	Spec       ShipyardSpec `json:"spec" yaml:"spec"`
-}
+type Shipyard = shipyard.Shipyard
 
 // Metadata contains meta-data of a resource
-type Metadata struct {
-	Name string `json:"name" yaml:"name"`
-}
+type Metadata = shipyard.Metadata
 
 // ShipyardSpec consists of any number of stages
-type ShipyardSpec struct {
-	Stages []Stage `json:"stages" yaml:"stages"`
-}
+type ShipyardSpec = shipyard.ShipyardSpec
 
 // Stage defines a stage by its name and list of task sequences
-type Stage struct {
-	Name      string     `json:"name" yaml:"name"`
-	Sequences []Sequence `json:"sequences" yaml:"sequences"`
-}
+type Stage = shipyard.Stage
 
 // Sequence defines a task sequence by its name and tasks. The triggers property is optional
-type Sequence struct {
-	Name        string    `json:"name" yaml:"name"`
-	TriggeredOn []Trigger `json:"triggeredOn,omitempty" yaml:"triggeredOn,omitempty"`
-	Tasks       []Task    `json:"tasks" yaml:"tasks"`
-}
+type Sequence = shipyard.Sequence
 
 // Task defines a task by its name and optional properties
-type Task struct {
-	Name           string      `json:"name" yaml:"name"`
-	TriggeredAfter string      `json:"triggeredAfter,omitempty" yaml:"triggeredAfter,omitempty"`
-	Properties     interface{} `json:"properties" yaml:"properties"`
-}
+type Task = shipyard.Task
 
 // Trigger defines a trigger which causes a sequence to get activated
-type Trigger struct {
-	Event    string   `json:"event" yaml:"event"`
-	Selector Selector `json:"selector,omitempty" yaml:"selector,omitempty"`
-}
+type Trigger = shipyard.Trigger
 
 // Selector defines conditions that need to evaluate to true for a trigger to fire
-type Selector struct {
-	Match map[string]string `json:"match" yaml:"match"`
-}
+type Selector = shipyard.Selector
 
 // DecodeShipyardYAML takes a shipyard string formatted as YAML and decodes it to
 // Shipyard value
 func DecodeShipyardYAML(shipyardYaml []byte) (*Shipyard, error) {
-	shipyardDecoded := &Shipyard{}
+	return shipyard.Decode(shipyardYaml)
+}
 
-	if err := yaml.Unmarshal(shipyardYaml, shipyardDecoded); err != nil {
-		return nil, err
-	}
-	return shipyardDecoded, nil
+// ValidateShipyard checks the shipyard against the rules of the Keptn spec 0.2.0, see shipyard.Validate
+func ValidateShipyard(s *Shipyard) error {
+	return shipyard.Validate(s)
 }
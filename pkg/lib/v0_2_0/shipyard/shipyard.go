@@ -0,0 +1,64 @@
+// Package shipyard contains the shipyard specification according to Keptn spec 0.2.0 and its validation.
+// It has no dependencies on the API clients, so that it can be used by all of them
+package shipyard
+
+import "gopkg.in/yaml.v3"
+
+// Shipyard describes a shipyard specification according to Keptn spec 0.2.0
+type Shipyard struct {
+	ApiVersion string       `json:"apiVersion" yaml:"apiVersion"`
+	Kind       string       `json:"kind" yaml:"kind"`
+	Metadata   Metadata     `json:"metadata" yaml:"metadata"`
+	Spec       ShipyardSpec `json:"spec" yaml:"spec"`
+}
+
+// Metadata contains meta-data of a resource
+type Metadata struct {
+	Name string `json:"name" yaml:"name"`
+}
+
+// ShipyardSpec consists of any number of stages
+type ShipyardSpec struct {
+	Stages []Stage `json:"stages" yaml:"stages"`
+}
+
+// Stage defines a stage by its name and list of task sequences
+type Stage struct {
+	Name      string     `json:"name" yaml:"name"`
+	Sequences []Sequence `json:"sequences" yaml:"sequences"`
+}
+
+// Sequence defines a task sequence by its name and tasks. The triggers property is optional
+type Sequence struct {
+	Name        string    `json:"name" yaml:"name"`
+	TriggeredOn []Trigger `json:"triggeredOn,omitempty" yaml:"triggeredOn,omitempty"`
+	Tasks       []Task    `json:"tasks" yaml:"tasks"`
+}
+
+// Task defines a task by its name and optional properties
+type Task struct {
+	Name           string      `json:"name" yaml:"name"`
+	TriggeredAfter string      `json:"triggeredAfter,omitempty" yaml:"triggeredAfter,omitempty"`
+	Properties     interface{} `json:"properties" yaml:"properties"`
+}
+
+// Trigger defines a trigger which causes a sequence to get activated
+type Trigger struct {
+	Event    string   `json:"event" yaml:"event"`
+	Selector Selector `json:"selector,omitempty" yaml:"selector,omitempty"`
+}
+
+// Selector defines conditions that need to evaluate to true for a trigger to fire
+type Selector struct {
+	Match map[string]string `json:"match" yaml:"match"`
+}
+
+// Decode takes a shipyard formatted as YAML and decodes it to a Shipyard value
+func Decode(shipyardYaml []byte) (*Shipyard, error) {
+	shipyardDecoded := &Shipyard{}
+
+	if err := yaml.Unmarshal(shipyardYaml, shipyardDecoded); err != nil {
+		return nil, err
+	}
+	return shipyardDecoded, nil
+}
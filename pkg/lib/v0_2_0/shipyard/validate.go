@@ -0,0 +1,119 @@
+package shipyard
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// APIVersionPrefix is the prefix of all apiVersions of the Keptn spec 0.2.x, e.g. spec.keptn.sh/0.2.3
+const APIVersionPrefix = "spec.keptn.sh/0.2."
+
+// Kind is the kind of a shipyard
+const Kind = "Shipyard"
+
+// namePattern is the pattern of valid stage and sequence names, matching the rules of the Keptn API for entity names
+var namePattern = regexp.MustCompile(`^[a-z][a-z0-9-]*[a-z0-9]$|^[a-z]$`)
+
+// ValidationError lists all problems found in a shipyard, each of them prefixed with the path to the offending field
+type ValidationError struct {
+	Problems []string
+}
+
+// Error returns all problems of the shipyard
+func (e *ValidationError) Error() string {
+	if len(e.Problems) == 1 {
+		return "invalid shipyard: " + e.Problems[0]
+	}
+	return fmt.Sprintf("invalid shipyard: %d problems: %s", len(e.Problems), strings.Join(e.Problems, "; "))
+}
+
+// Validate checks the shipyard against the rules of the Keptn spec 0.2.0, so that it is not rejected by the Keptn API.
+// It returns a *ValidationError listing all problems found
+func Validate(s *Shipyard) error {
+	if s == nil {
+		return &ValidationError{Problems: []string{"shipyard is empty"}}
+	}
+	v := &validator{}
+	if !strings.HasPrefix(s.ApiVersion, APIVersionPrefix) {
+		v.addf("apiVersion: %q is not supported, use %s<patch>, e.g. spec.keptn.sh/0.2.3", s.ApiVersion, APIVersionPrefix)
+	}
+	if s.Kind != Kind {
+		v.addf("kind: %q is not supported, use %s", s.Kind, Kind)
+	}
+	if len(s.Spec.Stages) == 0 {
+		v.addf("spec.stages: at least one stage is required")
+	}
+
+	sequences := map[string]map[string]bool{}
+	for i, stage := range s.Spec.Stages {
+		path := fmt.Sprintf("spec.stages[%d]", i)
+		v.checkName(path, "stage", stage.Name)
+		if _, ok := sequences[stage.Name]; ok && stage.Name != "" {
+			v.addf("%s.name: stage %q is defined more than once", path, stage.Name)
+		}
+		sequences[stage.Name] = map[string]bool{}
+		for j, sequence := range stage.Sequences {
+			seqPath := fmt.Sprintf("%s.sequences[%d]", path, j)
+			v.checkName(seqPath, "sequence", sequence.Name)
+			if sequences[stage.Name][sequence.Name] && sequence.Name != "" {
+				v.addf("%s.name: sequence %q is defined more than once in stage %q", seqPath, sequence.Name, stage.Name)
+			}
+			sequences[stage.Name][sequence.Name] = true
+			for k, task := range sequence.Tasks {
+				if task.Name == "" {
+					v.addf("%s.tasks[%d].name: task name is required", seqPath, k)
+				}
+			}
+		}
+	}
+
+	// triggers may refer to sequences of stages defined later on, so they are checked after all sequences are known
+	for i, stage := range s.Spec.Stages {
+		for j, sequence := range stage.Sequences {
+			for k, trigger := range sequence.TriggeredOn {
+				v.checkTrigger(fmt.Sprintf("spec.stages[%d].sequences[%d].triggeredOn[%d].event", i, j, k), trigger.Event, sequences)
+			}
+		}
+	}
+
+	if len(v.problems) > 0 {
+		return &ValidationError{Problems: v.problems}
+	}
+	return nil
+}
+
+type validator struct {
+	problems []string
+}
+
+func (v *validator) addf(format string, args ...interface{}) {
+	v.problems = append(v.problems, fmt.Sprintf(format, args...))
+}
+
+func (v *validator) checkName(path string, kind string, name string) {
+	if name == "" {
+		v.addf("%s.name: %s name is required", path, kind)
+		return
+	}
+	if !namePattern.MatchString(name) {
+		v.addf("%s.name: %s name %q must start with a lower case letter and contain only lower case letters, digits and hyphens", path, kind, name)
+	}
+}
+
+// checkTrigger checks that the trigger event has the format <stage>.<sequence>.finished and refers to an existing sequence
+func (v *validator) checkTrigger(path string, event string, sequences map[string]map[string]bool) {
+	parts := strings.Split(event, ".")
+	if len(parts) != 3 || parts[2] != "finished" {
+		v.addf("%s: %q must have the format <stage>.<sequence>.finished", path, event)
+		return
+	}
+	stageSequences, ok := sequences[parts[0]]
+	if !ok {
+		v.addf("%s: %q refers to stage %q, which is not defined", path, event, parts[0])
+		return
+	}
+	if !stageSequences[parts[1]] {
+		v.addf("%s: %q refers to sequence %q, which is not defined in stage %q", path, event, parts[1], parts[0])
+	}
+}
@@ -0,0 +1,75 @@
+package shipyard
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+const validShipyard = `apiVersion: spec.keptn.sh/0.2.3
+kind: Shipyard
+metadata:
+  name: shipyard-sockshop
+spec:
+  stages:
+    - name: dev
+      sequences:
+        - name: delivery
+          tasks:
+            - name: deployment
+    - name: production
+      sequences:
+        - name: delivery
+          triggeredOn:
+            - event: dev.delivery.finished
+          tasks:
+            - name: deployment
+`
+
+func TestValidate(t *testing.T) {
+	s, err := Decode([]byte(validShipyard))
+	require.Nil(t, err)
+	require.Nil(t, Validate(s))
+}
+
+func TestValidate_ReportsAllProblems(t *testing.T) {
+	s, err := Decode([]byte(`apiVersion: spec.keptn.sh/0.1.7
+kind: shipyard
+spec:
+  stages:
+    - name: Dev
+      sequences:
+        - name: delivery
+          tasks:
+            - name: ""
+        - name: delivery
+    - name: production
+      sequences:
+        - name: delivery
+          triggeredOn:
+            - event: staging.delivery.finished
+            - event: production.rollback.finished
+            - event: dev.delivery
+    - name: production
+`))
+	require.Nil(t, err)
+
+	err = Validate(s)
+	require.IsType(t, &ValidationError{}, err)
+	require.Equal(t, []string{
+		`apiVersion: "spec.keptn.sh/0.1.7" is not supported, use spec.keptn.sh/0.2.<patch>, e.g. spec.keptn.sh/0.2.3`,
+		`kind: "shipyard" is not supported, use Shipyard`,
+		`spec.stages[0].name: stage name "Dev" must start with a lower case letter and contain only lower case letters, digits and hyphens`,
+		`spec.stages[0].sequences[0].tasks[0].name: task name is required`,
+		`spec.stages[0].sequences[1].name: sequence "delivery" is defined more than once in stage "Dev"`,
+		`spec.stages[2].name: stage "production" is defined more than once`,
+		`spec.stages[1].sequences[0].triggeredOn[0].event: "staging.delivery.finished" refers to stage "staging", which is not defined`,
+		`spec.stages[1].sequences[0].triggeredOn[1].event: "production.rollback.finished" refers to sequence "rollback", which is not defined in stage "production"`,
+		`spec.stages[1].sequences[0].triggeredOn[2].event: "dev.delivery" must have the format <stage>.<sequence>.finished`,
+	}, err.(*ValidationError).Problems)
+}
+
+func TestValidate_Empty(t *testing.T) {
+	require.EqualError(t, Validate(nil), "invalid shipyard: shipyard is empty")
+	require.EqualError(t, Validate(&Shipyard{ApiVersion: "spec.keptn.sh/0.2.0", Kind: "Shipyard"}), "invalid shipyard: spec.stages: at least one stage is required")
+}
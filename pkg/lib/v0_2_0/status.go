@@ -1,5 +1,10 @@
 package v0_2_0
 
+import (
+	"fmt"
+	"strings"
+)
+
 type StatusType string
 
 const (
@@ -8,3 +13,57 @@ const (
 	StatusUnknown   StatusType = "unknown"
 	StatusAborted   StatusType = "aborted"
 )
+
+// Validate returns an error if the StatusType is not one of StatusSucceeded, StatusErrored, StatusUnknown or StatusAborted
+func (s StatusType) Validate() error {
+	switch s {
+	case StatusSucceeded, StatusErrored, StatusUnknown, StatusAborted:
+		return nil
+	}
+	return fmt.Errorf("invalid status %q: must be one of %q, %q, %q, %q", string(s), StatusSucceeded, StatusErrored, StatusUnknown, StatusAborted)
+}
+
+// IsSucceeded returns whether the status is StatusSucceeded
+func (s StatusType) IsSucceeded() bool {
+	return s == StatusSucceeded
+}
+
+// IsErrored returns whether the status is StatusErrored
+func (s StatusType) IsErrored() bool {
+	return s == StatusErrored
+}
+
+// IsUnknown returns whether the status is StatusUnknown
+func (s StatusType) IsUnknown() bool {
+	return s == StatusUnknown
+}
+
+// IsAborted returns whether the status is StatusAborted
+func (s StatusType) IsAborted() bool {
+	return s == StatusAborted
+}
+
+// MarshalText implements encoding.TextMarshaler and rejects unknown statuss.
+// An empty status is accepted, since the status is optional in the event data
+func (s StatusType) MarshalText() ([]byte, error) {
+	if s != "" {
+		if err := s.Validate(); err != nil {
+			return nil, err
+		}
+	}
+	return []byte(s), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Known statuss are matched regardless of case and surrounding
+// whitespace, while unknown statuss are decoded as they are, so that they can be rejected via Validate
+func (s *StatusType) UnmarshalText(text []byte) error {
+	value := strings.TrimSpace(string(text))
+	for _, known := range []StatusType{StatusSucceeded, StatusErrored, StatusUnknown, StatusAborted} {
+		if strings.EqualFold(value, string(known)) {
+			*s = known
+			return nil
+		}
+	}
+	*s = StatusType(text)
+	return nil
+}
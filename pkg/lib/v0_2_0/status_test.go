@@ -0,0 +1,62 @@
+package v0_2_0
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestStatusType(t *testing.T) {
+	for _, status := range []StatusType{StatusSucceeded, StatusErrored, StatusUnknown, StatusAborted} {
+		require.Nil(t, status.Validate())
+	}
+	require.NotNil(t, StatusType("success").Validate())
+	require.NotNil(t, StatusType("").Validate())
+
+	require.True(t, StatusSucceeded.IsSucceeded())
+	require.True(t, StatusErrored.IsErrored())
+	require.True(t, StatusUnknown.IsUnknown())
+	require.True(t, StatusAborted.IsAborted())
+	require.False(t, StatusErrored.IsSucceeded())
+}
+
+func TestResultType(t *testing.T) {
+	for _, result := range []ResultType{ResultPass, ResultWarning, ResultFailed} {
+		require.Nil(t, result.Validate())
+	}
+	require.NotNil(t, ResultType("failed").Validate())
+
+	require.True(t, ResultPass.IsPass())
+	require.True(t, ResultWarning.IsWarning())
+	require.True(t, ResultFailed.IsFailed())
+	require.False(t, ResultWarning.IsPass())
+}
+
+func TestEventData_StatusAndResultJSON(t *testing.T) {
+	data := EventData{}
+	require.Nil(t, json.Unmarshal([]byte(`{"status":"errored","result":"fail"}`), &data))
+	require.True(t, data.Status.IsErrored())
+	require.True(t, data.Result.IsFailed())
+
+	data = EventData{}
+	require.Nil(t, json.Unmarshal([]byte(`{"project":"sockshop"}`), &data))
+	require.Equal(t, StatusType(""), data.Status)
+
+	// known values are matched regardless of case, unknown values are decoded and rejected by Validate
+	require.Nil(t, json.Unmarshal([]byte(`{"status":"Succeeded","result":"PASS "}`), &data))
+	require.Equal(t, StatusSucceeded, data.Status)
+	require.Equal(t, ResultPass, data.Result)
+	require.Nil(t, json.Unmarshal([]byte(`{"status":"done","result":"ok"}`), &data))
+	require.NotNil(t, data.Status.Validate())
+	require.NotNil(t, data.Result.Validate())
+
+	b, err := json.Marshal(EventData{Status: StatusSucceeded, Result: ResultPass})
+	require.Nil(t, err)
+	require.JSONEq(t, `{"status":"succeeded","result":"pass"}`, string(b))
+
+	_, err = json.Marshal(EventData{Status: "done"})
+	require.NotNil(t, err)
+	_, err = json.Marshal(EventData{Result: "ok"})
+	require.NotNil(t, err)
+}
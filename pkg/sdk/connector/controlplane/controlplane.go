@@ -5,14 +5,17 @@ import (
 	"errors"
 	"fmt"
 	"github.com/keptn/go-utils/pkg/api/models"
+	"github.com/keptn/go-utils/pkg/common/contextutils"
+	"github.com/keptn/go-utils/pkg/lib/v0_2_0"
 	"github.com/keptn/go-utils/pkg/sdk/connector/eventmatcher"
 	"github.com/keptn/go-utils/pkg/sdk/connector/eventsource"
 	"github.com/keptn/go-utils/pkg/sdk/connector/logforwarder"
 	"github.com/keptn/go-utils/pkg/sdk/connector/logger"
+	"github.com/keptn/go-utils/pkg/sdk/connector/metrics"
+	"github.com/keptn/go-utils/pkg/sdk/connector/sharding"
+	"github.com/keptn/go-utils/pkg/sdk/connector/specversion"
 	"github.com/keptn/go-utils/pkg/sdk/connector/subscriptionsource"
 	"github.com/keptn/go-utils/pkg/sdk/connector/types"
-	"log"
-	"os"
 	"os/signal"
 	"sync"
 	"syscall"
@@ -27,6 +30,9 @@ const tmpDataDistributorKey = "distributor"
 
 var ErrEventHandleFatal = errors.New("fatal event handling error")
 
+// ErrShutdownTimeout is returned by RunWithGracefulShutdown if the control plane did not shut down within the shutdown timeout
+var ErrShutdownTimeout = errors.New("failed to gracefully shutdown")
+
 // Integration represents a Keptn Service that wants to receive events from the Keptn Control plane
 type Integration interface {
 	// OnEvent is called when a new event was received
@@ -44,7 +50,12 @@ type ControlPlane struct {
 	logger               logger.Logger
 	registered           bool
 	integrationID        string
+	registrationData     types.RegistrationData
 	logForwarder         logforwarder.LogForwarder
+	sharder              *sharding.Sharder
+	sampler              *eventmatcher.Sampler
+	metrics              *metrics.Collector
+	specVersionChecker   *specversion.Checker
 	mtx                  *sync.RWMutex
 }
 
@@ -55,6 +66,40 @@ func WithLogger(logger logger.Logger) func(plane *ControlPlane) {
 	}
 }
 
+// WithSharder sets the Sharder deciding which events this instance of the integration handles.
+// Events of projects that are assigned to other instances are dropped, so the event source has to deliver
+// all events to every instance, e.g. a NATS event source created with nats.WithoutQueueGroup
+func WithSharder(sharder *sharding.Sharder) func(plane *ControlPlane) {
+	return func(ns *ControlPlane) {
+		ns.sharder = sharder
+	}
+}
+
+// WithSampler sets the Sampler bounding the rate at which events of noisy types, e.g. sh.keptn.event.status.changed,
+// are forwarded to the integration. An event is sampled once, when it matches its first subscription
+func WithSampler(sampler *eventmatcher.Sampler) func(plane *ControlPlane) {
+	return func(ns *ControlPlane) {
+		ns.sampler = sampler
+	}
+}
+
+// WithMetrics sets the Collector recording the outcomes of the received events per subscription.
+// By default, the ControlPlane creates its own Collector emitting to the global OpenTelemetry MeterProvider
+func WithMetrics(collector *metrics.Collector) func(plane *ControlPlane) {
+	return func(ns *ControlPlane) {
+		ns.metrics = collector
+	}
+}
+
+// WithSpecVersionChecker sets the Checker for the spec versions of received and sent events.
+// With specversion.Reject, received events with an unsupported version are dropped and sending such events fails.
+// By default, events are checked against specversion.DefaultCompatibility and unsupported versions are only logged
+func WithSpecVersionChecker(checker *specversion.Checker) func(plane *ControlPlane) {
+	return func(ns *ControlPlane) {
+		ns.specVersionChecker = checker
+	}
+}
+
 // RunWithGracefulShutdown starts the controlplane component which takes care of registering
 // the integration and handling events and subscriptions. Further, it supports graceful shutdown handling
 // when receiving a SIGHUB, SIGINT, SIGQUIT, SIGARBT or SIGTERM signal.
@@ -68,14 +113,22 @@ func RunWithGracefulShutdown(controlPlane *ControlPlane, integration Integration
 	defer cancel()
 
 	ctxShutdown, _ = signal.NotifyContext(ctxShutdown, syscall.SIGHUP, syscall.SIGINT, syscall.SIGQUIT, syscall.SIGABRT, syscall.SIGTERM)
+	errC := make(chan error, 1)
 	go func() {
-		<-ctxShutdown.Done()
-		time.Sleep(shutdownTimeout) // shutdown timeout
-		log.Printf("failed to gracefully shutdown")
-		os.Exit(1)
+		errC <- controlPlane.Register(ctxShutdown, integration)
 	}()
 
-	return controlPlane.Register(ctxShutdown, integration)
+	select {
+	case err := <-errC:
+		return err
+	case <-ctxShutdown.Done():
+	}
+	select {
+	case err := <-errC:
+		return err
+	case <-time.After(shutdownTimeout):
+		return ErrShutdownTimeout
+	}
 }
 
 // New creates a new ControlPlane
@@ -95,6 +148,12 @@ func New(subscriptionSource subscriptionsource.SubscriptionSource, eventSource e
 	for _, o := range opts {
 		o(cp)
 	}
+	if cp.metrics == nil {
+		cp.metrics = metrics.NewCollector()
+	}
+	if cp.specVersionChecker == nil {
+		cp.specVersionChecker = specversion.NewChecker(specversion.DefaultCompatibility, specversion.Warn)
+	}
 	return cp
 }
 
@@ -113,6 +172,7 @@ func (cp *ControlPlane) Register(ctx context.Context, integration Integration) e
 	}
 	cp.logger.Debugf("Registered with integration ID %s", cp.integrationID)
 	registrationData.ID = cp.integrationID
+	cp.setRegistrationData(registrationData)
 
 	// WaitGroup used for synchronized shutdown of eventsource and subscription source
 	// during cancellation of the context
@@ -135,7 +195,7 @@ func (cp *ControlPlane) Register(ctx context.Context, integration Integration) e
 		// event updates
 		case event := <-eventUpdates:
 			cp.logger.Debug("Got new event update")
-			err := cp.handle(ctx, event, integration)
+			err := cp.handle(ctx, &event, integration)
 			if errors.Is(err, ErrEventHandleFatal) {
 				return err
 			}
@@ -156,7 +216,11 @@ func (cp *ControlPlane) Register(ctx context.Context, integration Integration) e
 		// control plane cancelled via error in either one of the sub components
 		case e := <-errC:
 			cp.logger.Debugf("Stopping control plane due to error: %v", e)
-			cp.cleanup()
+			if err := cp.cleanup(); err != nil {
+				// the components which could not be stopped might never finish, so they are not waited for
+				cp.setRegistrationStatus(false)
+				return err
+			}
 			cp.logger.Debug("Waiting for components to shutdown")
 			wg.Wait()
 			cp.setRegistrationStatus(false)
@@ -165,6 +229,12 @@ func (cp *ControlPlane) Register(ctx context.Context, integration Integration) e
 	}
 }
 
+// SubscriptionMetrics returns the number of received, matched, filtered, dropped, handled and failed events
+// per subscription ID since the ControlPlane has been created
+func (cp *ControlPlane) SubscriptionMetrics() map[string]metrics.Counts {
+	return cp.metrics.Subscriptions()
+}
+
 // IsRegistered can be called to detect whether the controlPlane is registered and ready to receive events
 func (cp *ControlPlane) IsRegistered() bool {
 	cp.mtx.RLock()
@@ -172,24 +242,92 @@ func (cp *ControlPlane) IsRegistered() bool {
 	return cp.registered
 }
 
-func (cp *ControlPlane) handle(ctx context.Context, eventUpdate types.EventUpdate, integration Integration) error {
+// Registration returns the data the integration has been registered with, including the integration ID
+// assigned by the control plane. It returns false if the integration has not been registered so far
+func (cp *ControlPlane) Registration() (RegistrationData, bool) {
+	cp.mtx.RLock()
+	defer cp.mtx.RUnlock()
+	return cp.registrationData, cp.registrationData.ID != ""
+}
+
+func (cp *ControlPlane) handle(ctx context.Context, eventUpdate *types.EventUpdate, integration Integration) error {
 	cp.logger.Debugf("Received an event of type: %s", *eventUpdate.KeptnEvent.Type)
+	subject := eventUpdate.MetaData.Subject
+	var subscriptions []models.EventSubscription
 	for _, subscription := range cp.currentSubscriptions {
-		if subscription.Event == eventUpdate.MetaData.Subject {
-			cp.logger.Debugf("Check if event matches subscription %s", subscription.ID)
-			matcher := eventmatcher.New(subscription)
-			if matcher.Matches(eventUpdate.KeptnEvent) {
-				cp.logger.Info("Forwarding matched event update: ", eventUpdate.KeptnEvent.ID)
-				if err := cp.forwardMatchedEvent(ctx, eventUpdate, integration, subscription); err != nil {
-					return err
-				}
+		if subscription.Event == subject {
+			subscriptions = append(subscriptions, subscription)
+			cp.metrics.Record(subscription.ID, subject, metrics.Received)
+		}
+	}
+	if len(subscriptions) == 0 {
+		return nil
+	}
+	if err := cp.specVersionChecker.Check(eventUpdate.KeptnEvent); err != nil {
+		if cp.specVersionChecker.Mode() == specversion.Reject {
+			cp.logger.Warnf("Dropping event %s: %v", eventUpdate.KeptnEvent.ID, err)
+			cp.recordAll(subscriptions, subject, metrics.Dropped)
+			return nil
+		}
+		cp.logger.Warnf("Received event %s: %v", eventUpdate.KeptnEvent.ID, err)
+	}
+
+	// the event data is decoded only once and shared by all subscriptions
+	eventData := &v0_2_0.EventData{}
+	if err := eventUpdate.KeptnEvent.DataAs(eventData); err != nil {
+		cp.logger.Warnf("Could not decode data of event %s: %v", eventUpdate.KeptnEvent.ID, err)
+		cp.recordAll(subscriptions, subject, metrics.Dropped)
+		return nil
+	}
+	if cp.sharder != nil && !cp.sharder.Owns(eventData.Project) {
+		cp.logger.Debugf("Event %s belongs to project %s which is handled by another instance", eventUpdate.KeptnEvent.ID, eventData.Project)
+		cp.recordAll(subscriptions, subject, metrics.Dropped)
+		return nil
+	}
+	ctx = contextutils.ContextWithIdentifiers(ctx, contextutils.Identifiers{
+		KeptnContext: eventUpdate.KeptnEvent.Shkeptncontext,
+		Project:      eventData.Project,
+		Stage:        eventData.Stage,
+		Service:      eventData.Service,
+	})
+
+	sampled := false
+	allowed := true
+	for _, subscription := range subscriptions {
+		cp.logger.Debugf("Check if event matches subscription %s", subscription.ID)
+		matcher := eventmatcher.New(subscription)
+		if !matcher.MatchesEventData(*eventData) {
+			cp.metrics.Record(subscription.ID, subject, metrics.Filtered)
+			continue
+		}
+		cp.metrics.Record(subscription.ID, subject, metrics.Matched)
+		if !sampled {
+			sampled = true
+			if cp.sampler != nil && !cp.sampler.Allow(subject) {
+				cp.logger.Debugf("Dropping event %s due to sampling of type %s", eventUpdate.KeptnEvent.ID, subject)
+				allowed = false
 			}
 		}
+		if !allowed {
+			cp.metrics.Record(subscription.ID, subject, metrics.Dropped)
+			continue
+		}
+		cp.logger.Info("Forwarding matched event update: ", eventUpdate.KeptnEvent.ID)
+		if err := cp.forwardMatchedEvent(ctx, eventUpdate, integration, subscription); err != nil {
+			return err
+		}
 	}
 	return nil
 }
 
+func (cp *ControlPlane) recordAll(subscriptions []models.EventSubscription, eventType string, outcome metrics.Outcome) {
+	for _, subscription := range subscriptions {
+		cp.metrics.Record(subscription.ID, eventType, outcome)
+	}
+}
+
 func (cp *ControlPlane) getSender(sender types.EventSender) types.EventSender {
+	sender = cp.checkedSender(sender)
 	if cp.logForwarder != nil {
 		return func(ce models.KeptnContextExtendedCE) error {
 			err := cp.logForwarder.Forward(ce, cp.integrationID)
@@ -203,8 +341,24 @@ func (cp *ControlPlane) getSender(sender types.EventSender) types.EventSender {
 	}
 }
 
-func (cp *ControlPlane) forwardMatchedEvent(ctx context.Context, eventUpdate types.EventUpdate, integration Integration, subscription models.EventSubscription) error {
-	err := eventUpdate.KeptnEvent.AddTemporaryData(
+// checkedSender checks the spec versions of the events before they are sent
+func (cp *ControlPlane) checkedSender(sender types.EventSender) types.EventSender {
+	return func(ce models.KeptnContextExtendedCE) error {
+		if err := cp.specVersionChecker.Check(ce); err != nil {
+			if cp.specVersionChecker.Mode() == specversion.Reject {
+				return fmt.Errorf("could not send event %s: %w", ce.ID, err)
+			}
+			cp.logger.Warnf("Sending event %s: %v", ce.ID, err)
+		}
+		return sender(ce)
+	}
+}
+
+func (cp *ControlPlane) forwardMatchedEvent(ctx context.Context, eventUpdate *types.EventUpdate, integration Integration, subscription models.EventSubscription) error {
+	// the event is shared between all matching subscriptions, so the subscription specific
+	// data is added to a copy. AddTemporaryData does not modify the data of the original event
+	event := eventUpdate.KeptnEvent
+	err := event.AddTemporaryData(
 		tmpDataDistributorKey,
 		types.AdditionalSubscriptionData{
 			SubscriptionID: subscription.ID,
@@ -216,25 +370,35 @@ func (cp *ControlPlane) forwardMatchedEvent(ctx context.Context, eventUpdate typ
 	if err != nil {
 		cp.logger.Warnf("Could not append subscription data to event: %v", err)
 	}
-	if err := integration.OnEvent(context.WithValue(ctx, types.EventSenderKey, cp.getSender(cp.eventSource.Sender())), eventUpdate.KeptnEvent); err != nil {
+	if err := integration.OnEvent(context.WithValue(ctx, types.EventSenderKey, cp.getSender(cp.eventSource.Sender())), event); err != nil {
+		cp.metrics.Record(subscription.ID, eventUpdate.MetaData.Subject, metrics.Failed)
 		if errors.Is(err, ErrEventHandleFatal) {
 			cp.logger.Errorf("Fatal error during handling of event: %v", err)
 			return err
 		}
 		cp.logger.Warnf("Error during handling of event: %v", err)
+		return nil
 	}
+	cp.metrics.Record(subscription.ID, eventUpdate.MetaData.Subject, metrics.Handled)
 	return nil
 }
 
-func (cp *ControlPlane) cleanup() {
+// cleanup stops the subscription source and the event source. It returns the first error that occurred
+func (cp *ControlPlane) cleanup() error {
+	var stopErr error
 	cp.logger.Info("Stopping subscription source...")
 	if err := cp.subscriptionSource.Stop(); err != nil {
-		log.Fatalf("Unable to stop subscription source: %v", err)
+		cp.logger.Errorf("Unable to stop subscription source: %v", err)
+		stopErr = fmt.Errorf("unable to stop subscription source: %w", err)
 	}
 	cp.logger.Info("Stopping event source...")
 	if err := cp.eventSource.Stop(); err != nil {
-		log.Fatalf("Unable to stop event source: %v", err)
+		cp.logger.Errorf("Unable to stop event source: %v", err)
+		if stopErr == nil {
+			stopErr = fmt.Errorf("unable to stop event source: %w", err)
+		}
 	}
+	return stopErr
 }
 
 func (cp *ControlPlane) setRegistrationStatus(registered bool) {
@@ -242,3 +406,9 @@ func (cp *ControlPlane) setRegistrationStatus(registered bool) {
 	defer cp.mtx.Unlock()
 	cp.registered = registered
 }
+
+func (cp *ControlPlane) setRegistrationData(registrationData types.RegistrationData) {
+	cp.mtx.Lock()
+	defer cp.mtx.Unlock()
+	cp.registrationData = registrationData
+}
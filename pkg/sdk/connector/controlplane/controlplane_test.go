@@ -3,7 +3,13 @@ package controlplane
 import (
 	"context"
 	"fmt"
+	"github.com/keptn/go-utils/pkg/common/contextutils"
+	"github.com/keptn/go-utils/pkg/lib/v0_2_0"
+	"github.com/keptn/go-utils/pkg/sdk/connector/eventmatcher"
 	"github.com/keptn/go-utils/pkg/sdk/connector/fake"
+	"github.com/keptn/go-utils/pkg/sdk/connector/metrics"
+	"github.com/keptn/go-utils/pkg/sdk/connector/sharding"
+	"github.com/keptn/go-utils/pkg/sdk/connector/specversion"
 	"github.com/keptn/go-utils/pkg/sdk/connector/types"
 	"reflect"
 	"sync"
@@ -665,3 +671,398 @@ func TestControlPlane_StoppedByReceivingErrEvent(t *testing.T) {
 		return subscriptionSourceStopCalled && eventSourceStopCalled
 	}, time.Second, 100*time.Millisecond)
 }
+
+func TestControlPlaneHandleDoesNotModifySharedEventData(t *testing.T) {
+	esm := &fake.EventSourceMock{
+		SenderFn: func() types.EventSender { return func(ce models.KeptnContextExtendedCE) error { return nil } },
+	}
+	controlPlane := New(&fake.SubscriptionSourceMock{}, esm, nil)
+	controlPlane.currentSubscriptions = []models.EventSubscription{
+		{ID: "sub-1", Event: "sh.keptn.event.echo.triggered"},
+		{ID: "sub-2", Event: "sh.keptn.event.echo.triggered"},
+	}
+
+	receivedSubscriptionIDs := []string{}
+	integration := ExampleIntegration{
+		OnEventFn: func(ctx context.Context, ce models.KeptnContextExtendedCE) error {
+			data := types.AdditionalSubscriptionData{}
+			require.Nil(t, ce.GetTemporaryData(tmpDataDistributorKey, &data))
+			receivedSubscriptionIDs = append(receivedSubscriptionIDs, data.SubscriptionID)
+			return nil
+		},
+	}
+
+	eventUpdate := &types.EventUpdate{
+		KeptnEvent: models.KeptnContextExtendedCE{
+			ID:   "some-id",
+			Type: strutils.Stringp("sh.keptn.event.echo.triggered"),
+			Data: map[string]interface{}{"project": "my-project"},
+		},
+		MetaData: types.EventUpdateMetaData{Subject: "sh.keptn.event.echo.triggered"},
+	}
+	err := controlPlane.handle(context.TODO(), eventUpdate, integration)
+	require.Nil(t, err)
+	require.Equal(t, []string{"sub-1", "sub-2"}, receivedSubscriptionIDs)
+	require.Equal(t, map[string]interface{}{"project": "my-project"}, eventUpdate.KeptnEvent.Data)
+}
+
+func TestControlPlaneHandleDropsEventsOfOtherShards(t *testing.T) {
+	esm := &fake.EventSourceMock{
+		SenderFn: func() types.EventSender { return func(ce models.KeptnContextExtendedCE) error { return nil } },
+	}
+	sharder, err := sharding.New(0, 2)
+	require.Nil(t, err)
+	controlPlane := New(&fake.SubscriptionSourceMock{}, esm, nil, WithSharder(sharder))
+	controlPlane.currentSubscriptions = []models.EventSubscription{{ID: "sub-1", Event: "sh.keptn.event.echo.triggered"}}
+
+	receivedProjects := []string{}
+	integration := ExampleIntegration{
+		OnEventFn: func(ctx context.Context, ce models.KeptnContextExtendedCE) error {
+			data := v0_2_0.EventData{}
+			require.Nil(t, ce.DataAs(&data))
+			receivedProjects = append(receivedProjects, data.Project)
+			return nil
+		},
+	}
+
+	ownedProjects := []string{}
+	for i := 0; i < 20; i++ {
+		project := fmt.Sprintf("project-%d", i)
+		if sharder.Owns(project) {
+			ownedProjects = append(ownedProjects, project)
+		}
+		eventUpdate := &types.EventUpdate{
+			KeptnEvent: models.KeptnContextExtendedCE{
+				ID:   "some-id",
+				Type: strutils.Stringp("sh.keptn.event.echo.triggered"),
+				Data: map[string]interface{}{"project": project},
+			},
+			MetaData: types.EventUpdateMetaData{Subject: "sh.keptn.event.echo.triggered"},
+		}
+		require.Nil(t, controlPlane.handle(context.TODO(), eventUpdate, integration))
+	}
+	require.NotEmpty(t, ownedProjects)
+	require.Less(t, len(ownedProjects), 20)
+	require.Equal(t, ownedProjects, receivedProjects)
+}
+
+func TestControlPlaneHandleSamplesMatchedEvents(t *testing.T) {
+	esm := &fake.EventSourceMock{
+		SenderFn: func() types.EventSender { return func(ce models.KeptnContextExtendedCE) error { return nil } },
+	}
+	sampler, err := eventmatcher.NewSampler(eventmatcher.SamplingRule{EventType: "sh.keptn.event.status.changed", Every: 3})
+	require.Nil(t, err)
+	controlPlane := New(&fake.SubscriptionSourceMock{}, esm, nil, WithSampler(sampler))
+	controlPlane.currentSubscriptions = []models.EventSubscription{
+		{ID: "sub-1", Event: "sh.keptn.event.status.changed", Filter: models.EventSubscriptionFilter{Projects: []string{"my-project"}}},
+		{ID: "sub-2", Event: "sh.keptn.event.status.changed"},
+	}
+
+	receivedSubscriptionIDs := []string{}
+	integration := ExampleIntegration{
+		OnEventFn: func(ctx context.Context, ce models.KeptnContextExtendedCE) error {
+			data := types.AdditionalSubscriptionData{}
+			require.Nil(t, ce.GetTemporaryData(tmpDataDistributorKey, &data))
+			receivedSubscriptionIDs = append(receivedSubscriptionIDs, data.SubscriptionID)
+			return nil
+		},
+	}
+
+	for _, project := range []string{"my-project", "my-project", "other-project", "other-project", "my-project"} {
+		eventUpdate := &types.EventUpdate{
+			KeptnEvent: models.KeptnContextExtendedCE{
+				ID:   "some-id",
+				Type: strutils.Stringp("sh.keptn.event.status.changed"),
+				Data: map[string]interface{}{"project": project},
+			},
+			MetaData: types.EventUpdateMetaData{Subject: "sh.keptn.event.status.changed"},
+		}
+		require.Nil(t, controlPlane.handle(context.TODO(), eventUpdate, integration))
+	}
+	// the first and the fourth event are processed, each of them by all matching subscriptions
+	require.Equal(t, []string{"sub-1", "sub-2", "sub-2"}, receivedSubscriptionIDs)
+}
+
+func TestControlPlaneHandleRecordsSubscriptionMetrics(t *testing.T) {
+	esm := &fake.EventSourceMock{
+		SenderFn: func() types.EventSender { return func(ce models.KeptnContextExtendedCE) error { return nil } },
+	}
+	collector := metrics.NewCollector()
+	controlPlane := New(&fake.SubscriptionSourceMock{}, esm, nil, WithMetrics(collector))
+	controlPlane.currentSubscriptions = []models.EventSubscription{
+		{ID: "sub-1", Event: "sh.keptn.event.echo.triggered", Filter: models.EventSubscriptionFilter{Projects: []string{"my-project"}}},
+		{ID: "sub-2", Event: "sh.keptn.event.echo.triggered", Filter: models.EventSubscriptionFilter{Projects: []string{"misspelled-project"}}},
+		{ID: "sub-3", Event: "sh.keptn.event.other.triggered"},
+	}
+	integration := ExampleIntegration{
+		OnEventFn: func(ctx context.Context, ce models.KeptnContextExtendedCE) error {
+			data := v0_2_0.EventData{}
+			require.Nil(t, ce.DataAs(&data))
+			if data.Stage == "failing-stage" {
+				return fmt.Errorf("could not handle event")
+			}
+			return nil
+		},
+	}
+
+	for _, data := range []interface{}{
+		map[string]interface{}{"project": "my-project"},
+		map[string]interface{}{"project": "my-project", "stage": "failing-stage"},
+		map[string]interface{}{"project": "other-project"},
+		"not-decodable",
+	} {
+		eventUpdate := &types.EventUpdate{
+			KeptnEvent: models.KeptnContextExtendedCE{
+				ID:   "some-id",
+				Type: strutils.Stringp("sh.keptn.event.echo.triggered"),
+				Data: data,
+			},
+			MetaData: types.EventUpdateMetaData{Subject: "sh.keptn.event.echo.triggered"},
+		}
+		require.Nil(t, controlPlane.handle(context.TODO(), eventUpdate, integration))
+	}
+
+	require.Equal(t, map[string]metrics.Counts{
+		"sub-1": {Received: 4, Matched: 2, Filtered: 1, Dropped: 1, Handled: 1, Failed: 1},
+		"sub-2": {Received: 4, Filtered: 3, Dropped: 1},
+	}, controlPlane.SubscriptionMetrics())
+}
+
+func TestControlPlaneHandlePassesIdentifiersInContext(t *testing.T) {
+	esm := &fake.EventSourceMock{
+		SenderFn: func() types.EventSender { return func(ce models.KeptnContextExtendedCE) error { return nil } },
+	}
+	controlPlane := New(&fake.SubscriptionSourceMock{}, esm, nil)
+	controlPlane.currentSubscriptions = []models.EventSubscription{{ID: "sub-1", Event: "sh.keptn.event.echo.triggered"}}
+
+	var identifiers contextutils.Identifiers
+	integration := ExampleIntegration{
+		OnEventFn: func(ctx context.Context, ce models.KeptnContextExtendedCE) error {
+			identifiers = contextutils.IdentifiersFromContext(ctx)
+			return nil
+		},
+	}
+	eventUpdate := &types.EventUpdate{
+		KeptnEvent: models.KeptnContextExtendedCE{
+			ID:             "some-id",
+			Shkeptncontext: "my-context",
+			Type:           strutils.Stringp("sh.keptn.event.echo.triggered"),
+			Data:           map[string]interface{}{"project": "my-project", "stage": "my-stage", "service": "my-service"},
+		},
+		MetaData: types.EventUpdateMetaData{Subject: "sh.keptn.event.echo.triggered"},
+	}
+	require.Nil(t, controlPlane.handle(context.TODO(), eventUpdate, integration))
+	require.Equal(t, contextutils.Identifiers{KeptnContext: "my-context", Project: "my-project", Stage: "my-stage", Service: "my-service"}, identifiers)
+}
+
+func TestControlPlaneHandleRejectsIncompatibleSpecVersions(t *testing.T) {
+	sentEvents := []models.KeptnContextExtendedCE{}
+	esm := &fake.EventSourceMock{
+		SenderFn: func() types.EventSender {
+			return func(ce models.KeptnContextExtendedCE) error {
+				sentEvents = append(sentEvents, ce)
+				return nil
+			}
+		},
+	}
+	checker := specversion.NewChecker(specversion.DefaultCompatibility, specversion.Reject)
+	controlPlane := New(&fake.SubscriptionSourceMock{}, esm, nil, WithSpecVersionChecker(checker))
+	controlPlane.currentSubscriptions = []models.EventSubscription{{ID: "sub-1", Event: "sh.keptn.event.echo.triggered"}}
+
+	var sendErrors []error
+	integration := ExampleIntegration{
+		OnEventFn: func(ctx context.Context, ce models.KeptnContextExtendedCE) error {
+			sender := ctx.Value(types.EventSenderKey).(types.EventSender)
+			sendErrors = append(sendErrors, sender(models.KeptnContextExtendedCE{ID: "compatible", Specversion: "1.0", Shkeptnspecversion: "0.2.4"}))
+			sendErrors = append(sendErrors, sender(models.KeptnContextExtendedCE{ID: "incompatible", Specversion: "1.0", Shkeptnspecversion: "0.3.0"}))
+			return nil
+		},
+	}
+
+	for _, keptnSpecVersion := range []string{"0.2.4", "0.3.0"} {
+		eventUpdate := &types.EventUpdate{
+			KeptnEvent: models.KeptnContextExtendedCE{
+				ID:                 "some-id",
+				Specversion:        "1.0",
+				Shkeptnspecversion: keptnSpecVersion,
+				Type:               strutils.Stringp("sh.keptn.event.echo.triggered"),
+				Data:               map[string]interface{}{"project": "my-project"},
+			},
+			MetaData: types.EventUpdateMetaData{Subject: "sh.keptn.event.echo.triggered"},
+		}
+		require.Nil(t, controlPlane.handle(context.TODO(), eventUpdate, integration))
+	}
+
+	// only the compatible event is handled, and only the compatible event it sends is sent
+	require.Len(t, sendErrors, 2)
+	require.Nil(t, sendErrors[0])
+	require.ErrorIs(t, sendErrors[1], specversion.ErrIncompatible)
+	require.Len(t, sentEvents, 1)
+	require.Equal(t, "compatible", sentEvents[0].ID)
+	require.Equal(t, metrics.Counts{Received: 2, Matched: 1, Dropped: 1, Handled: 1}, controlPlane.SubscriptionMetrics()["sub-1"])
+}
+
+func TestControlPlaneHandleShardedReplicasHandleEachEventOnce(t *testing.T) {
+	const replicas = 3
+	handled := map[string]int{}
+	controlPlanes := []*ControlPlane{}
+	for i := 0; i < replicas; i++ {
+		esm := &fake.EventSourceMock{
+			SenderFn: func() types.EventSender { return func(ce models.KeptnContextExtendedCE) error { return nil } },
+		}
+		sharder, err := sharding.New(i, replicas)
+		require.Nil(t, err)
+		controlPlane := New(&fake.SubscriptionSourceMock{}, esm, nil, WithSharder(sharder))
+		controlPlane.currentSubscriptions = []models.EventSubscription{{ID: "sub-1", Event: "sh.keptn.event.echo.triggered"}}
+		controlPlanes = append(controlPlanes, controlPlane)
+	}
+	integration := ExampleIntegration{
+		OnEventFn: func(ctx context.Context, ce models.KeptnContextExtendedCE) error {
+			data := v0_2_0.EventData{}
+			require.Nil(t, ce.DataAs(&data))
+			handled[data.Project]++
+			return nil
+		},
+	}
+
+	// without a queue group, every replica receives every event
+	for i := 0; i < 50; i++ {
+		for _, controlPlane := range controlPlanes {
+			eventUpdate := &types.EventUpdate{
+				KeptnEvent: models.KeptnContextExtendedCE{
+					ID:   fmt.Sprintf("id-%d", i),
+					Type: strutils.Stringp("sh.keptn.event.echo.triggered"),
+					Data: map[string]interface{}{"project": fmt.Sprintf("project-%d", i)},
+				},
+				MetaData: types.EventUpdateMetaData{Subject: "sh.keptn.event.echo.triggered"},
+			}
+			require.Nil(t, controlPlane.handle(context.TODO(), eventUpdate, integration))
+		}
+	}
+	require.Len(t, handled, 50)
+	for project, count := range handled {
+		require.Equal(t, 1, count, "event of project %s has been handled %d times", project, count)
+	}
+}
+
+// BenchmarkControlPlaneHandle measures the handling of 10k events, i.e. the volume
+// an integration is expected to process per minute
+func BenchmarkControlPlaneHandle(b *testing.B) {
+	esm := &fake.EventSourceMock{
+		SenderFn: func() types.EventSender { return func(ce models.KeptnContextExtendedCE) error { return nil } },
+	}
+	controlPlane := New(&fake.SubscriptionSourceMock{}, esm, nil)
+	controlPlane.currentSubscriptions = []models.EventSubscription{
+		{ID: "sub-1", Event: "sh.keptn.event.echo.triggered", Filter: models.EventSubscriptionFilter{Projects: []string{"my-project"}}},
+		{ID: "sub-2", Event: "sh.keptn.event.echo.triggered", Filter: models.EventSubscriptionFilter{Stages: []string{"my-stage"}}},
+		{ID: "sub-3", Event: "sh.keptn.event.echo.triggered", Filter: models.EventSubscriptionFilter{Services: []string{"other-service"}}},
+	}
+	integration := ExampleIntegration{
+		OnEventFn: func(ctx context.Context, ce models.KeptnContextExtendedCE) error { return nil },
+	}
+
+	const eventsPerMinute = 10000
+	eventUpdates := make([]types.EventUpdate, eventsPerMinute)
+	for i := range eventUpdates {
+		eventUpdates[i] = types.EventUpdate{
+			KeptnEvent: models.KeptnContextExtendedCE{
+				ID:   fmt.Sprintf("id-%d", i),
+				Type: strutils.Stringp("sh.keptn.event.echo.triggered"),
+				Data: map[string]interface{}{"project": "my-project", "stage": "my-stage", "service": "my-service"},
+			},
+			MetaData: types.EventUpdateMetaData{Subject: "sh.keptn.event.echo.triggered"},
+		}
+	}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		for j := range eventUpdates {
+			if err := controlPlane.handle(context.TODO(), &eventUpdates[j], integration); err != nil {
+				b.Fatal(err)
+			}
+		}
+	}
+}
+
+func TestControlPlane_StopFailureIsReturned(t *testing.T) {
+	var errorC chan error
+	var eventSourceStopCalled bool
+	mtx := sync.RWMutex{}
+
+	ssm := &fake.SubscriptionSourceMock{
+		StartFn: func(ctx context.Context, data types.RegistrationData, subC chan []models.EventSubscription, errC chan error, wg *sync.WaitGroup) error {
+			mtx.Lock()
+			defer mtx.Unlock()
+			errorC = errC
+			return nil
+		},
+		RegisterFn: func(integration models.Integration) (string, error) { return "some-id", nil },
+		StopFn:     func() error { return fmt.Errorf("connection lost") },
+	}
+	esm := &fake.EventSourceMock{
+		StartFn: func(ctx context.Context, data types.RegistrationData, evC chan types.EventUpdate, errC chan error, wg *sync.WaitGroup) error {
+			return nil
+		},
+		OnSubscriptionUpdateFn: func(subscriptions []models.EventSubscription) {},
+		SenderFn:               func() types.EventSender { return func(ce models.KeptnContextExtendedCE) error { return nil } },
+		StopFn: func() error {
+			mtx.Lock()
+			defer mtx.Unlock()
+			eventSourceStopCalled = true
+			return nil
+		},
+	}
+	controlPlane := New(ssm, esm, &LogForwarderMock{})
+	integration := ExampleIntegration{
+		RegistrationDataFn: func() types.RegistrationData { return types.RegistrationData{} },
+		OnEventFn:          func(ctx context.Context, ce models.KeptnContextExtendedCE) error { return nil },
+	}
+
+	result := make(chan error, 1)
+	go func() { result <- controlPlane.Register(context.TODO(), integration) }()
+	require.Eventually(t, func() bool {
+		mtx.RLock()
+		defer mtx.RUnlock()
+		return errorC != nil
+	}, time.Second, 10*time.Millisecond)
+	errorC <- fmt.Errorf("some-error")
+
+	select {
+	case err := <-result:
+		require.EqualError(t, err, "unable to stop subscription source: connection lost")
+	case <-time.After(time.Second):
+		t.Fatal("control plane did not stop")
+	}
+	mtx.RLock()
+	defer mtx.RUnlock()
+	require.True(t, eventSourceStopCalled)
+	require.False(t, controlPlane.IsRegistered())
+}
+
+func TestControlPlane_Registration(t *testing.T) {
+	ssm := &fake.SubscriptionSourceMock{
+		RegisterFn: func(integration models.Integration) (string, error) {
+			return "some-id", nil
+		},
+	}
+	esm := &fake.EventSourceMock{
+		StartFn: func(ctx context.Context, data types.RegistrationData, ces chan types.EventUpdate, errC chan error, wg *sync.WaitGroup) error {
+			return fmt.Errorf("error occured")
+		}}
+	controlPlane := New(ssm, esm, &LogForwarderMock{})
+
+	_, registered := controlPlane.Registration()
+	require.False(t, registered)
+
+	integration := ExampleIntegration{RegistrationDataFn: func() types.RegistrationData {
+		return types.RegistrationData{Name: "my-service", MetaData: models.MetaData{IntegrationVersion: "1.0.0"}}
+	}}
+	require.Error(t, controlPlane.Register(context.TODO(), integration))
+
+	registrationData, registered := controlPlane.Registration()
+	require.True(t, registered)
+	require.Equal(t, "some-id", registrationData.ID)
+	require.Equal(t, "my-service", registrationData.Name)
+	require.Equal(t, "1.0.0", registrationData.MetaData.IntegrationVersion)
+}
@@ -33,7 +33,13 @@ func (ef EventMatcher) Matches(e models.KeptnContextExtendedCE) bool {
 	if err := e.DataAs(generalEventData); err != nil {
 		return false
 	}
+	return ef.MatchesEventData(*generalEventData)
+}
 
+// MatchesEventData checks whether already decoded event data matches the information of the
+// currently configured EventMatcher. This avoids decoding the event data again when the same event
+// is checked against multiple subscriptions
+func (ef EventMatcher) MatchesEventData(generalEventData v0_2_0.EventData) bool {
 	if ef.Project != "" && !sliceutils.ContainsStr(strings.Split(ef.Project, ","), generalEventData.Project) ||
 		ef.Stage != "" && !sliceutils.ContainsStr(strings.Split(ef.Stage, ","), generalEventData.Stage) ||
 		ef.Service != "" && !sliceutils.ContainsStr(strings.Split(ef.Service, ","), generalEventData.Service) {
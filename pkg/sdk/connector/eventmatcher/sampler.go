@@ -0,0 +1,121 @@
+package eventmatcher
+
+import (
+	"fmt"
+	"math/rand"
+	"strings"
+	"sync"
+	"time"
+)
+
+// SamplingRule bounds the rate at which events of a type are processed.
+// All configured mechanisms are applied, i.e. an event has to pass all of them to be processed
+type SamplingRule struct {
+	// EventType is the type of the events the rule applies to, e.g. sh.keptn.event.status.changed.
+	// A trailing * matches all types with the given prefix, e.g. sh.keptn.event.*
+	EventType string
+	// Every processes only every n-th event of the type. Values <= 1 disable rate sampling
+	Every int
+	// Probability is the probability in (0,1) with which an event of the type is processed.
+	// Values <= 0 or >= 1 disable probabilistic sampling
+	Probability float64
+	// Limit is the maximum number of events of the type processed per Interval. 0 disables throttling
+	Limit int
+	// Interval is the time window of Limit
+	Interval time.Duration
+}
+
+func (r SamplingRule) matches(eventType string) bool {
+	if strings.HasSuffix(r.EventType, "*") {
+		return strings.HasPrefix(eventType, strings.TrimSuffix(r.EventType, "*"))
+	}
+	return r.EventType == eventType
+}
+
+// samplingState is the state of a rule for a single event type
+type samplingState struct {
+	seen        int
+	windowStart time.Time
+	inWindow    int
+}
+
+// Sampler decides whether events are processed according to a list of SamplingRules.
+// The state of each rule is kept per event type, so a rule with a wildcard throttles every matching type on its own
+type Sampler struct {
+	rules  []SamplingRule
+	states map[string]*samplingState
+	mtx    sync.Mutex
+	now    func() time.Time
+	random func() float64
+}
+
+// NewSampler creates a new Sampler for the given rules. For an event type matched by multiple rules, the first one is used
+func NewSampler(rules ...SamplingRule) (*Sampler, error) {
+	for _, r := range rules {
+		if r.EventType == "" {
+			return nil, fmt.Errorf("sampling rule must have an event type")
+		}
+		if strings.Contains(strings.TrimSuffix(r.EventType, "*"), "*") {
+			return nil, fmt.Errorf("event type %s of sampling rule may only contain a trailing wildcard", r.EventType)
+		}
+		if r.Limit < 0 {
+			return nil, fmt.Errorf("limit of sampling rule for %s must not be negative, but is %d", r.EventType, r.Limit)
+		}
+		if r.Limit > 0 && r.Interval <= 0 {
+			return nil, fmt.Errorf("sampling rule for %s has a limit, but no interval", r.EventType)
+		}
+	}
+	return &Sampler{
+		rules:  rules,
+		states: map[string]*samplingState{},
+		now:    time.Now,
+		random: rand.Float64,
+	}, nil
+}
+
+// Allow returns whether an event of the given type is processed. Events of types without a rule are always processed
+func (s *Sampler) Allow(eventType string) bool {
+	rule, ok := s.rule(eventType)
+	if !ok {
+		return true
+	}
+
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	state, ok := s.states[eventType]
+	if !ok {
+		state = &samplingState{}
+		s.states[eventType] = state
+	}
+
+	if rule.Every > 1 {
+		state.seen++
+		if (state.seen-1)%rule.Every != 0 {
+			return false
+		}
+	}
+	if rule.Probability > 0 && rule.Probability < 1 && s.random() >= rule.Probability {
+		return false
+	}
+	if rule.Limit > 0 {
+		now := s.now()
+		if now.Sub(state.windowStart) >= rule.Interval {
+			state.windowStart = now
+			state.inWindow = 0
+		}
+		if state.inWindow >= rule.Limit {
+			return false
+		}
+		state.inWindow++
+	}
+	return true
+}
+
+func (s *Sampler) rule(eventType string) (SamplingRule, bool) {
+	for _, r := range s.rules {
+		if r.matches(eventType) {
+			return r, true
+		}
+	}
+	return SamplingRule{}, false
+}
@@ -0,0 +1,79 @@
+package eventmatcher
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+const statusChanged = "sh.keptn.event.status.changed"
+
+func allowed(s *Sampler, eventType string, n int) int {
+	count := 0
+	for i := 0; i < n; i++ {
+		if s.Allow(eventType) {
+			count++
+		}
+	}
+	return count
+}
+
+func TestSampler_Every(t *testing.T) {
+	s, err := NewSampler(SamplingRule{EventType: statusChanged, Every: 5})
+	require.Nil(t, err)
+	require.True(t, s.Allow(statusChanged))
+	require.Equal(t, 0, allowed(s, statusChanged, 4))
+	require.Equal(t, 2, allowed(s, statusChanged, 10))
+	// types without rule are not sampled
+	require.Equal(t, 10, allowed(s, "sh.keptn.event.echo.triggered", 10))
+}
+
+func TestSampler_Probability(t *testing.T) {
+	s, err := NewSampler(SamplingRule{EventType: statusChanged, Probability: 0.25})
+	require.Nil(t, err)
+	values := []float64{0.1, 0.3, 0.24, 0.25}
+	s.random = func() float64 {
+		v := values[0]
+		values = values[1:]
+		return v
+	}
+	require.Equal(t, 2, allowed(s, statusChanged, 4))
+}
+
+func TestSampler_Throttling(t *testing.T) {
+	now := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	s, err := NewSampler(SamplingRule{EventType: "sh.keptn.event.*", Limit: 3, Interval: time.Minute})
+	require.Nil(t, err)
+	s.now = func() time.Time { return now }
+
+	require.Equal(t, 3, allowed(s, statusChanged, 10))
+	// the limit applies per event type
+	require.Equal(t, 3, allowed(s, "sh.keptn.event.echo.triggered", 10))
+
+	now = now.Add(30 * time.Second)
+	require.Equal(t, 0, allowed(s, statusChanged, 10))
+	now = now.Add(30 * time.Second)
+	require.Equal(t, 3, allowed(s, statusChanged, 10))
+}
+
+func TestSampler_FirstMatchingRuleIsUsed(t *testing.T) {
+	s, err := NewSampler(
+		SamplingRule{EventType: statusChanged, Every: 2},
+		SamplingRule{EventType: "sh.keptn.*", Every: 10},
+	)
+	require.Nil(t, err)
+	require.Equal(t, 5, allowed(s, statusChanged, 10))
+	require.Equal(t, 1, allowed(s, "sh.keptn.event.echo.triggered", 10))
+}
+
+func TestNewSampler_InvalidRules(t *testing.T) {
+	_, err := NewSampler(SamplingRule{Every: 2})
+	require.EqualError(t, err, "sampling rule must have an event type")
+	_, err = NewSampler(SamplingRule{EventType: "sh.keptn.*.triggered"})
+	require.EqualError(t, err, "event type sh.keptn.*.triggered of sampling rule may only contain a trailing wildcard")
+	_, err = NewSampler(SamplingRule{EventType: statusChanged, Limit: -1})
+	require.EqualError(t, err, "limit of sampling rule for sh.keptn.event.status.changed must not be negative, but is -1")
+	_, err = NewSampler(SamplingRule{EventType: statusChanged, Limit: 1})
+	require.EqualError(t, err, "sampling rule for sh.keptn.event.status.changed has a limit, but no interval")
+}
@@ -0,0 +1,69 @@
+package eventsource
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/benbjohnson/clock"
+	eventsourcehttp "github.com/keptn/go-utils/pkg/sdk/connector/eventsource/http"
+	eventsourcenats "github.com/keptn/go-utils/pkg/sdk/connector/eventsource/nats"
+	"github.com/keptn/go-utils/pkg/sdk/connector/logger"
+	"github.com/keptn/go-utils/pkg/sdk/connector/nats"
+	sdkapi "github.com/keptn/go-utils/pkg/sdk/internal/api"
+	"github.com/keptn/go-utils/pkg/sdk/internal/config"
+)
+
+// Environment variables configuring the HTTP event source created by NewEventSourceFromEnv
+const (
+	// EnvVarPollingInterval is the interval between polling the Keptn API for new events, e.g. "5s"
+	EnvVarPollingInterval = "KEPTN_DISTRIBUTOR_POLLING_INTERVAL"
+	// EnvVarMaxPollingAttempts is the number of consecutive failed polls after which the event source stops
+	EnvVarMaxPollingAttempts = "KEPTN_DISTRIBUTOR_MAX_POLLING_ATTEMPTS"
+)
+
+// NewEventSourceFromEnv creates the EventSource matching the environment the integration is running in.
+// The environment is read like by NewKeptn: if KEPTN_API_ENDPOINT is set, the integration runs on a remote execution
+// plane and an HTTP event source polling the Keptn API is returned. It connects with KEPTN_API_TOKEN or via OAuth if
+// the OAUTH_* variables are set, and verifies the certificate of the Keptn API unless HTTP_SSL_VERIFY is false.
+// Otherwise, a NATS event source connecting to EVENTBROKER is returned.
+// If logger is nil, the default logger is used
+func NewEventSourceFromEnv(log logger.Logger) (EventSource, error) {
+	if log == nil {
+		log = logger.NewDefaultLogger()
+	}
+	env, err := config.NewEnvConfig()
+	if err != nil {
+		return nil, fmt.Errorf("unable to create event source: %w", err)
+	}
+	if env.PubSubConnectionType() == config.ConnectionTypeNATS {
+		return eventsourcenats.New(nats.New(env.EventBrokerURL, nats.WithLogger(log)), eventsourcenats.WithLogger(log)), nil
+	}
+
+	httpClient, err := sdkapi.CreateClientGetter(env).Get()
+	if err != nil {
+		return nil, fmt.Errorf("unable to create event source: could not initialize http client: %w", err)
+	}
+	keptnAPI, err := sdkapi.CreateKeptnAPI(httpClient, env)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create event source: %w", err)
+	}
+	opts := []func(*eventsourcehttp.HTTPEventSource){eventsourcehttp.WithLogger(log)}
+	if value := os.Getenv(EnvVarPollingInterval); value != "" {
+		interval, err := time.ParseDuration(value)
+		if err != nil || interval <= 0 {
+			return nil, fmt.Errorf("unable to create event source: environment variable %s must be a positive duration like 5s, but is %s", EnvVarPollingInterval, value)
+		}
+		opts = append(opts, eventsourcehttp.WithPollingInterval(interval))
+	}
+	if value := os.Getenv(EnvVarMaxPollingAttempts); value != "" {
+		attempts, err := strconv.Atoi(value)
+		if err != nil || attempts <= 0 {
+			return nil, fmt.Errorf("unable to create event source: environment variable %s must be a positive number, but is %s", EnvVarMaxPollingAttempts, value)
+		}
+		opts = append(opts, eventsourcehttp.WithMaxPollingAttempts(attempts))
+	}
+	eventAPI := eventsourcehttp.NewEventAPI(keptnAPI.ShipyardControlV1(), keptnAPI.APIV1(), eventsourcehttp.WithLog(log))
+	return eventsourcehttp.New(clock.New(), eventAPI, opts...), nil
+}
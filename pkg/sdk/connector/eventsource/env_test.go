@@ -0,0 +1,46 @@
+package eventsource
+
+import (
+	"testing"
+
+	v2 "github.com/keptn/go-utils/pkg/api/utils/v2"
+	eventsourcehttp "github.com/keptn/go-utils/pkg/sdk/connector/eventsource/http"
+	eventsourcenats "github.com/keptn/go-utils/pkg/sdk/connector/eventsource/nats"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewEventSourceFromEnv(t *testing.T) {
+	t.Setenv(v2.EnvVarKeptnAPIEndpoint, "")
+	eventSource, err := NewEventSourceFromEnv(nil)
+	require.Nil(t, err)
+	require.IsType(t, &eventsourcenats.NATSEventSource{}, eventSource)
+
+	t.Setenv(v2.EnvVarKeptnAPIEndpoint, "https://keptn.example.com/api")
+	t.Setenv(EnvVarPollingInterval, "5s")
+	t.Setenv(EnvVarMaxPollingAttempts, "3")
+	eventSource, err = NewEventSourceFromEnv(nil)
+	require.Nil(t, err)
+	require.IsType(t, &eventsourcehttp.HTTPEventSource{}, eventSource)
+}
+
+func TestNewEventSourceFromEnv_InvalidConfiguration(t *testing.T) {
+	tests := []struct {
+		name    string
+		envVars map[string]string
+	}{
+		{name: "invalid endpoint", envVars: map[string]string{v2.EnvVarKeptnAPIEndpoint: "keptn.example.com"}},
+		{name: "invalid polling interval", envVars: map[string]string{v2.EnvVarKeptnAPIEndpoint: "https://keptn.example.com/api", EnvVarPollingInterval: "5"}},
+		{name: "negative polling interval", envVars: map[string]string{v2.EnvVarKeptnAPIEndpoint: "https://keptn.example.com/api", EnvVarPollingInterval: "-5s"}},
+		{name: "invalid ssl verification flag", envVars: map[string]string{v2.EnvVarKeptnAPIEndpoint: "https://keptn.example.com/api", "HTTP_SSL_VERIFY": "maybe"}},
+		{name: "invalid max polling attempts", envVars: map[string]string{v2.EnvVarKeptnAPIEndpoint: "https://keptn.example.com/api", EnvVarMaxPollingAttempts: "many"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for key, value := range tt.envVars {
+				t.Setenv(key, value)
+			}
+			_, err := NewEventSourceFromEnv(nil)
+			require.NotNil(t, err)
+		})
+	}
+}
@@ -6,7 +6,9 @@ import (
 	"github.com/benbjohnson/clock"
 	"github.com/keptn/go-utils/pkg/api/models"
 	api "github.com/keptn/go-utils/pkg/api/utils"
+	v2 "github.com/keptn/go-utils/pkg/api/utils/v2"
 	"github.com/keptn/go-utils/pkg/sdk/connector/logger"
+	"github.com/keptn/go-utils/pkg/sdk/connector/metrics"
 	"github.com/keptn/go-utils/pkg/sdk/connector/types"
 	"sync"
 	"time"
@@ -39,6 +41,25 @@ func WithPollingInterval(interval time.Duration) func(plane *HTTPEventSource) {
 	}
 }
 
+// WithDeltaPolling lets the HTTPEventSource only request events newer than the newest event it has seen for a subscription,
+// minus the given overlap window. If overlap is not positive, v2.DefaultDeltaOverlap is used
+func WithDeltaPolling(overlap time.Duration) func(plane *HTTPEventSource) {
+	return func(ns *HTTPEventSource) {
+		if overlap <= 0 {
+			overlap = v2.DefaultDeltaOverlap
+		}
+		ns.deltaOverlap = overlap
+	}
+}
+
+// WithMetrics sets the Collector recording events which are skipped because they have already been received for a subscription.
+// Usually, this is the same Collector as the one passed to the ControlPlane
+func WithMetrics(collector *metrics.Collector) func(plane *HTTPEventSource) {
+	return func(ns *HTTPEventSource) {
+		ns.metrics = collector
+	}
+}
+
 // New creates a new HTTPEventSource to be used for running a service on the remote execution plane
 func New(clock clock.Clock, eventGetSender EventAPI, opts ...func(source *HTTPEventSource)) *HTTPEventSource {
 	e := &HTTPEventSource{
@@ -51,6 +72,7 @@ func New(clock clock.Clock, eventGetSender EventAPI, opts ...func(source *HTTPEv
 		quitC:                make(chan struct{}, 1),
 		cache:                NewCache(),
 		logger:               logger.NewDefaultLogger(),
+		deltas:               map[string]*deltaState{},
 	}
 	for _, o := range opts {
 		o(e)
@@ -68,6 +90,15 @@ type HTTPEventSource struct {
 	quitC                chan struct{}
 	cache                *cache
 	logger               logger.Logger
+	deltaOverlap         time.Duration
+	deltas               map[string]*deltaState
+	metrics              *metrics.Collector
+}
+
+// deltaState tracks the newest event seen for the event filter of a subscription
+type deltaState struct {
+	filter  api.EventFilter
+	tracker *v2.EventDeltaTracker
 }
 
 func (hes *HTTPEventSource) Start(ctx context.Context, data types.RegistrationData, updates chan types.EventUpdate, errChan chan error, wg *sync.WaitGroup) error {
@@ -105,6 +136,20 @@ func (hes *HTTPEventSource) OnSubscriptionUpdate(subscriptions []models.EventSub
 	hes.mutex.Lock()
 	defer hes.mutex.Unlock()
 	hes.currentSubscriptions = subscriptions
+
+	// forget the newest seen events of subscriptions which have been removed or whose filter has changed
+	for id, delta := range hes.deltas {
+		keep := false
+		for _, sub := range subscriptions {
+			if sub.ID == id && getEventFilterForSubscription(sub) == delta.filter {
+				keep = true
+				break
+			}
+		}
+		if !keep {
+			delete(hes.deltas, id)
+		}
+	}
 }
 
 func (hes *HTTPEventSource) Sender() types.EventSender {
@@ -121,13 +166,22 @@ func (hes *HTTPEventSource) doPoll(eventUpdates chan types.EventUpdate) error {
 	subscriptions := hes.currentSubscriptions
 	hes.mutex.Unlock()
 	for _, sub := range subscriptions {
-		events, err := hes.eventAPI.Get(getEventFilterForSubscription(sub))
+		filter := getEventFilterForSubscription(sub)
+		tracker := hes.deltaTracker(sub.ID, filter)
+		if tracker != nil {
+			filter.FromTime = tracker.FromTime()
+		}
+		events, err := hes.eventAPI.Get(filter)
 		if err != nil {
 			hes.logger.Warnf("Could not retrieve events of type %s: %s", sub.Event, err)
 			return err
 		}
+		if tracker != nil {
+			events = tracker.Filter(events)
+		}
 		for _, e := range events {
 			if hes.cache.contains(sub.ID, e.ID) {
+				hes.metrics.Record(sub.ID, sub.Event, metrics.Deduplicated)
 				continue
 			}
 			eventUpdates <- types.EventUpdate{
@@ -140,6 +194,21 @@ func (hes *HTTPEventSource) doPoll(eventUpdates chan types.EventUpdate) error {
 	return nil
 }
 
+// deltaTracker returns the tracker of the newest seen event for the given subscription, or nil if delta polling is disabled
+func (hes *HTTPEventSource) deltaTracker(subscriptionID string, filter api.EventFilter) *v2.EventDeltaTracker {
+	if hes.deltaOverlap <= 0 {
+		return nil
+	}
+	hes.mutex.Lock()
+	defer hes.mutex.Unlock()
+	delta, ok := hes.deltas[subscriptionID]
+	if !ok || delta.filter != filter {
+		delta = &deltaState{filter: filter, tracker: v2.NewEventDeltaTracker(hes.deltaOverlap)}
+		hes.deltas[subscriptionID] = delta
+	}
+	return delta.tracker
+}
+
 // getEventFilterForSubscription returns the event filter for the subscription
 // Per default, it only sets the event type of the subscription.
 // If exactly one project, stage or service is specified respectively, they are included in the filter.
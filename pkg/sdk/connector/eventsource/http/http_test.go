@@ -9,6 +9,7 @@ import (
 	"github.com/keptn/go-utils/pkg/common/strutils"
 	"github.com/keptn/go-utils/pkg/lib/v0_2_0"
 	"github.com/keptn/go-utils/pkg/sdk/connector/eventsource/http/fake"
+	"github.com/keptn/go-utils/pkg/sdk/connector/metrics"
 	"github.com/keptn/go-utils/pkg/sdk/connector/types"
 	"github.com/stretchr/testify/require"
 	"sync"
@@ -138,7 +139,8 @@ func TestAPIPassEventOnlyOnce(t *testing.T) {
 	}
 	mtx := sync.RWMutex{}
 	clock := clock.NewMock()
-	eventsource := New(clock, eventGetSender)
+	collector := metrics.NewCollector()
+	eventsource := New(clock, eventGetSender, WithMetrics(collector))
 	eventChan := make(chan types.EventUpdate)
 
 	err := eventsource.Start(context.TODO(), types.RegistrationData{}, eventChan, make(chan error), &sync.WaitGroup{})
@@ -160,6 +162,8 @@ func TestAPIPassEventOnlyOnce(t *testing.T) {
 	mtx.RLock()
 	defer mtx.RUnlock()
 	require.Equal(t, 1, eventsReceived)
+	counts, _ := collector.Subscription("id1")
+	require.Equal(t, int64(1), counts.Deduplicated)
 }
 
 func TestEventSourceGetSender(t *testing.T) {
@@ -176,3 +180,35 @@ func TestEventSourceGetSender(t *testing.T) {
 	require.True(t, senderCalled)
 
 }
+
+func TestAPIDeltaPolling(t *testing.T) {
+	base := time.Date(2022, 3, 1, 10, 0, 0, 0, time.UTC)
+	filters := make(chan api.EventFilter, 10)
+	eventGetSender := &fake.EventAPIMock{}
+	eventGetSender.GetFunc = func(filter api.EventFilter) ([]*models.KeptnContextExtendedCE, error) {
+		filters <- filter
+		return []*models.KeptnContextExtendedCE{
+			{ID: "id1", Time: base, Type: strutils.Stringp("sh.keptn.event.task.triggered")},
+		}, nil
+	}
+	clock := clock.NewMock()
+	eventsource := New(clock, eventGetSender, WithDeltaPolling(time.Second))
+	eventChan := make(chan types.EventUpdate)
+
+	err := eventsource.Start(context.TODO(), types.RegistrationData{}, eventChan, make(chan error), &sync.WaitGroup{})
+	eventsource.OnSubscriptionUpdate([]models.EventSubscription{{ID: "sub1", Event: "sh.keptn.event.task.triggered"}})
+	require.NoError(t, err)
+	clock.Add(time.Second)
+	<-eventChan
+	require.Equal(t, "", (<-filters).FromTime)
+
+	clock.Add(time.Second)
+	require.Equal(t, "2022-03-01T09:59:59.000Z", (<-filters).FromTime)
+
+	// the newest seen event is forgotten once the filter of the subscription changes
+	eventsource.OnSubscriptionUpdate([]models.EventSubscription{{ID: "sub1", Event: "sh.keptn.event.task.triggered", Filter: models.EventSubscriptionFilter{Projects: []string{"project1"}}}})
+	clock.Add(time.Second)
+	filter := <-filters
+	require.Equal(t, "", filter.FromTime)
+	require.Equal(t, "project1", filter.Project)
+}
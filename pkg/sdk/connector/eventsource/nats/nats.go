@@ -2,7 +2,6 @@ package nats
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 	"github.com/keptn/go-utils/pkg/sdk/connector/types"
 	"reflect"
@@ -22,6 +21,7 @@ type NATSEventSource struct {
 	connector       natseventsource.NATS
 	eventProcessFn  natseventsource.ProcessEventFn
 	queueGroup      string
+	noQueueGroup    bool
 	logger          logger.Logger
 }
 
@@ -46,20 +46,29 @@ func WithLogger(logger logger.Logger) func(*NATSEventSource) {
 	}
 }
 
+// WithoutQueueGroup subscribes without a queue group, so that every instance of the integration receives all events
+// instead of NATS delivering each event to one of them. This is required if the instances select the events they handle
+// themselves, e.g. via a sharding.Sharder
+func WithoutQueueGroup() func(*NATSEventSource) {
+	return func(ns *NATSEventSource) {
+		ns.noQueueGroup = true
+	}
+}
+
 func (n *NATSEventSource) Start(ctx context.Context, registrationData types.RegistrationData, eventChannel chan types.EventUpdate, errChan chan error, wg *sync.WaitGroup) error {
 	n.queueGroup = registrationData.Name
 	n.eventProcessFn = func(event *nats.Msg) error {
-		keptnEvent := models.KeptnContextExtendedCE{}
-		if err := json.Unmarshal(event.Data, &keptnEvent); err != nil {
+		keptnEvent, err := natseventsource.DecodeEvent(event)
+		if err != nil {
 			return fmt.Errorf("could not unmarshal message: %w", err)
 		}
 		eventChannel <- types.EventUpdate{
 			KeptnEvent: keptnEvent,
-			MetaData:   types.EventUpdateMetaData{event.Sub.Subject},
+			MetaData:   types.EventUpdateMetaData{Subject: event.Sub.Subject},
 		}
 		return nil
 	}
-	if err := n.connector.QueueSubscribeMultiple(n.currentSubjects, n.queueGroup, n.eventProcessFn); err != nil {
+	if err := n.subscribe(n.currentSubjects); err != nil {
 		return fmt.Errorf("could not start NATS event source: %w", err)
 	}
 	go func() {
@@ -86,7 +95,7 @@ func (n *NATSEventSource) OnSubscriptionUpdate(subj []models.EventSubscription)
 			return
 		}
 		n.logger.Debugf("Subscribing to %d topics", len(s))
-		if err := n.connector.QueueSubscribeMultiple(s, n.queueGroup, n.eventProcessFn); err != nil {
+		if err := n.subscribe(s); err != nil {
 			n.logger.Errorf("Could not handle subscription update: %v", err)
 			return
 		}
@@ -95,6 +104,13 @@ func (n *NATSEventSource) OnSubscriptionUpdate(subj []models.EventSubscription)
 	}
 }
 
+func (n *NATSEventSource) subscribe(subjects []string) error {
+	if n.noQueueGroup {
+		return n.connector.SubscribeMultiple(subjects, n.eventProcessFn)
+	}
+	return n.connector.QueueSubscribeMultiple(subjects, n.queueGroup, n.eventProcessFn)
+}
+
 func (n *NATSEventSource) Sender() types.EventSender {
 	return n.connector.Publish
 }
@@ -143,6 +143,24 @@ func TestEventSourceForwardsEventToChannel(t *testing.T) {
 	require.Equal(t, eventFromChan.KeptnEvent, event)
 }
 
+func TestEventSourceWithoutQueueGroup(t *testing.T) {
+	subscribedSubjects := []string{}
+	natsConnectorMock := &NATSConnectorMock{
+		SubscribeMultipleFn: func(subjects []string, fn nats2.ProcessEventFn) error {
+			subscribedSubjects = append(subscribedSubjects, subjects...)
+			return nil
+		},
+		UnsubscribeAllFn: func() error { return nil },
+	}
+	wg := &sync.WaitGroup{}
+	wg.Add(1)
+	eventSource := New(natsConnectorMock, WithoutQueueGroup())
+	require.Nil(t, eventSource.Start(context.TODO(), types.RegistrationData{Name: "my-integration"}, make(chan types.EventUpdate), make(chan error), wg))
+	eventSource.OnSubscriptionUpdate([]models.EventSubscription{{Event: "a"}})
+	require.Equal(t, []string{"a"}, subscribedSubjects)
+	require.Equal(t, 0, natsConnectorMock.QueueSubscribeMultipleCalls())
+}
+
 func TestEventSourceCancelDisconnectsFromBroker(t *testing.T) {
 	natsConnectorMock := &NATSConnectorMock{
 		QueueSubscribeMultipleFn: func(subjects []string, queueGroup string, fn nats2.ProcessEventFn) error { return nil },
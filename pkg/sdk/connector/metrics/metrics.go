@@ -0,0 +1,166 @@
+package metrics
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"sync"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/metric/global"
+	"go.opentelemetry.io/otel/metric/instrument"
+	"go.opentelemetry.io/otel/metric/instrument/syncint64"
+)
+
+// instrumentationName is the name of the meter used if no other meter is configured
+const instrumentationName = "github.com/keptn/go-utils/pkg/sdk/connector"
+
+// counterName is the name of the OpenTelemetry counter the outcomes are emitted to
+const counterName = "keptn.subscription.events"
+
+// Outcome describes what happened to an event with respect to a subscription
+type Outcome string
+
+const (
+	// Received is recorded for every event of the type of the subscription
+	Received Outcome = "received"
+	// Matched is recorded for an event matching the filter of the subscription
+	Matched Outcome = "matched"
+	// Filtered is recorded for an event not matching the filter of the subscription
+	Filtered Outcome = "filtered"
+	// Deduplicated is recorded for an event which has already been received for the subscription and is skipped
+	Deduplicated Outcome = "deduplicated"
+	// Dropped is recorded for an event which is not processed, e.g. because it could not be decoded,
+	// belongs to another shard or has been sampled out
+	Dropped Outcome = "dropped"
+	// Handled is recorded for an event the integration has handled successfully
+	Handled Outcome = "handled"
+	// Failed is recorded for an event the integration has failed to handle
+	Failed Outcome = "failed"
+)
+
+// Counts contains the number of events per Outcome for a subscription.
+// A subscription with many received, but no matched events usually has a misconfigured filter
+type Counts struct {
+	Received     int64 `json:"received"`
+	Matched      int64 `json:"matched"`
+	Filtered     int64 `json:"filtered"`
+	Deduplicated int64 `json:"deduplicated"`
+	Dropped      int64 `json:"dropped"`
+	Handled      int64 `json:"handled"`
+	Failed       int64 `json:"failed"`
+}
+
+func (c *Counts) add(outcome Outcome) {
+	switch outcome {
+	case Received:
+		c.Received++
+	case Matched:
+		c.Matched++
+	case Filtered:
+		c.Filtered++
+	case Deduplicated:
+		c.Deduplicated++
+	case Dropped:
+		c.Dropped++
+	case Handled:
+		c.Handled++
+	case Failed:
+		c.Failed++
+	}
+}
+
+// Collector counts the outcomes of events per subscription and emits them as OpenTelemetry counter
+// keptn.subscription.events with the attributes subscription.id, event.type and outcome.
+// The counts are kept for the lifetime of the Collector, i.e. they are not reset when the subscriptions
+// of the integration are updated
+type Collector struct {
+	mtx     sync.RWMutex
+	counts  map[string]*Counts
+	meter   metric.Meter
+	counter syncint64.Counter
+}
+
+// WithMeter sets the OpenTelemetry meter used to emit the counts (default: the meter of the global MeterProvider)
+func WithMeter(meter metric.Meter) func(*Collector) {
+	return func(c *Collector) {
+		c.meter = meter
+	}
+}
+
+// NewCollector creates a new Collector
+func NewCollector(opts ...func(*Collector)) *Collector {
+	c := &Collector{
+		counts: map[string]*Counts{},
+	}
+	for _, o := range opts {
+		o(c)
+	}
+	if c.meter == nil {
+		c.meter = global.Meter(instrumentationName)
+	}
+	// if the counter cannot be created, the counts are still available via the Collector
+	c.counter, _ = c.meter.SyncInt64().Counter(counterName, instrument.WithDescription("Number of events per subscription and outcome"))
+	return c
+}
+
+// Record records the given outcome of an event of the given type for the subscription with the given ID.
+// Recording on a nil Collector does nothing
+func (c *Collector) Record(subscriptionID string, eventType string, outcome Outcome) {
+	if c == nil {
+		return
+	}
+	c.mtx.Lock()
+	counts, ok := c.counts[subscriptionID]
+	if !ok {
+		counts = &Counts{}
+		c.counts[subscriptionID] = counts
+	}
+	counts.add(outcome)
+	c.mtx.Unlock()
+
+	if c.counter != nil {
+		c.counter.Add(context.Background(), 1,
+			attribute.String("subscription.id", subscriptionID),
+			attribute.String("event.type", eventType),
+			attribute.String("outcome", string(outcome)),
+		)
+	}
+}
+
+// Subscription returns the counts of the subscription with the given ID. It returns false if nothing has been recorded for it
+func (c *Collector) Subscription(subscriptionID string) (Counts, bool) {
+	if c == nil {
+		return Counts{}, false
+	}
+	c.mtx.RLock()
+	defer c.mtx.RUnlock()
+	counts, ok := c.counts[subscriptionID]
+	if !ok {
+		return Counts{}, false
+	}
+	return *counts, true
+}
+
+// Subscriptions returns the counts of all subscriptions, keyed by the subscription ID
+func (c *Collector) Subscriptions() map[string]Counts {
+	if c == nil {
+		return map[string]Counts{}
+	}
+	c.mtx.RLock()
+	defer c.mtx.RUnlock()
+	result := make(map[string]Counts, len(c.counts))
+	for id, counts := range c.counts {
+		result[id] = *counts
+	}
+	return result
+}
+
+// ServeHTTP writes the counts of all subscriptions as JSON, so that they can be exposed on a debug endpoint of the integration
+func (c *Collector) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(c.Subscriptions()); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+	}
+}
@@ -0,0 +1,48 @@
+package metrics
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCollector(t *testing.T) {
+	c := NewCollector()
+	c.Record("sub-1", "sh.keptn.event.echo.triggered", Received)
+	c.Record("sub-1", "sh.keptn.event.echo.triggered", Matched)
+	c.Record("sub-1", "sh.keptn.event.echo.triggered", Handled)
+	c.Record("sub-1", "sh.keptn.event.echo.triggered", Received)
+	c.Record("sub-1", "sh.keptn.event.echo.triggered", Filtered)
+	c.Record("sub-2", "sh.keptn.event.echo.triggered", Deduplicated)
+
+	counts, ok := c.Subscription("sub-1")
+	require.True(t, ok)
+	require.Equal(t, Counts{Received: 2, Matched: 1, Filtered: 1, Handled: 1}, counts)
+
+	_, ok = c.Subscription("sub-3")
+	require.False(t, ok)
+
+	require.Equal(t, map[string]Counts{
+		"sub-1": {Received: 2, Matched: 1, Filtered: 1, Handled: 1},
+		"sub-2": {Deduplicated: 1},
+	}, c.Subscriptions())
+}
+
+func TestCollector_Nil(t *testing.T) {
+	var c *Collector
+	c.Record("sub-1", "sh.keptn.event.echo.triggered", Received)
+	require.Empty(t, c.Subscriptions())
+}
+
+func TestCollector_ServeHTTP(t *testing.T) {
+	c := NewCollector()
+	c.Record("sub-1", "sh.keptn.event.echo.triggered", Failed)
+
+	rec := httptest.NewRecorder()
+	c.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/metrics/subscriptions", nil))
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.Equal(t, "application/json", rec.Header().Get("Content-Type"))
+	require.JSONEq(t, `{"sub-1":{"received":0,"matched":0,"filtered":0,"deduplicated":0,"dropped":0,"handled":0,"failed":1}}`, rec.Body.String())
+}
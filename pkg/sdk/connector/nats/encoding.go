@@ -0,0 +1,206 @@
+package nats
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/keptn/go-utils/pkg/api/models"
+	"github.com/keptn/go-utils/pkg/api/models/modelspb"
+	"github.com/nats-io/nats.go"
+	"google.golang.org/protobuf/proto"
+)
+
+// PayloadEncodingHeader is the header of a NATS message carrying the name of the PayloadEncoding of its payload.
+// Messages without the header are JSON encoded, so that they can be read by receivers not supporting other encodings
+const PayloadEncodingHeader = "Keptn-Payload-Encoding"
+
+// AcceptPayloadEncodingsHeader is the header of a NATS message carrying the comma separated names of the
+// PayloadEncodings the publisher of the message is able to decode. It is sent with every published message,
+// so that the receivers learn which encodings they may use for the events they publish
+const AcceptPayloadEncodingsHeader = "Keptn-Accept-Payload-Encodings"
+
+// EnvVarNatsPayloadEncoding is the environment variable read by NewFromEnv to select the PayloadEncoding of published events
+const EnvVarNatsPayloadEncoding = "NATS_PAYLOAD_ENCODING"
+
+// ErrUnknownPayloadEncoding is returned if a message is encoded with a PayloadEncoding which has not been registered
+var ErrUnknownPayloadEncoding = errors.New("unknown payload encoding")
+
+// PayloadEncoding serializes keptn events into the payload of NATS messages and back
+type PayloadEncoding interface {
+	// Name is the name of the encoding, which is sent in the PayloadEncodingHeader
+	Name() string
+	Marshal(event models.KeptnContextExtendedCE) ([]byte, error)
+	Unmarshal(data []byte, event *models.KeptnContextExtendedCE) error
+}
+
+// JSONEncoding encodes events as JSON. It is the default encoding
+var JSONEncoding PayloadEncoding = jsonEncoding{}
+
+// GzipJSONEncoding encodes events as gzip compressed JSON, which reduces the size of large events, e.g. containing test results
+var GzipJSONEncoding PayloadEncoding = gzipJSONEncoding{}
+
+// ProtobufEncoding encodes events as protobuf messages, see modelspb.KeptnContextExtendedCE
+var ProtobufEncoding PayloadEncoding = protobufEncoding{}
+
+var (
+	payloadEncodingsMtx sync.RWMutex
+	payloadEncodings    = map[string]PayloadEncoding{
+		JSONEncoding.Name():     JSONEncoding,
+		GzipJSONEncoding.Name(): GzipJSONEncoding,
+		ProtobufEncoding.Name(): ProtobufEncoding,
+	}
+)
+
+// RegisterPayloadEncoding registers an additional PayloadEncoding, so that messages encoded with it can be decoded.
+// An encoding registered before with the same name is replaced
+func RegisterPayloadEncoding(encoding PayloadEncoding) {
+	payloadEncodingsMtx.Lock()
+	defer payloadEncodingsMtx.Unlock()
+	payloadEncodings[encoding.Name()] = encoding
+}
+
+// GetPayloadEncoding returns the registered PayloadEncoding with the given name
+func GetPayloadEncoding(name string) (PayloadEncoding, error) {
+	payloadEncodingsMtx.RLock()
+	defer payloadEncodingsMtx.RUnlock()
+	encoding, ok := payloadEncodings[name]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrUnknownPayloadEncoding, name)
+	}
+	return encoding, nil
+}
+
+// acceptedPayloadEncodings returns the value of the AcceptPayloadEncodingsHeader, i.e. the names of all registered encodings
+func acceptedPayloadEncodings() string {
+	payloadEncodingsMtx.RLock()
+	defer payloadEncodingsMtx.RUnlock()
+	names := make([]string, 0, len(payloadEncodings))
+	for name := range payloadEncodings {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return strings.Join(names, ",")
+}
+
+// WithPayloadEncoding sets the preferred PayloadEncoding of published events. Since receivers need to have the encoding
+// registered to decode a message, events are published as JSON until a message received by the NatsConnector
+// has advertised support for the encoding in its AcceptPayloadEncodingsHeader
+func WithPayloadEncoding(encoding PayloadEncoding) func(*NatsConnector) {
+	return func(n *NatsConnector) {
+		n.payloadEncoding = encoding
+	}
+}
+
+// DecodeEvent decodes the keptn event contained in the message, using the PayloadEncoding named in its PayloadEncodingHeader
+func DecodeEvent(msg *nats.Msg) (models.KeptnContextExtendedCE, error) {
+	event := models.KeptnContextExtendedCE{}
+	encoding := JSONEncoding
+	if name := msg.Header.Get(PayloadEncodingHeader); name != "" {
+		var err error
+		if encoding, err = GetPayloadEncoding(name); err != nil {
+			return event, err
+		}
+	}
+	if err := encoding.Unmarshal(msg.Data, &event); err != nil {
+		return event, fmt.Errorf("could not decode %s message: %w", encoding.Name(), err)
+	}
+	return event, nil
+}
+
+// encodeEvent creates the message for the event, encoded with the given PayloadEncoding
+func encodeEvent(subject string, event models.KeptnContextExtendedCE, encoding PayloadEncoding) (*nats.Msg, error) {
+	data, err := encoding.Marshal(event)
+	if err != nil {
+		return nil, err
+	}
+	msg := nats.NewMsg(subject)
+	msg.Data = data
+	// JSON messages are sent without the header, so that they can be read by all receivers
+	if encoding.Name() != JSONEncoding.Name() {
+		msg.Header.Set(PayloadEncodingHeader, encoding.Name())
+	}
+	return msg, nil
+}
+
+type jsonEncoding struct{}
+
+func (jsonEncoding) Name() string {
+	return "json"
+}
+
+func (jsonEncoding) Marshal(event models.KeptnContextExtendedCE) ([]byte, error) {
+	return json.Marshal(event)
+}
+
+func (jsonEncoding) Unmarshal(data []byte, event *models.KeptnContextExtendedCE) error {
+	return json.Unmarshal(data, event)
+}
+
+type gzipJSONEncoding struct{}
+
+func (gzipJSONEncoding) Name() string {
+	return "gzip+json"
+}
+
+func (gzipJSONEncoding) Marshal(event models.KeptnContextExtendedCE) ([]byte, error) {
+	data, err := json.Marshal(event)
+	if err != nil {
+		return nil, err
+	}
+	buf := &bytes.Buffer{}
+	writer := gzip.NewWriter(buf)
+	if _, err := writer.Write(data); err != nil {
+		return nil, err
+	}
+	if err := writer.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func (gzipJSONEncoding) Unmarshal(data []byte, event *models.KeptnContextExtendedCE) error {
+	reader, err := gzip.NewReader(bytes.NewReader(data))
+	if err != nil {
+		return err
+	}
+	defer reader.Close()
+	decompressed, err := ioutil.ReadAll(reader)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(decompressed, event)
+}
+
+type protobufEncoding struct{}
+
+func (protobufEncoding) Name() string {
+	return "protobuf"
+}
+
+func (protobufEncoding) Marshal(event models.KeptnContextExtendedCE) ([]byte, error) {
+	message, err := modelspb.FromKeptnContextExtendedCE(&event)
+	if err != nil {
+		return nil, err
+	}
+	return proto.Marshal(message)
+}
+
+func (protobufEncoding) Unmarshal(data []byte, event *models.KeptnContextExtendedCE) error {
+	message := &modelspb.KeptnContextExtendedCE{}
+	if err := proto.Unmarshal(data, message); err != nil {
+		return err
+	}
+	decoded, err := message.ToModel()
+	if err != nil {
+		return err
+	}
+	*event = *decoded
+	return nil
+}
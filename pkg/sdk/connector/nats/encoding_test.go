@@ -0,0 +1,155 @@
+package nats_test
+
+import (
+	"errors"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/keptn/go-utils/pkg/api/models"
+	"github.com/keptn/go-utils/pkg/common/strutils"
+	"github.com/keptn/go-utils/pkg/lib/v0_2_0"
+	nats2 "github.com/keptn/go-utils/pkg/sdk/connector/nats"
+	"github.com/nats-io/nats.go"
+	"github.com/stretchr/testify/require"
+)
+
+func subscribeChannel(t *testing.T, nc *nats2.NatsConnector, subject string) chan *nats.Msg {
+	received := make(chan *nats.Msg, 1)
+	err := nc.Subscribe(subject, func(msg *nats.Msg) error {
+		received <- msg
+		return nil
+	})
+	require.Nil(t, err)
+	return received
+}
+
+func waitForMsg(t *testing.T, received chan *nats.Msg) *nats.Msg {
+	select {
+	case msg := <-received:
+		return msg
+	case <-time.After(10 * time.Second):
+		t.Fatal("message has not been received")
+		return nil
+	}
+}
+
+// advertiseEncodings makes the publisher receive an event of the peer, advertising the encodings supported by the peer
+func advertiseEncodings(t *testing.T, peer *nats2.NatsConnector, publisher *nats2.NatsConnector) {
+	event := newEncodingTestEvent()
+	event.Type = strutils.Stringp("sh.keptn.event.test.triggered")
+	received := subscribeChannel(t, publisher, *event.Type)
+	require.Nil(t, peer.Publish(event))
+	waitForMsg(t, received)
+}
+
+func newEncodingTestEvent() models.KeptnContextExtendedCE {
+	return models.KeptnContextExtendedCE{
+		ID:   "my-id",
+		Type: strutils.Stringp("sh.keptn.event.test.finished"),
+		Data: v0_2_0.EventData{
+			Project: "someProject",
+			Stage:   "someStage",
+			Service: "someService",
+			Message: strings.Repeat("test results ", 1000),
+		},
+	}
+}
+
+func TestPublishWithPayloadEncoding(t *testing.T) {
+	svr, shutdown := runNATSServer()
+	defer shutdown()
+
+	for _, encoding := range []nats2.PayloadEncoding{nats2.JSONEncoding, nats2.GzipJSONEncoding, nats2.ProtobufEncoding} {
+		t.Run(encoding.Name(), func(t *testing.T) {
+			publisher := nats2.New(svr.ClientURL(), nats2.WithPayloadEncoding(encoding))
+			defer publisher.Disconnect()
+			peer := nats2.New(svr.ClientURL())
+			defer peer.Disconnect()
+			received := subscribeChannel(t, peer, *newEncodingTestEvent().Type)
+
+			// events are published as JSON until the peer has advertised support for the encoding
+			require.Nil(t, publisher.Publish(newEncodingTestEvent()))
+			msg := waitForMsg(t, received)
+			require.Empty(t, msg.Header.Get(nats2.PayloadEncodingHeader))
+			require.Contains(t, msg.Header.Get(nats2.AcceptPayloadEncodingsHeader), encoding.Name())
+
+			advertiseEncodings(t, peer, publisher)
+			require.Nil(t, publisher.Publish(newEncodingTestEvent()))
+			msg = waitForMsg(t, received)
+			if encoding == nats2.JSONEncoding {
+				require.Empty(t, msg.Header.Get(nats2.PayloadEncodingHeader))
+			} else {
+				require.Equal(t, encoding.Name(), msg.Header.Get(nats2.PayloadEncodingHeader))
+			}
+			if encoding == nats2.GzipJSONEncoding {
+				require.Less(t, len(msg.Data), 1000)
+			}
+
+			event, err := nats2.DecodeEvent(msg)
+			require.Nil(t, err)
+			require.Equal(t, "my-id", event.ID)
+			require.Equal(t, "sh.keptn.event.test.finished", *event.Type)
+			data := v0_2_0.EventData{}
+			require.Nil(t, event.DataAs(&data))
+			require.Equal(t, newEncodingTestEvent().Data, data)
+		})
+	}
+}
+
+func TestPayloadEncodingFromEnv(t *testing.T) {
+	svr, shutdown := runNATSServer()
+	defer shutdown()
+	os.Setenv(nats2.EnvVarNatsURL, svr.ClientURL())
+	defer os.Unsetenv(nats2.EnvVarNatsURL)
+	os.Setenv(nats2.EnvVarNatsPayloadEncoding, "gzip+json")
+	defer os.Unsetenv(nats2.EnvVarNatsPayloadEncoding)
+
+	nc := nats2.NewFromEnv()
+	defer nc.Disconnect()
+	peer := nats2.New(svr.ClientURL())
+	defer peer.Disconnect()
+	received := subscribeChannel(t, peer, *newEncodingTestEvent().Type)
+
+	advertiseEncodings(t, peer, nc)
+	require.Nil(t, nc.Publish(newEncodingTestEvent()))
+	require.Equal(t, "gzip+json", waitForMsg(t, received).Header.Get(nats2.PayloadEncodingHeader))
+}
+
+type upperCaseEncoding struct{}
+
+func (upperCaseEncoding) Name() string {
+	return "test-upper"
+}
+
+func (upperCaseEncoding) Marshal(event models.KeptnContextExtendedCE) ([]byte, error) {
+	return []byte(strings.ToUpper(event.ID)), nil
+}
+
+func (upperCaseEncoding) Unmarshal(data []byte, event *models.KeptnContextExtendedCE) error {
+	event.ID = strings.ToLower(string(data))
+	return nil
+}
+
+func TestDecodeEvent(t *testing.T) {
+	msg := nats.NewMsg("subj")
+	msg.Data = []byte("MY-ID")
+	msg.Header.Set(nats2.PayloadEncodingHeader, "test-upper")
+	_, err := nats2.DecodeEvent(msg)
+	require.True(t, errors.Is(err, nats2.ErrUnknownPayloadEncoding))
+
+	nats2.RegisterPayloadEncoding(upperCaseEncoding{})
+	event, err := nats2.DecodeEvent(msg)
+	require.Nil(t, err)
+	require.Equal(t, "my-id", event.ID)
+
+	msg.Header.Set(nats2.PayloadEncodingHeader, "gzip+json")
+	_, err = nats2.DecodeEvent(msg)
+	require.ErrorContains(t, err, "could not decode gzip+json message")
+
+	// messages without header are decoded as JSON
+	event, err = nats2.DecodeEvent(&nats.Msg{Data: []byte(`{"id":"my-id"}`)})
+	require.Nil(t, err)
+	require.Equal(t, "my-id", event.ID)
+}
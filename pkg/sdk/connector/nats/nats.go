@@ -1,7 +1,6 @@
 package nats
 
 import (
-	"encoding/json"
 	"errors"
 	"fmt"
 	"github.com/google/uuid"
@@ -9,6 +8,8 @@ import (
 	"github.com/keptn/go-utils/pkg/sdk/connector/logger"
 	"github.com/nats-io/nats.go"
 	"os"
+	"strings"
+	"sync"
 	"time"
 )
 
@@ -43,10 +44,14 @@ type ProcessEventFn func(msg *nats.Msg) error
 // NatsConnector can be used to subscribe to certain events
 // on the NATS event system
 type NatsConnector struct {
-	connection    *nats.Conn
-	connectURL    string
-	subscriptions map[string]*nats.Subscription
-	logger        logger.Logger
+	connection      *nats.Conn
+	connectURL      string
+	subscriptions   map[string]*nats.Subscription
+	logger          logger.Logger
+	payloadEncoding PayloadEncoding
+	// peerEncodings are the names of the encodings advertised by the publishers of received messages
+	peerEncodings    map[string]bool
+	peerEncodingsMtx sync.RWMutex
 }
 
 // WithLogger sets the logger to use
@@ -59,10 +64,12 @@ func WithLogger(logger logger.Logger) func(*NatsConnector) {
 // New returns an initialised NatsConnector with a nil connection
 func New(connectURL string, opts ...func(connector *NatsConnector)) *NatsConnector {
 	nc := &NatsConnector{
-		connection:    &nats.Conn{},
-		connectURL:    connectURL,
-		subscriptions: make(map[string]*nats.Subscription),
-		logger:        logger.NewDefaultLogger(),
+		connection:      &nats.Conn{},
+		connectURL:      connectURL,
+		subscriptions:   make(map[string]*nats.Subscription),
+		logger:          logger.NewDefaultLogger(),
+		payloadEncoding: JSONEncoding,
+		peerEncodings:   map[string]bool{},
 	}
 	for _, o := range opts {
 		o(nc)
@@ -74,12 +81,23 @@ func New(connectURL string, opts ...func(connector *NatsConnector)) *NatsConnect
 // The URL is read from the environment variable "NATS_URL"
 // If the URL is not set via the environment variable "NATS_URL",
 // it falls back to the default URL "nats://keptn-nats"
+// The preferred PayloadEncoding of published events is read from the environment variable "NATS_PAYLOAD_ENCODING",
+// falling back to JSONEncoding if it is not set or names an unknown encoding, see WithPayloadEncoding
 func NewFromEnv() *NatsConnector {
 	natsURL := os.Getenv(EnvVarNatsURL)
 	if natsURL == "" {
 		natsURL = EnvVarNatsURLDefault
 	}
-	return New(natsURL)
+	nc := New(natsURL)
+	if name := os.Getenv(EnvVarNatsPayloadEncoding); name != "" {
+		encoding, err := GetPayloadEncoding(name)
+		if err != nil {
+			nc.logger.Errorf("Unable to use payload encoding from %s: %v", EnvVarNatsPayloadEncoding, err)
+			return nc
+		}
+		nc.payloadEncoding = encoding
+	}
+	return nc
 }
 
 // ensureConnection connects a NatsConnector or returns the existing connection to NATS
@@ -155,7 +173,8 @@ func (nc *NatsConnector) QueueSubscribeMultiple(subjects []string, queueGroup st
 	return nil
 }
 
-// Publish sends a keptn event to the message broker
+// Publish sends a keptn event to the message broker. It is encoded with the preferred PayloadEncoding of the NatsConnector
+// once a peer has advertised support for it, and as JSON otherwise
 func (nc *NatsConnector) Publish(event models.KeptnContextExtendedCE) error {
 	if event.Type == nil || *event.Type == "" {
 		return ErrPubEventTypeMissing
@@ -166,7 +185,7 @@ func (nc *NatsConnector) Publish(event models.KeptnContextExtendedCE) error {
 	if event.ID == "" {
 		event.ID = uuid.New().String()
 	}
-	serializedEvent, err := json.Marshal(event)
+	msg, err := encodeEvent(*event.Type, event, nc.publishEncoding())
 	if err != nil {
 		return fmt.Errorf("could not publish event: %w", err)
 	}
@@ -174,7 +193,36 @@ func (nc *NatsConnector) Publish(event models.KeptnContextExtendedCE) error {
 	if err != nil {
 		return fmt.Errorf("could not connect to NATS to publish event: %w", err)
 	}
-	return conn.Publish(*event.Type, serializedEvent)
+	if conn.HeadersSupported() {
+		msg.Header.Set(AcceptPayloadEncodingsHeader, acceptedPayloadEncodings())
+	}
+	return conn.PublishMsg(msg)
+}
+
+// publishEncoding returns the preferred PayloadEncoding if a peer has advertised support for it, and JSONEncoding otherwise
+func (nc *NatsConnector) publishEncoding() PayloadEncoding {
+	nc.peerEncodingsMtx.RLock()
+	defer nc.peerEncodingsMtx.RUnlock()
+	if nc.peerEncodings[nc.payloadEncoding.Name()] {
+		return nc.payloadEncoding
+	}
+	return JSONEncoding
+}
+
+// recordPeerEncodings remembers the encodings advertised in the AcceptPayloadEncodingsHeader of a received message
+func (nc *NatsConnector) recordPeerEncodings(msg *nats.Msg) {
+	accepted := msg.Header.Get(AcceptPayloadEncodingsHeader)
+	if accepted == "" {
+		return
+	}
+	nc.peerEncodingsMtx.Lock()
+	defer nc.peerEncodingsMtx.Unlock()
+	if nc.peerEncodings == nil {
+		nc.peerEncodings = map[string]bool{}
+	}
+	for _, name := range strings.Split(accepted, ",") {
+		nc.peerEncodings[strings.TrimSpace(name)] = true
+	}
 }
 
 // Disconnect disconnects/closes the connection to NATS
@@ -193,6 +241,7 @@ func (nc *NatsConnector) queueSubscribe(subject string, queueGroup string, fn Pr
 		return fmt.Errorf("could not queue: %w", err)
 	}
 	sub, err := conn.QueueSubscribe(subject, queueGroup, func(m *nats.Msg) {
+		nc.recordPeerEncodings(m)
 		err := fn(m)
 		if err != nil {
 			nc.logger.Errorf("Could not process message %s: %v\n", string(m.Data), err)
@@ -0,0 +1,89 @@
+package sharding
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"fmt"
+	"sort"
+	"strconv"
+)
+
+// defaultVirtualNodes is the number of points each instance gets on the hash ring.
+// More points spread the projects more evenly across the instances
+const defaultVirtualNodes = 100
+
+// Sharder deterministically assigns projects to one of several instances of an integration
+// using consistent hashing on the project name, so that each event is processed by exactly one instance.
+// When the number of instances changes, only the projects of the added or removed instance move to another instance
+type Sharder struct {
+	instance     int
+	instances    int
+	virtualNodes int
+	ring         []uint32
+	owners       map[uint32]int
+}
+
+// WithVirtualNodes sets the number of points each instance gets on the hash ring
+func WithVirtualNodes(virtualNodes int) func(*Sharder) {
+	return func(s *Sharder) {
+		s.virtualNodes = virtualNodes
+	}
+}
+
+// New creates a new Sharder for the instance with the given index, e.g. the ordinal of a pod of a StatefulSet,
+// out of the given number of instances
+func New(instance int, instances int, opts ...func(*Sharder)) (*Sharder, error) {
+	if instances <= 0 {
+		return nil, fmt.Errorf("number of instances must be positive, but is %d", instances)
+	}
+	if instance < 0 || instance >= instances {
+		return nil, fmt.Errorf("instance must be between 0 and %d, but is %d", instances-1, instance)
+	}
+	s := &Sharder{
+		instance:     instance,
+		instances:    instances,
+		virtualNodes: defaultVirtualNodes,
+	}
+	for _, o := range opts {
+		o(s)
+	}
+	if s.virtualNodes <= 0 {
+		return nil, fmt.Errorf("number of virtual nodes must be positive, but is %d", s.virtualNodes)
+	}
+
+	s.owners = make(map[uint32]int, instances*s.virtualNodes)
+	for i := 0; i < instances; i++ {
+		for v := 0; v < s.virtualNodes; v++ {
+			point := hash(strconv.Itoa(i) + "-" + strconv.Itoa(v))
+			// on a collision, the point stays with the instance that got it first, which is the same on all instances
+			if _, ok := s.owners[point]; !ok {
+				s.owners[point] = i
+				s.ring = append(s.ring, point)
+			}
+		}
+	}
+	sort.Slice(s.ring, func(i, j int) bool { return s.ring[i] < s.ring[j] })
+	return s, nil
+}
+
+// Owner returns the index of the instance the given project is assigned to
+func (s *Sharder) Owner(project string) int {
+	h := hash(project)
+	i := sort.Search(len(s.ring), func(i int) bool { return s.ring[i] >= h })
+	if i == len(s.ring) {
+		i = 0
+	}
+	return s.owners[s.ring[i]]
+}
+
+// Owns returns whether the given project is assigned to this instance
+func (s *Sharder) Owns(project string) bool {
+	return s.Owner(project) == s.instance
+}
+
+// hash maps the given key to a point on the hash ring. SHA-256 is used instead of a faster hash function because it
+// spreads similar keys like project-1 and project-2 evenly
+func hash(key string) uint32 {
+	sum := sha256.Sum256([]byte(key))
+	return binary.BigEndian.Uint32(sum[:4])
+}
@@ -0,0 +1,63 @@
+package sharding
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNew_InvalidArguments(t *testing.T) {
+	_, err := New(0, 0)
+	require.Error(t, err)
+	_, err = New(3, 3)
+	require.Error(t, err)
+	_, err = New(-1, 3)
+	require.Error(t, err)
+	_, err = New(0, 3, WithVirtualNodes(0))
+	require.Error(t, err)
+}
+
+func TestSharder_EachProjectHasExactlyOneOwner(t *testing.T) {
+	sharders := []*Sharder{}
+	for i := 0; i < 3; i++ {
+		sharder, err := New(i, 3)
+		require.Nil(t, err)
+		sharders = append(sharders, sharder)
+	}
+
+	projectsPerInstance := make([]int, 3)
+	for p := 0; p < 300; p++ {
+		project := fmt.Sprintf("project-%d", p)
+		owners := 0
+		for i, sharder := range sharders {
+			require.Equal(t, sharders[0].Owner(project), sharder.Owner(project))
+			if sharder.Owns(project) {
+				owners++
+				projectsPerInstance[i]++
+			}
+		}
+		require.Equal(t, 1, owners)
+	}
+	for _, projects := range projectsPerInstance {
+		require.Greater(t, projects, 50)
+	}
+}
+
+func TestSharder_AddingAnInstanceOnlyMovesProjectsToIt(t *testing.T) {
+	three, err := New(0, 3)
+	require.Nil(t, err)
+	four, err := New(0, 4)
+	require.Nil(t, err)
+
+	moved := 0
+	for p := 0; p < 300; p++ {
+		project := fmt.Sprintf("project-%d", p)
+		if before, after := three.Owner(project), four.Owner(project); before != after {
+			require.Equal(t, 3, after)
+			moved++
+		}
+	}
+	require.Greater(t, moved, 0)
+	require.Less(t, moved, 150)
+}
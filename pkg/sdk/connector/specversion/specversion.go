@@ -0,0 +1,114 @@
+package specversion
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/keptn/go-utils/pkg/api/models"
+)
+
+// ErrIncompatible is wrapped by all errors returned for events with an unsupported spec version
+var ErrIncompatible = errors.New("incompatible spec version")
+
+// Mode defines how events with an unsupported spec version are treated
+type Mode int
+
+const (
+	// Warn lets the events pass, but reports them, e.g. with a log message
+	Warn Mode = iota
+	// Reject drops received events and refuses to send events
+	Reject
+)
+
+// Compatibility is the table of spec versions an integration supports.
+// A version ending with * matches all versions with the given prefix, e.g. 0.2.* matches 0.2.4
+type Compatibility struct {
+	// SpecVersions are the supported CloudEvents spec versions, i.e. values of specversion
+	SpecVersions []string
+	// KeptnSpecVersions are the supported Keptn spec versions, i.e. values of shkeptnspecversion
+	KeptnSpecVersions []string
+	// RequireVersions treats events without specversion or shkeptnspecversion as incompatible.
+	// By default, such events are accepted, since older integrations do not always set shkeptnspecversion
+	RequireVersions bool
+}
+
+// DefaultCompatibility supports CloudEvents 1.0 and the Keptn spec 0.2.x, which the models of go-utils are based on
+var DefaultCompatibility = Compatibility{
+	SpecVersions:      []string{"1.0"},
+	KeptnSpecVersions: []string{"0.2.*"},
+}
+
+// IncompatibleError is returned for an event with an unsupported spec version
+type IncompatibleError struct {
+	// Field is the attribute of the event containing the version, i.e. specversion or shkeptnspecversion
+	Field string
+	// Version is the version of the event
+	Version string
+	// Supported are the versions supported for the field
+	Supported []string
+}
+
+// Error returns the unsupported version along with the supported ones
+func (e *IncompatibleError) Error() string {
+	if e.Version == "" {
+		return fmt.Sprintf("%s: event has no %s, supported are %s", ErrIncompatible, e.Field, strings.Join(e.Supported, ", "))
+	}
+	return fmt.Sprintf("%s: %s %s is not supported, supported are %s", ErrIncompatible, e.Field, e.Version, strings.Join(e.Supported, ", "))
+}
+
+// Unwrap returns ErrIncompatible
+func (e *IncompatibleError) Unwrap() error {
+	return ErrIncompatible
+}
+
+// Checker checks whether the spec versions of events are supported
+type Checker struct {
+	compatibility Compatibility
+	mode          Mode
+}
+
+// NewChecker creates a new Checker for the given compatibility table, e.g. DefaultCompatibility.
+// Passing a custom table allows to override the default, e.g. to accept a newer Keptn spec version
+func NewChecker(compatibility Compatibility, mode Mode) *Checker {
+	return &Checker{compatibility: compatibility, mode: mode}
+}
+
+// Mode returns how events with an unsupported spec version are treated
+func (c *Checker) Mode() Mode {
+	return c.mode
+}
+
+// Check returns an *IncompatibleError if the specversion or shkeptnspecversion of the event is not supported
+func (c *Checker) Check(event models.KeptnContextExtendedCE) error {
+	if err := c.check("specversion", event.Specversion, c.compatibility.SpecVersions); err != nil {
+		return err
+	}
+	return c.check("shkeptnspecversion", event.Shkeptnspecversion, c.compatibility.KeptnSpecVersions)
+}
+
+func (c *Checker) check(field string, version string, supported []string) error {
+	if version == "" {
+		if c.compatibility.RequireVersions {
+			return &IncompatibleError{Field: field, Supported: supported}
+		}
+		return nil
+	}
+	// an empty list places no restrictions on the field
+	if len(supported) == 0 {
+		return nil
+	}
+	for _, s := range supported {
+		if matches(s, version) {
+			return nil
+		}
+	}
+	return &IncompatibleError{Field: field, Version: version, Supported: supported}
+}
+
+func matches(pattern string, version string) bool {
+	if strings.HasSuffix(pattern, "*") {
+		return strings.HasPrefix(version, strings.TrimSuffix(pattern, "*"))
+	}
+	return pattern == version
+}
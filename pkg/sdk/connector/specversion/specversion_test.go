@@ -0,0 +1,70 @@
+package specversion
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/keptn/go-utils/pkg/api/models"
+	"github.com/stretchr/testify/require"
+)
+
+func TestChecker_Check(t *testing.T) {
+	tests := []struct {
+		name          string
+		compatibility Compatibility
+		event         models.KeptnContextExtendedCE
+		wantField     string
+	}{
+		{
+			name:          "supported versions",
+			compatibility: DefaultCompatibility,
+			event:         models.KeptnContextExtendedCE{Specversion: "1.0", Shkeptnspecversion: "0.2.4"},
+		},
+		{
+			name:          "missing versions are accepted by default",
+			compatibility: DefaultCompatibility,
+			event:         models.KeptnContextExtendedCE{},
+		},
+		{
+			name:          "missing versions are rejected if required",
+			compatibility: Compatibility{SpecVersions: []string{"1.0"}, KeptnSpecVersions: []string{"0.2.*"}, RequireVersions: true},
+			event:         models.KeptnContextExtendedCE{Specversion: "1.0"},
+			wantField:     "shkeptnspecversion",
+		},
+		{
+			name:          "unsupported CloudEvents spec version",
+			compatibility: DefaultCompatibility,
+			event:         models.KeptnContextExtendedCE{Specversion: "0.3", Shkeptnspecversion: "0.2.4"},
+			wantField:     "specversion",
+		},
+		{
+			name:          "unsupported Keptn spec version",
+			compatibility: DefaultCompatibility,
+			event:         models.KeptnContextExtendedCE{Specversion: "1.0", Shkeptnspecversion: "0.3.0"},
+			wantField:     "shkeptnspecversion",
+		},
+		{
+			name:          "overridden Keptn spec versions",
+			compatibility: Compatibility{SpecVersions: []string{"1.0"}, KeptnSpecVersions: []string{"0.2.*", "0.3.0"}},
+			event:         models.KeptnContextExtendedCE{Specversion: "1.0", Shkeptnspecversion: "0.3.0"},
+		},
+		{
+			name:          "no restrictions",
+			compatibility: Compatibility{},
+			event:         models.KeptnContextExtendedCE{Specversion: "2.0", Shkeptnspecversion: "1.0.0"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := NewChecker(tt.compatibility, Reject).Check(tt.event)
+			if tt.wantField == "" {
+				require.Nil(t, err)
+				return
+			}
+			require.ErrorIs(t, err, ErrIncompatible)
+			incompatible := &IncompatibleError{}
+			require.True(t, errors.As(err, &incompatible))
+			require.Equal(t, tt.wantField, incompatible.Field)
+		})
+	}
+}
@@ -14,7 +14,7 @@ func createStartedEvent(source string, parentEvent models.KeptnContextExtendedCE
 	if parentEvent.Shkeptncontext == "" {
 		return nil, fmt.Errorf("unable to get keptn context from parent event %s", parentEvent.ID)
 	}
-	startedEventType, err := keptnv2.ReplaceEventTypeKind(*parentEvent.Type, "started")
+	startedEventType, err := keptnv2.ReplaceEventTypeKind(*parentEvent.Type, keptnv2.StartedEventKind)
 	if err != nil {
 		return nil, fmt.Errorf("unable to create '.started' event for parent event %s: %w", parentEvent.ID, err)
 	}
@@ -31,7 +31,7 @@ func createFinishedEvent(source string, parentEvent models.KeptnContextExtendedC
 	if parentEvent.Shkeptncontext == "" {
 		return nil, fmt.Errorf("unable to get keptn context from parent event %s", parentEvent.ID)
 	}
-	finishedEventType, err := keptnv2.ReplaceEventTypeKind(*parentEvent.Type, "finished")
+	finishedEventType, err := keptnv2.ReplaceEventTypeKind(*parentEvent.Type, keptnv2.FinishedEventKind)
 	if err != nil {
 		return nil, fmt.Errorf("unable to create '.finished' event: %v from %s", err, *parentEvent.Type)
 	}
@@ -42,11 +42,11 @@ func createFinishedEvent(source string, parentEvent models.KeptnContextExtendedC
 	}
 
 	if genericEventData["status"] == nil || genericEventData["status"] == "" {
-		genericEventData["status"] = "succeeded"
+		genericEventData["status"] = string(keptnv2.StatusSucceeded)
 	}
 
 	if genericEventData["result"] == nil || genericEventData["result"] == "" {
-		genericEventData["result"] = "pass"
+		genericEventData["result"] = string(keptnv2.ResultPass)
 	}
 	return createEvent(source, finishedEventType, parentEvent, genericEventData), nil
 }
@@ -63,7 +63,7 @@ func createFinishedEventWithError(source string, parentEvent models.KeptnContext
 	commonEventData.Status = errVal.StatusType
 	commonEventData.Message = errVal.Message
 
-	finishedEventType, err := keptnv2.ReplaceEventTypeKind(*parentEvent.Type, "finished")
+	finishedEventType, err := keptnv2.ReplaceEventTypeKind(*parentEvent.Type, keptnv2.FinishedEventKind)
 	if err != nil {
 		return nil, fmt.Errorf("unable to create '.finished' event for parent event %s: %w", parentEvent.ID, err)
 	}
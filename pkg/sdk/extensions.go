@@ -0,0 +1,43 @@
+package sdk
+
+// EventMutator modifies incoming events before they are dispatched to their TaskHandler, e.g. to enrich them with
+// additional data. The mutation is applied before the event data is decoded and the event filters are executed.
+// If Mutate returns an error, the event is rejected
+type EventMutator interface {
+	Mutate(keptnHandle IKeptn, event *KeptnEvent) error
+}
+
+// PreDispatchHook is called before an event is dispatched to its TaskHandler, after the event filters have accepted it,
+// e.g. to enforce policies. If PreDispatch returns an error, the event is rejected and not passed to the TaskHandler
+type PreDispatchHook interface {
+	PreDispatch(keptnHandle IKeptn, event KeptnEvent) error
+}
+
+// PostDispatchHook is called after a TaskHandler has processed an event, with the result and error returned by the TaskHandler
+type PostDispatchHook interface {
+	PostDispatch(keptnHandle IKeptn, event KeptnEvent, result interface{}, err *Error)
+}
+
+// WithExtension registers an extension of the event pipeline. The extension is added to the pipeline for each of
+// the interfaces EventMutator, PreDispatchHook and PostDispatchHook it implements.
+// Extensions of the same kind are called in the order in which they have been registered
+func WithExtension(extension interface{}) KeptnOption {
+	return func(k *Keptn) {
+		registered := false
+		if mutator, ok := extension.(EventMutator); ok {
+			k.eventMutators = append(k.eventMutators, mutator)
+			registered = true
+		}
+		if hook, ok := extension.(PreDispatchHook); ok {
+			k.preDispatchHooks = append(k.preDispatchHooks, hook)
+			registered = true
+		}
+		if hook, ok := extension.(PostDispatchHook); ok {
+			k.postDispatchHooks = append(k.postDispatchHooks, hook)
+			registered = true
+		}
+		if !registered {
+			k.logger.Errorf("Unable to register extension of type %T: it implements neither EventMutator, PreDispatchHook nor PostDispatchHook", extension)
+		}
+	}
+}
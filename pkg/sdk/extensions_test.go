@@ -0,0 +1,123 @@
+package sdk
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/keptn/go-utils/pkg/api/models"
+	"github.com/keptn/go-utils/pkg/common/contextutils"
+	"github.com/keptn/go-utils/pkg/common/strutils"
+	"github.com/keptn/go-utils/pkg/lib/v0_2_0"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeExtension struct {
+	calls        []string
+	rejectReason error
+}
+
+func (f *fakeExtension) Mutate(keptnHandle IKeptn, event *KeptnEvent) error {
+	f.calls = append(f.calls, "mutate")
+	event.Data = v0_2_0.EventData{Project: "prj", Stage: "stg", Service: "svc", Labels: map[string]string{"enriched": "true"}}
+	return nil
+}
+
+func (f *fakeExtension) PreDispatch(keptnHandle IKeptn, event KeptnEvent) error {
+	f.calls = append(f.calls, "preDispatch")
+	return f.rejectReason
+}
+
+func (f *fakeExtension) PostDispatch(keptnHandle IKeptn, event KeptnEvent, result interface{}, err *Error) {
+	f.calls = append(f.calls, "postDispatch")
+}
+
+type fakePostDispatchHook struct {
+	results []interface{}
+}
+
+func (f *fakePostDispatchHook) PostDispatch(keptnHandle IKeptn, event KeptnEvent, result interface{}, err *Error) {
+	f.results = append(f.results, result)
+}
+
+func newExtensionTestEvent() models.KeptnContextExtendedCE {
+	return models.KeptnContextExtendedCE{
+		Data:           v0_2_0.EventData{Project: "prj", Stage: "stg", Service: "svc"},
+		ID:             "id",
+		Shkeptncontext: "context",
+		Source:         strutils.Stringp("source"),
+		Type:           strutils.Stringp("sh.keptn.event.faketask.triggered"),
+	}
+}
+
+func Test_WithExtension_HooksAreCalledInOrder(t *testing.T) {
+	var receivedEvent KeptnEvent
+	taskHandler := &TaskHandlerMock{}
+	taskHandler.ExecuteFunc = func(keptnHandle IKeptn, event KeptnEvent) (interface{}, *Error) {
+		receivedEvent = event
+		return FakeTaskData{}, nil
+	}
+	extension := &fakeExtension{}
+	postDispatchHook := &fakePostDispatchHook{}
+	fakeKeptn := NewFakeKeptn("fake")
+	fakeKeptn.AddTaskHandler("sh.keptn.event.faketask.triggered", taskHandler)
+	fakeKeptn.AddExtension(extension)
+	fakeKeptn.AddExtension(postDispatchHook)
+	fakeKeptn.NewEvent(newExtensionTestEvent())
+
+	require.Equal(t, []string{"mutate", "preDispatch", "postDispatch"}, extension.calls)
+	require.Equal(t, []interface{}{FakeTaskData{}}, postDispatchHook.results)
+	require.Equal(t, map[string]string{"enriched": "true"}, receivedEvent.Data.(v0_2_0.EventData).Labels)
+	fakeKeptn.AssertNumberOfEventSent(t, 2)
+}
+
+func Test_WithExtension_PreDispatchHookRejectsEvent(t *testing.T) {
+	taskHandler := &TaskHandlerMock{}
+	executed := false
+	taskHandler.ExecuteFunc = func(keptnHandle IKeptn, event KeptnEvent) (interface{}, *Error) {
+		executed = true
+		return FakeTaskData{}, nil
+	}
+	extension := &fakeExtension{rejectReason: errors.New("policy violated")}
+	fakeKeptn := NewFakeKeptn("fake")
+	fakeKeptn.AddTaskHandler("sh.keptn.event.faketask.triggered", taskHandler)
+	fakeKeptn.AddExtension(extension)
+	fakeKeptn.NewEvent(newExtensionTestEvent())
+
+	require.False(t, executed)
+	require.Equal(t, []string{"mutate", "preDispatch"}, extension.calls)
+	fakeKeptn.AssertNumberOfEventSent(t, 1)
+	fakeKeptn.AssertSentEventType(t, 0, "sh.keptn.event.faketask.finished")
+	fakeKeptn.AssertSentEventStatus(t, 0, v0_2_0.StatusErrored)
+	fakeKeptn.AssertSentEventResult(t, 0, v0_2_0.ResultFailed)
+}
+
+func Test_WithExtension_UnsupportedExtension(t *testing.T) {
+	fakeKeptn := NewFakeKeptn("fake")
+	fakeKeptn.AddExtension("not an extension")
+	require.Empty(t, fakeKeptn.Keptn.eventMutators)
+	require.Empty(t, fakeKeptn.Keptn.preDispatchHooks)
+	require.Empty(t, fakeKeptn.Keptn.postDispatchHooks)
+}
+
+type contextTaskHandler struct {
+	TaskHandlerMock
+	identifiers contextutils.Identifiers
+}
+
+func (c *contextTaskHandler) ExecuteWithContext(ctx context.Context, keptnHandle IKeptn, event KeptnEvent) (interface{}, *Error) {
+	c.identifiers = contextutils.IdentifiersFromContext(ctx)
+	return FakeTaskData{}, nil
+}
+
+func Test_ContextTaskHandlerReceivesIdentifiers(t *testing.T) {
+	taskHandler := &contextTaskHandler{}
+	fakeKeptn := NewFakeKeptn("fake")
+	fakeKeptn.AddTaskHandler("sh.keptn.event.faketask.triggered", taskHandler)
+	event := newExtensionTestEvent()
+	event.Data = v0_2_0.EventData{Project: "my-project", Stage: "my-stage", Service: "my-service"}
+	fakeKeptn.NewEvent(event)
+
+	require.Equal(t, contextutils.Identifiers{KeptnContext: "context", Project: "my-project", Stage: "my-stage", Service: "my-service"}, taskHandler.identifiers)
+	fakeKeptn.AssertNumberOfEventSent(t, 2)
+}
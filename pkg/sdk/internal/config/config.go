@@ -1,8 +1,8 @@
 package config
 
 import (
+	"fmt"
 	"github.com/kelseyhightower/envconfig"
-	"log"
 	"strconv"
 	"time"
 )
@@ -38,12 +38,13 @@ const (
 	ConnectionTypeHTTP         ConnectionType = "http"
 )
 
-func NewEnvConfig() EnvConfig {
+// NewEnvConfig reads the configuration from the environment variables
+func NewEnvConfig() (EnvConfig, error) {
 	var env EnvConfig
 	if err := envconfig.Process("", &env); err != nil {
-		log.Fatalf("failed to process env var: %s", err)
+		return env, fmt.Errorf("failed to process env var: %w", err)
 	}
-	return env
+	return env, nil
 }
 
 func (env *EnvConfig) OAuthEnabled() bool {
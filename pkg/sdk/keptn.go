@@ -2,9 +2,15 @@ package sdk
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"github.com/keptn/go-utils/pkg/common/contextutils"
+	"github.com/keptn/go-utils/pkg/common/policy"
+	"github.com/keptn/go-utils/pkg/sdk/connector/eventmatcher"
 	eventsource "github.com/keptn/go-utils/pkg/sdk/connector/eventsource/nats"
 	"github.com/keptn/go-utils/pkg/sdk/connector/logforwarder"
 	"github.com/keptn/go-utils/pkg/sdk/connector/logger"
+	"github.com/keptn/go-utils/pkg/sdk/connector/sharding"
 	"github.com/keptn/go-utils/pkg/sdk/connector/subscriptionsource"
 	"github.com/keptn/go-utils/pkg/sdk/connector/types"
 	sdk "github.com/keptn/go-utils/pkg/sdk/internal/api"
@@ -16,12 +22,13 @@ import (
 	"syscall"
 	"time"
 
-	"github.com/kelseyhightower/envconfig"
 	"github.com/keptn/go-utils/pkg/api/models"
 	api "github.com/keptn/go-utils/pkg/api/utils"
+	apiv2 "github.com/keptn/go-utils/pkg/api/utils/v2"
 	keptnv2 "github.com/keptn/go-utils/pkg/lib/v0_2_0"
 	"github.com/keptn/go-utils/pkg/sdk/connector/controlplane"
 	"github.com/keptn/go-utils/pkg/sdk/connector/nats"
+	"go.opentelemetry.io/otel/trace"
 )
 
 const (
@@ -29,6 +36,10 @@ const (
 	cloudeventsversion = "1.0"
 )
 
+// ErrInitialization is returned by Keptn.Start if the Keptn integration could not be initialized,
+// e.g. because the configuration in the environment variables is invalid
+var ErrInitialization = errors.New("unable to initialize keptn")
+
 type IKeptn interface {
 	// Start starts the internal event handling logic and needs to be called by the user
 	// after creating value of IKeptn
@@ -56,6 +67,14 @@ type TaskHandler interface {
 	Execute(keptnHandle IKeptn, event KeptnEvent) (interface{}, *Error)
 }
 
+// ContextTaskHandler is a TaskHandler which is called with a context carrying the Keptn context, project, stage and
+// service of the event, see contextutils.IdentifiersFromContext. If a registered TaskHandler implements it,
+// ExecuteWithContext is called instead of Execute
+type ContextTaskHandler interface {
+	TaskHandler
+	ExecuteWithContext(ctx context.Context, keptnHandle IKeptn, event KeptnEvent) (interface{}, *Error)
+}
+
 type KeptnEvent models.KeptnContextExtendedCE
 
 type Error struct {
@@ -110,6 +129,41 @@ func WithTaskHandler(eventType string, handler TaskHandler, filters ...func(kept
 	}
 }
 
+// WithEventSchema registers the payload type of a custom event type, see EventSchemaRegistry.Register.
+// Incoming events of this type are passed to the TaskHandler with their data decoded into a pointer to the payload type,
+// and outgoing events of this type are only sent if their data matches the payload type
+func WithEventSchema(eventType string, payload interface{}) KeptnOption {
+	return func(k *Keptn) {
+		if err := k.eventSchemas.Register(eventType, payload); err != nil {
+			k.logger.Errorf("Unable to register event schema: %v", err)
+		}
+	}
+}
+
+// WithSharder configures keptn to only handle events of the projects the given Sharder assigns to this instance,
+// so that the integration can be scaled horizontally without processing an event more than once
+func WithSharder(sharder *sharding.Sharder) KeptnOption {
+	return func(k *Keptn) {
+		k.sharder = sharder
+	}
+}
+
+// WithSampler configures keptn to bound the rate at which events of noisy types are handled, see eventmatcher.Sampler.
+// Events dropped by the sampler are neither handled nor answered with a .started or .finished event
+func WithSampler(sampler *eventmatcher.Sampler) KeptnOption {
+	return func(k *Keptn) {
+		k.sampler = sampler
+	}
+}
+
+// WithCapabilities adds the given capabilities of the client of the integration, see apiv2.APISet.Capabilities,
+// to the metadata of the registration, together with the event source through which the integration receives events
+func WithCapabilities(capabilities apiv2.Capabilities) KeptnOption {
+	return func(k *Keptn) {
+		k.capabilities = &capabilities
+	}
+}
+
 // WithAutomaticResponse sets the option to instruct the sdk to automatically send a .started and .finished event.
 // Per default this behavior is turned on and can be disabled with this function
 func WithAutomaticResponse(autoResponse bool) KeptnOption {
@@ -141,34 +195,61 @@ type Keptn struct {
 	api                    api.KeptnInterface
 	source                 string
 	taskRegistry           *taskRegistry
+	eventSchemas           *EventSchemaRegistry
+	sharder                *sharding.Sharder
+	sampler                *eventmatcher.Sampler
+	capabilities           *apiv2.Capabilities
+	eventMutators          []EventMutator
+	preDispatchHooks       []PreDispatchHook
+	postDispatchHooks      []PostDispatchHook
+	policyDecider          policy.Decider
 	syncProcessing         bool
 	automaticEventResponse bool
 	gracefulShutdown       bool
 	logger                 Logger
 	env                    config.EnvConfig
 	healthEndpointRunner   healthEndpointRunner
+	// initErr is the error which occurred while creating the Keptn integration, and which is returned by Start
+	initErr error
 }
 
-// NewKeptn creates a new Keptn
+// NewKeptn creates a new Keptn.
+// If it cannot be initialized, e.g. because the configuration in the environment variables is invalid,
+// the error is logged and Start returns an error wrapping ErrInitialization
 func NewKeptn(source string, opts ...KeptnOption) *Keptn {
+	env, err := config.NewEnvConfig()
 	keptn := &Keptn{
 		source:                 source,
 		taskRegistry:           newTaskMap(),
+		eventSchemas:           NewEventSchemaRegistry(),
 		automaticEventResponse: true,
 		gracefulShutdown:       true,
 		syncProcessing:         false,
 		logger:                 newDefaultLogger(),
-		env:                    config.NewEnvConfig(),
+		env:                    env,
 		healthEndpointRunner:   newHealthEndpointRunner,
 	}
 	for _, opt := range opts {
 		opt(keptn)
 	}
-	keptn.api, keptn.controlPlane, keptn.eventSender = newControlPlaneFromEnv(keptn.logger)
-	keptn.resourceHandler = newResourceHandlerFromEnv(keptn.logger)
+	if err != nil {
+		keptn.setInitErr(err)
+		return keptn
+	}
+	keptn.api, keptn.controlPlane, keptn.eventSender, err = newControlPlaneFromEnv(keptn.env, keptn.logger, keptn.sharder, keptn.sampler)
+	if err != nil {
+		keptn.setInitErr(err)
+		return keptn
+	}
+	keptn.resourceHandler = api.NewResourceHandler(keptn.env.ConfigurationServiceURL)
 	return keptn
 }
 
+func (k *Keptn) setInitErr(err error) {
+	k.logger.Errorf("Unable to initialize keptn: %v", err)
+	k.initErr = fmt.Errorf("%w: %v", ErrInitialization, err)
+}
+
 func (k *Keptn) OnEvent(ctx context.Context, event models.KeptnContextExtendedCE) error {
 	k.logger.Debug("Handling event ", event)
 	eventSender, ok := ctx.Value(types.EventSenderKey).(controlplane.EventSender)
@@ -191,23 +272,36 @@ func (k *Keptn) OnEvent(ctx context.Context, event models.KeptnContextExtendedCE
 		k.logger.Errorf("Unable to get graceful shutdown wait group. Skip processing of event %s", event.ID)
 		return nil
 	}
+	ctx = withEventIdentifiers(ctx, event)
+	trace.SpanFromContext(ctx).SetAttributes(contextutils.Attributes(ctx)...)
 	wg.Add(1)
 	k.runEventTaskAction(func() {
 		{
 			defer wg.Done()
 			if handler, ok := k.taskRegistry.Contains(*event.Type); ok {
-				keptnEvent := &KeptnEvent{}
-				if err := keptnv2.Decode(&event, keptnEvent); err != nil {
-					errorLogEvent, err := createErrorLogEvent(k.source, event, nil, &Error{Err: err, StatusType: keptnv2.StatusErrored, ResultType: keptnv2.ResultFailed})
-					if err != nil {
-						k.logger.Errorf("Unable to create '.error.log' event from '.triggered' event: %v", err)
+				// KeptnEvent has the same underlying type as the received event,
+				// so it can be passed on without re-encoding it
+				keptnEvent := (*KeptnEvent)(&event)
+
+				for _, mutator := range k.eventMutators {
+					if err := mutator.Mutate(k, keptnEvent); err != nil {
+						k.logger.Errorf("Unable to mutate event %s: %v", event.ID, err)
+						k.rejectEvent(eventSender, event, err)
 						return
 					}
-					// no started event sent yet, so it only makes sense to Send an error log event at this point
-					if err := eventSender(*errorLogEvent); err != nil {
-						k.logger.Errorf("Unable to send '.finished' event: %v", err)
+				}
+
+				// events with a registered payload type are passed on with their data decoded into that type
+				if k.eventSchemas.Contains(*event.Type) {
+					payload, err := k.eventSchemas.Decode(*event.Type, event.Data)
+					if err != nil {
+						k.logger.Errorf("Unable to decode payload of event %s: %v", event.ID, err)
+						k.rejectEvent(eventSender, event, err)
 						return
 					}
+					decodedEvent := *keptnEvent
+					decodedEvent.Data = payload
+					keptnEvent = &decodedEvent
 				}
 
 				// execute the filtering functions of the task handler to determine whether the incoming event should be handled
@@ -219,6 +313,14 @@ func (k *Keptn) OnEvent(ctx context.Context, event models.KeptnContextExtendedCE
 					}
 				}
 
+				for _, hook := range k.preDispatchHooks {
+					if err := hook.PreDispatch(k, *keptnEvent); err != nil {
+						k.logger.Infof("Event %s has been rejected: %v", event.ID, err)
+						k.rejectEvent(eventSender, event, err)
+						return
+					}
+				}
+
 				// only respond with .started event if the incoming event is a task.triggered event
 				if keptnv2.IsTaskEventType(*event.Type) && keptnv2.IsTriggeredEventType(*event.Type) && k.automaticEventResponse {
 					startedEvent, err := createStartedEvent(k.source, event)
@@ -226,13 +328,22 @@ func (k *Keptn) OnEvent(ctx context.Context, event models.KeptnContextExtendedCE
 						k.logger.Errorf("Unable to create '.started' event from '.triggered' event: %v", err)
 						return
 					}
-					if err := eventSender(*startedEvent); err != nil {
+					if err := k.sendValidated(eventSender, *startedEvent); err != nil {
 						k.logger.Errorf("Unable to send '.started' event: %v", err)
 						return
 					}
 				}
 
-				result, err := handler.taskHandler.Execute(k, *keptnEvent)
+				var result interface{}
+				var err *Error
+				if contextHandler, ok := handler.taskHandler.(ContextTaskHandler); ok {
+					result, err = contextHandler.ExecuteWithContext(ctx, k, *keptnEvent)
+				} else {
+					result, err = handler.taskHandler.Execute(k, *keptnEvent)
+				}
+				for _, hook := range k.postDispatchHooks {
+					hook.PostDispatch(k, *keptnEvent, result, err)
+				}
 				if err != nil {
 					k.logger.Errorf("Error during task execution %v", err.Err)
 					if k.automaticEventResponse {
@@ -241,7 +352,7 @@ func (k *Keptn) OnEvent(ctx context.Context, event models.KeptnContextExtendedCE
 							k.logger.Errorf("Unable to create '.error' event: %v", err)
 							return
 						}
-						if err := eventSender(*errorEvent); err != nil {
+						if err := k.sendValidated(eventSender, *errorEvent); err != nil {
 							k.logger.Errorf("Unable to send '.error' event: %v", err)
 							return
 						}
@@ -256,7 +367,7 @@ func (k *Keptn) OnEvent(ctx context.Context, event models.KeptnContextExtendedCE
 						k.logger.Errorf("Unable to create '.finished' event: %v", err)
 						return
 					}
-					if err := eventSender(*finishedEvent); err != nil {
+					if err := k.sendValidated(eventSender, *finishedEvent); err != nil {
 						k.logger.Errorf("Unable to send '.finished' event: %v", err)
 						return
 					}
@@ -277,24 +388,31 @@ func (k *Keptn) RegistrationData() controlplane.RegistrationData {
 	for _, s := range subjects {
 		subscriptions = append(subscriptions, models.EventSubscription{Event: s})
 	}
-	return controlplane.RegistrationData{
-		Name: k.source,
-		MetaData: models.MetaData{
-			Hostname:           k.env.K8sNodeName,
-			IntegrationVersion: k.env.K8sDeploymentVersion,
-			Location:           k.env.Location,
-			DistributorVersion: "0.15.0", // note: to be deleted when bridge stops requiring this info
-			KubernetesMetaData: models.KubernetesMetaData{
-				Namespace:      k.env.K8sNamespace,
-				PodName:        k.env.K8sPodName,
-				DeploymentName: k.env.K8sDeploymentName,
-			},
+	metaData := models.MetaData{
+		Hostname:           k.env.K8sNodeName,
+		IntegrationVersion: k.env.K8sDeploymentVersion,
+		Location:           k.env.Location,
+		DistributorVersion: "0.15.0", // note: to be deleted when bridge stops requiring this info
+		KubernetesMetaData: models.KubernetesMetaData{
+			Namespace:      k.env.K8sNamespace,
+			PodName:        k.env.K8sPodName,
+			DeploymentName: k.env.K8sDeploymentName,
 		},
+	}
+	if k.capabilities != nil {
+		metaData.Capabilities = k.capabilities.WithEventSources(string(k.env.PubSubConnectionType())).RegistrationMetaData()
+	}
+	return controlplane.RegistrationData{
+		Name:          k.source,
+		MetaData:      metaData,
 		Subscriptions: subscriptions,
 	}
 }
 
 func (k *Keptn) Start() error {
+	if k.initErr != nil {
+		return k.initErr
+	}
 	if k.env.HealthEndpointEnabled {
 		k.healthEndpointRunner(k.env.HealthEndpointPort, k.controlPlane)
 	}
@@ -315,7 +433,7 @@ func (k *Keptn) SendStartedEvent(event KeptnEvent) error {
 	if err != nil {
 		return err
 	}
-	return k.eventSender(*finishedEvent)
+	return k.sendValidated(k.eventSender, *finishedEvent)
 }
 
 func (k *Keptn) SendFinishedEvent(event KeptnEvent, result interface{}) error {
@@ -323,7 +441,55 @@ func (k *Keptn) SendFinishedEvent(event KeptnEvent, result interface{}) error {
 	if err != nil {
 		return err
 	}
-	return k.eventSender(*finishedEvent)
+	return k.sendValidated(k.eventSender, *finishedEvent)
+}
+
+// EventSchemas returns the registry of the payload types of custom event types,
+// e.g. to register further types or to list the registered ones
+func (k *Keptn) EventSchemas() *EventSchemaRegistry {
+	return k.eventSchemas
+}
+
+// withEventIdentifiers returns a context carrying the identifiers of the event. Identifiers already added by the
+// control plane are kept, so that the event data is only decoded if the context does not contain them
+func withEventIdentifiers(ctx context.Context, event models.KeptnContextExtendedCE) context.Context {
+	if contextutils.KeptnContextFromContext(ctx) != "" {
+		return ctx
+	}
+	ids := contextutils.Identifiers{KeptnContext: event.Shkeptncontext}
+	eventData := keptnv2.EventData{}
+	if err := event.DataAs(&eventData); err == nil {
+		ids.Project, ids.Stage, ids.Service = eventData.Project, eventData.Stage, eventData.Service
+	}
+	return contextutils.ContextWithIdentifiers(ctx, ids)
+}
+
+// rejectEvent sends an '.error' event for an event that is not passed to its TaskHandler, if automatic response is enabled
+func (k *Keptn) rejectEvent(eventSender controlplane.EventSender, event models.KeptnContextExtendedCE, reason error) {
+	if !k.automaticEventResponse {
+		return
+	}
+	errorEvent, err := createErrorEvent(k.source, event, nil, &Error{StatusType: keptnv2.StatusErrored, ResultType: keptnv2.ResultFailed, Message: reason.Error()})
+	if err != nil {
+		k.logger.Errorf("Unable to create '.error' event: %v", err)
+		return
+	}
+	if err := k.sendValidated(eventSender, *errorEvent); err != nil {
+		k.logger.Errorf("Unable to send '.error' event: %v", err)
+	}
+}
+
+// sendValidated sends the given event if its data matches the payload type registered for its type
+// and the event is allowed by the policy decider
+func (k *Keptn) sendValidated(eventSender controlplane.EventSender, event models.KeptnContextExtendedCE) error {
+	if err := k.eventSchemas.Validate(*event.Type, event.Data); err != nil {
+		return err
+	}
+	event, err := k.checkPolicies(event)
+	if err != nil {
+		return err
+	}
+	return eventSender(event)
 }
 
 func (k *Keptn) APIV1() api.KeptnInterface {
@@ -369,35 +535,32 @@ func newHealthEndpointRunner(port string, cp *controlplane.ControlPlane) {
 	}()
 }
 
-func newResourceHandlerFromEnv(logger logger.Logger) *api.ResourceHandler {
-	var env config.EnvConfig
-	if err := envconfig.Process("", &env); err != nil {
-		logger.Fatalf("failed to process env var: %s", err)
-	}
-	return api.NewResourceHandler(env.ConfigurationServiceURL)
-}
-
-func newControlPlaneFromEnv(logger logger.Logger) (api.KeptnInterface, *controlplane.ControlPlane, controlplane.EventSender) {
-	var env config.EnvConfig
-	if err := envconfig.Process("", &env); err != nil {
-		logger.Fatalf("failed to process env var: %s", err)
-	}
-
+func newControlPlaneFromEnv(env config.EnvConfig, logger logger.Logger, sharder *sharding.Sharder, sampler *eventmatcher.Sampler) (api.KeptnInterface, *controlplane.ControlPlane, controlplane.EventSender, error) {
 	httpClient, err := sdk.CreateClientGetter(env).Get()
 	if err != nil {
-		logger.Fatal("Could not initialize http client.", err)
+		return nil, nil, nil, fmt.Errorf("could not initialize http client: %w", err)
 	}
 
 	apiSet, err := sdk.CreateKeptnAPI(httpClient, env)
 	if err != nil {
-		logger.Fatal(err)
+		return nil, nil, nil, fmt.Errorf("could not create keptn API: %w", err)
 	}
 
 	natsConnector := nats.New(env.EventBrokerURL, nats.WithLogger(logger))
-	eventSource := eventsource.New(natsConnector, eventsource.WithLogger(logger))
+	eventSourceOpts := []func(*eventsource.NATSEventSource){eventsource.WithLogger(logger)}
+	controlPlaneOpts := []func(*controlplane.ControlPlane){controlplane.WithLogger(logger)}
+	if sharder != nil {
+		// every instance has to receive all events, so that the sharder of the instance owning the project handles it
+		eventSourceOpts = append(eventSourceOpts, eventsource.WithoutQueueGroup())
+		controlPlaneOpts = append(controlPlaneOpts, controlplane.WithSharder(sharder))
+	}
+	if sampler != nil {
+		controlPlaneOpts = append(controlPlaneOpts, controlplane.WithSampler(sampler))
+	}
+	eventSource := eventsource.New(natsConnector, eventSourceOpts...)
 	eventSender := eventSource.Sender()
 	subscriptionSource := subscriptionsource.New(apiSet.UniformV1(), subscriptionsource.WithLogger(logger))
 	logForwarder := logforwarder.New(apiSet.LogsV1(), logforwarder.WithLogger(logger))
-	controlPlane := controlplane.New(subscriptionSource, eventSource, logForwarder, controlplane.WithLogger(logger))
-	return apiSet, controlPlane, eventSender
+	controlPlane := controlplane.New(subscriptionSource, eventSource, logForwarder, controlPlaneOpts...)
+	return apiSet, controlPlane, eventSender, nil
 }
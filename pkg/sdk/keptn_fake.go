@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"github.com/keptn/go-utils/pkg/api/models"
 	api "github.com/keptn/go-utils/pkg/api/utils"
+	"github.com/keptn/go-utils/pkg/common/diffutils"
 	"github.com/keptn/go-utils/pkg/lib/v0_2_0"
 	"github.com/keptn/go-utils/pkg/sdk/connector/controlplane"
 	"github.com/keptn/go-utils/pkg/sdk/connector/types"
@@ -70,6 +71,17 @@ func (f *FakeKeptn) AssertSentEventResult(t *testing.T, eventIndex int, result v
 	require.Equal(t, result, eventData.Result)
 }
 
+// AssertSentEventData asserts that the data of the sent event equals the expected payload, e.g. a fixture.
+// If they differ, the test fails with a list of the differing JSON paths
+func (f *FakeKeptn) AssertSentEventData(t *testing.T, eventIndex int, expected interface{}) {
+	if eventIndex >= len(f.SentEvents) {
+		t.Fatalf("unable to assert sent event with index %d: too less events sent", eventIndex)
+	}
+	changes, err := diffutils.DiffEventData(f.SentEvents[eventIndex], expected)
+	require.Nil(t, err)
+	require.Truef(t, changes.Empty(), "data of sent event with index %d differs from expected data:\n%s", eventIndex, changes)
+}
+
 func (f *FakeKeptn) SetAutomaticResponse(autoResponse bool) {
 	f.Keptn.automaticEventResponse = autoResponse
 }
@@ -90,6 +102,10 @@ func (f *FakeKeptn) AddTaskHandlerWithSubscriptionID(eventType string, handler T
 	f.Keptn.taskRegistry.Add(eventType, taskEntry{taskHandler: handler, eventFilters: filters})
 }
 
+func (f *FakeKeptn) AddExtension(extension interface{}) {
+	WithExtension(extension)(f.Keptn)
+}
+
 func (f *FakeKeptn) fakeSender(ce models.KeptnContextExtendedCE) error {
 	f.SentEvents = append(f.SentEvents, ce)
 	return nil
@@ -104,6 +120,7 @@ func NewFakeKeptn(source string) *FakeKeptn {
 			api:                    panicKeptnInterface{},
 			resourceHandler:        resourceHandler,
 			taskRegistry:           newTaskMap(),
+			eventSchemas:           NewEventSchemaRegistry(),
 			syncProcessing:         true,
 			automaticEventResponse: true,
 			gracefulShutdown:       false,
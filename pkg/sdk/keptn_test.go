@@ -8,6 +8,7 @@ import (
 
 	"github.com/google/uuid"
 	"github.com/keptn/go-utils/pkg/api/models"
+	apiv2 "github.com/keptn/go-utils/pkg/api/utils/v2"
 	"github.com/keptn/go-utils/pkg/common/strutils"
 	"github.com/keptn/go-utils/pkg/lib/v0_2_0"
 	"github.com/stretchr/testify/require"
@@ -154,6 +155,19 @@ func Test_InitialRegistrationData(t *testing.T) {
 	require.Equal(t, []models.EventSubscription{{Event: "sh.keptn.event.task1.triggered"}, {Event: "sh.keptn.event.task2.triggered"}}, regData.Subscriptions)
 }
 
+func Test_InitialRegistrationData_Capabilities(t *testing.T) {
+	keptn := Keptn{env: config.EnvConfig{}}
+	regData := keptn.RegistrationData()
+	require.Nil(t, regData.MetaData.Capabilities)
+
+	apiSet, err := apiv2.New("http://localhost:8080/api")
+	require.Nil(t, err)
+	WithCapabilities(apiSet.Capabilities())(&keptn)
+	regData = keptn.RegistrationData()
+	require.Equal(t, "nats", regData.MetaData.Capabilities["eventSources"])
+	require.Equal(t, "none", regData.MetaData.Capabilities["auth"])
+}
+
 func Test_InitialRegistrationData_EmptyPubSubTopics(t *testing.T) {
 	keptn := Keptn{env: config.EnvConfig{PubSubTopic: ""}}
 	regData := keptn.RegistrationData()
@@ -199,3 +213,33 @@ func (mock *TaskHandlerMock) Execute(keptnHandle IKeptn, event KeptnEvent) (inte
 	}
 	return mock.ExecuteFunc(keptnHandle, event)
 }
+
+func Test_AssertSentEventData(t *testing.T) {
+	taskHandler := &TaskHandlerMock{}
+	taskHandler.ExecuteFunc = func(keptnHandle IKeptn, event KeptnEvent) (interface{}, *Error) {
+		return v0_2_0.EventData{Message: "done"}, nil
+	}
+	fakeKeptn := NewFakeKeptn("fake")
+	fakeKeptn.AddTaskHandler("sh.keptn.event.faketask.triggered", taskHandler)
+	fakeKeptn.NewEvent(models.KeptnContextExtendedCE{
+		Data:           v0_2_0.EventData{Project: "prj", Stage: "stg", Service: "svc"},
+		ID:             "id",
+		Shkeptncontext: "context",
+		Source:         strutils.Stringp("source"),
+		Type:           strutils.Stringp("sh.keptn.event.faketask.triggered"),
+	})
+
+	fakeKeptn.AssertSentEventData(t, 1, map[string]interface{}{
+		"status":  "succeeded",
+		"result":  "pass",
+		"message": "done",
+	})
+}
+
+func Test_NewKeptn_InvalidEnvConfig(t *testing.T) {
+	t.Setenv("HTTP_SSL_VERIFY", "not-a-bool")
+
+	keptn := NewKeptn("source")
+	err := keptn.Start()
+	require.ErrorIs(t, err, ErrInitialization)
+}
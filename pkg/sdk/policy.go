@@ -0,0 +1,58 @@
+package sdk
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/keptn/go-utils/pkg/api/models"
+	"github.com/keptn/go-utils/pkg/common/policy"
+	keptnv2 "github.com/keptn/go-utils/pkg/lib/v0_2_0"
+)
+
+// WithPolicyDecider configures a policy.Decider which is consulted before each event is sent.
+// Denied events are not sent. Annotations are added to the extensions of the event
+func WithPolicyDecider(decider policy.Decider) KeptnOption {
+	return func(k *Keptn) {
+		k.policyDecider = decider
+	}
+}
+
+// checkPolicies returns the event with the annotations of the policy decider, or an error if the event must not be sent
+func (k *Keptn) checkPolicies(event models.KeptnContextExtendedCE) (models.KeptnContextExtendedCE, error) {
+	if k.policyDecider == nil {
+		return event, nil
+	}
+	annotations, err := policy.Check(context.TODO(), k.policyDecider, newPolicyInput(event))
+	if err != nil {
+		return event, err
+	}
+	if len(annotations) == 0 {
+		return event, nil
+	}
+	extensions := map[string]interface{}{}
+	if existing, ok := event.Extensions.(map[string]interface{}); ok {
+		for key, value := range existing {
+			extensions[key] = value
+		}
+	}
+	for key, value := range annotations {
+		extensions[key] = value
+	}
+	event.Extensions = extensions
+	return event, nil
+}
+
+func newPolicyInput(event models.KeptnContextExtendedCE) policy.Input {
+	input := policy.Input{Operation: policy.OperationSendEvent}
+	if event.Type != nil {
+		input.Target.EventType = *event.Type
+	}
+	eventData := keptnv2.EventData{}
+	if err := keptnv2.EventDataAs(event, &eventData); err == nil {
+		input.Target.Project = eventData.Project
+		input.Target.Stage = eventData.Stage
+		input.Target.Service = eventData.Service
+	}
+	input.Payload, _ = json.Marshal(event.Data)
+	return input
+}
@@ -0,0 +1,46 @@
+package sdk
+
+import (
+	"context"
+	"testing"
+
+	"github.com/keptn/go-utils/pkg/common/policy"
+	"github.com/keptn/go-utils/pkg/lib/v0_2_0"
+	"github.com/stretchr/testify/require"
+)
+
+type policyDeciderMock struct {
+	inputs []policy.Input
+	decide func(input policy.Input) policy.Result
+}
+
+func (p *policyDeciderMock) Decide(ctx context.Context, input policy.Input) (policy.Result, error) {
+	p.inputs = append(p.inputs, input)
+	return p.decide(input), nil
+}
+
+func Test_WithPolicyDecider(t *testing.T) {
+	taskHandler := &TaskHandlerMock{}
+	taskHandler.ExecuteFunc = func(keptnHandle IKeptn, event KeptnEvent) (interface{}, *Error) { return FakeTaskData{}, nil }
+	decider := &policyDeciderMock{decide: func(input policy.Input) policy.Result {
+		if input.Target.EventType == "sh.keptn.event.faketask.finished" {
+			return policy.Result{Decision: policy.Deny, Reason: "no finished events"}
+		}
+		return policy.Result{Decision: policy.Annotate, Annotations: map[string]string{"auditedby": "guardrails"}}
+	}}
+	fakeKeptn := NewFakeKeptn("fake")
+	WithPolicyDecider(decider)(fakeKeptn.Keptn)
+	fakeKeptn.AddTaskHandler("sh.keptn.event.faketask.triggered", taskHandler)
+	fakeKeptn.NewEvent(newExtensionTestEvent())
+
+	fakeKeptn.AssertNumberOfEventSent(t, 1)
+	fakeKeptn.AssertSentEventType(t, 0, "sh.keptn.event.faketask.started")
+	require.Equal(t, map[string]interface{}{"auditedby": "guardrails"}, fakeKeptn.SentEvents[0].Extensions)
+
+	require.Len(t, decider.inputs, 2)
+	require.Equal(t, policy.OperationSendEvent, decider.inputs[0].Operation)
+	require.Equal(t, policy.Target{Project: "prj", Stage: "stg", Service: "svc", EventType: "sh.keptn.event.faketask.started"}, decider.inputs[0].Target)
+
+	err := fakeKeptn.Keptn.SendFinishedEvent(KeptnEvent(newExtensionTestEvent()), v0_2_0.EventData{})
+	require.ErrorContains(t, err, "no finished events")
+}
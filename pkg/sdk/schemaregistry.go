@@ -0,0 +1,126 @@
+package sdk
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+	"sync"
+)
+
+// ErrEventSchemaNotRegistered is returned when no payload type is registered for an event type
+var ErrEventSchemaNotRegistered = errors.New("no payload type registered for event type")
+
+// EventSchemaRegistry holds the payload types of custom event types.
+// Incoming events of a registered type are decoded into the registered payload type before they are passed to
+// the TaskHandler, and the payloads of outgoing events of a registered type are validated against it
+type EventSchemaRegistry struct {
+	sync.RWMutex
+	schemas map[string]reflect.Type
+}
+
+// NewEventSchemaRegistry creates an empty EventSchemaRegistry
+func NewEventSchemaRegistry() *EventSchemaRegistry {
+	return &EventSchemaRegistry{
+		schemas: make(map[string]reflect.Type),
+	}
+}
+
+// Register registers the type of the given payload, e.g. MyTaskTriggeredEventData{}, for the given event type.
+// The payload must be a struct or a pointer to a struct. A previously registered type is replaced
+func (r *EventSchemaRegistry) Register(eventType string, payload interface{}) error {
+	if eventType == "" {
+		return errors.New("event type must not be empty")
+	}
+	payloadType := reflect.TypeOf(payload)
+	if payloadType != nil && payloadType.Kind() == reflect.Ptr {
+		payloadType = payloadType.Elem()
+	}
+	if payloadType == nil || payloadType.Kind() != reflect.Struct {
+		return fmt.Errorf("payload type for event type %s must be a struct, but is %v", eventType, reflect.TypeOf(payload))
+	}
+	r.Lock()
+	defer r.Unlock()
+	r.schemas[eventType] = payloadType
+	return nil
+}
+
+// Contains returns whether a payload type is registered for the given event type
+func (r *EventSchemaRegistry) Contains(eventType string) bool {
+	_, ok := r.get(eventType)
+	return ok
+}
+
+// RegisteredTypes returns the sorted list of event types a payload type is registered for
+func (r *EventSchemaRegistry) RegisteredTypes() []string {
+	if r == nil {
+		return []string{}
+	}
+	r.RLock()
+	defer r.RUnlock()
+	eventTypes := make([]string, 0, len(r.schemas))
+	for eventType := range r.schemas {
+		eventTypes = append(eventTypes, eventType)
+	}
+	sort.Strings(eventTypes)
+	return eventTypes
+}
+
+// Decode decodes the given event data into a new value of the payload type registered for the event type
+// and returns a pointer to it. If the payload type has a Validate() error method, it is called as well.
+// If no payload type is registered, ErrEventSchemaNotRegistered is returned
+func (r *EventSchemaRegistry) Decode(eventType string, data interface{}) (interface{}, error) {
+	return r.decode(eventType, data, false)
+}
+
+// Validate checks whether the given event data matches the payload type registered for the event type.
+// In contrast to Decode, fields that are not part of the payload type are rejected.
+// Data of event types without a registered payload type is always valid
+func (r *EventSchemaRegistry) Validate(eventType string, data interface{}) error {
+	if !r.Contains(eventType) {
+		return nil
+	}
+	_, err := r.decode(eventType, data, true)
+	return err
+}
+
+func (r *EventSchemaRegistry) decode(eventType string, data interface{}, strict bool) (interface{}, error) {
+	payloadType, ok := r.get(eventType)
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrEventSchemaNotRegistered, eventType)
+	}
+	payload := reflect.New(payloadType).Interface()
+	if err := decode(data, payload, strict); err != nil {
+		return nil, fmt.Errorf("unable to decode payload of event type %s into %v: %w", eventType, payloadType, err)
+	}
+	if validator, ok := payload.(interface{ Validate() error }); ok {
+		if err := validator.Validate(); err != nil {
+			return nil, fmt.Errorf("invalid payload of event type %s: %w", eventType, err)
+		}
+	}
+	return payload, nil
+}
+
+func (r *EventSchemaRegistry) get(eventType string) (reflect.Type, bool) {
+	if r == nil {
+		return nil, false
+	}
+	r.RLock()
+	defer r.RUnlock()
+	payloadType, ok := r.schemas[eventType]
+	return payloadType, ok
+}
+
+func decode(in, out interface{}, strict bool) error {
+	data, err := json.Marshal(in)
+	if err != nil {
+		return err
+	}
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	if strict {
+		decoder.DisallowUnknownFields()
+	}
+	return decoder.Decode(out)
+}
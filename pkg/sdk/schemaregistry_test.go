@@ -0,0 +1,146 @@
+package sdk
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/keptn/go-utils/pkg/api/models"
+	"github.com/keptn/go-utils/pkg/common/strutils"
+	"github.com/keptn/go-utils/pkg/lib/v0_2_0"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeTaskTriggeredData struct {
+	v0_2_0.EventData
+	FakeTask struct {
+		Image string `json:"image"`
+	} `json:"faketask"`
+}
+
+type fakeTaskFinishedData struct {
+	v0_2_0.EventData
+	Tag string `json:"tag"`
+}
+
+func (d fakeTaskFinishedData) Validate() error {
+	if d.Tag == "" {
+		return errors.New("tag must not be empty")
+	}
+	return nil
+}
+
+func TestEventSchemaRegistry_Register(t *testing.T) {
+	registry := NewEventSchemaRegistry()
+	require.Nil(t, registry.Register("sh.keptn.event.faketask.triggered", fakeTaskTriggeredData{}))
+	require.Nil(t, registry.Register("sh.keptn.event.faketask.finished", &fakeTaskFinishedData{}))
+	require.Error(t, registry.Register("", fakeTaskTriggeredData{}))
+	require.Error(t, registry.Register("sh.keptn.event.faketask.started", "not a struct"))
+	require.Error(t, registry.Register("sh.keptn.event.faketask.started", nil))
+
+	require.True(t, registry.Contains("sh.keptn.event.faketask.triggered"))
+	require.False(t, registry.Contains("sh.keptn.event.faketask.started"))
+	require.Equal(t, []string{"sh.keptn.event.faketask.finished", "sh.keptn.event.faketask.triggered"}, registry.RegisteredTypes())
+}
+
+func TestEventSchemaRegistry_Decode(t *testing.T) {
+	registry := NewEventSchemaRegistry()
+	require.Nil(t, registry.Register("sh.keptn.event.faketask.triggered", fakeTaskTriggeredData{}))
+
+	payload, err := registry.Decode("sh.keptn.event.faketask.triggered", map[string]interface{}{
+		"project":  "prj",
+		"faketask": map[string]interface{}{"image": "my-image"},
+		"labels":   map[string]interface{}{"unknown": "fields are ignored"},
+	})
+	require.Nil(t, err)
+	data, ok := payload.(*fakeTaskTriggeredData)
+	require.True(t, ok)
+	require.Equal(t, "prj", data.Project)
+	require.Equal(t, "my-image", data.FakeTask.Image)
+
+	_, err = registry.Decode("sh.keptn.event.faketask.triggered", map[string]interface{}{"faketask": "not an object"})
+	require.Error(t, err)
+
+	_, err = registry.Decode("sh.keptn.event.other.triggered", map[string]interface{}{})
+	require.ErrorIs(t, err, ErrEventSchemaNotRegistered)
+}
+
+func TestEventSchemaRegistry_Validate(t *testing.T) {
+	registry := NewEventSchemaRegistry()
+	require.Nil(t, registry.Register("sh.keptn.event.faketask.finished", fakeTaskFinishedData{}))
+
+	require.Nil(t, registry.Validate("sh.keptn.event.faketask.finished", fakeTaskFinishedData{Tag: "1.0.0"}))
+	require.Nil(t, registry.Validate("sh.keptn.event.faketask.finished", map[string]interface{}{"tag": "1.0.0", "status": "succeeded"}))
+	require.Error(t, registry.Validate("sh.keptn.event.faketask.finished", fakeTaskFinishedData{}))
+	require.Error(t, registry.Validate("sh.keptn.event.faketask.finished", map[string]interface{}{"tag": "1.0.0", "unknown": "value"}))
+	require.Nil(t, registry.Validate("sh.keptn.event.other.finished", map[string]interface{}{"unknown": "value"}))
+}
+
+func Test_WhenReceivingAnEventWithRegisteredSchema_DataIsDecoded(t *testing.T) {
+	var receivedData interface{}
+	taskHandler := &TaskHandlerMock{}
+	taskHandler.ExecuteFunc = func(keptnHandle IKeptn, event KeptnEvent) (interface{}, *Error) {
+		receivedData = event.Data
+		return fakeTaskFinishedData{Tag: "1.0.0"}, nil
+	}
+	fakeKeptn := NewFakeKeptn("fake")
+	fakeKeptn.AddTaskHandler("sh.keptn.event.faketask.triggered", taskHandler)
+	require.Nil(t, fakeKeptn.Keptn.EventSchemas().Register("sh.keptn.event.faketask.triggered", fakeTaskTriggeredData{}))
+	require.Nil(t, fakeKeptn.Keptn.EventSchemas().Register("sh.keptn.event.faketask.finished", fakeTaskFinishedData{}))
+	fakeKeptn.NewEvent(models.KeptnContextExtendedCE{
+		Data:           map[string]interface{}{"project": "prj", "faketask": map[string]interface{}{"image": "my-image"}},
+		ID:             "id",
+		Shkeptncontext: "context",
+		Source:         strutils.Stringp("source"),
+		Type:           strutils.Stringp("sh.keptn.event.faketask.triggered"),
+	})
+
+	data, ok := receivedData.(*fakeTaskTriggeredData)
+	require.True(t, ok)
+	require.Equal(t, "my-image", data.FakeTask.Image)
+	fakeKeptn.AssertNumberOfEventSent(t, 2)
+	fakeKeptn.AssertSentEventType(t, 1, "sh.keptn.event.faketask.finished")
+	fakeKeptn.AssertSentEventStatus(t, 1, v0_2_0.StatusSucceeded)
+}
+
+func Test_WhenReceivingAnEventWithInvalidPayload_ErrorEventIsSent(t *testing.T) {
+	taskHandler := &TaskHandlerMock{}
+	taskHandler.ExecuteFunc = func(keptnHandle IKeptn, event KeptnEvent) (interface{}, *Error) {
+		t.Fatal("task handler must not be called")
+		return nil, nil
+	}
+	fakeKeptn := NewFakeKeptn("fake")
+	fakeKeptn.AddTaskHandler("sh.keptn.event.faketask.triggered", taskHandler)
+	require.Nil(t, fakeKeptn.Keptn.EventSchemas().Register("sh.keptn.event.faketask.triggered", fakeTaskTriggeredData{}))
+	fakeKeptn.NewEvent(models.KeptnContextExtendedCE{
+		Data:           map[string]interface{}{"project": "prj", "faketask": "not an object"},
+		ID:             "id",
+		Shkeptncontext: "context",
+		Source:         strutils.Stringp("source"),
+		Type:           strutils.Stringp("sh.keptn.event.faketask.triggered"),
+	})
+
+	fakeKeptn.AssertNumberOfEventSent(t, 1)
+	fakeKeptn.AssertSentEventType(t, 0, "sh.keptn.event.faketask.finished")
+	fakeKeptn.AssertSentEventStatus(t, 0, v0_2_0.StatusErrored)
+	fakeKeptn.AssertSentEventResult(t, 0, v0_2_0.ResultFailed)
+}
+
+func Test_WhenTaskResultDoesNotMatchRegisteredSchema_NoFinishedEventIsSent(t *testing.T) {
+	taskHandler := &TaskHandlerMock{}
+	taskHandler.ExecuteFunc = func(keptnHandle IKeptn, event KeptnEvent) (interface{}, *Error) {
+		return fakeTaskFinishedData{}, nil
+	}
+	fakeKeptn := NewFakeKeptn("fake")
+	fakeKeptn.AddTaskHandler("sh.keptn.event.faketask.triggered", taskHandler)
+	require.Nil(t, fakeKeptn.Keptn.EventSchemas().Register("sh.keptn.event.faketask.finished", fakeTaskFinishedData{}))
+	fakeKeptn.NewEvent(models.KeptnContextExtendedCE{
+		Data:           v0_2_0.EventData{Project: "prj", Stage: "stg", Service: "svc"},
+		ID:             "id",
+		Shkeptncontext: "context",
+		Source:         strutils.Stringp("source"),
+		Type:           strutils.Stringp("sh.keptn.event.faketask.triggered"),
+	})
+
+	fakeKeptn.AssertNumberOfEventSent(t, 1)
+	fakeKeptn.AssertSentEventType(t, 0, "sh.keptn.event.faketask.started")
+}
@@ -0,0 +1,193 @@
+package sdk
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/keptn/go-utils/pkg/common/timeutils"
+	keptnv2 "github.com/keptn/go-utils/pkg/lib/v0_2_0"
+)
+
+// DefaultSLIFetchConcurrency is the default number of indicators an SLIProvider fetches at the same time
+const DefaultSLIFetchConcurrency = 4
+
+// SLIRequest contains the parsed data of a get-sli.triggered event, which is passed to an SLIFetcher
+type SLIRequest struct {
+	Project    string
+	Stage      string
+	Service    string
+	Labels     map[string]string
+	Deployment string
+	// SLIProvider is the name of the monitoring solution the SLIs are requested from
+	SLIProvider string
+	// Start and End are the timeframe of the evaluation
+	Start time.Time
+	End   time.Time
+	// Indicators are the names of the requested SLIs
+	Indicators    []string
+	CustomFilters []*keptnv2.SLIFilter
+	// Event is the get-sli.triggered event
+	Event KeptnEvent
+}
+
+// SLIFetcher fetches the value of a single SLI from a monitoring solution
+type SLIFetcher interface {
+	FetchSLI(ctx context.Context, request SLIRequest, indicator string) (float64, error)
+}
+
+// SLIFetcherFunc is an SLIFetcher implemented by a function
+type SLIFetcherFunc func(ctx context.Context, request SLIRequest, indicator string) (float64, error)
+
+// FetchSLI calls f
+func (f SLIFetcherFunc) FetchSLI(ctx context.Context, request SLIRequest, indicator string) (float64, error) {
+	return f(ctx, request, indicator)
+}
+
+// SLIProviderOptions are options for NewSLIProvider()
+type SLIProviderOptions struct {
+	// Concurrency is the number of indicators fetched at the same time. If it is not positive, DefaultSLIFetchConcurrency is used
+	Concurrency int
+	// Timeout is the maximum duration of fetching a single indicator. If it is not positive, there is no timeout
+	Timeout time.Duration
+}
+
+// SLIProvider is a TaskHandler for get-sli.triggered events, which implements the parts every SLI provider shares:
+// It parses the requested indicators and timeframe, fetches each indicator via an SLIFetcher and builds the
+// get-sli.finished payload. Indicators which cannot be fetched are reported with success set to false and the error
+// as message, and the result of the event is fail; the other indicators are still reported.
+// It is registered via WithTaskHandler(keptnv2.GetTriggeredEventType(keptnv2.GetSLITaskName), provider)
+type SLIProvider struct {
+	fetcher SLIFetcher
+	opts    SLIProviderOptions
+}
+
+// NewSLIProvider creates an SLIProvider fetching the indicators via the given SLIFetcher
+func NewSLIProvider(fetcher SLIFetcher, opts SLIProviderOptions) *SLIProvider {
+	if opts.Concurrency <= 0 {
+		opts.Concurrency = DefaultSLIFetchConcurrency
+	}
+	return &SLIProvider{fetcher: fetcher, opts: opts}
+}
+
+// Execute handles a get-sli.triggered event, see ExecuteWithContext
+func (p *SLIProvider) Execute(keptnHandle IKeptn, event KeptnEvent) (interface{}, *Error) {
+	return p.ExecuteWithContext(context.Background(), keptnHandle, event)
+}
+
+// ExecuteWithContext handles a get-sli.triggered event and returns the keptnv2.GetSLIFinishedEventData containing the fetched values
+func (p *SLIProvider) ExecuteWithContext(ctx context.Context, keptnHandle IKeptn, event KeptnEvent) (interface{}, *Error) {
+	request, data, err := ParseSLIRequest(event)
+	if err != nil {
+		return nil, &Error{StatusType: keptnv2.StatusErrored, ResultType: keptnv2.ResultFailed, Message: err.Error(), Err: err}
+	}
+
+	values := p.fetchAll(ctx, request)
+
+	finished := keptnv2.GetSLIFinishedEventData{
+		EventData: keptnv2.EventData{
+			Project: data.Project,
+			Stage:   data.Stage,
+			Service: data.Service,
+			Labels:  data.Labels,
+			Status:  keptnv2.StatusSucceeded,
+			Result:  keptnv2.ResultPass,
+		},
+		GetSLI: keptnv2.GetSLIFinished{
+			Start:           data.GetSLI.Start,
+			End:             data.GetSLI.End,
+			IndicatorValues: values,
+		},
+	}
+	var failed []string
+	for _, value := range values {
+		if !value.Success {
+			failed = append(failed, fmt.Sprintf("%s: %s", value.Metric, value.Message))
+		}
+	}
+	if len(failed) > 0 {
+		finished.Result = keptnv2.ResultFailed
+		finished.Message = fmt.Sprintf("could not fetch %d of %d indicators: %s", len(failed), len(values), strings.Join(failed, "; "))
+	}
+	return finished, nil
+}
+
+// fetchAll fetches all indicators of the request and returns their values in the order of the indicators
+func (p *SLIProvider) fetchAll(ctx context.Context, request SLIRequest) []*keptnv2.SLIResult {
+	values := make([]*keptnv2.SLIResult, len(request.Indicators))
+	slots := make(chan struct{}, p.opts.Concurrency)
+	wg := sync.WaitGroup{}
+	for i, indicator := range request.Indicators {
+		wg.Add(1)
+		slots <- struct{}{}
+		go func(i int, indicator string) {
+			defer wg.Done()
+			defer func() { <-slots }()
+			values[i] = p.fetch(ctx, request, indicator)
+		}(i, indicator)
+	}
+	wg.Wait()
+	return values
+}
+
+// fetch fetches a single indicator. Errors and panics of the SLIFetcher are reported as the message of the result
+func (p *SLIProvider) fetch(ctx context.Context, request SLIRequest, indicator string) (result *keptnv2.SLIResult) {
+	result = &keptnv2.SLIResult{Metric: indicator}
+	defer func() {
+		if r := recover(); r != nil {
+			result.Success = false
+			result.Message = fmt.Sprintf("panic while fetching indicator: %v", r)
+		}
+	}()
+	if p.opts.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, p.opts.Timeout)
+		defer cancel()
+	}
+	value, err := p.fetcher.FetchSLI(ctx, request, indicator)
+	if err != nil {
+		result.Message = err.Error()
+		return result
+	}
+	result.Value = value
+	result.Success = true
+	return result
+}
+
+// ParseSLIRequest decodes the data of a get-sli.triggered event and parses its timeframe.
+// It returns an error if no indicators are requested or the timeframe is invalid
+func ParseSLIRequest(event KeptnEvent) (SLIRequest, keptnv2.GetSLITriggeredEventData, error) {
+	data := keptnv2.GetSLITriggeredEventData{}
+	if err := keptnv2.Decode(event.Data, &data); err != nil {
+		return SLIRequest{}, data, fmt.Errorf("could not decode get-sli.triggered event data: %w", err)
+	}
+	if len(data.GetSLI.Indicators) == 0 {
+		return SLIRequest{}, data, fmt.Errorf("no indicators requested")
+	}
+	start, err := timeutils.ParseTimestamp(data.GetSLI.Start)
+	if err != nil {
+		return SLIRequest{}, data, fmt.Errorf("could not parse start of timeframe: %w", err)
+	}
+	end, err := timeutils.ParseTimestamp(data.GetSLI.End)
+	if err != nil {
+		return SLIRequest{}, data, fmt.Errorf("could not parse end of timeframe: %w", err)
+	}
+	if end.Before(*start) {
+		return SLIRequest{}, data, fmt.Errorf("end of timeframe %s is before its start %s", data.GetSLI.End, data.GetSLI.Start)
+	}
+	return SLIRequest{
+		Project:       data.Project,
+		Stage:         data.Stage,
+		Service:       data.Service,
+		Labels:        data.Labels,
+		Deployment:    data.Deployment,
+		SLIProvider:   data.GetSLI.SLIProvider,
+		Start:         *start,
+		End:           *end,
+		Indicators:    data.GetSLI.Indicators,
+		CustomFilters: data.GetSLI.CustomFilters,
+		Event:         event,
+	}, data, nil
+}
@@ -0,0 +1,138 @@
+package sdk
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/keptn/go-utils/pkg/api/models"
+	"github.com/keptn/go-utils/pkg/common/strutils"
+	"github.com/keptn/go-utils/pkg/lib/v0_2_0"
+	"github.com/stretchr/testify/require"
+)
+
+func newGetSLITriggeredEvent(indicators ...string) models.KeptnContextExtendedCE {
+	return models.KeptnContextExtendedCE{
+		Data: v0_2_0.GetSLITriggeredEventData{
+			EventData:  v0_2_0.EventData{Project: "prj", Stage: "stg", Service: "svc", Labels: map[string]string{"buildId": "1"}},
+			Deployment: "canary",
+			GetSLI: v0_2_0.GetSLI{
+				SLIProvider:   "prometheus",
+				Start:         "2022-01-26T10:05:53.931Z",
+				End:           "2022-01-26T10:10:53.931Z",
+				Indicators:    indicators,
+				CustomFilters: []*v0_2_0.SLIFilter{{Key: "handler", Value: "ItemsController"}},
+			},
+		},
+		ID:             "id",
+		Shkeptncontext: "context",
+		Source:         strutils.Stringp("source"),
+		Type:           strutils.Stringp(v0_2_0.GetTriggeredEventType(v0_2_0.GetSLITaskName)),
+	}
+}
+
+func Test_SLIProvider(t *testing.T) {
+	var inFlight, maxInFlight int32
+	fetcher := SLIFetcherFunc(func(ctx context.Context, request SLIRequest, indicator string) (float64, error) {
+		current := atomic.AddInt32(&inFlight, 1)
+		defer atomic.AddInt32(&inFlight, -1)
+		for {
+			max := atomic.LoadInt32(&maxInFlight)
+			if current <= max || atomic.CompareAndSwapInt32(&maxInFlight, max, current) {
+				break
+			}
+		}
+		time.Sleep(10 * time.Millisecond)
+
+		require.Equal(t, "prj", request.Project)
+		require.Equal(t, "canary", request.Deployment)
+		require.Equal(t, time.Date(2022, 1, 26, 10, 5, 53, 931000000, time.UTC), request.Start)
+		require.Equal(t, 5*time.Minute, request.End.Sub(request.Start))
+		require.Equal(t, "ItemsController", request.CustomFilters[0].Value)
+		switch indicator {
+		case "error_rate":
+			return 0, errors.New("query failed")
+		case "throughput":
+			panic("unexpected response")
+		}
+		return float64(len(indicator)), nil
+	})
+
+	fakeKeptn := NewFakeKeptn("fake")
+	fakeKeptn.AddTaskHandler(v0_2_0.GetTriggeredEventType(v0_2_0.GetSLITaskName), NewSLIProvider(fetcher, SLIProviderOptions{Concurrency: 2}))
+	fakeKeptn.NewEvent(newGetSLITriggeredEvent("response_time_p95", "error_rate", "throughput", "cpu", "memory"))
+
+	fakeKeptn.AssertNumberOfEventSent(t, 2)
+	fakeKeptn.AssertSentEventType(t, 1, v0_2_0.GetFinishedEventType(v0_2_0.GetSLITaskName))
+	fakeKeptn.AssertSentEventStatus(t, 1, v0_2_0.StatusSucceeded)
+	fakeKeptn.AssertSentEventResult(t, 1, v0_2_0.ResultFailed)
+	require.Equal(t, int32(2), atomic.LoadInt32(&maxInFlight))
+
+	finished := v0_2_0.GetSLIFinishedEventData{}
+	require.Nil(t, v0_2_0.EventDataAs(fakeKeptn.SentEvents[1], &finished))
+	require.Equal(t, map[string]string{"buildId": "1"}, finished.Labels)
+	require.Equal(t, "2022-01-26T10:05:53.931Z", finished.GetSLI.Start)
+	require.Equal(t, "2022-01-26T10:10:53.931Z", finished.GetSLI.End)
+	require.Equal(t, "could not fetch 2 of 5 indicators: error_rate: query failed; throughput: panic while fetching indicator: unexpected response", finished.Message)
+	require.Equal(t, []*v0_2_0.SLIResult{
+		{Metric: "response_time_p95", Value: 17, Success: true},
+		{Metric: "error_rate", Message: "query failed"},
+		{Metric: "throughput", Message: "panic while fetching indicator: unexpected response"},
+		{Metric: "cpu", Value: 3, Success: true},
+		{Metric: "memory", Value: 6, Success: true},
+	}, finished.GetSLI.IndicatorValues)
+}
+
+func Test_SLIProviderAllIndicatorsFetched(t *testing.T) {
+	fetcher := SLIFetcherFunc(func(ctx context.Context, request SLIRequest, indicator string) (float64, error) {
+		return 1, nil
+	})
+	fakeKeptn := NewFakeKeptn("fake")
+	fakeKeptn.AddTaskHandler(v0_2_0.GetTriggeredEventType(v0_2_0.GetSLITaskName), NewSLIProvider(fetcher, SLIProviderOptions{}))
+	fakeKeptn.NewEvent(newGetSLITriggeredEvent("response_time_p95"))
+
+	fakeKeptn.AssertNumberOfEventSent(t, 2)
+	fakeKeptn.AssertSentEventResult(t, 1, v0_2_0.ResultPass)
+}
+
+func Test_SLIProviderTimeout(t *testing.T) {
+	fetcher := SLIFetcherFunc(func(ctx context.Context, request SLIRequest, indicator string) (float64, error) {
+		<-ctx.Done()
+		return 0, ctx.Err()
+	})
+	provider := NewSLIProvider(fetcher, SLIProviderOptions{Timeout: 10 * time.Millisecond})
+	result, err := provider.Execute(nil, KeptnEvent(newGetSLITriggeredEvent("response_time_p95")))
+	require.Nil(t, err)
+	finished := result.(v0_2_0.GetSLIFinishedEventData)
+	require.Equal(t, v0_2_0.ResultFailed, finished.Result)
+	require.Equal(t, context.DeadlineExceeded.Error(), finished.GetSLI.IndicatorValues[0].Message)
+}
+
+func Test_SLIProviderInvalidRequest(t *testing.T) {
+	fetcher := SLIFetcherFunc(func(ctx context.Context, request SLIRequest, indicator string) (float64, error) {
+		t.Fatal("no indicator must be fetched")
+		return 0, nil
+	})
+	provider := NewSLIProvider(fetcher, SLIProviderOptions{})
+
+	_, err := provider.Execute(nil, KeptnEvent(newGetSLITriggeredEvent()))
+	require.NotNil(t, err)
+	require.Equal(t, "no indicators requested", err.Message)
+
+	event := newGetSLITriggeredEvent("response_time_p95")
+	data := event.Data.(v0_2_0.GetSLITriggeredEventData)
+	data.GetSLI.End = "2022-01-26T10:00:00.000Z"
+	event.Data = data
+	_, err = provider.Execute(nil, KeptnEvent(event))
+	require.NotNil(t, err)
+	require.Equal(t, v0_2_0.StatusErrored, err.StatusType)
+	require.Contains(t, err.Message, "is before its start")
+
+	data.GetSLI.Start = "yesterday"
+	event.Data = data
+	_, err = provider.Execute(nil, KeptnEvent(event))
+	require.NotNil(t, err)
+	require.Contains(t, err.Message, "could not parse start of timeframe")
+}